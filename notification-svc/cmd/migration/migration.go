@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	_ "github.com/hervibest/be-yourmoments-backup/notification-svc/db/migrations"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
 	_ "github.com/lib/pq"
 	"github.com/pressly/goose/v3"
@@ -3,20 +3,34 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/cmd/migration"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/config"
+	grpcHandler "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/grpc"
+	http "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/controller"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/route"
 	subscriber "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/messaging"
 	consumer "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/messaging/photo"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/discovery"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/discovery/consul"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 var logs = logger.New("main")
+var grpcServer *grpc.Server
 
 func worker(ctx context.Context) error {
 	dbConfig := config.NewPostgresDatabase()
@@ -27,24 +41,85 @@ func worker(ctx context.Context) error {
 	config.DeletePhotoStream(jetStreamConfig, logs)
 	config.InitPhotoStream(jetStreamConfig, logs)
 	config.InitUserDeviceStream(jetStreamConfig, logs)
+	config.InitUserDeletionStream(jetStreamConfig, logs)
+	config.InitCreatorBatchStream(jetStreamConfig, logs)
+
+	app := config.NewApp()
+	serverConfig := config.NewServerConfig()
+
+	registry, err := consul.NewRegistry(serverConfig.ConsulAddr, serverConfig.Name)
+	if err != nil {
+		logs.Error("Failed to create consul registry: " + err.Error())
+		return err
+	}
+
+	GRPCserviceID := discovery.GenerateServiceID(serverConfig.Name + "-grpc")
+	grpcPortInt, _ := strconv.Atoi(serverConfig.GRPCPort)
+
+	err = registry.RegisterService(ctx, serverConfig.Name+"-grpc", GRPCserviceID, serverConfig.GRPCInternalAddr, grpcPortInt, []string{"grpc"})
+	if err != nil {
+		logs.Error("Failed to register notification service to consul")
+		return err
+	}
 
 	go func() {
 		<-ctx.Done()
 		logs.Log("Context canceled. Deregistering services...")
+		registry.DeregisterService(context.Background(), GRPCserviceID)
 
 		logs.Log("Shutting down servers...")
+		if err := app.Shutdown(); err != nil {
+			logs.Error(fmt.Sprintf("Error shutting down HTTP server: %v", err))
+		}
+		if grpcServer != nil {
+			grpcServer.GracefulStop()
+		}
 
 		logs.Log("Successfully shutdown...")
 	}()
 
+	go startHealthCheckLoop(ctx, registry, GRPCserviceID, serverConfig.Name+"-grpc")
+
+	userAdapter, err := adapter.NewUserAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+
+	photoAdapter, err := adapter.NewPhotoAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+
+	emailAdapter, err := adapter.NewEmailAdapter(config.NewEmailConfig())
+	if err != nil {
+		return err
+	}
+
 	cacheAdapter := adapter.NewCacheAdapter(redisConfig)
 	databaseAdapter := repository.NewDatabaseAdapter(dbConfig)
 	cloudMessagingAdapter := adapter.NewCloudMessagingAdapter(firebaseConfig)
 
+	customValidator := helper.NewCustomValidator()
+
 	userDeviceRepo := repository.NewUserDeviceRepository()
+	notificationRepo := repository.NewNotificationRepository()
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository()
+	notificationSettingRepo := repository.NewNotificationSettingRepository()
+	emailDeliveryRepo := repository.NewEmailDeliveryRepository()
+	emailBounceRepo := repository.NewEmailBounceRepository()
+	pushDeliveryRepo := repository.NewPushDeliveryRepository()
+	pushCampaignRepo := repository.NewPushCampaignRepository()
 
 	userDeviceUseCase := usecase.NewUserDeviceUseCase(databaseAdapter, userDeviceRepo, cacheAdapter, logs)
-	notificationUseCase := usecase.NewNotificationUseCase(databaseAdapter, redisConfig, userDeviceRepo, cloudMessagingAdapter, logs)
+	notificationUseCase := usecase.NewNotificationUseCase(databaseAdapter, redisConfig, userDeviceRepo, notificationRepo,
+		notificationPreferenceRepo, notificationSettingRepo, pushDeliveryRepo, cloudMessagingAdapter, photoAdapter, logs)
+	notificationInboxUseCase := usecase.NewNotificationInboxUseCase(databaseAdapter, notificationRepo, logs)
+	notificationPreferenceUseCase := usecase.NewNotificationPreferenceUseCase(databaseAdapter, notificationPreferenceRepo, notificationSettingRepo, logs)
+	emailNotificationUseCase := usecase.NewEmailNotificationUseCase(databaseAdapter, emailDeliveryRepo, emailBounceRepo,
+		notificationPreferenceRepo, notificationSettingRepo, emailAdapter, userAdapter, photoAdapter, logs)
+	pushAnalyticsUseCase := usecase.NewPushAnalyticsUseCase(databaseAdapter, pushDeliveryRepo, logs)
+	pushCampaignUseCase := usecase.NewPushCampaignUseCase(databaseAdapter, pushCampaignRepo, pushDeliveryRepo, notificationUseCase,
+		userAdapter, photoAdapter, logs)
 
 	photoConsumer := consumer.NewPhotoConsumer(notificationUseCase, jetStreamConfig, logs)
 	go func() {
@@ -61,9 +136,177 @@ func worker(ctx context.Context) error {
 		}
 	}()
 
+	userDeletionSubscriber := subscriber.NewUserDeletionSubscriber(jetStreamConfig, userDeviceUseCase, notificationInboxUseCase,
+		notificationPreferenceUseCase, emailNotificationUseCase, pushAnalyticsUseCase, logs)
+	go func() {
+		if err := userDeletionSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	creatorBatchSubscriber := subscriber.NewCreatorBatchSubscriber(jetStreamConfig, notificationUseCase, logs)
+	go func() {
+		if err := creatorBatchSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	transactionSubscriber := subscriber.NewTransactionSubscriber(jetStreamConfig, notificationUseCase, emailNotificationUseCase, pushAnalyticsUseCase, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "TRANSACTION_STREAM", logs); err != nil {
+			return
+		}
+		if err := transactionSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	transactionCanceledSubscriber := subscriber.NewTransactionCanceledSubscriber(jetStreamConfig, notificationUseCase, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "TRANSACTION_STREAM", logs); err != nil {
+			return
+		}
+		if err := transactionCanceledSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	creatorReviewSubscriber := subscriber.NewCreatorReviewSubscriber(jetStreamConfig, notificationUseCase, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "CREATOR_REVIEW_STREAM", logs); err != nil {
+			return
+		}
+		if err := creatorReviewSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	reviewSubscriber := subscriber.NewReviewSubscriber(jetStreamConfig, emailNotificationUseCase, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "REVIEW_STREAM", logs); err != nil {
+			return
+		}
+		if err := reviewSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	withdrawalSubscriber := subscriber.NewWithdrawalSubscriber(jetStreamConfig, notificationUseCase, emailNotificationUseCase, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "WITHDRAWAL_STREAM", logs); err != nil {
+			return
+		}
+		if err := withdrawalSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	go startDigestWorkerLoop(ctx, notificationUseCase)
+	go startCampaignWorkerLoop(ctx, pushCampaignUseCase)
+
+	healthCheckController := http.NewHealthCheckController()
+	notificationController := http.NewNotificationController(notificationInboxUseCase, customValidator, logs)
+	notificationPreferenceController := http.NewNotificationPreferenceController(notificationPreferenceUseCase, customValidator, logs)
+	pushAnalyticsController := http.NewPushAnalyticsController(pushAnalyticsUseCase, customValidator, logs)
+	pushCampaignController := http.NewPushCampaignController(pushCampaignUseCase, customValidator, logs)
+	authMiddleware := middleware.NewUserAuth(userAdapter, logs)
+
+	routes := route.RouteConfig{
+		App:                     app,
+		HealthCheckController:   healthCheckController,
+		NotificationController:  notificationController,
+		PreferenceController:    notificationPreferenceController,
+		PushAnalyticsController: pushAnalyticsController,
+		PushCampaignController:  pushCampaignController,
+		AuthMiddleware:          authMiddleware,
+	}
+	routes.Setup()
+
+	go func() {
+		grpcServer = grpc.NewServer()
+		reflection.Register(grpcServer)
+		l, err := net.Listen("tcp", serverConfig.GRPC)
+		if err != nil {
+			logs.Error(fmt.Sprintf("Failed to listen: %v", err))
+			return
+		}
+		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
+		defer l.Close()
+
+		grpcHandler.NewNotificationGRPCHandler(grpcServer, notificationUseCase, userDeviceUseCase)
+
+		if err := grpcServer.Serve(l); err != nil {
+			logs.Error(fmt.Sprintf("Failed to start gRPC server: %v", err))
+		}
+	}()
+
+	serverErrors := make(chan error, 1)
+	go func() {
+		logs.Log(fmt.Sprintf("Starting HTTP server at %s", serverConfig.HTTP))
+		serverErrors <- app.Listen(serverConfig.HTTP)
+	}()
+
 	select {
 	case <-ctx.Done():
 		return nil
+	case err := <-serverErrors:
+		return err
+	}
+}
+
+func startHealthCheckLoop(ctx context.Context, registry *consul.Registry, serviceID, serviceName string) {
+	failureCount := 0
+	const maxFailures = 5
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			err := registry.HealthCheck(serviceID, serviceName)
+			if err != nil {
+				logs.Error(fmt.Sprintf("Failed to perform health check for %s: %v", serviceName, err))
+				failureCount++
+				if failureCount >= maxFailures {
+					logs.Error(fmt.Sprintf("Max health check failures reached for %s. Exiting loop.", serviceName))
+					return
+				}
+			} else {
+				failureCount = 0
+			}
+			time.Sleep(2 * time.Second)
+		}
+	}
+}
+
+// startDigestWorkerLoop sends the due notification digests until the worker shuts down.
+func startDigestWorkerLoop(ctx context.Context, notificationUseCase usecase.NotificationUseCase) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := notificationUseCase.FlushDueDigests(ctx); err != nil {
+				logs.CustomError("failed to flush due notification digests", err)
+			}
+		}
+	}
+}
+
+// startCampaignWorkerLoop sends the due batches of the push campaigns until the worker shuts down.
+func startCampaignWorkerLoop(ctx context.Context, pushCampaignUseCase usecase.PushCampaignUseCase) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := pushCampaignUseCase.ProcessDueCampaigns(ctx); err != nil {
+				logs.CustomError("failed to process due push campaigns", err)
+			}
+		}
 	}
 }
 
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notifications (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(150) NOT NULL,
+    body TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX idx_notifications_userid_eventid ON notifications (user_id, event_id);
+CREATE INDEX idx_notifications_userid_createdat ON notifications (user_id, created_at DESC, id DESC);
+CREATE INDEX idx_notifications_userid_unread ON notifications (user_id) WHERE read_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_notifications_userid_unread;
+DROP INDEX IF EXISTS idx_notifications_userid_createdat;
+DROP INDEX IF EXISTS idx_notifications_userid_eventid;
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id CHAR(26) NOT NULL,
+    category VARCHAR(30) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (user_id, category, channel)
+);
+
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id CHAR(26) PRIMARY KEY NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta',
+    quiet_hours_start SMALLINT NULL,
+    quiet_hours_end SMALLINT NULL,
+    digest_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    digest_window_minutes INT NOT NULL DEFAULT 60,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_settings;
+DROP TABLE IF EXISTS notification_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notification_settings ADD COLUMN IF NOT EXISTS language VARCHAR(2) NOT NULL DEFAULT 'id';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_settings DROP COLUMN IF EXISTS language;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS email_deliveries (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    template VARCHAR(30) NOT NULL,
+    language VARCHAR(2) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    sent_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_email_deliveries_event_user_template ON email_deliveries (event_id, user_id, template);
+CREATE INDEX IF NOT EXISTS idx_email_deliveries_user_id ON email_deliveries (user_id);
+
+CREATE TABLE IF NOT EXISTS email_bounces (
+    email VARCHAR(255) PRIMARY KEY NOT NULL,
+    bounce_type VARCHAR(10) NOT NULL,
+    bounce_count INT NOT NULL DEFAULT 1,
+    last_code INT NOT NULL,
+    last_reason TEXT NOT NULL,
+    last_bounced_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS email_bounces;
+DROP TABLE IF EXISTS email_deliveries;
+-- +goose StatementEnd
@@ -0,0 +1,160 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
+	"github.com/lib/pq"
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upMoveUserDevicesFromUserSvc, downMoveUserDevicesFromUserSvc)
+}
+
+// upMoveUserDevicesFromUserSvc copies the device tokens still stored in user-svc so notification-svc becomes their
+// only owner. USER_DB_URL is required, skipping the copy would mark the migration applied and lose the tokens for good.
+func upMoveUserDevicesFromUserSvc(ctx context.Context, tx *sql.Tx) error {
+	userDB, err := openUserDB()
+	if err != nil {
+		return err
+	}
+	defer userDB.Close()
+
+	return MoveUserDevicesFromUserSvc(ctx, tx, userDB)
+}
+
+// downMoveUserDevicesFromUserSvc removes the tokens copied from user-svc, user-svc still has them so moving up again
+// copies them back
+func downMoveUserDevicesFromUserSvc(ctx context.Context, tx *sql.Tx) error {
+	userDB, err := openUserDB()
+	if err != nil {
+		return err
+	}
+	defer userDB.Close()
+
+	return RemoveUserDevicesMovedFromUserSvc(ctx, tx, userDB)
+}
+
+// MoveUserDevicesFromUserSvc copies every device token of the user-svc database into tx, tokens that already exist
+// here are kept as they are
+func MoveUserDevicesFromUserSvc(ctx context.Context, tx *sql.Tx, userDB *sql.DB) error {
+	found, err := hasUserDevicesTable(ctx, userDB)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Println("user-svc has no user_devices table, nothing to migrate")
+		return nil
+	}
+
+	rows, err := userDB.QueryContext(ctx, `SELECT id, user_id, token, platform, created_at FROM user_devices`)
+	if err != nil {
+		return fmt.Errorf("failed to read user-svc user devices: %w", err)
+	}
+	defer rows.Close()
+
+	query := `
+	INSERT INTO user_devices
+	(id, user_id, token, platform, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id, token) DO NOTHING
+	`
+
+	moved := 0
+	for rows.Next() {
+		var (
+			id, userId, token, platform string
+			createdAt                   time.Time
+		)
+		if err := rows.Scan(&id, &userId, &token, &platform, &createdAt); err != nil {
+			return fmt.Errorf("failed to scan user-svc user device: %w", err)
+		}
+
+		result, err := tx.ExecContext(ctx, query, id, userId, token, platform, createdAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert user device %s: %w", id, err)
+		}
+
+		affected, _ := result.RowsAffected()
+		moved += int(affected)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read user-svc user devices: %w", err)
+	}
+
+	log.Printf("moved %d user devices from user-svc", moved)
+	return nil
+}
+
+// RemoveUserDevicesMovedFromUserSvc deletes from tx the device tokens that still exist in the user-svc database,
+// tokens registered in notification-svc after the move are kept
+func RemoveUserDevicesMovedFromUserSvc(ctx context.Context, tx *sql.Tx, userDB *sql.DB) error {
+	found, err := hasUserDevicesTable(ctx, userDB)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	rows, err := userDB.QueryContext(ctx, `SELECT id FROM user_devices`)
+	if err != nil {
+		return fmt.Errorf("failed to read user-svc user devices: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan user-svc user device: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read user-svc user devices: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM user_devices WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to delete moved user devices: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	log.Printf("removed %d user devices moved from user-svc", removed)
+	return nil
+}
+
+// openUserDB connects to the user-svc database
+func openUserDB() (*sql.DB, error) {
+	userDBURL := utils.GetEnv("USER_DB_URL")
+	if userDBURL == "" {
+		return nil, fmt.Errorf("USER_DB_URL is required to move the user devices from user-svc")
+	}
+
+	userDB, err := sql.Open("postgres", userDBURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to user-svc DB: %w", err)
+	}
+
+	return userDB, nil
+}
+
+// hasUserDevicesTable reports whether the user-svc database still has its user_devices table
+func hasUserDevicesTable(ctx context.Context, userDB *sql.DB) (bool, error) {
+	var table sql.NullString
+	if err := userDB.QueryRowContext(ctx, `SELECT to_regclass('public.user_devices')`).Scan(&table); err != nil {
+		return false, fmt.Errorf("failed to check user-svc user_devices table: %w", err)
+	}
+
+	return table.Valid, nil
+}
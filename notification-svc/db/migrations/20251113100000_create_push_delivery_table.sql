@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS push_deliveries (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    push_id CHAR(26) NOT NULL,
+    campaign_id VARCHAR(64) NOT NULL,
+    category VARCHAR(30) NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    notification_id CHAR(26) NULL,
+    user_id CHAR(26) NOT NULL,
+    token TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL,
+    error_code VARCHAR(64) NULL,
+    opened_at TIMESTAMPTZ NULL,
+    clicked_at TIMESTAMPTZ NULL,
+    converted_at TIMESTAMPTZ NULL,
+    transaction_id VARCHAR(64) NULL,
+    revenue BIGINT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_push_deliveries_campaign_id ON push_deliveries (campaign_id);
+CREATE INDEX IF NOT EXISTS idx_push_deliveries_push_id_user_id ON push_deliveries (push_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_push_deliveries_user_id_opened_at ON push_deliveries (user_id, opened_at DESC) WHERE opened_at IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_push_deliveries_transaction_id ON push_deliveries (transaction_id) WHERE transaction_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS push_deliveries;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS push_campaigns (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    body VARCHAR(500) NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    segment VARCHAR(20) NOT NULL,
+    bulk_photo_id CHAR(26) NULL,
+    inactive_days INT NOT NULL DEFAULT 0,
+    batch_size INT NOT NULL,
+    status VARCHAR(10) NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    next_batch_at TIMESTAMPTZ NOT NULL,
+    cursor_user_id VARCHAR(26) NOT NULL DEFAULT '',
+    targeted_count INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    started_at TIMESTAMPTZ NULL,
+    completed_at TIMESTAMPTZ NULL,
+    canceled_at TIMESTAMPTZ NULL,
+    created_by CHAR(26) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_push_campaigns_created_at ON push_campaigns (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_push_campaigns_next_batch_at ON push_campaigns (next_batch_at) WHERE status IN ('SCHEDULED', 'SENDING');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS push_campaigns;
+-- +goose StatementEnd
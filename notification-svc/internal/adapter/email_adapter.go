@@ -0,0 +1,253 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/config"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/templates"
+)
+
+type EmailAdapter interface {
+	SendEmail(ctx context.Context, message *model.EmailMessage) error
+}
+
+var emailLanguages = []enum.LanguageEnum{enum.LanguageIndonesian, enum.LanguageEnglish}
+
+var emailSubjects = map[enum.LanguageEnum]map[enum.EmailTemplateEnum]string{
+	enum.LanguageIndonesian: {
+		enum.EmailTemplateTransactionReceipt: "Bukti pembelian {{.TransactionId}}",
+		enum.EmailTemplateCreatorSale:        "Fotomu terjual seharga {{.Amount}}",
+		enum.EmailTemplateWithdrawalStatus: `{{if eq .Status "SUCCESS"}}Penarikan dana disetujui{{else if eq .Status "FAILED"}}` +
+			`Penarikan dana ditolak{{else}}Permintaan penarikan dana diterima{{end}}`,
+		enum.EmailTemplateReviewReceived: "Kamu mendapat ulasan {{.Rating}} bintang",
+	},
+	enum.LanguageEnglish: {
+		enum.EmailTemplateTransactionReceipt: "Your receipt for {{.TransactionId}}",
+		enum.EmailTemplateCreatorSale:        "Your photos were sold for {{.Amount}}",
+		enum.EmailTemplateWithdrawalStatus: `{{if eq .Status "SUCCESS"}}Your withdrawal was approved{{else if eq .Status "FAILED"}}` +
+			`Your withdrawal was rejected{{else}}We received your withdrawal request{{end}}`,
+		enum.EmailTemplateReviewReceived: "You received a {{.Rating}} star review",
+	},
+}
+
+type emailTemplate struct {
+	subject *texttemplate.Template
+	body    *htmltemplate.Template
+}
+
+type emailAdapter struct {
+	config    *config.EmailConfig
+	templates map[enum.LanguageEnum]map[enum.EmailTemplateEnum]*emailTemplate
+}
+
+// NewEmailAdapter parses every template up front so a broken template stops the service at start instead of failing
+// each delivery
+func NewEmailAdapter(emailConfig *config.EmailConfig) (EmailAdapter, error) {
+	parsedTemplates := make(map[enum.LanguageEnum]map[enum.EmailTemplateEnum]*emailTemplate, len(emailLanguages))
+	for _, language := range emailLanguages {
+		parsedTemplates[language] = make(map[enum.EmailTemplateEnum]*emailTemplate, len(enum.EmailTemplates))
+		for _, name := range enum.EmailTemplates {
+			subject, err := texttemplate.New("subject").Parse(emailSubjects[language][name])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s subject of %s email: %w", language, name, err)
+			}
+
+			body, err := htmltemplate.ParseFS(templates.EmailFS,
+				fmt.Sprintf("email/%s/layout.html", language),
+				fmt.Sprintf("email/%s/%s.html", language, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s template of %s email: %w", language, name, err)
+			}
+
+			parsedTemplates[language][name] = &emailTemplate{subject: subject, body: body}
+		}
+	}
+
+	return &emailAdapter{
+		config:    emailConfig,
+		templates: parsedTemplates,
+	}, nil
+}
+
+// SendEmail retries temporary failures with a growing backoff, a permanent reply such as an unknown mailbox is
+// returned at once so it can be recorded as a bounce
+func (a *emailAdapter) SendEmail(ctx context.Context, message *model.EmailMessage) error {
+	subject, body, err := a.render(message)
+	if err != nil {
+		return err
+	}
+
+	raw, err := a.buildMessage(message, subject, body)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = a.send(ctx, message.To, raw)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= a.config.MaxAttempts || !helper.ParseSMTPError(err).IsRetryable() {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(a.config.RetryBackoff * time.Duration(attempt)):
+		}
+	}
+}
+
+func (a *emailAdapter) render(message *model.EmailMessage) (string, string, error) {
+	languageTemplates, ok := a.templates[message.Language]
+	if !ok {
+		languageTemplates = a.templates[enum.LanguageIndonesian]
+	}
+
+	emailTemplate, ok := languageTemplates[message.Template]
+	if !ok {
+		return "", "", fmt.Errorf("unknown email template %s", message.Template)
+	}
+
+	subject := new(bytes.Buffer)
+	if err := emailTemplate.subject.Execute(subject, message.Data); err != nil {
+		return "", "", fmt.Errorf("failed to render email subject: %w", err)
+	}
+
+	layoutData := struct {
+		AppUrl      string
+		FrontendUrl string
+		Data        any
+	}{
+		AppUrl:      a.config.AppUrl,
+		FrontendUrl: a.config.FrontendUrl,
+		Data:        message.Data,
+	}
+
+	body := new(bytes.Buffer)
+	if err := emailTemplate.body.ExecuteTemplate(body, "layout", layoutData); err != nil {
+		return "", "", fmt.Errorf("failed to render email body: %w", err)
+	}
+
+	return subject.String(), body.String(), nil
+}
+
+func (a *emailAdapter) buildMessage(message *model.EmailMessage, subject, body string) ([]byte, error) {
+	from := mail.Address{Name: a.config.SenderName, Address: a.config.EmailFrom}
+	to := mail.Address{Address: message.To}
+
+	domain := a.config.EmailFrom[strings.LastIndex(a.config.EmailFrom, "@")+1:]
+
+	raw := new(bytes.Buffer)
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + to.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		fmt.Sprintf("Message-ID: <%s@%s>", message.Id, domain),
+		"MIME-Version: 1.0",
+		"Content-Type: text/html; charset=UTF-8",
+		"Content-Transfer-Encoding: quoted-printable",
+	}
+	for _, header := range headers {
+		raw.WriteString(header + "\r\n")
+	}
+	raw.WriteString("\r\n")
+
+	writer := quotedprintable.NewWriter(raw)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return nil, fmt.Errorf("failed to encode email body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode email body: %w", err)
+	}
+
+	return raw.Bytes(), nil
+}
+
+// send delivers the email in one SMTP session, port 465 uses implicit TLS while other ports upgrade with STARTTLS when
+// the server offers it
+func (a *emailAdapter) send(ctx context.Context, to string, raw []byte) error {
+	address := net.JoinHostPort(a.config.Host, a.config.Port)
+	dialer := &net.Dialer{Timeout: a.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(a.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	tlsConfig := &tls.Config{ServerName: a.config.Host}
+	if a.config.Port == "465" {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, a.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	if a.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); ok {
+			auth := smtp.PlainAuth("", a.config.Username, a.config.Password, a.config.Host)
+			if err := client.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := client.Mail(a.config.EmailFrom); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(raw); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,89 @@
+package adapter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/discovery"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
+
+	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
+)
+
+type PhotoAdapter interface {
+	GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error)
+	ListEventAttendeeUserIds(ctx context.Context, bulkPhotoId, afterUserId string, limit int) ([]string, error)
+	ListCreatorUserIds(ctx context.Context, afterUserId string, limit int) ([]string, error)
+}
+
+type photoAdapter struct {
+	client photopb.PhotoServiceClient
+}
+
+func NewPhotoAdapter(ctx context.Context, registry discovery.Registry, logs logger.Log) (PhotoAdapter, error) {
+	photoServiceName := utils.GetEnv("PHOTO_SVC_NAME")
+	conn, err := discovery.ServiceConnection(ctx, photoServiceName, registry, logs)
+	if err != nil {
+		logs.CustomError("failed to connect to photo service due to an error : ", err)
+		return nil, err
+	}
+
+	log.Print("successfuly connected to photo-svc-grpc")
+	client := photopb.NewPhotoServiceClient(conn)
+
+	return &photoAdapter{
+		client: client,
+	}, nil
+}
+
+// GetCreatorUserIds maps each creator id to the user id owning the creator account
+func (a *photoAdapter) GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error) {
+	getCreatorsRequest := &photopb.GetCreatorsByIdsRequest{
+		CreatorIds: creatorIds,
+	}
+
+	response, err := a.client.GetCreatorsByIds(ctx, getCreatorsRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	creatorUserIds := make(map[string]string, len(response.GetCreators()))
+	for _, creator := range response.GetCreators() {
+		creatorUserIds[creator.GetId()] = creator.GetUserId()
+	}
+
+	return creatorUserIds, nil
+}
+
+// ListEventAttendeeUserIds returns a page of the users found in the photos of a bulk upload sorted by id
+func (a *photoAdapter) ListEventAttendeeUserIds(ctx context.Context, bulkPhotoId, afterUserId string, limit int) ([]string, error) {
+	listEventAttendeeUserIdsRequest := &photopb.ListEventAttendeeUserIdsRequest{
+		BulkPhotoId: bulkPhotoId,
+		AfterUserId: afterUserId,
+		Limit:       int32(limit),
+	}
+
+	response, err := a.client.ListEventAttendeeUserIds(ctx, listEventAttendeeUserIdsRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response.GetUserIds(), nil
+}
+
+// ListCreatorUserIds returns a page of the users owning a creator account sorted by id
+func (a *photoAdapter) ListCreatorUserIds(ctx context.Context, afterUserId string, limit int) ([]string, error) {
+	listCreatorUserIdsRequest := &photopb.ListCreatorUserIdsRequest{
+		AfterUserId: afterUserId,
+		Limit:       int32(limit),
+	}
+
+	response, err := a.client.ListCreatorUserIds(ctx, listCreatorUserIdsRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response.GetUserIds(), nil
+}
@@ -0,0 +1,84 @@
+package adapter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/discovery"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
+
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+)
+
+type UserAdapter interface {
+	AuthenticateUser(ctx context.Context, token string) (*userpb.AuthenticateResponse, error)
+	GetUserContacts(ctx context.Context, userIds []string) ([]*userpb.UserContact, error)
+	ListSegmentUserIds(ctx context.Context, segment string, inactiveDays int, afterUserId string, limit int) ([]string, error)
+}
+
+type userAdapter struct {
+	client userpb.UserServiceClient
+}
+
+func NewUserAdapter(ctx context.Context, registry discovery.Registry, logs logger.Log) (UserAdapter, error) {
+	userServiceName := utils.GetEnv("USER_SVC_NAME")
+	conn, err := discovery.ServiceConnection(ctx, userServiceName, registry, logs)
+	if err != nil {
+		logs.CustomError("failed to connect to user service due to an error : ", err)
+		return nil, err
+	}
+
+	log.Print("successfuly connected to user-svc-grpc")
+	client := userpb.NewUserServiceClient(conn)
+
+	return &userAdapter{
+		client: client,
+	}, nil
+}
+
+func (a *userAdapter) AuthenticateUser(ctx context.Context, token string) (*userpb.AuthenticateResponse, error) {
+	authenticateRequest := &userpb.AuthenticateRequest{
+		Token: token,
+	}
+
+	response, err := a.client.Authenticate(ctx, authenticateRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response, nil
+}
+
+// GetUserContacts returns the users with a verified email, the others are left out of the response
+func (a *userAdapter) GetUserContacts(ctx context.Context, userIds []string) ([]*userpb.UserContact, error) {
+	getUserContactsRequest := &userpb.GetUserContactsRequest{
+		UserIds: userIds,
+	}
+
+	response, err := a.client.GetUserContacts(ctx, getUserContactsRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response.GetContacts(), nil
+}
+
+// ListSegmentUserIds returns a page of the active users of an audience segment sorted by id
+func (a *userAdapter) ListSegmentUserIds(ctx context.Context, segment string, inactiveDays int, afterUserId string,
+	limit int) ([]string, error) {
+	listSegmentUserIdsRequest := &userpb.ListSegmentUserIdsRequest{
+		Segment:      segment,
+		InactiveDays: int32(inactiveDays),
+		AfterUserId:  afterUserId,
+		Limit:        int32(limit),
+	}
+
+	response, err := a.client.ListSegmentUserIds(ctx, listSegmentUserIdsRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response.GetUserIds(), nil
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
+)
+
+// EmailConfig is the SMTP server the emails are sent through and the urls linked from the email templates
+type EmailConfig struct {
+	Host         string
+	Port         string
+	Username     string
+	Password     string
+	EmailFrom    string
+	SenderName   string
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	Timeout      time.Duration
+	AppUrl       string
+	FrontendUrl  string
+}
+
+func NewEmailConfig() *EmailConfig {
+	host := utils.GetEnv("SMTP_HOST")
+	if host == "" {
+		log.Fatal("SMTP_HOST environment variable is not set")
+	}
+	emailFrom := utils.GetEnv("SMTP_EMAIL_FROM")
+	if emailFrom == "" {
+		log.Fatal("SMTP_EMAIL_FROM environment variable is not set")
+	}
+
+	maxAttempts, err := strconv.Atoi(utils.GetEnv("SMTP_MAX_ATTEMPTS", "3"))
+	if err != nil || maxAttempts <= 0 {
+		log.Printf("Invalid SMTP_MAX_ATTEMPTS value, defaulting to 3")
+		maxAttempts = 3
+	}
+
+	retryBackoff, err := time.ParseDuration(utils.GetEnv("SMTP_RETRY_BACKOFF", "2s"))
+	if err != nil {
+		log.Printf("Invalid SMTP_RETRY_BACKOFF value, defaulting to 2s")
+		retryBackoff = 2 * time.Second
+	}
+
+	return &EmailConfig{
+		Host:         host,
+		Port:         utils.GetEnv("SMTP_PORT", "587"),
+		Username:     utils.GetEnv("SMTP_USERNAME"),
+		Password:     utils.GetEnv("SMTP_PASSWORD"),
+		EmailFrom:    emailFrom,
+		SenderName:   utils.GetEnv("SMTP_SENDER_NAME", "YourMoments"),
+		MaxAttempts:  maxAttempts,
+		RetryBackoff: retryBackoff,
+		Timeout:      30 * time.Second,
+		AppUrl:       utils.GetEnv("BACKEND_URL"),
+		FrontendUrl:  utils.GetEnv("FRONTEND_URL"),
+	}
+}
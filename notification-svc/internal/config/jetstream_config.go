@@ -1,8 +1,11 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/utils"
@@ -75,3 +78,53 @@ func InitUserDeviceStream(js nats.JetStreamContext, log logger.Log) {
 		log.CustomError("failed to setup photo stream", err)
 	}
 }
+
+func InitUserDeletionStream(js nats.JetStreamContext, log logger.Log) {
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "USER_DELETION_STREAM",
+		Subjects: []string{"user.deleted"},
+		Storage:  nats.FileStorage,
+	})
+
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		log.CustomError("failed to setup user deletion stream", err)
+	}
+}
+
+func InitCreatorBatchStream(js nats.JetStreamContext, log logger.Log) {
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "CREATOR_BATCH_STREAM",
+		Subjects: []string{"creator.batch.published"},
+		Storage:  nats.FileStorage,
+	})
+
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		log.CustomError("failed to setup creator batch stream", err)
+	}
+}
+
+// streamWaitInterval is how often WaitForStream looks again for a stream its publisher has not declared yet
+const streamWaitInterval = 5 * time.Second
+
+// WaitForStream blocks until a stream owned by another service exists. Consumers bind to such a stream
+// instead of declaring it, so only the publisher defines its subjects.
+func WaitForStream(ctx context.Context, js nats.JetStreamContext, name string, logs logger.Log) error {
+	for {
+		_, err := js.StreamInfo(name)
+		if err == nil {
+			return nil
+		}
+
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			logs.Log(fmt.Sprintf("waiting for %s to be declared by its publisher", name))
+		} else {
+			logs.CustomError(fmt.Sprintf("failed to get %s info", name), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(streamWaitInterval):
+		}
+	}
+}
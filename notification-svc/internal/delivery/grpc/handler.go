@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	notificationpb "github.com/hervibest/be-yourmoments-backup/pb/notification"
+	"google.golang.org/grpc"
+)
+
+type NotificationGRPCHandler struct {
+	notificationUseCase usecase.NotificationUseCase
+	userDeviceUseCase   usecase.UserDeviceUseCase
+	notificationpb.UnimplementedNotificationServiceServer
+}
+
+func NewNotificationGRPCHandler(server *grpc.Server, notificationUseCase usecase.NotificationUseCase, userDeviceUseCase usecase.UserDeviceUseCase) {
+	handler := &NotificationGRPCHandler{
+		notificationUseCase: notificationUseCase,
+		userDeviceUseCase:   userDeviceUseCase,
+	}
+
+	notificationpb.RegisterNotificationServiceServer(server, handler)
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	notificationpb "github.com/hervibest/be-yourmoments-backup/pb/notification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *NotificationGRPCHandler) SendNotification(ctx context.Context,
+	pbReq *notificationpb.SendNotificationRequest) (*notificationpb.SendNotificationResponse, error) {
+
+	log.Println("---- Send Notification via gRPC in notification-svc ------")
+
+	request := &model.SendNotificationRequest{
+		EventId:  pbReq.GetEventId(),
+		UserIds:  pbReq.GetUserIds(),
+		Category: enum.NotificationCategoryEnum(pbReq.GetCategory()),
+		Type:     enum.NotificationTypeEnum(pbReq.GetType()),
+		Title:    pbReq.GetTitle(),
+		Body:     pbReq.GetBody(),
+		Data:     pbReq.GetData(),
+	}
+
+	if err := h.notificationUseCase.SendNotification(ctx, request); err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &notificationpb.SendNotificationResponse{
+		Status: int64(codes.OK),
+	}, nil
+}
+
+func (h *NotificationGRPCHandler) GetUserDevices(ctx context.Context,
+	pbReq *notificationpb.GetUserDevicesRequest) (*notificationpb.GetUserDevicesResponse, error) {
+
+	log.Println("---- Get User Devices via gRPC in notification-svc ------")
+
+	response, err := h.userDeviceUseCase.GetDevices(ctx, pbReq.GetUserId())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	devicesPb := make([]*notificationpb.UserDevice, 0, len(response))
+	for _, device := range response {
+		devicePb := &notificationpb.UserDevice{
+			Id:       device.Id,
+			UserId:   device.UserId,
+			Token:    device.Token,
+			Platform: string(device.Platform),
+		}
+		if device.CreatedAt != nil {
+			devicePb.CreatedAt = timestamppb.New(*device.CreatedAt)
+		}
+		devicesPb = append(devicesPb, devicePb)
+	}
+
+	return &notificationpb.GetUserDevicesResponse{
+		Status:  int64(codes.OK),
+		Devices: devicesPb,
+	}, nil
+}
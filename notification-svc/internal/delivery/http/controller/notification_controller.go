@@ -0,0 +1,132 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationController interface {
+	GetNotifications(ctx *fiber.Ctx) error
+	CountUnread(ctx *fiber.Ctx) error
+	MarkRead(ctx *fiber.Ctx) error
+	MarkAllRead(ctx *fiber.Ctx) error
+	DeleteNotification(ctx *fiber.Ctx) error
+}
+
+type notificationController struct {
+	notificationInboxUseCase usecase.NotificationInboxUseCase
+	customValidator          helper.CustomValidator
+	logs                     logger.Log
+}
+
+func NewNotificationController(notificationInboxUseCase usecase.NotificationInboxUseCase, customValidator helper.CustomValidator,
+	logs logger.Log) NotificationController {
+	return &notificationController{
+		notificationInboxUseCase: notificationInboxUseCase,
+		customValidator:          customValidator,
+		logs:                     logs,
+	}
+}
+
+func (c *notificationController) GetNotifications(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.GetNotificationsRequest{
+		UserId:     auth.UserId,
+		UnreadOnly: ctx.QueryBool("unread", false),
+		Cursor:     ctx.Query("cursor"),
+		Size:       ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.notificationInboxUseCase.GetNotifications(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get notifications : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.OriginalURL()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.NotificationResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *notificationController) CountUnread(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	response, err := c.notificationInboxUseCase.CountUnread(ctx.Context(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Count unread notifications : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UnreadNotificationCountResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *notificationController) MarkRead(ctx *fiber.Ctx) error {
+	request := new(model.MarkNotificationsReadRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.UserId = middleware.GetUser(ctx).UserId
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.notificationInboxUseCase.MarkRead(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Mark notifications read : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UnreadNotificationCountResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *notificationController) MarkAllRead(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	response, err := c.notificationInboxUseCase.MarkAllRead(ctx.Context(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Mark all notifications read : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UnreadNotificationCountResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *notificationController) DeleteNotification(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.DeleteNotificationRequest{
+		UserId:         auth.UserId,
+		NotificationId: ctx.Params("notificationId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.notificationInboxUseCase.DeleteNotification(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Delete notification : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
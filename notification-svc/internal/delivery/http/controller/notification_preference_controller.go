@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationPreferenceController interface {
+	GetPreferences(ctx *fiber.Ctx) error
+	UpdatePreferences(ctx *fiber.Ctx) error
+}
+
+type notificationPreferenceController struct {
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase
+	customValidator               helper.CustomValidator
+	logs                          logger.Log
+}
+
+func NewNotificationPreferenceController(notificationPreferenceUseCase usecase.NotificationPreferenceUseCase,
+	customValidator helper.CustomValidator, logs logger.Log) NotificationPreferenceController {
+	return &notificationPreferenceController{
+		notificationPreferenceUseCase: notificationPreferenceUseCase,
+		customValidator:               customValidator,
+		logs:                          logs,
+	}
+}
+
+func (c *notificationPreferenceController) GetPreferences(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	response, err := c.notificationPreferenceUseCase.GetPreferences(ctx.Context(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get notification preferences : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.NotificationPreferenceResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *notificationPreferenceController) UpdatePreferences(ctx *fiber.Ctx) error {
+	request := new(model.UpdateNotificationPreferenceRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.UserId = middleware.GetUser(ctx).UserId
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.notificationPreferenceUseCase.UpdatePreferences(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Update notification preferences : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.NotificationPreferenceResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
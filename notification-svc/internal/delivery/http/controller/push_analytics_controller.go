@@ -0,0 +1,96 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PushAnalyticsController interface {
+	AcknowledgePush(ctx *fiber.Ctx) error
+	GetCampaignMetrics(ctx *fiber.Ctx) error
+	GetCampaignMetric(ctx *fiber.Ctx) error
+}
+
+type pushAnalyticsController struct {
+	pushAnalyticsUseCase usecase.PushAnalyticsUseCase
+	customValidator      helper.CustomValidator
+	logs                 logger.Log
+}
+
+func NewPushAnalyticsController(pushAnalyticsUseCase usecase.PushAnalyticsUseCase, customValidator helper.CustomValidator,
+	logs logger.Log) PushAnalyticsController {
+	return &pushAnalyticsController{
+		pushAnalyticsUseCase: pushAnalyticsUseCase,
+		customValidator:      customValidator,
+		logs:                 logs,
+	}
+}
+
+func (c *pushAnalyticsController) AcknowledgePush(ctx *fiber.Ctx) error {
+	request := new(model.AcknowledgePushRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.UserId = middleware.GetUser(ctx).UserId
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.pushAnalyticsUseCase.AcknowledgePush(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Acknowledge push : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *pushAnalyticsController) GetCampaignMetrics(ctx *fiber.Ctx) error {
+	request := &model.GetPushCampaignMetricsRequest{
+		Category: ctx.Query("category"),
+		Days:     ctx.QueryInt("days", 30),
+		Size:     ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.pushAnalyticsUseCase.GetCampaignMetrics(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get push campaign metrics : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.PushCampaignMetricResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *pushAnalyticsController) GetCampaignMetric(ctx *fiber.Ctx) error {
+	request := &model.GetPushCampaignMetricRequest{
+		CampaignId: ctx.Params("campaignId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.pushAnalyticsUseCase.GetCampaignMetric(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get push campaign metric : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.PushCampaignMetricResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PushCampaignController interface {
+	CreateCampaign(ctx *fiber.Ctx) error
+	GetCampaigns(ctx *fiber.Ctx) error
+	GetCampaign(ctx *fiber.Ctx) error
+	CancelCampaign(ctx *fiber.Ctx) error
+}
+
+type pushCampaignController struct {
+	pushCampaignUseCase usecase.PushCampaignUseCase
+	customValidator     helper.CustomValidator
+	logs                logger.Log
+}
+
+func NewPushCampaignController(pushCampaignUseCase usecase.PushCampaignUseCase, customValidator helper.CustomValidator,
+	logs logger.Log) PushCampaignController {
+	return &pushCampaignController{
+		pushCampaignUseCase: pushCampaignUseCase,
+		customValidator:     customValidator,
+		logs:                logs,
+	}
+}
+
+func (c *pushCampaignController) CreateCampaign(ctx *fiber.Ctx) error {
+	request := new(model.CreatePushCampaignRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.CreatedBy = middleware.GetUser(ctx).UserId
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.pushCampaignUseCase.CreateCampaign(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Create push campaign : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.PushCampaignResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *pushCampaignController) GetCampaigns(ctx *fiber.Ctx) error {
+	request := &model.GetPushCampaignsRequest{
+		Status: enum.PushCampaignStatusEnum(ctx.Query("status")),
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.pushCampaignUseCase.GetCampaigns(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get push campaigns : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.OriginalURL()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.PushCampaignResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *pushCampaignController) GetCampaign(ctx *fiber.Ctx) error {
+	request := &model.GetPushCampaignRequest{
+		CampaignId: ctx.Params("campaignId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.pushCampaignUseCase.GetCampaign(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get push campaign : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.PushCampaignResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *pushCampaignController) CancelCampaign(ctx *fiber.Ctx) error {
+	request := &model.CancelPushCampaignRequest{
+		CampaignId: ctx.Params("campaignId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.pushCampaignUseCase.CancelCampaign(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Cancel push campaign : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.PushCampaignResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -0,0 +1,5 @@
+package route
+
+func (r *RouteConfig) SetupHealtCheckRoute() {
+	r.App.Get("/health", r.HealthCheckController.HealthCheck)
+}
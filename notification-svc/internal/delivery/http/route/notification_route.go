@@ -0,0 +1,26 @@
+package route
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+func (r *RouteConfig) SetupNotificationRoute() {
+	notificationRoutes := r.App.Group("/api/notification", r.AuthMiddleware)
+	notificationRoutes.Get("/preferences", r.PreferenceController.GetPreferences)
+	notificationRoutes.Put("/preferences", r.PreferenceController.UpdatePreferences)
+	notificationRoutes.Post("/push/ack", r.PushAnalyticsController.AcknowledgePush)
+	notificationRoutes.Get("/", r.NotificationController.GetNotifications)
+	notificationRoutes.Get("/unread-count", r.NotificationController.CountUnread)
+	notificationRoutes.Put("/read", r.NotificationController.MarkRead)
+	notificationRoutes.Put("/read-all", r.NotificationController.MarkAllRead)
+	notificationRoutes.Delete("/:notificationId", r.NotificationController.DeleteNotification)
+
+	adminRoutes := r.App.Group("/api/admin/notification", r.AuthMiddleware, middleware.NewRequirePermission(enum.PermissionNotificationManage))
+	adminRoutes.Get("/push-campaigns", r.PushAnalyticsController.GetCampaignMetrics)
+	adminRoutes.Get("/push-campaigns/:campaignId", r.PushAnalyticsController.GetCampaignMetric)
+	adminRoutes.Post("/campaigns", r.PushCampaignController.CreateCampaign)
+	adminRoutes.Get("/campaigns", r.PushCampaignController.GetCampaigns)
+	adminRoutes.Get("/campaigns/:campaignId", r.PushCampaignController.GetCampaign)
+	adminRoutes.Put("/campaigns/:campaignId/cancel", r.PushCampaignController.CancelCampaign)
+}
@@ -0,0 +1,22 @@
+package route
+
+import (
+	http "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/controller"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RouteConfig struct {
+	App                     *fiber.App
+	HealthCheckController   http.HealthCheckController
+	NotificationController  http.NotificationController
+	PreferenceController    http.NotificationPreferenceController
+	PushAnalyticsController http.PushAnalyticsController
+	PushCampaignController  http.PushCampaignController
+	AuthMiddleware          fiber.Handler
+}
+
+func (r *RouteConfig) Setup() {
+	r.SetupHealtCheckRoute()
+	r.SetupNotificationRoute()
+}
@@ -41,7 +41,7 @@ func (s *PhotoConsumer) handleMessage(ctx context.Context, msg *nats.Msg) {
 
 		s.logs.Log(fmt.Sprintf("unmarshalled event: %+v", event))
 
-		err = s.notificationUseCase.ProcessAndSendBulkNotificationsV2(ctx, event.UserCountMap)
+		err = s.notificationUseCase.ProcessAndSendBulkNotificationsV2(ctx, event)
 		if err != nil {
 			s.handleError(msg, err, event.EventID)
 			return
@@ -55,7 +55,7 @@ func (s *PhotoConsumer) handleMessage(ctx context.Context, msg *nats.Msg) {
 			return
 		}
 
-		err = s.notificationUseCase.ProcessAndSendSingleFacecamNotifications(ctx, event.UserID, event.CountPhotos)
+		err = s.notificationUseCase.ProcessAndSendSingleFacecamNotifications(ctx, event)
 		if err != nil {
 			s.handleError(msg, err, event.EventID)
 			return
@@ -69,7 +69,7 @@ func (s *PhotoConsumer) handleMessage(ctx context.Context, msg *nats.Msg) {
 			return
 		}
 
-		err = s.notificationUseCase.ProcessAndSendSingleNotifications(ctx, event.UserIDs)
+		err = s.notificationUseCase.ProcessAndSendSingleNotifications(ctx, event)
 		if err != nil {
 			s.handleError(msg, err, event.EventID)
 			return
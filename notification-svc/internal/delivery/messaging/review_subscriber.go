@@ -0,0 +1,86 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+type ReviewSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.EmailNotificationUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         logger.Log
+}
+
+func NewReviewSubscriber(js nats.JetStreamContext, useCase usecase.EmailNotificationUseCase, logs logger.Log) *ReviewSubscriber {
+	return &ReviewSubscriber{
+		js:           js,
+		useCase:      useCase,
+		subject:      "review.created",
+		consumerName: "notification_svc_review_created_consumer",
+		durableName:  "notification_svc_review_created_durable",
+		logs:         logs,
+	}
+}
+
+func (s *ReviewSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("REVIEW_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.CreatorReviewCreatedEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing review created event", event.ReviewId)
+
+					if err := s.useCase.SendReviewReceivedEmail(ctx, event); err != nil {
+						s.logs.CustomError("failed to send review received email: %v", err)
+						_ = msg.NakWithDelay(emailRedeliveryDelay)
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+type TransactionCanceledSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.NotificationUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         logger.Log
+}
+
+func NewTransactionCanceledSubscriber(js nats.JetStreamContext, useCase usecase.NotificationUseCase, logs logger.Log) *TransactionCanceledSubscriber {
+	return &TransactionCanceledSubscriber{
+		js:           js,
+		useCase:      useCase,
+		subject:      "transaction.canceled",
+		consumerName: "notification_svc_transaction_canceled_consumer",
+		durableName:  "notification_svc_transaction_canceled_durable",
+		logs:         logs,
+	}
+}
+
+func (s *TransactionCanceledSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("TRANSACTION_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.CancelPhotosEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing transaction canceled event", event.TransactionId)
+
+					if err := s.useCase.ProcessAndSendTransactionCanceledNotification(ctx, event); err != nil {
+						s.logs.CustomError("failed to send transaction canceled notification: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+// emailRedeliveryDelay gives the mail server time to recover before an event with failed emails is delivered again
+const emailRedeliveryDelay = time.Minute
+
+type TransactionSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.NotificationUseCase
+	emailUseCase usecase.EmailNotificationUseCase
+	pushUseCase  usecase.PushAnalyticsUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         logger.Log
+}
+
+func NewTransactionSubscriber(js nats.JetStreamContext, useCase usecase.NotificationUseCase, emailUseCase usecase.EmailNotificationUseCase,
+	pushUseCase usecase.PushAnalyticsUseCase, logs logger.Log) *TransactionSubscriber {
+	return &TransactionSubscriber{
+		js:           js,
+		useCase:      useCase,
+		emailUseCase: emailUseCase,
+		pushUseCase:  pushUseCase,
+		subject:      "transaction.settled",
+		consumerName: "notification_svc_transaction_settled_consumer",
+		durableName:  "notification_svc_transaction_settled_durable",
+		logs:         logs,
+	}
+}
+
+func (s *TransactionSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("TRANSACTION_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.OwnerOwnPhotosEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing transaction settled event", event.TransactionId)
+
+					if err := s.pushUseCase.AttributePurchase(ctx, event); err != nil {
+						s.logs.CustomError("failed to attribute purchase to push: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.useCase.ProcessAndSendTransactionSettledNotifications(ctx, event); err != nil {
+						s.logs.CustomError("failed to send transaction settled notifications: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.emailUseCase.SendTransactionSettledEmails(ctx, event); err != nil {
+						s.logs.CustomError("failed to send transaction settled emails: %v", err)
+						_ = msg.NakWithDelay(emailRedeliveryDelay)
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
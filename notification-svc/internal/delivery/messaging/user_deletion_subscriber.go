@@ -0,0 +1,120 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+type UserDeletionSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.UserDeviceUseCase
+	inboxUseCase usecase.NotificationInboxUseCase
+	prefUseCase  usecase.NotificationPreferenceUseCase
+	emailUseCase usecase.EmailNotificationUseCase
+	pushUseCase  usecase.PushAnalyticsUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         logger.Log
+}
+
+func NewUserDeletionSubscriber(js nats.JetStreamContext, useCase usecase.UserDeviceUseCase, inboxUseCase usecase.NotificationInboxUseCase,
+	prefUseCase usecase.NotificationPreferenceUseCase, emailUseCase usecase.EmailNotificationUseCase, pushUseCase usecase.PushAnalyticsUseCase,
+	logs logger.Log) *UserDeletionSubscriber {
+	return &UserDeletionSubscriber{
+		js:           js,
+		useCase:      useCase,
+		inboxUseCase: inboxUseCase,
+		prefUseCase:  prefUseCase,
+		emailUseCase: emailUseCase,
+		pushUseCase:  pushUseCase,
+		subject:      "user.deleted",
+		consumerName: "notification_svc_user_deleted_consumer",
+		durableName:  "notification_svc_user_deleted_durable",
+		logs:         logs,
+	}
+}
+
+func (s *UserDeletionSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("USER_DELETION_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.UserDeletedEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing event: %+v", event)
+
+					if err := s.useCase.DeleteDevices(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to delete user devices: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.inboxUseCase.DeleteNotifications(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to delete user notifications: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.prefUseCase.DeletePreferences(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to delete user notification preferences: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.emailUseCase.DeleteEmailDeliveries(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to delete user email deliveries: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.pushUseCase.DeletePushDeliveries(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to delete user push deliveries: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+type WithdrawalSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.NotificationUseCase
+	emailUseCase usecase.EmailNotificationUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         logger.Log
+}
+
+func NewWithdrawalSubscriber(js nats.JetStreamContext, useCase usecase.NotificationUseCase, emailUseCase usecase.EmailNotificationUseCase,
+	logs logger.Log) *WithdrawalSubscriber {
+	return &WithdrawalSubscriber{
+		js:           js,
+		useCase:      useCase,
+		emailUseCase: emailUseCase,
+		subject:      "withdrawal.status.updated",
+		consumerName: "notification_svc_withdrawal_status_consumer",
+		durableName:  "notification_svc_withdrawal_status_durable",
+		logs:         logs,
+	}
+}
+
+func (s *WithdrawalSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("WITHDRAWAL_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.WithdrawalStatusEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing withdrawal status event", event.WithdrawalId)
+
+					if err := s.useCase.ProcessAndSendWithdrawalStatusNotification(ctx, event); err != nil {
+						s.logs.CustomError("failed to send withdrawal status notification: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := s.emailUseCase.SendWithdrawalStatusEmail(ctx, event); err != nil {
+						s.logs.CustomError("failed to send withdrawal status email: %v", err)
+						_ = msg.NakWithDelay(emailRedeliveryDelay)
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+// EmailDelivery is one email of an event to a user, a redelivered event only sends the emails that are not sent yet
+type EmailDelivery struct {
+	Id        string                       `db:"id"`
+	EventId   string                       `db:"event_id"`
+	UserId    string                       `db:"user_id"`
+	Template  enum.EmailTemplateEnum       `db:"template"`
+	Language  enum.LanguageEnum            `db:"language"`
+	Email     string                       `db:"email"`
+	Status    enum.EmailDeliveryStatusEnum `db:"status"`
+	Attempts  int                          `db:"attempts"`
+	LastError sql.NullString               `db:"last_error"`
+	SentAt    sql.NullTime                 `db:"sent_at"`
+	CreatedAt time.Time                    `db:"created_at"`
+	UpdatedAt time.Time                    `db:"updated_at"`
+}
+
+// EmailBounce is an address the mail server refused, a hard bounce or repeated soft bounces stop further emails
+type EmailBounce struct {
+	Email         string                   `db:"email"`
+	BounceType    enum.EmailBounceTypeEnum `db:"bounce_type"`
+	BounceCount   int                      `db:"bounce_count"`
+	LastCode      int                      `db:"last_code"`
+	LastReason    string                   `db:"last_reason"`
+	LastBouncedAt time.Time                `db:"last_bounced_at"`
+}
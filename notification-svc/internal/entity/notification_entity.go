@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// Notification is an inbox entry, EventID is the event that produced it so a redelivered event is stored once per user
+type Notification struct {
+	Id        string                    `db:"id"`
+	UserId    string                    `db:"user_id"`
+	EventId   string                    `db:"event_id"`
+	Type      enum.NotificationTypeEnum `db:"type"`
+	Title     string                    `db:"title"`
+	Body      string                    `db:"body"`
+	Data      types.JSONText            `db:"data"`
+	ReadAt    sql.NullTime              `db:"read_at"`
+	CreatedAt time.Time                 `db:"created_at"`
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+// NotificationPreference is stored only once a user changes a channel, a missing row means the channel is enabled
+type NotificationPreference struct {
+	UserId    string                        `db:"user_id"`
+	Category  enum.NotificationCategoryEnum `db:"category"`
+	Channel   enum.NotificationChannelEnum  `db:"channel"`
+	Enabled   bool                          `db:"enabled"`
+	UpdatedAt time.Time                     `db:"updated_at"`
+}
+
+// NotificationSetting quiet hours are minutes of the day in the user timezone, the end is exclusive and may be before
+// the start when the quiet hours cross midnight
+type NotificationSetting struct {
+	UserId              string            `db:"user_id"`
+	Timezone            string            `db:"timezone"`
+	QuietHoursStart     sql.NullInt16     `db:"quiet_hours_start"`
+	QuietHoursEnd       sql.NullInt16     `db:"quiet_hours_end"`
+	DigestEnabled       bool              `db:"digest_enabled"`
+	DigestWindowMinutes int               `db:"digest_window_minutes"`
+	Language            enum.LanguageEnum `db:"language"`
+	UpdatedAt           time.Time         `db:"updated_at"`
+}
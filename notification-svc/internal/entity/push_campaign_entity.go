@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// PushCampaign is a push sent by an admin to an audience segment. The audience is walked by user id in batches, the
+// cursor is the last user id sent and the next batch is not sent before NextBatchAt.
+type PushCampaign struct {
+	Id            string                      `db:"id"`
+	Title         string                      `db:"title"`
+	Body          string                      `db:"body"`
+	Data          types.JSONText              `db:"data"`
+	Segment       enum.AudienceSegmentEnum    `db:"segment"`
+	BulkPhotoId   sql.NullString              `db:"bulk_photo_id"`
+	InactiveDays  int                         `db:"inactive_days"`
+	BatchSize     int                         `db:"batch_size"`
+	Status        enum.PushCampaignStatusEnum `db:"status"`
+	ScheduledAt   time.Time                   `db:"scheduled_at"`
+	NextBatchAt   time.Time                   `db:"next_batch_at"`
+	CursorUserId  string                      `db:"cursor_user_id"`
+	TargetedCount int                         `db:"targeted_count"`
+	LastError     sql.NullString              `db:"last_error"`
+	StartedAt     sql.NullTime                `db:"started_at"`
+	CompletedAt   sql.NullTime                `db:"completed_at"`
+	CanceledAt    sql.NullTime                `db:"canceled_at"`
+	CreatedBy     string                      `db:"created_by"`
+	CreatedAt     time.Time                   `db:"created_at"`
+	UpdatedAt     time.Time                   `db:"updated_at"`
+}
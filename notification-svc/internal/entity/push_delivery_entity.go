@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+// PushDelivery is the result of one push message to one device token. A push id is shared by every token of the user
+// that got the message, the campaign id is the event the push was sent for.
+type PushDelivery struct {
+	Id             string                        `db:"id"`
+	PushId         string                        `db:"push_id"`
+	CampaignId     string                        `db:"campaign_id"`
+	Category       enum.NotificationCategoryEnum `db:"category"`
+	Type           enum.NotificationTypeEnum     `db:"type"`
+	NotificationId sql.NullString                `db:"notification_id"`
+	UserId         string                        `db:"user_id"`
+	Token          string                        `db:"token"`
+	Status         enum.PushDeliveryStatusEnum   `db:"status"`
+	ErrorCode      sql.NullString                `db:"error_code"`
+	OpenedAt       sql.NullTime                  `db:"opened_at"`
+	ClickedAt      sql.NullTime                  `db:"clicked_at"`
+	ConvertedAt    sql.NullTime                  `db:"converted_at"`
+	TransactionId  sql.NullString                `db:"transaction_id"`
+	Revenue        sql.NullInt64                 `db:"revenue"`
+	CreatedAt      time.Time                     `db:"created_at"`
+}
+
+// PushCampaignMetric counts the deliveries of a campaign, opened, clicked and converted count distinct push messages
+type PushCampaignMetric struct {
+	CampaignId  string                        `db:"campaign_id"`
+	Category    enum.NotificationCategoryEnum `db:"category"`
+	Type        enum.NotificationTypeEnum     `db:"type"`
+	Users       int                           `db:"users"`
+	Pushes      int                           `db:"pushes"`
+	Sent        int                           `db:"sent"`
+	Failed      int                           `db:"failed"`
+	Pruned      int                           `db:"pruned"`
+	Delivered   int                           `db:"delivered"`
+	Opened      int                           `db:"opened"`
+	Clicked     int                           `db:"clicked"`
+	Converted   int                           `db:"converted"`
+	Revenue     int64                         `db:"revenue"`
+	FirstSentAt time.Time                     `db:"first_sent_at"`
+	LastSentAt  time.Time                     `db:"last_sent_at"`
+}
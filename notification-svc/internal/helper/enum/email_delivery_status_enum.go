@@ -0,0 +1,18 @@
+package enum
+
+type EmailDeliveryStatusEnum string
+
+const (
+	EmailDeliveryStatusPending    EmailDeliveryStatusEnum = "PENDING"
+	EmailDeliveryStatusSent       EmailDeliveryStatusEnum = "SENT"
+	EmailDeliveryStatusFailed     EmailDeliveryStatusEnum = "FAILED"
+	EmailDeliveryStatusBounced    EmailDeliveryStatusEnum = "BOUNCED"
+	EmailDeliveryStatusSuppressed EmailDeliveryStatusEnum = "SUPPRESSED"
+)
+
+type EmailBounceTypeEnum string
+
+const (
+	EmailBounceTypeHard EmailBounceTypeEnum = "HARD"
+	EmailBounceTypeSoft EmailBounceTypeEnum = "SOFT"
+)
@@ -0,0 +1,17 @@
+package enum
+
+type EmailTemplateEnum string
+
+const (
+	EmailTemplateTransactionReceipt EmailTemplateEnum = "transaction_receipt"
+	EmailTemplateCreatorSale        EmailTemplateEnum = "creator_sale"
+	EmailTemplateWithdrawalStatus   EmailTemplateEnum = "withdrawal_status"
+	EmailTemplateReviewReceived     EmailTemplateEnum = "review_received"
+)
+
+var EmailTemplates = []EmailTemplateEnum{
+	EmailTemplateTransactionReceipt,
+	EmailTemplateCreatorSale,
+	EmailTemplateWithdrawalStatus,
+	EmailTemplateReviewReceived,
+}
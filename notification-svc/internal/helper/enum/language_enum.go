@@ -0,0 +1,8 @@
+package enum
+
+type LanguageEnum string
+
+const (
+	LanguageIndonesian LanguageEnum = "id"
+	LanguageEnglish    LanguageEnum = "en"
+)
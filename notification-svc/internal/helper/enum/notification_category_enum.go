@@ -0,0 +1,29 @@
+package enum
+
+type NotificationCategoryEnum string
+
+const (
+	NotificationCategoryNewMatch     NotificationCategoryEnum = "new_match"
+	NotificationCategoryFacecamMatch NotificationCategoryEnum = "facecam_match"
+	NotificationCategoryFollowing    NotificationCategoryEnum = "following"
+	NotificationCategoryTransaction  NotificationCategoryEnum = "transaction"
+	NotificationCategoryReview       NotificationCategoryEnum = "review"
+	NotificationCategoryChat         NotificationCategoryEnum = "chat"
+	NotificationCategoryPromotion    NotificationCategoryEnum = "promotion"
+)
+
+// NotificationCategories lists every category a user can set preferences for, in the order shown to the user
+var NotificationCategories = []NotificationCategoryEnum{
+	NotificationCategoryNewMatch,
+	NotificationCategoryFacecamMatch,
+	NotificationCategoryFollowing,
+	NotificationCategoryTransaction,
+	NotificationCategoryReview,
+	NotificationCategoryChat,
+	NotificationCategoryPromotion,
+}
+
+// IsDigestible reports whether notifications of the category may be summarized into a digest
+func (c NotificationCategoryEnum) IsDigestible() bool {
+	return c == NotificationCategoryNewMatch || c == NotificationCategoryFacecamMatch
+}
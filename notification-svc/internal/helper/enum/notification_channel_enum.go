@@ -0,0 +1,9 @@
+package enum
+
+type NotificationChannelEnum string
+
+const (
+	NotificationChannelPush  NotificationChannelEnum = "push"
+	NotificationChannelEmail NotificationChannelEnum = "email"
+	NotificationChannelInApp NotificationChannelEnum = "in_app"
+)
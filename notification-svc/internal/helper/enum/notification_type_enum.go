@@ -0,0 +1,14 @@
+package enum
+
+type NotificationTypeEnum string
+
+const (
+	NotificationTypeSimilarPhoto NotificationTypeEnum = "similar_photo"
+	NotificationTypeCreatorBatch NotificationTypeEnum = "creator_batch"
+	NotificationTypeTransaction  NotificationTypeEnum = "transaction"
+	NotificationTypeCreatorSale  NotificationTypeEnum = "creator_sale"
+	NotificationTypeReview       NotificationTypeEnum = "review"
+	NotificationTypeWithdrawal   NotificationTypeEnum = "withdrawal"
+	NotificationTypeGeneral      NotificationTypeEnum = "general"
+	NotificationTypeCampaign     NotificationTypeEnum = "campaign"
+)
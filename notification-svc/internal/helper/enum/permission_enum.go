@@ -0,0 +1,9 @@
+package enum
+
+// PermissionEnum mirrors the permission names resolved by user-svc and
+// returned through the Authenticate gRPC response.
+type PermissionEnum string
+
+const (
+	PermissionNotificationManage PermissionEnum = "notification:manage"
+)
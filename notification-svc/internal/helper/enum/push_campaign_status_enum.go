@@ -0,0 +1,22 @@
+package enum
+
+type PushCampaignStatusEnum string
+
+const (
+	PushCampaignStatusScheduled PushCampaignStatusEnum = "SCHEDULED"
+	PushCampaignStatusSending   PushCampaignStatusEnum = "SENDING"
+	PushCampaignStatusCompleted PushCampaignStatusEnum = "COMPLETED"
+	PushCampaignStatusCanceled  PushCampaignStatusEnum = "CANCELED"
+)
+
+// AudienceSegmentEnum is the audience of a push campaign, CREATOR and EVENT_ATTENDEE are resolved by photo-svc and
+// every other segment by user-svc
+type AudienceSegmentEnum string
+
+const (
+	AudienceSegmentAll           AudienceSegmentEnum = "ALL"
+	AudienceSegmentCreator       AudienceSegmentEnum = "CREATOR"
+	AudienceSegmentFacecam       AudienceSegmentEnum = "FACECAM"
+	AudienceSegmentEventAttendee AudienceSegmentEnum = "EVENT_ATTENDEE"
+	AudienceSegmentInactive      AudienceSegmentEnum = "INACTIVE"
+)
@@ -0,0 +1,16 @@
+package enum
+
+type PushDeliveryStatusEnum string
+
+const (
+	PushDeliveryStatusSent   PushDeliveryStatusEnum = "SENT"
+	PushDeliveryStatusFailed PushDeliveryStatusEnum = "FAILED"
+	PushDeliveryStatusPruned PushDeliveryStatusEnum = "PRUNED"
+)
+
+type PushInteractionEnum string
+
+const (
+	PushInteractionOpen  PushInteractionEnum = "open"
+	PushInteractionClick PushInteractionEnum = "click"
+)
@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+func GenerateCursorURL(baseURL string, metadata *model.CursorMetadata) {
+	if !metadata.HasMore {
+		return
+	}
+
+	parsedURL, _ := url.Parse(baseURL)
+	q := parsedURL.Query()
+	q.Set("cursor", metadata.NextCursor)
+	q.Set("size", strconv.Itoa(metadata.Size))
+	parsedURL.RawQuery = q.Encode()
+	metadata.NextPageURL = parsedURL.String()
+}
+
+func NewCursorMetadata(size int, hasMore bool) *model.CursorMetadata {
+	return &model.CursorMetadata{
+		Size:    size,
+		HasMore: hasMore,
+	}
+}
@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"errors"
+	"net/textproto"
+)
+
+type ErrorSMTP struct {
+	Code    int
+	Message string
+	Raw     string
+}
+
+// ParseSMTPError reads the reply code of an error returned by the mail server, errors that never reached the server
+// such as a refused connection or a timeout have no code
+func ParseSMTPError(err error) *ErrorSMTP {
+	if err == nil {
+		return nil
+	}
+
+	var protocolErr *textproto.Error
+	if errors.As(err, &protocolErr) {
+		return &ErrorSMTP{
+			Code:    protocolErr.Code,
+			Message: protocolErr.Msg,
+			Raw:     err.Error(),
+		}
+	}
+
+	return &ErrorSMTP{
+		Raw: err.Error(),
+	}
+}
+
+// IsHardBounce reports that the mailbox does not exist or does not accept mail, the address should not be used again
+func (e *ErrorSMTP) IsHardBounce() bool {
+	switch e.Code {
+	case 550, 551, 553:
+		return true
+	}
+	return false
+}
+
+// IsSoftBounce reports that the mailbox exists but can not take the email now, for example because it is full
+func (e *ErrorSMTP) IsSoftBounce() bool {
+	switch e.Code {
+	case 450, 452, 552:
+		return true
+	}
+	return false
+}
+
+func (e *ErrorSMTP) IsAuthError() bool {
+	switch e.Code {
+	case 530, 534, 535, 538:
+		return true
+	}
+	return false
+}
+
+// IsRetryable reports a temporary failure, a 4xx reply or an error before the server replied
+func (e *ErrorSMTP) IsRetryable() bool {
+	return e.Code == 0 || (e.Code >= 400 && e.Code < 500)
+}
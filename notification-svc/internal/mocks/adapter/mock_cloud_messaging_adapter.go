@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/cloud_messaging_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/cloud_messaging_adapter.go -destination=./mocks/adapter/mock_cloud_messaging_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	messaging "firebase.google.com/go/v4/messaging"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCloudMessagingAdapter is a mock of CloudMessagingAdapter interface.
+type MockCloudMessagingAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloudMessagingAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockCloudMessagingAdapterMockRecorder is the mock recorder for MockCloudMessagingAdapter.
+type MockCloudMessagingAdapterMockRecorder struct {
+	mock *MockCloudMessagingAdapter
+}
+
+// NewMockCloudMessagingAdapter creates a new mock instance.
+func NewMockCloudMessagingAdapter(ctrl *gomock.Controller) *MockCloudMessagingAdapter {
+	mock := &MockCloudMessagingAdapter{ctrl: ctrl}
+	mock.recorder = &MockCloudMessagingAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCloudMessagingAdapter) EXPECT() *MockCloudMessagingAdapterMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockCloudMessagingAdapter) Send(ctx context.Context, message *messaging.Message) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, message)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockCloudMessagingAdapterMockRecorder) Send(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCloudMessagingAdapter)(nil).Send), ctx, message)
+}
+
+// SendEachForMulticast mocks base method.
+func (m *MockCloudMessagingAdapter) SendEachForMulticast(ctx context.Context, message *messaging.MulticastMessage) (*messaging.BatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEachForMulticast", ctx, message)
+	ret0, _ := ret[0].(*messaging.BatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendEachForMulticast indicates an expected call of SendEachForMulticast.
+func (mr *MockCloudMessagingAdapterMockRecorder) SendEachForMulticast(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEachForMulticast", reflect.TypeOf((*MockCloudMessagingAdapter)(nil).SendEachForMulticast), ctx, message)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/photo_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/photo_adapter.go -destination=./mocks/adapter/mock_photo_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPhotoAdapter is a mock of PhotoAdapter interface.
+type MockPhotoAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockPhotoAdapterMockRecorder is the mock recorder for MockPhotoAdapter.
+type MockPhotoAdapterMockRecorder struct {
+	mock *MockPhotoAdapter
+}
+
+// NewMockPhotoAdapter creates a new mock instance.
+func NewMockPhotoAdapter(ctrl *gomock.Controller) *MockPhotoAdapter {
+	mock := &MockPhotoAdapter{ctrl: ctrl}
+	mock.recorder = &MockPhotoAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoAdapter) EXPECT() *MockPhotoAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetCreatorUserIds mocks base method.
+func (m *MockPhotoAdapter) GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorUserIds", ctx, creatorIds)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorUserIds indicates an expected call of GetCreatorUserIds.
+func (mr *MockPhotoAdapterMockRecorder) GetCreatorUserIds(ctx, creatorIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorUserIds", reflect.TypeOf((*MockPhotoAdapter)(nil).GetCreatorUserIds), ctx, creatorIds)
+}
+
+// ListCreatorUserIds mocks base method.
+func (m *MockPhotoAdapter) ListCreatorUserIds(ctx context.Context, afterUserId string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCreatorUserIds", ctx, afterUserId, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCreatorUserIds indicates an expected call of ListCreatorUserIds.
+func (mr *MockPhotoAdapterMockRecorder) ListCreatorUserIds(ctx, afterUserId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCreatorUserIds", reflect.TypeOf((*MockPhotoAdapter)(nil).ListCreatorUserIds), ctx, afterUserId, limit)
+}
+
+// ListEventAttendeeUserIds mocks base method.
+func (m *MockPhotoAdapter) ListEventAttendeeUserIds(ctx context.Context, bulkPhotoId, afterUserId string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventAttendeeUserIds", ctx, bulkPhotoId, afterUserId, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventAttendeeUserIds indicates an expected call of ListEventAttendeeUserIds.
+func (mr *MockPhotoAdapterMockRecorder) ListEventAttendeeUserIds(ctx, bulkPhotoId, afterUserId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventAttendeeUserIds", reflect.TypeOf((*MockPhotoAdapter)(nil).ListEventAttendeeUserIds), ctx, bulkPhotoId, afterUserId, limit)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/notification_preference_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/notification_preference_repository.go -destination=./mocks/repository/mock_notification_preference_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceRepository is a mock of NotificationPreferenceRepository interface.
+type MockNotificationPreferenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceRepositoryMockRecorder is the mock recorder for MockNotificationPreferenceRepository.
+type MockNotificationPreferenceRepositoryMockRecorder struct {
+	mock *MockNotificationPreferenceRepository
+}
+
+// NewMockNotificationPreferenceRepository creates a new mock instance.
+func NewMockNotificationPreferenceRepository(ctrl *gomock.Controller) *MockNotificationPreferenceRepository {
+	mock := &MockNotificationPreferenceRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPreferenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockNotificationPreferenceRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
+// FindByUserId mocks base method.
+func (m *MockNotificationPreferenceRepository) FindByUserId(ctx context.Context, tx repository.Querier, userId string) ([]*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].([]*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindByUserId), ctx, tx, userId)
+}
+
+// FindDisabledUserIds mocks base method.
+func (m *MockNotificationPreferenceRepository) FindDisabledUserIds(ctx context.Context, tx repository.Querier, userIds []string, category enum.NotificationCategoryEnum, channel enum.NotificationChannelEnum) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDisabledUserIds", ctx, tx, userIds, category, channel)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDisabledUserIds indicates an expected call of FindDisabledUserIds.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindDisabledUserIds(ctx, tx, userIds, category, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDisabledUserIds", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindDisabledUserIds), ctx, tx, userIds, category, channel)
+}
+
+// UpsertBulk mocks base method.
+func (m *MockNotificationPreferenceRepository) UpsertBulk(ctx context.Context, tx repository.Querier, preferences []*entity.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBulk", ctx, tx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBulk indicates an expected call of UpsertBulk.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) UpsertBulk(ctx, tx, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBulk", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).UpsertBulk), ctx, tx, preferences)
+}
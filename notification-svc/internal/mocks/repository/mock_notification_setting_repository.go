@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/notification_setting_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/notification_setting_repository.go -destination=./mocks/repository/mock_notification_setting_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationSettingRepository is a mock of NotificationSettingRepository interface.
+type MockNotificationSettingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationSettingRepositoryMockRecorder is the mock recorder for MockNotificationSettingRepository.
+type MockNotificationSettingRepositoryMockRecorder struct {
+	mock *MockNotificationSettingRepository
+}
+
+// NewMockNotificationSettingRepository creates a new mock instance.
+func NewMockNotificationSettingRepository(ctrl *gomock.Controller) *MockNotificationSettingRepository {
+	mock := &MockNotificationSettingRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationSettingRepository) EXPECT() *MockNotificationSettingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockNotificationSettingRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockNotificationSettingRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockNotificationSettingRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
+// FindByUserId mocks base method.
+func (m *MockNotificationSettingRepository) FindByUserId(ctx context.Context, tx repository.Querier, userId string) (*entity.NotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(*entity.NotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockNotificationSettingRepositoryMockRecorder) FindByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockNotificationSettingRepository)(nil).FindByUserId), ctx, tx, userId)
+}
+
+// FindByUserIds mocks base method.
+func (m *MockNotificationSettingRepository) FindByUserIds(ctx context.Context, tx repository.Querier, userIds []string) ([]*entity.NotificationSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIds", ctx, tx, userIds)
+	ret0, _ := ret[0].([]*entity.NotificationSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIds indicates an expected call of FindByUserIds.
+func (mr *MockNotificationSettingRepositoryMockRecorder) FindByUserIds(ctx, tx, userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIds", reflect.TypeOf((*MockNotificationSettingRepository)(nil).FindByUserIds), ctx, tx, userIds)
+}
+
+// Upsert mocks base method.
+func (m *MockNotificationSettingRepository) Upsert(ctx context.Context, tx repository.Querier, setting *entity.NotificationSetting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, tx, setting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNotificationSettingRepositoryMockRecorder) Upsert(ctx, tx, setting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNotificationSettingRepository)(nil).Upsert), ctx, tx, setting)
+}
@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/push_campaign_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/push_campaign_repository.go -destination=./mocks/repository/mock_push_campaign_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	model "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPushCampaignRepository is a mock of PushCampaignRepository interface.
+type MockPushCampaignRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushCampaignRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPushCampaignRepositoryMockRecorder is the mock recorder for MockPushCampaignRepository.
+type MockPushCampaignRepositoryMockRecorder struct {
+	mock *MockPushCampaignRepository
+}
+
+// NewMockPushCampaignRepository creates a new mock instance.
+func NewMockPushCampaignRepository(ctrl *gomock.Controller) *MockPushCampaignRepository {
+	mock := &MockPushCampaignRepository{ctrl: ctrl}
+	mock.recorder = &MockPushCampaignRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushCampaignRepository) EXPECT() *MockPushCampaignRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockPushCampaignRepository) Cancel(ctx context.Context, tx repository.Querier, campaignId string, canceledAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, tx, campaignId, canceledAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPushCampaignRepositoryMockRecorder) Cancel(ctx, tx, campaignId, canceledAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPushCampaignRepository)(nil).Cancel), ctx, tx, campaignId, canceledAt)
+}
+
+// ClaimDue mocks base method.
+func (m *MockPushCampaignRepository) ClaimDue(ctx context.Context, tx repository.Querier, now, leaseUntil time.Time) (*entity.PushCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, tx, now, leaseUntil)
+	ret0, _ := ret[0].(*entity.PushCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockPushCampaignRepositoryMockRecorder) ClaimDue(ctx, tx, now, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockPushCampaignRepository)(nil).ClaimDue), ctx, tx, now, leaseUntil)
+}
+
+// Complete mocks base method.
+func (m *MockPushCampaignRepository) Complete(ctx context.Context, tx repository.Querier, campaignId, cursorUserId string, targetedCount int, completedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, tx, campaignId, cursorUserId, targetedCount, completedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockPushCampaignRepositoryMockRecorder) Complete(ctx, tx, campaignId, cursorUserId, targetedCount, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockPushCampaignRepository)(nil).Complete), ctx, tx, campaignId, cursorUserId, targetedCount, completedAt)
+}
+
+// Create mocks base method.
+func (m *MockPushCampaignRepository) Create(ctx context.Context, tx repository.Querier, campaign *entity.PushCampaign) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, campaign)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPushCampaignRepositoryMockRecorder) Create(ctx, tx, campaign any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPushCampaignRepository)(nil).Create), ctx, tx, campaign)
+}
+
+// FindByCursor mocks base method.
+func (m *MockPushCampaignRepository) FindByCursor(ctx context.Context, tx repository.Querier, status enum.PushCampaignStatusEnum, cursor *pagination.Cursor, size int) ([]*entity.PushCampaign, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, tx, status, cursor, size)
+	ret0, _ := ret[0].([]*entity.PushCampaign)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockPushCampaignRepositoryMockRecorder) FindByCursor(ctx, tx, status, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockPushCampaignRepository)(nil).FindByCursor), ctx, tx, status, cursor, size)
+}
+
+// FindById mocks base method.
+func (m *MockPushCampaignRepository) FindById(ctx context.Context, tx repository.Querier, campaignId string) (*entity.PushCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, campaignId)
+	ret0, _ := ret[0].(*entity.PushCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPushCampaignRepositoryMockRecorder) FindById(ctx, tx, campaignId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPushCampaignRepository)(nil).FindById), ctx, tx, campaignId)
+}
+
+// UpdateLastError mocks base method.
+func (m *MockPushCampaignRepository) UpdateLastError(ctx context.Context, tx repository.Querier, campaignId, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastError", ctx, tx, campaignId, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastError indicates an expected call of UpdateLastError.
+func (mr *MockPushCampaignRepositoryMockRecorder) UpdateLastError(ctx, tx, campaignId, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastError", reflect.TypeOf((*MockPushCampaignRepository)(nil).UpdateLastError), ctx, tx, campaignId, lastError)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockPushCampaignRepository) UpdateProgress(ctx context.Context, tx repository.Querier, campaignId, cursorUserId string, targetedCount int, nextBatchAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", ctx, tx, campaignId, cursorUserId, targetedCount, nextBatchAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockPushCampaignRepositoryMockRecorder) UpdateProgress(ctx, tx, campaignId, cursorUserId, targetedCount, nextBatchAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockPushCampaignRepository)(nil).UpdateProgress), ctx, tx, campaignId, cursorUserId, targetedCount, nextBatchAt)
+}
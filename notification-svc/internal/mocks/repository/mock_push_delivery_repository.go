@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/push_delivery_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/push_delivery_repository.go -destination=./mocks/repository/mock_push_delivery_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPushDeliveryRepository is a mock of PushDeliveryRepository interface.
+type MockPushDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPushDeliveryRepositoryMockRecorder is the mock recorder for MockPushDeliveryRepository.
+type MockPushDeliveryRepositoryMockRecorder struct {
+	mock *MockPushDeliveryRepository
+}
+
+// NewMockPushDeliveryRepository creates a new mock instance.
+func NewMockPushDeliveryRepository(ctrl *gomock.Controller) *MockPushDeliveryRepository {
+	mock := &MockPushDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockPushDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushDeliveryRepository) EXPECT() *MockPushDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttributeConversion mocks base method.
+func (m *MockPushDeliveryRepository) AttributeConversion(ctx context.Context, tx repository.Querier, userId, transactionId string, revenue int64, convertedAt, openedSince time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttributeConversion", ctx, tx, userId, transactionId, revenue, convertedAt, openedSince)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttributeConversion indicates an expected call of AttributeConversion.
+func (mr *MockPushDeliveryRepositoryMockRecorder) AttributeConversion(ctx, tx, userId, transactionId, revenue, convertedAt, openedSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttributeConversion", reflect.TypeOf((*MockPushDeliveryRepository)(nil).AttributeConversion), ctx, tx, userId, transactionId, revenue, convertedAt, openedSince)
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockPushDeliveryRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockPushDeliveryRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockPushDeliveryRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
+// FindCampaignMetric mocks base method.
+func (m *MockPushDeliveryRepository) FindCampaignMetric(ctx context.Context, tx repository.Querier, campaignId string) (*entity.PushCampaignMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCampaignMetric", ctx, tx, campaignId)
+	ret0, _ := ret[0].(*entity.PushCampaignMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCampaignMetric indicates an expected call of FindCampaignMetric.
+func (mr *MockPushDeliveryRepositoryMockRecorder) FindCampaignMetric(ctx, tx, campaignId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCampaignMetric", reflect.TypeOf((*MockPushDeliveryRepository)(nil).FindCampaignMetric), ctx, tx, campaignId)
+}
+
+// FindCampaignMetrics mocks base method.
+func (m *MockPushDeliveryRepository) FindCampaignMetrics(ctx context.Context, tx repository.Querier, category string, since time.Time, size int) ([]*entity.PushCampaignMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCampaignMetrics", ctx, tx, category, since, size)
+	ret0, _ := ret[0].([]*entity.PushCampaignMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCampaignMetrics indicates an expected call of FindCampaignMetrics.
+func (mr *MockPushDeliveryRepositoryMockRecorder) FindCampaignMetrics(ctx, tx, category, since, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCampaignMetrics", reflect.TypeOf((*MockPushDeliveryRepository)(nil).FindCampaignMetrics), ctx, tx, category, since, size)
+}
+
+// InsertBulk mocks base method.
+func (m *MockPushDeliveryRepository) InsertBulk(ctx context.Context, tx repository.Querier, deliveries []*entity.PushDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBulk", ctx, tx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertBulk indicates an expected call of InsertBulk.
+func (mr *MockPushDeliveryRepositoryMockRecorder) InsertBulk(ctx, tx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBulk", reflect.TypeOf((*MockPushDeliveryRepository)(nil).InsertBulk), ctx, tx, deliveries)
+}
+
+// MarkInteraction mocks base method.
+func (m *MockPushDeliveryRepository) MarkInteraction(ctx context.Context, tx repository.Querier, userId, pushId, token string, interaction enum.PushInteractionEnum, interactedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInteraction", ctx, tx, userId, pushId, token, interaction, interactedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkInteraction indicates an expected call of MarkInteraction.
+func (mr *MockPushDeliveryRepositoryMockRecorder) MarkInteraction(ctx, tx, userId, pushId, token, interaction, interactedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInteraction", reflect.TypeOf((*MockPushDeliveryRepository)(nil).MarkInteraction), ctx, tx, userId, pushId, token, interaction, interactedAt)
+}
@@ -13,8 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
-	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	entity "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
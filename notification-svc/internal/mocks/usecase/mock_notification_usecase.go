@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/notification_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./usecase/notification_usecase.go -destination=./mocks/usecase/mock_notification_usecase.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	event "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationUseCase is a mock of NotificationUseCase interface.
+type MockNotificationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationUseCaseMockRecorder is the mock recorder for MockNotificationUseCase.
+type MockNotificationUseCaseMockRecorder struct {
+	mock *MockNotificationUseCase
+}
+
+// NewMockNotificationUseCase creates a new mock instance.
+func NewMockNotificationUseCase(ctrl *gomock.Controller) *MockNotificationUseCase {
+	mock := &MockNotificationUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUseCase) EXPECT() *MockNotificationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// FlushDueDigests mocks base method.
+func (m *MockNotificationUseCase) FlushDueDigests(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushDueDigests", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlushDueDigests indicates an expected call of FlushDueDigests.
+func (mr *MockNotificationUseCaseMockRecorder) FlushDueDigests(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushDueDigests", reflect.TypeOf((*MockNotificationUseCase)(nil).FlushDueDigests), ctx)
+}
+
+// ProcessAndSendBulkNotificationsV2 mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendBulkNotificationsV2(ctx context.Context, bulkEvent *event.BulkPhotoEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendBulkNotificationsV2", ctx, bulkEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendBulkNotificationsV2 indicates an expected call of ProcessAndSendBulkNotificationsV2.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendBulkNotificationsV2(ctx, bulkEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendBulkNotificationsV2", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendBulkNotificationsV2), ctx, bulkEvent)
+}
+
+// ProcessAndSendCreatorBatchNotifications mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendCreatorBatchNotifications(ctx context.Context, batchEvent *event.CreatorBatchPublishedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendCreatorBatchNotifications", ctx, batchEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendCreatorBatchNotifications indicates an expected call of ProcessAndSendCreatorBatchNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendCreatorBatchNotifications(ctx, batchEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendCreatorBatchNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendCreatorBatchNotifications), ctx, batchEvent)
+}
+
+// ProcessAndSendCreatorReviewNotification mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendCreatorReviewNotification(ctx context.Context, reviewEvent *event.CreatorReviewCountEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendCreatorReviewNotification", ctx, reviewEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendCreatorReviewNotification indicates an expected call of ProcessAndSendCreatorReviewNotification.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendCreatorReviewNotification(ctx, reviewEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendCreatorReviewNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendCreatorReviewNotification), ctx, reviewEvent)
+}
+
+// ProcessAndSendSingleFacecamNotifications mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendSingleFacecamNotifications(ctx context.Context, facecamEvent *event.SingleFacecamEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendSingleFacecamNotifications", ctx, facecamEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendSingleFacecamNotifications indicates an expected call of ProcessAndSendSingleFacecamNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendSingleFacecamNotifications(ctx, facecamEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendSingleFacecamNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendSingleFacecamNotifications), ctx, facecamEvent)
+}
+
+// ProcessAndSendSingleNotifications mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendSingleNotifications(ctx context.Context, photoEvent *event.SinglePhotoEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendSingleNotifications", ctx, photoEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendSingleNotifications indicates an expected call of ProcessAndSendSingleNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendSingleNotifications(ctx, photoEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendSingleNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendSingleNotifications), ctx, photoEvent)
+}
+
+// ProcessAndSendTransactionCanceledNotification mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendTransactionCanceledNotification(ctx context.Context, cancelEvent *event.CancelPhotosEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendTransactionCanceledNotification", ctx, cancelEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendTransactionCanceledNotification indicates an expected call of ProcessAndSendTransactionCanceledNotification.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendTransactionCanceledNotification(ctx, cancelEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendTransactionCanceledNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendTransactionCanceledNotification), ctx, cancelEvent)
+}
+
+// ProcessAndSendTransactionSettledNotifications mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendTransactionSettledNotifications(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendTransactionSettledNotifications", ctx, transactionEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendTransactionSettledNotifications indicates an expected call of ProcessAndSendTransactionSettledNotifications.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendTransactionSettledNotifications(ctx, transactionEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendTransactionSettledNotifications", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendTransactionSettledNotifications), ctx, transactionEvent)
+}
+
+// ProcessAndSendWithdrawalStatusNotification mocks base method.
+func (m *MockNotificationUseCase) ProcessAndSendWithdrawalStatusNotification(ctx context.Context, withdrawalEvent *event.WithdrawalStatusEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessAndSendWithdrawalStatusNotification", ctx, withdrawalEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessAndSendWithdrawalStatusNotification indicates an expected call of ProcessAndSendWithdrawalStatusNotification.
+func (mr *MockNotificationUseCaseMockRecorder) ProcessAndSendWithdrawalStatusNotification(ctx, withdrawalEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessAndSendWithdrawalStatusNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).ProcessAndSendWithdrawalStatusNotification), ctx, withdrawalEvent)
+}
+
+// SendNotification mocks base method.
+func (m *MockNotificationUseCase) SendNotification(ctx context.Context, request *model.SendNotificationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNotification", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendNotification indicates an expected call of SendNotification.
+func (mr *MockNotificationUseCaseMockRecorder) SendNotification(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNotification", reflect.TypeOf((*MockNotificationUseCase)(nil).SendNotification), ctx, request)
+}
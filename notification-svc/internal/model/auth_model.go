@@ -0,0 +1,11 @@
+package model
+
+type AuthResponse struct {
+	UserId      string
+	Username    string
+	Email       string
+	PhoneNumber string
+	CreatorId   string
+	Roles       []string
+	Permissions []string
+}
@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+func NotificationsToResponses(notifications []*entity.Notification) []*model.NotificationResponse {
+	responses := make([]*model.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		response := &model.NotificationResponse{
+			Id:        notification.Id,
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			Data:      json.RawMessage(notification.Data),
+			IsRead:    notification.ReadAt.Valid,
+			CreatedAt: notification.CreatedAt,
+		}
+		if notification.ReadAt.Valid {
+			response.ReadAt = &notification.ReadAt.Time
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+// NotificationPreferenceToResponse lists every category, the channels without a stored preference are enabled
+func NotificationPreferenceToResponse(setting *entity.NotificationSetting, preferences []*entity.NotificationPreference) *model.NotificationPreferenceResponse {
+	response := &model.NotificationPreferenceResponse{
+		Timezone:            setting.Timezone,
+		DigestEnabled:       setting.DigestEnabled,
+		DigestWindowMinutes: setting.DigestWindowMinutes,
+		Language:            setting.Language,
+		Categories:          make([]*model.NotificationCategoryPreference, 0, len(enum.NotificationCategories)),
+	}
+
+	if setting.QuietHoursStart.Valid && setting.QuietHoursEnd.Valid {
+		start := minutesToClock(setting.QuietHoursStart.Int16)
+		end := minutesToClock(setting.QuietHoursEnd.Int16)
+		response.QuietHoursStart = &start
+		response.QuietHoursEnd = &end
+	}
+
+	categoryMap := make(map[enum.NotificationCategoryEnum]*model.NotificationCategoryPreference, len(enum.NotificationCategories))
+	for _, category := range enum.NotificationCategories {
+		categoryPreference := &model.NotificationCategoryPreference{
+			Category: category,
+			Push:     true,
+			Email:    true,
+			InApp:    true,
+		}
+		categoryMap[category] = categoryPreference
+		response.Categories = append(response.Categories, categoryPreference)
+	}
+
+	for _, preference := range preferences {
+		categoryPreference, ok := categoryMap[preference.Category]
+		if !ok {
+			continue
+		}
+
+		switch preference.Channel {
+		case enum.NotificationChannelPush:
+			categoryPreference.Push = preference.Enabled
+		case enum.NotificationChannelEmail:
+			categoryPreference.Email = preference.Enabled
+		case enum.NotificationChannelInApp:
+			categoryPreference.InApp = preference.Enabled
+		}
+	}
+
+	return response
+}
+
+func minutesToClock(minutes int16) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+func PushCampaignToResponse(campaign *entity.PushCampaign) *model.PushCampaignResponse {
+	response := &model.PushCampaignResponse{
+		Id:            campaign.Id,
+		Title:         campaign.Title,
+		Body:          campaign.Body,
+		Data:          json.RawMessage(campaign.Data),
+		Segment:       campaign.Segment,
+		InactiveDays:  campaign.InactiveDays,
+		BatchSize:     campaign.BatchSize,
+		Status:        campaign.Status,
+		TargetedCount: campaign.TargetedCount,
+		ScheduledAt:   campaign.ScheduledAt,
+		CreatedBy:     campaign.CreatedBy,
+		CreatedAt:     campaign.CreatedAt,
+	}
+	if campaign.BulkPhotoId.Valid {
+		response.BulkPhotoId = &campaign.BulkPhotoId.String
+	}
+	if campaign.LastError.Valid {
+		response.LastError = &campaign.LastError.String
+	}
+	if campaign.StartedAt.Valid {
+		response.StartedAt = &campaign.StartedAt.Time
+	}
+	if campaign.CompletedAt.Valid {
+		response.CompletedAt = &campaign.CompletedAt.Time
+	}
+	if campaign.CanceledAt.Valid {
+		response.CanceledAt = &campaign.CanceledAt.Time
+	}
+	return response
+}
+
+func PushCampaignsToResponses(campaigns []*entity.PushCampaign) []*model.PushCampaignResponse {
+	responses := make([]*model.PushCampaignResponse, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		responses = append(responses, PushCampaignToResponse(campaign))
+	}
+	return responses
+}
@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"math"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+func PushCampaignMetricToResponse(metric *entity.PushCampaignMetric) *model.PushCampaignMetricResponse {
+	return &model.PushCampaignMetricResponse{
+		CampaignId:     metric.CampaignId,
+		Category:       metric.Category,
+		Type:           metric.Type,
+		Users:          metric.Users,
+		Pushes:         metric.Pushes,
+		Sent:           metric.Sent,
+		Failed:         metric.Failed,
+		Pruned:         metric.Pruned,
+		Delivered:      metric.Delivered,
+		Opened:         metric.Opened,
+		Clicked:        metric.Clicked,
+		Converted:      metric.Converted,
+		Revenue:        metric.Revenue,
+		DeliveryRate:   rate(metric.Delivered, metric.Pushes),
+		OpenRate:       rate(metric.Opened, metric.Delivered),
+		ClickRate:      rate(metric.Clicked, metric.Delivered),
+		ConversionRate: rate(metric.Converted, metric.Delivered),
+		FirstSentAt:    metric.FirstSentAt,
+		LastSentAt:     metric.LastSentAt,
+	}
+}
+
+func PushCampaignMetricsToResponses(metrics []*entity.PushCampaignMetric) []*model.PushCampaignMetricResponse {
+	responses := make([]*model.PushCampaignMetricResponse, 0, len(metrics))
+	for _, metric := range metrics {
+		responses = append(responses, PushCampaignMetricToResponse(metric))
+	}
+	return responses
+}
+
+// rate is rounded to four decimals and is zero when there is nothing to divide by
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*10000) / 10000
+}
@@ -0,0 +1,20 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+)
+
+func UserDevicesToResponses(userDevices []*entity.UserDevice) []*model.UserDeviceResponse {
+	responses := make([]*model.UserDeviceResponse, 0, len(userDevices))
+	for _, userDevice := range userDevices {
+		responses = append(responses, &model.UserDeviceResponse{
+			Id:        userDevice.Id,
+			UserId:    userDevice.UserId,
+			Token:     userDevice.Token,
+			Platform:  userDevice.Platform,
+			CreatedAt: userDevice.CreatedAt,
+		})
+	}
+	return responses
+}
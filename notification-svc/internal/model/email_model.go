@@ -0,0 +1,42 @@
+package model
+
+import "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+
+// EmailMessage Id is sent as the Message-ID so a bounce report can be matched to its delivery
+type EmailMessage struct {
+	Id       string
+	To       string
+	Language enum.LanguageEnum
+	Template enum.EmailTemplateEnum
+	Data     any
+}
+
+type TransactionReceiptEmailData struct {
+	Username      string
+	TransactionId string
+	PhotoCount    int
+	Amount        string
+	SettledAt     string
+}
+
+type CreatorSaleEmailData struct {
+	Username      string
+	TransactionId string
+	Amount        string
+	SettledAt     string
+}
+
+type WithdrawalStatusEmailData struct {
+	Username     string
+	WithdrawalId string
+	Amount       string
+	Status       string
+	Description  string
+	UpdatedAt    string
+}
+
+type ReviewReceivedEmailData struct {
+	Username string
+	Rating   int
+	Comment  string
+}
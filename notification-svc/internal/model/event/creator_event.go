@@ -0,0 +1,12 @@
+package event
+
+import "time"
+
+type CreatorBatchPublishedEvent struct {
+	EventID         string    `json:"uuid"`
+	CreatorId       string    `json:"creator_id"`
+	BulkPhotoId     string    `json:"bulk_photo_id"`
+	TotalPhoto      int       `json:"total_photo"`
+	FollowerUserIds []string  `json:"follower_user_ids"`
+	PublishedAt     time.Time `json:"published_at"`
+}
@@ -1,17 +1,21 @@
 package event
 
 type BulkPhotoEvent struct {
-	EventID      string           `json:"uuid"`
-	UserCountMap map[string]int32 `json:"user_count_map"`
+	EventID         string              `json:"uuid"`
+	BulkPhotoID     string              `json:"bulk_photo_id"`
+	UserCountMap    map[string]int32    `json:"user_count_map"`
+	UserPhotoIDsMap map[string][]string `json:"user_photo_ids_map"`
 }
 
 type SingleFacecamEvent struct {
-	EventID     string `json:"uuid"`
-	UserID      string `json:"user_id"`
-	CountPhotos int    `json:"count_photos"`
+	EventID     string   `json:"uuid"`
+	UserID      string   `json:"user_id"`
+	CountPhotos int      `json:"count_photos"`
+	PhotoIDs    []string `json:"photo_ids"`
 }
 
 type SinglePhotoEvent struct {
 	EventID string   `json:"uuid"`
+	PhotoID string   `json:"photo_id"`
 	UserIDs []string `json:"user_ids"`
 }
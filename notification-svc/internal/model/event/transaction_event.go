@@ -0,0 +1,38 @@
+package event
+
+import "time"
+
+type OwnerOwnPhotosEvent struct {
+	EventID       string              `json:"event_id"`
+	UserId        string              `json:"user_id"`
+	TransactionId string              `json:"transaction_id"`
+	PhotoIds      []string            `json:"photo_ids"`
+	Amount        int32               `json:"amount"`
+	CreatorSales  []*CreatorSaleEvent `json:"creator_sales"`
+	SettledAt     *time.Time          `json:"settled_at"`
+}
+
+// CancelPhotosEvent status is empty when the transaction failed before the buyer could pay it
+type CancelPhotosEvent struct {
+	EventID       string   `json:"event_id"`
+	UserId        string   `json:"user_id"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids"`
+	Status        string   `json:"status"`
+}
+
+type CreatorSaleEvent struct {
+	CreatorId string `json:"creator_id"`
+	Amount    int32  `json:"amount"`
+}
+
+type WithdrawalStatusEvent struct {
+	EventID      string     `json:"event_id"`
+	WithdrawalId string     `json:"withdrawal_id"`
+	WalletId     string     `json:"wallet_id"`
+	CreatorId    string     `json:"creator_id"`
+	Amount       int        `json:"amount"`
+	Status       string     `json:"status"`
+	Description  string     `json:"description"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
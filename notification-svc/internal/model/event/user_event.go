@@ -1,9 +1,19 @@
 package event
 
-import "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
 
 type UserDeviceEvent struct {
 	UserID      string                `json:"user_id" validate:"required"`
 	DeviceToken string                `json:"device_token" validate:"required"`
 	Platform    enum.PlatformTypeEnum `json:"platform" validate:"required"`
 }
+
+type UserDeletedEvent struct {
+	Id          string     `json:"id"`
+	PseudonymId string     `json:"pseudonym_id"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+}
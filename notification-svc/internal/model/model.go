@@ -0,0 +1,31 @@
+package model
+
+type WebResponse[T any] struct {
+	Success        bool            `json:"success"`
+	Data           T               `json:"data,omitempty"`
+	CursorMetadata *CursorMetadata `json:"cursor,omitempty"`
+}
+
+type CursorMetadata struct {
+	Size        int    `json:"size"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasMore     bool   `json:"has_more"`
+	NextPageURL string `json:"next_page_url,omitempty"`
+}
+
+type BodyParseErrorResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Errors  interface{} `json:"errors,omitempty"`
+}
+
+type ValidationErrorResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Errors  interface{} `json:"errors,omitempty"`
+}
+
+type ErrorResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+type GetNotificationsRequest struct {
+	UserId     string `validate:"required"`
+	UnreadOnly bool   `json:"unread"`
+	Cursor     string `json:"cursor"`
+	Size       int    `json:"size" validate:"required,min=1,max=50"`
+}
+
+type MarkNotificationsReadRequest struct {
+	UserId          string   `validate:"required"`
+	NotificationIds []string `json:"notification_ids" validate:"required,min=1,max=100,dive,required,max=26"`
+}
+
+type DeleteNotificationRequest struct {
+	UserId         string `validate:"required"`
+	NotificationId string `json:"notification_id" validate:"required,max=26"`
+}
+
+// SendNotificationRequest is an ad-hoc push of another service, the event id deduplicates retried requests
+type SendNotificationRequest struct {
+	EventId  string
+	UserIds  []string
+	Category enum.NotificationCategoryEnum
+	Type     enum.NotificationTypeEnum
+	Title    string
+	Body     string
+	Data     map[string]string
+}
+
+// NotificationResponse data is the deep link payload, e.g. photo_ids and bulk_photo_id for similar photos
+type NotificationResponse struct {
+	Id        string                    `json:"id"`
+	Type      enum.NotificationTypeEnum `json:"type"`
+	Title     string                    `json:"title"`
+	Body      string                    `json:"body"`
+	Data      json.RawMessage           `json:"data"`
+	IsRead    bool                      `json:"is_read"`
+	ReadAt    *time.Time                `json:"read_at"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
+
+type UnreadNotificationCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
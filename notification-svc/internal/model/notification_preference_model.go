@@ -0,0 +1,33 @@
+package model
+
+import "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+
+type NotificationCategoryPreference struct {
+	Category enum.NotificationCategoryEnum `json:"category" validate:"required,oneof=new_match facecam_match following transaction review chat promotion"`
+	Push     bool                          `json:"push"`
+	Email    bool                          `json:"email"`
+	InApp    bool                          `json:"in_app"`
+}
+
+// UpdateNotificationPreferenceRequest quiet hours are HH:MM in the timezone, both empty turns quiet hours off. Only the
+// categories sent are changed. The language is used for emails and defaults to Indonesian.
+type UpdateNotificationPreferenceRequest struct {
+	UserId              string                            `validate:"required"`
+	Timezone            string                            `json:"timezone" validate:"required,timezone"`
+	QuietHoursStart     string                            `json:"quiet_hours_start" validate:"required_with=QuietHoursEnd,omitempty,datetime=15:04"`
+	QuietHoursEnd       string                            `json:"quiet_hours_end" validate:"required_with=QuietHoursStart,omitempty,datetime=15:04,nefield=QuietHoursStart"`
+	DigestEnabled       bool                              `json:"digest_enabled"`
+	DigestWindowMinutes int                               `json:"digest_window_minutes" validate:"required,min=15,max=1440"`
+	Language            enum.LanguageEnum                 `json:"language" validate:"omitempty,oneof=id en"`
+	Categories          []*NotificationCategoryPreference `json:"categories" validate:"max=7,dive,required"`
+}
+
+type NotificationPreferenceResponse struct {
+	Timezone            string                            `json:"timezone"`
+	QuietHoursStart     *string                           `json:"quiet_hours_start"`
+	QuietHoursEnd       *string                           `json:"quiet_hours_end"`
+	DigestEnabled       bool                              `json:"digest_enabled"`
+	DigestWindowMinutes int                               `json:"digest_window_minutes"`
+	Language            enum.LanguageEnum                 `json:"language"`
+	Categories          []*NotificationCategoryPreference `json:"categories"`
+}
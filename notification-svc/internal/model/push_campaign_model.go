@@ -0,0 +1,59 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+// CreatePushCampaignRequest batch size is the number of users pushed per minute, a campaign without a schedule time
+// starts on the next worker tick. Inactive days default to 30 for the INACTIVE segment.
+type CreatePushCampaignRequest struct {
+	CreatedBy    string                   `validate:"required"`
+	Title        string                   `json:"title" validate:"required,max=100"`
+	Body         string                   `json:"body" validate:"required,max=500"`
+	Data         map[string]string        `json:"data" validate:"max=10"`
+	Segment      enum.AudienceSegmentEnum `json:"segment" validate:"required,oneof=ALL CREATOR FACECAM EVENT_ATTENDEE INACTIVE"`
+	BulkPhotoId  string                   `json:"bulk_photo_id" validate:"required_if=Segment EVENT_ATTENDEE,omitempty,max=26"`
+	InactiveDays int                      `json:"inactive_days" validate:"omitempty,min=1,max=365"`
+	BatchSize    int                      `json:"batch_size" validate:"omitempty,min=100,max=10000"`
+	ScheduledAt  *time.Time               `json:"scheduled_at"`
+}
+
+type GetPushCampaignsRequest struct {
+	Status enum.PushCampaignStatusEnum `json:"status" validate:"omitempty,oneof=SCHEDULED SENDING COMPLETED CANCELED"`
+	Cursor string                      `json:"cursor"`
+	Size   int                         `json:"size" validate:"required,min=1,max=50"`
+}
+
+type GetPushCampaignRequest struct {
+	CampaignId string `json:"campaign_id" validate:"required,max=26"`
+}
+
+type CancelPushCampaignRequest struct {
+	CampaignId string `json:"campaign_id" validate:"required,max=26"`
+}
+
+// PushCampaignResponse targeted count is the number of users handed to delivery so far, users that turned off
+// promotions or are in their quiet hours are counted but not pushed. Results are only set on a single campaign.
+type PushCampaignResponse struct {
+	Id            string                      `json:"id"`
+	Title         string                      `json:"title"`
+	Body          string                      `json:"body"`
+	Data          json.RawMessage             `json:"data"`
+	Segment       enum.AudienceSegmentEnum    `json:"segment"`
+	BulkPhotoId   *string                     `json:"bulk_photo_id,omitempty"`
+	InactiveDays  int                         `json:"inactive_days,omitempty"`
+	BatchSize     int                         `json:"batch_size"`
+	Status        enum.PushCampaignStatusEnum `json:"status"`
+	TargetedCount int                         `json:"targeted_count"`
+	LastError     *string                     `json:"last_error,omitempty"`
+	ScheduledAt   time.Time                   `json:"scheduled_at"`
+	StartedAt     *time.Time                  `json:"started_at"`
+	CompletedAt   *time.Time                  `json:"completed_at"`
+	CanceledAt    *time.Time                  `json:"canceled_at"`
+	CreatedBy     string                      `json:"created_by"`
+	CreatedAt     time.Time                   `json:"created_at"`
+	Results       *PushCampaignMetricResponse `json:"results,omitempty"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+// AcknowledgePushRequest push id is the push_id of the push data, the token limits the acknowledgement to the device
+// that opened the push
+type AcknowledgePushRequest struct {
+	UserId string                   `validate:"required"`
+	PushId string                   `json:"push_id" validate:"required,max=26"`
+	Action enum.PushInteractionEnum `json:"action" validate:"required,oneof=open click"`
+	Token  string                   `json:"token" validate:"max=4096"`
+}
+
+// GetPushCampaignMetricsRequest lists the campaigns first sent in the last days
+type GetPushCampaignMetricsRequest struct {
+	Category string `json:"category" validate:"omitempty,oneof=new_match facecam_match following transaction review chat promotion"`
+	Days     int    `json:"days" validate:"required,min=1,max=365"`
+	Size     int    `json:"size" validate:"required,min=1,max=100"`
+}
+
+type GetPushCampaignMetricRequest struct {
+	CampaignId string `json:"campaign_id" validate:"required,max=64"`
+}
+
+// PushCampaignMetricResponse sent, failed and pruned count device tokens while the other counts are push messages.
+// Open, click and conversion rates are relative to the delivered pushes.
+type PushCampaignMetricResponse struct {
+	CampaignId     string                        `json:"campaign_id"`
+	Category       enum.NotificationCategoryEnum `json:"category"`
+	Type           enum.NotificationTypeEnum     `json:"type"`
+	Users          int                           `json:"users"`
+	Pushes         int                           `json:"pushes"`
+	Sent           int                           `json:"sent"`
+	Failed         int                           `json:"failed"`
+	Pruned         int                           `json:"pruned"`
+	Delivered      int                           `json:"delivered"`
+	Opened         int                           `json:"opened"`
+	Clicked        int                           `json:"clicked"`
+	Converted      int                           `json:"converted"`
+	Revenue        int64                         `json:"revenue"`
+	DeliveryRate   float64                       `json:"delivery_rate"`
+	OpenRate       float64                       `json:"open_rate"`
+	ClickRate      float64                       `json:"click_rate"`
+	ConversionRate float64                       `json:"conversion_rate"`
+	FirstSentAt    time.Time                     `json:"first_sent_at"`
+	LastSentAt     time.Time                     `json:"last_sent_at"`
+}
@@ -1,9 +1,21 @@
 package model
 
-import "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
 
 type CreateDeviceRequest struct {
 	UserID      string                `json:"user_id" validate:"required"`
 	DeviceToken string                `json:"device_token" validate:"required"`
 	Platform    enum.PlatformTypeEnum `json:"platform" validate:"required"`
 }
+
+type UserDeviceResponse struct {
+	Id        string                `json:"id"`
+	UserId    string                `json:"user_id"`
+	Token     string                `json:"token"`
+	Platform  enum.PlatformTypeEnum `json:"platform"`
+	CreatedAt *time.Time            `json:"created_at"`
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/lib/pq"
+)
+
+type EmailBounceRepository interface {
+	Upsert(ctx context.Context, tx Querier, bounce *entity.EmailBounce) error
+	FindSuppressedEmails(ctx context.Context, tx Querier, emails []string, softBounceLimit int) ([]string, error)
+	DeleteSoftBounce(ctx context.Context, tx Querier, email string) error
+}
+
+type emailBounceRepository struct{}
+
+func NewEmailBounceRepository() EmailBounceRepository {
+	return &emailBounceRepository{}
+}
+
+// Upsert counts the bounce of the address, once an address hard bounced it stays a hard bounce
+func (r *emailBounceRepository) Upsert(ctx context.Context, tx Querier, bounce *entity.EmailBounce) error {
+	query := `
+	INSERT INTO email_bounces 
+		(email, bounce_type, bounce_count, last_code, last_reason, last_bounced_at)
+	VALUES 
+		($1, $2, 1, $3, $4, $5)
+	ON CONFLICT 
+		(email) 
+	DO UPDATE
+	SET 
+		bounce_type = CASE WHEN email_bounces.bounce_type = $6 THEN email_bounces.bounce_type ELSE EXCLUDED.bounce_type END,
+		bounce_count = email_bounces.bounce_count + 1,
+		last_code = EXCLUDED.last_code,
+		last_reason = EXCLUDED.last_reason,
+		last_bounced_at = EXCLUDED.last_bounced_at`
+
+	_, err := tx.ExecContext(ctx, query, bounce.Email, bounce.BounceType, bounce.LastCode, bounce.LastReason,
+		bounce.LastBouncedAt, enum.EmailBounceTypeHard)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindSuppressedEmails returns the emails among emails that hard bounced or soft bounced softBounceLimit times in a row
+func (r *emailBounceRepository) FindSuppressedEmails(ctx context.Context, tx Querier, emails []string, softBounceLimit int) ([]string, error) {
+	suppressedEmails := make([]string, 0)
+	if len(emails) == 0 {
+		return suppressedEmails, nil
+	}
+
+	query := `SELECT email FROM email_bounces WHERE email = ANY($1) AND (bounce_type = $2 OR bounce_count >= $3)`
+	if err := tx.SelectContext(ctx, &suppressedEmails, query, pq.Array(emails), enum.EmailBounceTypeHard, softBounceLimit); err != nil {
+		return nil, err
+	}
+	return suppressedEmails, nil
+}
+
+// DeleteSoftBounce resets the soft bounces of an address after an email was accepted again
+func (r *emailBounceRepository) DeleteSoftBounce(ctx context.Context, tx Querier, email string) error {
+	query := `DELETE FROM email_bounces WHERE email = $1 AND bounce_type = $2`
+	if _, err := tx.ExecContext(ctx, query, email, enum.EmailBounceTypeSoft); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+)
+
+type EmailDeliveryRepository interface {
+	Claim(ctx context.Context, tx Querier, delivery *entity.EmailDelivery, maxAttempts int) (bool, error)
+	UpdateStatus(ctx context.Context, tx Querier, delivery *entity.EmailDelivery) error
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type emailDeliveryRepository struct{}
+
+func NewEmailDeliveryRepository() EmailDeliveryRepository {
+	return &emailDeliveryRepository{}
+}
+
+// Claim stores the delivery as pending and returns false when the email of the event was already sent, bounced,
+// suppressed or ran out of attempts. A claimed delivery that existed before keeps its id.
+func (r *emailDeliveryRepository) Claim(ctx context.Context, tx Querier, delivery *entity.EmailDelivery, maxAttempts int) (bool, error) {
+	query := `
+	INSERT INTO email_deliveries 
+		(id, event_id, user_id, template, language, email, status, attempts, created_at, updated_at)
+	VALUES 
+		($1, $2, $3, $4, $5, $6, $7, 1, $8, $8)
+	ON CONFLICT 
+		(event_id, user_id, template) 
+	DO UPDATE
+	SET 
+		language = EXCLUDED.language,
+		email = EXCLUDED.email,
+		status = EXCLUDED.status,
+		attempts = email_deliveries.attempts + 1,
+		updated_at = EXCLUDED.updated_at
+	WHERE 
+		email_deliveries.status IN ($7, $9) AND email_deliveries.attempts < $10
+	RETURNING id, attempts`
+
+	row := tx.QueryRowxContext(ctx, query, delivery.Id, delivery.EventId, delivery.UserId, delivery.Template, delivery.Language,
+		delivery.Email, enum.EmailDeliveryStatusPending, delivery.CreatedAt, enum.EmailDeliveryStatusFailed, maxAttempts)
+	if err := row.Scan(&delivery.Id, &delivery.Attempts); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	delivery.Status = enum.EmailDeliveryStatusPending
+	return true, nil
+}
+
+func (r *emailDeliveryRepository) UpdateStatus(ctx context.Context, tx Querier, delivery *entity.EmailDelivery) error {
+	query := `UPDATE email_deliveries SET status = $1, last_error = $2, sent_at = $3, updated_at = $4 WHERE id = $5`
+	if _, err := tx.ExecContext(ctx, query, delivery.Status, delivery.LastError, delivery.SentAt, delivery.UpdatedAt,
+		delivery.Id); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *emailDeliveryRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM email_deliveries WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+	return nil
+}
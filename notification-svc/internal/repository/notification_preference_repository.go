@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/lib/pq"
+)
+
+type NotificationPreferenceRepository interface {
+	UpsertBulk(ctx context.Context, tx Querier, preferences []*entity.NotificationPreference) error
+	FindByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.NotificationPreference, error)
+	FindDisabledUserIds(ctx context.Context, tx Querier, userIds []string, category enum.NotificationCategoryEnum,
+		channel enum.NotificationChannelEnum) ([]string, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type notificationPreferenceRepository struct{}
+
+func NewNotificationPreferenceRepository() NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{}
+}
+
+func (r *notificationPreferenceRepository) UpsertBulk(ctx context.Context, tx Querier, preferences []*entity.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	userIds := make([]string, 0, len(preferences))
+	categories := make([]string, 0, len(preferences))
+	channels := make([]string, 0, len(preferences))
+	enableds := make([]bool, 0, len(preferences))
+	for _, preference := range preferences {
+		userIds = append(userIds, preference.UserId)
+		categories = append(categories, string(preference.Category))
+		channels = append(channels, string(preference.Channel))
+		enableds = append(enableds, preference.Enabled)
+	}
+
+	query := `
+	INSERT INTO notification_preferences 
+		(user_id, category, channel, enabled, updated_at)
+	SELECT 
+		user_id, category, channel, enabled, $5
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::boolean[]) 
+		AS t(user_id, category, channel, enabled)
+	ON CONFLICT 
+		(user_id, category, channel) 
+	DO UPDATE
+	SET 
+		enabled = EXCLUDED.enabled,
+		updated_at = EXCLUDED.updated_at`
+
+	_, err := tx.ExecContext(ctx, query, pq.Array(userIds), pq.Array(categories), pq.Array(channels), pq.Array(enableds),
+		preferences[0].UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *notificationPreferenceRepository) FindByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.NotificationPreference, error) {
+	preferences := make([]*entity.NotificationPreference, 0)
+	query := `SELECT user_id, category, channel, enabled, updated_at FROM notification_preferences WHERE user_id = $1`
+	if err := tx.SelectContext(ctx, &preferences, query, userId); err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// FindDisabledUserIds returns the users among userIds that turned off the channel for the category
+func (r *notificationPreferenceRepository) FindDisabledUserIds(ctx context.Context, tx Querier, userIds []string, category enum.NotificationCategoryEnum,
+	channel enum.NotificationChannelEnum) ([]string, error) {
+	disabledUserIds := make([]string, 0)
+	if len(userIds) == 0 {
+		return disabledUserIds, nil
+	}
+
+	query := `
+	SELECT user_id 
+	FROM notification_preferences 
+	WHERE user_id = ANY($1) AND category = $2 AND channel = $3 AND enabled = FALSE`
+	if err := tx.SelectContext(ctx, &disabledUserIds, query, pq.Array(userIds), category, channel); err != nil {
+		return nil, err
+	}
+	return disabledUserIds, nil
+}
+
+func (r *notificationPreferenceRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM notification_preferences WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+	return nil
+}
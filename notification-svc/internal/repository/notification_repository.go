@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/lib/pq"
+)
+
+type NotificationRepository interface {
+	InsertBulk(ctx context.Context, tx Querier, notifications []*entity.Notification) ([]string, error)
+	FindByCursor(ctx context.Context, tx Querier, userId string, unreadOnly bool, cursor *pagination.Cursor,
+		size int) ([]*entity.Notification, *model.CursorMetadata, error)
+	CountUnread(ctx context.Context, tx Querier, userId string) (int, error)
+	MarkRead(ctx context.Context, tx Querier, userId string, notificationIds []string, readAt time.Time) error
+	MarkAllRead(ctx context.Context, tx Querier, userId string, readAt time.Time) error
+	Delete(ctx context.Context, tx Querier, userId, notificationId string) (bool, error)
+	DeleteByUserID(ctx context.Context, tx Querier, userId string) error
+}
+
+type notificationRepository struct{}
+
+func NewNotificationRepository() NotificationRepository {
+	return &notificationRepository{}
+}
+
+// InsertBulk returns the user ids that got a new notification, users that already have a notification of the same
+// event are skipped
+func (r *notificationRepository) InsertBulk(ctx context.Context, tx Querier, notifications []*entity.Notification) ([]string, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(notifications))
+	userIds := make([]string, 0, len(notifications))
+	eventIds := make([]string, 0, len(notifications))
+	notificationTypes := make([]string, 0, len(notifications))
+	titles := make([]string, 0, len(notifications))
+	bodies := make([]string, 0, len(notifications))
+	datas := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		ids = append(ids, notification.Id)
+		userIds = append(userIds, notification.UserId)
+		eventIds = append(eventIds, notification.EventId)
+		notificationTypes = append(notificationTypes, string(notification.Type))
+		titles = append(titles, notification.Title)
+		bodies = append(bodies, notification.Body)
+		datas = append(datas, notification.Data.String())
+	}
+
+	query := `
+	INSERT INTO notifications 
+		(id, user_id, event_id, type, title, body, data, created_at)
+	SELECT 
+		id, user_id, event_id, type, title, body, data, $8
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::jsonb[]) 
+		AS t(id, user_id, event_id, type, title, body, data)
+	ON CONFLICT 
+		(user_id, event_id) 
+	DO NOTHING
+	RETURNING user_id`
+
+	insertedUserIds := make([]string, 0, len(notifications))
+	err := tx.SelectContext(ctx, &insertedUserIds, query, pq.Array(ids), pq.Array(userIds), pq.Array(eventIds), pq.Array(notificationTypes),
+		pq.Array(titles), pq.Array(bodies), pq.Array(datas), notifications[0].CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return insertedUserIds, nil
+}
+
+// FindByCursor lists the notifications of a user from the newest, sorted by created time and id
+func (r *notificationRepository) FindByCursor(ctx context.Context, tx Querier, userId string, unreadOnly bool, cursor *pagination.Cursor,
+	size int) ([]*entity.Notification, *model.CursorMetadata, error) {
+	query := `SELECT id, user_id, event_id, type, title, body, data, read_at, created_at FROM notifications WHERE user_id = $1`
+	args := []interface{}{userId}
+	argIndex := 2
+
+	if unreadOnly {
+		query += " AND read_at IS NULL"
+	}
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY created_at DESC, id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	notifications := make([]*entity.Notification, 0)
+	if err := tx.SelectContext(ctx, &notifications, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(notifications) > size
+	if hasMore {
+		notifications = notifications[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := notifications[len(notifications)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return notifications, cursorMetadata, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, tx Querier, userId string) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+	if err := tx.GetContext(ctx, &count, query, userId); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// MarkRead ignores ids that do not belong to the user or are already read
+func (r *notificationRepository) MarkRead(ctx context.Context, tx Querier, userId string, notificationIds []string, readAt time.Time) error {
+	query := `
+	UPDATE notifications 
+	SET read_at = $1 
+	WHERE user_id = $2 AND id = ANY($3) AND read_at IS NULL`
+	if _, err := tx.ExecContext(ctx, query, readAt, userId, pq.Array(notificationIds)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, tx Querier, userId string, readAt time.Time) error {
+	query := `UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL`
+	if _, err := tx.ExecContext(ctx, query, readAt, userId); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete returns false when the notification does not exist or belongs to another user
+func (r *notificationRepository) Delete(ctx context.Context, tx Querier, userId, notificationId string) (bool, error) {
+	query := `DELETE FROM notifications WHERE id = $1 AND user_id = $2`
+	result, err := tx.ExecContext(ctx, query, notificationId, userId)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *notificationRepository) DeleteByUserID(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM notifications WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/lib/pq"
+)
+
+type NotificationSettingRepository interface {
+	Upsert(ctx context.Context, tx Querier, setting *entity.NotificationSetting) error
+	FindByUserId(ctx context.Context, tx Querier, userId string) (*entity.NotificationSetting, error)
+	FindByUserIds(ctx context.Context, tx Querier, userIds []string) ([]*entity.NotificationSetting, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type notificationSettingRepository struct{}
+
+func NewNotificationSettingRepository() NotificationSettingRepository {
+	return &notificationSettingRepository{}
+}
+
+func (r *notificationSettingRepository) Upsert(ctx context.Context, tx Querier, setting *entity.NotificationSetting) error {
+	query := `
+	INSERT INTO notification_settings 
+		(user_id, timezone, quiet_hours_start, quiet_hours_end, digest_enabled, digest_window_minutes, language, updated_at)
+	VALUES 
+		($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT 
+		(user_id) 
+	DO UPDATE
+	SET 
+		timezone = EXCLUDED.timezone,
+		quiet_hours_start = EXCLUDED.quiet_hours_start,
+		quiet_hours_end = EXCLUDED.quiet_hours_end,
+		digest_enabled = EXCLUDED.digest_enabled,
+		digest_window_minutes = EXCLUDED.digest_window_minutes,
+		language = EXCLUDED.language,
+		updated_at = EXCLUDED.updated_at`
+
+	_, err := tx.ExecContext(ctx, query, setting.UserId, setting.Timezone, setting.QuietHoursStart, setting.QuietHoursEnd,
+		setting.DigestEnabled, setting.DigestWindowMinutes, setting.Language, setting.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindByUserId returns nil when the user never changed the settings
+func (r *notificationSettingRepository) FindByUserId(ctx context.Context, tx Querier, userId string) (*entity.NotificationSetting, error) {
+	setting := new(entity.NotificationSetting)
+	query := `SELECT * FROM notification_settings WHERE user_id = $1`
+	if err := tx.GetContext(ctx, setting, query, userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
+
+func (r *notificationSettingRepository) FindByUserIds(ctx context.Context, tx Querier, userIds []string) ([]*entity.NotificationSetting, error) {
+	settings := make([]*entity.NotificationSetting, 0)
+	if len(userIds) == 0 {
+		return settings, nil
+	}
+
+	query := `SELECT * FROM notification_settings WHERE user_id = ANY($1)`
+	if err := tx.SelectContext(ctx, &settings, query, pq.Array(userIds)); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (r *notificationSettingRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM notification_settings WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+)
+
+type PushCampaignRepository interface {
+	Create(ctx context.Context, tx Querier, campaign *entity.PushCampaign) error
+	FindById(ctx context.Context, tx Querier, campaignId string) (*entity.PushCampaign, error)
+	FindByCursor(ctx context.Context, tx Querier, status enum.PushCampaignStatusEnum, cursor *pagination.Cursor,
+		size int) ([]*entity.PushCampaign, *model.CursorMetadata, error)
+	ClaimDue(ctx context.Context, tx Querier, now, leaseUntil time.Time) (*entity.PushCampaign, error)
+	UpdateProgress(ctx context.Context, tx Querier, campaignId, cursorUserId string, targetedCount int, nextBatchAt time.Time) (bool, error)
+	UpdateLastError(ctx context.Context, tx Querier, campaignId, lastError string) error
+	Complete(ctx context.Context, tx Querier, campaignId, cursorUserId string, targetedCount int, completedAt time.Time) (bool, error)
+	Cancel(ctx context.Context, tx Querier, campaignId string, canceledAt time.Time) (bool, error)
+}
+
+type pushCampaignRepository struct{}
+
+func NewPushCampaignRepository() PushCampaignRepository {
+	return &pushCampaignRepository{}
+}
+
+func (r *pushCampaignRepository) Create(ctx context.Context, tx Querier, campaign *entity.PushCampaign) error {
+	query := `
+	INSERT INTO push_campaigns
+		(id, title, body, data, segment, bulk_photo_id, inactive_days, batch_size, status, scheduled_at, next_batch_at,
+		created_by, created_at, updated_at)
+	VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+
+	_, err := tx.ExecContext(ctx, query, campaign.Id, campaign.Title, campaign.Body, campaign.Data, campaign.Segment,
+		campaign.BulkPhotoId, campaign.InactiveDays, campaign.BatchSize, campaign.Status, campaign.ScheduledAt,
+		campaign.NextBatchAt, campaign.CreatedBy, campaign.CreatedAt, campaign.UpdatedAt)
+	return err
+}
+
+func (r *pushCampaignRepository) FindById(ctx context.Context, tx Querier, campaignId string) (*entity.PushCampaign, error) {
+	query := `SELECT * FROM push_campaigns WHERE id = $1`
+	campaign := new(entity.PushCampaign)
+	if err := tx.GetContext(ctx, campaign, query, campaignId); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// FindByCursor lists the campaigns from the newest, sorted by created time and id. An empty status lists every status.
+func (r *pushCampaignRepository) FindByCursor(ctx context.Context, tx Querier, status enum.PushCampaignStatusEnum, cursor *pagination.Cursor,
+	size int) ([]*entity.PushCampaign, *model.CursorMetadata, error) {
+	query := `SELECT * FROM push_campaigns WHERE ($1 = '' OR status = $1)`
+	args := []interface{}{status}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY created_at DESC, id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	campaigns := make([]*entity.PushCampaign, 0)
+	if err := tx.SelectContext(ctx, &campaigns, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(campaigns) > size
+	if hasMore {
+		campaigns = campaigns[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := campaigns[len(campaigns)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return campaigns, cursorMetadata, nil
+}
+
+// ClaimDue takes the campaign whose next batch is due the longest and holds it until leaseUntil, so other workers skip
+// it and a worker that dies mid batch gives it back once the lease ends. It returns sql.ErrNoRows when nothing is due.
+func (r *pushCampaignRepository) ClaimDue(ctx context.Context, tx Querier, now, leaseUntil time.Time) (*entity.PushCampaign, error) {
+	query := `
+	UPDATE push_campaigns
+	SET
+		status = $3,
+		started_at = COALESCE(started_at, $1),
+		next_batch_at = $2,
+		updated_at = $1
+	WHERE id = (
+		SELECT id FROM push_campaigns
+		WHERE status IN ($4, $3) AND next_batch_at <= $1
+		ORDER BY next_batch_at
+		FOR UPDATE SKIP LOCKED
+		LIMIT 1
+	)
+	RETURNING *`
+
+	campaign := new(entity.PushCampaign)
+	if err := tx.GetContext(ctx, campaign, query, now, leaseUntil, enum.PushCampaignStatusSending,
+		enum.PushCampaignStatusScheduled); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// UpdateProgress moves the cursor after a sent batch, it returns false when the campaign was canceled meanwhile
+func (r *pushCampaignRepository) UpdateProgress(ctx context.Context, tx Querier, campaignId, cursorUserId string, targetedCount int,
+	nextBatchAt time.Time) (bool, error) {
+	query := `
+	UPDATE push_campaigns
+	SET
+		cursor_user_id = $2,
+		targeted_count = $3,
+		next_batch_at = $4,
+		last_error = NULL,
+		updated_at = now()
+	WHERE id = $1 AND status = $5`
+
+	result, err := tx.ExecContext(ctx, query, campaignId, cursorUserId, targetedCount, nextBatchAt, enum.PushCampaignStatusSending)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// UpdateLastError keeps the error of the last failed batch, the batch is sent again once the claim lease ends
+func (r *pushCampaignRepository) UpdateLastError(ctx context.Context, tx Querier, campaignId, lastError string) error {
+	query := `UPDATE push_campaigns SET last_error = $2, updated_at = now() WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, campaignId, lastError); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Complete marks the campaign sent after its last batch, it returns false when the campaign was canceled meanwhile
+func (r *pushCampaignRepository) Complete(ctx context.Context, tx Querier, campaignId, cursorUserId string, targetedCount int,
+	completedAt time.Time) (bool, error) {
+	query := `
+	UPDATE push_campaigns
+	SET
+		status = $5,
+		cursor_user_id = $2,
+		targeted_count = $3,
+		completed_at = $4,
+		last_error = NULL,
+		updated_at = $4
+	WHERE id = $1 AND status = $6`
+
+	result, err := tx.ExecContext(ctx, query, campaignId, cursorUserId, targetedCount, completedAt, enum.PushCampaignStatusCompleted,
+		enum.PushCampaignStatusSending)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// Cancel stops a campaign that is not finished yet, the batch being sent at that moment still goes out. It returns
+// false when the campaign is already completed or canceled.
+func (r *pushCampaignRepository) Cancel(ctx context.Context, tx Querier, campaignId string, canceledAt time.Time) (bool, error) {
+	query := `
+	UPDATE push_campaigns
+	SET
+		status = $3,
+		canceled_at = $2,
+		updated_at = $2
+	WHERE id = $1 AND status IN ($4, $5)`
+
+	result, err := tx.ExecContext(ctx, query, campaignId, canceledAt, enum.PushCampaignStatusCanceled,
+		enum.PushCampaignStatusScheduled, enum.PushCampaignStatusSending)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/lib/pq"
+)
+
+type PushDeliveryRepository interface {
+	InsertBulk(ctx context.Context, tx Querier, deliveries []*entity.PushDelivery) error
+	MarkInteraction(ctx context.Context, tx Querier, userId, pushId, token string, interaction enum.PushInteractionEnum,
+		interactedAt time.Time) (bool, error)
+	AttributeConversion(ctx context.Context, tx Querier, userId, transactionId string, revenue int64, convertedAt,
+		openedSince time.Time) (bool, error)
+	FindCampaignMetrics(ctx context.Context, tx Querier, category string, since time.Time, size int) ([]*entity.PushCampaignMetric, error)
+	FindCampaignMetric(ctx context.Context, tx Querier, campaignId string) (*entity.PushCampaignMetric, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type pushDeliveryRepository struct{}
+
+func NewPushDeliveryRepository() PushDeliveryRepository {
+	return &pushDeliveryRepository{}
+}
+
+func (r *pushDeliveryRepository) InsertBulk(ctx context.Context, tx Querier, deliveries []*entity.PushDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(deliveries))
+	pushIds := make([]string, 0, len(deliveries))
+	campaignIds := make([]string, 0, len(deliveries))
+	categories := make([]string, 0, len(deliveries))
+	notificationTypes := make([]string, 0, len(deliveries))
+	notificationIds := make([]*string, 0, len(deliveries))
+	userIds := make([]string, 0, len(deliveries))
+	tokens := make([]string, 0, len(deliveries))
+	statuses := make([]string, 0, len(deliveries))
+	errorCodes := make([]*string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.Id)
+		pushIds = append(pushIds, delivery.PushId)
+		campaignIds = append(campaignIds, delivery.CampaignId)
+		categories = append(categories, string(delivery.Category))
+		notificationTypes = append(notificationTypes, string(delivery.Type))
+		userIds = append(userIds, delivery.UserId)
+		tokens = append(tokens, delivery.Token)
+		statuses = append(statuses, string(delivery.Status))
+
+		var notificationId, errorCode *string
+		if delivery.NotificationId.Valid {
+			notificationId = &delivery.NotificationId.String
+		}
+		if delivery.ErrorCode.Valid {
+			errorCode = &delivery.ErrorCode.String
+		}
+		notificationIds = append(notificationIds, notificationId)
+		errorCodes = append(errorCodes, errorCode)
+	}
+
+	query := `
+	INSERT INTO push_deliveries
+		(id, push_id, campaign_id, category, type, notification_id, user_id, token, status, error_code, created_at)
+	SELECT
+		id, push_id, campaign_id, category, type, notification_id, user_id, token, status, error_code, $11
+	FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[], $8::text[], $9::text[], $10::text[])
+		AS t(id, push_id, campaign_id, category, type, notification_id, user_id, token, status, error_code)`
+
+	_, err := tx.ExecContext(ctx, query, pq.Array(ids), pq.Array(pushIds), pq.Array(campaignIds), pq.Array(categories),
+		pq.Array(notificationTypes), pq.Array(notificationIds), pq.Array(userIds), pq.Array(tokens), pq.Array(statuses),
+		pq.Array(errorCodes), deliveries[0].CreatedAt)
+	return err
+}
+
+// MarkInteraction records the first open or click of a push, a click also counts as an open. Without a token every
+// device that got the push is marked. It returns false when the user got no delivered push with the id.
+func (r *pushDeliveryRepository) MarkInteraction(ctx context.Context, tx Querier, userId, pushId, token string,
+	interaction enum.PushInteractionEnum, interactedAt time.Time) (bool, error) {
+	query := `
+	UPDATE push_deliveries
+	SET
+		opened_at = COALESCE(opened_at, $4),
+		clicked_at = CASE WHEN $5 THEN COALESCE(clicked_at, $4) ELSE clicked_at END
+	WHERE
+		push_id = $1 AND user_id = $2 AND status = $6 AND ($3 = '' OR token = $3)`
+
+	result, err := tx.ExecContext(ctx, query, pushId, userId, token, interactedAt, interaction == enum.PushInteractionClick,
+		enum.PushDeliveryStatusSent)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// AttributeConversion credits the purchase to the push the user opened last since openedSince. A push is credited
+// once and a transaction is credited to one push, it returns false when nothing was credited.
+func (r *pushDeliveryRepository) AttributeConversion(ctx context.Context, tx Querier, userId, transactionId string, revenue int64,
+	convertedAt, openedSince time.Time) (bool, error) {
+	query := `
+	UPDATE push_deliveries
+	SET
+		converted_at = $3,
+		transaction_id = $2,
+		revenue = $4
+	WHERE id = (
+		SELECT id FROM push_deliveries
+		WHERE
+			user_id = $1 AND opened_at >= $5 AND opened_at <= $3
+			AND push_id NOT IN (SELECT push_id FROM push_deliveries WHERE user_id = $1 AND converted_at IS NOT NULL)
+		ORDER BY opened_at DESC, id DESC
+		LIMIT 1
+	) AND NOT EXISTS (SELECT 1 FROM push_deliveries WHERE transaction_id = $2)`
+
+	result, err := tx.ExecContext(ctx, query, userId, transactionId, convertedAt, revenue, openedSince)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+const pushCampaignMetricColumns = `
+	campaign_id,
+	MIN(category) AS category,
+	MIN(type) AS type,
+	COUNT(DISTINCT user_id) AS users,
+	COUNT(DISTINCT push_id) AS pushes,
+	COUNT(*) FILTER (WHERE status = 'SENT') AS sent,
+	COUNT(*) FILTER (WHERE status = 'FAILED') AS failed,
+	COUNT(*) FILTER (WHERE status = 'PRUNED') AS pruned,
+	COUNT(DISTINCT push_id) FILTER (WHERE status = 'SENT') AS delivered,
+	COUNT(DISTINCT push_id) FILTER (WHERE opened_at IS NOT NULL) AS opened,
+	COUNT(DISTINCT push_id) FILTER (WHERE clicked_at IS NOT NULL) AS clicked,
+	COUNT(DISTINCT push_id) FILTER (WHERE converted_at IS NOT NULL) AS converted,
+	COALESCE(SUM(revenue), 0) AS revenue,
+	MIN(created_at) AS first_sent_at,
+	MAX(created_at) AS last_sent_at`
+
+// FindCampaignMetrics lists the campaigns first sent since the given time from the newest, an empty category lists
+// every category
+func (r *pushDeliveryRepository) FindCampaignMetrics(ctx context.Context, tx Querier, category string, since time.Time,
+	size int) ([]*entity.PushCampaignMetric, error) {
+	query := `
+	SELECT ` + pushCampaignMetricColumns + `
+	FROM push_deliveries
+	WHERE ($1 = '' OR category = $1)
+	GROUP BY campaign_id
+	HAVING MIN(created_at) >= $2
+	ORDER BY first_sent_at DESC
+	LIMIT $3`
+
+	metrics := make([]*entity.PushCampaignMetric, 0)
+	if err := tx.SelectContext(ctx, &metrics, query, category, since, size); err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+func (r *pushDeliveryRepository) FindCampaignMetric(ctx context.Context, tx Querier, campaignId string) (*entity.PushCampaignMetric, error) {
+	query := `
+	SELECT ` + pushCampaignMetricColumns + `
+	FROM push_deliveries
+	WHERE campaign_id = $1
+	GROUP BY campaign_id`
+
+	metric := new(entity.PushCampaignMetric)
+	if err := tx.GetContext(ctx, metric, query, campaignId); err != nil {
+		return nil, err
+	}
+
+	return metric, nil
+}
+
+func (r *pushDeliveryRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM push_deliveries WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package templates
+
+import "embed"
+
+// EmailFS holds the email templates, one directory per language with a layout and a content file per template
+//
+//go:embed email
+var EmailFS embed.FS
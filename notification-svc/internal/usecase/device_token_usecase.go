@@ -10,12 +10,15 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/converter"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
 	"github.com/oklog/ulid/v2"
 )
 
 type UserDeviceUseCase interface {
 	CreateDevice(ctx context.Context, request *model.CreateDeviceRequest) error
+	DeleteDevices(ctx context.Context, userId string) error
+	GetDevices(ctx context.Context, userId string) ([]*model.UserDeviceResponse, error)
 }
 
 type deviceTokenUseCase struct {
@@ -59,3 +62,27 @@ func (uc *deviceTokenUseCase) CreateDevice(ctx context.Context, request *model.C
 
 	return nil
 }
+
+// DeleteDevices removes every device token of a user, used when the account is deleted
+func (uc *deviceTokenUseCase) DeleteDevices(ctx context.Context, userId string) error {
+	if err := uc.userDeviceRepository.DeleteByUserID(ctx, uc.db, userId); err != nil {
+		return helper.WrapInternalServerError(uc.logs, "failed to delete user devices", err)
+	}
+
+	setKey := fmt.Sprintf("fcm_tokens:%s", userId)
+	if err := uc.cacheAdapter.Del(ctx, setKey); err != nil {
+		return helper.WrapInternalServerError(uc.logs, "failed to delete redis set", err)
+	}
+
+	return nil
+}
+
+// GetDevices returns the device tokens of a user, used by the account data export of user service
+func (uc *deviceTokenUseCase) GetDevices(ctx context.Context, userId string) ([]*model.UserDeviceResponse, error) {
+	userDevices, err := uc.userDeviceRepository.FetchFCMTokensFromPostgre(ctx, uc.db, []string{userId})
+	if err != nil {
+		return nil, helper.WrapInternalServerError(uc.logs, "failed to find user devices", err)
+	}
+
+	return converter.UserDevicesToResponses(*userDevices), nil
+}
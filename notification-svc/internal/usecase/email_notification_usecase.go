@@ -0,0 +1,430 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+)
+
+const (
+	// emailMaxDeliveryAttempts caps how many times a redelivered event may try the same email
+	emailMaxDeliveryAttempts = 5
+	// emailSoftBounceLimit is the soft bounces in a row after which an address is not emailed anymore
+	emailSoftBounceLimit = 3
+)
+
+var indonesianMonths = [...]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus",
+	"September", "Oktober", "November", "Desember"}
+
+type EmailNotificationUseCase interface {
+	SendTransactionSettledEmails(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error
+	SendWithdrawalStatusEmail(ctx context.Context, withdrawalEvent *event.WithdrawalStatusEvent) error
+	SendReviewReceivedEmail(ctx context.Context, reviewEvent *event.CreatorReviewCreatedEvent) error
+	DeleteEmailDeliveries(ctx context.Context, userId string) error
+}
+
+// emailContent is one email of an event, data is rendered once the username, language and timezone of the user are
+// known
+type emailContent struct {
+	userId   string
+	template enum.EmailTemplateEnum
+	data     func(username string, language enum.LanguageEnum, location *time.Location) any
+}
+
+type emailNotificationUseCase struct {
+	db                               repository.BeginTx
+	emailDeliveryRepository          repository.EmailDeliveryRepository
+	emailBounceRepository            repository.EmailBounceRepository
+	notificationPreferenceRepository repository.NotificationPreferenceRepository
+	notificationSettingRepository    repository.NotificationSettingRepository
+	emailAdapter                     adapter.EmailAdapter
+	userAdapter                      adapter.UserAdapter
+	photoAdapter                     adapter.PhotoAdapter
+	logs                             logger.Log
+}
+
+func NewEmailNotificationUseCase(db repository.BeginTx, emailDeliveryRepository repository.EmailDeliveryRepository,
+	emailBounceRepository repository.EmailBounceRepository, notificationPreferenceRepository repository.NotificationPreferenceRepository,
+	notificationSettingRepository repository.NotificationSettingRepository, emailAdapter adapter.EmailAdapter,
+	userAdapter adapter.UserAdapter, photoAdapter adapter.PhotoAdapter, logs logger.Log) EmailNotificationUseCase {
+	return &emailNotificationUseCase{
+		db:                               db,
+		emailDeliveryRepository:          emailDeliveryRepository,
+		emailBounceRepository:            emailBounceRepository,
+		notificationPreferenceRepository: notificationPreferenceRepository,
+		notificationSettingRepository:    notificationSettingRepository,
+		emailAdapter:                     emailAdapter,
+		userAdapter:                      userAdapter,
+		photoAdapter:                     photoAdapter,
+		logs:                             logs,
+	}
+}
+
+// SendTransactionSettledEmails sends the receipt to the buyer and a sale email to every creator of the bought photos
+func (u *emailNotificationUseCase) SendTransactionSettledEmails(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error {
+	eventID := transactionEvent.EventID
+	if eventID == "" {
+		eventID = transactionEvent.TransactionId
+	}
+
+	settledAt := time.Now()
+	if transactionEvent.SettledAt != nil {
+		settledAt = *transactionEvent.SettledAt
+	}
+
+	contents := []*emailContent{
+		{
+			userId:   transactionEvent.UserId,
+			template: enum.EmailTemplateTransactionReceipt,
+			data: func(username string, language enum.LanguageEnum, location *time.Location) any {
+				return &model.TransactionReceiptEmailData{
+					Username:      username,
+					TransactionId: transactionEvent.TransactionId,
+					PhotoCount:    len(transactionEvent.PhotoIds),
+					Amount:        formatRupiah(int64(transactionEvent.Amount)),
+					SettledAt:     formatEmailTime(settledAt, language, location),
+				}
+			},
+		},
+	}
+
+	creatorIds, creatorAmounts := creatorSaleAmounts(transactionEvent.CreatorSales)
+
+	if len(creatorIds) > 0 {
+		creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, creatorIds)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to get creator user ids", err)
+		}
+
+		for _, creatorId := range creatorIds {
+			userId, ok := creatorUserIds[creatorId]
+			if !ok {
+				continue
+			}
+
+			amount := creatorAmounts[creatorId]
+			contents = append(contents, &emailContent{
+				userId:   userId,
+				template: enum.EmailTemplateCreatorSale,
+				data: func(username string, language enum.LanguageEnum, location *time.Location) any {
+					return &model.CreatorSaleEmailData{
+						Username:      username,
+						TransactionId: transactionEvent.TransactionId,
+						Amount:        formatRupiah(amount),
+						SettledAt:     formatEmailTime(settledAt, language, location),
+					}
+				},
+			})
+		}
+	}
+
+	return u.deliver(ctx, eventID, enum.NotificationCategoryTransaction, contents)
+}
+
+func (u *emailNotificationUseCase) SendWithdrawalStatusEmail(ctx context.Context, withdrawalEvent *event.WithdrawalStatusEvent) error {
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, []string{withdrawalEvent.CreatorId})
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get creator user ids", err)
+	}
+
+	userId, ok := creatorUserIds[withdrawalEvent.CreatorId]
+	if !ok {
+		u.logs.Log(fmt.Sprintf("[EMAIL] creator %s of withdrawal %s not found", withdrawalEvent.CreatorId, withdrawalEvent.WithdrawalId))
+		return nil
+	}
+
+	updatedAt := time.Now()
+	if withdrawalEvent.UpdatedAt != nil {
+		updatedAt = *withdrawalEvent.UpdatedAt
+	}
+
+	contents := []*emailContent{
+		{
+			userId:   userId,
+			template: enum.EmailTemplateWithdrawalStatus,
+			data: func(username string, language enum.LanguageEnum, location *time.Location) any {
+				return &model.WithdrawalStatusEmailData{
+					Username:     username,
+					WithdrawalId: withdrawalEvent.WithdrawalId,
+					Amount:       formatRupiah(int64(withdrawalEvent.Amount)),
+					Status:       withdrawalEvent.Status,
+					Description:  withdrawalEvent.Description,
+					UpdatedAt:    formatEmailTime(updatedAt, language, location),
+				}
+			},
+		},
+	}
+
+	return u.deliver(ctx, withdrawalEvent.EventID, enum.NotificationCategoryTransaction, contents)
+}
+
+func (u *emailNotificationUseCase) SendReviewReceivedEmail(ctx context.Context, reviewEvent *event.CreatorReviewCreatedEvent) error {
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, []string{reviewEvent.CreatorId})
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get creator user ids", err)
+	}
+
+	userId, ok := creatorUserIds[reviewEvent.CreatorId]
+	if !ok {
+		u.logs.Log(fmt.Sprintf("[EMAIL] creator %s of review %s not found", reviewEvent.CreatorId, reviewEvent.ReviewId))
+		return nil
+	}
+
+	contents := []*emailContent{
+		{
+			userId:   userId,
+			template: enum.EmailTemplateReviewReceived,
+			data: func(username string, language enum.LanguageEnum, location *time.Location) any {
+				return &model.ReviewReceivedEmailData{
+					Username: username,
+					Rating:   reviewEvent.Rating,
+					Comment:  reviewEvent.Comment,
+				}
+			},
+		},
+	}
+
+	return u.deliver(ctx, reviewEvent.EventID, enum.NotificationCategoryReview, contents)
+}
+
+func (u *emailNotificationUseCase) DeleteEmailDeliveries(ctx context.Context, userId string) error {
+	if err := u.emailDeliveryRepository.DeleteByUserId(ctx, u.db, userId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete email deliveries", err)
+	}
+	return nil
+}
+
+// deliver sends the emails of an event to the users that did not turn off email for the category and have a verified
+// address. It returns an error when an email failed so the event is redelivered, the emails already sent are skipped.
+func (u *emailNotificationUseCase) deliver(ctx context.Context, eventID string, category enum.NotificationCategoryEnum,
+	contents []*emailContent) error {
+	userIds := make([]string, 0, len(contents))
+	for _, content := range contents {
+		userIds = append(userIds, content.userId)
+	}
+
+	disabledUserIds, err := u.notificationPreferenceRepository.FindDisabledUserIds(ctx, u.db, userIds, category, enum.NotificationChannelEmail)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to find disabled email preferences", err)
+	}
+
+	disabledSet := make(map[string]struct{}, len(disabledUserIds))
+	for _, userId := range disabledUserIds {
+		disabledSet[userId] = struct{}{}
+	}
+
+	enabledUserIds := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		if _, ok := disabledSet[userId]; !ok {
+			enabledUserIds = append(enabledUserIds, userId)
+		}
+	}
+
+	if len(enabledUserIds) == 0 {
+		return nil
+	}
+
+	contacts, err := u.userAdapter.GetUserContacts(ctx, enabledUserIds)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get user contacts", err)
+	}
+
+	contactMap := make(map[string]*userContact, len(contacts))
+	emails := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		contactMap[contact.GetUserId()] = &userContact{username: contact.GetUsername(), email: contact.GetEmail()}
+		emails = append(emails, contact.GetEmail())
+	}
+
+	settings, err := u.notificationSettingRepository.FindByUserIds(ctx, u.db, enabledUserIds)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to find notification settings", err)
+	}
+
+	settingMap := make(map[string]*entity.NotificationSetting, len(settings))
+	for _, setting := range settings {
+		settingMap[setting.UserId] = setting
+	}
+
+	suppressedEmails, err := u.emailBounceRepository.FindSuppressedEmails(ctx, u.db, emails, emailSoftBounceLimit)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to find suppressed emails", err)
+	}
+
+	suppressedSet := make(map[string]struct{}, len(suppressedEmails))
+	for _, email := range suppressedEmails {
+		suppressedSet[email] = struct{}{}
+	}
+
+	failed := 0
+	for _, content := range contents {
+		contact, ok := contactMap[content.userId]
+		if !ok {
+			continue
+		}
+
+		setting, ok := settingMap[content.userId]
+		if !ok {
+			setting = defaultNotificationSetting(content.userId)
+		}
+
+		language := setting.Language
+		if language == "" {
+			language = defaultNotificationLanguage
+		}
+
+		location, err := time.LoadLocation(setting.Timezone)
+		if err != nil {
+			location = time.UTC
+		}
+
+		now := time.Now()
+		delivery := &entity.EmailDelivery{
+			Id:        ulid.Make().String(),
+			EventId:   eventID,
+			UserId:    content.userId,
+			Template:  content.template,
+			Language:  language,
+			Email:     contact.email,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		claimed, err := u.emailDeliveryRepository.Claim(ctx, u.db, delivery, emailMaxDeliveryAttempts)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to claim email delivery", err)
+		}
+
+		if !claimed {
+			continue
+		}
+
+		if _, ok := suppressedSet[contact.email]; ok {
+			delivery.Status = enum.EmailDeliveryStatusSuppressed
+			u.updateDelivery(ctx, delivery)
+			continue
+		}
+
+		message := &model.EmailMessage{
+			Id:       delivery.Id,
+			To:       contact.email,
+			Language: language,
+			Template: content.template,
+			Data:     content.data(contact.username, language, location),
+		}
+
+		if !u.send(ctx, delivery, message) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d emails of event %s", failed, eventID)
+	}
+
+	return nil
+}
+
+type userContact struct {
+	username string
+	email    string
+}
+
+// send records the outcome of the email, a refused address is counted as a bounce and reports false only when the
+// email may still be sent on a later attempt
+func (u *emailNotificationUseCase) send(ctx context.Context, delivery *entity.EmailDelivery, message *model.EmailMessage) bool {
+	err := u.emailAdapter.SendEmail(ctx, message)
+	delivery.UpdatedAt = time.Now()
+	if err == nil {
+		delivery.Status = enum.EmailDeliveryStatusSent
+		delivery.SentAt = sql.NullTime{Time: delivery.UpdatedAt, Valid: true}
+		u.updateDelivery(ctx, delivery)
+
+		if err := u.emailBounceRepository.DeleteSoftBounce(ctx, u.db, delivery.Email); err != nil {
+			u.logs.Error(fmt.Sprintf("[EMAIL] failed to reset soft bounces of delivery %s: %v", delivery.Id, err))
+		}
+		return true
+	}
+
+	u.logs.Error(fmt.Sprintf("[EMAIL] failed to send %s email of delivery %s: %v", delivery.Template, delivery.Id, err))
+	delivery.LastError = sql.NullString{String: err.Error(), Valid: true}
+
+	smtpErr := helper.ParseSMTPError(err)
+	bounceType := enum.EmailBounceTypeEnum("")
+	switch {
+	case smtpErr.IsHardBounce():
+		delivery.Status = enum.EmailDeliveryStatusBounced
+		bounceType = enum.EmailBounceTypeHard
+	case smtpErr.IsSoftBounce():
+		delivery.Status = enum.EmailDeliveryStatusFailed
+		bounceType = enum.EmailBounceTypeSoft
+	default:
+		delivery.Status = enum.EmailDeliveryStatusFailed
+	}
+	u.updateDelivery(ctx, delivery)
+
+	if bounceType != "" {
+		bounce := &entity.EmailBounce{
+			Email:         delivery.Email,
+			BounceType:    bounceType,
+			LastCode:      smtpErr.Code,
+			LastReason:    smtpErr.Message,
+			LastBouncedAt: delivery.UpdatedAt,
+		}
+		if err := u.emailBounceRepository.Upsert(ctx, u.db, bounce); err != nil {
+			u.logs.Error(fmt.Sprintf("[EMAIL] failed to store bounce of delivery %s: %v", delivery.Id, err))
+		}
+	}
+
+	return delivery.Status != enum.EmailDeliveryStatusFailed
+}
+
+// updateDelivery only logs a failed update, the email is already sent or refused at this point
+func (u *emailNotificationUseCase) updateDelivery(ctx context.Context, delivery *entity.EmailDelivery) {
+	if err := u.emailDeliveryRepository.UpdateStatus(ctx, u.db, delivery); err != nil {
+		u.logs.Error(fmt.Sprintf("[EMAIL] failed to update delivery %s: %v", delivery.Id, err))
+	}
+}
+
+// formatRupiah formats an amount the way it is written in Indonesia, for example Rp150.000
+func formatRupiah(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	var builder strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			builder.WriteByte('.')
+		}
+		builder.WriteRune(digit)
+	}
+
+	return sign + "Rp" + builder.String()
+}
+
+func formatEmailTime(t time.Time, language enum.LanguageEnum, location *time.Location) string {
+	local := t.In(location)
+	if language == enum.LanguageEnglish {
+		return local.Format("January 2, 2006 15:04 MST")
+	}
+
+	return fmt.Sprintf("%d %s %d %s", local.Day(), indonesianMonths[local.Month()-1], local.Year(), local.Format("15:04 MST"))
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+)
+
+type NotificationInboxUseCase interface {
+	GetNotifications(ctx context.Context, request *model.GetNotificationsRequest) ([]*model.NotificationResponse, *model.CursorMetadata, error)
+	CountUnread(ctx context.Context, userId string) (*model.UnreadNotificationCountResponse, error)
+	MarkRead(ctx context.Context, request *model.MarkNotificationsReadRequest) (*model.UnreadNotificationCountResponse, error)
+	MarkAllRead(ctx context.Context, userId string) (*model.UnreadNotificationCountResponse, error)
+	DeleteNotification(ctx context.Context, request *model.DeleteNotificationRequest) error
+	DeleteNotifications(ctx context.Context, userId string) error
+}
+
+type notificationInboxUseCase struct {
+	db                     repository.BeginTx
+	notificationRepository repository.NotificationRepository
+	logs                   logger.Log
+}
+
+func NewNotificationInboxUseCase(db repository.BeginTx, notificationRepository repository.NotificationRepository,
+	logs logger.Log) NotificationInboxUseCase {
+	return &notificationInboxUseCase{
+		db:                     db,
+		notificationRepository: notificationRepository,
+		logs:                   logs,
+	}
+}
+
+func (u *notificationInboxUseCase) GetNotifications(ctx context.Context, request *model.GetNotificationsRequest) ([]*model.NotificationResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	notifications, cursorMetadata, err := u.notificationRepository.FindByCursor(ctx, u.db, request.UserId, request.UnreadOnly, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find notifications", err)
+	}
+
+	return converter.NotificationsToResponses(notifications), cursorMetadata, nil
+}
+
+func (u *notificationInboxUseCase) CountUnread(ctx context.Context, userId string) (*model.UnreadNotificationCountResponse, error) {
+	count, err := u.notificationRepository.CountUnread(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count unread notifications", err)
+	}
+
+	return &model.UnreadNotificationCountResponse{UnreadCount: count}, nil
+}
+
+// MarkRead returns the unread count left so the app can update its badge without another request
+func (u *notificationInboxUseCase) MarkRead(ctx context.Context, request *model.MarkNotificationsReadRequest) (*model.UnreadNotificationCountResponse, error) {
+	if err := u.notificationRepository.MarkRead(ctx, u.db, request.UserId, request.NotificationIds, time.Now()); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to mark notifications as read", err)
+	}
+
+	return u.CountUnread(ctx, request.UserId)
+}
+
+func (u *notificationInboxUseCase) MarkAllRead(ctx context.Context, userId string) (*model.UnreadNotificationCountResponse, error) {
+	if err := u.notificationRepository.MarkAllRead(ctx, u.db, userId, time.Now()); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to mark all notifications as read", err)
+	}
+
+	return &model.UnreadNotificationCountResponse{UnreadCount: 0}, nil
+}
+
+func (u *notificationInboxUseCase) DeleteNotification(ctx context.Context, request *model.DeleteNotificationRequest) error {
+	deleted, err := u.notificationRepository.Delete(ctx, u.db, request.UserId, request.NotificationId)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete notification", err)
+	}
+
+	if !deleted {
+		return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Notification not found")
+	}
+
+	return nil
+}
+
+// DeleteNotifications removes the whole inbox of a user, used when the account is deleted
+func (u *notificationInboxUseCase) DeleteNotifications(ctx context.Context, userId string) error {
+	if err := u.notificationRepository.DeleteByUserID(ctx, u.db, userId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user notifications", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+)
+
+const (
+	defaultNotificationTimezone = "Asia/Jakarta"
+	defaultDigestWindowMinutes  = 60
+	defaultNotificationLanguage = enum.LanguageIndonesian
+)
+
+type NotificationPreferenceUseCase interface {
+	GetPreferences(ctx context.Context, userId string) (*model.NotificationPreferenceResponse, error)
+	UpdatePreferences(ctx context.Context, request *model.UpdateNotificationPreferenceRequest) (*model.NotificationPreferenceResponse, error)
+	DeletePreferences(ctx context.Context, userId string) error
+}
+
+type notificationPreferenceUseCase struct {
+	db                               repository.BeginTx
+	notificationPreferenceRepository repository.NotificationPreferenceRepository
+	notificationSettingRepository    repository.NotificationSettingRepository
+	logs                             logger.Log
+}
+
+func NewNotificationPreferenceUseCase(db repository.BeginTx, notificationPreferenceRepository repository.NotificationPreferenceRepository,
+	notificationSettingRepository repository.NotificationSettingRepository, logs logger.Log) NotificationPreferenceUseCase {
+	return &notificationPreferenceUseCase{
+		db:                               db,
+		notificationPreferenceRepository: notificationPreferenceRepository,
+		notificationSettingRepository:    notificationSettingRepository,
+		logs:                             logs,
+	}
+}
+
+func defaultNotificationSetting(userId string) *entity.NotificationSetting {
+	return &entity.NotificationSetting{
+		UserId:              userId,
+		Timezone:            defaultNotificationTimezone,
+		DigestWindowMinutes: defaultDigestWindowMinutes,
+		Language:            defaultNotificationLanguage,
+	}
+}
+
+func (u *notificationPreferenceUseCase) GetPreferences(ctx context.Context, userId string) (*model.NotificationPreferenceResponse, error) {
+	setting, err := u.notificationSettingRepository.FindByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find notification setting", err)
+	}
+
+	if setting == nil {
+		setting = defaultNotificationSetting(userId)
+	}
+
+	preferences, err := u.notificationPreferenceRepository.FindByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find notification preferences", err)
+	}
+
+	return converter.NotificationPreferenceToResponse(setting, preferences), nil
+}
+
+func (u *notificationPreferenceUseCase) UpdatePreferences(ctx context.Context, request *model.UpdateNotificationPreferenceRequest) (*model.NotificationPreferenceResponse, error) {
+	now := time.Now()
+	setting := &entity.NotificationSetting{
+		UserId:              request.UserId,
+		Timezone:            request.Timezone,
+		DigestEnabled:       request.DigestEnabled,
+		DigestWindowMinutes: request.DigestWindowMinutes,
+		Language:            request.Language,
+		UpdatedAt:           now,
+	}
+
+	if setting.Language == "" {
+		setting.Language = defaultNotificationLanguage
+	}
+
+	if request.QuietHoursStart != "" {
+		setting.QuietHoursStart = clockToMinutes(request.QuietHoursStart)
+		setting.QuietHoursEnd = clockToMinutes(request.QuietHoursEnd)
+	}
+
+	preferences := make([]*entity.NotificationPreference, 0, len(request.Categories)*3)
+	for _, category := range request.Categories {
+		channels := map[enum.NotificationChannelEnum]bool{
+			enum.NotificationChannelPush:  category.Push,
+			enum.NotificationChannelEmail: category.Email,
+			enum.NotificationChannelInApp: category.InApp,
+		}
+		for channel, enabled := range channels {
+			preferences = append(preferences, &entity.NotificationPreference{
+				UserId:    request.UserId,
+				Category:  category.Category,
+				Channel:   channel,
+				Enabled:   enabled,
+				UpdatedAt: now,
+			})
+		}
+	}
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if err := u.notificationSettingRepository.Upsert(ctx, tx, setting); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to upsert notification setting", err)
+		}
+
+		if err := u.notificationPreferenceRepository.UpsertBulk(ctx, tx, preferences); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to upsert notification preferences", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return u.GetPreferences(ctx, request.UserId)
+}
+
+// DeletePreferences removes the settings and preferences of a user, used when the account is deleted
+func (u *notificationPreferenceUseCase) DeletePreferences(ctx context.Context, userId string) error {
+	return repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if err := u.notificationSettingRepository.DeleteByUserId(ctx, tx, userId); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete notification setting", err)
+		}
+
+		if err := u.notificationPreferenceRepository.DeleteByUserId(ctx, tx, userId); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete notification preferences", err)
+		}
+		return nil
+	})
+}
+
+// clockToMinutes converts an already validated HH:MM into minutes of the day
+func clockToMinutes(clock string) sql.NullInt16 {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: int16(parsed.Hour()*60 + parsed.Minute()), Valid: true}
+}
@@ -2,87 +2,846 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 
 	"firebase.google.com/go/v4/messaging"
+	"github.com/bytedance/sonic"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/oklog/ulid/v2"
 
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
 	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
 
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	// creatorBatchCooldown is the minimum time between two new batch notifications of the same creator to a follower
+	creatorBatchCooldown = 6 * time.Hour
+	// creatorBatchDailyLimit caps the new batch notifications a follower gets per day across every followed creator
+	creatorBatchDailyLimit = 5
+	// digestDueKey is a sorted set of the users with a pending digest scored by the unix time the digest is due
+	digestDueKey = "notification_digest_due"
+	// digestFlushSize caps the digests sent per flush so one flush does not hold the worker for long
+	digestFlushSize = 1000
+	// pushClaimTTL keeps the push claim of an event and user longer than the event can be redelivered
+	pushClaimTTL = 7 * 24 * time.Hour
+)
+
 type NotificationUseCase interface {
-	ProcessAndSendBulkNotificationsV2(ctx context.Context, userCountMap map[string]int32) error
-	ProcessAndSendSingleFacecamNotifications(ctx context.Context, userID string, countPhotos int) error
-	ProcessAndSendSingleNotifications(ctx context.Context, userIDs []string) error
+	ProcessAndSendBulkNotificationsV2(ctx context.Context, bulkEvent *event.BulkPhotoEvent) error
+	ProcessAndSendSingleFacecamNotifications(ctx context.Context, facecamEvent *event.SingleFacecamEvent) error
+	ProcessAndSendSingleNotifications(ctx context.Context, photoEvent *event.SinglePhotoEvent) error
+	ProcessAndSendCreatorBatchNotifications(ctx context.Context, batchEvent *event.CreatorBatchPublishedEvent) error
+	ProcessAndSendTransactionSettledNotifications(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error
+	ProcessAndSendTransactionCanceledNotification(ctx context.Context, cancelEvent *event.CancelPhotosEvent) error
+	ProcessAndSendCreatorReviewNotification(ctx context.Context, reviewEvent *event.CreatorReviewCountEvent) error
+	ProcessAndSendWithdrawalStatusNotification(ctx context.Context, withdrawalEvent *event.WithdrawalStatusEvent) error
+	SendNotification(ctx context.Context, request *model.SendNotificationRequest) error
+	FlushDueDigests(ctx context.Context) error
+}
+
+// pushContent is the notification shown on the device and stored in the inbox, data is the deep link payload the app
+// uses to route the tap. Count is the number of photos added to the digest when the push is held back. Campaign id
+// and category group the delivery log of the push.
+type pushContent struct {
+	notificationID   string
+	campaignID       string
+	category         enum.NotificationCategoryEnum
+	title            string
+	body             string
+	notificationType enum.NotificationTypeEnum
+	count            int32
+	data             map[string]any
+}
+
+func similarPhotoContent(count int32, data map[string]any) *pushContent {
+	data["count"] = count
+	return &pushContent{
+		title:            "Foto Mirip Terdeteksi",
+		body:             fmt.Sprintf("Terdapat %d foto yang mirip dengan Anda!", count),
+		notificationType: enum.NotificationTypeSimilarPhoto,
+		count:            count,
+		data:             data,
+	}
+}
+
+func digestContent(count int) *pushContent {
+	return &pushContent{
+		category:         enum.NotificationCategoryNewMatch,
+		title:            "Ringkasan Foto Mirip",
+		body:             fmt.Sprintf("Anda muncul di %d foto baru!", count),
+		notificationType: enum.NotificationTypeSimilarPhoto,
+		count:            int32(count),
+		data: map[string]any{
+			"count":  count,
+			"digest": "true",
+		},
+	}
 }
 
 type notificationUseCase struct {
-	db                    repository.BeginTx
-	redisClient           *redis.Client
-	userDeviceRepository  repository.UserDeviceRepository
-	cloudMessagingAdapter adapter.CloudMessagingAdapter
+	db                               repository.BeginTx
+	redisClient                      *redis.Client
+	userDeviceRepository             repository.UserDeviceRepository
+	notificationRepository           repository.NotificationRepository
+	notificationPreferenceRepository repository.NotificationPreferenceRepository
+	notificationSettingRepository    repository.NotificationSettingRepository
+	pushDeliveryRepository           repository.PushDeliveryRepository
+	cloudMessagingAdapter            adapter.CloudMessagingAdapter
+	photoAdapter                     adapter.PhotoAdapter
 
 	logs logger.Log
 }
 
 func NewNotificationUseCase(db repository.BeginTx, redisClient *redis.Client, userDeviceRepository repository.UserDeviceRepository,
-	cloudMessagingAdapter adapter.CloudMessagingAdapter, logs logger.Log) NotificationUseCase {
+	notificationRepository repository.NotificationRepository, notificationPreferenceRepository repository.NotificationPreferenceRepository,
+	notificationSettingRepository repository.NotificationSettingRepository, pushDeliveryRepository repository.PushDeliveryRepository,
+	cloudMessagingAdapter adapter.CloudMessagingAdapter, photoAdapter adapter.PhotoAdapter, logs logger.Log) NotificationUseCase {
 	return &notificationUseCase{
-		db:                    db,
-		redisClient:           redisClient,
-		userDeviceRepository:  userDeviceRepository,
-		cloudMessagingAdapter: cloudMessagingAdapter,
-		logs:                  logs,
+		db:                               db,
+		redisClient:                      redisClient,
+		userDeviceRepository:             userDeviceRepository,
+		notificationRepository:           notificationRepository,
+		notificationPreferenceRepository: notificationPreferenceRepository,
+		notificationSettingRepository:    notificationSettingRepository,
+		pushDeliveryRepository:           pushDeliveryRepository,
+		cloudMessagingAdapter:            cloudMessagingAdapter,
+		photoAdapter:                     photoAdapter,
+		logs:                             logs,
+	}
+}
+
+func (u *notificationUseCase) ProcessAndSendSingleFacecamNotifications(ctx context.Context, facecamEvent *event.SingleFacecamEvent) error {
+	log.Println("[USER][NOTIFICATION USECASE]Process and send single facecam notification with userID and count", facecamEvent.UserID, facecamEvent.CountPhotos)
+
+	contents := map[string]*pushContent{
+		facecamEvent.UserID: similarPhotoContent(int32(facecamEvent.CountPhotos), map[string]any{
+			"photo_ids": facecamEvent.PhotoIDs,
+		}),
+	}
+
+	return u.deliver(ctx, facecamEvent.EventID, enum.NotificationCategoryFacecamMatch, contents, 1)
+}
+
+func (u *notificationUseCase) ProcessAndSendSingleNotifications(ctx context.Context, photoEvent *event.SinglePhotoEvent) error {
+	log.Println("[USER][NOTIFICATION USECASE]Process and send single notification with len", len(photoEvent.UserIDs))
+
+	contents := make(map[string]*pushContent, len(photoEvent.UserIDs))
+	for _, userID := range photoEvent.UserIDs {
+		contents[userID] = similarPhotoContent(1, map[string]any{
+			"photo_ids": []string{photoEvent.PhotoID},
+		})
 	}
+
+	return u.deliver(ctx, photoEvent.EventID, enum.NotificationCategoryNewMatch, contents, 10)
 }
 
-func (u *notificationUseCase) ProcessAndSendSingleFacecamNotifications(ctx context.Context, userID string, countPhotos int) error {
-	log.Println("[USER][NOTIFICATION USECASE]Process and send single facecam notification with userID and count", userID, countPhotos)
+// ProcessAndSendCreatorBatchNotifications tells followers that a creator published a new batch. Each follower gets
+// at most one notification per creator within creatorBatchCooldown and creatorBatchDailyLimit per day, the limits are
+// claimed before sending so a redelivered event does not notify the same follower twice. A failed delivery releases
+// the claims so the redelivered event can still reach the followers.
+func (u *notificationUseCase) ProcessAndSendCreatorBatchNotifications(ctx context.Context, batchEvent *event.CreatorBatchPublishedEvent) error {
+	u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Process and send creator batch notification for creator %s with %d followers",
+		batchEvent.CreatorId, len(batchEvent.FollowerUserIds)))
 
-	userAuthentications, err := u.fetchFCMTokens(ctx, []string{userID})
+	userIDs, err := u.claimCreatorBatchQuota(ctx, batchEvent.CreatorId, batchEvent.FollowerUserIds)
 	if err != nil {
-		log.Println("Error fetching tokens:", err)
 		return err
 	}
-	u.sendFCMWorkerPool(ctx, false, true, userAuthentications, nil, countPhotos, 1)
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	contents := make(map[string]*pushContent, len(userIDs))
+	for _, userID := range userIDs {
+		contents[userID] = &pushContent{
+			title:            "Foto Baru dari Kreator yang Anda Ikuti",
+			body:             fmt.Sprintf("Terdapat %d foto baru dari event terbaru. Mungkin ada Anda di dalamnya!", batchEvent.TotalPhoto),
+			notificationType: enum.NotificationTypeCreatorBatch,
+			data: map[string]any{
+				"creator_id":    batchEvent.CreatorId,
+				"bulk_photo_id": batchEvent.BulkPhotoId,
+			},
+		}
+	}
+
+	if err := u.deliver(ctx, batchEvent.EventID, enum.NotificationCategoryFollowing, contents, 10); err != nil {
+		u.releaseCreatorBatchQuota(ctx, batchEvent.CreatorId, userIDs)
+		return err
+	}
+
+	return nil
+}
+
+// ProcessAndSendTransactionSettledNotifications tells the buyer the payment succeeded and every creator of the bought
+// photos that they made a sale. The creators get their own event id so a creator buying own photos gets both pushes.
+func (u *notificationUseCase) ProcessAndSendTransactionSettledNotifications(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error {
+	u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Process and send transaction settled notification for transaction %s",
+		transactionEvent.TransactionId))
+
+	eventID := transactionEvent.EventID
+	if eventID == "" {
+		eventID = transactionEvent.TransactionId
+	}
+
+	buyerContents := map[string]*pushContent{
+		transactionEvent.UserId: {
+			title: "Pembayaran Berhasil",
+			body: fmt.Sprintf("Pembayaran %s untuk %d foto berhasil. Foto Anda sudah bisa diunduh!",
+				formatRupiah(int64(transactionEvent.Amount)), len(transactionEvent.PhotoIds)),
+			notificationType: enum.NotificationTypeTransaction,
+			data: map[string]any{
+				"transaction_id": transactionEvent.TransactionId,
+				"photo_ids":      transactionEvent.PhotoIds,
+				"status":         "SUCCESS",
+			},
+		},
+	}
+
+	outerError := u.deliver(ctx, eventID, enum.NotificationCategoryTransaction, buyerContents, 1)
+
+	creatorIds, creatorAmounts := creatorSaleAmounts(transactionEvent.CreatorSales)
+	if len(creatorIds) == 0 {
+		return outerError
+	}
+
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, creatorIds)
+	if err != nil {
+		return fmt.Errorf("failed to get creator user ids: %w", err)
+	}
+
+	creatorContents := make(map[string]*pushContent, len(creatorIds))
+	for _, creatorId := range creatorIds {
+		userID, ok := creatorUserIds[creatorId]
+		if !ok {
+			continue
+		}
+
+		creatorContents[userID] = &pushContent{
+			title:            "Foto Anda Terjual",
+			body:             fmt.Sprintf("Selamat! Foto Anda terjual seharga %s.", formatRupiah(creatorAmounts[creatorId])),
+			notificationType: enum.NotificationTypeCreatorSale,
+			data: map[string]any{
+				"transaction_id": transactionEvent.TransactionId,
+				"creator_id":     creatorId,
+			},
+		}
+	}
+
+	if err := u.deliver(ctx, eventID+":sale", enum.NotificationCategoryTransaction, creatorContents, 1); err != nil {
+		outerError = err
+	}
+
+	return outerError
+}
+
+// ProcessAndSendTransactionCanceledNotification tells the buyer the payment expired, failed or was canceled, a
+// transaction that failed before the buyer could pay has no status and is not notified
+func (u *notificationUseCase) ProcessAndSendTransactionCanceledNotification(ctx context.Context, cancelEvent *event.CancelPhotosEvent) error {
+	var title, body string
+	switch cancelEvent.Status {
+	case "EXPIRED":
+		title, body = "Pembayaran Kedaluwarsa", "Batas waktu pembayaran transaksi Anda telah habis. Silakan pilih ulang foto yang ingin dibeli."
+	case "FAILED":
+		title, body = "Pembayaran Gagal", "Pembayaran transaksi Anda gagal diproses. Silakan coba lagi."
+	case "CANCELED":
+		title, body = "Transaksi Dibatalkan", "Transaksi Anda telah dibatalkan dan foto yang dipilih sudah dilepas."
+	default:
+		return nil
+	}
+
+	u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Process and send transaction %s notification for transaction %s",
+		cancelEvent.Status, cancelEvent.TransactionId))
+
+	eventID := cancelEvent.EventID
+	if eventID == "" {
+		eventID = fmt.Sprintf("%s:%s", cancelEvent.TransactionId, cancelEvent.Status)
+	}
+
+	contents := map[string]*pushContent{
+		cancelEvent.UserId: {
+			title:            title,
+			body:             body,
+			notificationType: enum.NotificationTypeTransaction,
+			data: map[string]any{
+				"transaction_id": cancelEvent.TransactionId,
+				"status":         cancelEvent.Status,
+			},
+		},
+	}
+
+	return u.deliver(ctx, eventID, enum.NotificationCategoryTransaction, contents, 1)
+}
+
+func (u *notificationUseCase) ProcessAndSendCreatorReviewNotification(ctx context.Context, reviewEvent *event.CreatorReviewCountEvent) error {
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, []string{reviewEvent.Id})
+	if err != nil {
+		return fmt.Errorf("failed to get creator user ids: %w", err)
+	}
+
+	userID, ok := creatorUserIds[reviewEvent.Id]
+	if !ok {
+		u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] creator %s of review not found", reviewEvent.Id))
+		return nil
+	}
+
+	// the review count is unique per review of the creator so it stands in for events published without an id
+	eventID := reviewEvent.EventID
+	if eventID == "" {
+		eventID = fmt.Sprintf("%s:%d", reviewEvent.Id, reviewEvent.RatingCount)
+	}
+
+	contents := map[string]*pushContent{
+		userID: {
+			title: "Ulasan Baru",
+			body: fmt.Sprintf("Anda mendapat ulasan baru. Rating Anda sekarang %.1f dari %d ulasan.",
+				reviewEvent.Rating, reviewEvent.RatingCount),
+			notificationType: enum.NotificationTypeReview,
+			data: map[string]any{
+				"creator_id": reviewEvent.Id,
+			},
+		},
+	}
+
+	return u.deliver(ctx, eventID, enum.NotificationCategoryReview, contents, 1)
+}
+
+func (u *notificationUseCase) ProcessAndSendWithdrawalStatusNotification(ctx context.Context, withdrawalEvent *event.WithdrawalStatusEvent) error {
+	amount := formatRupiah(int64(withdrawalEvent.Amount))
+	var title, body string
+	switch withdrawalEvent.Status {
+	case "PENDING":
+		title, body = "Penarikan Dana Diproses", fmt.Sprintf("Permintaan penarikan dana sebesar %s sedang kami proses.", amount)
+	case "SUCCESS":
+		title, body = "Penarikan Dana Berhasil", fmt.Sprintf("Penarikan dana sebesar %s telah ditransfer ke rekening Anda.", amount)
+	case "FAILED":
+		title, body = "Penarikan Dana Ditolak", fmt.Sprintf("Penarikan dana sebesar %s ditolak. Saldo telah dikembalikan ke dompet Anda.", amount)
+	default:
+		return nil
+	}
+
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, []string{withdrawalEvent.CreatorId})
+	if err != nil {
+		return fmt.Errorf("failed to get creator user ids: %w", err)
+	}
+
+	userID, ok := creatorUserIds[withdrawalEvent.CreatorId]
+	if !ok {
+		u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] creator %s of withdrawal %s not found", withdrawalEvent.CreatorId,
+			withdrawalEvent.WithdrawalId))
+		return nil
+	}
+
+	contents := map[string]*pushContent{
+		userID: {
+			title:            title,
+			body:             body,
+			notificationType: enum.NotificationTypeWithdrawal,
+			data: map[string]any{
+				"withdrawal_id": withdrawalEvent.WithdrawalId,
+				"status":        withdrawalEvent.Status,
+			},
+		},
+	}
+
+	return u.deliver(ctx, withdrawalEvent.EventID, enum.NotificationCategoryTransaction, contents, 1)
+}
+
+// SendNotification pushes the same notification to every user, a request without an event id can not be deduplicated
+// so it gets a new one
+func (u *notificationUseCase) SendNotification(ctx context.Context, request *model.SendNotificationRequest) error {
+	if !slices.Contains(enum.NotificationCategories, request.Category) {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid notification category")
+	}
+
+	if request.Title == "" || request.Body == "" {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Notification title and body are required")
+	}
+
+	if len(request.UserIds) == 0 {
+		return nil
+	}
+
+	eventID := request.EventId
+	if eventID == "" {
+		eventID = ulid.Make().String()
+	}
+
+	notificationType := request.Type
+	if notificationType == "" {
+		notificationType = enum.NotificationTypeGeneral
+	}
+
+	contents := make(map[string]*pushContent, len(request.UserIds))
+	for _, userID := range request.UserIds {
+		data := make(map[string]any, len(request.Data))
+		for key, value := range request.Data {
+			data[key] = value
+		}
+
+		contents[userID] = &pushContent{
+			title:            request.Title,
+			body:             request.Body,
+			notificationType: notificationType,
+			data:             data,
+		}
+	}
+
+	if err := u.deliver(ctx, eventID, request.Category, contents, 10); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to deliver notification", err)
+	}
 
 	return nil
 }
 
-func (u *notificationUseCase) ProcessAndSendSingleNotifications(ctx context.Context, userIDs []string) error {
-	lenData := len(userIDs)
-	log.Println("[USER][NOTIFICATION USECASE]Process and send single notification with len", lenData)
+// creatorSaleAmounts sums the sales of each creator, the creator ids keep the order of the sales
+func creatorSaleAmounts(sales []*event.CreatorSaleEvent) ([]string, map[string]int64) {
+	creatorAmounts := make(map[string]int64, len(sales))
+	creatorIds := make([]string, 0, len(sales))
+	for _, sale := range sales {
+		if _, ok := creatorAmounts[sale.CreatorId]; !ok {
+			creatorIds = append(creatorIds, sale.CreatorId)
+		}
+		creatorAmounts[sale.CreatorId] += int64(sale.Amount)
+	}
+	return creatorIds, creatorAmounts
+}
 
+/* Deliver Notification Logic
+1. The users are divided base on batch size
+2. Every batch is stored to the inbox of the users that did not turn off in-app for the category, users that already
+   have the notification of this event are skipped
+3. The push of the event is claimed per user so a redelivered event does not push or add to the digest again, users
+   that turned off push for the category are dropped
+4. Users in digest mode or in their quiet hours get the notification added to their digest, non digestible categories
+   are not pushed during quiet hours and stay in the inbox only
+5. Fetch FCM tokens of the remaining users then send the push using the worker pool, the claims of the users not
+   pushed yet are released when a step fails so the redelivered event can push them
+*/
+
+func (u *notificationUseCase) deliver(ctx context.Context, eventID string, category enum.NotificationCategoryEnum,
+	contents map[string]*pushContent, workerCount int) error {
 	const batchSize = 5000
 
+	now := time.Now()
+	notifications := make([]*entity.Notification, 0, len(contents))
+	for userID, content := range contents {
+		data, err := sonic.ConfigFastest.Marshal(content.data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification data: %w", err)
+		}
+
+		content.notificationID = ulid.Make().String()
+		content.campaignID = eventID
+		content.category = category
+		notifications = append(notifications, &entity.Notification{
+			Id:        content.notificationID,
+			UserId:    userID,
+			EventId:   eventID,
+			Type:      content.notificationType,
+			Title:     content.title,
+			Body:      content.body,
+			Data:      types.JSONText(data),
+			CreatedAt: now,
+		})
+	}
+
 	var outerError error
-	for i := 0; i < lenData; i += batchSize {
-		end := min(i+batchSize, lenData)
-		batch := userIDs[i:end]
+	for i := 0; i < len(notifications); i += batchSize {
+		end := min(i+batchSize, len(notifications))
 
-		userAuthentications, err := u.fetchFCMTokens(ctx, batch)
+		if err := u.storeInbox(ctx, category, notifications[i:end]); err != nil {
+			outerError = fmt.Errorf("failed to store inbox notifications: %w", err)
+			u.logs.Log(outerError.Error())
+			continue
+		}
+
+		claimedUserIDs, err := u.claimPushes(ctx, eventID, notifications[i:end])
+		if err != nil {
+			outerError = err
+			u.logs.Log(outerError.Error())
+			continue
+		}
+
+		if len(claimedUserIDs) == 0 {
+			continue
+		}
+
+		userIDs, err := u.applyPushPreferences(ctx, category, claimedUserIDs, contents)
+		if err != nil {
+			outerError = fmt.Errorf("failed to apply push preferences: %w", err)
+			u.logs.Log(outerError.Error())
+			u.releasePushes(ctx, eventID, claimedUserIDs)
+			continue
+		}
+
+		if len(userIDs) == 0 {
+			continue
+		}
+
+		userAuthentications, err := u.fetchFCMTokens(ctx, userIDs)
 		if err != nil {
 			outerError = err
 			log.Println("Error fetching tokens:", err)
+			u.releasePushes(ctx, eventID, userIDs)
 			continue
 		}
-		u.sendFCMWorkerPool(ctx, false, false, userAuthentications, nil, 0, 10)
+		u.sendFCMWorkerPool(ctx, userAuthentications, func(userID string) *pushContent {
+			return contents[userID]
+		}, workerCount)
 	}
 
-	if outerError != nil {
-		return outerError
+	return outerError
+}
+
+// storeInbox skips the users that turned off in-app for the category
+func (u *notificationUseCase) storeInbox(ctx context.Context, category enum.NotificationCategoryEnum, notifications []*entity.Notification) error {
+	userIDs := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		userIDs = append(userIDs, notification.UserId)
+	}
+
+	disabledUserIDs, err := u.notificationPreferenceRepository.FindDisabledUserIds(ctx, u.db, userIDs, category, enum.NotificationChannelInApp)
+	if err != nil {
+		return err
+	}
+
+	disabledSet := make(map[string]struct{}, len(disabledUserIDs))
+	for _, userID := range disabledUserIDs {
+		disabledSet[userID] = struct{}{}
+	}
+
+	storedNotifications := make([]*entity.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		if _, ok := disabledSet[notification.UserId]; !ok {
+			storedNotifications = append(storedNotifications, notification)
+		}
+	}
+
+	if _, err := u.notificationRepository.InsertBulk(ctx, u.db, storedNotifications); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// claimPushes returns the users whose push of the event was not claimed yet. The claim covers the users without an
+// inbox entry as well, so whether the inbox row was inserted does not decide the push.
+func (u *notificationUseCase) claimPushes(ctx context.Context, eventID string, notifications []*entity.Notification) ([]string, error) {
+	pipe := u.redisClient.Pipeline()
+	claimCmds := make([]*redis.BoolCmd, len(notifications))
+	for i, notification := range notifications {
+		claimCmds[i] = pipe.SetNX(ctx, pushClaimKey(eventID, notification.UserId), 1, pushClaimTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("redis push claim error: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(notifications))
+	for i, cmd := range claimCmds {
+		if cmd.Val() {
+			userIDs = append(userIDs, notifications[i].UserId)
+		}
 	}
+	return userIDs, nil
+}
+
+// releasePushes gives back the push claims of users that were not pushed, a failure is only logged and the users
+// miss the push of the redelivered event
+func (u *notificationUseCase) releasePushes(ctx context.Context, eventID string, userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		keys = append(keys, pushClaimKey(eventID, userID))
+	}
+	if err := u.redisClient.Del(ctx, keys...).Err(); err != nil {
+		u.logs.Log(fmt.Sprintf("[PUSH CLAIM] failed to release %d push claims of event=%s: %v", len(keys), eventID, err))
+	}
+}
+
+func pushClaimKey(eventID, userID string) string {
+	return fmt.Sprintf("notification_pushed:%s:%s", eventID, userID)
+}
+
+// applyPushPreferences returns the users to push right away, the held back pushes are added to the digest
+func (u *notificationUseCase) applyPushPreferences(ctx context.Context, category enum.NotificationCategoryEnum, userIDs []string,
+	contents map[string]*pushContent) ([]string, error) {
+	disabledUserIDs, err := u.notificationPreferenceRepository.FindDisabledUserIds(ctx, u.db, userIDs, category, enum.NotificationChannelPush)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledSet := make(map[string]struct{}, len(disabledUserIDs))
+	for _, userID := range disabledUserIDs {
+		disabledSet[userID] = struct{}{}
+	}
+
+	enabledUserIDs := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if _, ok := disabledSet[userID]; !ok {
+			enabledUserIDs = append(enabledUserIDs, userID)
+		}
+	}
+
+	settings, err := u.notificationSettingRepository.FindByUserIds(ctx, u.db, enabledUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	settingMap := make(map[string]*entity.NotificationSetting, len(settings))
+	for _, setting := range settings {
+		settingMap[setting.UserId] = setting
+	}
+
+	now := time.Now()
+	pushUserIDs := make([]string, 0, len(enabledUserIDs))
+	digestDueMap := make(map[string]time.Time)
+	for _, userID := range enabledUserIDs {
+		setting, ok := settingMap[userID]
+		if !ok {
+			pushUserIDs = append(pushUserIDs, userID)
+			continue
+		}
+
+		quiet, quietEnd := quietHours(setting, now)
+		switch {
+		case category.IsDigestible() && setting.DigestEnabled:
+			digestDueMap[userID] = now.Add(time.Duration(setting.DigestWindowMinutes) * time.Minute)
+		case category.IsDigestible() && quiet:
+			digestDueMap[userID] = quietEnd
+		case quiet:
+			continue
+		default:
+			pushUserIDs = append(pushUserIDs, userID)
+		}
+	}
+
+	if len(digestDueMap) == 0 {
+		return pushUserIDs, nil
+	}
+
+	if err := u.addToDigest(ctx, digestDueMap, contents); err != nil {
+		u.logs.Log(fmt.Sprintf("[DIGEST] failed to add %d users to digest, sending right away: %v", len(digestDueMap), err))
+		for userID := range digestDueMap {
+			pushUserIDs = append(pushUserIDs, userID)
+		}
+	}
+
+	return pushUserIDs, nil
+}
+
+// addToDigest keeps the due time of a digest already pending so the window starts at the first held back push
+func (u *notificationUseCase) addToDigest(ctx context.Context, digestDueMap map[string]time.Time, contents map[string]*pushContent) error {
+	pipe := u.redisClient.Pipeline()
+	for userID, due := range digestDueMap {
+		pipe.HIncrBy(ctx, fmt.Sprintf("notification_digest:%s", userID), "count", int64(max(contents[userID].count, 1)))
+		pipe.ZAddNX(ctx, digestDueKey, redis.Z{Score: float64(due.Unix()), Member: userID})
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis digest error: %w", err)
+	}
+	return nil
+}
+
+// FlushDueDigests sends one summary push per due digest. Removing the user from the due set claims the digest so
+// several workers can flush at the same time, a digest that became due in quiet hours is moved to the end of them.
+func (u *notificationUseCase) FlushDueDigests(ctx context.Context) error {
+	now := time.Now()
+	userIDs, err := u.redisClient.ZRangeByScore(ctx, digestDueKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: digestFlushSize,
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("redis digest due error: %w", err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	settings, err := u.notificationSettingRepository.FindByUserIds(ctx, u.db, userIDs)
+	if err != nil {
+		return fmt.Errorf("failed to find notification settings: %w", err)
+	}
+
+	settingMap := make(map[string]*entity.NotificationSetting, len(settings))
+	for _, setting := range settings {
+		settingMap[setting.UserId] = setting
+	}
+
+	campaignID := "digest:" + now.Format("2006-01-02")
+	contents := make(map[string]*pushContent, len(userIDs))
+	for _, userID := range userIDs {
+		if setting, ok := settingMap[userID]; ok {
+			if quiet, quietEnd := quietHours(setting, now); quiet {
+				if err := u.redisClient.ZAdd(ctx, digestDueKey, redis.Z{Score: float64(quietEnd.Unix()), Member: userID}).Err(); err != nil {
+					u.logs.Log(fmt.Sprintf("[DIGEST] failed to postpone digest of userID=%s: %v", userID, err))
+				}
+				continue
+			}
+		}
+
+		removed, err := u.redisClient.ZRem(ctx, digestDueKey, userID).Result()
+		if err != nil {
+			u.logs.Log(fmt.Sprintf("[DIGEST] failed to claim digest of userID=%s: %v", userID, err))
+			continue
+		}
+
+		if removed == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("notification_digest:%s", userID)
+		pipe := u.redisClient.TxPipeline()
+		countCmd := pipe.HGet(ctx, key, "count")
+		pipe.Del(ctx, key)
+		if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+			u.logs.Log(fmt.Sprintf("[DIGEST] failed to read digest of userID=%s: %v", userID, err))
+			continue
+		}
+
+		if count, _ := countCmd.Int(); count > 0 {
+			content := digestContent(count)
+			content.campaignID = campaignID
+			contents[userID] = content
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+
+	digestUserIDs := make([]string, 0, len(contents))
+	for userID := range contents {
+		digestUserIDs = append(digestUserIDs, userID)
+	}
+
+	userAuthentications, err := u.fetchFCMTokens(ctx, digestUserIDs)
+	if err != nil {
+		return err
+	}
+	u.sendFCMWorkerPool(ctx, userAuthentications, func(userID string) *pushContent {
+		return contents[userID]
+	}, 10)
+
+	u.logs.Log(fmt.Sprintf("[DIGEST] flushed %d digests", len(contents)))
 	return nil
 }
 
+// quietHours reports whether now is within the quiet hours of the user and when they end
+func quietHours(setting *entity.NotificationSetting, now time.Time) (bool, time.Time) {
+	if !setting.QuietHoursStart.Valid || !setting.QuietHoursEnd.Valid {
+		return false, time.Time{}
+	}
+
+	location, err := time.LoadLocation(setting.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	start, end := int(setting.QuietHoursStart.Int16), int(setting.QuietHoursEnd.Int16)
+
+	quiet := minute >= start && minute < end
+	if start > end {
+		quiet = minute >= start || minute < end
+	}
+
+	if !quiet {
+		return false, time.Time{}
+	}
+
+	quietEnd := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, location)
+	if !quietEnd.After(local) {
+		quietEnd = quietEnd.AddDate(0, 0, 1)
+	}
+	return true, quietEnd
+}
+
+// claimCreatorBatchQuota returns the followers still allowed to get a notification from the creator
+func (u *notificationUseCase) claimCreatorBatchQuota(ctx context.Context, creatorID string, userIDs []string) ([]string, error) {
+	day := time.Now().Format("2006-01-02")
+
+	pipe := u.redisClient.Pipeline()
+	cooldownCmds := make([]*redis.BoolCmd, len(userIDs))
+	for i, userID := range userIDs {
+		key := fmt.Sprintf("creator_batch_notified:%s:%s", userID, creatorID)
+		cooldownCmds[i] = pipe.SetNX(ctx, key, 1, creatorBatchCooldown)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("redis creator batch cooldown error: %w", err)
+	}
+
+	cooledUserIDs := make([]string, 0, len(userIDs))
+	for i, cmd := range cooldownCmds {
+		if cmd.Val() {
+			cooledUserIDs = append(cooledUserIDs, userIDs[i])
+		}
+	}
+
+	if len(cooledUserIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe = u.redisClient.Pipeline()
+	dailyCmds := make([]*redis.IntCmd, len(cooledUserIDs))
+	for i, userID := range cooledUserIDs {
+		key := fmt.Sprintf("creator_batch_daily:%s:%s", userID, day)
+		dailyCmds[i] = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, 24*time.Hour)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("redis creator batch daily limit error: %w", err)
+	}
+
+	allowedUserIDs := make([]string, 0, len(cooledUserIDs))
+	for i, cmd := range dailyCmds {
+		if cmd.Val() <= creatorBatchDailyLimit {
+			allowedUserIDs = append(allowedUserIDs, cooledUserIDs[i])
+		}
+	}
+
+	u.logs.Log(fmt.Sprintf("[CREATOR BATCH] creator=%s followers=%d allowed=%d", creatorID, len(userIDs), len(allowedUserIDs)))
+	return allowedUserIDs, nil
+}
+
+// releaseCreatorBatchQuota gives back the quota claimed by claimCreatorBatchQuota, a failure is only logged since
+// the claims expire on their own
+func (u *notificationUseCase) releaseCreatorBatchQuota(ctx context.Context, creatorID string, userIDs []string) {
+	day := time.Now().Format("2006-01-02")
+
+	pipe := u.redisClient.Pipeline()
+	for _, userID := range userIDs {
+		pipe.Del(ctx, fmt.Sprintf("creator_batch_notified:%s:%s", userID, creatorID))
+		pipe.Decr(ctx, fmt.Sprintf("creator_batch_daily:%s:%s", userID, day))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		u.logs.Log(fmt.Sprintf("[CREATOR BATCH] failed to release quota of creator=%s for %d followers: %v", creatorID, len(userIDs), err))
+	}
+}
+
 func (u *notificationUseCase) alertAdminFCMAuthIssue(userID string, err *helper.ErrorFCM) {
 	u.logs.Log(fmt.Sprintf("[ALERT] Admin needs to investigate auth issue for userID=%s: %s (%s)", userID, err.Code, err.Details))
 	// Bisa kirim ke Sentry, Email, atau channel Discord internal kamu
@@ -174,35 +933,23 @@ func (u *notificationUseCase) fetchFCMTokens(ctx context.Context, userIDs []stri
 13. Done
 */
 
-func (u *notificationUseCase) ProcessAndSendBulkNotificationsV2(ctx context.Context, userCountMap map[string]int32) error {
-	u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Process and send bulk notification with userCountMap: %v", userCountMap))
-	lenCountMap := len(userCountMap)
-	userIDs := make([]string, 0, lenCountMap)
-	for idx := range userCountMap {
-		u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Processing userID: %s with count: %d", idx, userCountMap[idx]))
-		userIDs = append(userIDs, idx)
-	}
-
-	const batchSize = 5000
-
-	var outerError error
-	for i := 0; i < lenCountMap; i += batchSize {
-		end := min(i+batchSize, lenCountMap)
-		batch := userIDs[i:end]
+func (u *notificationUseCase) ProcessAndSendBulkNotificationsV2(ctx context.Context, bulkEvent *event.BulkPhotoEvent) error {
+	u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Process and send bulk notification with userCountMap: %v", bulkEvent.UserCountMap))
 
-		userAuthentications, err := u.fetchFCMTokens(ctx, batch)
-		if err != nil {
-			outerError = err
-			log.Println("Error fetching tokens:", err)
+	contents := make(map[string]*pushContent, len(bulkEvent.UserCountMap))
+	for userID, count := range bulkEvent.UserCountMap {
+		u.logs.Log(fmt.Sprintf("[USER][NOTIFICATION USECASE] Processing userID: %s with count: %d", userID, count))
+		if count == 0 {
 			continue
 		}
-		u.sendFCMWorkerPool(ctx, true, false, userAuthentications, userCountMap, 0, 10)
-	}
 
-	if outerError != nil {
-		return outerError
+		contents[userID] = similarPhotoContent(count, map[string]any{
+			"bulk_photo_id": bulkEvent.BulkPhotoID,
+			"photo_ids":     bulkEvent.UserPhotoIDsMap[userID],
+		})
 	}
-	return nil
+
+	return u.deliver(ctx, bulkEvent.EventID, enum.NotificationCategoryNewMatch, contents, 10)
 }
 
 func (u *notificationUseCase) removeUserToken(ctx context.Context, userID, token string) error {
@@ -227,17 +974,37 @@ func (u *notificationUseCase) removeUserToken(ctx context.Context, userID, token
 	return nil
 }
 
-func (u *notificationUseCase) sendMulticast(ctx context.Context, userID string, tokens []string, message string) error {
+// sendMulticast records the result of every token, an invalid token is pruned while other token errors keep the token
+// for the next push
+func (u *notificationUseCase) sendMulticast(ctx context.Context, pushID, userID string, tokens []string, content *pushContent) error {
+	data := map[string]string{
+		"type":    string(content.notificationType),
+		"message": content.body,
+		"push_id": pushID,
+	}
+	if content.notificationID != "" {
+		data["notification_id"] = content.notificationID
+	}
+	for key, value := range content.data {
+		if str, ok := value.(string); ok {
+			data[key] = str
+			continue
+		}
+
+		encoded, err := sonic.ConfigFastest.MarshalToString(value)
+		if err != nil {
+			return err
+		}
+		data[key] = encoded
+	}
+
 	msg := &messaging.MulticastMessage{
 		Tokens: tokens,
 		Notification: &messaging.Notification{
-			Title: "Foto Mirip Terdeteksi",
-			Body:  message,
-		},
-		Data: map[string]string{
-			"type":    "similar_photo",
-			"message": message,
+			Title: content.title,
+			Body:  content.body,
 		},
+		Data: data,
 	}
 
 	res, err := u.cloudMessagingAdapter.SendEachForMulticast(ctx, msg)
@@ -245,33 +1012,45 @@ func (u *notificationUseCase) sendMulticast(ctx context.Context, userID string,
 		return err
 	}
 
+	now := time.Now()
+	deliveries := make([]*entity.PushDelivery, 0, len(res.Responses))
 	for i, r := range res.Responses {
-		if !r.Success {
-			token := tokens[i]
-			u.logs.Log(fmt.Sprintf("[MULTICAST][CHECKER] userID=%s, token=%s, raw=%v", userID, token, r.Error))
-
-			fcmErr := helper.ParseFCMError(r.Error)
-
-			if fcmErr.IsInvalidToken() {
-				u.logs.Log(fmt.Sprintf("[MULTICAST][INVALID] userID=%s, token=%s, code=%s raw = %s", userID, token, fcmErr.Code, fcmErr.Raw))
-				_ = u.removeUserToken(ctx, userID, token)
-			} else {
-				u.logs.Log(fmt.Sprintf("[MULTICAST][FAIL] userID=%s, token=%s, code=%s, detail=%s", userID, token, fcmErr.Code, fcmErr.Details))
-				_ = u.removeUserToken(ctx, userID, token)
-			}
+		token := tokens[i]
+		if r.Success {
+			deliveries = append(deliveries, newPushDelivery(pushID, userID, token, content, enum.PushDeliveryStatusSent, "", now))
+			continue
+		}
+
+		u.logs.Log(fmt.Sprintf("[MULTICAST][CHECKER] userID=%s, token=%s, raw=%v", userID, token, r.Error))
+
+		fcmErr := helper.ParseFCMError(r.Error)
+
+		if fcmErr.IsInvalidToken() {
+			u.logs.Log(fmt.Sprintf("[MULTICAST][INVALID] userID=%s, token=%s, code=%s raw = %s", userID, token, fcmErr.Code, fcmErr.Raw))
+			_ = u.removeUserToken(ctx, userID, token)
+			deliveries = append(deliveries, newPushDelivery(pushID, userID, token, content, enum.PushDeliveryStatusPruned, fcmErr.Code, now))
+		} else {
+			u.logs.Log(fmt.Sprintf("[MULTICAST][FAIL] userID=%s, token=%s, code=%s, detail=%s", userID, token, fcmErr.Code, fcmErr.Details))
+			deliveries = append(deliveries, newPushDelivery(pushID, userID, token, content, enum.PushDeliveryStatusFailed, fcmErr.Code, now))
 		}
 	}
 
+	u.recordPushDeliveries(ctx, userID, deliveries)
+
 	u.logs.Log(fmt.Sprintf("✅ Sent multicast to userID=%s, success=%d, failed=%d", userID, res.SuccessCount, res.FailureCount))
 	return nil
 }
 
-func (u *notificationUseCase) sendFCMMulticastWithRetry(ctx context.Context, userID string, tokens []string, message string) error {
+// sendFCMMulticastWithRetry keeps one push id across the retries so the app can acknowledge the push it got, every
+// token is logged as failed when the multicast itself could not be sent
+func (u *notificationUseCase) sendFCMMulticastWithRetry(ctx context.Context, userID string, tokens []string, content *pushContent) error {
 	const maxRetries = 3
 	backoff := 500 * time.Millisecond
 
+	pushID := ulid.Make().String()
+	sendErr := fmt.Errorf("failed multicast after retries for userID=%s", userID)
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		err := u.sendMulticast(ctx, userID, tokens, message)
+		err := u.sendMulticast(ctx, pushID, userID, tokens, content)
 		if err == nil {
 			return nil
 		}
@@ -287,17 +1066,51 @@ func (u *notificationUseCase) sendFCMMulticastWithRetry(ctx context.Context, use
 		if fcmErr.IsAuthError() {
 			u.logs.Log(fmt.Sprintf("[AUTH ERROR] userID=%s, code=%s → admin action required", userID, fcmErr.Code))
 			u.alertAdminFCMAuthIssue(userID, fcmErr)
-			return fmt.Errorf("auth error sending FCM to userID=%s: %w", userID, err)
+			sendErr = fmt.Errorf("auth error sending FCM to userID=%s: %w", userID, err)
+		} else {
+			u.logs.Log(fmt.Sprintf("[MULTICAST][FATAL ERROR] userID=%s, err=%v", userID, err))
+			sendErr = err
 		}
+		break
+	}
 
-		u.logs.Log(fmt.Sprintf("[MULTICAST][FATAL ERROR] userID=%s, err=%v", userID, err))
-		return err
+	errorCode := helper.ParseFCMError(sendErr).Code
+	now := time.Now()
+	deliveries := make([]*entity.PushDelivery, 0, len(tokens))
+	for _, token := range tokens {
+		deliveries = append(deliveries, newPushDelivery(pushID, userID, token, content, enum.PushDeliveryStatusFailed, errorCode, now))
 	}
+	u.recordPushDeliveries(ctx, userID, deliveries)
 
-	return fmt.Errorf("failed multicast after retries for userID=%s", userID)
+	return sendErr
 }
 
-func (u *notificationUseCase) sendFCMWorkerPool(ctx context.Context, isBulk bool, isSingleFacecam bool, userAuthentications *[]*entity.UserDevice, countMap map[string]int32, countPhotos int, workerCount int) {
+func newPushDelivery(pushID, userID, token string, content *pushContent, status enum.PushDeliveryStatusEnum, errorCode string,
+	now time.Time) *entity.PushDelivery {
+	return &entity.PushDelivery{
+		Id:             ulid.Make().String(),
+		PushId:         pushID,
+		CampaignId:     content.campaignID,
+		Category:       content.category,
+		Type:           content.notificationType,
+		NotificationId: sql.NullString{String: content.notificationID, Valid: content.notificationID != ""},
+		UserId:         userID,
+		Token:          token,
+		Status:         status,
+		ErrorCode:      sql.NullString{String: errorCode, Valid: errorCode != ""},
+		CreatedAt:      now,
+	}
+}
+
+// recordPushDeliveries only logs a failure, the push already reached the device and must not be sent again
+func (u *notificationUseCase) recordPushDeliveries(ctx context.Context, userID string, deliveries []*entity.PushDelivery) {
+	if err := u.pushDeliveryRepository.InsertBulk(ctx, u.db, deliveries); err != nil {
+		u.logs.Log(fmt.Sprintf("[PUSH DELIVERY] failed to record %d deliveries of userID=%s: %v", len(deliveries), userID, err))
+	}
+}
+
+// sendFCMWorkerPool sends the content built by contentFor to every user, a nil content skips the user
+func (u *notificationUseCase) sendFCMWorkerPool(ctx context.Context, userAuthentications *[]*entity.UserDevice, contentFor func(userID string) *pushContent, workerCount int) {
 	// 1. Group tokens per userID
 	userTokensMap := make(map[string][]string)
 	for _, ua := range *userAuthentications {
@@ -322,24 +1135,12 @@ func (u *notificationUseCase) sendFCMWorkerPool(ctx context.Context, isBulk bool
 					}
 
 					tokens := userTokensMap[userID]
-					var count int32 = 1
-					if isBulk {
-						count = countMap[userID]
-						if count == 0 {
-							continue
-						}
-					}
-
-					if isSingleFacecam {
-						count = int32(countPhotos)
-					}
-
-					if len(tokens) == 0 {
+					content := contentFor(userID)
+					if content == nil || len(tokens) == 0 {
 						continue
 					}
 
-					message := fmt.Sprintf("Terdapat %d foto yang mirip dengan Anda!", count)
-					if err := u.sendFCMMulticastWithRetry(ctx, userID, tokens, message); err != nil {
+					if err := u.sendFCMMulticastWithRetry(ctx, userID, tokens, content); err != nil {
 						u.logs.Log(fmt.Sprintf("[Worker %d] ❌ Failed send to userID=%s: %v", workerID, userID, err))
 					}
 				}
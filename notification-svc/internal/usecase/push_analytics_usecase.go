@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+)
+
+// pushConversionWindow is how long after opening a push a purchase of the user is credited to it
+const pushConversionWindow = 72 * time.Hour
+
+type PushAnalyticsUseCase interface {
+	AcknowledgePush(ctx context.Context, request *model.AcknowledgePushRequest) error
+	AttributePurchase(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error
+	GetCampaignMetrics(ctx context.Context, request *model.GetPushCampaignMetricsRequest) ([]*model.PushCampaignMetricResponse, error)
+	GetCampaignMetric(ctx context.Context, request *model.GetPushCampaignMetricRequest) (*model.PushCampaignMetricResponse, error)
+	DeletePushDeliveries(ctx context.Context, userId string) error
+}
+
+type pushAnalyticsUseCase struct {
+	db                     repository.BeginTx
+	pushDeliveryRepository repository.PushDeliveryRepository
+	logs                   logger.Log
+}
+
+func NewPushAnalyticsUseCase(db repository.BeginTx, pushDeliveryRepository repository.PushDeliveryRepository,
+	logs logger.Log) PushAnalyticsUseCase {
+	return &pushAnalyticsUseCase{
+		db:                     db,
+		pushDeliveryRepository: pushDeliveryRepository,
+		logs:                   logs,
+	}
+}
+
+// AcknowledgePush records that the user opened or tapped a push, acknowledging it again keeps the first time
+func (u *pushAnalyticsUseCase) AcknowledgePush(ctx context.Context, request *model.AcknowledgePushRequest) error {
+	found, err := u.pushDeliveryRepository.MarkInteraction(ctx, u.db, request.UserId, request.PushId, request.Token,
+		request.Action, time.Now())
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to mark push interaction", err)
+	}
+
+	if !found {
+		return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Push not found")
+	}
+
+	return nil
+}
+
+// AttributePurchase credits a settled transaction to the last push the buyer opened within the conversion window, a
+// redelivered event does not credit the transaction twice
+func (u *pushAnalyticsUseCase) AttributePurchase(ctx context.Context, transactionEvent *event.OwnerOwnPhotosEvent) error {
+	if transactionEvent.UserId == "" || transactionEvent.TransactionId == "" {
+		return nil
+	}
+
+	convertedAt := time.Now()
+	if transactionEvent.SettledAt != nil {
+		convertedAt = *transactionEvent.SettledAt
+	}
+
+	converted, err := u.pushDeliveryRepository.AttributeConversion(ctx, u.db, transactionEvent.UserId, transactionEvent.TransactionId,
+		int64(transactionEvent.Amount), convertedAt, convertedAt.Add(-pushConversionWindow))
+	if err != nil {
+		return fmt.Errorf("failed to attribute purchase to push: %w", err)
+	}
+
+	if converted {
+		u.logs.Log(fmt.Sprintf("[PUSH CONVERSION] transaction=%s credited to a push of userID=%s", transactionEvent.TransactionId,
+			transactionEvent.UserId))
+	}
+	return nil
+}
+
+func (u *pushAnalyticsUseCase) GetCampaignMetrics(ctx context.Context, request *model.GetPushCampaignMetricsRequest) ([]*model.PushCampaignMetricResponse, error) {
+	since := time.Now().AddDate(0, 0, -request.Days)
+	metrics, err := u.pushDeliveryRepository.FindCampaignMetrics(ctx, u.db, request.Category, since, request.Size)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find push campaign metrics", err)
+	}
+
+	return converter.PushCampaignMetricsToResponses(metrics), nil
+}
+
+func (u *pushAnalyticsUseCase) GetCampaignMetric(ctx context.Context, request *model.GetPushCampaignMetricRequest) (*model.PushCampaignMetricResponse, error) {
+	metric, err := u.pushDeliveryRepository.FindCampaignMetric(ctx, u.db, request.CampaignId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Push campaign not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find push campaign metric", err)
+	}
+
+	return converter.PushCampaignMetricToResponse(metric), nil
+}
+
+// DeletePushDeliveries removes the delivery log of a user, used when the account is deleted
+func (u *pushAnalyticsUseCase) DeletePushDeliveries(ctx context.Context, userId string) error {
+	if err := u.pushDeliveryRepository.DeleteByUserId(ctx, u.db, userId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user push deliveries", err)
+	}
+
+	return nil
+}
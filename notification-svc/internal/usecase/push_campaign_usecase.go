@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/repository"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	defaultCampaignBatchSize    = 1000
+	defaultCampaignInactiveDays = 30
+	// campaignBatchInterval throttles a campaign to one batch per interval
+	campaignBatchInterval = time.Minute
+	// campaignClaimLease is how long a claimed campaign is skipped by other workers, a batch left unfinished by a
+	// crashed worker is sent again after it
+	campaignClaimLease = 5 * time.Minute
+	// campaignAudiencePage is the page size asked to user-svc and photo-svc, a batch may take several pages
+	campaignAudiencePage = 1000
+)
+
+type PushCampaignUseCase interface {
+	CreateCampaign(ctx context.Context, request *model.CreatePushCampaignRequest) (*model.PushCampaignResponse, error)
+	GetCampaigns(ctx context.Context, request *model.GetPushCampaignsRequest) ([]*model.PushCampaignResponse, *model.CursorMetadata, error)
+	GetCampaign(ctx context.Context, request *model.GetPushCampaignRequest) (*model.PushCampaignResponse, error)
+	CancelCampaign(ctx context.Context, request *model.CancelPushCampaignRequest) (*model.PushCampaignResponse, error)
+	ProcessDueCampaigns(ctx context.Context) error
+}
+
+type pushCampaignUseCase struct {
+	db                     repository.BeginTx
+	pushCampaignRepository repository.PushCampaignRepository
+	pushDeliveryRepository repository.PushDeliveryRepository
+	notificationUseCase    NotificationUseCase
+	userAdapter            adapter.UserAdapter
+	photoAdapter           adapter.PhotoAdapter
+	logs                   logger.Log
+}
+
+func NewPushCampaignUseCase(db repository.BeginTx, pushCampaignRepository repository.PushCampaignRepository,
+	pushDeliveryRepository repository.PushDeliveryRepository, notificationUseCase NotificationUseCase,
+	userAdapter adapter.UserAdapter, photoAdapter adapter.PhotoAdapter, logs logger.Log) PushCampaignUseCase {
+	return &pushCampaignUseCase{
+		db:                     db,
+		pushCampaignRepository: pushCampaignRepository,
+		pushDeliveryRepository: pushDeliveryRepository,
+		notificationUseCase:    notificationUseCase,
+		userAdapter:            userAdapter,
+		photoAdapter:           photoAdapter,
+		logs:                   logs,
+	}
+}
+
+func (u *pushCampaignUseCase) CreateCampaign(ctx context.Context, request *model.CreatePushCampaignRequest) (*model.PushCampaignResponse, error) {
+	now := time.Now()
+	scheduledAt := now
+	if request.ScheduledAt != nil {
+		if request.ScheduledAt.Before(now.Add(-time.Minute)) {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Schedule time must not be in the past")
+		}
+		scheduledAt = *request.ScheduledAt
+	}
+
+	if request.Data == nil {
+		request.Data = map[string]string{}
+	}
+
+	data, err := sonic.ConfigFastest.Marshal(request.Data)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to marshal push campaign data", err)
+	}
+
+	campaign := &entity.PushCampaign{
+		Id:          ulid.Make().String(),
+		Title:       request.Title,
+		Body:        request.Body,
+		Data:        types.JSONText(data),
+		Segment:     request.Segment,
+		BatchSize:   request.BatchSize,
+		Status:      enum.PushCampaignStatusScheduled,
+		ScheduledAt: scheduledAt,
+		NextBatchAt: scheduledAt,
+		CreatedBy:   request.CreatedBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if campaign.BatchSize == 0 {
+		campaign.BatchSize = defaultCampaignBatchSize
+	}
+
+	switch request.Segment {
+	case enum.AudienceSegmentEventAttendee:
+		campaign.BulkPhotoId = sql.NullString{String: request.BulkPhotoId, Valid: true}
+	case enum.AudienceSegmentInactive:
+		campaign.InactiveDays = request.InactiveDays
+		if campaign.InactiveDays == 0 {
+			campaign.InactiveDays = defaultCampaignInactiveDays
+		}
+	}
+
+	if err := u.pushCampaignRepository.Create(ctx, u.db, campaign); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to create push campaign", err)
+	}
+
+	return converter.PushCampaignToResponse(campaign), nil
+}
+
+func (u *pushCampaignUseCase) GetCampaigns(ctx context.Context, request *model.GetPushCampaignsRequest) ([]*model.PushCampaignResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	campaigns, cursorMetadata, err := u.pushCampaignRepository.FindByCursor(ctx, u.db, request.Status, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find push campaigns", err)
+	}
+
+	return converter.PushCampaignsToResponses(campaigns), cursorMetadata, nil
+}
+
+// GetCampaign includes the delivery, open and conversion results once the first batch is sent
+func (u *pushCampaignUseCase) GetCampaign(ctx context.Context, request *model.GetPushCampaignRequest) (*model.PushCampaignResponse, error) {
+	campaign, err := u.pushCampaignRepository.FindById(ctx, u.db, request.CampaignId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Push campaign not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find push campaign", err)
+	}
+
+	response := converter.PushCampaignToResponse(campaign)
+	metric, err := u.pushDeliveryRepository.FindCampaignMetric(ctx, u.db, campaign.Id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find push campaign metric", err)
+	}
+	if metric != nil {
+		response.Results = converter.PushCampaignMetricToResponse(metric)
+	}
+
+	return response, nil
+}
+
+func (u *pushCampaignUseCase) CancelCampaign(ctx context.Context, request *model.CancelPushCampaignRequest) (*model.PushCampaignResponse, error) {
+	canceled, err := u.pushCampaignRepository.Cancel(ctx, u.db, request.CampaignId, time.Now())
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to cancel push campaign", err)
+	}
+
+	campaign, err := u.pushCampaignRepository.FindById(ctx, u.db, request.CampaignId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Push campaign not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find push campaign", err)
+	}
+
+	if !canceled {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Push campaign is already "+string(campaign.Status))
+	}
+
+	return converter.PushCampaignToResponse(campaign), nil
+}
+
+// ProcessDueCampaigns sends one batch of every campaign that is due, a campaign is claimed before its batch so
+// several workers never send the same batch at once
+func (u *pushCampaignUseCase) ProcessDueCampaigns(ctx context.Context) error {
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		now := time.Now()
+		campaign, err := u.pushCampaignRepository.ClaimDue(ctx, u.db, now, now.Add(campaignClaimLease))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to claim due push campaign: %w", err)
+		}
+
+		if err := u.sendBatch(ctx, campaign); err != nil {
+			u.logs.Log(fmt.Sprintf("[PUSH CAMPAIGN] campaign=%s batch failed, retrying after %s: %v", campaign.Id,
+				campaignClaimLease, err))
+			if err := u.pushCampaignRepository.UpdateLastError(ctx, u.db, campaign.Id, err.Error()); err != nil {
+				u.logs.Log(fmt.Sprintf("[PUSH CAMPAIGN] failed to save error of campaign=%s: %v", campaign.Id, err))
+			}
+		}
+	}
+}
+
+/* Campaign Batch Logic
+1. The next batch size users of the segment after the cursor are fetched, sorted by user id
+2. The users are sent through the regular delivery so inbox, preferences, quiet hours and the push worker pool apply.
+   The campaign id is the event id, so a batch sent again after a crash is not stored or pushed twice
+3. A batch shorter than the batch size is the last one and completes the campaign, otherwise the next batch is due
+   after the batch interval
+*/
+
+func (u *pushCampaignUseCase) sendBatch(ctx context.Context, campaign *entity.PushCampaign) error {
+	userIDs, err := u.audienceUserIds(ctx, campaign)
+	if err != nil {
+		return err
+	}
+
+	if len(userIDs) > 0 {
+		data := make(map[string]string)
+		if err := sonic.ConfigFastest.Unmarshal(campaign.Data, &data); err != nil {
+			return fmt.Errorf("failed to unmarshal campaign data: %w", err)
+		}
+
+		request := &model.SendNotificationRequest{
+			EventId:  campaign.Id,
+			UserIds:  userIDs,
+			Category: enum.NotificationCategoryPromotion,
+			Type:     enum.NotificationTypeCampaign,
+			Title:    campaign.Title,
+			Body:     campaign.Body,
+			Data:     data,
+		}
+		if err := u.notificationUseCase.SendNotification(ctx, request); err != nil {
+			return fmt.Errorf("failed to send campaign batch: %w", err)
+		}
+	}
+
+	cursorUserID := campaign.CursorUserId
+	if len(userIDs) > 0 {
+		cursorUserID = userIDs[len(userIDs)-1]
+	}
+	targetedCount := campaign.TargetedCount + len(userIDs)
+
+	if len(userIDs) < campaign.BatchSize {
+		if _, err := u.pushCampaignRepository.Complete(ctx, u.db, campaign.Id, cursorUserID, targetedCount, time.Now()); err != nil {
+			return fmt.Errorf("failed to complete push campaign: %w", err)
+		}
+		u.logs.Log(fmt.Sprintf("[PUSH CAMPAIGN] campaign=%s completed, targeted %d users", campaign.Id, targetedCount))
+		return nil
+	}
+
+	if _, err := u.pushCampaignRepository.UpdateProgress(ctx, u.db, campaign.Id, cursorUserID, targetedCount,
+		time.Now().Add(campaignBatchInterval)); err != nil {
+		return fmt.Errorf("failed to update push campaign progress: %w", err)
+	}
+
+	return nil
+}
+
+// audienceUserIds pages through the segment until the batch is full or the segment runs out
+func (u *pushCampaignUseCase) audienceUserIds(ctx context.Context, campaign *entity.PushCampaign) ([]string, error) {
+	userIDs := make([]string, 0, campaign.BatchSize)
+	afterUserID := campaign.CursorUserId
+	for len(userIDs) < campaign.BatchSize {
+		limit := min(campaignAudiencePage, campaign.BatchSize-len(userIDs))
+
+		var page []string
+		var err error
+		switch campaign.Segment {
+		case enum.AudienceSegmentEventAttendee:
+			page, err = u.photoAdapter.ListEventAttendeeUserIds(ctx, campaign.BulkPhotoId.String, afterUserID, limit)
+		case enum.AudienceSegmentCreator:
+			page, err = u.photoAdapter.ListCreatorUserIds(ctx, afterUserID, limit)
+		default:
+			page, err = u.userAdapter.ListSegmentUserIds(ctx, string(campaign.Segment), campaign.InactiveDays, afterUserID, limit)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s audience: %w", campaign.Segment, err)
+		}
+
+		userIDs = append(userIDs, page...)
+		if len(page) < limit {
+			break
+		}
+		afterUserID = page[len(page)-1]
+	}
+
+	return userIDs, nil
+}
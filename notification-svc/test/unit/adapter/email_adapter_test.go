@@ -0,0 +1,418 @@
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/config"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEmailFrom = "no-reply@yourmoments.test"
+	testRecipient = "buyer@example.com"
+)
+
+type receivedEmail struct {
+	from string
+	to   string
+	data string
+}
+
+// smtpStub is a local SMTP server, rcptReplies are answered to RCPT TO in order and every RCPT TO after the last
+// reply is accepted
+type smtpStub struct {
+	listener    net.Listener
+	mu          sync.Mutex
+	rcptReplies []string
+	sessions    int
+	auths       []string
+	emails      []*receivedEmail
+}
+
+func newSMTPStub(t *testing.T, rcptReplies ...string) *smtpStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	stub := &smtpStub{listener: listener, rcptReplies: rcptReplies}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go stub.serve(conn)
+		}
+	}()
+
+	return stub
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.sessions++
+	s.mu.Unlock()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+
+	reply("220 stub ESMTP ready")
+	email := new(receivedEmail)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250-stub greets you")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.mu.Lock()
+			s.auths = append(s.auths, command)
+			s.mu.Unlock()
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			email.from = command
+			reply("250 2.1.0 Ok")
+		case "RCPT":
+			s.mu.Lock()
+			rcptReply := "250 2.1.5 Ok"
+			if len(s.rcptReplies) > 0 {
+				rcptReply, s.rcptReplies = s.rcptReplies[0], s.rcptReplies[1:]
+			}
+			s.mu.Unlock()
+			email.to = command
+			reply(rcptReply)
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data := new(strings.Builder)
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			email.data = data.String()
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			email = new(receivedEmail)
+			reply("250 2.0.0 Ok: queued")
+		case "RSET":
+			reply("250 2.0.0 Ok")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			reply("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+func (s *smtpStub) port() string {
+	return fmt.Sprintf("%d", s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *smtpStub) received() (int, []*receivedEmail) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions, s.emails
+}
+
+func newTestEmailAdapter(t *testing.T, stub *smtpStub) adapter.EmailAdapter {
+	emailAdapter, err := adapter.NewEmailAdapter(&config.EmailConfig{
+		Host:         "127.0.0.1",
+		Port:         stub.port(),
+		Username:     "smtp-user",
+		Password:     "smtp-password",
+		EmailFrom:    testEmailFrom,
+		SenderName:   "YourMoments",
+		MaxAttempts:  3,
+		RetryBackoff: time.Millisecond,
+		Timeout:      5 * time.Second,
+		AppUrl:       "https://api.yourmoments.test",
+		FrontendUrl:  "https://yourmoments.test",
+	})
+	require.NoError(t, err)
+
+	return emailAdapter
+}
+
+func receiptMessage(language enum.LanguageEnum) *model.EmailMessage {
+	return &model.EmailMessage{
+		Id:       "01JCDELIVERY0000000000000",
+		To:       testRecipient,
+		Language: language,
+		Template: enum.EmailTemplateTransactionReceipt,
+		Data: &model.TransactionReceiptEmailData{
+			Username:      "budi",
+			TransactionId: "01JCTRANSACTION0000000000",
+			PhotoCount:    3,
+			Amount:        "Rp150.000",
+			SettledAt:     "9 November 2025 10:00 WIB",
+		},
+	}
+}
+
+func parseReceivedEmail(t *testing.T, email *receivedEmail) (string, string, *mail.Message) {
+	message, err := mail.ReadMessage(strings.NewReader(email.data))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(message.Body))
+	require.NoError(t, err)
+
+	return subject, string(body), message
+}
+
+func TestSendEmail_RendersIndonesianTemplate(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.NoError(t, err)
+
+	sessions, emails := stub.received()
+	require.Len(t, emails, 1)
+	assert.Equal(t, 1, sessions)
+	assert.Equal(t, "MAIL FROM:<"+testEmailFrom+">", emails[0].from)
+	assert.Equal(t, "RCPT TO:<"+testRecipient+">", emails[0].to)
+
+	subject, body, message := parseReceivedEmail(t, emails[0])
+	assert.Equal(t, "Bukti pembelian 01JCTRANSACTION0000000000", subject)
+	assert.Equal(t, "<01JCDELIVERY0000000000000@yourmoments.test>", message.Header.Get("Message-ID"))
+	assert.Contains(t, message.Header.Get("From"), testEmailFrom)
+	assert.Contains(t, body, `lang="id-ID"`)
+	assert.Contains(t, body, "Terima kasih atas pembelianmu")
+	assert.Contains(t, body, "Hai budi!")
+	assert.Contains(t, body, "Rp150.000")
+	assert.Contains(t, body, "https://yourmoments.test/transactions/01JCTRANSACTION0000000000")
+}
+
+func TestSendEmail_RendersEnglishTemplate(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageEnglish))
+	require.NoError(t, err)
+
+	_, emails := stub.received()
+	require.Len(t, emails, 1)
+
+	subject, body, _ := parseReceivedEmail(t, emails[0])
+	assert.Equal(t, "Your receipt for 01JCTRANSACTION0000000000", subject)
+	assert.Contains(t, body, `lang="en-US"`)
+	assert.Contains(t, body, "Thank you for your purchase")
+	assert.Contains(t, body, "Hi budi!")
+}
+
+func TestSendEmail_UnknownLanguageFallsBackToIndonesian(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage("fr"))
+	require.NoError(t, err)
+
+	_, emails := stub.received()
+	require.Len(t, emails, 1)
+
+	subject, _, _ := parseReceivedEmail(t, emails[0])
+	assert.Equal(t, "Bukti pembelian 01JCTRANSACTION0000000000", subject)
+}
+
+func TestSendEmail_EscapesUserContent(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), &model.EmailMessage{
+		Id:       "01JCDELIVERY0000000000001",
+		To:       testRecipient,
+		Language: enum.LanguageEnglish,
+		Template: enum.EmailTemplateReviewReceived,
+		Data: &model.ReviewReceivedEmailData{
+			Username: "sari",
+			Rating:   5,
+			Comment:  "<script>alert(1)</script>",
+		},
+	})
+	require.NoError(t, err)
+
+	_, emails := stub.received()
+	require.Len(t, emails, 1)
+
+	subject, body, _ := parseReceivedEmail(t, emails[0])
+	assert.Equal(t, "You received a 5 star review", subject)
+	assert.NotContains(t, body, "<script>")
+	assert.Contains(t, body, "&lt;script&gt;")
+}
+
+func TestSendEmail_RendersEveryTemplate(t *testing.T) {
+	data := map[enum.EmailTemplateEnum]any{
+		enum.EmailTemplateTransactionReceipt: receiptMessage(enum.LanguageIndonesian).Data,
+		enum.EmailTemplateCreatorSale: &model.CreatorSaleEmailData{
+			Username: "sari", TransactionId: "01JCTRANSACTION0000000000", Amount: "Rp50.000", SettledAt: "9 November 2025 10:00 WIB",
+		},
+		enum.EmailTemplateWithdrawalStatus: &model.WithdrawalStatusEmailData{
+			Username: "sari", WithdrawalId: "01JCWITHDRAWAL00000000000", Amount: "Rp100.000", Status: "FAILED",
+			Description: "Invalid bank account", UpdatedAt: "9 November 2025 10:00 WIB",
+		},
+		enum.EmailTemplateReviewReceived: &model.ReviewReceivedEmailData{Username: "sari", Rating: 4},
+	}
+
+	for _, language := range []enum.LanguageEnum{enum.LanguageIndonesian, enum.LanguageEnglish} {
+		for _, template := range enum.EmailTemplates {
+			t.Run(fmt.Sprintf("%s/%s", language, template), func(t *testing.T) {
+				stub := newSMTPStub(t)
+				emailAdapter := newTestEmailAdapter(t, stub)
+
+				err := emailAdapter.SendEmail(context.Background(), &model.EmailMessage{
+					Id:       "01JCDELIVERY0000000000002",
+					To:       testRecipient,
+					Language: language,
+					Template: template,
+					Data:     data[template],
+				})
+				require.NoError(t, err)
+
+				_, emails := stub.received()
+				require.Len(t, emails, 1)
+
+				subject, body, _ := parseReceivedEmail(t, emails[0])
+				assert.NotEmpty(t, subject)
+				assert.NotContains(t, body, "<no value>")
+			})
+		}
+	}
+}
+
+func TestSendEmail_WithdrawalStatusSubject(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	for _, status := range []string{"PENDING", "SUCCESS", "FAILED"} {
+		err := emailAdapter.SendEmail(context.Background(), &model.EmailMessage{
+			Id:       "01JCDELIVERY000000000000" + status[:1],
+			To:       testRecipient,
+			Language: enum.LanguageEnglish,
+			Template: enum.EmailTemplateWithdrawalStatus,
+			Data:     &model.WithdrawalStatusEmailData{Username: "sari", WithdrawalId: "01JCWITHDRAWAL00000000000", Status: status},
+		})
+		require.NoError(t, err)
+	}
+
+	_, emails := stub.received()
+	require.Len(t, emails, 3)
+
+	subjects := make([]string, 0, len(emails))
+	for _, email := range emails {
+		subject, _, _ := parseReceivedEmail(t, email)
+		subjects = append(subjects, subject)
+	}
+	assert.Equal(t, []string{"We received your withdrawal request", "Your withdrawal was approved", "Your withdrawal was rejected"}, subjects)
+}
+
+func TestSendEmail_AuthenticatesWithPlainAuth(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.NoError(t, err)
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+	require.Len(t, stub.auths, 1)
+	assert.True(t, strings.HasPrefix(stub.auths[0], "AUTH PLAIN "))
+}
+
+func TestSendEmail_RetriesTemporaryFailure(t *testing.T) {
+	stub := newSMTPStub(t, "451 4.3.0 Temporary local problem")
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.NoError(t, err)
+
+	sessions, emails := stub.received()
+	assert.Equal(t, 2, sessions)
+	assert.Len(t, emails, 1)
+}
+
+func TestSendEmail_GivesUpAfterMaxAttempts(t *testing.T) {
+	stub := newSMTPStub(t,
+		"452 4.2.2 Mailbox full",
+		"452 4.2.2 Mailbox full",
+		"452 4.2.2 Mailbox full",
+	)
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.Error(t, err)
+
+	smtpErr := helper.ParseSMTPError(err)
+	assert.Equal(t, 452, smtpErr.Code)
+	assert.True(t, smtpErr.IsSoftBounce())
+	assert.False(t, smtpErr.IsHardBounce())
+
+	sessions, emails := stub.received()
+	assert.Equal(t, 3, sessions)
+	assert.Empty(t, emails)
+}
+
+func TestSendEmail_HardBounceIsNotRetried(t *testing.T) {
+	stub := newSMTPStub(t, "550 5.1.1 User unknown")
+	emailAdapter := newTestEmailAdapter(t, stub)
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.Error(t, err)
+
+	smtpErr := helper.ParseSMTPError(err)
+	assert.Equal(t, 550, smtpErr.Code)
+	assert.True(t, smtpErr.IsHardBounce())
+	assert.False(t, smtpErr.IsRetryable())
+
+	sessions, emails := stub.received()
+	assert.Equal(t, 1, sessions)
+	assert.Empty(t, emails)
+}
+
+func TestSendEmail_ConnectionRefusedIsRetryable(t *testing.T) {
+	stub := newSMTPStub(t)
+	emailAdapter := newTestEmailAdapter(t, stub)
+	stub.listener.Close()
+
+	err := emailAdapter.SendEmail(context.Background(), receiptMessage(enum.LanguageIndonesian))
+	require.Error(t, err)
+
+	smtpErr := helper.ParseSMTPError(err)
+	assert.Zero(t, smtpErr.Code)
+	assert.True(t, smtpErr.IsRetryable())
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	controller "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/delivery/http/controller"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newAckApp serves the push ack route for user-1 on the push analytics use case backed by a mocked repository
+func newAckApp(t *testing.T) (*fiber.App, *mockrepository.MockPushDeliveryRepository) {
+	ctrl := gomock.NewController(t)
+	pushDeliveryRepo := mockrepository.NewMockPushDeliveryRepository(ctrl)
+	logs := logger.New("test")
+
+	pushAnalyticsUC := usecase.NewPushAnalyticsUseCase(mockrepository.NewMockBeginTx(ctrl), pushDeliveryRepo, logs)
+	pushAnalyticsController := controller.NewPushAnalyticsController(pushAnalyticsUC, helper.NewCustomValidator(), logs)
+
+	app := fiber.New()
+	app.Use(func(ctx *fiber.Ctx) error {
+		ctx.Locals("auth", &model.AuthResponse{UserId: "user-1"})
+		return ctx.Next()
+	})
+	app.Post("/api/notification/push/ack", pushAnalyticsController.AcknowledgePush)
+	return app, pushDeliveryRepo
+}
+
+func postAck(t *testing.T, app *fiber.App, body string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/notification/push/ack", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestAcknowledgePush(t *testing.T) {
+	t.Run("Click of the user is recorded on the device", func(t *testing.T) {
+		app, pushDeliveryRepo := newAckApp(t)
+		pushDeliveryRepo.EXPECT().MarkInteraction(gomock.Any(), gomock.Any(), "user-1", "push-1", "token-1",
+			enum.PushInteractionClick, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, _, _, _ string, _ enum.PushInteractionEnum, interactedAt time.Time) (bool, error) {
+				assert.WithinDuration(t, time.Now(), interactedAt, time.Minute)
+				return true, nil
+			})
+
+		status := postAck(t, app, `{"push_id":"push-1","action":"click","token":"token-1"}`)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Open without a token marks every device", func(t *testing.T) {
+		app, pushDeliveryRepo := newAckApp(t)
+		pushDeliveryRepo.EXPECT().MarkInteraction(gomock.Any(), gomock.Any(), "user-1", "push-1", "",
+			enum.PushInteractionOpen, gomock.Any()).Return(true, nil)
+
+		status := postAck(t, app, `{"push_id":"push-1","action":"open"}`)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Push of another user is not found", func(t *testing.T) {
+		app, pushDeliveryRepo := newAckApp(t)
+		pushDeliveryRepo.EXPECT().MarkInteraction(gomock.Any(), gomock.Any(), "user-1", "push-2", "",
+			enum.PushInteractionOpen, gomock.Any()).Return(false, nil)
+
+		status := postAck(t, app, `{"push_id":"push-2","action":"open"}`)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("Unknown action is rejected", func(t *testing.T) {
+		app, _ := newAckApp(t)
+
+		status := postAck(t, app, `{"push_id":"push-1","action":"dismiss"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+	})
+
+	t.Run("User id in the body is rejected", func(t *testing.T) {
+		app, _ := newAckApp(t)
+
+		status := postAck(t, app, `{"push_id":"push-1","action":"open","user_id":"user-2"}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+}
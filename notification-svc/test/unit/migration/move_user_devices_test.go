@@ -0,0 +1,278 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/db/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const devicesDriverName = "user-devices-stub"
+
+func init() {
+	sql.Register(devicesDriverName, devicesDriver{})
+}
+
+type device struct {
+	id, userID, token, platform string
+	createdAt                   time.Time
+}
+
+// devicesDB is an in-memory user_devices table answering the statements of the device move migration
+type devicesDB struct {
+	mu       sync.Mutex
+	hasTable bool
+	devices  []device
+}
+
+var (
+	devicesDBsMu sync.Mutex
+	devicesDBs   = map[string]*devicesDB{}
+)
+
+// openDevicesDB opens a database/sql handle on a fresh user_devices table holding the given devices
+func openDevicesDB(t *testing.T, hasTable bool, devices ...device) (*sql.DB, *devicesDB) {
+	t.Helper()
+	store := &devicesDB{hasTable: hasTable, devices: devices}
+
+	devicesDBsMu.Lock()
+	dsn := fmt.Sprintf("%s/%d", t.Name(), len(devicesDBs))
+	devicesDBs[dsn] = store
+	devicesDBsMu.Unlock()
+
+	db, err := sql.Open(devicesDriverName, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, store
+}
+
+func (s *devicesDB) tokens() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens := make([]string, 0, len(s.devices))
+	for _, device := range s.devices {
+		tokens = append(tokens, device.id+":"+device.userID+":"+device.token)
+	}
+	return tokens
+}
+
+type devicesDriver struct{}
+
+func (devicesDriver) Open(dsn string) (driver.Conn, error) {
+	devicesDBsMu.Lock()
+	defer devicesDBsMu.Unlock()
+	store, ok := devicesDBs[dsn]
+	if !ok {
+		return nil, fmt.Errorf("unknown database %s", dsn)
+	}
+	return &devicesConn{store: store}, nil
+}
+
+type devicesConn struct {
+	store *devicesDB
+}
+
+func (c *devicesConn) Prepare(query string) (driver.Stmt, error) {
+	return &devicesStmt{store: c.store, query: strings.Join(strings.Fields(query), " ")}, nil
+}
+
+func (c *devicesConn) Close() error              { return nil }
+func (c *devicesConn) Begin() (driver.Tx, error) { return devicesTx{}, nil }
+
+type devicesTx struct{}
+
+func (devicesTx) Commit() error   { return nil }
+func (devicesTx) Rollback() error { return nil }
+
+type devicesStmt struct {
+	store *devicesDB
+	query string
+}
+
+func (s *devicesStmt) Close() error  { return nil }
+func (s *devicesStmt) NumInput() int { return -1 }
+
+func (s *devicesStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(s.query, "INSERT INTO user_devices"):
+		inserted := device{
+			id:        args[0].(string),
+			userID:    args[1].(string),
+			token:     args[2].(string),
+			platform:  args[3].(string),
+			createdAt: args[4].(time.Time),
+		}
+		for _, existing := range s.store.devices {
+			if existing.userID == inserted.userID && existing.token == inserted.token {
+				return driver.RowsAffected(0), nil
+			}
+		}
+		s.store.devices = append(s.store.devices, inserted)
+		return driver.RowsAffected(1), nil
+	case s.query == "DELETE FROM user_devices WHERE id = ANY($1)":
+		ids := map[string]bool{}
+		for _, id := range strings.Split(strings.Trim(args[0].(string), "{}"), ",") {
+			ids[strings.Trim(id, `"`)] = true
+		}
+		kept := s.store.devices[:0]
+		for _, device := range s.store.devices {
+			if !ids[device.id] {
+				kept = append(kept, device)
+			}
+		}
+		removed := len(s.store.devices) - len(kept)
+		s.store.devices = kept
+		return driver.RowsAffected(removed), nil
+	}
+	return nil, fmt.Errorf("unexpected exec: %s", s.query)
+}
+
+func (s *devicesStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	switch s.query {
+	case "SELECT to_regclass('public.user_devices')":
+		var table driver.Value
+		if s.store.hasTable {
+			table = "user_devices"
+		}
+		return &devicesRows{columns: []string{"to_regclass"}, values: [][]driver.Value{{table}}}, nil
+	case "SELECT id, user_id, token, platform, created_at FROM user_devices":
+		rows := &devicesRows{columns: []string{"id", "user_id", "token", "platform", "created_at"}}
+		for _, device := range s.store.devices {
+			rows.values = append(rows.values, []driver.Value{device.id, device.userID, device.token, device.platform, device.createdAt})
+		}
+		return rows, nil
+	case "SELECT id FROM user_devices":
+		rows := &devicesRows{columns: []string{"id"}}
+		for _, device := range s.store.devices {
+			rows.values = append(rows.values, []driver.Value{device.id})
+		}
+		return rows, nil
+	}
+	return nil, fmt.Errorf("unexpected query: %s", s.query)
+}
+
+type devicesRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *devicesRows) Columns() []string { return r.columns }
+func (r *devicesRows) Close() error      { return nil }
+
+func (r *devicesRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestMoveUserDevicesFromUserSvc(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Devices are copied and existing tokens are kept", func(t *testing.T) {
+		userDB, _ := openDevicesDB(t, true,
+			device{id: "device-1", userID: "user-1", token: "token-a", platform: "android", createdAt: createdAt},
+			device{id: "device-2", userID: "user-2", token: "token-b", platform: "ios", createdAt: createdAt},
+		)
+		notificationDB, notificationStore := openDevicesDB(t, true,
+			device{id: "device-9", userID: "user-1", token: "token-a", platform: "android", createdAt: createdAt.Add(time.Hour)},
+		)
+
+		tx, err := notificationDB.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, migrations.MoveUserDevicesFromUserSvc(ctx, tx, userDB))
+		require.NoError(t, tx.Commit())
+
+		assert.Equal(t, []string{"device-9:user-1:token-a", "device-2:user-2:token-b"}, notificationStore.tokens())
+		assert.Equal(t, createdAt, notificationStore.devices[1].createdAt)
+		assert.Equal(t, "ios", notificationStore.devices[1].platform)
+	})
+
+	t.Run("Moving twice copies nothing new", func(t *testing.T) {
+		userDB, _ := openDevicesDB(t, true,
+			device{id: "device-1", userID: "user-1", token: "token-a", platform: "android", createdAt: createdAt},
+		)
+		notificationDB, notificationStore := openDevicesDB(t, true)
+
+		for i := 0; i < 2; i++ {
+			tx, err := notificationDB.BeginTx(ctx, nil)
+			require.NoError(t, err)
+			require.NoError(t, migrations.MoveUserDevicesFromUserSvc(ctx, tx, userDB))
+			require.NoError(t, tx.Commit())
+		}
+
+		assert.Equal(t, []string{"device-1:user-1:token-a"}, notificationStore.tokens())
+	})
+
+	t.Run("User-svc without a user_devices table", func(t *testing.T) {
+		userDB, _ := openDevicesDB(t, false)
+		notificationDB, notificationStore := openDevicesDB(t, true)
+
+		tx, err := notificationDB.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, migrations.MoveUserDevicesFromUserSvc(ctx, tx, userDB))
+		require.NoError(t, tx.Commit())
+
+		assert.Empty(t, notificationStore.tokens())
+	})
+}
+
+func TestRemoveUserDevicesMovedFromUserSvc(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Moved devices are removed and newer tokens are kept", func(t *testing.T) {
+		userDB, _ := openDevicesDB(t, true,
+			device{id: "device-1", userID: "user-1", token: "token-a", platform: "android", createdAt: createdAt},
+			device{id: "device-2", userID: "user-2", token: "token-b", platform: "ios", createdAt: createdAt},
+		)
+		notificationDB, notificationStore := openDevicesDB(t, true,
+			device{id: "device-3", userID: "user-3", token: "token-c", platform: "android", createdAt: createdAt},
+		)
+
+		tx, err := notificationDB.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, migrations.MoveUserDevicesFromUserSvc(ctx, tx, userDB))
+		require.NoError(t, tx.Commit())
+		require.Len(t, notificationStore.tokens(), 3)
+
+		tx, err = notificationDB.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, migrations.RemoveUserDevicesMovedFromUserSvc(ctx, tx, userDB))
+		require.NoError(t, tx.Commit())
+
+		assert.Equal(t, []string{"device-3:user-3:token-c"}, notificationStore.tokens())
+	})
+
+	t.Run("User-svc without a user_devices table", func(t *testing.T) {
+		userDB, _ := openDevicesDB(t, false)
+		notificationDB, notificationStore := openDevicesDB(t, true,
+			device{id: "device-1", userID: "user-1", token: "token-a", platform: "android", createdAt: createdAt},
+		)
+
+		tx, err := notificationDB.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		require.NoError(t, migrations.RemoveUserDevicesMovedFromUserSvc(ctx, tx, userDB))
+		require.NoError(t, tx.Commit())
+
+		assert.Equal(t, []string{"device-1:user-1:token-a"}, notificationStore.tokens())
+	})
+}
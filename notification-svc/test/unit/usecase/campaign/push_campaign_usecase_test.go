@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/repository"
+	mockusecase "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/usecase"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type campaignMocks struct {
+	campaignRepo   *mockrepository.MockPushCampaignRepository
+	notificationUC *mockusecase.MockNotificationUseCase
+	userAdapter    *mockadapter.MockUserAdapter
+	photoAdapter   *mockadapter.MockPhotoAdapter
+}
+
+func newPushCampaignUseCase(t *testing.T) (usecase.PushCampaignUseCase, *campaignMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &campaignMocks{
+		campaignRepo:   mockrepository.NewMockPushCampaignRepository(ctrl),
+		notificationUC: mockusecase.NewMockNotificationUseCase(ctrl),
+		userAdapter:    mockadapter.NewMockUserAdapter(ctrl),
+		photoAdapter:   mockadapter.NewMockPhotoAdapter(ctrl),
+	}
+
+	campaignUC := usecase.NewPushCampaignUseCase(mockrepository.NewMockBeginTx(ctrl), mocks.campaignRepo,
+		mockrepository.NewMockPushDeliveryRepository(ctrl), mocks.notificationUC, mocks.userAdapter, mocks.photoAdapter,
+		logger.New("test"))
+	return campaignUC, mocks
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func campaignUserIDs(from, count int) []string {
+	userIDs := make([]string, 0, count)
+	for i := from; i < from+count; i++ {
+		userIDs = append(userIDs, fmt.Sprintf("user-%05d", i))
+	}
+	return userIDs
+}
+
+func dueCampaign(segment enum.AudienceSegmentEnum, batchSize int) *entity.PushCampaign {
+	return &entity.PushCampaign{
+		Id:        "campaign-1",
+		Title:     "Promo Akhir Pekan",
+		Body:      "Diskon untuk semua foto",
+		Data:      types.JSONText(`{"screen":"explore"}`),
+		Segment:   segment,
+		BatchSize: batchSize,
+		Status:    enum.PushCampaignStatusSending,
+	}
+}
+
+// expectClaims hands out the campaign on the first claim and reports nothing due afterwards
+func expectClaims(mocks *campaignMocks, campaign *entity.PushCampaign) {
+	gomock.InOrder(
+		mocks.campaignRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(campaign, nil),
+		mocks.campaignRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows),
+	)
+}
+
+func TestCreateCampaign(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Schedule time in the past", func(t *testing.T) {
+		campaignUC, _ := newPushCampaignUseCase(t)
+		scheduledAt := time.Now().Add(-time.Hour)
+
+		_, err := campaignUC.CreateCampaign(ctx, &model.CreatePushCampaignRequest{
+			CreatedBy:   "admin-1",
+			Title:       "Promo",
+			Body:        "Promo",
+			Segment:     enum.AudienceSegmentAll,
+			ScheduledAt: &scheduledAt,
+		})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Inactive campaign gets the default batch size and inactive days", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+
+		var created *entity.PushCampaign
+		mocks.campaignRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, campaign *entity.PushCampaign) error {
+				created = campaign
+				return nil
+			})
+
+		_, err := campaignUC.CreateCampaign(ctx, &model.CreatePushCampaignRequest{
+			CreatedBy:   "admin-1",
+			Title:       "Kami Rindu",
+			Body:        "Ada foto baru untukmu",
+			Segment:     enum.AudienceSegmentInactive,
+			BulkPhotoId: "bulk-1",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, enum.PushCampaignStatusScheduled, created.Status)
+		assert.Equal(t, 1000, created.BatchSize)
+		assert.Equal(t, 30, created.InactiveDays)
+		assert.False(t, created.BulkPhotoId.Valid)
+		assert.JSONEq(t, `{}`, string(created.Data))
+	})
+
+	t.Run("Event attendee campaign keeps its bulk photo", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+
+		var created *entity.PushCampaign
+		mocks.campaignRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, campaign *entity.PushCampaign) error {
+				created = campaign
+				return nil
+			})
+
+		_, err := campaignUC.CreateCampaign(ctx, &model.CreatePushCampaignRequest{
+			CreatedBy:    "admin-1",
+			Title:        "Foto Acara",
+			Body:         "Foto acaramu sudah tersedia",
+			Segment:      enum.AudienceSegmentEventAttendee,
+			BulkPhotoId:  "bulk-1",
+			InactiveDays: 10,
+			BatchSize:    500,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, "bulk-1", created.BulkPhotoId.String)
+		assert.Equal(t, 500, created.BatchSize)
+		assert.Zero(t, created.InactiveDays)
+	})
+}
+
+func TestProcessDueCampaigns(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Creator segment pages through photo-svc until the batch is full", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+		campaign := dueCampaign(enum.AudienceSegmentCreator, 1500)
+		campaign.CursorUserId = "user-00000"
+		campaign.TargetedCount = 200
+		expectClaims(mocks, campaign)
+
+		firstPage := campaignUserIDs(1, 1000)
+		secondPage := campaignUserIDs(1001, 500)
+		gomock.InOrder(
+			mocks.photoAdapter.EXPECT().ListCreatorUserIds(ctx, "user-00000", 1000).Return(firstPage, nil),
+			mocks.photoAdapter.EXPECT().ListCreatorUserIds(ctx, "user-01000", 500).Return(secondPage, nil),
+		)
+		mocks.notificationUC.EXPECT().SendNotification(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *model.SendNotificationRequest) error {
+				assert.Equal(t, "campaign-1", request.EventId)
+				assert.Equal(t, enum.NotificationCategoryPromotion, request.Category)
+				assert.Equal(t, "explore", request.Data["screen"])
+				assert.Len(t, request.UserIds, 1500)
+				return nil
+			})
+		mocks.campaignRepo.EXPECT().UpdateProgress(ctx, gomock.Any(), "campaign-1", "user-01500", 1700, gomock.Any()).
+			Return(true, nil)
+
+		require.NoError(t, campaignUC.ProcessDueCampaigns(ctx))
+	})
+
+	t.Run("Short page completes the campaign", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+		campaign := dueCampaign(enum.AudienceSegmentFacecam, 100)
+		expectClaims(mocks, campaign)
+
+		userIDs := campaignUserIDs(1, 2)
+		mocks.userAdapter.EXPECT().ListSegmentUserIds(ctx, "FACECAM", 0, "", 100).Return(userIDs, nil)
+		mocks.notificationUC.EXPECT().SendNotification(ctx, gomock.Any()).Return(nil)
+		mocks.campaignRepo.EXPECT().Complete(ctx, gomock.Any(), "campaign-1", "user-00002", 2, gomock.Any()).Return(true, nil)
+
+		require.NoError(t, campaignUC.ProcessDueCampaigns(ctx))
+	})
+
+	t.Run("Empty event audience completes without sending", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+		campaign := dueCampaign(enum.AudienceSegmentEventAttendee, 100)
+		campaign.BulkPhotoId = sql.NullString{String: "bulk-1", Valid: true}
+		campaign.CursorUserId = "user-00042"
+		campaign.TargetedCount = 42
+		expectClaims(mocks, campaign)
+
+		mocks.photoAdapter.EXPECT().ListEventAttendeeUserIds(ctx, "bulk-1", "user-00042", 100).Return([]string{}, nil)
+		mocks.campaignRepo.EXPECT().Complete(ctx, gomock.Any(), "campaign-1", "user-00042", 42, gomock.Any()).Return(true, nil)
+
+		require.NoError(t, campaignUC.ProcessDueCampaigns(ctx))
+	})
+
+	t.Run("Failed batch keeps the cursor and records the error", func(t *testing.T) {
+		campaignUC, mocks := newPushCampaignUseCase(t)
+		campaign := dueCampaign(enum.AudienceSegmentInactive, 100)
+		campaign.InactiveDays = 30
+		expectClaims(mocks, campaign)
+
+		mocks.userAdapter.EXPECT().ListSegmentUserIds(ctx, "INACTIVE", 30, "", 100).Return(campaignUserIDs(1, 100), nil)
+		mocks.notificationUC.EXPECT().SendNotification(ctx, gomock.Any()).Return(errors.New("database is down"))
+		mocks.campaignRepo.EXPECT().UpdateLastError(ctx, gomock.Any(), "campaign-1", gomock.Any()).Return(nil)
+
+		require.NoError(t, campaignUC.ProcessDueCampaigns(ctx))
+	})
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testUserId = "user-1"
+
+func newInboxUseCase(t *testing.T) (usecase.NotificationInboxUseCase, *mockrepository.MockNotificationRepository) {
+	ctrl := gomock.NewController(t)
+	notificationRepo := mockrepository.NewMockNotificationRepository(ctrl)
+	return usecase.NewNotificationInboxUseCase(mockrepository.NewMockBeginTx(ctrl), notificationRepo, logger.New("test")), notificationRepo
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestGetNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		inboxUC, _ := newInboxUseCase(t)
+
+		_, _, err := inboxUC.GetNotifications(ctx, &model.GetNotificationsRequest{UserId: testUserId, Cursor: "not-a-cursor", Size: 10})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Notifications keep their deep link and read state", func(t *testing.T) {
+		inboxUC, notificationRepo := newInboxUseCase(t)
+
+		createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		readAt := createdAt.Add(time.Hour)
+		notificationRepo.EXPECT().FindByCursor(ctx, gomock.Any(), testUserId, true, nil, 10).Return([]*entity.Notification{
+			{
+				Id:        "notification-1",
+				UserId:    testUserId,
+				Type:      enum.NotificationTypeSimilarPhoto,
+				Title:     "Foto Mirip Terdeteksi",
+				Data:      types.JSONText(`{"photo_ids":["photo-1"]}`),
+				CreatedAt: createdAt,
+			},
+			{
+				Id:        "notification-2",
+				UserId:    testUserId,
+				Type:      enum.NotificationTypeGeneral,
+				Data:      types.JSONText(`{}`),
+				ReadAt:    sql.NullTime{Time: readAt, Valid: true},
+				CreatedAt: createdAt,
+			},
+		}, &model.CursorMetadata{}, nil)
+
+		notifications, _, err := inboxUC.GetNotifications(ctx, &model.GetNotificationsRequest{UserId: testUserId, UnreadOnly: true, Size: 10})
+		require.NoError(t, err)
+		require.Len(t, notifications, 2)
+
+		assert.JSONEq(t, `{"photo_ids":["photo-1"]}`, string(notifications[0].Data))
+		assert.False(t, notifications[0].IsRead)
+		assert.Nil(t, notifications[0].ReadAt)
+
+		assert.True(t, notifications[1].IsRead)
+		require.NotNil(t, notifications[1].ReadAt)
+		assert.Equal(t, readAt, *notifications[1].ReadAt)
+	})
+}
+
+func TestMarkNotificationsRead(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Mark read returns the unread count left", func(t *testing.T) {
+		inboxUC, notificationRepo := newInboxUseCase(t)
+		notificationIds := []string{"notification-1", "notification-2"}
+		notificationRepo.EXPECT().MarkRead(ctx, gomock.Any(), testUserId, notificationIds, gomock.Any()).Return(nil)
+		notificationRepo.EXPECT().CountUnread(ctx, gomock.Any(), testUserId).Return(3, nil)
+
+		resp, err := inboxUC.MarkRead(ctx, &model.MarkNotificationsReadRequest{UserId: testUserId, NotificationIds: notificationIds})
+		require.NoError(t, err)
+		assert.Equal(t, 3, resp.UnreadCount)
+	})
+
+	t.Run("Mark all read clears the unread count", func(t *testing.T) {
+		inboxUC, notificationRepo := newInboxUseCase(t)
+		notificationRepo.EXPECT().MarkAllRead(ctx, gomock.Any(), testUserId, gomock.Any()).Return(nil)
+
+		resp, err := inboxUC.MarkAllRead(ctx, testUserId)
+		require.NoError(t, err)
+		assert.Zero(t, resp.UnreadCount)
+	})
+}
+
+func TestDeleteNotification(t *testing.T) {
+	ctx := context.Background()
+	request := &model.DeleteNotificationRequest{UserId: testUserId, NotificationId: "notification-1"}
+
+	t.Run("Notification of the user is deleted", func(t *testing.T) {
+		inboxUC, notificationRepo := newInboxUseCase(t)
+		notificationRepo.EXPECT().Delete(ctx, gomock.Any(), testUserId, "notification-1").Return(true, nil)
+
+		require.NoError(t, inboxUC.DeleteNotification(ctx, request))
+	})
+
+	t.Run("Missing or foreign notification is not found", func(t *testing.T) {
+		inboxUC, notificationRepo := newInboxUseCase(t)
+		notificationRepo.EXPECT().Delete(ctx, gomock.Any(), testUserId, "notification-1").Return(false, nil)
+
+		assertUseCaseError(t, inboxUC.DeleteNotification(ctx, request), errorcode.ErrResourceNotFound)
+	})
+}
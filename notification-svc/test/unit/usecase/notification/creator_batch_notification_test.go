@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func creatorBatchEvent(eventID, creatorID string, followerUserIDs ...string) *event.CreatorBatchPublishedEvent {
+	return &event.CreatorBatchPublishedEvent{
+		EventID:         eventID,
+		CreatorId:       creatorID,
+		BulkPhotoId:     "bulk-" + eventID,
+		TotalPhoto:      12,
+		FollowerUserIds: followerUserIDs,
+		PublishedAt:     time.Now(),
+	}
+}
+
+func creatorBatchCooldownKey(userID, creatorID string) string {
+	return fmt.Sprintf("creator_batch_notified:%s:%s", userID, creatorID)
+}
+
+func creatorBatchDailyKey(userID string) string {
+	return fmt.Sprintf("creator_batch_daily:%s:%s", userID, time.Now().Format("2006-01-02"))
+}
+
+func TestCreatorBatchNotificationQuota(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Follower is notified once per creator within the cooldown", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+
+		require.NoError(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, creatorBatchEvent("event-1", "creator-1", "user-1", "user-2")))
+		assert.Equal(t, []string{"user-1", "user-2"}, mocks.store.pushedUserIDs())
+
+		require.NoError(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, creatorBatchEvent("event-2", "creator-1", "user-1", "user-2")))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+		assert.Zero(t, mocks.store.inboxCount("event-2"))
+
+		// The cooldown is per creator
+		require.NoError(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, creatorBatchEvent("event-3", "creator-2", "user-1")))
+		assert.Equal(t, []string{"user-1"}, mocks.store.pushedUserIDs())
+	})
+
+	t.Run("Daily limit caps the notifications across creators", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.redis.setString(creatorBatchDailyKey("user-1"), "5")
+
+		require.NoError(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, creatorBatchEvent("event-1", "creator-1", "user-1", "user-2")))
+		assert.Equal(t, []string{"user-2"}, mocks.store.pushedUserIDs())
+	})
+
+	t.Run("Failed delivery releases the quota", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		batchEvent := creatorBatchEvent("event-1", "creator-1", "user-1")
+
+		mocks.store.setTokenErr(errDatabaseDown)
+		require.Error(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, batchEvent))
+
+		_, claimed := mocks.redis.get(creatorBatchCooldownKey("user-1", "creator-1"))
+		assert.False(t, claimed)
+		daily, _ := mocks.redis.get(creatorBatchDailyKey("user-1"))
+		assert.Equal(t, "0", daily)
+
+		// The redelivered event is not blocked by the quota of the failed attempt
+		mocks.store.setTokenErr(nil)
+		require.NoError(t, notificationUC.ProcessAndSendCreatorBatchNotifications(ctx, batchEvent))
+		assert.Equal(t, []string{"user-1"}, mocks.store.pushedUserIDs())
+
+		_, claimed = mocks.redis.get(creatorBatchCooldownKey("user-1", "creator-1"))
+		assert.True(t, claimed)
+		daily, _ = mocks.redis.get(creatorBatchDailyKey("user-1"))
+		assert.Equal(t, "1", daily)
+	})
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const digestDueKey = "notification_digest_due"
+
+func digestKey(userID string) string {
+	return "notification_digest:" + userID
+}
+
+func bulkPhotoEvent(eventID, userID string, count int32) *event.BulkPhotoEvent {
+	return &event.BulkPhotoEvent{
+		EventID:         eventID,
+		BulkPhotoID:     "bulk-" + eventID,
+		UserCountMap:    map[string]int32{userID: count},
+		UserPhotoIDsMap: map[string][]string{userID: {"photo-1"}},
+	}
+}
+
+// quietNowSetting puts the user in quiet hours from an hour ago to an hour from now
+func quietNowSetting(userID string) *entity.NotificationSetting {
+	now := time.Now().UTC()
+	minute := now.Hour()*60 + now.Minute()
+	return &entity.NotificationSetting{
+		UserId:          userID,
+		Timezone:        "UTC",
+		QuietHoursStart: sql.NullInt16{Int16: int16((minute + 1440 - 60) % 1440), Valid: true},
+		QuietHoursEnd:   sql.NullInt16{Int16: int16((minute + 60) % 1440), Valid: true},
+	}
+}
+
+func TestDigestNotification(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Digest collects the matches and flushes one summary", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.store.setSetting(&entity.NotificationSetting{UserId: "user-1", Timezone: "UTC", DigestEnabled: true, DigestWindowMinutes: 30})
+
+		require.NoError(t, notificationUC.ProcessAndSendBulkNotificationsV2(ctx, bulkPhotoEvent("event-1", "user-1", 3)))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+		count, _ := mocks.redis.hashField(digestKey("user-1"), "count")
+		assert.Equal(t, "3", count)
+		due, ok := mocks.redis.zscore(digestDueKey, "user-1")
+		require.True(t, ok)
+		assert.InDelta(t, float64(time.Now().Add(30*time.Minute).Unix()), due, 5)
+
+		// A redelivered event is not counted twice and a later match keeps the first due time
+		require.NoError(t, notificationUC.ProcessAndSendBulkNotificationsV2(ctx, bulkPhotoEvent("event-1", "user-1", 3)))
+		require.NoError(t, notificationUC.ProcessAndSendBulkNotificationsV2(ctx, bulkPhotoEvent("event-2", "user-1", 2)))
+		count, _ = mocks.redis.hashField(digestKey("user-1"), "count")
+		assert.Equal(t, "5", count)
+		laterDue, _ := mocks.redis.zscore(digestDueKey, "user-1")
+		assert.Equal(t, due, laterDue)
+
+		// Nothing is sent before the digest is due
+		require.NoError(t, notificationUC.FlushDueDigests(ctx))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+
+		mocks.redis.setZScore(digestDueKey, "user-1", float64(time.Now().Add(-time.Minute).Unix()))
+		require.NoError(t, notificationUC.FlushDueDigests(ctx))
+		pushes := mocks.store.takePushes()
+		require.Len(t, pushes, 1)
+		assert.Equal(t, "user-1", pushes[0].userID)
+		assert.Equal(t, "true", pushes[0].data["digest"])
+		assert.Equal(t, "5", pushes[0].data["count"])
+
+		_, pending := mocks.redis.zscore(digestDueKey, "user-1")
+		assert.False(t, pending)
+		_, ok = mocks.redis.hashField(digestKey("user-1"), "count")
+		assert.False(t, ok)
+
+		require.NoError(t, notificationUC.FlushDueDigests(ctx))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+	})
+
+	t.Run("Match in quiet hours waits for the end of them", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.store.setSetting(quietNowSetting("user-1"))
+
+		require.NoError(t, notificationUC.ProcessAndSendBulkNotificationsV2(ctx, bulkPhotoEvent("event-1", "user-1", 1)))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+		due, ok := mocks.redis.zscore(digestDueKey, "user-1")
+		require.True(t, ok)
+		assert.InDelta(t, float64(time.Now().Add(time.Hour).Unix()), due, 120)
+
+		// A digest due while the user is still in quiet hours is postponed
+		mocks.redis.setZScore(digestDueKey, "user-1", float64(time.Now().Add(-time.Minute).Unix()))
+		require.NoError(t, notificationUC.FlushDueDigests(ctx))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+		postponed, _ := mocks.redis.zscore(digestDueKey, "user-1")
+		assert.Greater(t, postponed, float64(time.Now().Unix()))
+	})
+
+	t.Run("Non digestible notification in quiet hours stays in the inbox", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.store.setSetting(quietNowSetting("user-1"))
+
+		require.NoError(t, notificationUC.SendNotification(ctx, &model.SendNotificationRequest{
+			EventId:  "event-1",
+			UserIds:  []string{"user-1", "user-2"},
+			Category: enum.NotificationCategoryTransaction,
+			Title:    "Pembayaran Berhasil",
+			Body:     "Pembayaran berhasil",
+		}))
+
+		assert.Equal(t, []string{"user-2"}, mocks.store.pushedUserIDs())
+		assert.Equal(t, 2, mocks.store.inboxCount("event-1"))
+		_, pending := mocks.redis.zscore(digestDueKey, "user-1")
+		assert.False(t, pending)
+	})
+}
+
+func TestDeliverPushDeduplication(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("In-app disabled user is pushed once per event", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.store.disable(enum.NotificationChannelInApp, "user-1")
+		photoEvent := &event.SinglePhotoEvent{EventID: "event-1", PhotoID: "photo-1", UserIDs: []string{"user-1"}}
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Equal(t, []string{"user-1"}, mocks.store.pushedUserIDs())
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+	})
+
+	t.Run("Redelivered event pushes the users a failed attempt missed", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		photoEvent := &event.SinglePhotoEvent{EventID: "event-1", PhotoID: "photo-1", UserIDs: []string{"user-1"}}
+
+		mocks.store.setTokenErr(errDatabaseDown)
+		require.Error(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Equal(t, 1, mocks.store.inboxCount("event-1"))
+
+		mocks.store.setTokenErr(nil)
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Equal(t, []string{"user-1"}, mocks.store.pushedUserIDs())
+		assert.Equal(t, 1, mocks.store.inboxCount("event-1"))
+	})
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"firebase.google.com/go/v4/messaging"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"go.uber.org/mock/gomock"
+)
+
+var errDatabaseDown = errors.New("database is down")
+
+// sentPush is one multicast the cloud messaging mock received
+type sentPush struct {
+	userID string
+	data   map[string]string
+}
+
+// notificationStore backs the repository and messaging mocks with maps so a redelivered event sees what the first
+// delivery stored, like it does against postgres
+type notificationStore struct {
+	mu       sync.Mutex
+	inbox    map[string]*entity.Notification
+	disabled map[enum.NotificationChannelEnum]map[string]bool
+	settings map[string]*entity.NotificationSetting
+	tokenErr error
+	pushes   []*sentPush
+}
+
+type notificationMocks struct {
+	redis        *redisStub
+	store        *notificationStore
+	photoAdapter *mockadapter.MockPhotoAdapter
+}
+
+// newNotificationUseCase wires the notification use case on a redis stub and map backed mocks, every user has one
+// device token and every push succeeds unless a test changes the store
+func newNotificationUseCase(t *testing.T) (usecase.NotificationUseCase, *notificationMocks) {
+	ctrl := gomock.NewController(t)
+	redisClient, redisStub := newRedisStub(t)
+	store := &notificationStore{
+		inbox: map[string]*entity.Notification{},
+		disabled: map[enum.NotificationChannelEnum]map[string]bool{
+			enum.NotificationChannelInApp: {},
+			enum.NotificationChannelPush:  {},
+		},
+		settings: map[string]*entity.NotificationSetting{},
+	}
+
+	notificationRepo := mockrepository.NewMockNotificationRepository(ctrl)
+	notificationRepo.EXPECT().InsertBulk(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, tx interface{}, notifications []*entity.Notification) ([]string, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			userIDs := make([]string, 0, len(notifications))
+			for _, notification := range notifications {
+				key := notification.EventId + ":" + notification.UserId
+				if _, ok := store.inbox[key]; ok {
+					continue
+				}
+				store.inbox[key] = notification
+				userIDs = append(userIDs, notification.UserId)
+			}
+			return userIDs, nil
+		}).AnyTimes()
+
+	preferenceRepo := mockrepository.NewMockNotificationPreferenceRepository(ctrl)
+	preferenceRepo.EXPECT().FindDisabledUserIds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, tx interface{}, userIDs []string, category enum.NotificationCategoryEnum,
+			channel enum.NotificationChannelEnum) ([]string, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			disabledUserIDs := make([]string, 0)
+			for _, userID := range userIDs {
+				if store.disabled[channel][userID] {
+					disabledUserIDs = append(disabledUserIDs, userID)
+				}
+			}
+			return disabledUserIDs, nil
+		}).AnyTimes()
+
+	settingRepo := mockrepository.NewMockNotificationSettingRepository(ctrl)
+	settingRepo.EXPECT().FindByUserIds(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, tx interface{}, userIDs []string) ([]*entity.NotificationSetting, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			settings := make([]*entity.NotificationSetting, 0)
+			for _, userID := range userIDs {
+				if setting, ok := store.settings[userID]; ok {
+					settings = append(settings, setting)
+				}
+			}
+			return settings, nil
+		}).AnyTimes()
+
+	userDeviceRepo := mockrepository.NewMockUserDeviceRepository(ctrl)
+	userDeviceRepo.EXPECT().FetchFCMTokensFromPostgre(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, tx interface{}, userIDs []string) (*[]*entity.UserDevice, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			if store.tokenErr != nil {
+				return nil, store.tokenErr
+			}
+			devices := make([]*entity.UserDevice, 0, len(userIDs))
+			for _, userID := range userIDs {
+				devices = append(devices, &entity.UserDevice{UserId: userID, Token: "token:" + userID})
+			}
+			return &devices, nil
+		}).AnyTimes()
+
+	cloudMessaging := mockadapter.NewMockCloudMessagingAdapter(ctrl)
+	cloudMessaging.EXPECT().SendEachForMulticast(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, message *messaging.MulticastMessage) (*messaging.BatchResponse, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			responses := make([]*messaging.SendResponse, 0, len(message.Tokens))
+			for _, token := range message.Tokens {
+				store.pushes = append(store.pushes, &sentPush{userID: strings.TrimPrefix(token, "token:"), data: message.Data})
+				responses = append(responses, &messaging.SendResponse{Success: true})
+			}
+			return &messaging.BatchResponse{Responses: responses, SuccessCount: len(responses)}, nil
+		}).AnyTimes()
+
+	pushDeliveryRepo := mockrepository.NewMockPushDeliveryRepository(ctrl)
+	pushDeliveryRepo.EXPECT().InsertBulk(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mocks := &notificationMocks{
+		redis:        redisStub,
+		store:        store,
+		photoAdapter: mockadapter.NewMockPhotoAdapter(ctrl),
+	}
+
+	notificationUC := usecase.NewNotificationUseCase(mockrepository.NewMockBeginTx(ctrl), redisClient, userDeviceRepo, notificationRepo,
+		preferenceRepo, settingRepo, pushDeliveryRepo, cloudMessaging, mocks.photoAdapter, logger.New("test"))
+	return notificationUC, mocks
+}
+
+// takePushes returns the pushes sent since the last call
+func (s *notificationStore) takePushes() []*sentPush {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pushes := s.pushes
+	s.pushes = nil
+	return pushes
+}
+
+// pushedUserIDs returns the users that got a push since the last call, sorted
+func (s *notificationStore) pushedUserIDs() []string {
+	pushes := s.takePushes()
+	userIDs := make([]string, 0, len(pushes))
+	for _, push := range pushes {
+		userIDs = append(userIDs, push.userID)
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+func (s *notificationStore) inboxEntry(eventID, userID string) (*entity.Notification, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	notification, ok := s.inbox[eventID+":"+userID]
+	return notification, ok
+}
+
+func (s *notificationStore) disable(channel enum.NotificationChannelEnum, userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.disabled[channel][userID] = true
+}
+
+func (s *notificationStore) inboxCount(eventID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, notification := range s.inbox {
+		if notification.EventId == eventID {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *notificationStore) setTokenErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokenErr = err
+}
+
+func (s *notificationStore) setSetting(setting *entity.NotificationSetting) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings[setting.UserId] = setting
+}
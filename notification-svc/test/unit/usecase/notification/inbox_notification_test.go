@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverInbox(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Notification is stored with its deep link and pushed with its id", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, &event.SinglePhotoEvent{
+			EventID: "event-1",
+			PhotoID: "photo-1",
+			UserIDs: []string{"user-1"},
+		}))
+
+		notification, ok := mocks.store.inboxEntry("event-1", "user-1")
+		require.True(t, ok)
+		assert.Equal(t, enum.NotificationTypeSimilarPhoto, notification.Type)
+		assert.JSONEq(t, `{"count":1,"photo_ids":["photo-1"]}`, string(notification.Data))
+
+		pushes := mocks.store.takePushes()
+		require.Len(t, pushes, 1)
+		assert.Equal(t, notification.Id, pushes[0].data["notification_id"])
+		assert.Equal(t, `["photo-1"]`, pushes[0].data["photo_ids"])
+	})
+
+	t.Run("Redelivered event is stored once", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		photoEvent := &event.SinglePhotoEvent{EventID: "event-1", PhotoID: "photo-1", UserIDs: []string{"user-1", "user-2"}}
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Equal(t, []string{"user-1", "user-2"}, mocks.store.pushedUserIDs())
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, photoEvent))
+		assert.Equal(t, 2, mocks.store.inboxCount("event-1"))
+		assert.Empty(t, mocks.store.pushedUserIDs())
+	})
+
+	t.Run("In-app disabled user is pushed without an inbox entry", func(t *testing.T) {
+		notificationUC, mocks := newNotificationUseCase(t)
+		mocks.store.disable(enum.NotificationChannelInApp, "user-2")
+
+		require.NoError(t, notificationUC.ProcessAndSendSingleNotifications(ctx, &event.SinglePhotoEvent{
+			EventID: "event-1",
+			PhotoID: "photo-1",
+			UserIDs: []string{"user-1", "user-2"},
+		}))
+
+		_, stored := mocks.store.inboxEntry("event-1", "user-2")
+		assert.False(t, stored)
+		assert.Equal(t, 1, mocks.store.inboxCount("event-1"))
+		assert.Equal(t, []string{"user-1", "user-2"}, mocks.store.pushedUserIDs())
+	})
+}
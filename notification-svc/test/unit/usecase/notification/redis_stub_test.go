@@ -0,0 +1,469 @@
+package usecase
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	respStatus string
+	respError  string
+	respNil    struct{}
+)
+
+// redisStub is a local redis server keeping strings, sets, hashes and sorted sets in memory. It speaks RESP2 for the
+// commands the notification use case sends, expirations are recorded but keys never expire.
+type redisStub struct {
+	listener net.Listener
+	mu       sync.Mutex
+	strings  map[string]string
+	sets     map[string]map[string]struct{}
+	hashes   map[string]map[string]string
+	zsets    map[string]map[string]float64
+	ttls     map[string]time.Duration
+}
+
+func newRedisStub(t *testing.T) (*redis.Client, *redisStub) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	stub := &redisStub{
+		listener: listener,
+		strings:  map[string]string{},
+		sets:     map[string]map[string]struct{}{},
+		hashes:   map[string]map[string]string{},
+		zsets:    map[string]map[string]float64{},
+		ttls:     map[string]time.Duration{},
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go stub.serve(conn)
+		}
+	}()
+
+	client := redis.NewClient(&redis.Options{Addr: listener.Addr().String(), Protocol: 2, DisableIdentity: true})
+	t.Cleanup(func() {
+		client.Close()
+		listener.Close()
+	})
+	return client, stub
+}
+
+func (s *redisStub) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+
+	var queued [][]string
+	inMulti := false
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		var reply any
+		switch name := strings.ToUpper(args[0]); {
+		case name == "MULTI":
+			inMulti, queued = true, nil
+			reply = respStatus("OK")
+		case name == "EXEC":
+			replies := make([]any, 0, len(queued))
+			for _, command := range queued {
+				replies = append(replies, s.execute(command))
+			}
+			inMulti, queued = false, nil
+			reply = replies
+		case inMulti:
+			queued = append(queued, args)
+			reply = respStatus("QUEUED")
+		default:
+			reply = s.execute(args)
+		}
+
+		writeReply(writer, reply)
+		if reader.Buffered() == 0 {
+			if err := writer.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected command line %q", line)
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		args = append(args, string(data[:size]))
+	}
+	return args, nil
+}
+
+func writeReply(writer *bufio.Writer, reply any) {
+	switch r := reply.(type) {
+	case respStatus:
+		fmt.Fprintf(writer, "+%s\r\n", r)
+	case respError:
+		fmt.Fprintf(writer, "-%s\r\n", r)
+	case respNil:
+		writer.WriteString("$-1\r\n")
+	case int64:
+		fmt.Fprintf(writer, ":%d\r\n", r)
+	case string:
+		fmt.Fprintf(writer, "$%d\r\n%s\r\n", len(r), r)
+	case []any:
+		fmt.Fprintf(writer, "*%d\r\n", len(r))
+		for _, item := range r {
+			writeReply(writer, item)
+		}
+	}
+}
+
+func (s *redisStub) execute(args []string) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := ""
+	if len(args) > 1 {
+		key = args[1]
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return respStatus("PONG")
+	case "GET":
+		if value, ok := s.strings[key]; ok {
+			return value
+		}
+		return respNil{}
+	case "SET":
+		return s.set(args)
+	case "SETNX":
+		if s.exists(key) {
+			return int64(0)
+		}
+		s.strings[key] = args[2]
+		return int64(1)
+	case "DEL":
+		var removed int64
+		for _, key := range args[1:] {
+			if s.exists(key) {
+				removed++
+			}
+			s.delete(key)
+		}
+		return removed
+	case "EXISTS":
+		var count int64
+		for _, key := range args[1:] {
+			if s.exists(key) {
+				count++
+			}
+		}
+		return count
+	case "INCR":
+		return s.incrBy(key, 1)
+	case "DECR":
+		return s.incrBy(key, -1)
+	case "INCRBY", "DECRBY":
+		delta, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			return respError("ERR value is not an integer or out of range")
+		}
+		if strings.ToUpper(args[0]) == "DECRBY" {
+			delta = -delta
+		}
+		return s.incrBy(key, delta)
+	case "EXPIRE":
+		if !s.exists(key) {
+			return int64(0)
+		}
+		seconds, _ := strconv.Atoi(args[2])
+		s.ttls[key] = time.Duration(seconds) * time.Second
+		return int64(1)
+	case "SADD":
+		set, ok := s.sets[key]
+		if !ok {
+			set = map[string]struct{}{}
+			s.sets[key] = set
+		}
+		var added int64
+		for _, member := range args[2:] {
+			if _, ok := set[member]; !ok {
+				set[member] = struct{}{}
+				added++
+			}
+		}
+		return added
+	case "SREM":
+		var removed int64
+		for _, member := range args[2:] {
+			if _, ok := s.sets[key][member]; ok {
+				delete(s.sets[key], member)
+				removed++
+			}
+		}
+		return removed
+	case "SMEMBERS":
+		members := make([]any, 0, len(s.sets[key]))
+		for member := range s.sets[key] {
+			members = append(members, member)
+		}
+		return members
+	case "HINCRBY":
+		hash, ok := s.hashes[key]
+		if !ok {
+			hash = map[string]string{}
+			s.hashes[key] = hash
+		}
+		delta, _ := strconv.ParseInt(args[3], 10, 64)
+		value, _ := strconv.ParseInt(hash[args[2]], 10, 64)
+		value += delta
+		hash[args[2]] = strconv.FormatInt(value, 10)
+		return value
+	case "HGET":
+		if value, ok := s.hashes[key][args[2]]; ok {
+			return value
+		}
+		return respNil{}
+	case "ZADD":
+		return s.zadd(args)
+	case "ZREM":
+		var removed int64
+		for _, member := range args[2:] {
+			if _, ok := s.zsets[key][member]; ok {
+				delete(s.zsets[key], member)
+				removed++
+			}
+		}
+		return removed
+	case "ZSCORE":
+		if score, ok := s.zsets[key][args[2]]; ok {
+			return strconv.FormatFloat(score, 'f', -1, 64)
+		}
+		return respNil{}
+	case "ZRANGEBYSCORE":
+		return s.zrangeByScore(args)
+	default:
+		return respError(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+	}
+}
+
+// set supports the EX, PX and NX options go-redis sends for Set and SetNX
+func (s *redisStub) set(args []string) any {
+	key, value := args[1], args[2]
+	var ttl time.Duration
+	nx := false
+	for i := 3; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "NX":
+			nx = true
+		case "EX", "PX":
+			amount, _ := strconv.Atoi(args[i+1])
+			ttl = time.Duration(amount) * time.Second
+			if strings.ToUpper(args[i]) == "PX" {
+				ttl = time.Duration(amount) * time.Millisecond
+			}
+			i++
+		}
+	}
+
+	if nx && s.exists(key) {
+		return respNil{}
+	}
+	s.strings[key] = value
+	s.ttls[key] = ttl
+	return respStatus("OK")
+}
+
+func (s *redisStub) incrBy(key string, delta int64) any {
+	value, err := strconv.ParseInt(s.stringOrZero(key), 10, 64)
+	if err != nil {
+		return respError("ERR value is not an integer or out of range")
+	}
+	value += delta
+	s.strings[key] = strconv.FormatInt(value, 10)
+	return value
+}
+
+func (s *redisStub) zadd(args []string) any {
+	zset, ok := s.zsets[args[1]]
+	if !ok {
+		zset = map[string]float64{}
+		s.zsets[args[1]] = zset
+	}
+
+	i, nx := 2, false
+	if strings.ToUpper(args[i]) == "NX" {
+		nx = true
+		i++
+	}
+
+	var added int64
+	for ; i+1 < len(args); i += 2 {
+		score, err := strconv.ParseFloat(args[i], 64)
+		if err != nil {
+			return respError("ERR value is not a valid float")
+		}
+		member := args[i+1]
+		if _, ok := zset[member]; ok {
+			if !nx {
+				zset[member] = score
+			}
+			continue
+		}
+		zset[member] = score
+		added++
+	}
+	return added
+}
+
+func (s *redisStub) zrangeByScore(args []string) any {
+	low, high := parseScore(args[2]), parseScore(args[3])
+	offset, count := 0, -1
+	for i := 4; i < len(args); i++ {
+		if strings.ToUpper(args[i]) == "LIMIT" {
+			offset, _ = strconv.Atoi(args[i+1])
+			count, _ = strconv.Atoi(args[i+2])
+			i += 2
+		}
+	}
+
+	members := make([]string, 0, len(s.zsets[args[1]]))
+	for member, score := range s.zsets[args[1]] {
+		if score >= low && score <= high {
+			members = append(members, member)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		left, right := s.zsets[args[1]][members[i]], s.zsets[args[1]][members[j]]
+		if left != right {
+			return left < right
+		}
+		return members[i] < members[j]
+	})
+
+	members = members[min(offset, len(members)):]
+	if count >= 0 && count < len(members) {
+		members = members[:count]
+	}
+
+	replies := make([]any, 0, len(members))
+	for _, member := range members {
+		replies = append(replies, member)
+	}
+	return replies
+}
+
+func parseScore(value string) float64 {
+	switch value {
+	case "-inf":
+		return math.Inf(-1)
+	case "+inf", "inf":
+		return math.Inf(1)
+	}
+	score, _ := strconv.ParseFloat(value, 64)
+	return score
+}
+
+func (s *redisStub) exists(key string) bool {
+	_, isString := s.strings[key]
+	_, isSet := s.sets[key]
+	_, isHash := s.hashes[key]
+	_, isZSet := s.zsets[key]
+	return isString || isSet || isHash || isZSet
+}
+
+func (s *redisStub) delete(key string) {
+	delete(s.strings, key)
+	delete(s.sets, key)
+	delete(s.hashes, key)
+	delete(s.zsets, key)
+	delete(s.ttls, key)
+}
+
+func (s *redisStub) stringOrZero(key string) string {
+	if value, ok := s.strings[key]; ok {
+		return value
+	}
+	return "0"
+}
+
+// get returns the string value of the key, found is false when the key does not exist
+func (s *redisStub) get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.strings[key]
+	return value, ok
+}
+
+func (s *redisStub) setString(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strings[key] = value
+}
+
+func (s *redisStub) hashField(key, field string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.hashes[key][field]
+	return value, ok
+}
+
+// zscore returns the score of the member, found is false when the member is not in the sorted set
+func (s *redisStub) zscore(key, member string) (float64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	score, ok := s.zsets[key][member]
+	return score, ok
+}
+
+func (s *redisStub) setZScore(key, member string, score float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.zsets[key]; !ok {
+		s.zsets[key] = map[string]float64{}
+	}
+	s.zsets[key][member] = score
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/notification-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/notification-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newPushAnalyticsUseCase(t *testing.T) (usecase.PushAnalyticsUseCase, *mockrepository.MockPushDeliveryRepository) {
+	ctrl := gomock.NewController(t)
+	pushDeliveryRepo := mockrepository.NewMockPushDeliveryRepository(ctrl)
+	return usecase.NewPushAnalyticsUseCase(mockrepository.NewMockBeginTx(ctrl), pushDeliveryRepo, logger.New("test")), pushDeliveryRepo
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestGetCampaignMetric(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Rates are relative to the delivered pushes", func(t *testing.T) {
+		pushAnalyticsUC, pushDeliveryRepo := newPushAnalyticsUseCase(t)
+		pushDeliveryRepo.EXPECT().FindCampaignMetric(ctx, gomock.Any(), "event-1").Return(&entity.PushCampaignMetric{
+			CampaignId: "event-1",
+			Category:   enum.NotificationCategoryNewMatch,
+			Users:      9,
+			Pushes:     10,
+			Sent:       14,
+			Failed:     3,
+			Pruned:     1,
+			Delivered:  6,
+			Opened:     3,
+			Clicked:    2,
+			Converted:  1,
+			Revenue:    50000,
+		}, nil)
+
+		metric, err := pushAnalyticsUC.GetCampaignMetric(ctx, &model.GetPushCampaignMetricRequest{CampaignId: "event-1"})
+		require.NoError(t, err)
+		assert.Equal(t, 0.6, metric.DeliveryRate)
+		assert.Equal(t, 0.5, metric.OpenRate)
+		assert.Equal(t, 0.3333, metric.ClickRate)
+		assert.Equal(t, 0.1667, metric.ConversionRate)
+		assert.Equal(t, int64(50000), metric.Revenue)
+	})
+
+	t.Run("Campaign without delivered pushes has zero rates", func(t *testing.T) {
+		pushAnalyticsUC, pushDeliveryRepo := newPushAnalyticsUseCase(t)
+		pushDeliveryRepo.EXPECT().FindCampaignMetric(ctx, gomock.Any(), "event-1").Return(&entity.PushCampaignMetric{
+			CampaignId: "event-1",
+			Pushes:     4,
+			Failed:     4,
+		}, nil)
+
+		metric, err := pushAnalyticsUC.GetCampaignMetric(ctx, &model.GetPushCampaignMetricRequest{CampaignId: "event-1"})
+		require.NoError(t, err)
+		assert.Zero(t, metric.DeliveryRate)
+		assert.Zero(t, metric.OpenRate)
+		assert.Zero(t, metric.ConversionRate)
+	})
+
+	t.Run("Unknown campaign", func(t *testing.T) {
+		pushAnalyticsUC, pushDeliveryRepo := newPushAnalyticsUseCase(t)
+		pushDeliveryRepo.EXPECT().FindCampaignMetric(ctx, gomock.Any(), "event-1").Return(nil, sql.ErrNoRows)
+
+		_, err := pushAnalyticsUC.GetCampaignMetric(ctx, &model.GetPushCampaignMetricRequest{CampaignId: "event-1"})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+}
+
+func TestGetCampaignMetrics(t *testing.T) {
+	ctx := context.Background()
+	pushAnalyticsUC, pushDeliveryRepo := newPushAnalyticsUseCase(t)
+
+	pushDeliveryRepo.EXPECT().FindCampaignMetrics(ctx, gomock.Any(), "transaction", gomock.Any(), 20).DoAndReturn(
+		func(_ context.Context, _ any, _ string, since time.Time, _ int) ([]*entity.PushCampaignMetric, error) {
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, -7), since, time.Minute)
+			return []*entity.PushCampaignMetric{
+				{CampaignId: "event-2", Pushes: 2, Delivered: 2, Opened: 1},
+				{CampaignId: "event-1", Pushes: 1, Delivered: 1, Opened: 1},
+			}, nil
+		})
+
+	metrics, err := pushAnalyticsUC.GetCampaignMetrics(ctx, &model.GetPushCampaignMetricsRequest{Category: "transaction", Days: 7, Size: 20})
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+	assert.Equal(t, "event-2", metrics[0].CampaignId)
+	assert.Equal(t, 0.5, metrics[0].OpenRate)
+	assert.Equal(t, 1.0, metrics[1].OpenRate)
+}
+
+func TestAttributePurchase(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Purchase is credited to pushes opened within the conversion window", func(t *testing.T) {
+		pushAnalyticsUC, pushDeliveryRepo := newPushAnalyticsUseCase(t)
+		settledAt := time.Date(2025, 1, 4, 12, 0, 0, 0, time.UTC)
+		pushDeliveryRepo.EXPECT().AttributeConversion(ctx, gomock.Any(), "user-1", "transaction-1", int64(25000), settledAt,
+			settledAt.Add(-72*time.Hour)).Return(true, nil)
+
+		require.NoError(t, pushAnalyticsUC.AttributePurchase(ctx, &event.OwnerOwnPhotosEvent{
+			UserId:        "user-1",
+			TransactionId: "transaction-1",
+			Amount:        25000,
+			SettledAt:     &settledAt,
+		}))
+	})
+
+	t.Run("Event without a transaction is ignored", func(t *testing.T) {
+		pushAnalyticsUC, _ := newPushAnalyticsUseCase(t)
+
+		require.NoError(t, pushAnalyticsUC.AttributePurchase(ctx, &event.OwnerOwnPhotosEvent{UserId: "user-1"}))
+	})
+}
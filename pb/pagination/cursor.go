@@ -0,0 +1,59 @@
+// Package pagination holds the keyset cursor format shared by every service, a cursor encoded by one
+// service can be decoded by another.
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+// cursorSeparator splits the sort key from the id, the id never contains it so the sort key may
+const cursorSeparator = "|"
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the decoded keyset position of the last item of a page, SortKey is the sort column value and Id breaks ties
+type Cursor struct {
+	SortKey string
+	Id      string
+}
+
+// EncodeCursor builds the opaque keyset cursor from the sort key and id of the last item of a page
+func EncodeCursor(sortKey, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(sortKey + cursorSeparator + id))
+}
+
+// DecodeCursor returns nil for an empty cursor which means the first page
+func DecodeCursor(encoded string) (*Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	index := strings.LastIndex(string(payload), cursorSeparator)
+	if index <= 0 || index == len(payload)-1 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{SortKey: string(payload[:index]), Id: string(payload[index+1:])}, nil
+}
+
+// DecodeTimeCursor decodes a cursor whose sort key is a timestamp
+func DecodeTimeCursor(encoded string) (*Cursor, error) {
+	cursor, err := DecodeCursor(encoded)
+	if err != nil || cursor == nil {
+		return cursor, err
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, cursor.SortKey); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,87 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	t.Run("Empty cursor is the first page", func(t *testing.T) {
+		cursor, err := pagination.DecodeCursor("")
+		if err != nil || cursor != nil {
+			t.Fatalf("got %v, %v, want nil cursor", cursor, err)
+		}
+	})
+
+	valid := []struct {
+		name    string
+		sortKey string
+		id      string
+	}{
+		{name: "Encoded cursor decodes to its sort key and id", sortKey: "alice", id: "user-1"},
+		{name: "Sort key may contain the separator", sortKey: "a|b", id: "user-1"},
+	}
+
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := pagination.DecodeCursor(pagination.EncodeCursor(tt.sortKey, tt.id))
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if cursor.SortKey != tt.sortKey || cursor.Id != tt.id {
+				t.Fatalf("got %+v, want sort key %q and id %q", cursor, tt.sortKey, tt.id)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name    string
+		encoded string
+	}{
+		{name: "Not base64", encoded: "not a cursor!"},
+		{name: "No separator", encoded: base64.RawURLEncoding.EncodeToString([]byte("alice"))},
+		{name: "Empty sort key", encoded: base64.RawURLEncoding.EncodeToString([]byte("|user-1"))},
+		{name: "Empty id", encoded: base64.RawURLEncoding.EncodeToString([]byte("alice|"))},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := pagination.DecodeCursor(tt.encoded)
+			if !errors.Is(err, pagination.ErrInvalidCursor) || cursor != nil {
+				t.Fatalf("got %v, %v, want ErrInvalidCursor", cursor, err)
+			}
+		})
+	}
+}
+
+func TestDecodeTimeCursor(t *testing.T) {
+	t.Run("Empty cursor is the first page", func(t *testing.T) {
+		cursor, err := pagination.DecodeTimeCursor("")
+		if err != nil || cursor != nil {
+			t.Fatalf("got %v, %v, want nil cursor", cursor, err)
+		}
+	})
+
+	t.Run("Timestamp sort key keeps its precision", func(t *testing.T) {
+		createdAt := time.Date(2025, 11, 12, 10, 30, 15, 123456789, time.UTC).Format(time.RFC3339Nano)
+
+		cursor, err := pagination.DecodeTimeCursor(pagination.EncodeCursor(createdAt, "photo-1"))
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if cursor.SortKey != createdAt || cursor.Id != "photo-1" {
+			t.Fatalf("got %+v, want sort key %q", cursor, createdAt)
+		}
+	})
+
+	t.Run("Sort key that is not a timestamp", func(t *testing.T) {
+		cursor, err := pagination.DecodeTimeCursor(pagination.EncodeCursor("alice", "user-1"))
+		if !errors.Is(err, pagination.ErrInvalidCursor) || cursor != nil {
+			t.Fatalf("got %v, %v, want ErrInvalidCursor", cursor, err)
+		}
+	})
+}
@@ -1679,6 +1679,116 @@ func (x *GetCreatorResponse) GetError() string {
 	return ""
 }
 
+type GetCreatorsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatorIds []string `protobuf:"bytes,1,rep,name=creator_ids,json=creatorIds,proto3" json:"creator_ids,omitempty"`
+}
+
+func (x *GetCreatorsByIdsRequest) Reset() {
+	*x = GetCreatorsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreatorsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorsByIdsRequest) ProtoMessage() {}
+
+func (x *GetCreatorsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreatorsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCreatorsByIdsRequest) GetCreatorIds() []string {
+	if x != nil {
+		return x.CreatorIds
+	}
+	return nil
+}
+
+type GetCreatorsByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Creators []*Creator `protobuf:"bytes,2,rep,name=creators,proto3" json:"creators,omitempty"`
+	Error    string     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetCreatorsByIdsResponse) Reset() {
+	*x = GetCreatorsByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreatorsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorsByIdsResponse) ProtoMessage() {}
+
+func (x *GetCreatorsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetCreatorsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCreatorsByIdsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetCreatorsByIdsResponse) GetCreators() []*Creator {
+	if x != nil {
+		return x.Creators
+	}
+	return nil
+}
+
+func (x *GetCreatorsByIdsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type CheckoutItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1695,12 +1805,16 @@ type CheckoutItem struct {
 	DiscountMinQuantity int32  `protobuf:"varint,9,opt,name=discount_min_quantity,json=discountMinQuantity,proto3" json:"discount_min_quantity,omitempty"`
 	DiscountValue       int32  `protobuf:"varint,10,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
 	FinalPrice          int32  `protobuf:"varint,11,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	VoucherId           string `protobuf:"bytes,12,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	VoucherCode         string `protobuf:"bytes,13,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	VoucherDiscount     int32  `protobuf:"varint,14,opt,name=voucher_discount,json=voucherDiscount,proto3" json:"voucher_discount,omitempty"`
+	VoucherFundedBy     string `protobuf:"bytes,15,opt,name=voucher_funded_by,json=voucherFundedBy,proto3" json:"voucher_funded_by,omitempty"`
 }
 
 func (x *CheckoutItem) Reset() {
 	*x = CheckoutItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[23]
+		mi := &file_photo_photo_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1713,7 +1827,7 @@ func (x *CheckoutItem) String() string {
 func (*CheckoutItem) ProtoMessage() {}
 
 func (x *CheckoutItem) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[23]
+	mi := &file_photo_photo_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +1840,7 @@ func (x *CheckoutItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckoutItem.ProtoReflect.Descriptor instead.
 func (*CheckoutItem) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{23}
+	return file_photo_photo_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CheckoutItem) GetPhotoId() string {
@@ -1806,19 +1920,51 @@ func (x *CheckoutItem) GetFinalPrice() int32 {
 	return 0
 }
 
+func (x *CheckoutItem) GetVoucherId() string {
+	if x != nil {
+		return x.VoucherId
+	}
+	return ""
+}
+
+func (x *CheckoutItem) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *CheckoutItem) GetVoucherDiscount() int32 {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return 0
+}
+
+func (x *CheckoutItem) GetVoucherFundedBy() string {
+	if x != nil {
+		return x.VoucherFundedBy
+	}
+	return ""
+}
+
 type Total struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price    int32 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
-	Discount int32 `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	Price           int32  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Discount        int32  `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	VoucherDiscount int32  `protobuf:"varint,3,opt,name=voucher_discount,json=voucherDiscount,proto3" json:"voucher_discount,omitempty"`
+	VoucherId       string `protobuf:"bytes,4,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	VoucherCode     string `protobuf:"bytes,5,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	VoucherFundedBy string `protobuf:"bytes,6,opt,name=voucher_funded_by,json=voucherFundedBy,proto3" json:"voucher_funded_by,omitempty"`
 }
 
 func (x *Total) Reset() {
 	*x = Total{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[24]
+		mi := &file_photo_photo_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1831,7 +1977,7 @@ func (x *Total) String() string {
 func (*Total) ProtoMessage() {}
 
 func (x *Total) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[24]
+	mi := &file_photo_photo_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +1990,7 @@ func (x *Total) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Total.ProtoReflect.Descriptor instead.
 func (*Total) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{24}
+	return file_photo_photo_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Total) GetPrice() int32 {
@@ -1861,6 +2007,34 @@ func (x *Total) GetDiscount() int32 {
 	return 0
 }
 
+func (x *Total) GetVoucherDiscount() int32 {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return 0
+}
+
+func (x *Total) GetVoucherId() string {
+	if x != nil {
+		return x.VoucherId
+	}
+	return ""
+}
+
+func (x *Total) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *Total) GetVoucherFundedBy() string {
+	if x != nil {
+		return x.VoucherFundedBy
+	}
+	return ""
+}
+
 type CalculatePhotoPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1874,7 +2048,7 @@ type CalculatePhotoPriceRequest struct {
 func (x *CalculatePhotoPriceRequest) Reset() {
 	*x = CalculatePhotoPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[25]
+		mi := &file_photo_photo_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1887,7 +2061,7 @@ func (x *CalculatePhotoPriceRequest) String() string {
 func (*CalculatePhotoPriceRequest) ProtoMessage() {}
 
 func (x *CalculatePhotoPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[25]
+	mi := &file_photo_photo_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +2074,7 @@ func (x *CalculatePhotoPriceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculatePhotoPriceRequest.ProtoReflect.Descriptor instead.
 func (*CalculatePhotoPriceRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{25}
+	return file_photo_photo_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CalculatePhotoPriceRequest) GetUserId() string {
@@ -1938,7 +2112,7 @@ type CalculatePhotoPriceResponse struct {
 func (x *CalculatePhotoPriceResponse) Reset() {
 	*x = CalculatePhotoPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[26]
+		mi := &file_photo_photo_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1951,7 +2125,7 @@ func (x *CalculatePhotoPriceResponse) String() string {
 func (*CalculatePhotoPriceResponse) ProtoMessage() {}
 
 func (x *CalculatePhotoPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[26]
+	mi := &file_photo_photo_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2138,7 @@ func (x *CalculatePhotoPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculatePhotoPriceResponse.ProtoReflect.Descriptor instead.
 func (*CalculatePhotoPriceResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{26}
+	return file_photo_photo_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CalculatePhotoPriceResponse) GetStatus() int64 {
@@ -2000,14 +2174,15 @@ type OwnerOwnPhotosRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerId  string   `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	PhotoIds []string `protobuf:"bytes,2,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	OwnerId       string   `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	PhotoIds      []string `protobuf:"bytes,2,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	TransactionId string   `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (x *OwnerOwnPhotosRequest) Reset() {
 	*x = OwnerOwnPhotosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[27]
+		mi := &file_photo_photo_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2020,7 +2195,7 @@ func (x *OwnerOwnPhotosRequest) String() string {
 func (*OwnerOwnPhotosRequest) ProtoMessage() {}
 
 func (x *OwnerOwnPhotosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[27]
+	mi := &file_photo_photo_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2208,7 @@ func (x *OwnerOwnPhotosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnerOwnPhotosRequest.ProtoReflect.Descriptor instead.
 func (*OwnerOwnPhotosRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{27}
+	return file_photo_photo_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *OwnerOwnPhotosRequest) GetOwnerId() string {
@@ -2050,6 +2225,13 @@ func (x *OwnerOwnPhotosRequest) GetPhotoIds() []string {
 	return nil
 }
 
+func (x *OwnerOwnPhotosRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
 type OwnerOwnPhotosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2062,7 +2244,7 @@ type OwnerOwnPhotosResponse struct {
 func (x *OwnerOwnPhotosResponse) Reset() {
 	*x = OwnerOwnPhotosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[28]
+		mi := &file_photo_photo_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2075,7 +2257,7 @@ func (x *OwnerOwnPhotosResponse) String() string {
 func (*OwnerOwnPhotosResponse) ProtoMessage() {}
 
 func (x *OwnerOwnPhotosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[28]
+	mi := &file_photo_photo_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,7 +2270,7 @@ func (x *OwnerOwnPhotosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnerOwnPhotosResponse.ProtoReflect.Descriptor instead.
 func (*OwnerOwnPhotosResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{28}
+	return file_photo_photo_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OwnerOwnPhotosResponse) GetStatus() int64 {
@@ -2120,7 +2302,7 @@ type BulkPhoto struct {
 func (x *BulkPhoto) Reset() {
 	*x = BulkPhoto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[29]
+		mi := &file_photo_photo_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2133,7 +2315,7 @@ func (x *BulkPhoto) String() string {
 func (*BulkPhoto) ProtoMessage() {}
 
 func (x *BulkPhoto) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[29]
+	mi := &file_photo_photo_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,7 +2328,7 @@ func (x *BulkPhoto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkPhoto.ProtoReflect.Descriptor instead.
 func (*BulkPhoto) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{29}
+	return file_photo_photo_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *BulkPhoto) GetId() string {
@@ -2196,7 +2378,7 @@ type CreateBulkPhotoRequest struct {
 func (x *CreateBulkPhotoRequest) Reset() {
 	*x = CreateBulkPhotoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[30]
+		mi := &file_photo_photo_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2209,7 +2391,7 @@ func (x *CreateBulkPhotoRequest) String() string {
 func (*CreateBulkPhotoRequest) ProtoMessage() {}
 
 func (x *CreateBulkPhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[30]
+	mi := &file_photo_photo_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,7 +2404,7 @@ func (x *CreateBulkPhotoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBulkPhotoRequest.ProtoReflect.Descriptor instead.
 func (*CreateBulkPhotoRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{30}
+	return file_photo_photo_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateBulkPhotoRequest) GetBulkPhoto() *BulkPhoto {
@@ -2251,7 +2433,7 @@ type CreateBulkPhotoResponse struct {
 func (x *CreateBulkPhotoResponse) Reset() {
 	*x = CreateBulkPhotoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[31]
+		mi := &file_photo_photo_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2264,7 +2446,7 @@ func (x *CreateBulkPhotoResponse) String() string {
 func (*CreateBulkPhotoResponse) ProtoMessage() {}
 
 func (x *CreateBulkPhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[31]
+	mi := &file_photo_photo_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,7 +2459,7 @@ func (x *CreateBulkPhotoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBulkPhotoResponse.ProtoReflect.Descriptor instead.
 func (*CreateBulkPhotoResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{31}
+	return file_photo_photo_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreateBulkPhotoResponse) GetStatus() int64 {
@@ -2306,7 +2488,7 @@ type BulkUserSimilarPhoto struct {
 func (x *BulkUserSimilarPhoto) Reset() {
 	*x = BulkUserSimilarPhoto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[32]
+		mi := &file_photo_photo_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2319,7 +2501,7 @@ func (x *BulkUserSimilarPhoto) String() string {
 func (*BulkUserSimilarPhoto) ProtoMessage() {}
 
 func (x *BulkUserSimilarPhoto) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[32]
+	mi := &file_photo_photo_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2332,7 +2514,7 @@ func (x *BulkUserSimilarPhoto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkUserSimilarPhoto.ProtoReflect.Descriptor instead.
 func (*BulkUserSimilarPhoto) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{32}
+	return file_photo_photo_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *BulkUserSimilarPhoto) GetPhotoDetail() *PhotoDetail {
@@ -2361,7 +2543,7 @@ type CreateBulkUserSimilarPhotoRequest struct {
 func (x *CreateBulkUserSimilarPhotoRequest) Reset() {
 	*x = CreateBulkUserSimilarPhotoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[33]
+		mi := &file_photo_photo_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2374,7 +2556,7 @@ func (x *CreateBulkUserSimilarPhotoRequest) String() string {
 func (*CreateBulkUserSimilarPhotoRequest) ProtoMessage() {}
 
 func (x *CreateBulkUserSimilarPhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[33]
+	mi := &file_photo_photo_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2387,7 +2569,7 @@ func (x *CreateBulkUserSimilarPhotoRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateBulkUserSimilarPhotoRequest.ProtoReflect.Descriptor instead.
 func (*CreateBulkUserSimilarPhotoRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{33}
+	return file_photo_photo_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateBulkUserSimilarPhotoRequest) GetBulkPhoto() *BulkPhoto {
@@ -2416,7 +2598,7 @@ type CreateBulkUserSimilarPhotoResponse struct {
 func (x *CreateBulkUserSimilarPhotoResponse) Reset() {
 	*x = CreateBulkUserSimilarPhotoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[34]
+		mi := &file_photo_photo_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2429,7 +2611,7 @@ func (x *CreateBulkUserSimilarPhotoResponse) String() string {
 func (*CreateBulkUserSimilarPhotoResponse) ProtoMessage() {}
 
 func (x *CreateBulkUserSimilarPhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[34]
+	mi := &file_photo_photo_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,7 +2624,7 @@ func (x *CreateBulkUserSimilarPhotoResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateBulkUserSimilarPhotoResponse.ProtoReflect.Descriptor instead.
 func (*CreateBulkUserSimilarPhotoResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{34}
+	return file_photo_photo_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateBulkUserSimilarPhotoResponse) GetStatus() int64 {
@@ -2470,7 +2652,7 @@ type CountMap struct {
 func (x *CountMap) Reset() {
 	*x = CountMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[35]
+		mi := &file_photo_photo_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2483,7 +2665,7 @@ func (x *CountMap) String() string {
 func (*CountMap) ProtoMessage() {}
 
 func (x *CountMap) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[35]
+	mi := &file_photo_photo_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +2678,7 @@ func (x *CountMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountMap.ProtoReflect.Descriptor instead.
 func (*CountMap) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{35}
+	return file_photo_photo_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CountMap) GetCountMap() map[string]int32 {
@@ -2518,7 +2700,7 @@ type GetPhotoWithDetailsRequest struct {
 func (x *GetPhotoWithDetailsRequest) Reset() {
 	*x = GetPhotoWithDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[36]
+		mi := &file_photo_photo_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2531,7 +2713,7 @@ func (x *GetPhotoWithDetailsRequest) String() string {
 func (*GetPhotoWithDetailsRequest) ProtoMessage() {}
 
 func (x *GetPhotoWithDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[36]
+	mi := &file_photo_photo_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2726,7 @@ func (x *GetPhotoWithDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPhotoWithDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetPhotoWithDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{36}
+	return file_photo_photo_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetPhotoWithDetailsRequest) GetUserId() string {
@@ -2574,7 +2756,7 @@ type GetPhotoWithDetailsResponse struct {
 func (x *GetPhotoWithDetailsResponse) Reset() {
 	*x = GetPhotoWithDetailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[37]
+		mi := &file_photo_photo_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2587,7 +2769,7 @@ func (x *GetPhotoWithDetailsResponse) String() string {
 func (*GetPhotoWithDetailsResponse) ProtoMessage() {}
 
 func (x *GetPhotoWithDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[37]
+	mi := &file_photo_photo_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2600,7 +2782,7 @@ func (x *GetPhotoWithDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPhotoWithDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetPhotoWithDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{37}
+	return file_photo_photo_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPhotoWithDetailsResponse) GetStatus() int64 {
@@ -2629,14 +2811,15 @@ type CancelPhotosRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PhotoIds []string `protobuf:"bytes,2,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	UserId        string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhotoIds      []string `protobuf:"bytes,2,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	TransactionId string   `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (x *CancelPhotosRequest) Reset() {
 	*x = CancelPhotosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[38]
+		mi := &file_photo_photo_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2649,7 +2832,7 @@ func (x *CancelPhotosRequest) String() string {
 func (*CancelPhotosRequest) ProtoMessage() {}
 
 func (x *CancelPhotosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[38]
+	mi := &file_photo_photo_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,7 +2845,7 @@ func (x *CancelPhotosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPhotosRequest.ProtoReflect.Descriptor instead.
 func (*CancelPhotosRequest) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{38}
+	return file_photo_photo_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CancelPhotosRequest) GetUserId() string {
@@ -2679,6 +2862,13 @@ func (x *CancelPhotosRequest) GetPhotoIds() []string {
 	return nil
 }
 
+func (x *CancelPhotosRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
 type CancelPhotosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2691,7 +2881,7 @@ type CancelPhotosResponse struct {
 func (x *CancelPhotosResponse) Reset() {
 	*x = CancelPhotosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[39]
+		mi := &file_photo_photo_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2704,7 +2894,7 @@ func (x *CancelPhotosResponse) String() string {
 func (*CancelPhotosResponse) ProtoMessage() {}
 
 func (x *CancelPhotosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[39]
+	mi := &file_photo_photo_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2717,7 +2907,7 @@ func (x *CancelPhotosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPhotosResponse.ProtoReflect.Descriptor instead.
 func (*CancelPhotosResponse) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{39}
+	return file_photo_photo_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CancelPhotosResponse) GetStatus() int64 {
@@ -2750,7 +2940,7 @@ type CheckoutItemWeb struct {
 func (x *CheckoutItemWeb) Reset() {
 	*x = CheckoutItemWeb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[40]
+		mi := &file_photo_photo_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2763,7 +2953,7 @@ func (x *CheckoutItemWeb) String() string {
 func (*CheckoutItemWeb) ProtoMessage() {}
 
 func (x *CheckoutItemWeb) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[40]
+	mi := &file_photo_photo_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +2966,7 @@ func (x *CheckoutItemWeb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckoutItemWeb.ProtoReflect.Descriptor instead.
 func (*CheckoutItemWeb) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{40}
+	return file_photo_photo_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CheckoutItemWeb) GetPhotoId() string {
@@ -2836,7 +3026,7 @@ type Discount struct {
 func (x *Discount) Reset() {
 	*x = Discount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[41]
+		mi := &file_photo_photo_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2849,7 +3039,7 @@ func (x *Discount) String() string {
 func (*Discount) ProtoMessage() {}
 
 func (x *Discount) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[41]
+	mi := &file_photo_photo_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,7 +3052,7 @@ func (x *Discount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discount.ProtoReflect.Descriptor instead.
 func (*Discount) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{41}
+	return file_photo_photo_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *Discount) GetDiscount() int32 {
@@ -2910,12 +3100,15 @@ type CalculatePhotoPriceV2Request struct {
 	ChekoutItemWeb []*CheckoutItemWeb `protobuf:"bytes,3,rep,name=chekout_item_web,json=chekoutItemWeb,proto3" json:"chekout_item_web,omitempty"`
 	TotalPrice     int32              `protobuf:"varint,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	TotalDiscount  int32              `protobuf:"varint,5,opt,name=total_discount,json=totalDiscount,proto3" json:"total_discount,omitempty"`
+	TransactionId  string             `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	VoucherCode    string             `protobuf:"bytes,7,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	QuoteToken     string             `protobuf:"bytes,8,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`
 }
 
 func (x *CalculatePhotoPriceV2Request) Reset() {
 	*x = CalculatePhotoPriceV2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[42]
+		mi := &file_photo_photo_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2928,7 +3121,7 @@ func (x *CalculatePhotoPriceV2Request) String() string {
 func (*CalculatePhotoPriceV2Request) ProtoMessage() {}
 
 func (x *CalculatePhotoPriceV2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[42]
+	mi := &file_photo_photo_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2941,7 +3134,7 @@ func (x *CalculatePhotoPriceV2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculatePhotoPriceV2Request.ProtoReflect.Descriptor instead.
 func (*CalculatePhotoPriceV2Request) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{42}
+	return file_photo_photo_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CalculatePhotoPriceV2Request) GetUserId() string {
@@ -2979,34 +3172,861 @@ func (x *CalculatePhotoPriceV2Request) GetTotalDiscount() int32 {
 	return 0
 }
 
-type CalculatePhotoPriceV2Response struct {
-	state         protoimpl.MessageState
+func (x *CalculatePhotoPriceV2Request) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CalculatePhotoPriceV2Request) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *CalculatePhotoPriceV2Request) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+// When price_changed is true the checkout is rejected, items and total hold the current price
+// and quote_token is a fresh quote for it.
+type CalculatePhotoPriceV2Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status             int64                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Items              []*CheckoutItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Total              *Total                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Error              string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	PriceChanged       bool                   `protobuf:"varint,5,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`
+	PriceChangedReason string                 `protobuf:"bytes,6,opt,name=price_changed_reason,json=priceChangedReason,proto3" json:"price_changed_reason,omitempty"`
+	QuoteToken         string                 `protobuf:"bytes,7,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`
+	QuoteExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	QuoteId            string                 `protobuf:"bytes,9,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+}
+
+func (x *CalculatePhotoPriceV2Response) Reset() {
+	*x = CalculatePhotoPriceV2Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculatePhotoPriceV2Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePhotoPriceV2Response) ProtoMessage() {}
+
+func (x *CalculatePhotoPriceV2Response) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePhotoPriceV2Response.ProtoReflect.Descriptor instead.
+func (*CalculatePhotoPriceV2Response) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CalculatePhotoPriceV2Response) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CalculatePhotoPriceV2Response) GetItems() []*CheckoutItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CalculatePhotoPriceV2Response) GetTotal() *Total {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CalculatePhotoPriceV2Response) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CalculatePhotoPriceV2Response) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CalculatePhotoPriceV2Response) GetPriceChangedReason() string {
+	if x != nil {
+		return x.PriceChangedReason
+	}
+	return ""
+}
+
+func (x *CalculatePhotoPriceV2Response) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *CalculatePhotoPriceV2Response) GetQuoteExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteExpiresAt
+	}
+	return nil
+}
+
+func (x *CalculatePhotoPriceV2Response) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+// ListEventAttendeeUserIdsRequest pages through the users found in the photos of a bulk photo upload sorted by id, the
+// next page starts after the last user id of the previous one
+type ListEventAttendeeUserIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BulkPhotoId string `protobuf:"bytes,1,opt,name=bulk_photo_id,json=bulkPhotoId,proto3" json:"bulk_photo_id,omitempty"`
+	AfterUserId string `protobuf:"bytes,2,opt,name=after_user_id,json=afterUserId,proto3" json:"after_user_id,omitempty"`
+	Limit       int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListEventAttendeeUserIdsRequest) Reset() {
+	*x = ListEventAttendeeUserIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventAttendeeUserIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendeeUserIdsRequest) ProtoMessage() {}
+
+func (x *ListEventAttendeeUserIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendeeUserIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventAttendeeUserIdsRequest) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListEventAttendeeUserIdsRequest) GetBulkPhotoId() string {
+	if x != nil {
+		return x.BulkPhotoId
+	}
+	return ""
+}
+
+func (x *ListEventAttendeeUserIdsRequest) GetAfterUserId() string {
+	if x != nil {
+		return x.AfterUserId
+	}
+	return ""
+}
+
+func (x *ListEventAttendeeUserIdsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListEventAttendeeUserIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  int64    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserIds []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *ListEventAttendeeUserIdsResponse) Reset() {
+	*x = ListEventAttendeeUserIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventAttendeeUserIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendeeUserIdsResponse) ProtoMessage() {}
+
+func (x *ListEventAttendeeUserIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendeeUserIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventAttendeeUserIdsResponse) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListEventAttendeeUserIdsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListEventAttendeeUserIdsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListEventAttendeeUserIdsResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// ListCreatorUserIdsRequest pages through the users owning a creator account sorted by id, the next page starts after
+// the last user id of the previous one
+type ListCreatorUserIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AfterUserId string `protobuf:"bytes,1,opt,name=after_user_id,json=afterUserId,proto3" json:"after_user_id,omitempty"`
+	Limit       int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListCreatorUserIdsRequest) Reset() {
+	*x = ListCreatorUserIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCreatorUserIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreatorUserIdsRequest) ProtoMessage() {}
+
+func (x *ListCreatorUserIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreatorUserIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListCreatorUserIdsRequest) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCreatorUserIdsRequest) GetAfterUserId() string {
+	if x != nil {
+		return x.AfterUserId
+	}
+	return ""
+}
+
+func (x *ListCreatorUserIdsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCreatorUserIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  int64    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserIds []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *ListCreatorUserIdsResponse) Reset() {
+	*x = ListCreatorUserIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCreatorUserIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreatorUserIdsResponse) ProtoMessage() {}
+
+func (x *ListCreatorUserIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreatorUserIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListCreatorUserIdsResponse) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCreatorUserIdsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListCreatorUserIdsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListCreatorUserIdsResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// ChatPhotoPreview preview url always points to the watermarked photo
+type ChatPhotoPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhotoId    string `protobuf:"bytes,1,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+	CreatorId  string `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Title      string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Price      int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	PriceStr   string `protobuf:"bytes,5,opt,name=price_str,json=priceStr,proto3" json:"price_str,omitempty"`
+	PreviewUrl string `protobuf:"bytes,6,opt,name=preview_url,json=previewUrl,proto3" json:"preview_url,omitempty"`
+	IsBuyable  bool   `protobuf:"varint,7,opt,name=is_buyable,json=isBuyable,proto3" json:"is_buyable,omitempty"`
+}
+
+func (x *ChatPhotoPreview) Reset() {
+	*x = ChatPhotoPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatPhotoPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatPhotoPreview) ProtoMessage() {}
+
+func (x *ChatPhotoPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatPhotoPreview.ProtoReflect.Descriptor instead.
+func (*ChatPhotoPreview) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChatPhotoPreview) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+func (x *ChatPhotoPreview) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *ChatPhotoPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChatPhotoPreview) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ChatPhotoPreview) GetPriceStr() string {
+	if x != nil {
+		return x.PriceStr
+	}
+	return ""
+}
+
+func (x *ChatPhotoPreview) GetPreviewUrl() string {
+	if x != nil {
+		return x.PreviewUrl
+	}
+	return ""
+}
+
+func (x *ChatPhotoPreview) GetIsBuyable() bool {
+	if x != nil {
+		return x.IsBuyable
+	}
+	return false
+}
+
+// GetChatPhotoPreviewsRequest only the photos the user can see are returned
+type GetChatPhotoPreviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhotoIds []string `protobuf:"bytes,2,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+}
+
+func (x *GetChatPhotoPreviewsRequest) Reset() {
+	*x = GetChatPhotoPreviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatPhotoPreviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatPhotoPreviewsRequest) ProtoMessage() {}
+
+func (x *GetChatPhotoPreviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatPhotoPreviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetChatPhotoPreviewsRequest) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetChatPhotoPreviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetChatPhotoPreviewsRequest) GetPhotoIds() []string {
+	if x != nil {
+		return x.PhotoIds
+	}
+	return nil
+}
+
+type GetChatPhotoPreviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64               `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string              `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Previews []*ChatPhotoPreview `protobuf:"bytes,3,rep,name=previews,proto3" json:"previews,omitempty"`
+}
+
+func (x *GetChatPhotoPreviewsResponse) Reset() {
+	*x = GetChatPhotoPreviewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatPhotoPreviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatPhotoPreviewsResponse) ProtoMessage() {}
+
+func (x *GetChatPhotoPreviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatPhotoPreviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetChatPhotoPreviewsResponse) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetChatPhotoPreviewsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetChatPhotoPreviewsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetChatPhotoPreviewsResponse) GetPreviews() []*ChatPhotoPreview {
+	if x != nil {
+		return x.Previews
+	}
+	return nil
+}
+
+// UserDataExportPurchase is a photo bought by the user
+type UserDataExportPurchase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhotoId    string                 `protobuf:"bytes,1,opt,name=photo_id,json=photoId,proto3" json:"photo_id,omitempty"`
+	CreatorId  string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Price      int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	PriceStr   string                 `protobuf:"bytes,5,opt,name=price_str,json=priceStr,proto3" json:"price_str,omitempty"`
+	OriginalAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=original_at,json=originalAt,proto3" json:"original_at,omitempty"`
+}
+
+func (x *UserDataExportPurchase) Reset() {
+	*x = UserDataExportPurchase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataExportPurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportPurchase) ProtoMessage() {}
+
+func (x *UserDataExportPurchase) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExportPurchase.ProtoReflect.Descriptor instead.
+func (*UserDataExportPurchase) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UserDataExportPurchase) GetPhotoId() string {
+	if x != nil {
+		return x.PhotoId
+	}
+	return ""
+}
+
+func (x *UserDataExportPurchase) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *UserDataExportPurchase) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserDataExportPurchase) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UserDataExportPurchase) GetPriceStr() string {
+	if x != nil {
+		return x.PriceStr
+	}
+	return ""
+}
+
+func (x *UserDataExportPurchase) GetOriginalAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalAt
+	}
+	return nil
+}
+
+type UserDataExportFacecam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	IsProcessed bool                   `protobuf:"varint,5,opt,name=is_processed,json=isProcessed,proto3" json:"is_processed,omitempty"`
+	OriginalAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=original_at,json=originalAt,proto3" json:"original_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *UserDataExportFacecam) Reset() {
+	*x = UserDataExportFacecam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataExportFacecam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportFacecam) ProtoMessage() {}
+
+func (x *UserDataExportFacecam) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExportFacecam.ProtoReflect.Descriptor instead.
+func (*UserDataExportFacecam) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UserDataExportFacecam) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserDataExportFacecam) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UserDataExportFacecam) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserDataExportFacecam) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UserDataExportFacecam) GetIsProcessed() bool {
+	if x != nil {
+		return x.IsProcessed
+	}
+	return false
+}
+
+func (x *UserDataExportFacecam) GetOriginalAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OriginalAt
+	}
+	return nil
+}
+
+func (x *UserDataExportFacecam) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetUserDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserDataExportRequest) Reset() {
+	*x = GetUserDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_photo_photo_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataExportRequest) ProtoMessage() {}
+
+func (x *GetUserDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetUserDataExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDataExportResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Items  []*CheckoutItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	Total  *Total          `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
-	Error  string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Status    int64                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error     string                    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Purchases []*UserDataExportPurchase `protobuf:"bytes,3,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	Facecams  []*UserDataExportFacecam  `protobuf:"bytes,4,rep,name=facecams,proto3" json:"facecams,omitempty"`
 }
 
-func (x *CalculatePhotoPriceV2Response) Reset() {
-	*x = CalculatePhotoPriceV2Response{}
+func (x *GetUserDataExportResponse) Reset() {
+	*x = GetUserDataExportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_photo_photo_proto_msgTypes[43]
+		mi := &file_photo_photo_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CalculatePhotoPriceV2Response) String() string {
+func (x *GetUserDataExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculatePhotoPriceV2Response) ProtoMessage() {}
+func (*GetUserDataExportResponse) ProtoMessage() {}
 
-func (x *CalculatePhotoPriceV2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_photo_photo_proto_msgTypes[43]
+func (x *GetUserDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_photo_photo_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3017,37 +4037,37 @@ func (x *CalculatePhotoPriceV2Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculatePhotoPriceV2Response.ProtoReflect.Descriptor instead.
-func (*CalculatePhotoPriceV2Response) Descriptor() ([]byte, []int) {
-	return file_photo_photo_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetUserDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_photo_photo_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CalculatePhotoPriceV2Response) GetStatus() int64 {
+func (x *GetUserDataExportResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *CalculatePhotoPriceV2Response) GetItems() []*CheckoutItem {
+func (x *GetUserDataExportResponse) GetError() string {
 	if x != nil {
-		return x.Items
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *CalculatePhotoPriceV2Response) GetTotal() *Total {
+func (x *GetUserDataExportResponse) GetPurchases() []*UserDataExportPurchase {
 	if x != nil {
-		return x.Total
+		return x.Purchases
 	}
 	return nil
 }
 
-func (x *CalculatePhotoPriceV2Response) GetError() string {
+func (x *GetUserDataExportResponse) GetFacecams() []*UserDataExportFacecam {
 	if x != nil {
-		return x.Error
+		return x.Facecams
 	}
-	return ""
+	return nil
 }
 
 var File_photo_photo_proto protoreflect.FileDescriptor
@@ -3298,43 +4318,226 @@ var file_photo_photo_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xfc, 0x02, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x6d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x79,
-	0x6f, 0x75, 0x72, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69,
-	0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0x39, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x1a,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x74,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x95, 0x04, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x6d, 0x6f,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x79, 0x6f, 0x75, 0x72, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x22, 0xd2, 0x01, 0x0a,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x5f, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x42,
+	0x79, 0x22, 0x71, 0x0a, 0x1a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x49, 0x64, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x76, 0x0a, 0x15, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xdc, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x6c, 0x6b, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x6f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x75,
+	0x6c, 0x6b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x52, 0x09, 0x62, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x73, 0x22, 0x47, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x42,
+	0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x0a, 0x12, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x10,
+	0x75, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x22, 0xa8, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x62, 0x75,
+	0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x52, 0x0a, 0x17, 0x62, 0x75, 0x6c, 0x6b, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x14, 0x62, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x22, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x83, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x3a, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3a, 0x0a, 0x12, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x10, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x72, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
 	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22,
-	0x9a, 0x01, 0x0a, 0x1b, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01,
+	0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x65,
+	0x62, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xcb, 0x02, 0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x65, 0x62, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x6b,
+	0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x65, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf5, 0x02,
+	0x0a, 0x1d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
@@ -3342,243 +4545,244 @@ var file_photo_photo_proto_rawDesc = []byte{
 	0x6d, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
 	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x15,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x46, 0x0a,
-	0x16, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdc, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62,
-	0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x75, 0x6c, 0x6b,
+	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x22, 0xd5, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x62, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x42, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x53, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x81,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x72,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x74, 0x22, 0x89, 0x02,
+	0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
-	0x0a, 0x0a, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x62, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12,
-	0x24, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0x47, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x93,
-	0x01, 0x0a, 0x14, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c,
-	0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x0a,
-	0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x75,
-	0x6c, 0x6b, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f,
-	0x52, 0x09, 0x62, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x52, 0x0a, 0x17, 0x62,
-	0x75, 0x6c, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
-	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d,
-	0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x14, 0x62, 0x75, 0x6c, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x22,
-	0x52, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70,
-	0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x3b, 0x0a, 0x0d,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x87, 0x01,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x12, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52,
-	0x10, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4b, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x49, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc0,
+	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x65, 0x62, 0x12, 0x19,
-	0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69,
-	0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe0, 0x01, 0x0a,
-	0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x6b, 0x6f, 0x75, 0x74,
-	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x77, 0x65, 0x62, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x57, 0x65, 0x62, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x6b, 0x6f, 0x75, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x57, 0x65, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x9c, 0x01, 0x0a, 0x1d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x9f,
-	0x0b, 0x0a, 0x0c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x68, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x2e, 0x70, 0x68, 0x6f,
-	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x09, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x66, 0x61, 0x63, 0x65, 0x63,
+	0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x52, 0x08, 0x66, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d,
+	0x73, 0x32, 0xf5, 0x0e, 0x0a, 0x0c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x65, 0x72, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x12, 0x22, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6b, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69,
-	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46, 0x61,
-	0x63, 0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x12, 0x1b,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x12,
+	0x26, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61,
+	0x6d, 0x12, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
 	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63,
-	0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1f,
-	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x2e,
-	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x23, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c,
-	0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68,
-	0x6f, 0x74, 0x6f, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c,
-	0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x65, 0x63, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x1f, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x18, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x21, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56,
+	0x32, 0x12, 0x23, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x32, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x56, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x1c,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x1d,
 	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c,
-	0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x21, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74,
-	0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
-	0x65, 0x72, 0x76, 0x69, 0x62, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x65, 0x2d, 0x79, 0x6f, 0x75, 0x72,
-	0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x70,
-	0x62, 0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x28, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74,
+	0x68, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x57, 0x69, 0x74, 0x68,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68,
+	0x6f, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x65, 0x72, 0x76, 0x69, 0x62, 0x65, 0x73,
+	0x74, 0x2f, 0x62, 0x65, 0x2d, 0x79, 0x6f, 0x75, 0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x3b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3593,7 +4797,7 @@ func file_photo_photo_proto_rawDescGZIP() []byte {
 	return file_photo_photo_proto_rawDescData
 }
 
-var file_photo_photo_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_photo_photo_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_photo_photo_proto_goTypes = []interface{}{
 	(*Photo)(nil),                              // 0: photo.Photo
 	(*PhotoDetail)(nil),                        // 1: photo.PhotoDetail
@@ -3618,113 +4822,144 @@ var file_photo_photo_proto_goTypes = []interface{}{
 	(*CreateCreatorResponse)(nil),              // 20: photo.CreateCreatorResponse
 	(*GetCreatorRequest)(nil),                  // 21: photo.GetCreatorRequest
 	(*GetCreatorResponse)(nil),                 // 22: photo.GetCreatorResponse
-	(*CheckoutItem)(nil),                       // 23: photo.CheckoutItem
-	(*Total)(nil),                              // 24: photo.Total
-	(*CalculatePhotoPriceRequest)(nil),         // 25: photo.CalculatePhotoPriceRequest
-	(*CalculatePhotoPriceResponse)(nil),        // 26: photo.CalculatePhotoPriceResponse
-	(*OwnerOwnPhotosRequest)(nil),              // 27: photo.OwnerOwnPhotosRequest
-	(*OwnerOwnPhotosResponse)(nil),             // 28: photo.OwnerOwnPhotosResponse
-	(*BulkPhoto)(nil),                          // 29: photo.BulkPhoto
-	(*CreateBulkPhotoRequest)(nil),             // 30: photo.CreateBulkPhotoRequest
-	(*CreateBulkPhotoResponse)(nil),            // 31: photo.CreateBulkPhotoResponse
-	(*BulkUserSimilarPhoto)(nil),               // 32: photo.BulkUserSimilarPhoto
-	(*CreateBulkUserSimilarPhotoRequest)(nil),  // 33: photo.CreateBulkUserSimilarPhotoRequest
-	(*CreateBulkUserSimilarPhotoResponse)(nil), // 34: photo.CreateBulkUserSimilarPhotoResponse
-	(*CountMap)(nil),                           // 35: photo.CountMap
-	(*GetPhotoWithDetailsRequest)(nil),         // 36: photo.GetPhotoWithDetailsRequest
-	(*GetPhotoWithDetailsResponse)(nil),        // 37: photo.GetPhotoWithDetailsResponse
-	(*CancelPhotosRequest)(nil),                // 38: photo.CancelPhotosRequest
-	(*CancelPhotosResponse)(nil),               // 39: photo.CancelPhotosResponse
-	(*CheckoutItemWeb)(nil),                    // 40: photo.CheckoutItemWeb
-	(*Discount)(nil),                           // 41: photo.Discount
-	(*CalculatePhotoPriceV2Request)(nil),       // 42: photo.CalculatePhotoPriceV2Request
-	(*CalculatePhotoPriceV2Response)(nil),      // 43: photo.CalculatePhotoPriceV2Response
-	nil,                                        // 44: photo.CountMap.CountMapEntry
-	(*timestamppb.Timestamp)(nil),              // 45: google.protobuf.Timestamp
-	(*wrapperspb.DoubleValue)(nil),             // 46: google.protobuf.DoubleValue
-	(*wrapperspb.StringValue)(nil),             // 47: google.protobuf.StringValue
+	(*GetCreatorsByIdsRequest)(nil),            // 23: photo.GetCreatorsByIdsRequest
+	(*GetCreatorsByIdsResponse)(nil),           // 24: photo.GetCreatorsByIdsResponse
+	(*CheckoutItem)(nil),                       // 25: photo.CheckoutItem
+	(*Total)(nil),                              // 26: photo.Total
+	(*CalculatePhotoPriceRequest)(nil),         // 27: photo.CalculatePhotoPriceRequest
+	(*CalculatePhotoPriceResponse)(nil),        // 28: photo.CalculatePhotoPriceResponse
+	(*OwnerOwnPhotosRequest)(nil),              // 29: photo.OwnerOwnPhotosRequest
+	(*OwnerOwnPhotosResponse)(nil),             // 30: photo.OwnerOwnPhotosResponse
+	(*BulkPhoto)(nil),                          // 31: photo.BulkPhoto
+	(*CreateBulkPhotoRequest)(nil),             // 32: photo.CreateBulkPhotoRequest
+	(*CreateBulkPhotoResponse)(nil),            // 33: photo.CreateBulkPhotoResponse
+	(*BulkUserSimilarPhoto)(nil),               // 34: photo.BulkUserSimilarPhoto
+	(*CreateBulkUserSimilarPhotoRequest)(nil),  // 35: photo.CreateBulkUserSimilarPhotoRequest
+	(*CreateBulkUserSimilarPhotoResponse)(nil), // 36: photo.CreateBulkUserSimilarPhotoResponse
+	(*CountMap)(nil),                           // 37: photo.CountMap
+	(*GetPhotoWithDetailsRequest)(nil),         // 38: photo.GetPhotoWithDetailsRequest
+	(*GetPhotoWithDetailsResponse)(nil),        // 39: photo.GetPhotoWithDetailsResponse
+	(*CancelPhotosRequest)(nil),                // 40: photo.CancelPhotosRequest
+	(*CancelPhotosResponse)(nil),               // 41: photo.CancelPhotosResponse
+	(*CheckoutItemWeb)(nil),                    // 42: photo.CheckoutItemWeb
+	(*Discount)(nil),                           // 43: photo.Discount
+	(*CalculatePhotoPriceV2Request)(nil),       // 44: photo.CalculatePhotoPriceV2Request
+	(*CalculatePhotoPriceV2Response)(nil),      // 45: photo.CalculatePhotoPriceV2Response
+	(*ListEventAttendeeUserIdsRequest)(nil),    // 46: photo.ListEventAttendeeUserIdsRequest
+	(*ListEventAttendeeUserIdsResponse)(nil),   // 47: photo.ListEventAttendeeUserIdsResponse
+	(*ListCreatorUserIdsRequest)(nil),          // 48: photo.ListCreatorUserIdsRequest
+	(*ListCreatorUserIdsResponse)(nil),         // 49: photo.ListCreatorUserIdsResponse
+	(*ChatPhotoPreview)(nil),                   // 50: photo.ChatPhotoPreview
+	(*GetChatPhotoPreviewsRequest)(nil),        // 51: photo.GetChatPhotoPreviewsRequest
+	(*GetChatPhotoPreviewsResponse)(nil),       // 52: photo.GetChatPhotoPreviewsResponse
+	(*UserDataExportPurchase)(nil),             // 53: photo.UserDataExportPurchase
+	(*UserDataExportFacecam)(nil),              // 54: photo.UserDataExportFacecam
+	(*GetUserDataExportRequest)(nil),           // 55: photo.GetUserDataExportRequest
+	(*GetUserDataExportResponse)(nil),          // 56: photo.GetUserDataExportResponse
+	nil,                                        // 57: photo.CountMap.CountMapEntry
+	(*timestamppb.Timestamp)(nil),              // 58: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),             // 59: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),             // 60: google.protobuf.StringValue
 }
 var file_photo_photo_proto_depIdxs = []int32{
-	45, // 0: photo.Photo.original_at:type_name -> google.protobuf.Timestamp
-	45, // 1: photo.Photo.created_at:type_name -> google.protobuf.Timestamp
-	45, // 2: photo.Photo.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 0: photo.Photo.original_at:type_name -> google.protobuf.Timestamp
+	58, // 1: photo.Photo.created_at:type_name -> google.protobuf.Timestamp
+	58, // 2: photo.Photo.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 3: photo.Photo.detail:type_name -> photo.PhotoDetail
-	46, // 4: photo.Photo.latitude:type_name -> google.protobuf.DoubleValue
-	46, // 5: photo.Photo.longitude:type_name -> google.protobuf.DoubleValue
-	47, // 6: photo.Photo.description:type_name -> google.protobuf.StringValue
-	47, // 7: photo.Photo.bulk_photo_id:type_name -> google.protobuf.StringValue
-	45, // 8: photo.PhotoDetail.created_at:type_name -> google.protobuf.Timestamp
-	45, // 9: photo.PhotoDetail.updated_at:type_name -> google.protobuf.Timestamp
+	59, // 4: photo.Photo.latitude:type_name -> google.protobuf.DoubleValue
+	59, // 5: photo.Photo.longitude:type_name -> google.protobuf.DoubleValue
+	60, // 6: photo.Photo.description:type_name -> google.protobuf.StringValue
+	60, // 7: photo.Photo.bulk_photo_id:type_name -> google.protobuf.StringValue
+	58, // 8: photo.PhotoDetail.created_at:type_name -> google.protobuf.Timestamp
+	58, // 9: photo.PhotoDetail.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 10: photo.CreatePhotoRequest.photo:type_name -> photo.Photo
 	1,  // 11: photo.UpdatePhotoDetailRequest.photoDetail:type_name -> photo.PhotoDetail
-	45, // 12: photo.UserSimilarPhoto.created_at:type_name -> google.protobuf.Timestamp
-	45, // 13: photo.UserSimilarPhoto.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 12: photo.UserSimilarPhoto.created_at:type_name -> google.protobuf.Timestamp
+	58, // 13: photo.UserSimilarPhoto.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 14: photo.CreateUserSimilarPhotoRequest.photoDetail:type_name -> photo.PhotoDetail
 	10, // 15: photo.CreateUserSimilarPhotoRequest.user_similar_photo:type_name -> photo.UserSimilarPhoto
-	45, // 16: photo.Facecam.original_at:type_name -> google.protobuf.Timestamp
-	45, // 17: photo.Facecam.created_at:type_name -> google.protobuf.Timestamp
-	45, // 18: photo.Facecam.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 16: photo.Facecam.original_at:type_name -> google.protobuf.Timestamp
+	58, // 17: photo.Facecam.created_at:type_name -> google.protobuf.Timestamp
+	58, // 18: photo.Facecam.updated_at:type_name -> google.protobuf.Timestamp
 	13, // 19: photo.CreateFacecamRequest.facecam:type_name -> photo.Facecam
 	13, // 20: photo.CreateUserSimilarFacecamRequest.facecam:type_name -> photo.Facecam
 	10, // 21: photo.CreateUserSimilarFacecamRequest.user_similar_photo:type_name -> photo.UserSimilarPhoto
-	45, // 22: photo.Creator.verified_at:type_name -> google.protobuf.Timestamp
-	45, // 23: photo.Creator.created_at:type_name -> google.protobuf.Timestamp
-	45, // 24: photo.Creator.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 22: photo.Creator.verified_at:type_name -> google.protobuf.Timestamp
+	58, // 23: photo.Creator.created_at:type_name -> google.protobuf.Timestamp
+	58, // 24: photo.Creator.updated_at:type_name -> google.protobuf.Timestamp
 	18, // 25: photo.CreateCreatorResponse.creator:type_name -> photo.Creator
 	18, // 26: photo.GetCreatorResponse.creator:type_name -> photo.Creator
-	23, // 27: photo.CalculatePhotoPriceResponse.items:type_name -> photo.CheckoutItem
-	24, // 28: photo.CalculatePhotoPriceResponse.total:type_name -> photo.Total
-	45, // 29: photo.BulkPhoto.created_at:type_name -> google.protobuf.Timestamp
-	45, // 30: photo.BulkPhoto.updated_at:type_name -> google.protobuf.Timestamp
-	29, // 31: photo.CreateBulkPhotoRequest.bulk_photo:type_name -> photo.BulkPhoto
-	0,  // 32: photo.CreateBulkPhotoRequest.photos:type_name -> photo.Photo
-	1,  // 33: photo.BulkUserSimilarPhoto.photoDetail:type_name -> photo.PhotoDetail
-	10, // 34: photo.BulkUserSimilarPhoto.user_similar_photo:type_name -> photo.UserSimilarPhoto
-	29, // 35: photo.CreateBulkUserSimilarPhotoRequest.bulk_photo:type_name -> photo.BulkPhoto
-	32, // 36: photo.CreateBulkUserSimilarPhotoRequest.bulk_user_similar_photo:type_name -> photo.BulkUserSimilarPhoto
-	44, // 37: photo.CountMap.count_map:type_name -> photo.CountMap.CountMapEntry
-	0,  // 38: photo.GetPhotoWithDetailsResponse.photo_with_details:type_name -> photo.Photo
-	41, // 39: photo.CheckoutItemWeb.discount:type_name -> photo.Discount
-	40, // 40: photo.CalculatePhotoPriceV2Request.chekout_item_web:type_name -> photo.CheckoutItemWeb
-	23, // 41: photo.CalculatePhotoPriceV2Response.items:type_name -> photo.CheckoutItem
-	24, // 42: photo.CalculatePhotoPriceV2Response.total:type_name -> photo.Total
-	6,  // 43: photo.PhotoService.UpdatePhotographerPhoto:input_type -> photo.UpdatePhotographerPhotoRequest
-	8,  // 44: photo.PhotoService.UpdateFaceRecogPhoto:input_type -> photo.UpdateFaceRecogPhotoRequest
-	2,  // 45: photo.PhotoService.CreatePhoto:input_type -> photo.CreatePhotoRequest
-	16, // 46: photo.PhotoService.CreateUserSimilarFacecam:input_type -> photo.CreateUserSimilarFacecamRequest
-	14, // 47: photo.PhotoService.CreateFacecam:input_type -> photo.CreateFacecamRequest
-	4,  // 48: photo.PhotoService.UpdatePhotoDetail:input_type -> photo.UpdatePhotoDetailRequest
-	11, // 49: photo.PhotoService.CreateUserSimilar:input_type -> photo.CreateUserSimilarPhotoRequest
-	19, // 50: photo.PhotoService.CreateCreator:input_type -> photo.CreateCreatorRequest
-	21, // 51: photo.PhotoService.GetCreator:input_type -> photo.GetCreatorRequest
-	25, // 52: photo.PhotoService.CalculatePhotoPrice:input_type -> photo.CalculatePhotoPriceRequest
-	42, // 53: photo.PhotoService.CalculatePhotoPriceV2:input_type -> photo.CalculatePhotoPriceV2Request
-	27, // 54: photo.PhotoService.OwnerOwnPhotos:input_type -> photo.OwnerOwnPhotosRequest
-	30, // 55: photo.PhotoService.CreateBulkPhoto:input_type -> photo.CreateBulkPhotoRequest
-	33, // 56: photo.PhotoService.CreateBulkUserSimilarPhotos:input_type -> photo.CreateBulkUserSimilarPhotoRequest
-	36, // 57: photo.PhotoService.GetPhotoWithDetails:input_type -> photo.GetPhotoWithDetailsRequest
-	38, // 58: photo.PhotoService.CancelPhotos:input_type -> photo.CancelPhotosRequest
-	7,  // 59: photo.PhotoService.UpdatePhotographerPhoto:output_type -> photo.UpdatePhotographerPhotoResponse
-	9,  // 60: photo.PhotoService.UpdateFaceRecogPhoto:output_type -> photo.UpdateFaceRecogPhotoResponse
-	3,  // 61: photo.PhotoService.CreatePhoto:output_type -> photo.CreatePhotoResponse
-	17, // 62: photo.PhotoService.CreateUserSimilarFacecam:output_type -> photo.CreateUserSimilarFacecamResponse
-	15, // 63: photo.PhotoService.CreateFacecam:output_type -> photo.CreateFacecamResponse
-	5,  // 64: photo.PhotoService.UpdatePhotoDetail:output_type -> photo.UpdatePhotoDetailResponse
-	12, // 65: photo.PhotoService.CreateUserSimilar:output_type -> photo.CreateUserSimilarPhotoResponse
-	20, // 66: photo.PhotoService.CreateCreator:output_type -> photo.CreateCreatorResponse
-	22, // 67: photo.PhotoService.GetCreator:output_type -> photo.GetCreatorResponse
-	26, // 68: photo.PhotoService.CalculatePhotoPrice:output_type -> photo.CalculatePhotoPriceResponse
-	43, // 69: photo.PhotoService.CalculatePhotoPriceV2:output_type -> photo.CalculatePhotoPriceV2Response
-	28, // 70: photo.PhotoService.OwnerOwnPhotos:output_type -> photo.OwnerOwnPhotosResponse
-	31, // 71: photo.PhotoService.CreateBulkPhoto:output_type -> photo.CreateBulkPhotoResponse
-	34, // 72: photo.PhotoService.CreateBulkUserSimilarPhotos:output_type -> photo.CreateBulkUserSimilarPhotoResponse
-	37, // 73: photo.PhotoService.GetPhotoWithDetails:output_type -> photo.GetPhotoWithDetailsResponse
-	39, // 74: photo.PhotoService.CancelPhotos:output_type -> photo.CancelPhotosResponse
-	59, // [59:75] is the sub-list for method output_type
-	43, // [43:59] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	18, // 27: photo.GetCreatorsByIdsResponse.creators:type_name -> photo.Creator
+	25, // 28: photo.CalculatePhotoPriceResponse.items:type_name -> photo.CheckoutItem
+	26, // 29: photo.CalculatePhotoPriceResponse.total:type_name -> photo.Total
+	58, // 30: photo.BulkPhoto.created_at:type_name -> google.protobuf.Timestamp
+	58, // 31: photo.BulkPhoto.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 32: photo.CreateBulkPhotoRequest.bulk_photo:type_name -> photo.BulkPhoto
+	0,  // 33: photo.CreateBulkPhotoRequest.photos:type_name -> photo.Photo
+	1,  // 34: photo.BulkUserSimilarPhoto.photoDetail:type_name -> photo.PhotoDetail
+	10, // 35: photo.BulkUserSimilarPhoto.user_similar_photo:type_name -> photo.UserSimilarPhoto
+	31, // 36: photo.CreateBulkUserSimilarPhotoRequest.bulk_photo:type_name -> photo.BulkPhoto
+	34, // 37: photo.CreateBulkUserSimilarPhotoRequest.bulk_user_similar_photo:type_name -> photo.BulkUserSimilarPhoto
+	57, // 38: photo.CountMap.count_map:type_name -> photo.CountMap.CountMapEntry
+	0,  // 39: photo.GetPhotoWithDetailsResponse.photo_with_details:type_name -> photo.Photo
+	43, // 40: photo.CheckoutItemWeb.discount:type_name -> photo.Discount
+	42, // 41: photo.CalculatePhotoPriceV2Request.chekout_item_web:type_name -> photo.CheckoutItemWeb
+	25, // 42: photo.CalculatePhotoPriceV2Response.items:type_name -> photo.CheckoutItem
+	26, // 43: photo.CalculatePhotoPriceV2Response.total:type_name -> photo.Total
+	58, // 44: photo.CalculatePhotoPriceV2Response.quote_expires_at:type_name -> google.protobuf.Timestamp
+	50, // 45: photo.GetChatPhotoPreviewsResponse.previews:type_name -> photo.ChatPhotoPreview
+	58, // 46: photo.UserDataExportPurchase.original_at:type_name -> google.protobuf.Timestamp
+	58, // 47: photo.UserDataExportFacecam.original_at:type_name -> google.protobuf.Timestamp
+	58, // 48: photo.UserDataExportFacecam.created_at:type_name -> google.protobuf.Timestamp
+	53, // 49: photo.GetUserDataExportResponse.purchases:type_name -> photo.UserDataExportPurchase
+	54, // 50: photo.GetUserDataExportResponse.facecams:type_name -> photo.UserDataExportFacecam
+	6,  // 51: photo.PhotoService.UpdatePhotographerPhoto:input_type -> photo.UpdatePhotographerPhotoRequest
+	8,  // 52: photo.PhotoService.UpdateFaceRecogPhoto:input_type -> photo.UpdateFaceRecogPhotoRequest
+	2,  // 53: photo.PhotoService.CreatePhoto:input_type -> photo.CreatePhotoRequest
+	16, // 54: photo.PhotoService.CreateUserSimilarFacecam:input_type -> photo.CreateUserSimilarFacecamRequest
+	14, // 55: photo.PhotoService.CreateFacecam:input_type -> photo.CreateFacecamRequest
+	4,  // 56: photo.PhotoService.UpdatePhotoDetail:input_type -> photo.UpdatePhotoDetailRequest
+	11, // 57: photo.PhotoService.CreateUserSimilar:input_type -> photo.CreateUserSimilarPhotoRequest
+	19, // 58: photo.PhotoService.CreateCreator:input_type -> photo.CreateCreatorRequest
+	21, // 59: photo.PhotoService.GetCreator:input_type -> photo.GetCreatorRequest
+	23, // 60: photo.PhotoService.GetCreatorsByIds:input_type -> photo.GetCreatorsByIdsRequest
+	27, // 61: photo.PhotoService.CalculatePhotoPrice:input_type -> photo.CalculatePhotoPriceRequest
+	44, // 62: photo.PhotoService.CalculatePhotoPriceV2:input_type -> photo.CalculatePhotoPriceV2Request
+	29, // 63: photo.PhotoService.OwnerOwnPhotos:input_type -> photo.OwnerOwnPhotosRequest
+	32, // 64: photo.PhotoService.CreateBulkPhoto:input_type -> photo.CreateBulkPhotoRequest
+	35, // 65: photo.PhotoService.CreateBulkUserSimilarPhotos:input_type -> photo.CreateBulkUserSimilarPhotoRequest
+	38, // 66: photo.PhotoService.GetPhotoWithDetails:input_type -> photo.GetPhotoWithDetailsRequest
+	40, // 67: photo.PhotoService.CancelPhotos:input_type -> photo.CancelPhotosRequest
+	46, // 68: photo.PhotoService.ListEventAttendeeUserIds:input_type -> photo.ListEventAttendeeUserIdsRequest
+	48, // 69: photo.PhotoService.ListCreatorUserIds:input_type -> photo.ListCreatorUserIdsRequest
+	51, // 70: photo.PhotoService.GetChatPhotoPreviews:input_type -> photo.GetChatPhotoPreviewsRequest
+	55, // 71: photo.PhotoService.GetUserDataExport:input_type -> photo.GetUserDataExportRequest
+	7,  // 72: photo.PhotoService.UpdatePhotographerPhoto:output_type -> photo.UpdatePhotographerPhotoResponse
+	9,  // 73: photo.PhotoService.UpdateFaceRecogPhoto:output_type -> photo.UpdateFaceRecogPhotoResponse
+	3,  // 74: photo.PhotoService.CreatePhoto:output_type -> photo.CreatePhotoResponse
+	17, // 75: photo.PhotoService.CreateUserSimilarFacecam:output_type -> photo.CreateUserSimilarFacecamResponse
+	15, // 76: photo.PhotoService.CreateFacecam:output_type -> photo.CreateFacecamResponse
+	5,  // 77: photo.PhotoService.UpdatePhotoDetail:output_type -> photo.UpdatePhotoDetailResponse
+	12, // 78: photo.PhotoService.CreateUserSimilar:output_type -> photo.CreateUserSimilarPhotoResponse
+	20, // 79: photo.PhotoService.CreateCreator:output_type -> photo.CreateCreatorResponse
+	22, // 80: photo.PhotoService.GetCreator:output_type -> photo.GetCreatorResponse
+	24, // 81: photo.PhotoService.GetCreatorsByIds:output_type -> photo.GetCreatorsByIdsResponse
+	28, // 82: photo.PhotoService.CalculatePhotoPrice:output_type -> photo.CalculatePhotoPriceResponse
+	45, // 83: photo.PhotoService.CalculatePhotoPriceV2:output_type -> photo.CalculatePhotoPriceV2Response
+	30, // 84: photo.PhotoService.OwnerOwnPhotos:output_type -> photo.OwnerOwnPhotosResponse
+	33, // 85: photo.PhotoService.CreateBulkPhoto:output_type -> photo.CreateBulkPhotoResponse
+	36, // 86: photo.PhotoService.CreateBulkUserSimilarPhotos:output_type -> photo.CreateBulkUserSimilarPhotoResponse
+	39, // 87: photo.PhotoService.GetPhotoWithDetails:output_type -> photo.GetPhotoWithDetailsResponse
+	41, // 88: photo.PhotoService.CancelPhotos:output_type -> photo.CancelPhotosResponse
+	47, // 89: photo.PhotoService.ListEventAttendeeUserIds:output_type -> photo.ListEventAttendeeUserIdsResponse
+	49, // 90: photo.PhotoService.ListCreatorUserIds:output_type -> photo.ListCreatorUserIdsResponse
+	52, // 91: photo.PhotoService.GetChatPhotoPreviews:output_type -> photo.GetChatPhotoPreviewsResponse
+	56, // 92: photo.PhotoService.GetUserDataExport:output_type -> photo.GetUserDataExportResponse
+	72, // [72:93] is the sub-list for method output_type
+	51, // [51:72] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_photo_photo_proto_init() }
@@ -4010,7 +5245,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckoutItem); i {
+			switch v := v.(*GetCreatorsByIdsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4022,7 +5257,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Total); i {
+			switch v := v.(*GetCreatorsByIdsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4034,7 +5269,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalculatePhotoPriceRequest); i {
+			switch v := v.(*CheckoutItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4046,7 +5281,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalculatePhotoPriceResponse); i {
+			switch v := v.(*Total); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4058,7 +5293,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OwnerOwnPhotosRequest); i {
+			switch v := v.(*CalculatePhotoPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4070,7 +5305,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OwnerOwnPhotosResponse); i {
+			switch v := v.(*CalculatePhotoPriceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4082,7 +5317,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkPhoto); i {
+			switch v := v.(*OwnerOwnPhotosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4094,7 +5329,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkPhotoRequest); i {
+			switch v := v.(*OwnerOwnPhotosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4106,7 +5341,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkPhotoResponse); i {
+			switch v := v.(*BulkPhoto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4118,7 +5353,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkUserSimilarPhoto); i {
+			switch v := v.(*CreateBulkPhotoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4130,7 +5365,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkUserSimilarPhotoRequest); i {
+			switch v := v.(*CreateBulkPhotoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4142,7 +5377,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkUserSimilarPhotoResponse); i {
+			switch v := v.(*BulkUserSimilarPhoto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4154,7 +5389,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountMap); i {
+			switch v := v.(*CreateBulkUserSimilarPhotoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4166,7 +5401,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPhotoWithDetailsRequest); i {
+			switch v := v.(*CreateBulkUserSimilarPhotoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4178,7 +5413,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPhotoWithDetailsResponse); i {
+			switch v := v.(*CountMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4190,7 +5425,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPhotosRequest); i {
+			switch v := v.(*GetPhotoWithDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4202,7 +5437,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPhotosResponse); i {
+			switch v := v.(*GetPhotoWithDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4214,7 +5449,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckoutItemWeb); i {
+			switch v := v.(*CancelPhotosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4226,7 +5461,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Discount); i {
+			switch v := v.(*CancelPhotosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4238,7 +5473,7 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalculatePhotoPriceV2Request); i {
+			switch v := v.(*CheckoutItemWeb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4250,6 +5485,30 @@ func file_photo_photo_proto_init() {
 			}
 		}
 		file_photo_photo_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculatePhotoPriceV2Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CalculatePhotoPriceV2Response); i {
 			case 0:
 				return &v.state
@@ -4261,6 +5520,138 @@ func file_photo_photo_proto_init() {
 				return nil
 			}
 		}
+		file_photo_photo_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventAttendeeUserIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventAttendeeUserIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCreatorUserIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCreatorUserIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatPhotoPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatPhotoPreviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatPhotoPreviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDataExportPurchase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDataExportFacecam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_photo_photo_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4268,7 +5659,7 @@ func file_photo_photo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_photo_photo_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
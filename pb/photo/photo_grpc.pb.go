@@ -28,6 +28,7 @@ const (
 	PhotoService_CreateUserSimilar_FullMethodName           = "/photo.PhotoService/CreateUserSimilar"
 	PhotoService_CreateCreator_FullMethodName               = "/photo.PhotoService/CreateCreator"
 	PhotoService_GetCreator_FullMethodName                  = "/photo.PhotoService/GetCreator"
+	PhotoService_GetCreatorsByIds_FullMethodName            = "/photo.PhotoService/GetCreatorsByIds"
 	PhotoService_CalculatePhotoPrice_FullMethodName         = "/photo.PhotoService/CalculatePhotoPrice"
 	PhotoService_CalculatePhotoPriceV2_FullMethodName       = "/photo.PhotoService/CalculatePhotoPriceV2"
 	PhotoService_OwnerOwnPhotos_FullMethodName              = "/photo.PhotoService/OwnerOwnPhotos"
@@ -35,6 +36,10 @@ const (
 	PhotoService_CreateBulkUserSimilarPhotos_FullMethodName = "/photo.PhotoService/CreateBulkUserSimilarPhotos"
 	PhotoService_GetPhotoWithDetails_FullMethodName         = "/photo.PhotoService/GetPhotoWithDetails"
 	PhotoService_CancelPhotos_FullMethodName                = "/photo.PhotoService/CancelPhotos"
+	PhotoService_ListEventAttendeeUserIds_FullMethodName    = "/photo.PhotoService/ListEventAttendeeUserIds"
+	PhotoService_ListCreatorUserIds_FullMethodName          = "/photo.PhotoService/ListCreatorUserIds"
+	PhotoService_GetChatPhotoPreviews_FullMethodName        = "/photo.PhotoService/GetChatPhotoPreviews"
+	PhotoService_GetUserDataExport_FullMethodName           = "/photo.PhotoService/GetUserDataExport"
 )
 
 // PhotoServiceClient is the client API for PhotoService service.
@@ -50,6 +55,7 @@ type PhotoServiceClient interface {
 	CreateUserSimilar(ctx context.Context, in *CreateUserSimilarPhotoRequest, opts ...grpc.CallOption) (*CreateUserSimilarPhotoResponse, error)
 	CreateCreator(ctx context.Context, in *CreateCreatorRequest, opts ...grpc.CallOption) (*CreateCreatorResponse, error)
 	GetCreator(ctx context.Context, in *GetCreatorRequest, opts ...grpc.CallOption) (*GetCreatorResponse, error)
+	GetCreatorsByIds(ctx context.Context, in *GetCreatorsByIdsRequest, opts ...grpc.CallOption) (*GetCreatorsByIdsResponse, error)
 	CalculatePhotoPrice(ctx context.Context, in *CalculatePhotoPriceRequest, opts ...grpc.CallOption) (*CalculatePhotoPriceResponse, error)
 	CalculatePhotoPriceV2(ctx context.Context, in *CalculatePhotoPriceV2Request, opts ...grpc.CallOption) (*CalculatePhotoPriceV2Response, error)
 	OwnerOwnPhotos(ctx context.Context, in *OwnerOwnPhotosRequest, opts ...grpc.CallOption) (*OwnerOwnPhotosResponse, error)
@@ -57,6 +63,10 @@ type PhotoServiceClient interface {
 	CreateBulkUserSimilarPhotos(ctx context.Context, in *CreateBulkUserSimilarPhotoRequest, opts ...grpc.CallOption) (*CreateBulkUserSimilarPhotoResponse, error)
 	GetPhotoWithDetails(ctx context.Context, in *GetPhotoWithDetailsRequest, opts ...grpc.CallOption) (*GetPhotoWithDetailsResponse, error)
 	CancelPhotos(ctx context.Context, in *CancelPhotosRequest, opts ...grpc.CallOption) (*CancelPhotosResponse, error)
+	ListEventAttendeeUserIds(ctx context.Context, in *ListEventAttendeeUserIdsRequest, opts ...grpc.CallOption) (*ListEventAttendeeUserIdsResponse, error)
+	ListCreatorUserIds(ctx context.Context, in *ListCreatorUserIdsRequest, opts ...grpc.CallOption) (*ListCreatorUserIdsResponse, error)
+	GetChatPhotoPreviews(ctx context.Context, in *GetChatPhotoPreviewsRequest, opts ...grpc.CallOption) (*GetChatPhotoPreviewsResponse, error)
+	GetUserDataExport(ctx context.Context, in *GetUserDataExportRequest, opts ...grpc.CallOption) (*GetUserDataExportResponse, error)
 }
 
 type photoServiceClient struct {
@@ -157,6 +167,16 @@ func (c *photoServiceClient) GetCreator(ctx context.Context, in *GetCreatorReque
 	return out, nil
 }
 
+func (c *photoServiceClient) GetCreatorsByIds(ctx context.Context, in *GetCreatorsByIdsRequest, opts ...grpc.CallOption) (*GetCreatorsByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreatorsByIdsResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetCreatorsByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *photoServiceClient) CalculatePhotoPrice(ctx context.Context, in *CalculatePhotoPriceRequest, opts ...grpc.CallOption) (*CalculatePhotoPriceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CalculatePhotoPriceResponse)
@@ -227,6 +247,46 @@ func (c *photoServiceClient) CancelPhotos(ctx context.Context, in *CancelPhotosR
 	return out, nil
 }
 
+func (c *photoServiceClient) ListEventAttendeeUserIds(ctx context.Context, in *ListEventAttendeeUserIdsRequest, opts ...grpc.CallOption) (*ListEventAttendeeUserIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventAttendeeUserIdsResponse)
+	err := c.cc.Invoke(ctx, PhotoService_ListEventAttendeeUserIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) ListCreatorUserIds(ctx context.Context, in *ListCreatorUserIdsRequest, opts ...grpc.CallOption) (*ListCreatorUserIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreatorUserIdsResponse)
+	err := c.cc.Invoke(ctx, PhotoService_ListCreatorUserIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) GetChatPhotoPreviews(ctx context.Context, in *GetChatPhotoPreviewsRequest, opts ...grpc.CallOption) (*GetChatPhotoPreviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChatPhotoPreviewsResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetChatPhotoPreviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) GetUserDataExport(ctx context.Context, in *GetUserDataExportRequest, opts ...grpc.CallOption) (*GetUserDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDataExportResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetUserDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PhotoServiceServer is the server API for PhotoService service.
 // All implementations must embed UnimplementedPhotoServiceServer
 // for forward compatibility.
@@ -240,6 +300,7 @@ type PhotoServiceServer interface {
 	CreateUserSimilar(context.Context, *CreateUserSimilarPhotoRequest) (*CreateUserSimilarPhotoResponse, error)
 	CreateCreator(context.Context, *CreateCreatorRequest) (*CreateCreatorResponse, error)
 	GetCreator(context.Context, *GetCreatorRequest) (*GetCreatorResponse, error)
+	GetCreatorsByIds(context.Context, *GetCreatorsByIdsRequest) (*GetCreatorsByIdsResponse, error)
 	CalculatePhotoPrice(context.Context, *CalculatePhotoPriceRequest) (*CalculatePhotoPriceResponse, error)
 	CalculatePhotoPriceV2(context.Context, *CalculatePhotoPriceV2Request) (*CalculatePhotoPriceV2Response, error)
 	OwnerOwnPhotos(context.Context, *OwnerOwnPhotosRequest) (*OwnerOwnPhotosResponse, error)
@@ -247,6 +308,10 @@ type PhotoServiceServer interface {
 	CreateBulkUserSimilarPhotos(context.Context, *CreateBulkUserSimilarPhotoRequest) (*CreateBulkUserSimilarPhotoResponse, error)
 	GetPhotoWithDetails(context.Context, *GetPhotoWithDetailsRequest) (*GetPhotoWithDetailsResponse, error)
 	CancelPhotos(context.Context, *CancelPhotosRequest) (*CancelPhotosResponse, error)
+	ListEventAttendeeUserIds(context.Context, *ListEventAttendeeUserIdsRequest) (*ListEventAttendeeUserIdsResponse, error)
+	ListCreatorUserIds(context.Context, *ListCreatorUserIdsRequest) (*ListCreatorUserIdsResponse, error)
+	GetChatPhotoPreviews(context.Context, *GetChatPhotoPreviewsRequest) (*GetChatPhotoPreviewsResponse, error)
+	GetUserDataExport(context.Context, *GetUserDataExportRequest) (*GetUserDataExportResponse, error)
 	mustEmbedUnimplementedPhotoServiceServer()
 }
 
@@ -284,6 +349,9 @@ func (UnimplementedPhotoServiceServer) CreateCreator(context.Context, *CreateCre
 func (UnimplementedPhotoServiceServer) GetCreator(context.Context, *GetCreatorRequest) (*GetCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCreator not implemented")
 }
+func (UnimplementedPhotoServiceServer) GetCreatorsByIds(context.Context, *GetCreatorsByIdsRequest) (*GetCreatorsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorsByIds not implemented")
+}
 func (UnimplementedPhotoServiceServer) CalculatePhotoPrice(context.Context, *CalculatePhotoPriceRequest) (*CalculatePhotoPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculatePhotoPrice not implemented")
 }
@@ -305,6 +373,18 @@ func (UnimplementedPhotoServiceServer) GetPhotoWithDetails(context.Context, *Get
 func (UnimplementedPhotoServiceServer) CancelPhotos(context.Context, *CancelPhotosRequest) (*CancelPhotosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelPhotos not implemented")
 }
+func (UnimplementedPhotoServiceServer) ListEventAttendeeUserIds(context.Context, *ListEventAttendeeUserIdsRequest) (*ListEventAttendeeUserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventAttendeeUserIds not implemented")
+}
+func (UnimplementedPhotoServiceServer) ListCreatorUserIds(context.Context, *ListCreatorUserIdsRequest) (*ListCreatorUserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreatorUserIds not implemented")
+}
+func (UnimplementedPhotoServiceServer) GetChatPhotoPreviews(context.Context, *GetChatPhotoPreviewsRequest) (*GetChatPhotoPreviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatPhotoPreviews not implemented")
+}
+func (UnimplementedPhotoServiceServer) GetUserDataExport(context.Context, *GetUserDataExportRequest) (*GetUserDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataExport not implemented")
+}
 func (UnimplementedPhotoServiceServer) mustEmbedUnimplementedPhotoServiceServer() {}
 func (UnimplementedPhotoServiceServer) testEmbeddedByValue()                      {}
 
@@ -488,6 +568,24 @@ func _PhotoService_GetCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PhotoService_GetCreatorsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreatorsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetCreatorsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetCreatorsByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetCreatorsByIds(ctx, req.(*GetCreatorsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PhotoService_CalculatePhotoPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculatePhotoPriceRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +712,78 @@ func _PhotoService_CancelPhotos_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PhotoService_ListEventAttendeeUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventAttendeeUserIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).ListEventAttendeeUserIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_ListEventAttendeeUserIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).ListEventAttendeeUserIds(ctx, req.(*ListEventAttendeeUserIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_ListCreatorUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreatorUserIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).ListCreatorUserIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_ListCreatorUserIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).ListCreatorUserIds(ctx, req.(*ListCreatorUserIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_GetChatPhotoPreviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatPhotoPreviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetChatPhotoPreviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetChatPhotoPreviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetChatPhotoPreviews(ctx, req.(*GetChatPhotoPreviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_GetUserDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetUserDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetUserDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetUserDataExport(ctx, req.(*GetUserDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PhotoService_ServiceDesc is the grpc.ServiceDesc for PhotoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -657,6 +827,10 @@ var PhotoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCreator",
 			Handler:    _PhotoService_GetCreator_Handler,
 		},
+		{
+			MethodName: "GetCreatorsByIds",
+			Handler:    _PhotoService_GetCreatorsByIds_Handler,
+		},
 		{
 			MethodName: "CalculatePhotoPrice",
 			Handler:    _PhotoService_CalculatePhotoPrice_Handler,
@@ -685,6 +859,22 @@ var PhotoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelPhotos",
 			Handler:    _PhotoService_CancelPhotos_Handler,
 		},
+		{
+			MethodName: "ListEventAttendeeUserIds",
+			Handler:    _PhotoService_ListEventAttendeeUserIds_Handler,
+		},
+		{
+			MethodName: "ListCreatorUserIds",
+			Handler:    _PhotoService_ListCreatorUserIds_Handler,
+		},
+		{
+			MethodName: "GetChatPhotoPreviews",
+			Handler:    _PhotoService_GetChatPhotoPreviews_Handler,
+		},
+		{
+			MethodName: "GetUserDataExport",
+			Handler:    _PhotoService_GetUserDataExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "photo/photo.proto",
@@ -7,11 +7,12 @@
 package transactionpb
 
 import (
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -130,8 +131,8 @@ type Wallet struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
 	Balance       int32                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -187,14 +188,14 @@ func (x *Wallet) GetBalance() int32 {
 	return 0
 }
 
-func (x *Wallet) GetCreatedAt() *timestamp.Timestamp {
+func (x *Wallet) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Wallet) GetUpdatedAt() *timestamp.Timestamp {
+func (x *Wallet) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -305,98 +306,641 @@ func (x *GetWalletResponse) GetError() string {
 	return ""
 }
 
-var File_transaction_proto protoreflect.FileDescriptor
+type GetCreatorReviewSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     string                 `protobuf:"bytes,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorReviewSummaryRequest) Reset() {
+	*x = GetCreatorReviewSummaryRequest{}
+	mi := &file_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorReviewSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorReviewSummaryRequest) ProtoMessage() {}
+
+func (x *GetCreatorReviewSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorReviewSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCreatorReviewSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCreatorReviewSummaryRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+type RatingCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        int32                  `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingCount) Reset() {
+	*x = RatingCount{}
+	mi := &file_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_transaction_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x06, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xb5, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x20,
-	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x12, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x20, 0x5a, 0x1e, 0x2e, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*RatingCount) ProtoMessage() {}
+
+func (x *RatingCount) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCount.ProtoReflect.Descriptor instead.
+func (*RatingCount) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RatingCount) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RatingCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreatorReviewSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId       string                 `protobuf:"bytes,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	AverageRating   float32                `protobuf:"fixed32,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	TotalReview     int32                  `protobuf:"varint,3,opt,name=total_review,json=totalReview,proto3" json:"total_review,omitempty"`
+	RatingBreakdown []*RatingCount         `protobuf:"bytes,4,rep,name=rating_breakdown,json=ratingBreakdown,proto3" json:"rating_breakdown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatorReviewSummary) Reset() {
+	*x = CreatorReviewSummary{}
+	mi := &file_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatorReviewSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatorReviewSummary) ProtoMessage() {}
+
+func (x *CreatorReviewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatorReviewSummary.ProtoReflect.Descriptor instead.
+func (*CreatorReviewSummary) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatorReviewSummary) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *CreatorReviewSummary) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *CreatorReviewSummary) GetTotalReview() int32 {
+	if x != nil {
+		return x.TotalReview
+	}
+	return 0
+}
+
+func (x *CreatorReviewSummary) GetRatingBreakdown() []*RatingCount {
+	if x != nil {
+		return x.RatingBreakdown
+	}
+	return nil
+}
+
+type GetCreatorReviewSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int64                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Summary       *CreatorReviewSummary  `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorReviewSummaryResponse) Reset() {
+	*x = GetCreatorReviewSummaryResponse{}
+	mi := &file_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorReviewSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorReviewSummaryResponse) ProtoMessage() {}
+
+func (x *GetCreatorReviewSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorReviewSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetCreatorReviewSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCreatorReviewSummaryResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetCreatorReviewSummaryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetCreatorReviewSummaryResponse) GetSummary() *CreatorReviewSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type UserDataExportTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PhotoIds      []string               `protobuf:"bytes,4,rep,name=photo_ids,json=photoIds,proto3" json:"photo_ids,omitempty"`
+	CheckoutAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=checkout_at,json=checkoutAt,proto3" json:"checkout_at,omitempty"`
+	PaymentAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=payment_at,json=paymentAt,proto3" json:"payment_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExportTransaction) Reset() {
+	*x = UserDataExportTransaction{}
+	mi := &file_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExportTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportTransaction) ProtoMessage() {}
+
+func (x *UserDataExportTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
+// Deprecated: Use UserDataExportTransaction.ProtoReflect.Descriptor instead.
+func (*UserDataExportTransaction) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserDataExportTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserDataExportTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserDataExportTransaction) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UserDataExportTransaction) GetPhotoIds() []string {
+	if x != nil {
+		return x.PhotoIds
+	}
+	return nil
+}
+
+func (x *UserDataExportTransaction) GetCheckoutAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckoutAt
+	}
+	return nil
+}
+
+func (x *UserDataExportTransaction) GetPaymentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentAt
+	}
+	return nil
+}
+
+func (x *UserDataExportTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UserDataExportReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExportReview) Reset() {
+	*x = UserDataExportReview{}
+	mi := &file_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExportReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportReview) ProtoMessage() {}
+
+func (x *UserDataExportReview) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExportReview.ProtoReflect.Descriptor instead.
+func (*UserDataExportReview) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserDataExportReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserDataExportReview) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *UserDataExportReview) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UserDataExportReview) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UserDataExportReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetUserDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDataExportRequest) Reset() {
+	*x = GetUserDataExportRequest{}
+	mi := &file_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataExportRequest) ProtoMessage() {}
+
+func (x *GetUserDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserDataExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserDataExportResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Status        int64                        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Transactions  []*UserDataExportTransaction `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Reviews       []*UserDataExportReview      `protobuf:"bytes,4,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDataExportResponse) Reset() {
+	*x = GetUserDataExportResponse{}
+	mi := &file_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDataExportResponse) ProtoMessage() {}
+
+func (x *GetUserDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserDataExportResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetUserDataExportResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetUserDataExportResponse) GetTransactions() []*UserDataExportTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetUserDataExportResponse) GetReviews() []*UserDataExportReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+var File_transaction_proto protoreflect.FileDescriptor
+
+const file_transaction_proto_rawDesc = "" +
+	"\n" +
+	"\x11transaction.proto\x12\vtransaction\x1a\x1fgoogle/protobuf/timestamp.proto\"4\n" +
+	"\x13CreateWalletRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\tR\tcreatorId\"q\n" +
+	"\x14CreateWalletResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x03R\x06status\x12+\n" +
+	"\x06wallet\x18\x02 \x01(\v2\x13.transaction.WalletR\x06wallet\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc7\x01\n" +
+	"\x06Wallet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\tR\tcreatorId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x05R\abalance\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"1\n" +
+	"\x10GetWalletRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\tR\tcreatorId\"n\n" +
+	"\x11GetWalletResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x03R\x06status\x12+\n" +
+	"\x06wallet\x18\x02 \x01(\v2\x13.transaction.WalletR\x06wallet\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"?\n" +
+	"\x1eGetCreatorReviewSummaryRequest\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\tR\tcreatorId\";\n" +
+	"\vRatingCount\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xc4\x01\n" +
+	"\x14CreatorReviewSummary\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\tR\tcreatorId\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x02R\raverageRating\x12!\n" +
+	"\ftotal_review\x18\x03 \x01(\x05R\vtotalReview\x12C\n" +
+	"\x10rating_breakdown\x18\x04 \x03(\v2\x18.transaction.RatingCountR\x0fratingBreakdown\"\x8c\x01\n" +
+	"\x1fGetCreatorReviewSummaryResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x03R\x06status\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12;\n" +
+	"\asummary\x18\x03 \x01(\v2!.transaction.CreatorReviewSummaryR\asummary\"\xab\x02\n" +
+	"\x19UserDataExportTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1b\n" +
+	"\tphoto_ids\x18\x04 \x03(\tR\bphotoIds\x12;\n" +
+	"\vcheckout_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"checkoutAt\x129\n" +
+	"\n" +
+	"payment_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tpaymentAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb2\x01\n" +
+	"\x14UserDataExportReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\tR\tcreatorId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x18GetUserDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd2\x01\n" +
+	"\x19GetUserDataExportResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x03R\x06status\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12J\n" +
+	"\ftransactions\x18\x03 \x03(\v2&.transaction.UserDataExportTransactionR\ftransactions\x12;\n" +
+	"\areviews\x18\x04 \x03(\v2!.transaction.UserDataExportReviewR\areviews2\x8f\x03\n" +
+	"\x12TransactionService\x12S\n" +
+	"\fCreateWallet\x12 .transaction.CreateWalletRequest\x1a!.transaction.CreateWalletResponse\x12J\n" +
+	"\tGetWallet\x12\x1d.transaction.GetWalletRequest\x1a\x1e.transaction.GetWalletResponse\x12t\n" +
+	"\x17GetCreatorReviewSummary\x12+.transaction.GetCreatorReviewSummaryRequest\x1a,.transaction.GetCreatorReviewSummaryResponse\x12b\n" +
+	"\x11GetUserDataExport\x12%.transaction.GetUserDataExportRequest\x1a&.transaction.GetUserDataExportResponseB Z\x1e.pkg/transaction;transactionpbb\x06proto3"
+
 var (
 	file_transaction_proto_rawDescOnce sync.Once
-	file_transaction_proto_rawDescData = file_transaction_proto_rawDesc
+	file_transaction_proto_rawDescData []byte
 )
 
 func file_transaction_proto_rawDescGZIP() []byte {
 	file_transaction_proto_rawDescOnce.Do(func() {
-		file_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_transaction_proto_rawDescData)
+		file_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transaction_proto_rawDesc), len(file_transaction_proto_rawDesc)))
 	})
 	return file_transaction_proto_rawDescData
 }
 
-var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_transaction_proto_goTypes = []any{
-	(*CreateWalletRequest)(nil),  // 0: transaction.CreateWalletRequest
-	(*CreateWalletResponse)(nil), // 1: transaction.CreateWalletResponse
-	(*Wallet)(nil),               // 2: transaction.Wallet
-	(*GetWalletRequest)(nil),     // 3: transaction.GetWalletRequest
-	(*GetWalletResponse)(nil),    // 4: transaction.GetWalletResponse
-	(*timestamp.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*CreateWalletRequest)(nil),             // 0: transaction.CreateWalletRequest
+	(*CreateWalletResponse)(nil),            // 1: transaction.CreateWalletResponse
+	(*Wallet)(nil),                          // 2: transaction.Wallet
+	(*GetWalletRequest)(nil),                // 3: transaction.GetWalletRequest
+	(*GetWalletResponse)(nil),               // 4: transaction.GetWalletResponse
+	(*GetCreatorReviewSummaryRequest)(nil),  // 5: transaction.GetCreatorReviewSummaryRequest
+	(*RatingCount)(nil),                     // 6: transaction.RatingCount
+	(*CreatorReviewSummary)(nil),            // 7: transaction.CreatorReviewSummary
+	(*GetCreatorReviewSummaryResponse)(nil), // 8: transaction.GetCreatorReviewSummaryResponse
+	(*UserDataExportTransaction)(nil),       // 9: transaction.UserDataExportTransaction
+	(*UserDataExportReview)(nil),            // 10: transaction.UserDataExportReview
+	(*GetUserDataExportRequest)(nil),        // 11: transaction.GetUserDataExportRequest
+	(*GetUserDataExportResponse)(nil),       // 12: transaction.GetUserDataExportResponse
+	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
 }
 var file_transaction_proto_depIdxs = []int32{
-	2, // 0: transaction.CreateWalletResponse.wallet:type_name -> transaction.Wallet
-	5, // 1: transaction.Wallet.created_at:type_name -> google.protobuf.Timestamp
-	5, // 2: transaction.Wallet.updated_at:type_name -> google.protobuf.Timestamp
-	2, // 3: transaction.GetWalletResponse.wallet:type_name -> transaction.Wallet
-	0, // 4: transaction.TransactionService.CreateWallet:input_type -> transaction.CreateWalletRequest
-	3, // 5: transaction.TransactionService.GetWallet:input_type -> transaction.GetWalletRequest
-	1, // 6: transaction.TransactionService.CreateWallet:output_type -> transaction.CreateWalletResponse
-	4, // 7: transaction.TransactionService.GetWallet:output_type -> transaction.GetWalletResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: transaction.CreateWalletResponse.wallet:type_name -> transaction.Wallet
+	13, // 1: transaction.Wallet.created_at:type_name -> google.protobuf.Timestamp
+	13, // 2: transaction.Wallet.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: transaction.GetWalletResponse.wallet:type_name -> transaction.Wallet
+	6,  // 4: transaction.CreatorReviewSummary.rating_breakdown:type_name -> transaction.RatingCount
+	7,  // 5: transaction.GetCreatorReviewSummaryResponse.summary:type_name -> transaction.CreatorReviewSummary
+	13, // 6: transaction.UserDataExportTransaction.checkout_at:type_name -> google.protobuf.Timestamp
+	13, // 7: transaction.UserDataExportTransaction.payment_at:type_name -> google.protobuf.Timestamp
+	13, // 8: transaction.UserDataExportTransaction.created_at:type_name -> google.protobuf.Timestamp
+	13, // 9: transaction.UserDataExportReview.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 10: transaction.GetUserDataExportResponse.transactions:type_name -> transaction.UserDataExportTransaction
+	10, // 11: transaction.GetUserDataExportResponse.reviews:type_name -> transaction.UserDataExportReview
+	0,  // 12: transaction.TransactionService.CreateWallet:input_type -> transaction.CreateWalletRequest
+	3,  // 13: transaction.TransactionService.GetWallet:input_type -> transaction.GetWalletRequest
+	5,  // 14: transaction.TransactionService.GetCreatorReviewSummary:input_type -> transaction.GetCreatorReviewSummaryRequest
+	11, // 15: transaction.TransactionService.GetUserDataExport:input_type -> transaction.GetUserDataExportRequest
+	1,  // 16: transaction.TransactionService.CreateWallet:output_type -> transaction.CreateWalletResponse
+	4,  // 17: transaction.TransactionService.GetWallet:output_type -> transaction.GetWalletResponse
+	8,  // 18: transaction.TransactionService.GetCreatorReviewSummary:output_type -> transaction.GetCreatorReviewSummaryResponse
+	12, // 19: transaction.TransactionService.GetUserDataExport:output_type -> transaction.GetUserDataExportResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_transaction_proto_init() }
@@ -408,9 +952,9 @@ func file_transaction_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_transaction_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transaction_proto_rawDesc), len(file_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -419,7 +963,6 @@ func file_transaction_proto_init() {
 		MessageInfos:      file_transaction_proto_msgTypes,
 	}.Build()
 	File_transaction_proto = out.File
-	file_transaction_proto_rawDesc = nil
 	file_transaction_proto_goTypes = nil
 	file_transaction_proto_depIdxs = nil
 }
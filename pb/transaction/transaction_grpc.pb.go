@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransactionService_CreateWallet_FullMethodName = "/transaction.TransactionService/CreateWallet"
-	TransactionService_GetWallet_FullMethodName    = "/transaction.TransactionService/GetWallet"
+	TransactionService_CreateWallet_FullMethodName            = "/transaction.TransactionService/CreateWallet"
+	TransactionService_GetWallet_FullMethodName               = "/transaction.TransactionService/GetWallet"
+	TransactionService_GetCreatorReviewSummary_FullMethodName = "/transaction.TransactionService/GetCreatorReviewSummary"
+	TransactionService_GetUserDataExport_FullMethodName       = "/transaction.TransactionService/GetUserDataExport"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -29,6 +31,8 @@ const (
 type TransactionServiceClient interface {
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
 	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	GetCreatorReviewSummary(ctx context.Context, in *GetCreatorReviewSummaryRequest, opts ...grpc.CallOption) (*GetCreatorReviewSummaryResponse, error)
+	GetUserDataExport(ctx context.Context, in *GetUserDataExportRequest, opts ...grpc.CallOption) (*GetUserDataExportResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -59,12 +63,34 @@ func (c *transactionServiceClient) GetWallet(ctx context.Context, in *GetWalletR
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetCreatorReviewSummary(ctx context.Context, in *GetCreatorReviewSummaryRequest, opts ...grpc.CallOption) (*GetCreatorReviewSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreatorReviewSummaryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetCreatorReviewSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetUserDataExport(ctx context.Context, in *GetUserDataExportRequest, opts ...grpc.CallOption) (*GetUserDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserDataExportResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetUserDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
 type TransactionServiceServer interface {
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
 	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	GetCreatorReviewSummary(context.Context, *GetCreatorReviewSummaryRequest) (*GetCreatorReviewSummaryResponse, error)
+	GetUserDataExport(context.Context, *GetUserDataExportRequest) (*GetUserDataExportResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -81,6 +107,12 @@ func (UnimplementedTransactionServiceServer) CreateWallet(context.Context, *Crea
 func (UnimplementedTransactionServiceServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetCreatorReviewSummary(context.Context, *GetCreatorReviewSummaryRequest) (*GetCreatorReviewSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorReviewSummary not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetUserDataExport(context.Context, *GetUserDataExportRequest) (*GetUserDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataExport not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -138,6 +170,42 @@ func _TransactionService_GetWallet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetCreatorReviewSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreatorReviewSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetCreatorReviewSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetCreatorReviewSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetCreatorReviewSummary(ctx, req.(*GetCreatorReviewSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetUserDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetUserDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetUserDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetUserDataExport(ctx, req.(*GetUserDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWallet",
 			Handler:    _TransactionService_GetWallet_Handler,
 		},
+		{
+			MethodName: "GetCreatorReviewSummary",
+			Handler:    _TransactionService_GetCreatorReviewSummary_Handler,
+		},
+		{
+			MethodName: "GetUserDataExport",
+			Handler:    _TransactionService_GetUserDataExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction.proto",
@@ -7,7 +7,6 @@
 package userpb
 
 import (
-	photo "github.com/hervibest/be-yourmoments-backup/pb/photo"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -136,13 +135,18 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username    string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email       string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber string `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Similarity  uint32 `protobuf:"varint,5,opt,name=similarity,proto3" json:"similarity,omitempty"`
-	CreatorId   string `protobuf:"bytes,6,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	WalletId    string `protobuf:"bytes,7,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	UserId           string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username         string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email            string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber      string   `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Similarity       uint32   `protobuf:"varint,5,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	CreatorId        string   `protobuf:"bytes,6,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	WalletId         string   `protobuf:"bytes,7,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Roles            []string `protobuf:"bytes,8,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions      []string `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	TwoFactorEnabled bool     `protobuf:"varint,10,opt,name=two_factor_enabled,json=twoFactorEnabled,proto3" json:"two_factor_enabled,omitempty"`
+	// unix seconds of the last 2FA step-up on this session, 0 when not fresh
+	TwoFactorVerifiedAt int64 `protobuf:"varint,11,opt,name=two_factor_verified_at,json=twoFactorVerifiedAt,proto3" json:"two_factor_verified_at,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -226,16 +230,44 @@ func (x *User) GetWalletId() string {
 	return ""
 }
 
-type SendBulkNotificationRequest struct {
+func (x *User) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *User) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *User) GetTwoFactorEnabled() bool {
+	if x != nil {
+		return x.TwoFactorEnabled
+	}
+	return false
+}
+
+func (x *User) GetTwoFactorVerifiedAt() int64 {
+	if x != nil {
+		return x.TwoFactorVerifiedAt
+	}
+	return 0
+}
+
+type GetPublicUserProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CountMap map[string]int32 `protobuf:"bytes,1,rep,name=count_map,json=countMap,proto3" json:"count_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *SendBulkNotificationRequest) Reset() {
-	*x = SendBulkNotificationRequest{}
+func (x *GetPublicUserProfileRequest) Reset() {
+	*x = GetPublicUserProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,13 +275,13 @@ func (x *SendBulkNotificationRequest) Reset() {
 	}
 }
 
-func (x *SendBulkNotificationRequest) String() string {
+func (x *GetPublicUserProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBulkNotificationRequest) ProtoMessage() {}
+func (*GetPublicUserProfileRequest) ProtoMessage() {}
 
-func (x *SendBulkNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPublicUserProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -261,29 +293,32 @@ func (x *SendBulkNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBulkNotificationRequest.ProtoReflect.Descriptor instead.
-func (*SendBulkNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPublicUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicUserProfileRequest) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SendBulkNotificationRequest) GetCountMap() map[string]int32 {
+func (x *GetPublicUserProfileRequest) GetUserId() string {
 	if x != nil {
-		return x.CountMap
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type SendBulkNotificationResponse struct {
+type SocialLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	SocialMediaId string `protobuf:"bytes,1,opt,name=social_media_id,json=socialMediaId,proto3" json:"social_media_id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LogoUrl       string `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Handle        string `protobuf:"bytes,4,opt,name=handle,proto3" json:"handle,omitempty"`
+	Url           string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *SendBulkNotificationResponse) Reset() {
-	*x = SendBulkNotificationResponse{}
+func (x *SocialLink) Reset() {
+	*x = SocialLink{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,13 +326,13 @@ func (x *SendBulkNotificationResponse) Reset() {
 	}
 }
 
-func (x *SendBulkNotificationResponse) String() string {
+func (x *SocialLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBulkNotificationResponse) ProtoMessage() {}
+func (*SocialLink) ProtoMessage() {}
 
-func (x *SendBulkNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *SocialLink) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -309,35 +344,62 @@ func (x *SendBulkNotificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBulkNotificationResponse.ProtoReflect.Descriptor instead.
-func (*SendBulkNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SocialLink.ProtoReflect.Descriptor instead.
+func (*SocialLink) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SendBulkNotificationResponse) GetStatus() int64 {
+func (x *SocialLink) GetSocialMediaId() string {
 	if x != nil {
-		return x.Status
+		return x.SocialMediaId
 	}
-	return 0
+	return ""
 }
 
-func (x *SendBulkNotificationResponse) GetError() string {
+func (x *SocialLink) GetName() string {
 	if x != nil {
-		return x.Error
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SocialLink) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *SocialLink) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *SocialLink) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
 	return ""
 }
 
-type SendBulkPhotoNotificationRequest struct {
+type PublicUserProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BulkUserSimilarPhoto []*photo.BulkUserSimilarPhoto `protobuf:"bytes,1,rep,name=bulk_user_similar_photo,json=bulkUserSimilarPhoto,proto3" json:"bulk_user_similar_photo,omitempty"`
+	UserId          string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username        string        `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname        string        `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Biography       string        `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
+	ProfileUrl      string        `protobuf:"bytes,5,opt,name=profile_url,json=profileUrl,proto3" json:"profile_url,omitempty"`
+	ProfileCoverUrl string        `protobuf:"bytes,6,opt,name=profile_cover_url,json=profileCoverUrl,proto3" json:"profile_cover_url,omitempty"`
+	SocialLinks     []*SocialLink `protobuf:"bytes,7,rep,name=social_links,json=socialLinks,proto3" json:"social_links,omitempty"`
 }
 
-func (x *SendBulkPhotoNotificationRequest) Reset() {
-	*x = SendBulkPhotoNotificationRequest{}
+func (x *PublicUserProfile) Reset() {
+	*x = PublicUserProfile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,13 +407,13 @@ func (x *SendBulkPhotoNotificationRequest) Reset() {
 	}
 }
 
-func (x *SendBulkPhotoNotificationRequest) String() string {
+func (x *PublicUserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBulkPhotoNotificationRequest) ProtoMessage() {}
+func (*PublicUserProfile) ProtoMessage() {}
 
-func (x *SendBulkPhotoNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *PublicUserProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,29 +425,72 @@ func (x *SendBulkPhotoNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBulkPhotoNotificationRequest.ProtoReflect.Descriptor instead.
-func (*SendBulkPhotoNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublicUserProfile.ProtoReflect.Descriptor instead.
+func (*PublicUserProfile) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SendBulkPhotoNotificationRequest) GetBulkUserSimilarPhoto() []*photo.BulkUserSimilarPhoto {
+func (x *PublicUserProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetBiography() string {
 	if x != nil {
-		return x.BulkUserSimilarPhoto
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetProfileUrl() string {
+	if x != nil {
+		return x.ProfileUrl
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetProfileCoverUrl() string {
+	if x != nil {
+		return x.ProfileCoverUrl
+	}
+	return ""
+}
+
+func (x *PublicUserProfile) GetSocialLinks() []*SocialLink {
+	if x != nil {
+		return x.SocialLinks
 	}
 	return nil
 }
 
-type SendBulkPhotoNotificationResponse struct {
+type GetPublicUserProfileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status  int64              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Profile *PublicUserProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *SendBulkPhotoNotificationResponse) Reset() {
-	*x = SendBulkPhotoNotificationResponse{}
+func (x *GetPublicUserProfileResponse) Reset() {
+	*x = GetPublicUserProfileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,13 +498,13 @@ func (x *SendBulkPhotoNotificationResponse) Reset() {
 	}
 }
 
-func (x *SendBulkPhotoNotificationResponse) String() string {
+func (x *GetPublicUserProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBulkPhotoNotificationResponse) ProtoMessage() {}
+func (*GetPublicUserProfileResponse) ProtoMessage() {}
 
-func (x *SendBulkPhotoNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPublicUserProfileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,35 +516,44 @@ func (x *SendBulkPhotoNotificationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBulkPhotoNotificationResponse.ProtoReflect.Descriptor instead.
-func (*SendBulkPhotoNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPublicUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicUserProfileResponse) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SendBulkPhotoNotificationResponse) GetStatus() int64 {
+func (x *GetPublicUserProfileResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *SendBulkPhotoNotificationResponse) GetError() string {
+func (x *GetPublicUserProfileResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SendSinglePhotoNotificationRequest struct {
+func (x *GetPublicUserProfileResponse) GetProfile() *PublicUserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type SetUserRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserSimilarPhoto []*photo.UserSimilarPhoto `protobuf:"bytes,1,rep,name=user_similar_photo,json=userSimilarPhoto,proto3" json:"user_similar_photo,omitempty"`
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Granted bool   `protobuf:"varint,3,opt,name=granted,proto3" json:"granted,omitempty"`
 }
 
-func (x *SendSinglePhotoNotificationRequest) Reset() {
-	*x = SendSinglePhotoNotificationRequest{}
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,13 +561,13 @@ func (x *SendSinglePhotoNotificationRequest) Reset() {
 	}
 }
 
-func (x *SendSinglePhotoNotificationRequest) String() string {
+func (x *SetUserRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendSinglePhotoNotificationRequest) ProtoMessage() {}
+func (*SetUserRoleRequest) ProtoMessage() {}
 
-func (x *SendSinglePhotoNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,19 +579,33 @@ func (x *SendSinglePhotoNotificationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendSinglePhotoNotificationRequest.ProtoReflect.Descriptor instead.
-func (*SendSinglePhotoNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SendSinglePhotoNotificationRequest) GetUserSimilarPhoto() []*photo.UserSimilarPhoto {
+func (x *SetUserRoleRequest) GetUserId() string {
 	if x != nil {
-		return x.UserSimilarPhoto
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type SendSinglePhotoNotificationResponse struct {
+func (x *SetUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+type SetUserRoleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -486,8 +614,8 @@ type SendSinglePhotoNotificationResponse struct {
 	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *SendSinglePhotoNotificationResponse) Reset() {
-	*x = SendSinglePhotoNotificationResponse{}
+func (x *SetUserRoleResponse) Reset() {
+	*x = SetUserRoleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,13 +623,13 @@ func (x *SendSinglePhotoNotificationResponse) Reset() {
 	}
 }
 
-func (x *SendSinglePhotoNotificationResponse) String() string {
+func (x *SetUserRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendSinglePhotoNotificationResponse) ProtoMessage() {}
+func (*SetUserRoleResponse) ProtoMessage() {}
 
-func (x *SendSinglePhotoNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *SetUserRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,35 +641,35 @@ func (x *SendSinglePhotoNotificationResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendSinglePhotoNotificationResponse.ProtoReflect.Descriptor instead.
-func (*SendSinglePhotoNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SendSinglePhotoNotificationResponse) GetStatus() int64 {
+func (x *SetUserRoleResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *SendSinglePhotoNotificationResponse) GetError() string {
+func (x *SetUserRoleResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SendSingleFacecamNotificationRequest struct {
+type GetUserContactsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserSimilarPhoto []*photo.UserSimilarPhoto `protobuf:"bytes,1,rep,name=user_similar_photo,json=userSimilarPhoto,proto3" json:"user_similar_photo,omitempty"`
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 }
 
-func (x *SendSingleFacecamNotificationRequest) Reset() {
-	*x = SendSingleFacecamNotificationRequest{}
+func (x *GetUserContactsRequest) Reset() {
+	*x = GetUserContactsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,13 +677,13 @@ func (x *SendSingleFacecamNotificationRequest) Reset() {
 	}
 }
 
-func (x *SendSingleFacecamNotificationRequest) String() string {
+func (x *GetUserContactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendSingleFacecamNotificationRequest) ProtoMessage() {}
+func (*GetUserContactsRequest) ProtoMessage() {}
 
-func (x *SendSingleFacecamNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserContactsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,29 +695,30 @@ func (x *SendSingleFacecamNotificationRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendSingleFacecamNotificationRequest.ProtoReflect.Descriptor instead.
-func (*SendSingleFacecamNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContactsRequest) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SendSingleFacecamNotificationRequest) GetUserSimilarPhoto() []*photo.UserSimilarPhoto {
+func (x *GetUserContactsRequest) GetUserIds() []string {
 	if x != nil {
-		return x.UserSimilarPhoto
+		return x.UserIds
 	}
 	return nil
 }
 
-type SendSingleFacecamNotificationResponse struct {
+type UserContact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int64  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *SendSingleFacecamNotificationResponse) Reset() {
-	*x = SendSingleFacecamNotificationResponse{}
+func (x *UserContact) Reset() {
+	*x = UserContact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_user_user_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,13 +726,13 @@ func (x *SendSingleFacecamNotificationResponse) Reset() {
 	}
 }
 
-func (x *SendSingleFacecamNotificationResponse) String() string {
+func (x *UserContact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendSingleFacecamNotificationResponse) ProtoMessage() {}
+func (*UserContact) ProtoMessage() {}
 
-func (x *SendSingleFacecamNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *UserContact) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_user_user_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,144 +744,378 @@ func (x *SendSingleFacecamNotificationResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendSingleFacecamNotificationResponse.ProtoReflect.Descriptor instead.
-func (*SendSingleFacecamNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserContact.ProtoReflect.Descriptor instead.
+func (*UserContact) Descriptor() ([]byte, []int) {
 	return file_pb_user_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SendSingleFacecamNotificationResponse) GetStatus() int64 {
+func (x *UserContact) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserContact) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserContact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetUserContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int64          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Contacts []*UserContact `protobuf:"bytes,3,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *GetUserContactsResponse) Reset() {
+	*x = GetUserContactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_user_user_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContactsResponse) ProtoMessage() {}
+
+func (x *GetUserContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_user_user_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserContactsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_user_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserContactsResponse) GetStatus() int64 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *SendSingleFacecamNotificationResponse) GetError() string {
+func (x *GetUserContactsResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
+func (x *GetUserContactsResponse) GetContacts() []*UserContact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+// ListSegmentUserIdsRequest pages through the active users of an audience segment sorted by id, the next page starts
+// after the last user id of the previous one. inactive_days is only used by the INACTIVE segment.
+type ListSegmentUserIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segment      string `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	InactiveDays int32  `protobuf:"varint,2,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	AfterUserId  string `protobuf:"bytes,3,opt,name=after_user_id,json=afterUserId,proto3" json:"after_user_id,omitempty"`
+	Limit        int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListSegmentUserIdsRequest) Reset() {
+	*x = ListSegmentUserIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_user_user_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSegmentUserIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentUserIdsRequest) ProtoMessage() {}
+
+func (x *ListSegmentUserIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_user_user_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentUserIdsRequest.ProtoReflect.Descriptor instead.
+func (*ListSegmentUserIdsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_user_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSegmentUserIdsRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *ListSegmentUserIdsRequest) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+func (x *ListSegmentUserIdsRequest) GetAfterUserId() string {
+	if x != nil {
+		return x.AfterUserId
+	}
+	return ""
+}
+
+func (x *ListSegmentUserIdsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSegmentUserIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  int64    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserIds []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *ListSegmentUserIdsResponse) Reset() {
+	*x = ListSegmentUserIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_user_user_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSegmentUserIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentUserIdsResponse) ProtoMessage() {}
+
+func (x *ListSegmentUserIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_user_user_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentUserIdsResponse.ProtoReflect.Descriptor instead.
+func (*ListSegmentUserIdsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_user_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSegmentUserIdsResponse) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListSegmentUserIdsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListSegmentUserIdsResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
 var File_pb_user_user_proto protoreflect.FileDescriptor
 
 var file_pb_user_user_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x70, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x11, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a,
-	0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64, 0x0a, 0x14, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x1e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x22, 0xd0, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xeb, 0x02,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x77, 0x6f, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0x84, 0x02, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c,
-	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x69, 0x6d,
-	0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61,
-	0x70, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c,
-	0x0a, 0x1c, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x76, 0x0a, 0x20,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x52, 0x0a, 0x17, 0x62, 0x75, 0x6c, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69,
-	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x14,
-	0x62, 0x75, 0x6c, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x21, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b,
-	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x69, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x76,
+	0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0x7f, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x33, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x58, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x76, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x22, 0x53, 0x65, 0x6e, 0x64, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a,
-	0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f,
-	0x74, 0x6f, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x23, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x24, 0x53, 0x65, 0x6e,
-	0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x45, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
-	0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61,
-	0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6d, 0x69,
-	0x6c, 0x61, 0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x25, 0x53, 0x65, 0x6e, 0x64,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32,
-	0x8f, 0x04, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x45, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75,
-	0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x68, 0x6f, 0x74, 0x6f,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x75, 0x6c, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6c, 0x6b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x75, 0x6c, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x1d, 0x53, 0x65, 0x6e, 0x64, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61,
-	0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x65, 0x63, 0x61, 0x6d, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x68, 0x65, 0x72, 0x76, 0x69, 0x62, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x65, 0x2d, 0x79, 0x6f, 0x75,
-	0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2f,
-	0x70, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x32, 0xa0, 0x03, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12,
+	0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x65, 0x72, 0x76, 0x69, 0x62, 0x65, 0x73, 0x74, 0x2f, 0x62, 0x65,
+	0x2d, 0x79, 0x6f, 0x75, 0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x3b, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -767,44 +1130,43 @@ func file_pb_user_user_proto_rawDescGZIP() []byte {
 	return file_pb_user_user_proto_rawDescData
 }
 
-var file_pb_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_pb_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_pb_user_user_proto_goTypes = []interface{}{
-	(*AuthenticateRequest)(nil),                   // 0: user.AuthenticateRequest
-	(*AuthenticateResponse)(nil),                  // 1: user.AuthenticateResponse
-	(*User)(nil),                                  // 2: user.User
-	(*SendBulkNotificationRequest)(nil),           // 3: user.SendBulkNotificationRequest
-	(*SendBulkNotificationResponse)(nil),          // 4: user.SendBulkNotificationResponse
-	(*SendBulkPhotoNotificationRequest)(nil),      // 5: user.SendBulkPhotoNotificationRequest
-	(*SendBulkPhotoNotificationResponse)(nil),     // 6: user.SendBulkPhotoNotificationResponse
-	(*SendSinglePhotoNotificationRequest)(nil),    // 7: user.SendSinglePhotoNotificationRequest
-	(*SendSinglePhotoNotificationResponse)(nil),   // 8: user.SendSinglePhotoNotificationResponse
-	(*SendSingleFacecamNotificationRequest)(nil),  // 9: user.SendSingleFacecamNotificationRequest
-	(*SendSingleFacecamNotificationResponse)(nil), // 10: user.SendSingleFacecamNotificationResponse
-	nil,                                // 11: user.SendBulkNotificationRequest.CountMapEntry
-	(*photo.BulkUserSimilarPhoto)(nil), // 12: photo.BulkUserSimilarPhoto
-	(*photo.UserSimilarPhoto)(nil),     // 13: photo.UserSimilarPhoto
+	(*AuthenticateRequest)(nil),          // 0: user.AuthenticateRequest
+	(*AuthenticateResponse)(nil),         // 1: user.AuthenticateResponse
+	(*User)(nil),                         // 2: user.User
+	(*GetPublicUserProfileRequest)(nil),  // 3: user.GetPublicUserProfileRequest
+	(*SocialLink)(nil),                   // 4: user.SocialLink
+	(*PublicUserProfile)(nil),            // 5: user.PublicUserProfile
+	(*GetPublicUserProfileResponse)(nil), // 6: user.GetPublicUserProfileResponse
+	(*SetUserRoleRequest)(nil),           // 7: user.SetUserRoleRequest
+	(*SetUserRoleResponse)(nil),          // 8: user.SetUserRoleResponse
+	(*GetUserContactsRequest)(nil),       // 9: user.GetUserContactsRequest
+	(*UserContact)(nil),                  // 10: user.UserContact
+	(*GetUserContactsResponse)(nil),      // 11: user.GetUserContactsResponse
+	(*ListSegmentUserIdsRequest)(nil),    // 12: user.ListSegmentUserIdsRequest
+	(*ListSegmentUserIdsResponse)(nil),   // 13: user.ListSegmentUserIdsResponse
 }
 var file_pb_user_user_proto_depIdxs = []int32{
 	2,  // 0: user.AuthenticateResponse.user:type_name -> user.User
-	11, // 1: user.SendBulkNotificationRequest.count_map:type_name -> user.SendBulkNotificationRequest.CountMapEntry
-	12, // 2: user.SendBulkPhotoNotificationRequest.bulk_user_similar_photo:type_name -> photo.BulkUserSimilarPhoto
-	13, // 3: user.SendSinglePhotoNotificationRequest.user_similar_photo:type_name -> photo.UserSimilarPhoto
-	13, // 4: user.SendSingleFacecamNotificationRequest.user_similar_photo:type_name -> photo.UserSimilarPhoto
-	0,  // 5: user.UserService.Authenticate:input_type -> user.AuthenticateRequest
-	5,  // 6: user.UserService.SendBulkPhotoNotification:input_type -> user.SendBulkPhotoNotificationRequest
-	7,  // 7: user.UserService.SendSinglePhotoNotification:input_type -> user.SendSinglePhotoNotificationRequest
-	3,  // 8: user.UserService.SendBulkNotification:input_type -> user.SendBulkNotificationRequest
-	9,  // 9: user.UserService.SendSingleFacecamNotification:input_type -> user.SendSingleFacecamNotificationRequest
-	1,  // 10: user.UserService.Authenticate:output_type -> user.AuthenticateResponse
-	6,  // 11: user.UserService.SendBulkPhotoNotification:output_type -> user.SendBulkPhotoNotificationResponse
-	8,  // 12: user.UserService.SendSinglePhotoNotification:output_type -> user.SendSinglePhotoNotificationResponse
-	4,  // 13: user.UserService.SendBulkNotification:output_type -> user.SendBulkNotificationResponse
-	10, // 14: user.UserService.SendSingleFacecamNotification:output_type -> user.SendSingleFacecamNotificationResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,  // 1: user.PublicUserProfile.social_links:type_name -> user.SocialLink
+	5,  // 2: user.GetPublicUserProfileResponse.profile:type_name -> user.PublicUserProfile
+	10, // 3: user.GetUserContactsResponse.contacts:type_name -> user.UserContact
+	0,  // 4: user.UserService.Authenticate:input_type -> user.AuthenticateRequest
+	3,  // 5: user.UserService.GetPublicUserProfile:input_type -> user.GetPublicUserProfileRequest
+	7,  // 6: user.UserService.SetUserRole:input_type -> user.SetUserRoleRequest
+	9,  // 7: user.UserService.GetUserContacts:input_type -> user.GetUserContactsRequest
+	12, // 8: user.UserService.ListSegmentUserIds:input_type -> user.ListSegmentUserIdsRequest
+	1,  // 9: user.UserService.Authenticate:output_type -> user.AuthenticateResponse
+	6,  // 10: user.UserService.GetPublicUserProfile:output_type -> user.GetPublicUserProfileResponse
+	8,  // 11: user.UserService.SetUserRole:output_type -> user.SetUserRoleResponse
+	11, // 12: user.UserService.GetUserContacts:output_type -> user.GetUserContactsResponse
+	13, // 13: user.UserService.ListSegmentUserIds:output_type -> user.ListSegmentUserIdsResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_pb_user_user_proto_init() }
@@ -850,7 +1212,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBulkNotificationRequest); i {
+			switch v := v.(*GetPublicUserProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -862,7 +1224,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBulkNotificationResponse); i {
+			switch v := v.(*SocialLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,7 +1236,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBulkPhotoNotificationRequest); i {
+			switch v := v.(*PublicUserProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,7 +1248,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBulkPhotoNotificationResponse); i {
+			switch v := v.(*GetPublicUserProfileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,7 +1260,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendSinglePhotoNotificationRequest); i {
+			switch v := v.(*SetUserRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,7 +1272,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendSinglePhotoNotificationResponse); i {
+			switch v := v.(*SetUserRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -922,7 +1284,7 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendSingleFacecamNotificationRequest); i {
+			switch v := v.(*GetUserContactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -934,7 +1296,43 @@ func file_pb_user_user_proto_init() {
 			}
 		}
 		file_pb_user_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendSingleFacecamNotificationResponse); i {
+			switch v := v.(*UserContact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_user_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserContactsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_user_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSegmentUserIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_user_user_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSegmentUserIdsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +1350,7 @@ func file_pb_user_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_user_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Authenticate_FullMethodName                  = "/user.UserService/Authenticate"
-	UserService_SendBulkPhotoNotification_FullMethodName     = "/user.UserService/SendBulkPhotoNotification"
-	UserService_SendSinglePhotoNotification_FullMethodName   = "/user.UserService/SendSinglePhotoNotification"
-	UserService_SendBulkNotification_FullMethodName          = "/user.UserService/SendBulkNotification"
-	UserService_SendSingleFacecamNotification_FullMethodName = "/user.UserService/SendSingleFacecamNotification"
+	UserService_Authenticate_FullMethodName         = "/user.UserService/Authenticate"
+	UserService_GetPublicUserProfile_FullMethodName = "/user.UserService/GetPublicUserProfile"
+	UserService_SetUserRole_FullMethodName          = "/user.UserService/SetUserRole"
+	UserService_GetUserContacts_FullMethodName      = "/user.UserService/GetUserContacts"
+	UserService_ListSegmentUserIds_FullMethodName   = "/user.UserService/ListSegmentUserIds"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,10 +31,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
-	SendBulkPhotoNotification(ctx context.Context, in *SendBulkPhotoNotificationRequest, opts ...grpc.CallOption) (*SendBulkPhotoNotificationResponse, error)
-	SendSinglePhotoNotification(ctx context.Context, in *SendSinglePhotoNotificationRequest, opts ...grpc.CallOption) (*SendSinglePhotoNotificationResponse, error)
-	SendBulkNotification(ctx context.Context, in *SendBulkNotificationRequest, opts ...grpc.CallOption) (*SendBulkNotificationResponse, error)
-	SendSingleFacecamNotification(ctx context.Context, in *SendSingleFacecamNotificationRequest, opts ...grpc.CallOption) (*SendSingleFacecamNotificationResponse, error)
+	GetPublicUserProfile(ctx context.Context, in *GetPublicUserProfileRequest, opts ...grpc.CallOption) (*GetPublicUserProfileResponse, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error)
+	GetUserContacts(ctx context.Context, in *GetUserContactsRequest, opts ...grpc.CallOption) (*GetUserContactsResponse, error)
+	ListSegmentUserIds(ctx context.Context, in *ListSegmentUserIdsRequest, opts ...grpc.CallOption) (*ListSegmentUserIdsResponse, error)
 }
 
 type userServiceClient struct {
@@ -55,40 +55,40 @@ func (c *userServiceClient) Authenticate(ctx context.Context, in *AuthenticateRe
 	return out, nil
 }
 
-func (c *userServiceClient) SendBulkPhotoNotification(ctx context.Context, in *SendBulkPhotoNotificationRequest, opts ...grpc.CallOption) (*SendBulkPhotoNotificationResponse, error) {
+func (c *userServiceClient) GetPublicUserProfile(ctx context.Context, in *GetPublicUserProfileRequest, opts ...grpc.CallOption) (*GetPublicUserProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendBulkPhotoNotificationResponse)
-	err := c.cc.Invoke(ctx, UserService_SendBulkPhotoNotification_FullMethodName, in, out, cOpts...)
+	out := new(GetPublicUserProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicUserProfile_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) SendSinglePhotoNotification(ctx context.Context, in *SendSinglePhotoNotificationRequest, opts ...grpc.CallOption) (*SendSinglePhotoNotificationResponse, error) {
+func (c *userServiceClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendSinglePhotoNotificationResponse)
-	err := c.cc.Invoke(ctx, UserService_SendSinglePhotoNotification_FullMethodName, in, out, cOpts...)
+	out := new(SetUserRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_SetUserRole_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) SendBulkNotification(ctx context.Context, in *SendBulkNotificationRequest, opts ...grpc.CallOption) (*SendBulkNotificationResponse, error) {
+func (c *userServiceClient) GetUserContacts(ctx context.Context, in *GetUserContactsRequest, opts ...grpc.CallOption) (*GetUserContactsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendBulkNotificationResponse)
-	err := c.cc.Invoke(ctx, UserService_SendBulkNotification_FullMethodName, in, out, cOpts...)
+	out := new(GetUserContactsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserContacts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) SendSingleFacecamNotification(ctx context.Context, in *SendSingleFacecamNotificationRequest, opts ...grpc.CallOption) (*SendSingleFacecamNotificationResponse, error) {
+func (c *userServiceClient) ListSegmentUserIds(ctx context.Context, in *ListSegmentUserIdsRequest, opts ...grpc.CallOption) (*ListSegmentUserIdsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendSingleFacecamNotificationResponse)
-	err := c.cc.Invoke(ctx, UserService_SendSingleFacecamNotification_FullMethodName, in, out, cOpts...)
+	out := new(ListSegmentUserIdsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSegmentUserIds_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,10 +100,10 @@ func (c *userServiceClient) SendSingleFacecamNotification(ctx context.Context, i
 // for forward compatibility.
 type UserServiceServer interface {
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
-	SendBulkPhotoNotification(context.Context, *SendBulkPhotoNotificationRequest) (*SendBulkPhotoNotificationResponse, error)
-	SendSinglePhotoNotification(context.Context, *SendSinglePhotoNotificationRequest) (*SendSinglePhotoNotificationResponse, error)
-	SendBulkNotification(context.Context, *SendBulkNotificationRequest) (*SendBulkNotificationResponse, error)
-	SendSingleFacecamNotification(context.Context, *SendSingleFacecamNotificationRequest) (*SendSingleFacecamNotificationResponse, error)
+	GetPublicUserProfile(context.Context, *GetPublicUserProfileRequest) (*GetPublicUserProfileResponse, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error)
+	GetUserContacts(context.Context, *GetUserContactsRequest) (*GetUserContactsResponse, error)
+	ListSegmentUserIds(context.Context, *ListSegmentUserIdsRequest) (*ListSegmentUserIdsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -117,17 +117,17 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
-func (UnimplementedUserServiceServer) SendBulkPhotoNotification(context.Context, *SendBulkPhotoNotificationRequest) (*SendBulkPhotoNotificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendBulkPhotoNotification not implemented")
+func (UnimplementedUserServiceServer) GetPublicUserProfile(context.Context, *GetPublicUserProfileRequest) (*GetPublicUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicUserProfile not implemented")
 }
-func (UnimplementedUserServiceServer) SendSinglePhotoNotification(context.Context, *SendSinglePhotoNotificationRequest) (*SendSinglePhotoNotificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendSinglePhotoNotification not implemented")
+func (UnimplementedUserServiceServer) SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
 }
-func (UnimplementedUserServiceServer) SendBulkNotification(context.Context, *SendBulkNotificationRequest) (*SendBulkNotificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendBulkNotification not implemented")
+func (UnimplementedUserServiceServer) GetUserContacts(context.Context, *GetUserContactsRequest) (*GetUserContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserContacts not implemented")
 }
-func (UnimplementedUserServiceServer) SendSingleFacecamNotification(context.Context, *SendSingleFacecamNotificationRequest) (*SendSingleFacecamNotificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendSingleFacecamNotification not implemented")
+func (UnimplementedUserServiceServer) ListSegmentUserIds(context.Context, *ListSegmentUserIdsRequest) (*ListSegmentUserIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegmentUserIds not implemented")
 }
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
@@ -168,74 +168,74 @@ func _UserService_Authenticate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SendBulkPhotoNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendBulkPhotoNotificationRequest)
+func _UserService_GetPublicUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicUserProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SendBulkPhotoNotification(ctx, in)
+		return srv.(UserServiceServer).GetPublicUserProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SendBulkPhotoNotification_FullMethodName,
+		FullMethod: UserService_GetPublicUserProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SendBulkPhotoNotification(ctx, req.(*SendBulkPhotoNotificationRequest))
+		return srv.(UserServiceServer).GetPublicUserProfile(ctx, req.(*GetPublicUserProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SendSinglePhotoNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendSinglePhotoNotificationRequest)
+func _UserService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SendSinglePhotoNotification(ctx, in)
+		return srv.(UserServiceServer).SetUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SendSinglePhotoNotification_FullMethodName,
+		FullMethod: UserService_SetUserRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SendSinglePhotoNotification(ctx, req.(*SendSinglePhotoNotificationRequest))
+		return srv.(UserServiceServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SendBulkNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendBulkNotificationRequest)
+func _UserService_GetUserContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SendBulkNotification(ctx, in)
+		return srv.(UserServiceServer).GetUserContacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SendBulkNotification_FullMethodName,
+		FullMethod: UserService_GetUserContacts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SendBulkNotification(ctx, req.(*SendBulkNotificationRequest))
+		return srv.(UserServiceServer).GetUserContacts(ctx, req.(*GetUserContactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SendSingleFacecamNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendSingleFacecamNotificationRequest)
+func _UserService_ListSegmentUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSegmentUserIdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SendSingleFacecamNotification(ctx, in)
+		return srv.(UserServiceServer).ListSegmentUserIds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SendSingleFacecamNotification_FullMethodName,
+		FullMethod: UserService_ListSegmentUserIds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SendSingleFacecamNotification(ctx, req.(*SendSingleFacecamNotificationRequest))
+		return srv.(UserServiceServer).ListSegmentUserIds(ctx, req.(*ListSegmentUserIdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -252,20 +252,20 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UserService_Authenticate_Handler,
 		},
 		{
-			MethodName: "SendBulkPhotoNotification",
-			Handler:    _UserService_SendBulkPhotoNotification_Handler,
+			MethodName: "GetPublicUserProfile",
+			Handler:    _UserService_GetPublicUserProfile_Handler,
 		},
 		{
-			MethodName: "SendSinglePhotoNotification",
-			Handler:    _UserService_SendSinglePhotoNotification_Handler,
+			MethodName: "SetUserRole",
+			Handler:    _UserService_SetUserRole_Handler,
 		},
 		{
-			MethodName: "SendBulkNotification",
-			Handler:    _UserService_SendBulkNotification_Handler,
+			MethodName: "GetUserContacts",
+			Handler:    _UserService_GetUserContacts_Handler,
 		},
 		{
-			MethodName: "SendSingleFacecamNotification",
-			Handler:    _UserService_SendSingleFacecamNotification_Handler,
+			MethodName: "ListSegmentUserIds",
+			Handler:    _UserService_ListSegmentUserIds_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
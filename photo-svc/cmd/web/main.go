@@ -60,12 +60,13 @@ func webServer(ctx context.Context) error {
 	jetStreamConfig := config.NewJetStream()
 	redisConfig := config.NewRedisClient()
 
-	config.InitCreatorReviewStream(jetStreamConfig)
 	config.InitUserStream(jetStreamConfig)
+	config.InitUserDeletionStream(jetStreamConfig)
+	config.InitUserProfileStream(jetStreamConfig)
+	config.InitCreatorBatchStream(jetStreamConfig)
 	config.DeleteAISimilarStream(jetStreamConfig, logs)
 	config.InitAISimilarStream(jetStreamConfig)
 	config.InitUploadPhotoStream(jetStreamConfig)
-	config.InitTransactionStream(jetStreamConfig)
 
 	registry, err := consul.NewRegistry(serverConfig.ConsulAddr, serverConfig.Name)
 	if err != nil {
@@ -112,10 +113,12 @@ func webServer(ctx context.Context) error {
 
 	// aiAdapter, _ := adapter.NewAiAdapter(ctx, registry, logs)
 	userAdapter, _ := adapter.NewUserAdapter(ctx, registry, logs)
+	transactionAdapter, _ := adapter.NewTransactionAdapter(ctx, registry, logs)
 	storageAdapter := adapter.NewStorageAdapter(minioConfig)
 	CDNAdapter := adapter.NewCDNadapter()
 	cacheAdapter := adapter.NewCacheAdapter(redisConfig)
 	messaginAdapter := adapter.NewMessagingAdapter(jetStreamConfig)
+	priceQuoteAdapter := adapter.NewPriceQuoteAdapter(redisConfig)
 	creatorProducer := producer.NewCreatorProducer(messaginAdapter, logs)
 	photoProducer := producer.NewPhotoProducer(messaginAdapter, logs)
 
@@ -129,19 +132,28 @@ func webServer(ctx context.Context) error {
 	creatorRepository, _ := repository.NewCreatorRepository(dbConfig)
 	creatorDiscountRepository, _ := repository.NewCreatorDiscountRepository(dbConfig)
 	bulkPhotoRepository := repository.NewBulkPhotoRepository()
+	voucherRepository := repository.NewVoucherRepository()
+	creatorFollowRepository := repository.NewCreatorFollowRepository()
 
 	photoUseCase := usecase.NewPhotoUseCase(dbConfig, photoRepo, photoDetailRepo, userSimilarRepo, creatorRepository,
 		bulkPhotoRepository, storageAdapter, CDNAdapter, logs)
 	faceCamUseCase := usecase.NewFacecamUseCase(dbConfig, facecamRepo, userSimilarRepo, storageAdapter, logs)
 	userSimilarPhotoUsecase := usecase.NewUserSimilarUsecase(dbConfig, photoRepo, photoDetailRepo, facecamRepo,
-		userSimilarRepo, bulkPhotoRepository, userAdapter, photoProducer, logs)
-	creatorUseCase := usecase.NewCreatorUseCase(dbConfig, creatorRepository, cacheAdapter, creatorProducer, logs)
+		userSimilarRepo, bulkPhotoRepository, creatorFollowRepository, userAdapter, photoProducer, creatorProducer, logs)
+	creatorUseCase := usecase.NewCreatorUseCase(dbConfig, creatorRepository, cacheAdapter, userAdapter, creatorProducer, logs)
 	exploreUseCase := usecase.NewExploreUseCase(dbConfig, exploreRepo, photoRepo, CDNAdapter, tracer, logs)
-	creatorDiscountUseCase := usecase.NewCreatorDiscountUseCase(dbConfig, creatorDiscountRepository, logs)
-	checkoutUseCase := usecase.NewCheckoutUseCase(dbConfig, photoRepo, creatorRepository, creatorDiscountRepository, logs, CDNAdapter)
+	voucherUseCase := usecase.NewVoucherUseCase(dbConfig, voucherRepository, logs)
+	creatorProfileUseCase := usecase.NewCreatorProfileUseCase(dbConfig, creatorRepository, bulkPhotoRepository, photoRepo, creatorDiscountRepository,
+		userAdapter, transactionAdapter, cacheAdapter, CDNAdapter, logs)
+	creatorDiscountUseCase := usecase.NewCreatorDiscountUseCase(dbConfig, creatorDiscountRepository, creatorProfileUseCase, logs)
+	checkoutUseCase := usecase.NewCheckoutUseCase(dbConfig, photoRepo, creatorRepository, creatorDiscountRepository, voucherRepository,
+		logs, CDNAdapter, priceQuoteAdapter)
+	userDataUseCase := usecase.NewUserDataUseCase(dbConfig, facecamRepo, userSimilarRepo, photoRepo, voucherRepository, creatorFollowRepository,
+		storageAdapter, logs)
+	followUseCase := usecase.NewFollowUseCase(dbConfig, creatorRepository, creatorFollowRepository, userAdapter, CDNAdapter, logs)
 
 	userSimilarWorkerUC := usecase.NewUserSimilarWorkerUseCase(dbConfig, photoRepo, photoDetailRepo, facecamRepo,
-		userSimilarRepo, bulkPhotoRepository, userAdapter, photoProducer, logs)
+		userSimilarRepo, bulkPhotoRepository, creatorFollowRepository, userAdapter, photoProducer, creatorProducer, logs)
 
 	photoUseCaseWorker := usecase.NewPhotoWorkerUseCase(dbConfig, photoRepo, photoDetailRepo, userSimilarRepo, creatorRepository,
 		bulkPhotoRepository, storageAdapter, CDNAdapter, logs)
@@ -150,9 +162,12 @@ func webServer(ctx context.Context) error {
 
 	exploreController := http.NewExploreController(tracer, customValidator, exploreUseCase, logs)
 	creatorDiscountController := http.NewCreatorDiscountController(creatorDiscountUseCase, customValidator, logs)
+	voucherController := http.NewVoucherController(voucherUseCase, customValidator, logs)
 	healthCheckController := http.NewHealthCheckController()
 	checkoutController := http.NewCheckoutController(checkoutUseCase, customValidator, logs)
 	photoController := http.NewPhotoController(photoUseCase, customValidator, logs)
+	creatorController := http.NewCreatorController(creatorUseCase, creatorProfileUseCase, customValidator, logs)
+	followController := http.NewFollowController(followUseCase, customValidator, logs)
 	authMiddleware := middleware.NewUserAuth(userAdapter, tracer, logs)
 	creatorMiddleware := middleware.NewCreatorMiddleware(creatorUseCase, tracer, logs)
 
@@ -174,6 +189,9 @@ func webServer(ctx context.Context) error {
 
 	transactionConsumer := transactionconsumer.NewTransactionConsumer(checkoutUseCase, jetStreamConfig, logs)
 	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "TRANSACTION_STREAM", logs); err != nil {
+			return
+		}
 		logs.Log("consume all transaction event beginning")
 		if err := transactionConsumer.ConsumeAllEvents(ctx); err != nil {
 			logs.CustomError("failed to consume all transaction event", err)
@@ -182,6 +200,9 @@ func webServer(ctx context.Context) error {
 
 	creatorReviewSubscriber := subscriber.NewCreatorReviewSubscriber(jetStreamConfig, creatorUseCase, logs)
 	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "CREATOR_REVIEW_STREAM", logs); err != nil {
+			return
+		}
 		if err := creatorReviewSubscriber.Start(ctx); err != nil {
 			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
 		}
@@ -194,6 +215,20 @@ func webServer(ctx context.Context) error {
 		}
 	}()
 
+	userDeletionSubscriber := subscriber.NewUserDeletionSubscriber(jetStreamConfig, userDataUseCase, logs)
+	go func() {
+		if err := userDeletionSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
+	userProfileSubscriber := subscriber.NewUserProfileSubscriber(jetStreamConfig, creatorProfileUseCase, logs)
+	go func() {
+		if err := userProfileSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
 	go func() {
 		grpcServer = grpc.NewServer()
 		reflection.Register(grpcServer)
@@ -204,7 +239,7 @@ func webServer(ctx context.Context) error {
 		}
 		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
 		defer l.Close()
-		grpcHandler.NewPhotoGRPCHandler(grpcServer, photoUseCase, faceCamUseCase, userSimilarPhotoUsecase, creatorUseCase, checkoutUseCase)
+		grpcHandler.NewPhotoGRPCHandler(grpcServer, photoUseCase, faceCamUseCase, userSimilarPhotoUsecase, creatorUseCase, checkoutUseCase, userDataUseCase)
 		if err := grpcServer.Serve(l); err != nil {
 			logs.Error(fmt.Sprintf("Failed to start gRPC server: %v", err))
 		}
@@ -215,7 +250,10 @@ func webServer(ctx context.Context) error {
 		ExploreController:        exploreController,
 		HealthCheckController:    healthCheckController,
 		CreatorDiscountControler: creatorDiscountController,
+		VoucherController:        voucherController,
 		PhotoController:          photoController,
+		CreatorController:        creatorController,
+		FollowController:         followController,
 		AuthMiddleware:           authMiddleware,
 		CreatorMiddleware:        creatorMiddleware,
 		CheckoutController:       checkoutController,
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE discount_type ADD VALUE IF NOT EXISTS 'BUNDLE';
+
+-- +goose Down
+-- Postgres cannot drop a single enum value, BUNDLE rules are removed instead.
+DELETE FROM creator_discounts WHERE discount_type = 'BUNDLE';
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE creator_discounts
+    ADD COLUMN starts_at TIMESTAMPTZ,
+    ADD COLUMN ends_at TIMESTAMPTZ,
+    ADD CONSTRAINT chk_creator_discounts_window CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at);
+
+CREATE INDEX IF NOT EXISTS idx_creator_discounts_active_window ON creator_discounts (creator_id, is_active, starts_at, ends_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_creator_discounts_active_window;
+ALTER TABLE creator_discounts
+    DROP CONSTRAINT IF EXISTS chk_creator_discounts_window,
+    DROP COLUMN IF EXISTS starts_at,
+    DROP COLUMN IF EXISTS ends_at;
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE voucher_funder AS ENUM ('CREATOR', 'PLATFORM');
+CREATE TYPE voucher_redemption_status AS ENUM ('RESERVED', 'REDEEMED', 'RELEASED');
+
+CREATE TABLE IF NOT EXISTS vouchers (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    creator_id CHAR(26),
+    funded_by voucher_funder NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    discount_type discount_type NOT NULL,
+    value INT NOT NULL,
+    max_discount INT,
+    min_quantity INT NOT NULL DEFAULT 1,
+    usage_limit INT,
+    usage_limit_per_user INT,
+    is_stackable BOOLEAN NOT NULL DEFAULT false,
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    FOREIGN KEY (creator_id) REFERENCES creators(id),
+    CONSTRAINT chk_vouchers_funder CHECK (
+        (funded_by = 'CREATOR' AND creator_id IS NOT NULL) OR (funded_by = 'PLATFORM' AND creator_id IS NULL)
+    ),
+    CONSTRAINT chk_vouchers_type CHECK (discount_type IN ('FLAT', 'PERCENT')),
+    CONSTRAINT chk_vouchers_window CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vouchers_creator_id ON vouchers (creator_id);
+
+CREATE TABLE IF NOT EXISTS voucher_redemptions (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    voucher_id CHAR(26) NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    transaction_id VARCHAR(36) NOT NULL,
+    amount INT NOT NULL,
+    status voucher_redemption_status NOT NULL DEFAULT 'RESERVED',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    FOREIGN KEY (voucher_id) REFERENCES vouchers(id),
+    UNIQUE (voucher_id, transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_voucher_redemptions_usage ON voucher_redemptions (voucher_id, user_id, status);
+CREATE INDEX IF NOT EXISTS idx_voucher_redemptions_transaction_id ON voucher_redemptions (transaction_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS voucher_redemptions;
+DROP TABLE IF EXISTS vouchers;
+DROP TYPE IF EXISTS voucher_redemption_status;
+DROP TYPE IF EXISTS voucher_funder;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_photo_bulk_photo_id ON photos (bulk_photo_id);
+CREATE INDEX IF NOT EXISTS idx_user_similar_photo_user_id_similarity ON user_similar_photos (user_id, similarity);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_similar_photo_user_id_similarity;
+DROP INDEX IF EXISTS idx_photo_bulk_photo_id;
+-- +goose StatementEnd
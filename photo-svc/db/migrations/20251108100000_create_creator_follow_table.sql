@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE creators ADD COLUMN IF NOT EXISTS follower_count INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS creator_follows (
+    follower_user_id CHAR(26) NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (follower_user_id, creator_id),
+    FOREIGN KEY (creator_id) REFERENCES creators(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_creator_follows_creator_id_created_at ON creator_follows (creator_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_creator_follows_follower_user_id_created_at ON creator_follows (follower_user_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS creator_follows;
+ALTER TABLE creators DROP COLUMN IF EXISTS follower_count;
+-- +goose StatementEnd
@@ -0,0 +1,141 @@
+package adapter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/utils"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrInvalidPriceQuote = errors.New("invalid price quote")
+	ErrExpiredPriceQuote = errors.New("price quote has expired")
+	ErrUsedPriceQuote    = errors.New("price quote has already been used")
+)
+
+const (
+	priceQuoteMinSecretLength = 32
+	priceQuoteKeyPrefix       = "price_quote:"
+)
+
+// PriceQuoteAdapter signs checkout previews so the previewed price can be honoured at payment time.
+// The token format is base64url(payload).base64url(HMAC-SHA256(payload)).
+// Every signed quote id is kept in redis until it expires or is consumed, so a token can only be paid once.
+type PriceQuoteAdapter interface {
+	Sign(ctx context.Context, quote *model.PriceQuote) (string, error)
+	Verify(token string) (*model.PriceQuote, error)
+	Consume(ctx context.Context, quote *model.PriceQuote) error
+	Restore(ctx context.Context, quote *model.PriceQuote) error
+	TTL() time.Duration
+}
+
+type priceQuoteAdapter struct {
+	redisClient *redis.Client
+	secret      []byte
+	ttl         time.Duration
+}
+
+func NewPriceQuoteAdapter(redisClient *redis.Client) PriceQuoteAdapter {
+	secret := utils.GetEnv("PRICE_QUOTE_SECRET")
+	if len(secret) < priceQuoteMinSecretLength {
+		log.Fatalf("PRICE_QUOTE_SECRET must be at least %d characters", priceQuoteMinSecretLength)
+	}
+
+	ttlInt, err := strconv.Atoi(utils.GetEnv("PRICE_QUOTE_TTL_SECOND"))
+	if err != nil || ttlInt <= 0 {
+		ttlInt = 900
+	}
+
+	return &priceQuoteAdapter{
+		redisClient: redisClient,
+		secret:      []byte(secret),
+		ttl:         time.Duration(ttlInt) * time.Second,
+	}
+}
+
+func (a *priceQuoteAdapter) TTL() time.Duration {
+	return a.ttl
+}
+
+func (a *priceQuoteAdapter) Sign(ctx context.Context, quote *model.PriceQuote) (string, error) {
+	payload, err := sonic.ConfigFastest.Marshal(quote)
+	if err != nil {
+		return "", err
+	}
+
+	if err := a.redisClient.Set(ctx, priceQuoteKeyPrefix+quote.Id, quote.UserId, time.Until(time.Unix(quote.ExpiresAt, 0))).Err(); err != nil {
+		return "", err
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(a.signature(encodedPayload)), nil
+}
+
+func (a *priceQuoteAdapter) Verify(token string) (*model.PriceQuote, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidPriceQuote
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, a.signature(encodedPayload)) {
+		return nil, ErrInvalidPriceQuote
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidPriceQuote
+	}
+
+	quote := new(model.PriceQuote)
+	if err := sonic.ConfigFastest.Unmarshal(payload, quote); err != nil {
+		return nil, ErrInvalidPriceQuote
+	}
+
+	if time.Now().Unix() > quote.ExpiresAt {
+		return quote, ErrExpiredPriceQuote
+	}
+
+	return quote, nil
+}
+
+// Consume deletes the quote id, only the first caller sees the key so a replayed token is rejected
+func (a *priceQuoteAdapter) Consume(ctx context.Context, quote *model.PriceQuote) error {
+	deleted, err := a.redisClient.Del(ctx, priceQuoteKeyPrefix+quote.Id).Result()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrUsedPriceQuote
+	}
+
+	return nil
+}
+
+// Restore puts a consumed quote id back for the rest of its lifetime, used when the checkout that consumed it was not stored
+func (a *priceQuoteAdapter) Restore(ctx context.Context, quote *model.PriceQuote) error {
+	ttl := time.Until(time.Unix(quote.ExpiresAt, 0))
+	if ttl <= 0 {
+		return nil
+	}
+
+	return a.redisClient.SetNX(ctx, priceQuoteKeyPrefix+quote.Id, quote.UserId, ttl).Err()
+}
+
+func (a *priceQuoteAdapter) signature(encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
+}
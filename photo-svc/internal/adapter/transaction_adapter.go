@@ -13,6 +13,7 @@ import (
 
 type TransactionAdapter interface {
 	CreateWallet(ctx context.Context, creatorId string) (*transcationpb.CreateWalletResponse, error)
+	GetCreatorReviewSummary(ctx context.Context, creatorId string) (*transcationpb.GetCreatorReviewSummaryResponse, error)
 }
 
 type transactionAdapter struct {
@@ -47,3 +48,16 @@ func (a *transactionAdapter) CreateWallet(ctx context.Context, creatorId string)
 
 	return response, nil
 }
+
+func (a *transactionAdapter) GetCreatorReviewSummary(ctx context.Context, creatorId string) (*transcationpb.GetCreatorReviewSummaryResponse, error) {
+	getCreatorReviewSummaryReq := &transcationpb.GetCreatorReviewSummaryRequest{
+		CreatorId: creatorId,
+	}
+
+	response, err := a.client.GetCreatorReviewSummary(ctx, getCreatorReviewSummaryReq)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	return response, nil
+}
@@ -9,16 +9,13 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/utils"
 
-	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
 	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
 )
 
 type UserAdapter interface {
 	AuthenticateUser(ctx context.Context, token string) (*userpb.AuthenticateResponse, error)
-	SendBulkPhotoNotification(ctx context.Context, request []*photopb.BulkUserSimilarPhoto) (*userpb.SendBulkPhotoNotificationResponse, error)
-	SendSinglePhotoNotification(ctx context.Context, request []*photopb.UserSimilarPhoto) (*userpb.SendSinglePhotoNotificationResponse, error)
-	SendBulkNotification(ctx context.Context, countMap map[string]int32) (*userpb.SendBulkNotificationResponse, error)
-	SendSingleFacecamNotificaton(ctx context.Context, request []*photopb.UserSimilarPhoto) (*userpb.SendSingleFacecamNotificationResponse, error)
+	GetPublicUserProfile(ctx context.Context, userId string) (*userpb.GetPublicUserProfileResponse, error)
+	SetUserRole(ctx context.Context, userId, role string, granted bool) error
 }
 
 type userAdapter struct {
@@ -54,12 +51,12 @@ func (a *userAdapter) AuthenticateUser(ctx context.Context, token string) (*user
 	return response, nil
 }
 
-func (a *userAdapter) SendBulkPhotoNotification(ctx context.Context, request []*photopb.BulkUserSimilarPhoto) (*userpb.SendBulkPhotoNotificationResponse, error) {
-	sendBulkPhotoNotificationReq := &userpb.SendBulkPhotoNotificationRequest{
-		BulkUserSimilarPhoto: request,
+func (a *userAdapter) GetPublicUserProfile(ctx context.Context, userId string) (*userpb.GetPublicUserProfileResponse, error) {
+	getPublicUserProfileReq := &userpb.GetPublicUserProfileRequest{
+		UserId: userId,
 	}
 
-	response, err := a.client.SendBulkPhotoNotification(ctx, sendBulkPhotoNotificationReq)
+	response, err := a.client.GetPublicUserProfile(ctx, getPublicUserProfileReq)
 	if err != nil {
 		return nil, helper.FromGRPCError(err)
 	}
@@ -67,42 +64,16 @@ func (a *userAdapter) SendBulkPhotoNotification(ctx context.Context, request []*
 	return response, nil
 }
 
-func (a *userAdapter) SendSinglePhotoNotification(ctx context.Context, request []*photopb.UserSimilarPhoto) (*userpb.SendSinglePhotoNotificationResponse, error) {
-	sendSinglePhotoNotificationReq := &userpb.SendSinglePhotoNotificationRequest{
-		UserSimilarPhoto: request,
+func (a *userAdapter) SetUserRole(ctx context.Context, userId, role string, granted bool) error {
+	setUserRoleReq := &userpb.SetUserRoleRequest{
+		UserId:  userId,
+		Role:    role,
+		Granted: granted,
 	}
 
-	response, err := a.client.SendSinglePhotoNotification(ctx, sendSinglePhotoNotificationReq)
-	if err != nil {
-		return nil, helper.FromGRPCError(err)
-	}
-
-	return response, nil
-}
-
-func (a *userAdapter) SendSingleFacecamNotificaton(ctx context.Context, request []*photopb.UserSimilarPhoto) (*userpb.SendSingleFacecamNotificationResponse, error) {
-	sendSingleFacecamNotificationReq := &userpb.SendSingleFacecamNotificationRequest{
-		UserSimilarPhoto: request,
-	}
-
-	response, err := a.client.SendSingleFacecamNotification(ctx, sendSingleFacecamNotificationReq)
-	if err != nil {
-		return nil, helper.FromGRPCError(err)
+	if _, err := a.client.SetUserRole(ctx, setUserRoleReq); err != nil {
+		return helper.FromGRPCError(err)
 	}
 
-	return response, nil
-}
-
-// THIS IS THE NEWEST VERSION USING COUNT IN PHOTO SVC
-func (a *userAdapter) SendBulkNotification(ctx context.Context, countMap map[string]int32) (*userpb.SendBulkNotificationResponse, error) {
-	sendBulkPhotoNotificationReq := &userpb.SendBulkNotificationRequest{
-		CountMap: countMap,
-	}
-
-	response, err := a.client.SendBulkNotification(ctx, sendBulkPhotoNotificationReq)
-	if err != nil {
-		return nil, helper.FromGRPCError(err)
-	}
-
-	return response, nil
+	return nil
 }
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/utils"
@@ -28,10 +31,10 @@ func NewJetStream() nats.JetStreamContext {
 	return js
 }
 
-func InitCreatorReviewStream(js nats.JetStreamContext) {
+func InitCreatorBatchStream(js nats.JetStreamContext) {
 	_, err := js.AddStream(&nats.StreamConfig{
-		Name:     "CREATOR_REVIEW_STREAM",
-		Subjects: []string{"creator.review.updated"},
+		Name:     "CREATOR_BATCH_STREAM",
+		Subjects: []string{"creator.batch.published"},
 		Storage:  nats.FileStorage,
 	})
 	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
@@ -50,6 +53,28 @@ func InitUserStream(js nats.JetStreamContext) {
 	}
 }
 
+func InitUserDeletionStream(js nats.JetStreamContext) {
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "USER_DELETION_STREAM",
+		Subjects: []string{"user.deleted"},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		log.Fatalf("failed to create stream: %v", err)
+	}
+}
+
+func InitUserProfileStream(js nats.JetStreamContext) {
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "USER_PROFILE_STREAM",
+		Subjects: []string{"user.profile.updated"},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		log.Fatalf("failed to create stream: %v", err)
+	}
+}
+
 func DeleteAISimilarStream(js nats.JetStreamContext, log *logger.Log) {
 	err := js.DeleteStream("AI_SIMILAR_STREAM")
 	if err != nil {
@@ -81,13 +106,28 @@ func InitUploadPhotoStream(js nats.JetStreamContext) {
 	}
 }
 
-func InitTransactionStream(js nats.JetStreamContext) {
-	_, err := js.AddStream(&nats.StreamConfig{
-		Name:     "TRANSACTION_STREAM",
-		Subjects: []string{"transaction.settled", "transaction.canceled"},
-		Storage:  nats.FileStorage,
-	})
-	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
-		log.Fatalf("failed to create stream: %v", err)
+// streamWaitInterval is how often WaitForStream looks again for a stream its publisher has not declared yet
+const streamWaitInterval = 5 * time.Second
+
+// WaitForStream blocks until a stream owned by another service exists. Consumers bind to such a stream
+// instead of declaring it, so only the publisher defines its subjects.
+func WaitForStream(ctx context.Context, js nats.JetStreamContext, name string, logs *logger.Log) error {
+	for {
+		_, err := js.StreamInfo(name)
+		if err == nil {
+			return nil
+		}
+
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			logs.Log(fmt.Sprintf("waiting for %s to be declared by its publisher", name))
+		} else {
+			logs.CustomError(fmt.Sprintf("failed to get %s info", name), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(streamWaitInterval):
+		}
 	}
 }
@@ -2,13 +2,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *PhotoGRPCHandler) CalculatePhotoPrice(ctx context.Context, pbReq *photopb.CalculatePhotoPriceRequest) (
@@ -39,6 +42,10 @@ func (h *PhotoGRPCHandler) CalculatePhotoPrice(ctx context.Context, pbReq *photo
 			DiscountMinQuantity: int32(item.DiscountMinQuantity),
 			DiscountId:          item.DiscountId,
 			DiscountType:        string(item.DiscountType),
+			VoucherId:           item.VoucherId,
+			VoucherCode:         item.VoucherCode,
+			VoucherDiscount:     item.VoucherDiscount,
+			VoucherFundedBy:     string(item.VoucherFundedBy),
 			FinalPrice:          item.FinalPrice,
 		}
 
@@ -46,8 +53,12 @@ func (h *PhotoGRPCHandler) CalculatePhotoPrice(ctx context.Context, pbReq *photo
 	}
 
 	totalPbResponse := &photopb.Total{
-		Price:    total.Price,
-		Discount: total.Discount,
+		Price:           total.Price,
+		Discount:        total.Discount,
+		VoucherDiscount: total.VoucherDiscount,
+		VoucherId:       total.VoucherId,
+		VoucherCode:     total.VoucherCode,
+		VoucherFundedBy: string(total.VoucherFundedBy),
 	}
 
 	return &photopb.CalculatePhotoPriceResponse{
@@ -62,8 +73,9 @@ func (h *PhotoGRPCHandler) OwnerOwnPhotos(ctx context.Context, pbReq *photopb.Ow
 	log.Println("----  OwnerOwnPhotos Requets via GRPC in photo-svc ------")
 
 	request := &model.OwnerOwnPhotosRequest{
-		OwnerId:  pbReq.GetOwnerId(),
-		PhotoIds: pbReq.GetPhotoIds(),
+		OwnerId:       pbReq.GetOwnerId(),
+		TransactionId: pbReq.GetTransactionId(),
+		PhotoIds:      pbReq.GetPhotoIds(),
 	}
 
 	if err := h.checkoutUseCase.OwnerOwnPhotos(context.Background(), request); err != nil {
@@ -80,8 +92,9 @@ func (h *PhotoGRPCHandler) CancelPhotos(ctx context.Context, pbReq *photopb.Canc
 	log.Println("----  Cancel Photos Request via GRPC in photo-svc ------")
 
 	request := &model.CancelPhotosRequest{
-		UserId:   pbReq.GetUserId(),
-		PhotoIds: pbReq.GetPhotoIds(),
+		UserId:        pbReq.GetUserId(),
+		TransactionId: pbReq.GetTransactionId(),
+		PhotoIds:      pbReq.GetPhotoIds(),
 	}
 	if err := h.checkoutUseCase.CancelPhotos(context.Background(), request); err != nil {
 		return nil, helper.ErrGRPC(err)
@@ -122,13 +135,20 @@ func (h *PhotoGRPCHandler) CalculatePhotoPriceV2(ctx context.Context, pbReq *pho
 	request := &model.CalculateV2Request{
 		UserId:        pbReq.GetUserId(),
 		CreatorId:     pbReq.GetCreatorId(),
+		TransactionId: pbReq.GetTransactionId(),
+		VoucherCode:   pbReq.GetVoucherCode(),
 		Items:         checkoutItemWeb,
 		TotalPrice:    pbReq.GetTotalPrice(),
 		TotalDiscount: pbReq.GetTotalDiscount(),
+		QuoteToken:    pbReq.GetQuoteToken(),
 	}
 
 	items, total, err := h.checkoutUseCase.LockPhotosAndCalculatePriceV2(context.Background(), request)
 	if err != nil {
+		var appErr *helper.AppError
+		if errors.As(err, &appErr) && appErr.Code == errorcode.ErrPriceChanged {
+			return h.priceChangedResponse(ctx, request, appErr.Message)
+		}
 		return nil, helper.ErrGRPC(err)
 	}
 
@@ -145,6 +165,10 @@ func (h *PhotoGRPCHandler) CalculatePhotoPriceV2(ctx context.Context, pbReq *pho
 			DiscountMinQuantity: int32(item.DiscountMinQuantity),
 			DiscountId:          item.DiscountId,
 			DiscountType:        string(item.DiscountType),
+			VoucherId:           item.VoucherId,
+			VoucherCode:         item.VoucherCode,
+			VoucherDiscount:     item.VoucherDiscount,
+			VoucherFundedBy:     string(item.VoucherFundedBy),
 			FinalPrice:          item.FinalPrice,
 		}
 
@@ -152,13 +176,84 @@ func (h *PhotoGRPCHandler) CalculatePhotoPriceV2(ctx context.Context, pbReq *pho
 	}
 
 	totalPbResponse := &photopb.Total{
-		Price:    total.Price,
-		Discount: total.Discount,
+		Price:           total.Price,
+		Discount:        total.Discount,
+		VoucherDiscount: total.VoucherDiscount,
+		VoucherId:       total.VoucherId,
+		VoucherCode:     total.VoucherCode,
+		VoucherFundedBy: string(total.VoucherFundedBy),
 	}
 
 	return &photopb.CalculatePhotoPriceV2Response{
-		Status: int64(codes.OK),
-		Items:  pbItemReponses,
-		Total:  totalPbResponse,
+		Status:  int64(codes.OK),
+		Items:   pbItemReponses,
+		Total:   totalPbResponse,
+		QuoteId: total.QuoteId,
+	}, nil
+}
+
+// priceChangedResponse rejects the checkout with the current price and a fresh quote so the client can confirm it
+func (h *PhotoGRPCHandler) priceChangedResponse(ctx context.Context, request *model.CalculateV2Request, reason string) (
+	*photopb.CalculatePhotoPriceV2Response, error) {
+	photoIds := make([]string, 0, len(request.Items))
+	for _, item := range request.Items {
+		photoIds = append(photoIds, item.PhotoId)
+	}
+
+	preview, err := h.checkoutUseCase.PreviewCheckout(ctx, &model.PreviewCheckoutRequest{
+		UserId:      request.UserId,
+		PhotoIds:    photoIds,
+		VoucherCode: request.VoucherCode,
+	})
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	pbItemReponses := make([]*photopb.CheckoutItem, 0, len(*preview.Items))
+	for _, item := range *preview.Items {
+		pbResponse := &photopb.CheckoutItem{
+			PhotoId:        item.PhotoId,
+			CreatorId:      item.CreatorId,
+			Title:          item.Title,
+			YourMomentsUrl: item.YourMomentsUrl,
+			Price:          item.Price,
+			FinalPrice:     item.FinalPrice,
+		}
+		if item.Discount != nil {
+			pbResponse.Discount = item.Discount.Amount
+			pbResponse.DiscountValue = item.Discount.Value
+			pbResponse.DiscountMinQuantity = int32(item.Discount.MinQuantity)
+			pbResponse.DiscountId = item.Discount.Id
+			pbResponse.DiscountType = string(item.Discount.Type)
+		}
+		if item.Voucher != nil {
+			pbResponse.VoucherId = item.Voucher.Id
+			pbResponse.VoucherCode = item.Voucher.Code
+			pbResponse.VoucherDiscount = item.Voucher.Amount
+			pbResponse.VoucherFundedBy = string(item.Voucher.FundedBy)
+		}
+
+		pbItemReponses = append(pbItemReponses, pbResponse)
+	}
+
+	totalPbResponse := &photopb.Total{
+		Price:    preview.TotalPrice,
+		Discount: preview.TotalDiscount,
+	}
+	if preview.Voucher != nil {
+		totalPbResponse.VoucherDiscount = preview.Voucher.Amount
+		totalPbResponse.VoucherId = preview.Voucher.Id
+		totalPbResponse.VoucherCode = preview.Voucher.Code
+		totalPbResponse.VoucherFundedBy = string(preview.Voucher.FundedBy)
+	}
+
+	return &photopb.CalculatePhotoPriceV2Response{
+		Status:             int64(codes.FailedPrecondition),
+		Items:              pbItemReponses,
+		Total:              totalPbResponse,
+		PriceChanged:       true,
+		PriceChangedReason: reason,
+		QuoteToken:         preview.QuoteToken,
+		QuoteExpiresAt:     timestamppb.New(*preview.QuoteExpiresAt),
 	}, nil
 }
@@ -74,3 +74,46 @@ func (h *PhotoGRPCHandler) GetCreator(ctx context.Context, pbReq *photopb.GetCre
 		Creator: creatorPb,
 	}, nil
 }
+
+func (h *PhotoGRPCHandler) GetCreatorsByIds(ctx context.Context, pbReq *photopb.GetCreatorsByIdsRequest) (
+	*photopb.GetCreatorsByIdsResponse, error) {
+	log.Println("----  GetCreatorsByIds Requets via GRPC in photo-svc ------")
+
+	response, err := h.creatorUseCase.GetCreatorsByIds(ctx, pbReq.GetCreatorIds())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	creatorsPb := make([]*photopb.Creator, 0, len(response))
+	for _, creator := range response {
+		creatorsPb = append(creatorsPb, &photopb.Creator{
+			Id:          creator.Id,
+			UserId:      creator.UserId,
+			Rating:      creator.Rating,
+			RatingCount: int32(creator.RatingCount),
+		})
+	}
+
+	return &photopb.GetCreatorsByIdsResponse{
+		Status:   int64(codes.OK),
+		Creators: creatorsPb,
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) ListCreatorUserIds(ctx context.Context, pbReq *photopb.ListCreatorUserIdsRequest) (
+	*photopb.ListCreatorUserIdsResponse, error) {
+	request := &model.ListCreatorUserIdsRequest{
+		AfterUserId: pbReq.GetAfterUserId(),
+		Limit:       int(pbReq.GetLimit()),
+	}
+
+	userIds, err := h.creatorUseCase.ListCreatorUserIds(ctx, request)
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &photopb.ListCreatorUserIdsResponse{
+		Status:  int64(codes.OK),
+		UserIds: userIds,
+	}, nil
+}
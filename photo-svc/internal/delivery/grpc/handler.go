@@ -12,19 +12,21 @@ type PhotoGRPCHandler struct {
 	userSimilarPhotoUseCase usecase.UserSimilarUsecase
 	creatorUseCase          usecase.CreatorUseCase
 	checkoutUseCase         usecase.CheckoutUseCase
+	userDataUseCase         usecase.UserDataUseCase
 
 	photopb.UnimplementedPhotoServiceServer
 }
 
 func NewPhotoGRPCHandler(server *grpc.Server, photoUseCase usecase.PhotoUseCase,
 	facecamUseCase usecase.FacecamUseCase, userSimilarPhotoUseCase usecase.UserSimilarUsecase,
-	creatorUseCase usecase.CreatorUseCase, checkoutUseCase usecase.CheckoutUseCase) {
+	creatorUseCase usecase.CreatorUseCase, checkoutUseCase usecase.CheckoutUseCase, userDataUseCase usecase.UserDataUseCase) {
 	handler := &PhotoGRPCHandler{
 		photoUseCase:            photoUseCase,
 		facecamUseCase:          facecamUseCase,
 		userSimilarPhotoUseCase: userSimilarPhotoUseCase,
 		creatorUseCase:          creatorUseCase,
 		checkoutUseCase:         checkoutUseCase,
+		userDataUseCase:         userDataUseCase,
 	}
 
 	photopb.RegisterPhotoServiceServer(server, handler)
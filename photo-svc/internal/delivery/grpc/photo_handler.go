@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 
 	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
 
@@ -57,3 +58,21 @@ func (h *PhotoGRPCHandler) GetPhotoWithDetails(ctx context.Context, pbReq *photo
 
 	return response, nil
 }
+
+func (h *PhotoGRPCHandler) GetChatPhotoPreviews(ctx context.Context, pbReq *photopb.GetChatPhotoPreviewsRequest) (
+	*photopb.GetChatPhotoPreviewsResponse, error) {
+	request := &model.GetChatPhotoPreviewsRequest{
+		UserId:   pbReq.GetUserId(),
+		PhotoIds: pbReq.GetPhotoIds(),
+	}
+
+	previews, err := h.photoUseCase.GetChatPhotoPreviews(ctx, request)
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &photopb.GetChatPhotoPreviewsResponse{
+		Status:   int64(codes.OK),
+		Previews: previews,
+	}, nil
+}
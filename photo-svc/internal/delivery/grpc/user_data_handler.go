@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"context"
+
+	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+)
+
+func (h *PhotoGRPCHandler) GetUserDataExport(ctx context.Context, pbReq *photopb.GetUserDataExportRequest) (
+	*photopb.GetUserDataExportResponse, error) {
+	response, err := h.userDataUseCase.GetUserDataExport(ctx, pbReq.GetUserId())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return response, nil
+}
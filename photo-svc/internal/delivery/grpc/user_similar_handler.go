@@ -6,6 +6,7 @@ import (
 
 	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 	"google.golang.org/grpc/codes"
 )
 
@@ -44,3 +45,22 @@ func (h *PhotoGRPCHandler) CreateUserSimilarFacecam(ctx context.Context, pbReq *
 		Status: int64(codes.OK),
 	}, nil
 }
+
+func (h *PhotoGRPCHandler) ListEventAttendeeUserIds(ctx context.Context, pbReq *photopb.ListEventAttendeeUserIdsRequest) (
+	*photopb.ListEventAttendeeUserIdsResponse, error) {
+	request := &model.ListEventAttendeeUserIdsRequest{
+		BulkPhotoId: pbReq.GetBulkPhotoId(),
+		AfterUserId: pbReq.GetAfterUserId(),
+		Limit:       int(pbReq.GetLimit()),
+	}
+
+	userIds, err := h.userSimilarPhotoUseCase.ListEventAttendeeUserIds(ctx, request)
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &photopb.ListEventAttendeeUserIdsResponse{
+		Status:  int64(codes.OK),
+		UserIds: userIds,
+	}, nil
+}
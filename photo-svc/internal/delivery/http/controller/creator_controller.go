@@ -0,0 +1,86 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreatorController interface {
+	GetCreatorProfile(ctx *fiber.Ctx) error
+	VerifyCreator(ctx *fiber.Ctx) error
+	UnverifyCreator(ctx *fiber.Ctx) error
+}
+
+type creatorController struct {
+	creatorUseCase        usecase.CreatorUseCase
+	creatorProfileUseCase usecase.CreatorProfileUseCase
+	customValidator       helper.CustomValidator
+	logs                  *logger.Log
+}
+
+func NewCreatorController(creatorUseCase usecase.CreatorUseCase, creatorProfileUseCase usecase.CreatorProfileUseCase,
+	customValidator helper.CustomValidator, logs *logger.Log) CreatorController {
+	return &creatorController{
+		creatorUseCase:        creatorUseCase,
+		creatorProfileUseCase: creatorProfileUseCase,
+		customValidator:       customValidator,
+		logs:                  logs,
+	}
+}
+
+func (c *creatorController) GetCreatorProfile(ctx *fiber.Ctx) error {
+	request := &model.GetCreatorProfileRequest{
+		CreatorId: ctx.Params("creatorId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.creatorProfileUseCase.GetCreatorProfile(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get creator profile : ", err, c.logs)
+	}
+
+	ctx.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(usecase.CreatorProfileCacheTTL.Seconds())))
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CreatorProfileResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *creatorController) VerifyCreator(ctx *fiber.Ctx) error {
+	return c.setCreatorVerification(ctx, true)
+}
+
+func (c *creatorController) UnverifyCreator(ctx *fiber.Ctx) error {
+	return c.setCreatorVerification(ctx, false)
+}
+
+func (c *creatorController) setCreatorVerification(ctx *fiber.Ctx, verified bool) error {
+	request := &model.VerifyCreatorRequest{
+		CreatorId: ctx.Params("creatorId"),
+		Verified:  verified,
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.creatorUseCase.VerifyCreator(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Verify creator : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CreatorResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
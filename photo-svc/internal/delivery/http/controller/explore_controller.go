@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/delivery/http/middleware"
@@ -50,13 +51,32 @@ func (c *exploreController) GetAllExploreSimilar(ctx *fiber.Ctx) error {
 		Similarity: auth.Similarity,
 		CreatorId:  auth.CreatorId,
 		Page:       ctx.QueryInt("page", 1),
+		Cursor:     ctx.Query("cursor"),
 		Size:       ctx.QueryInt("size", 10),
+		Debug:      ctx.QueryBool("debug", false),
 	}
 
 	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
 		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
 	}
 
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		response, cursorMetadata, err := c.exploreUseCase.GetUserExploreSimilarByCursor(context, request)
+		if err != nil {
+			return helper.ErrUseCaseResponseJSON(ctx, "Get all explore similar error : ", err, c.logs)
+		}
+
+		baseURL := ctx.BaseURL() + ctx.Path()
+		helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+		return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.ExploreUserSimilarResponse]{
+			Success:        true,
+			Data:           response,
+			CursorMetadata: cursorMetadata,
+		})
+	}
+
 	response, pageMetadata, err := c.exploreUseCase.GetUserExploreSimilar(context, request)
 	if err != nil {
 		return helper.ErrUseCaseResponseJSON(ctx, "Get all explore similar error : ", err, c.logs)
@@ -77,6 +97,11 @@ func (c *exploreController) GetAllUserWishlist(ctx *fiber.Ctx) error {
 	context, span := c.tracer.Start(ctx.Context(), "getUserWishlist", oteltrace.WithAttributes(attribute.String("id", auth.UserId)))
 	defer span.End()
 
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.getAllStagePhotoByCursor(ctx, context, auth, c.exploreUseCase.GetUserWishlistByCursor, "Get all user error : ")
+	}
+
 	request := &model.GetAllWishlistRequest{
 		UserId:     auth.UserId,
 		Similarity: auth.Similarity,
@@ -168,6 +193,11 @@ func (c *exploreController) GetAllUserFavorite(ctx *fiber.Ctx) error {
 	auth := middleware.GetUser(ctx)
 	context, span := c.tracer.Start(ctx.Context(), "getUserFavorite", oteltrace.WithAttributes(attribute.String("id", auth.UserId)))
 	defer span.End()
+
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.getAllStagePhotoByCursor(ctx, context, auth, c.exploreUseCase.GetUserFavoriteByCursor, "User get all user favorite error : ")
+	}
 	request := &model.GetAllFavoriteRequest{
 		UserId:     auth.UserId,
 		Similarity: auth.Similarity,
@@ -259,6 +289,11 @@ func (c *exploreController) GetAllUserCart(ctx *fiber.Ctx) error {
 	auth := middleware.GetUser(ctx)
 	context, span := c.tracer.Start(ctx.Context(), "getUserCart", oteltrace.WithAttributes(attribute.String("id", auth.UserId)))
 	defer span.End()
+
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.getAllStagePhotoByCursor(ctx, context, auth, c.exploreUseCase.GetUserCartByCursor, "Get all user cart error : ")
+	}
 	request := &model.GetAllCartRequest{
 		UserId:     auth.UserId,
 		Similarity: auth.Similarity,
@@ -345,3 +380,33 @@ func (c *exploreController) UserDeleteCart(ctx *fiber.Ctx) error {
 		Success: true,
 	})
 }
+
+func (c *exploreController) getAllStagePhotoByCursor(ctx *fiber.Ctx, context context.Context, auth *model.AuthResponse,
+	getByCursor func(context.Context, *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error),
+	errMsg string) error {
+	request := &model.GetAllStagePhotoByCursorRequest{
+		UserId:     auth.UserId,
+		Similarity: auth.Similarity,
+		CreatorId:  auth.CreatorId,
+		Cursor:     ctx.Query("cursor"),
+		Size:       ctx.QueryInt("size", 10),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := getByCursor(context, request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, errMsg, err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.ExploreUserSimilarResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
@@ -0,0 +1,182 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FollowController interface {
+	FollowCreator(ctx *fiber.Ctx) error
+	UnfollowCreator(ctx *fiber.Ctx) error
+	GetCreatorFollow(ctx *fiber.Ctx) error
+	GetFollowers(ctx *fiber.Ctx) error
+	GetFollowing(ctx *fiber.Ctx) error
+	GetFollowingFeed(ctx *fiber.Ctx) error
+}
+
+type followController struct {
+	followUseCase   usecase.FollowUseCase
+	customValidator helper.CustomValidator
+	logs            *logger.Log
+}
+
+func NewFollowController(followUseCase usecase.FollowUseCase, customValidator helper.CustomValidator, logs *logger.Log) FollowController {
+	return &followController{
+		followUseCase:   followUseCase,
+		customValidator: customValidator,
+		logs:            logs,
+	}
+}
+
+func (c *followController) FollowCreator(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.FollowCreatorRequest{
+		UserId:    auth.UserId,
+		CreatorId: ctx.Params("creatorId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.followUseCase.FollowCreator(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Follow creator : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CreatorFollowResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *followController) UnfollowCreator(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.UnfollowCreatorRequest{
+		UserId:    auth.UserId,
+		CreatorId: ctx.Params("creatorId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.followUseCase.UnfollowCreator(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Unfollow creator : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CreatorFollowResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *followController) GetCreatorFollow(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.GetCreatorFollowRequest{
+		UserId:    auth.UserId,
+		CreatorId: ctx.Params("creatorId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.followUseCase.GetCreatorFollow(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get creator follow : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CreatorFollowResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *followController) GetFollowers(ctx *fiber.Ctx) error {
+	request := &model.GetCreatorFollowersRequest{
+		CreatorId: ctx.Params("creatorId"),
+		Cursor:    ctx.Query("cursor"),
+		Size:      ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.followUseCase.GetFollowers(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get creator followers : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.CreatorFollowerResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *followController) GetFollowing(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.GetFollowingRequest{
+		UserId: auth.UserId,
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.followUseCase.GetFollowing(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get following creators : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.FollowedCreatorResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *followController) GetFollowingFeed(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.GetFollowingFeedRequest{
+		UserId: auth.UserId,
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 10),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.followUseCase.GetFollowingFeed(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get following feed : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.FollowingFeedPhotoResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
@@ -0,0 +1,161 @@
+package http
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/oklog/ulid/v2"
+)
+
+type VoucherController interface {
+	ActivateVoucher(ctx *fiber.Ctx) error
+	CreateVoucher(ctx *fiber.Ctx) error
+	DeactivateVoucher(ctx *fiber.Ctx) error
+	GetVoucher(ctx *fiber.Ctx) error
+	GetAllVoucher(ctx *fiber.Ctx) error
+}
+
+type voucherController struct {
+	voucherUseCase  usecase.VoucherUseCase
+	customValidator helper.CustomValidator
+	logs            *logger.Log
+}
+
+func NewVoucherController(voucherUseCase usecase.VoucherUseCase, customValidator helper.CustomValidator, logs *logger.Log) VoucherController {
+	return &voucherController{
+		voucherUseCase:  voucherUseCase,
+		customValidator: customValidator,
+		logs:            logs,
+	}
+}
+
+func (c *voucherController) CreateVoucher(ctx *fiber.Ctx) error {
+	request := new(model.CreateVoucherRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	user := middleware.GetUser(ctx)
+	request.CreatorId = voucherCreatorId(ctx)
+	request.CanFundPlatform = slices.Contains(user.Permissions, string(enum.PermissionVoucherPlatform))
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.voucherUseCase.CreateVoucher(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Create voucher : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.VoucherResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *voucherController) ActivateVoucher(ctx *fiber.Ctx) error {
+	voucherId := ctx.Params("voucherId")
+	request := &model.ActivateVoucherRequest{
+		Id: voucherId,
+	}
+
+	request.CreatorId = voucherCreatorId(ctx)
+
+	if _, err := ulid.Parse(request.Id); err != nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "The provided voucher ID is not valid")
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.voucherUseCase.ActivateVoucher(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Activate voucher : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *voucherController) DeactivateVoucher(ctx *fiber.Ctx) error {
+	voucherId := ctx.Params("voucherId")
+	request := &model.DeactivateVoucherRequest{
+		Id: voucherId,
+	}
+
+	request.CreatorId = voucherCreatorId(ctx)
+
+	if _, err := ulid.Parse(request.Id); err != nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "The provided voucher ID is not valid")
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.voucherUseCase.DeactivateVoucher(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Deactivate voucher : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *voucherController) GetVoucher(ctx *fiber.Ctx) error {
+	voucherId := ctx.Params("voucherId")
+
+	request := &model.GetVoucherRequest{
+		Id: voucherId,
+	}
+
+	request.CreatorId = voucherCreatorId(ctx)
+
+	if _, err := ulid.Parse(request.Id); err != nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "The provided voucher ID is not valid")
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.voucherUseCase.GetVoucher(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get voucher : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.VoucherResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *voucherController) GetAllVoucher(ctx *fiber.Ctx) error {
+	response, err := c.voucherUseCase.GetAllVoucher(ctx.Context(), voucherCreatorId(ctx))
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get voucher : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.VoucherResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// Platform vouchers have no creator, the admin routes manage them with an empty creator id
+func voucherCreatorId(ctx *fiber.Ctx) string {
+	if middleware.IsPlatformScope(ctx) {
+		return ""
+	}
+	return middleware.GetUser(ctx).CreatorId
+}
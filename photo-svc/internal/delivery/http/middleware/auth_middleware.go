@@ -36,6 +36,8 @@ func NewUserAuth(userAdapter adapter.UserAdapter, tracer trace.Tracer, logs *log
 			Similarity:  authResponse.GetUser().GetSimilarity(),
 			CreatorId:   authResponse.GetUser().GetCreatorId(),
 			WalletId:    authResponse.GetUser().GetWalletId(),
+			Roles:       authResponse.GetUser().GetRoles(),
+			Permissions: authResponse.GetUser().GetPermissions(),
 		}
 
 		ctx.Locals("auth", auth)
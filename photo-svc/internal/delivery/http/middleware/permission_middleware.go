@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRequirePermission must be mounted after NewUserAuth, it only inspects the
+// permissions user-svc resolved for the authenticated user.
+func NewRequirePermission(permission enum.PermissionEnum) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		auth := GetUser(ctx)
+		if !slices.Contains(auth.Permissions, string(permission)) {
+			return fiber.NewError(fiber.StatusForbidden, "Forbidden access")
+		}
+
+		return ctx.Next()
+	}
+}
+
+// NewPlatformScope marks the request as acting on behalf of the platform, so
+// handlers shared with the creator routes ignore the caller creator id.
+func NewPlatformScope() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		ctx.Locals("platform_scope", true)
+		return ctx.Next()
+	}
+}
+
+func IsPlatformScope(ctx *fiber.Ctx) bool {
+	scope, _ := ctx.Locals("platform_scope").(bool)
+	return scope
+}
@@ -0,0 +1,23 @@
+package route
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+)
+
+func (r *RouteConfig) SetupCreatorRoute() {
+	creatorRoutes := r.App.Group("/api/creator")
+	creatorRoutes.Get("/:creatorId/profile", r.CreatorController.GetCreatorProfile)
+	creatorRoutes.Get("/:creatorId/followers", r.FollowController.GetFollowers)
+	creatorRoutes.Get("/:creatorId/follow", r.AuthMiddleware, r.FollowController.GetCreatorFollow)
+	creatorRoutes.Post("/:creatorId/follow", r.AuthMiddleware, r.FollowController.FollowCreator)
+	creatorRoutes.Delete("/:creatorId/follow", r.AuthMiddleware, r.FollowController.UnfollowCreator)
+
+	followingRoutes := r.App.Group("/api/following", r.AuthMiddleware)
+	followingRoutes.Get("/", r.FollowController.GetFollowing)
+	followingRoutes.Get("/feed", r.FollowController.GetFollowingFeed)
+
+	adminRoutes := r.App.Group("/api/admin/creator", r.AuthMiddleware, middleware.NewRequirePermission(enum.PermissionCreatorVerify))
+	adminRoutes.Put("/:creatorId/verify", r.CreatorController.VerifyCreator)
+	adminRoutes.Delete("/:creatorId/verify", r.CreatorController.UnverifyCreator)
+}
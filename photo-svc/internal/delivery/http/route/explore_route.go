@@ -1,5 +1,10 @@
 package route
 
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+)
+
 func (r *RouteConfig) SetupExploreRoute() {
 	exploreRoutes := r.App.Group("/api/explore", r.AuthMiddleware, r.CreatorMiddleware)
 	// exploreRoutes.Get("/", r.ExploreController.GetUserExploreSimilar)
@@ -26,6 +31,22 @@ func (r *RouteConfig) SetupDiscountRoute() {
 	exploreRoutes.Put("/deactivate/:discountId", r.CreatorDiscountControler.DeactivateDiscount)
 }
 
+func (r *RouteConfig) SetupVoucherRoute() {
+	voucherRoutes := r.App.Group("/api/voucher", r.AuthMiddleware, r.CreatorMiddleware)
+	voucherRoutes.Get("/:voucherId", r.VoucherController.GetVoucher)
+	voucherRoutes.Get("/", r.VoucherController.GetAllVoucher)
+	voucherRoutes.Post("/create", r.VoucherController.CreateVoucher)
+	voucherRoutes.Put("/activate/:voucherId", r.VoucherController.ActivateVoucher)
+	voucherRoutes.Put("/deactivate/:voucherId", r.VoucherController.DeactivateVoucher)
+
+	adminRoutes := r.App.Group("/api/admin/voucher", r.AuthMiddleware, middleware.NewRequirePermission(enum.PermissionVoucherPlatform), middleware.NewPlatformScope())
+	adminRoutes.Get("/:voucherId", r.VoucherController.GetVoucher)
+	adminRoutes.Get("/", r.VoucherController.GetAllVoucher)
+	adminRoutes.Post("/create", r.VoucherController.CreateVoucher)
+	adminRoutes.Put("/activate/:voucherId", r.VoucherController.ActivateVoucher)
+	adminRoutes.Put("/deactivate/:voucherId", r.VoucherController.DeactivateVoucher)
+}
+
 func (r *RouteConfig) SetupCheckoutRoute() {
 	exploreRoutes := r.App.Group("/api/checkout", r.AuthMiddleware)
 	exploreRoutes.Post("/preview", r.CheckoutController.PreviewCheckout)
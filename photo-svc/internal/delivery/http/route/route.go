@@ -12,7 +12,10 @@ type RouteConfig struct {
 	HealthCheckController    http.HealthCheckController
 	CheckoutController       http.CheckoutController
 	CreatorDiscountControler http.CreatorDiscountController
+	VoucherController        http.VoucherController
 	PhotoController          http.PhotoController
+	CreatorController        http.CreatorController
+	FollowController         http.FollowController
 	AuthMiddleware           fiber.Handler
 	CreatorMiddleware        fiber.Handler
 }
@@ -21,6 +24,8 @@ func (r *RouteConfig) Setup() {
 	r.SetupExploreRoute()
 	r.SetupHealtCheckRoute()
 	r.SetupDiscountRoute()
+	r.SetupVoucherRoute()
 	r.SetupCheckoutRoute()
 	r.SetupPhotoRoute()
+	r.SetupCreatorRoute()
 }
@@ -43,8 +43,9 @@ func (s *TransactionConsumer) handleMessage(ctx context.Context, msg *nats.Msg)
 
 		s.logs.Log(fmt.Sprintf("unmarshalled event: %+v", event))
 		request := &model.OwnerOwnPhotosRequest{
-			OwnerId:  event.UserId,
-			PhotoIds: event.PhotoIds,
+			OwnerId:       event.UserId,
+			TransactionId: event.TransactionId,
+			PhotoIds:      event.PhotoIds,
 		}
 		err = s.checkoutUC.OwnerOwnPhotos(ctx, request)
 		if err != nil {
@@ -60,8 +61,9 @@ func (s *TransactionConsumer) handleMessage(ctx context.Context, msg *nats.Msg)
 			return
 		}
 		request := &model.CancelPhotosRequest{
-			UserId:   event.UserId,
-			PhotoIds: event.PhotoIds,
+			UserId:        event.UserId,
+			TransactionId: event.TransactionId,
+			PhotoIds:      event.PhotoIds,
 		}
 
 		err = s.checkoutUC.CancelPhotos(ctx, request)
@@ -0,0 +1,86 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+type UserProfileSubscriber struct {
+	js           nats.JetStreamContext
+	useCase      usecase.CreatorProfileUseCase
+	subject      string
+	consumerName string
+	durableName  string
+	logs         *logger.Log
+}
+
+func NewUserProfileSubscriber(js nats.JetStreamContext, useCase usecase.CreatorProfileUseCase, logs *logger.Log) *UserProfileSubscriber {
+	return &UserProfileSubscriber{
+		js:           js,
+		useCase:      useCase,
+		subject:      "user.profile.updated",
+		consumerName: "photo_svc_user_profile_consumer",
+		durableName:  "photo_svc_user_profile_durable",
+		logs:         logs,
+	}
+}
+
+func (s *UserProfileSubscriber) Start(ctx context.Context) error {
+	sub, err := s.js.PullSubscribe(
+		s.subject,
+		s.durableName,
+		nats.BindStream("USER_PROFILE_STREAM"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pull subscription: %w", err)
+	}
+
+	s.logs.CustomLog("Started synchronous subscriber for", s.subject)
+
+	func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Stopping subscriber...")
+				return
+			default:
+				msgs, err := sub.Fetch(10, nats.MaxWait(2*time.Second))
+				if err != nil && err != nats.ErrTimeout {
+					s.logs.CustomLog("Fetch error: %v", err)
+					continue
+				}
+
+				for _, msg := range msgs {
+					event := new(event.UserProfileUpdatedEvent)
+					if err := sonic.ConfigFastest.Unmarshal(msg.Data, event); err != nil {
+						s.logs.CustomError("failed to unmarshal event: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					s.logs.CustomLog("Processing event: %+v", event)
+
+					if err := s.useCase.InvalidateUserCreatorProfile(ctx, event.Id); err != nil {
+						s.logs.CustomError("failed to invalidate creator profile: %v", err)
+						_ = msg.Nak()
+						continue
+					}
+
+					if err := msg.Ack(); err != nil {
+						s.logs.CustomError("failed to ack message: %v", err)
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -39,3 +39,11 @@ type BulkPhotoDetail struct {
 	PhotoCreatedAt      time.Time       `db:"photo_created_at"`
 	PhotoUpdatedAt      time.Time       `db:"photo_updated_at"`
 }
+
+type CreatorEvent struct {
+	BulkPhotoId  string         `db:"bulk_photo_id"`
+	TotalPhoto   int            `db:"total_photo"`
+	CoverFileKey sql.NullString `db:"cover_file_key"`
+	StartedAt    time.Time      `db:"started_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
@@ -14,6 +15,8 @@ type CreatorDiscount struct {
 	DiscountType enum.DiscountType `db:"discount_type"`
 	Value        int32             `db:"value"`
 	IsActive     bool              `db:"is_active"`
+	StartsAt     sql.NullTime      `db:"starts_at"`
+	EndsAt       sql.NullTime      `db:"ends_at"`
 	CreatedAt    *time.Time        `db:"created_at"`
 	UpdatedAt    *time.Time        `db:"updated_at"`
 }
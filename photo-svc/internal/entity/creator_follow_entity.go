@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type CreatorFollow struct {
+	FollowerUserId string    `db:"follower_user_id"`
+	CreatorId      string    `db:"creator_id"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// FollowedCreator is a creator followed by a user together with the time it was followed
+type FollowedCreator struct {
+	CreatorId     string     `db:"creator_id"`
+	UserId        string     `db:"user_id"`
+	FollowerCount int        `db:"follower_count"`
+	VerifiedAt    *time.Time `db:"verified_at"`
+	FollowedAt    time.Time  `db:"followed_at"`
+}
+
+// FollowingFeedPhoto is a public preview of an available photo from a followed creator
+type FollowingFeedPhoto struct {
+	PhotoId     string         `db:"photo_id"`
+	CreatorId   string         `db:"creator_id"`
+	BulkPhotoId sql.NullString `db:"bulk_photo_id"`
+	Title       string         `db:"title"`
+	Price       int32          `db:"price"`
+	PriceStr    string         `db:"price_str"`
+	FileKey     string         `db:"file_key"`
+	OriginalAt  time.Time      `db:"original_at"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
@@ -57,3 +57,23 @@ type PhotoWithDetail struct {
 
 	Status enum.PhotoStatusEnum `db:"status"`
 }
+
+type CreatorSamplePhoto struct {
+	PhotoId    string    `db:"photo_id"`
+	Title      string    `db:"title"`
+	Price      int32     `db:"price"`
+	PriceStr   string    `db:"price_str"`
+	FileKey    string    `db:"file_key"`
+	OriginalAt time.Time `db:"original_at"`
+}
+
+// ChatPhotoPreview file key is the watermarked photo detail
+type ChatPhotoPreview struct {
+	PhotoId   string `db:"photo_id"`
+	CreatorId string `db:"creator_id"`
+	Title     string `db:"title"`
+	Price     int32  `db:"price"`
+	PriceStr  string `db:"price_str"`
+	FileKey   string `db:"file_key"`
+	IsBuyable bool   `db:"is_buyable"`
+}
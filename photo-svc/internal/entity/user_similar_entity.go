@@ -48,3 +48,12 @@ type Explore struct {
 	FileKey         string `db:"file_key"`
 	PhotoDetailType string `db:"photo_detail_type"`
 }
+
+// ExploreCandidate is an explore photo together with the signals used to rank it
+type ExploreCandidate struct {
+	Explore
+	CreatorRating   float32 `db:"creator_rating"`
+	CreatorAffinity int     `db:"creator_affinity"`
+	EventOwnedCount int     `db:"event_owned_count"`
+	EventMatchCount int     `db:"event_match_count"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+)
+
+type Voucher struct {
+	Id                string             `db:"id"`
+	Code              string             `db:"code"`
+	CreatorId         sql.NullString     `db:"creator_id"`
+	FundedBy          enum.VoucherFunder `db:"funded_by"`
+	Name              string             `db:"name"`
+	DiscountType      enum.DiscountType  `db:"discount_type"`
+	Value             int32              `db:"value"`
+	MaxDiscount       sql.NullInt32      `db:"max_discount"`
+	MinQuantity       int                `db:"min_quantity"`
+	UsageLimit        sql.NullInt32      `db:"usage_limit"`
+	UsageLimitPerUser sql.NullInt32      `db:"usage_limit_per_user"`
+	IsStackable       bool               `db:"is_stackable"`
+	IsActive          bool               `db:"is_active"`
+	StartsAt          sql.NullTime       `db:"starts_at"`
+	EndsAt            sql.NullTime       `db:"ends_at"`
+	CreatedAt         *time.Time         `db:"created_at"`
+	UpdatedAt         *time.Time         `db:"updated_at"`
+}
+
+type VoucherRedemption struct {
+	Id            string                       `db:"id"`
+	VoucherId     string                       `db:"voucher_id"`
+	UserId        string                       `db:"user_id"`
+	TransactionId string                       `db:"transaction_id"`
+	Amount        int32                        `db:"amount"`
+	Status        enum.VoucherRedemptionStatus `db:"status"`
+	CreatedAt     *time.Time                   `db:"created_at"`
+	UpdatedAt     *time.Time                   `db:"updated_at"`
+}
+
+type VoucherUsage struct {
+	Total   int `db:"total"`
+	ForUser int `db:"for_user"`
+}
@@ -5,4 +5,6 @@ type DiscountType string
 var (
 	DiscountTypeFlat    DiscountType = "FLAT"
 	DiscountTypePercent DiscountType = "PERCENT"
+	// DiscountTypeBundle prices every MinQuantity photos at Value (e.g. buy 5 for 50000)
+	DiscountTypeBundle DiscountType = "BUNDLE"
 )
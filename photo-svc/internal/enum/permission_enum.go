@@ -0,0 +1,10 @@
+package enum
+
+// PermissionEnum mirrors the permission names resolved by user-svc and
+// returned through the Authenticate gRPC response.
+type PermissionEnum string
+
+const (
+	PermissionCreatorVerify   PermissionEnum = "creator:verify"
+	PermissionVoucherPlatform PermissionEnum = "voucher:platform:manage"
+)
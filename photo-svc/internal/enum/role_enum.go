@@ -0,0 +1,7 @@
+package enum
+
+type RoleEnum string
+
+const (
+	RoleCreator RoleEnum = "CREATOR"
+)
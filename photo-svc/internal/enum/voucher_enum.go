@@ -0,0 +1,16 @@
+package enum
+
+type VoucherFunder string
+
+const (
+	VoucherFunderCreator  VoucherFunder = "CREATOR"
+	VoucherFunderPlatform VoucherFunder = "PLATFORM"
+)
+
+type VoucherRedemptionStatus string
+
+const (
+	VoucherRedemptionStatusReserved VoucherRedemptionStatus = "RESERVED"
+	VoucherRedemptionStatusRedeemed VoucherRedemptionStatus = "REDEEMED"
+	VoucherRedemptionStatusReleased VoucherRedemptionStatus = "RELEASED"
+)
@@ -12,6 +12,7 @@ import (
 
 type CreatorProducer interface {
 	ProduceCreatorCreated(ctx context.Context, creatorEvent *event.CreatorEvent) error
+	ProduceCreatorBatchPublished(ctx context.Context, batchEvent *event.CreatorBatchPublishedEvent) error
 }
 
 type creatorProducer struct {
@@ -37,3 +38,16 @@ func (s *creatorProducer) ProduceCreatorCreated(ctx context.Context, creatorEven
 	log.Printf("Published create creator event for creator id %s", creatorEvent.Id)
 	return nil
 }
+
+func (s *creatorProducer) ProduceCreatorBatchPublished(ctx context.Context, batchEvent *event.CreatorBatchPublishedEvent) error {
+	subject := "creator.batch.published"
+
+	err := s.messagingAdapter.Publish(ctx, subject, batchEvent)
+	if err != nil {
+		return fmt.Errorf("failed to publish creator batch published event: %w", err)
+	}
+
+	s.logs.Log(fmt.Sprintf("Published creator batch published event for bulk photo id %s to %d followers", batchEvent.BulkPhotoId,
+		len(batchEvent.FollowerUserIds)))
+	return nil
+}
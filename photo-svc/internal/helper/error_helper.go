@@ -67,7 +67,7 @@ func (e *AppError) HTTPStatus() int {
 		return 403
 	case errorcode.ErrValidationFailed, errorcode.ErrInvalidArgument:
 		return 422
-	case errorcode.ErrAlreadyExists:
+	case errorcode.ErrAlreadyExists, errorcode.ErrPriceChanged:
 		return 409
 	case errorcode.ErrUserNotFound, errorcode.ErrResourceNotFound:
 		return 404
@@ -88,6 +88,8 @@ func (e *AppError) GRPCErrorCode() error {
 		return status.Error(codes.InvalidArgument, e.Message)
 	case errorcode.ErrAlreadyExists:
 		return status.Error(codes.AlreadyExists, e.Message)
+	case errorcode.ErrPriceChanged:
+		return status.Error(codes.FailedPrecondition, e.Message)
 	case errorcode.ErrUserNotFound, errorcode.ErrResourceNotFound:
 		return status.Error(codes.NotFound, e.Message)
 	case errorcode.ErrTooManyRequests:
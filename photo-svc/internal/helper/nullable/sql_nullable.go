@@ -2,6 +2,7 @@ package nullable
 
 import (
 	"database/sql"
+	"time"
 
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -52,6 +53,20 @@ func SQLStringToPtr(ns sql.NullString) *string {
 	return nil
 }
 
+func SQLInt32ToPtr(ns sql.NullInt32) *int32 {
+	if ns.Valid {
+		return &ns.Int32
+	}
+	return nil
+}
+
+func SQLTimeToPtr(nt sql.NullTime) *time.Time {
+	if nt.Valid {
+		return &nt.Time
+	}
+	return nil
+}
+
 func SQLFloat64ToPtr(ns sql.NullFloat64) *float64 {
 	if ns.Valid {
 		return &ns.Float64
@@ -102,3 +117,14 @@ func ToSQLString(input *string) sql.NullString {
 		Valid:  true,
 	}
 }
+
+// ToSQLTime mengonversi pointer time.Time ke sql.NullTime
+func ToSQLTime(input *time.Time) sql.NullTime {
+	if input == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{
+		Time:  *input,
+		Valid: true,
+	}
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/cdn_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/cdn_adapter.go -destination=./mocks/adapter/mock_cdn_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCDNAdapter is a mock of CDNAdapter interface.
+type MockCDNAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCDNAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockCDNAdapterMockRecorder is the mock recorder for MockCDNAdapter.
+type MockCDNAdapterMockRecorder struct {
+	mock *MockCDNAdapter
+}
+
+// NewMockCDNAdapter creates a new mock instance.
+func NewMockCDNAdapter(ctrl *gomock.Controller) *MockCDNAdapter {
+	mock := &MockCDNAdapter{ctrl: ctrl}
+	mock.recorder = &MockCDNAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCDNAdapter) EXPECT() *MockCDNAdapterMockRecorder {
+	return m.recorder
+}
+
+// GenerateCDN mocks base method.
+func (m *MockCDNAdapter) GenerateCDN(fileKey string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCDN", fileKey)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GenerateCDN indicates an expected call of GenerateCDN.
+func (mr *MockCDNAdapterMockRecorder) GenerateCDN(fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCDN", reflect.TypeOf((*MockCDNAdapter)(nil).GenerateCDN), fileKey)
+}
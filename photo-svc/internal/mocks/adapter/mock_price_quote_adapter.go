@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/price_quote_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/price_quote_adapter.go -destination=./mocks/adapter/mock_price_quote_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceQuoteAdapter is a mock of PriceQuoteAdapter interface.
+type MockPriceQuoteAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceQuoteAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceQuoteAdapterMockRecorder is the mock recorder for MockPriceQuoteAdapter.
+type MockPriceQuoteAdapterMockRecorder struct {
+	mock *MockPriceQuoteAdapter
+}
+
+// NewMockPriceQuoteAdapter creates a new mock instance.
+func NewMockPriceQuoteAdapter(ctrl *gomock.Controller) *MockPriceQuoteAdapter {
+	mock := &MockPriceQuoteAdapter{ctrl: ctrl}
+	mock.recorder = &MockPriceQuoteAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceQuoteAdapter) EXPECT() *MockPriceQuoteAdapterMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockPriceQuoteAdapter) Consume(ctx context.Context, quote *model.PriceQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockPriceQuoteAdapterMockRecorder) Consume(ctx, quote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockPriceQuoteAdapter)(nil).Consume), ctx, quote)
+}
+
+// Restore mocks base method.
+func (m *MockPriceQuoteAdapter) Restore(ctx context.Context, quote *model.PriceQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockPriceQuoteAdapterMockRecorder) Restore(ctx, quote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockPriceQuoteAdapter)(nil).Restore), ctx, quote)
+}
+
+// Sign mocks base method.
+func (m *MockPriceQuoteAdapter) Sign(ctx context.Context, quote *model.PriceQuote) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, quote)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockPriceQuoteAdapterMockRecorder) Sign(ctx, quote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPriceQuoteAdapter)(nil).Sign), ctx, quote)
+}
+
+// TTL mocks base method.
+func (m *MockPriceQuoteAdapter) TTL() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// TTL indicates an expected call of TTL.
+func (mr *MockPriceQuoteAdapterMockRecorder) TTL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockPriceQuoteAdapter)(nil).TTL))
+}
+
+// Verify mocks base method.
+func (m *MockPriceQuoteAdapter) Verify(token string) (*model.PriceQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", token)
+	ret0, _ := ret[0].(*model.PriceQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockPriceQuoteAdapterMockRecorder) Verify(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockPriceQuoteAdapter)(nil).Verify), token)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/transaction_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/transaction_adapter.go -destination=./mocks/adapter/mock_transaction_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	transactionpb "github.com/hervibest/be-yourmoments-backup/pb/transaction"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionAdapter is a mock of TransactionAdapter interface.
+type MockTransactionAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionAdapterMockRecorder is the mock recorder for MockTransactionAdapter.
+type MockTransactionAdapterMockRecorder struct {
+	mock *MockTransactionAdapter
+}
+
+// NewMockTransactionAdapter creates a new mock instance.
+func NewMockTransactionAdapter(ctrl *gomock.Controller) *MockTransactionAdapter {
+	mock := &MockTransactionAdapter{ctrl: ctrl}
+	mock.recorder = &MockTransactionAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionAdapter) EXPECT() *MockTransactionAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockTransactionAdapter) CreateWallet(ctx context.Context, creatorId string) (*transactionpb.CreateWalletResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, creatorId)
+	ret0, _ := ret[0].(*transactionpb.CreateWalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockTransactionAdapterMockRecorder) CreateWallet(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockTransactionAdapter)(nil).CreateWallet), ctx, creatorId)
+}
+
+// GetCreatorReviewSummary mocks base method.
+func (m *MockTransactionAdapter) GetCreatorReviewSummary(ctx context.Context, creatorId string) (*transactionpb.GetCreatorReviewSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorReviewSummary", ctx, creatorId)
+	ret0, _ := ret[0].(*transactionpb.GetCreatorReviewSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorReviewSummary indicates an expected call of GetCreatorReviewSummary.
+func (mr *MockTransactionAdapterMockRecorder) GetCreatorReviewSummary(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorReviewSummary", reflect.TypeOf((*MockTransactionAdapter)(nil).GetCreatorReviewSummary), ctx, creatorId)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/bulk_photo_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/bulk_photo_repository.go -destination=./mocks/repository/mock_bulk_photo_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBulkPhotoRepository is a mock of BulkPhotoRepository interface.
+type MockBulkPhotoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkPhotoRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBulkPhotoRepositoryMockRecorder is the mock recorder for MockBulkPhotoRepository.
+type MockBulkPhotoRepositoryMockRecorder struct {
+	mock *MockBulkPhotoRepository
+}
+
+// NewMockBulkPhotoRepository creates a new mock instance.
+func NewMockBulkPhotoRepository(ctrl *gomock.Controller) *MockBulkPhotoRepository {
+	mock := &MockBulkPhotoRepository{ctrl: ctrl}
+	mock.recorder = &MockBulkPhotoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkPhotoRepository) EXPECT() *MockBulkPhotoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBulkPhotoRepository) Create(ctx context.Context, tx repository.Querier, bulkPhoto *entity.BulkPhoto) (*entity.BulkPhoto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, bulkPhoto)
+	ret0, _ := ret[0].(*entity.BulkPhoto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBulkPhotoRepositoryMockRecorder) Create(ctx, tx, bulkPhoto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBulkPhotoRepository)(nil).Create), ctx, tx, bulkPhoto)
+}
+
+// FindDetailById mocks base method.
+func (m *MockBulkPhotoRepository) FindDetailById(ctx context.Context, tx repository.Querier, bulkPhotoID, creatorID string) (*[]*entity.BulkPhotoDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDetailById", ctx, tx, bulkPhotoID, creatorID)
+	ret0, _ := ret[0].(*[]*entity.BulkPhotoDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDetailById indicates an expected call of FindDetailById.
+func (mr *MockBulkPhotoRepositoryMockRecorder) FindDetailById(ctx, tx, bulkPhotoID, creatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDetailById", reflect.TypeOf((*MockBulkPhotoRepository)(nil).FindDetailById), ctx, tx, bulkPhotoID, creatorID)
+}
+
+// FindRecentEventByCreatorId mocks base method.
+func (m *MockBulkPhotoRepository) FindRecentEventByCreatorId(ctx context.Context, tx repository.Querier, creatorId string, limit int) ([]*entity.CreatorEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentEventByCreatorId", ctx, tx, creatorId, limit)
+	ret0, _ := ret[0].([]*entity.CreatorEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentEventByCreatorId indicates an expected call of FindRecentEventByCreatorId.
+func (mr *MockBulkPhotoRepositoryMockRecorder) FindRecentEventByCreatorId(ctx, tx, creatorId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentEventByCreatorId", reflect.TypeOf((*MockBulkPhotoRepository)(nil).FindRecentEventByCreatorId), ctx, tx, creatorId, limit)
+}
+
+// Update mocks base method.
+func (m *MockBulkPhotoRepository) Update(ctx context.Context, tx repository.Querier, bulkPhoto *entity.BulkPhoto) (*entity.BulkPhoto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, bulkPhoto)
+	ret0, _ := ret[0].(*entity.BulkPhoto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBulkPhotoRepositoryMockRecorder) Update(ctx, tx, bulkPhoto any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBulkPhotoRepository)(nil).Update), ctx, tx, bulkPhoto)
+}
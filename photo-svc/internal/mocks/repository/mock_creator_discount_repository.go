@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/creator_discount_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/creator_discount_repository.go -destination=./mocks/repository/mock_creator_discount_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreatorDiscountRepository is a mock of CreatorDiscountRepository interface.
+type MockCreatorDiscountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatorDiscountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCreatorDiscountRepositoryMockRecorder is the mock recorder for MockCreatorDiscountRepository.
+type MockCreatorDiscountRepositoryMockRecorder struct {
+	mock *MockCreatorDiscountRepository
+}
+
+// NewMockCreatorDiscountRepository creates a new mock instance.
+func NewMockCreatorDiscountRepository(ctrl *gomock.Controller) *MockCreatorDiscountRepository {
+	mock := &MockCreatorDiscountRepository{ctrl: ctrl}
+	mock.recorder = &MockCreatorDiscountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatorDiscountRepository) EXPECT() *MockCreatorDiscountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Activate mocks base method.
+func (m *MockCreatorDiscountRepository) Activate(ctx context.Context, tx repository.Querier, discountId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", ctx, tx, discountId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) Activate(ctx, tx, discountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).Activate), ctx, tx, discountId)
+}
+
+// Create mocks base method.
+func (m *MockCreatorDiscountRepository) Create(ctx context.Context, tx repository.Querier, discount *entity.CreatorDiscount) (*entity.CreatorDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, discount)
+	ret0, _ := ret[0].(*entity.CreatorDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) Create(ctx, tx, discount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).Create), ctx, tx, discount)
+}
+
+// Deactivate mocks base method.
+func (m *MockCreatorDiscountRepository) Deactivate(ctx context.Context, tx repository.Querier, discountId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, tx, discountId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) Deactivate(ctx, tx, discountId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).Deactivate), ctx, tx, discountId)
+}
+
+// FindAll mocks base method.
+func (m *MockCreatorDiscountRepository) FindAll(ctx context.Context, tx repository.Querier, creatorId string) (*[]*entity.CreatorDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, tx, creatorId)
+	ret0, _ := ret[0].(*[]*entity.CreatorDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) FindAll(ctx, tx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).FindAll), ctx, tx, creatorId)
+}
+
+// FindByIdAndCreatorId mocks base method.
+func (m *MockCreatorDiscountRepository) FindByIdAndCreatorId(ctx context.Context, tx repository.Querier, discountId, creatorId string) (*entity.CreatorDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdAndCreatorId", ctx, tx, discountId, creatorId)
+	ret0, _ := ret[0].(*entity.CreatorDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdAndCreatorId indicates an expected call of FindByIdAndCreatorId.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) FindByIdAndCreatorId(ctx, tx, discountId, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdAndCreatorId", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).FindByIdAndCreatorId), ctx, tx, discountId, creatorId)
+}
+
+// GetDiscountRules mocks base method.
+func (m *MockCreatorDiscountRepository) GetDiscountRules(ctx context.Context, creatorIds []string) (*[]*entity.CreatorDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscountRules", ctx, creatorIds)
+	ret0, _ := ret[0].(*[]*entity.CreatorDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscountRules indicates an expected call of GetDiscountRules.
+func (mr *MockCreatorDiscountRepositoryMockRecorder) GetDiscountRules(ctx, creatorIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscountRules", reflect.TypeOf((*MockCreatorDiscountRepository)(nil).GetDiscountRules), ctx, creatorIds)
+}
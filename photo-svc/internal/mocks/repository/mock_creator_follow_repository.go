@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/creator_follow_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/creator_follow_repository.go -destination=./mocks/repository/mock_creator_follow_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreatorFollowRepository is a mock of CreatorFollowRepository interface.
+type MockCreatorFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatorFollowRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCreatorFollowRepositoryMockRecorder is the mock recorder for MockCreatorFollowRepository.
+type MockCreatorFollowRepositoryMockRecorder struct {
+	mock *MockCreatorFollowRepository
+}
+
+// NewMockCreatorFollowRepository creates a new mock instance.
+func NewMockCreatorFollowRepository(ctrl *gomock.Controller) *MockCreatorFollowRepository {
+	mock := &MockCreatorFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockCreatorFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatorFollowRepository) EXPECT() *MockCreatorFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCreatorFollowRepository) Delete(ctx context.Context, tx repository.Querier, followerUserId, creatorId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, followerUserId, creatorId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCreatorFollowRepositoryMockRecorder) Delete(ctx, tx, followerUserId, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCreatorFollowRepository)(nil).Delete), ctx, tx, followerUserId, creatorId)
+}
+
+// DeleteByFollowerUserId mocks base method.
+func (m *MockCreatorFollowRepository) DeleteByFollowerUserId(ctx context.Context, tx repository.Querier, followerUserId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByFollowerUserId", ctx, tx, followerUserId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByFollowerUserId indicates an expected call of DeleteByFollowerUserId.
+func (mr *MockCreatorFollowRepositoryMockRecorder) DeleteByFollowerUserId(ctx, tx, followerUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByFollowerUserId", reflect.TypeOf((*MockCreatorFollowRepository)(nil).DeleteByFollowerUserId), ctx, tx, followerUserId)
+}
+
+// FindAllFollowerUserIds mocks base method.
+func (m *MockCreatorFollowRepository) FindAllFollowerUserIds(ctx context.Context, tx repository.Querier, creatorId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllFollowerUserIds", ctx, tx, creatorId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllFollowerUserIds indicates an expected call of FindAllFollowerUserIds.
+func (mr *MockCreatorFollowRepositoryMockRecorder) FindAllFollowerUserIds(ctx, tx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllFollowerUserIds", reflect.TypeOf((*MockCreatorFollowRepository)(nil).FindAllFollowerUserIds), ctx, tx, creatorId)
+}
+
+// FindFeedByCursor mocks base method.
+func (m *MockCreatorFollowRepository) FindFeedByCursor(ctx context.Context, tx repository.Querier, followerUserId string, since time.Time, cursor *pagination.Cursor, size int) ([]*entity.FollowingFeedPhoto, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeedByCursor", ctx, tx, followerUserId, since, cursor, size)
+	ret0, _ := ret[0].([]*entity.FollowingFeedPhoto)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFeedByCursor indicates an expected call of FindFeedByCursor.
+func (mr *MockCreatorFollowRepositoryMockRecorder) FindFeedByCursor(ctx, tx, followerUserId, since, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeedByCursor", reflect.TypeOf((*MockCreatorFollowRepository)(nil).FindFeedByCursor), ctx, tx, followerUserId, since, cursor, size)
+}
+
+// FindFollowersByCursor mocks base method.
+func (m *MockCreatorFollowRepository) FindFollowersByCursor(ctx context.Context, tx repository.Querier, creatorId string, cursor *pagination.Cursor, size int) ([]*entity.CreatorFollow, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFollowersByCursor", ctx, tx, creatorId, cursor, size)
+	ret0, _ := ret[0].([]*entity.CreatorFollow)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFollowersByCursor indicates an expected call of FindFollowersByCursor.
+func (mr *MockCreatorFollowRepositoryMockRecorder) FindFollowersByCursor(ctx, tx, creatorId, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowersByCursor", reflect.TypeOf((*MockCreatorFollowRepository)(nil).FindFollowersByCursor), ctx, tx, creatorId, cursor, size)
+}
+
+// FindFollowingByCursor mocks base method.
+func (m *MockCreatorFollowRepository) FindFollowingByCursor(ctx context.Context, tx repository.Querier, followerUserId string, cursor *pagination.Cursor, size int) ([]*entity.FollowedCreator, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFollowingByCursor", ctx, tx, followerUserId, cursor, size)
+	ret0, _ := ret[0].([]*entity.FollowedCreator)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFollowingByCursor indicates an expected call of FindFollowingByCursor.
+func (mr *MockCreatorFollowRepositoryMockRecorder) FindFollowingByCursor(ctx, tx, followerUserId, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowingByCursor", reflect.TypeOf((*MockCreatorFollowRepository)(nil).FindFollowingByCursor), ctx, tx, followerUserId, cursor, size)
+}
+
+// Insert mocks base method.
+func (m *MockCreatorFollowRepository) Insert(ctx context.Context, tx repository.Querier, creatorFollow *entity.CreatorFollow) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, tx, creatorFollow)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreatorFollowRepositoryMockRecorder) Insert(ctx, tx, creatorFollow any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreatorFollowRepository)(nil).Insert), ctx, tx, creatorFollow)
+}
+
+// IsFollowing mocks base method.
+func (m *MockCreatorFollowRepository) IsFollowing(ctx context.Context, tx repository.Querier, followerUserId, creatorId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowing", ctx, tx, followerUserId, creatorId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFollowing indicates an expected call of IsFollowing.
+func (mr *MockCreatorFollowRepositoryMockRecorder) IsFollowing(ctx, tx, followerUserId, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowing", reflect.TypeOf((*MockCreatorFollowRepository)(nil).IsFollowing), ctx, tx, followerUserId, creatorId)
+}
@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/creator_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/creator_repository.go -destination=./mocks/repository/mock_creator_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreatorRepository is a mock of CreatorRepository interface.
+type MockCreatorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCreatorRepositoryMockRecorder is the mock recorder for MockCreatorRepository.
+type MockCreatorRepositoryMockRecorder struct {
+	mock *MockCreatorRepository
+}
+
+// NewMockCreatorRepository creates a new mock instance.
+func NewMockCreatorRepository(ctrl *gomock.Controller) *MockCreatorRepository {
+	mock := &MockCreatorRepository{ctrl: ctrl}
+	mock.recorder = &MockCreatorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatorRepository) EXPECT() *MockCreatorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFollowerCount mocks base method.
+func (m *MockCreatorRepository) AddFollowerCount(ctx context.Context, tx repository.Querier, creatorId string, delta int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFollowerCount", ctx, tx, creatorId, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFollowerCount indicates an expected call of AddFollowerCount.
+func (mr *MockCreatorRepositoryMockRecorder) AddFollowerCount(ctx, tx, creatorId, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFollowerCount", reflect.TypeOf((*MockCreatorRepository)(nil).AddFollowerCount), ctx, tx, creatorId, delta)
+}
+
+// Create mocks base method.
+func (m *MockCreatorRepository) Create(ctx context.Context, tx repository.Querier, creator *entity.Creator) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, creator)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreatorRepositoryMockRecorder) Create(ctx, tx, creator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreatorRepository)(nil).Create), ctx, tx, creator)
+}
+
+// FindById mocks base method.
+func (m *MockCreatorRepository) FindById(ctx context.Context, tx repository.Querier, creatorId string) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, creatorId)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockCreatorRepositoryMockRecorder) FindById(ctx, tx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCreatorRepository)(nil).FindById), ctx, tx, creatorId)
+}
+
+// FindByUserId mocks base method.
+func (m *MockCreatorRepository) FindByUserId(ctx context.Context, userId string) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockCreatorRepositoryMockRecorder) FindByUserId(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockCreatorRepository)(nil).FindByUserId), ctx, userId)
+}
+
+// FindIdByUserId mocks base method.
+func (m *MockCreatorRepository) FindIdByUserId(ctx context.Context, tx repository.Querier, userId string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdByUserId indicates an expected call of FindIdByUserId.
+func (mr *MockCreatorRepositoryMockRecorder) FindIdByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdByUserId", reflect.TypeOf((*MockCreatorRepository)(nil).FindIdByUserId), ctx, tx, userId)
+}
+
+// FindManyByIds mocks base method.
+func (m *MockCreatorRepository) FindManyByIds(ctx context.Context, tx repository.Querier, creatorIds []string) ([]*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyByIds", ctx, tx, creatorIds)
+	ret0, _ := ret[0].([]*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindManyByIds indicates an expected call of FindManyByIds.
+func (mr *MockCreatorRepositoryMockRecorder) FindManyByIds(ctx, tx, creatorIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyByIds", reflect.TypeOf((*MockCreatorRepository)(nil).FindManyByIds), ctx, tx, creatorIds)
+}
+
+// FindUserIds mocks base method.
+func (m *MockCreatorRepository) FindUserIds(ctx context.Context, tx repository.Querier, afterUserId string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserIds", ctx, tx, afterUserId, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserIds indicates an expected call of FindUserIds.
+func (mr *MockCreatorRepositoryMockRecorder) FindUserIds(ctx, tx, afterUserId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserIds", reflect.TypeOf((*MockCreatorRepository)(nil).FindUserIds), ctx, tx, afterUserId, limit)
+}
+
+// UpdateCreatorRating mocks base method.
+func (m *MockCreatorRepository) UpdateCreatorRating(ctx context.Context, tx repository.Querier, creator *entity.Creator) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCreatorRating", ctx, tx, creator)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCreatorRating indicates an expected call of UpdateCreatorRating.
+func (mr *MockCreatorRepositoryMockRecorder) UpdateCreatorRating(ctx, tx, creator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCreatorRating", reflect.TypeOf((*MockCreatorRepository)(nil).UpdateCreatorRating), ctx, tx, creator)
+}
+
+// UpdateVerifiedAt mocks base method.
+func (m *MockCreatorRepository) UpdateVerifiedAt(ctx context.Context, tx repository.Querier, creator *entity.Creator) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVerifiedAt", ctx, tx, creator)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVerifiedAt indicates an expected call of UpdateVerifiedAt.
+func (mr *MockCreatorRepositoryMockRecorder) UpdateVerifiedAt(ctx, tx, creator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifiedAt", reflect.TypeOf((*MockCreatorRepository)(nil).UpdateVerifiedAt), ctx, tx, creator)
+}
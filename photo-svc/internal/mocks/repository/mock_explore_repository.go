@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/explore_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/explore_repository.go -destination=./mocks/repository/mock_explore_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	model "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExploreRepository is a mock of ExploreRepository interface.
+type MockExploreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExploreRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExploreRepositoryMockRecorder is the mock recorder for MockExploreRepository.
+type MockExploreRepositoryMockRecorder struct {
+	mock *MockExploreRepository
+}
+
+// NewMockExploreRepository creates a new mock instance.
+func NewMockExploreRepository(ctrl *gomock.Controller) *MockExploreRepository {
+	mock := &MockExploreRepository{ctrl: ctrl}
+	mock.recorder = &MockExploreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExploreRepository) EXPECT() *MockExploreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountExploreCandidates mocks base method.
+func (m *MockExploreRepository) CountExploreCandidates(ctx context.Context, tx repository.Querier, similarity uint32, userId, creatorId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountExploreCandidates", ctx, tx, similarity, userId, creatorId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountExploreCandidates indicates an expected call of CountExploreCandidates.
+func (mr *MockExploreRepositoryMockRecorder) CountExploreCandidates(ctx, tx, similarity, userId, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountExploreCandidates", reflect.TypeOf((*MockExploreRepository)(nil).CountExploreCandidates), ctx, tx, similarity, userId, creatorId)
+}
+
+// FindAllExploreSimilar mocks base method.
+func (m *MockExploreRepository) FindAllExploreSimilar(ctx context.Context, tx repository.Querier, page, size int, similarity uint32, userId, creatorId string, isWishlist, isFavorite, isCart bool) ([]*entity.Explore, *model.PageMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllExploreSimilar", ctx, tx, page, size, similarity, userId, creatorId, isWishlist, isFavorite, isCart)
+	ret0, _ := ret[0].([]*entity.Explore)
+	ret1, _ := ret[1].(*model.PageMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllExploreSimilar indicates an expected call of FindAllExploreSimilar.
+func (mr *MockExploreRepositoryMockRecorder) FindAllExploreSimilar(ctx, tx, page, size, similarity, userId, creatorId, isWishlist, isFavorite, isCart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllExploreSimilar", reflect.TypeOf((*MockExploreRepository)(nil).FindAllExploreSimilar), ctx, tx, page, size, similarity, userId, creatorId, isWishlist, isFavorite, isCart)
+}
+
+// FindAllExploreStageByCursor mocks base method.
+func (m *MockExploreRepository) FindAllExploreStageByCursor(ctx context.Context, tx repository.Querier, cursor *pagination.Cursor, size int, similarity uint32, userId, creatorId string, stage enum.PhotoStageEnum) ([]*entity.Explore, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllExploreStageByCursor", ctx, tx, cursor, size, similarity, userId, creatorId, stage)
+	ret0, _ := ret[0].([]*entity.Explore)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllExploreStageByCursor indicates an expected call of FindAllExploreStageByCursor.
+func (mr *MockExploreRepositoryMockRecorder) FindAllExploreStageByCursor(ctx, tx, cursor, size, similarity, userId, creatorId, stage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllExploreStageByCursor", reflect.TypeOf((*MockExploreRepository)(nil).FindAllExploreStageByCursor), ctx, tx, cursor, size, similarity, userId, creatorId, stage)
+}
+
+// FindExploreCandidates mocks base method.
+func (m *MockExploreRepository) FindExploreCandidates(ctx context.Context, tx repository.Querier, similarity, confidentSimilarity uint32, userId, creatorId string, after *model.ExploreCandidateKey, limit int) ([]*entity.ExploreCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExploreCandidates", ctx, tx, similarity, confidentSimilarity, userId, creatorId, after, limit)
+	ret0, _ := ret[0].([]*entity.ExploreCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExploreCandidates indicates an expected call of FindExploreCandidates.
+func (mr *MockExploreRepositoryMockRecorder) FindExploreCandidates(ctx, tx, similarity, confidentSimilarity, userId, creatorId, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExploreCandidates", reflect.TypeOf((*MockExploreRepository)(nil).FindExploreCandidates), ctx, tx, similarity, confidentSimilarity, userId, creatorId, after, limit)
+}
+
+// UserAddStage mocks base method.
+func (m *MockExploreRepository) UserAddStage(ctx context.Context, tx repository.Querier, photoId, userId string, stage enum.PhotoStageEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAddStage", ctx, tx, photoId, userId, stage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserAddStage indicates an expected call of UserAddStage.
+func (mr *MockExploreRepositoryMockRecorder) UserAddStage(ctx, tx, photoId, userId, stage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAddStage", reflect.TypeOf((*MockExploreRepository)(nil).UserAddStage), ctx, tx, photoId, userId, stage)
+}
+
+// UserDeleteStage mocks base method.
+func (m *MockExploreRepository) UserDeleteStage(ctx context.Context, tx repository.Querier, photoId, userId string, stage enum.PhotoStageEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeleteStage", ctx, tx, photoId, userId, stage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeleteStage indicates an expected call of UserDeleteStage.
+func (mr *MockExploreRepositoryMockRecorder) UserDeleteStage(ctx, tx, photoId, userId, stage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeleteStage", reflect.TypeOf((*MockExploreRepository)(nil).UserDeleteStage), ctx, tx, photoId, userId, stage)
+}
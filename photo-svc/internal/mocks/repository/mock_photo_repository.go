@@ -0,0 +1,320 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/photo_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/photo_repository.go -destination=./mocks/repository/mock_photo_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPhotoRepository is a mock of PhotoRepository interface.
+type MockPhotoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPhotoRepositoryMockRecorder is the mock recorder for MockPhotoRepository.
+type MockPhotoRepositoryMockRecorder struct {
+	mock *MockPhotoRepository
+}
+
+// NewMockPhotoRepository creates a new mock instance.
+func NewMockPhotoRepository(ctrl *gomock.Controller) *MockPhotoRepository {
+	mock := &MockPhotoRepository{ctrl: ctrl}
+	mock.recorder = &MockPhotoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoRepository) EXPECT() *MockPhotoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPhotoTotal mocks base method.
+func (m *MockPhotoRepository) AddPhotoTotal(ctx context.Context, tx repository.Querier, photoID string, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPhotoTotal", ctx, tx, photoID, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPhotoTotal indicates an expected call of AddPhotoTotal.
+func (mr *MockPhotoRepositoryMockRecorder) AddPhotoTotal(ctx, tx, photoID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPhotoTotal", reflect.TypeOf((*MockPhotoRepository)(nil).AddPhotoTotal), ctx, tx, photoID, count)
+}
+
+// BulkAddPhotoTotals mocks base method.
+func (m *MockPhotoRepository) BulkAddPhotoTotals(ctx context.Context, tx repository.Querier, photoCountMap map[string]int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkAddPhotoTotals", ctx, tx, photoCountMap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkAddPhotoTotals indicates an expected call of BulkAddPhotoTotals.
+func (mr *MockPhotoRepositoryMockRecorder) BulkAddPhotoTotals(ctx, tx, photoCountMap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkAddPhotoTotals", reflect.TypeOf((*MockPhotoRepository)(nil).BulkAddPhotoTotals), ctx, tx, photoCountMap)
+}
+
+// BulkCreate mocks base method.
+func (m *MockPhotoRepository) BulkCreate(ctx context.Context, tx repository.Querier, items []*entity.Photo) (*[]*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreate", ctx, tx, items)
+	ret0, _ := ret[0].(*[]*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreate indicates an expected call of BulkCreate.
+func (mr *MockPhotoRepositoryMockRecorder) BulkCreate(ctx, tx, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockPhotoRepository)(nil).BulkCreate), ctx, tx, items)
+}
+
+// BulkIncrementTotal mocks base method.
+func (m *MockPhotoRepository) BulkIncrementTotal(ctx context.Context, tx repository.Querier, photoIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkIncrementTotal", ctx, tx, photoIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkIncrementTotal indicates an expected call of BulkIncrementTotal.
+func (mr *MockPhotoRepositoryMockRecorder) BulkIncrementTotal(ctx, tx, photoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkIncrementTotal", reflect.TypeOf((*MockPhotoRepository)(nil).BulkIncrementTotal), ctx, tx, photoIDs)
+}
+
+// Create mocks base method.
+func (m *MockPhotoRepository) Create(tx repository.Querier, photo *entity.Photo) (*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tx, photo)
+	ret0, _ := ret[0].(*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPhotoRepositoryMockRecorder) Create(tx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPhotoRepository)(nil).Create), tx, photo)
+}
+
+// FindAllOwnedByUserId mocks base method.
+func (m *MockPhotoRepository) FindAllOwnedByUserId(ctx context.Context, tx repository.Querier, userId string) ([]*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllOwnedByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].([]*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllOwnedByUserId indicates an expected call of FindAllOwnedByUserId.
+func (mr *MockPhotoRepositoryMockRecorder) FindAllOwnedByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllOwnedByUserId", reflect.TypeOf((*MockPhotoRepository)(nil).FindAllOwnedByUserId), ctx, tx, userId)
+}
+
+// FindBuyableByPhotoId mocks base method.
+func (m *MockPhotoRepository) FindBuyableByPhotoId(ctx context.Context, tx repository.Querier, photoId string, forUpdate bool) (*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBuyableByPhotoId", ctx, tx, photoId, forUpdate)
+	ret0, _ := ret[0].(*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBuyableByPhotoId indicates an expected call of FindBuyableByPhotoId.
+func (mr *MockPhotoRepositoryMockRecorder) FindBuyableByPhotoId(ctx, tx, photoId, forUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBuyableByPhotoId", reflect.TypeOf((*MockPhotoRepository)(nil).FindBuyableByPhotoId), ctx, tx, photoId, forUpdate)
+}
+
+// FindByPhotoId mocks base method.
+func (m *MockPhotoRepository) FindByPhotoId(ctx context.Context, tx repository.Querier, photoId string) (*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPhotoId", ctx, tx, photoId)
+	ret0, _ := ret[0].(*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPhotoId indicates an expected call of FindByPhotoId.
+func (mr *MockPhotoRepositoryMockRecorder) FindByPhotoId(ctx, tx, photoId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhotoId", reflect.TypeOf((*MockPhotoRepository)(nil).FindByPhotoId), ctx, tx, photoId)
+}
+
+// FindSampleByCreatorId mocks base method.
+func (m *MockPhotoRepository) FindSampleByCreatorId(ctx context.Context, tx repository.Querier, creatorId string, limit int) ([]*entity.CreatorSamplePhoto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSampleByCreatorId", ctx, tx, creatorId, limit)
+	ret0, _ := ret[0].([]*entity.CreatorSamplePhoto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSampleByCreatorId indicates an expected call of FindSampleByCreatorId.
+func (mr *MockPhotoRepositoryMockRecorder) FindSampleByCreatorId(ctx, tx, creatorId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSampleByCreatorId", reflect.TypeOf((*MockPhotoRepository)(nil).FindSampleByCreatorId), ctx, tx, creatorId, limit)
+}
+
+// FindVisiblePreviewsByIds mocks base method.
+func (m *MockPhotoRepository) FindVisiblePreviewsByIds(ctx context.Context, tx repository.Querier, userId string, photoIds []string) ([]*entity.ChatPhotoPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVisiblePreviewsByIds", ctx, tx, userId, photoIds)
+	ret0, _ := ret[0].([]*entity.ChatPhotoPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVisiblePreviewsByIds indicates an expected call of FindVisiblePreviewsByIds.
+func (mr *MockPhotoRepositoryMockRecorder) FindVisiblePreviewsByIds(ctx, tx, userId, photoIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVisiblePreviewsByIds", reflect.TypeOf((*MockPhotoRepository)(nil).FindVisiblePreviewsByIds), ctx, tx, userId, photoIds)
+}
+
+// GetManyInTransactionByIDsAndUserID mocks base method.
+func (m *MockPhotoRepository) GetManyInTransactionByIDsAndUserID(ctx context.Context, tx repository.Querier, userId string, ids []string, forUpdate bool) (*[]*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyInTransactionByIDsAndUserID", ctx, tx, userId, ids, forUpdate)
+	ret0, _ := ret[0].(*[]*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyInTransactionByIDsAndUserID indicates an expected call of GetManyInTransactionByIDsAndUserID.
+func (mr *MockPhotoRepositoryMockRecorder) GetManyInTransactionByIDsAndUserID(ctx, tx, userId, ids, forUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyInTransactionByIDsAndUserID", reflect.TypeOf((*MockPhotoRepository)(nil).GetManyInTransactionByIDsAndUserID), ctx, tx, userId, ids, forUpdate)
+}
+
+// GetSimilarPhotosByIDs mocks base method.
+func (m *MockPhotoRepository) GetSimilarPhotosByIDs(ctx context.Context, tx repository.Querier, userId, creatorId string, ids []string, forUpdate bool, converter func(string) string) (*[]*entity.Photo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarPhotosByIDs", ctx, tx, userId, creatorId, ids, forUpdate, converter)
+	ret0, _ := ret[0].(*[]*entity.Photo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarPhotosByIDs indicates an expected call of GetSimilarPhotosByIDs.
+func (mr *MockPhotoRepositoryMockRecorder) GetSimilarPhotosByIDs(ctx, tx, userId, creatorId, ids, forUpdate, converter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarPhotosByIDs", reflect.TypeOf((*MockPhotoRepository)(nil).GetSimilarPhotosByIDs), ctx, tx, userId, creatorId, ids, forUpdate, converter)
+}
+
+// PseudonymizeOwner mocks base method.
+func (m *MockPhotoRepository) PseudonymizeOwner(ctx context.Context, tx repository.Querier, userId, pseudonymId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PseudonymizeOwner", ctx, tx, userId, pseudonymId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PseudonymizeOwner indicates an expected call of PseudonymizeOwner.
+func (mr *MockPhotoRepositoryMockRecorder) PseudonymizeOwner(ctx, tx, userId, pseudonymId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PseudonymizeOwner", reflect.TypeOf((*MockPhotoRepository)(nil).PseudonymizeOwner), ctx, tx, userId, pseudonymId)
+}
+
+// UpdateCompressedUrl mocks base method.
+func (m *MockPhotoRepository) UpdateCompressedUrl(tx repository.Querier, photo *entity.Photo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCompressedUrl", tx, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCompressedUrl indicates an expected call of UpdateCompressedUrl.
+func (mr *MockPhotoRepositoryMockRecorder) UpdateCompressedUrl(tx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCompressedUrl", reflect.TypeOf((*MockPhotoRepository)(nil).UpdateCompressedUrl), tx, photo)
+}
+
+// UpdatePhotoOwnerAndStatusByIds mocks base method.
+func (m *MockPhotoRepository) UpdatePhotoOwnerAndStatusByIds(ctx context.Context, tx repository.Querier, ownerID string, photoIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhotoOwnerAndStatusByIds", ctx, tx, ownerID, photoIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhotoOwnerAndStatusByIds indicates an expected call of UpdatePhotoOwnerAndStatusByIds.
+func (mr *MockPhotoRepositoryMockRecorder) UpdatePhotoOwnerAndStatusByIds(ctx, tx, ownerID, photoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhotoOwnerAndStatusByIds", reflect.TypeOf((*MockPhotoRepository)(nil).UpdatePhotoOwnerAndStatusByIds), ctx, tx, ownerID, photoIDs)
+}
+
+// UpdatePhotoStatusesByIDs mocks base method.
+func (m *MockPhotoRepository) UpdatePhotoStatusesByIDs(ctx context.Context, tx repository.Querier, status enum.PhotoStatusEnum, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhotoStatusesByIDs", ctx, tx, status, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhotoStatusesByIDs indicates an expected call of UpdatePhotoStatusesByIDs.
+func (mr *MockPhotoRepositoryMockRecorder) UpdatePhotoStatusesByIDs(ctx, tx, status, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhotoStatusesByIDs", reflect.TypeOf((*MockPhotoRepository)(nil).UpdatePhotoStatusesByIDs), ctx, tx, status, ids)
+}
+
+// UpdateProcessedUrl mocks base method.
+func (m *MockPhotoRepository) UpdateProcessedUrl(tx repository.Querier, photo *entity.Photo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessedUrl", tx, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProcessedUrl indicates an expected call of UpdateProcessedUrl.
+func (mr *MockPhotoRepositoryMockRecorder) UpdateProcessedUrl(tx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessedUrl", reflect.TypeOf((*MockPhotoRepository)(nil).UpdateProcessedUrl), tx, photo)
+}
+
+// UpdateProcessedUrlBulk mocks base method.
+func (m *MockPhotoRepository) UpdateProcessedUrlBulk(tx repository.Querier, photos []*entity.Photo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessedUrlBulk", tx, photos)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProcessedUrlBulk indicates an expected call of UpdateProcessedUrlBulk.
+func (mr *MockPhotoRepositoryMockRecorder) UpdateProcessedUrlBulk(tx, photos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessedUrlBulk", reflect.TypeOf((*MockPhotoRepository)(nil).UpdateProcessedUrlBulk), tx, photos)
+}
+
+// UserGetPhotoWithDetail mocks base method.
+func (m *MockPhotoRepository) UserGetPhotoWithDetail(ctx context.Context, tx repository.Querier, photoIDs []string, userID string) ([]*entity.PhotoWithDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGetPhotoWithDetail", ctx, tx, photoIDs, userID)
+	ret0, _ := ret[0].([]*entity.PhotoWithDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGetPhotoWithDetail indicates an expected call of UserGetPhotoWithDetail.
+func (mr *MockPhotoRepositoryMockRecorder) UserGetPhotoWithDetail(ctx, tx, photoIDs, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGetPhotoWithDetail", reflect.TypeOf((*MockPhotoRepository)(nil).UserGetPhotoWithDetail), ctx, tx, photoIDs, userID)
+}
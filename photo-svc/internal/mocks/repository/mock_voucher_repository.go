@@ -0,0 +1,205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/voucher_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/voucher_repository.go -destination=./mocks/repository/mock_voucher_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVoucherRepository is a mock of VoucherRepository interface.
+type MockVoucherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoucherRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVoucherRepositoryMockRecorder is the mock recorder for MockVoucherRepository.
+type MockVoucherRepositoryMockRecorder struct {
+	mock *MockVoucherRepository
+}
+
+// NewMockVoucherRepository creates a new mock instance.
+func NewMockVoucherRepository(ctrl *gomock.Controller) *MockVoucherRepository {
+	mock := &MockVoucherRepository{ctrl: ctrl}
+	mock.recorder = &MockVoucherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoucherRepository) EXPECT() *MockVoucherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUsage mocks base method.
+func (m *MockVoucherRepository) CountUsage(ctx context.Context, tx repository.Querier, voucherId, userId string) (*entity.VoucherUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsage", ctx, tx, voucherId, userId)
+	ret0, _ := ret[0].(*entity.VoucherUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsage indicates an expected call of CountUsage.
+func (mr *MockVoucherRepositoryMockRecorder) CountUsage(ctx, tx, voucherId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsage", reflect.TypeOf((*MockVoucherRepository)(nil).CountUsage), ctx, tx, voucherId, userId)
+}
+
+// Create mocks base method.
+func (m *MockVoucherRepository) Create(ctx context.Context, tx repository.Querier, voucher *entity.Voucher) (*entity.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, voucher)
+	ret0, _ := ret[0].(*entity.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVoucherRepositoryMockRecorder) Create(ctx, tx, voucher any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVoucherRepository)(nil).Create), ctx, tx, voucher)
+}
+
+// CreateRedemption mocks base method.
+func (m *MockVoucherRepository) CreateRedemption(ctx context.Context, tx repository.Querier, redemption *entity.VoucherRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedemption", ctx, tx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRedemption indicates an expected call of CreateRedemption.
+func (mr *MockVoucherRepositoryMockRecorder) CreateRedemption(ctx, tx, redemption any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedemption", reflect.TypeOf((*MockVoucherRepository)(nil).CreateRedemption), ctx, tx, redemption)
+}
+
+// ExistsByCode mocks base method.
+func (m *MockVoucherRepository) ExistsByCode(ctx context.Context, tx repository.Querier, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByCode", ctx, tx, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByCode indicates an expected call of ExistsByCode.
+func (mr *MockVoucherRepositoryMockRecorder) ExistsByCode(ctx, tx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByCode", reflect.TypeOf((*MockVoucherRepository)(nil).ExistsByCode), ctx, tx, code)
+}
+
+// FindAllByCreatorId mocks base method.
+func (m *MockVoucherRepository) FindAllByCreatorId(ctx context.Context, tx repository.Querier, creatorId string) (*[]*entity.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCreatorId", ctx, tx, creatorId)
+	ret0, _ := ret[0].(*[]*entity.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCreatorId indicates an expected call of FindAllByCreatorId.
+func (mr *MockVoucherRepositoryMockRecorder) FindAllByCreatorId(ctx, tx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCreatorId", reflect.TypeOf((*MockVoucherRepository)(nil).FindAllByCreatorId), ctx, tx, creatorId)
+}
+
+// FindById mocks base method.
+func (m *MockVoucherRepository) FindById(ctx context.Context, tx repository.Querier, voucherId string, forUpdate bool) (*entity.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, voucherId, forUpdate)
+	ret0, _ := ret[0].(*entity.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockVoucherRepositoryMockRecorder) FindById(ctx, tx, voucherId, forUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockVoucherRepository)(nil).FindById), ctx, tx, voucherId, forUpdate)
+}
+
+// FindByIdAndCreatorId mocks base method.
+func (m *MockVoucherRepository) FindByIdAndCreatorId(ctx context.Context, tx repository.Querier, voucherId, creatorId string) (*entity.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdAndCreatorId", ctx, tx, voucherId, creatorId)
+	ret0, _ := ret[0].(*entity.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdAndCreatorId indicates an expected call of FindByIdAndCreatorId.
+func (mr *MockVoucherRepositoryMockRecorder) FindByIdAndCreatorId(ctx, tx, voucherId, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdAndCreatorId", reflect.TypeOf((*MockVoucherRepository)(nil).FindByIdAndCreatorId), ctx, tx, voucherId, creatorId)
+}
+
+// FindRedeemableByCode mocks base method.
+func (m *MockVoucherRepository) FindRedeemableByCode(ctx context.Context, tx repository.Querier, code string, forUpdate bool) (*entity.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRedeemableByCode", ctx, tx, code, forUpdate)
+	ret0, _ := ret[0].(*entity.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRedeemableByCode indicates an expected call of FindRedeemableByCode.
+func (mr *MockVoucherRepositoryMockRecorder) FindRedeemableByCode(ctx, tx, code, forUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRedeemableByCode", reflect.TypeOf((*MockVoucherRepository)(nil).FindRedeemableByCode), ctx, tx, code, forUpdate)
+}
+
+// PseudonymizeRedemptions mocks base method.
+func (m *MockVoucherRepository) PseudonymizeRedemptions(ctx context.Context, tx repository.Querier, userId, pseudonymId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PseudonymizeRedemptions", ctx, tx, userId, pseudonymId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PseudonymizeRedemptions indicates an expected call of PseudonymizeRedemptions.
+func (mr *MockVoucherRepositoryMockRecorder) PseudonymizeRedemptions(ctx, tx, userId, pseudonymId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PseudonymizeRedemptions", reflect.TypeOf((*MockVoucherRepository)(nil).PseudonymizeRedemptions), ctx, tx, userId, pseudonymId)
+}
+
+// UpdateIsActive mocks base method.
+func (m *MockVoucherRepository) UpdateIsActive(ctx context.Context, tx repository.Querier, voucherId, creatorId string, isActive bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIsActive", ctx, tx, voucherId, creatorId, isActive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIsActive indicates an expected call of UpdateIsActive.
+func (mr *MockVoucherRepositoryMockRecorder) UpdateIsActive(ctx, tx, voucherId, creatorId, isActive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsActive", reflect.TypeOf((*MockVoucherRepository)(nil).UpdateIsActive), ctx, tx, voucherId, creatorId, isActive)
+}
+
+// UpdateRedemptionStatus mocks base method.
+func (m *MockVoucherRepository) UpdateRedemptionStatus(ctx context.Context, tx repository.Querier, transactionId string, from, to enum.VoucherRedemptionStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedemptionStatus", ctx, tx, transactionId, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRedemptionStatus indicates an expected call of UpdateRedemptionStatus.
+func (mr *MockVoucherRepositoryMockRecorder) UpdateRedemptionStatus(ctx, tx, transactionId, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedemptionStatus", reflect.TypeOf((*MockVoucherRepository)(nil).UpdateRedemptionStatus), ctx, tx, transactionId, from, to)
+}
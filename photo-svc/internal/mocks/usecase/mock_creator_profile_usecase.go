@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./usecase/creator_profile_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./usecase/creator_profile_usecase.go -destination=./mocks/usecase/mock_creator_profile_usecase.go -package=mockusecase
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreatorProfileUseCase is a mock of CreatorProfileUseCase interface.
+type MockCreatorProfileUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatorProfileUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreatorProfileUseCaseMockRecorder is the mock recorder for MockCreatorProfileUseCase.
+type MockCreatorProfileUseCaseMockRecorder struct {
+	mock *MockCreatorProfileUseCase
+}
+
+// NewMockCreatorProfileUseCase creates a new mock instance.
+func NewMockCreatorProfileUseCase(ctrl *gomock.Controller) *MockCreatorProfileUseCase {
+	mock := &MockCreatorProfileUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreatorProfileUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreatorProfileUseCase) EXPECT() *MockCreatorProfileUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetCreatorProfile mocks base method.
+func (m *MockCreatorProfileUseCase) GetCreatorProfile(ctx context.Context, request *model.GetCreatorProfileRequest) (*model.CreatorProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorProfile", ctx, request)
+	ret0, _ := ret[0].(*model.CreatorProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorProfile indicates an expected call of GetCreatorProfile.
+func (mr *MockCreatorProfileUseCaseMockRecorder) GetCreatorProfile(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorProfile", reflect.TypeOf((*MockCreatorProfileUseCase)(nil).GetCreatorProfile), ctx, request)
+}
+
+// InvalidateCreatorProfile mocks base method.
+func (m *MockCreatorProfileUseCase) InvalidateCreatorProfile(ctx context.Context, creatorId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateCreatorProfile", ctx, creatorId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateCreatorProfile indicates an expected call of InvalidateCreatorProfile.
+func (mr *MockCreatorProfileUseCaseMockRecorder) InvalidateCreatorProfile(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateCreatorProfile", reflect.TypeOf((*MockCreatorProfileUseCase)(nil).InvalidateCreatorProfile), ctx, creatorId)
+}
+
+// InvalidateUserCreatorProfile mocks base method.
+func (m *MockCreatorProfileUseCase) InvalidateUserCreatorProfile(ctx context.Context, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateUserCreatorProfile", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateUserCreatorProfile indicates an expected call of InvalidateUserCreatorProfile.
+func (mr *MockCreatorProfileUseCaseMockRecorder) InvalidateUserCreatorProfile(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateUserCreatorProfile", reflect.TypeOf((*MockCreatorProfileUseCase)(nil).InvalidateUserCreatorProfile), ctx, userId)
+}
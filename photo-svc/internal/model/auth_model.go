@@ -8,4 +8,6 @@ type AuthResponse struct {
 	Similarity  uint32
 	CreatorId   string
 	WalletId    string
+	Roles       []string
+	Permissions []string
 }
@@ -38,3 +38,9 @@ type PhotoResponse struct {
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
 }
+
+type ListEventAttendeeUserIdsRequest struct {
+	BulkPhotoId string
+	AfterUserId string
+	Limit       int
+}
@@ -7,17 +7,21 @@ import (
 )
 
 type CheckoutItem struct {
-	PhotoId             string            `json:"photo_id"`
-	CreatorId           string            `json:"creator_id"`
-	Title               string            `json:"title"`
-	YourMomentsUrl      string            `json:"your_moments_url"`
-	Price               int32             `json:"price"`
-	Discount            int32             `json:"discount"`
-	DiscountMinQuantity int               `json:"discount_min_quantity"`
-	DiscountValue       int32             `json:"discount_value"`
-	DiscountId          string            `json:"discount_id"`
-	DiscountType        enum.DiscountType `json:"discount_type"`
-	FinalPrice          int32             `json:"final_price"`
+	PhotoId             string             `json:"photo_id"`
+	CreatorId           string             `json:"creator_id"`
+	Title               string             `json:"title"`
+	YourMomentsUrl      string             `json:"your_moments_url"`
+	Price               int32              `json:"price"`
+	Discount            int32              `json:"discount"`
+	DiscountMinQuantity int                `json:"discount_min_quantity"`
+	DiscountValue       int32              `json:"discount_value"`
+	DiscountId          string             `json:"discount_id"`
+	DiscountType        enum.DiscountType  `json:"discount_type"`
+	VoucherId           string             `json:"voucher_id"`
+	VoucherCode         string             `json:"voucher_code"`
+	VoucherDiscount     int32              `json:"voucher_discount"`
+	VoucherFundedBy     enum.VoucherFunder `json:"voucher_funded_by"`
+	FinalPrice          int32              `json:"final_price"`
 }
 
 type CheckoutItemWeb struct {
@@ -27,6 +31,7 @@ type CheckoutItemWeb struct {
 	YourMomentsUrl string        `json:"your_moments_url"`
 	Price          int32         `json:"price"`
 	Discount       *DiscountItem `json:"discount,omitempty"`
+	Voucher        *VoucherItem  `json:"voucher,omitempty"`
 	FinalPrice     int32         `json:"final_price"`
 }
 
@@ -38,42 +43,92 @@ type DiscountItem struct {
 	Type        enum.DiscountType `json:"type"`
 }
 
+type VoucherItem struct {
+	Id       string             `json:"id"`
+	Code     string             `json:"code"`
+	Amount   int32              `json:"amount"`
+	FundedBy enum.VoucherFunder `json:"funded_by"`
+}
+
 type PreviewCheckoutRequest struct {
-	UserId   string   `json:"user_id" validate:"required"`
-	PhotoIds []string `json:"photo_ids" validate:"required"`
+	UserId      string   `json:"user_id" validate:"required"`
+	PhotoIds    []string `json:"photo_ids" validate:"required"`
+	VoucherCode string   `json:"voucher_code" validate:"omitempty,alphanum,max=32"`
 }
 
 type CalculateRequest struct {
-	UserId    string   `json:"user_id" validate:"required"`
-	CreatorId string   `json:"creator_id" validate:"required"`
-	PhotoIds  []string `json:"photo_ids" validate:"required"`
+	UserId      string   `json:"user_id" validate:"required"`
+	CreatorId   string   `json:"creator_id" validate:"required"`
+	PhotoIds    []string `json:"photo_ids" validate:"required"`
+	VoucherCode string   `json:"voucher_code"`
 }
 
 type OwnerOwnPhotosRequest struct {
-	OwnerId  string   `json:"user_id" validate:"required"`
-	PhotoIds []string `json:"photo_ids" validate:"required"`
+	OwnerId       string   `json:"user_id" validate:"required"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids" validate:"required"`
 }
 
 type CancelPhotosRequest struct {
-	UserId   string   `json:"user_id" validate:"required"`
-	PhotoIds []string `json:"photo_ids" validate:"required"`
+	UserId        string   `json:"user_id" validate:"required"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids" validate:"required"`
 }
 
 type PreviewCheckoutResponse struct {
-	Items         *[]*CheckoutItemWeb `json:"items"`
-	TotalPrice    int32               `json:"total_price"`
-	TotalDiscount int32               `json:"total_discount"`
-	CreatedAt     *time.Time          `json:"created_at"`
+	Items          *[]*CheckoutItemWeb `json:"items"`
+	Voucher        *VoucherItem        `json:"voucher,omitempty"`
+	TotalPrice     int32               `json:"total_price"`
+	TotalDiscount  int32               `json:"total_discount"`
+	QuoteToken     string              `json:"quote_token"`
+	QuoteExpiresAt *time.Time          `json:"quote_expires_at"`
+	CreatedAt      *time.Time          `json:"created_at"`
+}
+
+// PriceQuote is the signed snapshot of a checkout preview, it is honoured by LockPhotosAndCalculatePriceV2 until it expires
+type PriceQuote struct {
+	Id              string             `json:"id"`
+	UserId          string             `json:"user_id"`
+	Items           []*PriceQuoteItem  `json:"items"`
+	TotalPrice      int32              `json:"total_price"`
+	TotalDiscount   int32              `json:"total_discount"`
+	VoucherId       string             `json:"voucher_id,omitempty"`
+	VoucherCode     string             `json:"voucher_code,omitempty"`
+	VoucherDiscount int32              `json:"voucher_discount,omitempty"`
+	VoucherFundedBy enum.VoucherFunder `json:"voucher_funded_by,omitempty"`
+	ExpiresAt       int64              `json:"expires_at"`
+}
+
+type PriceQuoteItem struct {
+	PhotoId             string            `json:"photo_id"`
+	CreatorId           string            `json:"creator_id"`
+	Price               int32             `json:"price"`
+	Discount            int32             `json:"discount,omitempty"`
+	DiscountId          string            `json:"discount_id,omitempty"`
+	DiscountType        enum.DiscountType `json:"discount_type,omitempty"`
+	DiscountMinQuantity int               `json:"discount_min_quantity,omitempty"`
+	DiscountValue       int32             `json:"discount_value,omitempty"`
+	VoucherDiscount     int32             `json:"voucher_discount,omitempty"`
+	FinalPrice          int32             `json:"final_price"`
 }
 
+// Total.Discount includes VoucherDiscount, QuoteId is set when a price quote was honoured
 type Total struct {
-	Price    int32
-	Discount int32
+	QuoteId         string
+	Price           int32
+	Discount        int32
+	VoucherId       string
+	VoucherCode     string
+	VoucherDiscount int32
+	VoucherFundedBy enum.VoucherFunder
 }
 
 type CalculateV2Request struct {
 	UserId        string            `validate:"required"`
 	CreatorId     string            `validate:"required"`
+	TransactionId string            `validate:"required"`
+	VoucherCode   string            `json:"voucher_code"`
+	QuoteToken    string            `json:"quote_token"`
 	Items         []CheckoutItemWeb `json:"items" validate:"required"`
 	TotalPrice    int32             `json:"total_price" validate:"required,gt=0"`
 	TotalDiscount int32             `json:"total_discount" validate:"required,gt=0"`
@@ -6,7 +6,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 )
 
-func CheckoutItemToResponse(checkoutItems *[]*model.CheckoutItem, totalPrice, totalDiscount int32, createdAt *time.Time) *model.PreviewCheckoutResponse {
+func CheckoutItemToResponse(checkoutItems *[]*model.CheckoutItem, total *model.Total, createdAt *time.Time) *model.PreviewCheckoutResponse {
 	chekoutItemsWeb := make([]*model.CheckoutItemWeb, 0, len(*checkoutItems))
 	for _, checkoutItem := range *checkoutItems {
 		var discount *model.DiscountItem
@@ -20,6 +20,16 @@ func CheckoutItemToResponse(checkoutItems *[]*model.CheckoutItem, totalPrice, to
 			}
 		}
 
+		var voucher *model.VoucherItem
+		if checkoutItem.VoucherDiscount != 0 && checkoutItem.VoucherId != "" {
+			voucher = &model.VoucherItem{
+				Id:       checkoutItem.VoucherId,
+				Code:     checkoutItem.VoucherCode,
+				Amount:   checkoutItem.VoucherDiscount,
+				FundedBy: checkoutItem.VoucherFundedBy,
+			}
+		}
+
 		checkoutItemWeb := &model.CheckoutItemWeb{
 			PhotoId:        checkoutItem.PhotoId,
 			CreatorId:      checkoutItem.CreatorId,
@@ -27,14 +37,58 @@ func CheckoutItemToResponse(checkoutItems *[]*model.CheckoutItem, totalPrice, to
 			YourMomentsUrl: checkoutItem.YourMomentsUrl,
 			Price:          checkoutItem.Price,
 			Discount:       discount,
+			Voucher:        voucher,
 			FinalPrice:     checkoutItem.FinalPrice,
 		}
 		chekoutItemsWeb = append(chekoutItemsWeb, checkoutItemWeb)
 	}
+
+	var voucher *model.VoucherItem
+	if total.VoucherId != "" {
+		voucher = &model.VoucherItem{
+			Id:       total.VoucherId,
+			Code:     total.VoucherCode,
+			Amount:   total.VoucherDiscount,
+			FundedBy: total.VoucherFundedBy,
+		}
+	}
+
 	return &model.PreviewCheckoutResponse{
 		Items:         &chekoutItemsWeb,
-		TotalPrice:    totalPrice,
-		TotalDiscount: totalDiscount,
+		Voucher:       voucher,
+		TotalPrice:    total.Price,
+		TotalDiscount: total.Discount,
 		CreatedAt:     createdAt,
 	}
 }
+
+func CheckoutItemToPriceQuote(quoteId, userId string, checkoutItems *[]*model.CheckoutItem, total *model.Total, expiresAt *time.Time) *model.PriceQuote {
+	items := make([]*model.PriceQuoteItem, 0, len(*checkoutItems))
+	for _, checkoutItem := range *checkoutItems {
+		items = append(items, &model.PriceQuoteItem{
+			PhotoId:             checkoutItem.PhotoId,
+			CreatorId:           checkoutItem.CreatorId,
+			Price:               checkoutItem.Price,
+			Discount:            checkoutItem.Discount,
+			DiscountId:          checkoutItem.DiscountId,
+			DiscountType:        checkoutItem.DiscountType,
+			DiscountMinQuantity: checkoutItem.DiscountMinQuantity,
+			DiscountValue:       checkoutItem.DiscountValue,
+			VoucherDiscount:     checkoutItem.VoucherDiscount,
+			FinalPrice:          checkoutItem.FinalPrice,
+		})
+	}
+
+	return &model.PriceQuote{
+		Id:              quoteId,
+		UserId:          userId,
+		Items:           items,
+		TotalPrice:      total.Price,
+		TotalDiscount:   total.Discount,
+		VoucherId:       total.VoucherId,
+		VoucherCode:     total.VoucherCode,
+		VoucherDiscount: total.VoucherDiscount,
+		VoucherFundedBy: total.VoucherFundedBy,
+		ExpiresAt:       expiresAt.Unix(),
+	}
+}
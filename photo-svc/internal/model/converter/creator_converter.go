@@ -5,14 +5,23 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 )
 
+func CreatorsToResponses(creators []*entity.Creator) []*model.CreatorResponse {
+	responses := make([]*model.CreatorResponse, 0, len(creators))
+	for _, creator := range creators {
+		responses = append(responses, CreatorToResponse(creator))
+	}
+	return responses
+}
+
 func CreatorToResponse(creator *entity.Creator) *model.CreatorResponse {
 	return &model.CreatorResponse{
-		Id:          creator.Id,
-		UserId:      creator.UserId,
-		Rating:      creator.Rating,
-		RatingCount: creator.RatingCount,
-		VerifiedAt:  creator.VerifiedAt,
-		CreatedAt:   creator.CreatedAt,
-		UpdatedAt:   creator.UpdatedAt,
+		Id:            creator.Id,
+		UserId:        creator.UserId,
+		Rating:        creator.Rating,
+		RatingCount:   creator.RatingCount,
+		FollowerCount: creator.FollowerCount,
+		VerifiedAt:    creator.VerifiedAt,
+		CreatedAt:     creator.CreatedAt,
+		UpdatedAt:     creator.UpdatedAt,
 	}
 }
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 )
 
@@ -14,6 +15,8 @@ func CreatorDiscountToResponse(discount *entity.CreatorDiscount) *model.CreatorD
 		DiscountType: discount.DiscountType,
 		Value:        discount.Value,
 		IsActive:     discount.IsActive,
+		StartsAt:     nullable.SQLTimeToPtr(discount.StartsAt),
+		EndsAt:       nullable.SQLTimeToPtr(discount.EndsAt),
 		CreatedAt:    discount.CreatedAt,
 		UpdatedAt:    discount.UpdatedAt,
 	}
@@ -30,6 +33,8 @@ func CreatorDiscountsToResponses(discounts []*entity.CreatorDiscount) *[]*model.
 			DiscountType: discount.DiscountType,
 			Value:        discount.Value,
 			IsActive:     discount.IsActive,
+			StartsAt:     nullable.SQLTimeToPtr(discount.StartsAt),
+			EndsAt:       nullable.SQLTimeToPtr(discount.EndsAt),
 			CreatedAt:    discount.CreatedAt,
 			UpdatedAt:    discount.UpdatedAt,
 		}
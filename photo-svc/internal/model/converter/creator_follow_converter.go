@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+)
+
+func CreatorFollowersToResponses(followers []*entity.CreatorFollow, profiles map[string]*userpb.PublicUserProfile) []*model.CreatorFollowerResponse {
+	responses := make([]*model.CreatorFollowerResponse, 0, len(followers))
+	for _, follower := range followers {
+		profile := profiles[follower.FollowerUserId]
+		responses = append(responses, &model.CreatorFollowerResponse{
+			UserId:     follower.FollowerUserId,
+			Username:   profile.GetUsername(),
+			Nickname:   profile.GetNickname(),
+			ProfileUrl: profile.GetProfileUrl(),
+			FollowedAt: follower.CreatedAt,
+		})
+	}
+	return responses
+}
+
+func FollowedCreatorsToResponses(creators []*entity.FollowedCreator, profiles map[string]*userpb.PublicUserProfile) []*model.FollowedCreatorResponse {
+	responses := make([]*model.FollowedCreatorResponse, 0, len(creators))
+	for _, creator := range creators {
+		profile := profiles[creator.UserId]
+		responses = append(responses, &model.FollowedCreatorResponse{
+			CreatorId:     creator.CreatorId,
+			UserId:        creator.UserId,
+			Username:      profile.GetUsername(),
+			Nickname:      profile.GetNickname(),
+			ProfileUrl:    profile.GetProfileUrl(),
+			FollowerCount: creator.FollowerCount,
+			IsVerified:    creator.VerifiedAt != nil,
+			FollowedAt:    creator.FollowedAt,
+		})
+	}
+	return responses
+}
+
+func FollowingFeedPhotosToResponses(photos []*entity.FollowingFeedPhoto, generateCDN func(string) string) []*model.FollowingFeedPhotoResponse {
+	responses := make([]*model.FollowingFeedPhotoResponse, 0, len(photos))
+	for _, photo := range photos {
+		responses = append(responses, &model.FollowingFeedPhotoResponse{
+			PhotoId:     photo.PhotoId,
+			CreatorId:   photo.CreatorId,
+			BulkPhotoId: photo.BulkPhotoId.String,
+			Title:       photo.Title,
+			Price:       photo.Price,
+			PriceStr:    photo.PriceStr,
+			Url:         generateCDN(photo.FileKey),
+			OriginalAt:  photo.OriginalAt,
+			PublishedAt: photo.CreatedAt,
+		})
+	}
+	return responses
+}
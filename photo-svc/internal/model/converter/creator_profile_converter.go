@@ -0,0 +1,94 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+
+	transactionpb "github.com/hervibest/be-yourmoments-backup/pb/transaction"
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+)
+
+func ToCreatorProfileResponse(creator *entity.Creator, profile *userpb.PublicUserProfile, summary *transactionpb.CreatorReviewSummary,
+	events []*entity.CreatorEvent, samples []*entity.CreatorSamplePhoto, discounts []*entity.CreatorDiscount,
+	generateCDN func(string) string) *model.CreatorProfileResponse {
+	return &model.CreatorProfileResponse{
+		CreatorId:       creator.Id,
+		UserId:          creator.UserId,
+		Username:        profile.GetUsername(),
+		Nickname:        profile.GetNickname(),
+		Biography:       profile.GetBiography(),
+		ProfileUrl:      profile.GetProfileUrl(),
+		ProfileCoverUrl: profile.GetProfileCoverUrl(),
+		SocialLinks:     ToCreatorSocialLinkResponses(profile.GetSocialLinks()),
+		FollowerCount:   creator.FollowerCount,
+		IsVerified:      creator.VerifiedAt != nil,
+		VerifiedAt:      creator.VerifiedAt,
+		Review:          ToCreatorReviewSummaryResponse(summary),
+		RecentEvents:    CreatorEventsToResponses(events, generateCDN),
+		SamplePhotos:    CreatorSamplePhotosToResponses(samples, generateCDN),
+		ActiveDiscounts: *CreatorDiscountsToResponses(discounts),
+	}
+}
+
+func ToCreatorSocialLinkResponses(socialLinks []*userpb.SocialLink) []*model.CreatorSocialLinkResponse {
+	responses := make([]*model.CreatorSocialLinkResponse, 0, len(socialLinks))
+	for _, socialLink := range socialLinks {
+		responses = append(responses, &model.CreatorSocialLinkResponse{
+			Name:    socialLink.GetName(),
+			LogoUrl: socialLink.GetLogoUrl(),
+			Handle:  socialLink.GetHandle(),
+			Url:     socialLink.GetUrl(),
+		})
+	}
+	return responses
+}
+
+func ToCreatorReviewSummaryResponse(summary *transactionpb.CreatorReviewSummary) *model.CreatorReviewSummaryResponse {
+	ratingBreakdown := make([]*model.RatingCountResponse, 0, len(summary.GetRatingBreakdown()))
+	for _, ratingCount := range summary.GetRatingBreakdown() {
+		ratingBreakdown = append(ratingBreakdown, &model.RatingCountResponse{
+			Rating: int(ratingCount.GetRating()),
+			Count:  int(ratingCount.GetCount()),
+		})
+	}
+
+	return &model.CreatorReviewSummaryResponse{
+		AverageRating:   summary.GetAverageRating(),
+		TotalReview:     int(summary.GetTotalReview()),
+		RatingBreakdown: ratingBreakdown,
+	}
+}
+
+func CreatorEventsToResponses(events []*entity.CreatorEvent, generateCDN func(string) string) []*model.CreatorEventResponse {
+	responses := make([]*model.CreatorEventResponse, 0, len(events))
+	for _, event := range events {
+		response := &model.CreatorEventResponse{
+			BulkPhotoId: event.BulkPhotoId,
+			TotalPhoto:  event.TotalPhoto,
+			StartedAt:   event.StartedAt,
+			CreatedAt:   event.CreatedAt,
+		}
+
+		if event.CoverFileKey.Valid {
+			response.CoverUrl = generateCDN(event.CoverFileKey.String)
+		}
+
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+func CreatorSamplePhotosToResponses(samples []*entity.CreatorSamplePhoto, generateCDN func(string) string) []*model.CreatorSamplePhotoResponse {
+	responses := make([]*model.CreatorSamplePhotoResponse, 0, len(samples))
+	for _, sample := range samples {
+		responses = append(responses, &model.CreatorSamplePhotoResponse{
+			PhotoId:    sample.PhotoId,
+			Title:      sample.Title,
+			Price:      sample.Price,
+			PriceStr:   sample.PriceStr,
+			Url:        generateCDN(sample.FileKey),
+			OriginalAt: sample.OriginalAt,
+		})
+	}
+	return responses
+}
@@ -57,3 +57,19 @@ func PhotoWithDetailsToGRPC(photoWithDetails *[]*entity.PhotoWithDetail, generat
 
 	return response
 }
+
+func ChatPhotoPreviewsToGRPC(previews []*entity.ChatPhotoPreview, generateCDN func(string) string) []*photopb.ChatPhotoPreview {
+	pbPreviews := make([]*photopb.ChatPhotoPreview, 0, len(previews))
+	for _, preview := range previews {
+		pbPreviews = append(pbPreviews, &photopb.ChatPhotoPreview{
+			PhotoId:    preview.PhotoId,
+			CreatorId:  preview.CreatorId,
+			Title:      preview.Title,
+			Price:      preview.Price,
+			PriceStr:   preview.PriceStr,
+			PreviewUrl: generateCDN(preview.FileKey),
+			IsBuyable:  preview.IsBuyable,
+		})
+	}
+	return pbPreviews
+}
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
+)
+
+func UserDataExportToGRPC(purchases []*entity.Photo, facecams []*entity.Facecam) *photopb.GetUserDataExportResponse {
+	pbPurchases := make([]*photopb.UserDataExportPurchase, 0, len(purchases))
+	for _, purchase := range purchases {
+		pbPurchases = append(pbPurchases, &photopb.UserDataExportPurchase{
+			PhotoId:    purchase.Id,
+			CreatorId:  purchase.CreatorId,
+			Title:      purchase.Title,
+			Price:      purchase.Price,
+			PriceStr:   purchase.PriceStr,
+			OriginalAt: timestamppb.New(purchase.OriginalAt),
+		})
+	}
+
+	pbFacecams := make([]*photopb.UserDataExportFacecam, 0, len(facecams))
+	for _, facecam := range facecams {
+		pbFacecams = append(pbFacecams, &photopb.UserDataExportFacecam{
+			Id:          facecam.Id,
+			FileName:    facecam.FileName,
+			Title:       facecam.Title,
+			Size:        facecam.Size,
+			IsProcessed: facecam.IsProcessed,
+			OriginalAt:  timestamppb.New(facecam.OriginalAt),
+			CreatedAt:   timestamppb.New(facecam.CreatedAt),
+		})
+	}
+
+	return &photopb.GetUserDataExportResponse{
+		Status:    int64(codes.OK),
+		Purchases: pbPurchases,
+		Facecams:  pbFacecams,
+	}
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+)
+
+func VoucherToResponse(voucher *entity.Voucher) *model.VoucherResponse {
+	return &model.VoucherResponse{
+		Id:                voucher.Id,
+		Code:              voucher.Code,
+		CreatorId:         nullable.ExtractString(voucher.CreatorId),
+		FundedBy:          voucher.FundedBy,
+		Name:              voucher.Name,
+		DiscountType:      voucher.DiscountType,
+		Value:             voucher.Value,
+		MaxDiscount:       nullable.SQLInt32ToPtr(voucher.MaxDiscount),
+		MinQuantity:       voucher.MinQuantity,
+		UsageLimit:        nullable.SQLInt32ToPtr(voucher.UsageLimit),
+		UsageLimitPerUser: nullable.SQLInt32ToPtr(voucher.UsageLimitPerUser),
+		IsStackable:       voucher.IsStackable,
+		IsActive:          voucher.IsActive,
+		StartsAt:          nullable.SQLTimeToPtr(voucher.StartsAt),
+		EndsAt:            nullable.SQLTimeToPtr(voucher.EndsAt),
+		CreatedAt:         voucher.CreatedAt,
+		UpdatedAt:         voucher.UpdatedAt,
+	}
+}
+
+func VouchersToResponses(vouchers []*entity.Voucher) *[]*model.VoucherResponse {
+	responses := make([]*model.VoucherResponse, 0, len(vouchers))
+	for _, voucher := range vouchers {
+		responses = append(responses, VoucherToResponse(voucher))
+	}
+	return &responses
+}
@@ -20,11 +20,149 @@ type UpdateCreatorTotalRatingRequest struct {
 }
 
 type CreatorResponse struct {
-	Id          string     `json:"id"`
-	UserId      string     `json:"user_id"`
-	Rating      float32    `json:"rating,omitempty"`
-	RatingCount int        `json:"rating_count,omitempty"`
-	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
-	CreatedAt   *time.Time `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	Id            string     `json:"id"`
+	UserId        string     `json:"user_id"`
+	Rating        float32    `json:"rating,omitempty"`
+	RatingCount   int        `json:"rating_count,omitempty"`
+	FollowerCount int        `json:"follower_count"`
+	VerifiedAt    *time.Time `json:"verified_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+}
+
+type ListCreatorUserIdsRequest struct {
+	AfterUserId string
+	Limit       int
+}
+
+type VerifyCreatorRequest struct {
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+	Verified  bool   `json:"verified"`
+}
+
+type GetCreatorProfileRequest struct {
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+}
+
+type RatingCountResponse struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+type CreatorReviewSummaryResponse struct {
+	AverageRating   float32                `json:"average_rating"`
+	TotalReview     int                    `json:"total_review"`
+	RatingBreakdown []*RatingCountResponse `json:"rating_breakdown"`
+}
+
+type CreatorEventResponse struct {
+	BulkPhotoId string    `json:"bulk_photo_id"`
+	TotalPhoto  int       `json:"total_photo"`
+	CoverUrl    string    `json:"cover_url,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreatorSamplePhotoResponse struct {
+	PhotoId    string    `json:"photo_id"`
+	Title      string    `json:"title"`
+	Price      int32     `json:"price"`
+	PriceStr   string    `json:"price_str"`
+	Url        string    `json:"url"`
+	OriginalAt time.Time `json:"original_at"`
+}
+
+type CreatorSocialLinkResponse struct {
+	Name    string `json:"name"`
+	LogoUrl string `json:"logo_url,omitempty"`
+	Handle  string `json:"handle"`
+	Url     string `json:"url"`
+}
+
+type CreatorProfileResponse struct {
+	CreatorId       string                        `json:"creator_id"`
+	UserId          string                        `json:"user_id"`
+	Username        string                        `json:"username"`
+	Nickname        string                        `json:"nickname"`
+	Biography       string                        `json:"biography,omitempty"`
+	ProfileUrl      string                        `json:"profile_url,omitempty"`
+	ProfileCoverUrl string                        `json:"profile_cover_url,omitempty"`
+	SocialLinks     []*CreatorSocialLinkResponse  `json:"social_links"`
+	FollowerCount   int                           `json:"follower_count"`
+	IsVerified      bool                          `json:"is_verified"`
+	VerifiedAt      *time.Time                    `json:"verified_at,omitempty"`
+	Review          *CreatorReviewSummaryResponse `json:"review"`
+	RecentEvents    []*CreatorEventResponse       `json:"recent_events"`
+	SamplePhotos    []*CreatorSamplePhotoResponse `json:"sample_photos"`
+	ActiveDiscounts []*CreatorDiscountResponse    `json:"active_discounts"`
+}
+
+type FollowCreatorRequest struct {
+	UserId    string `validate:"required"`
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+}
+
+type UnfollowCreatorRequest struct {
+	UserId    string `validate:"required"`
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+}
+
+type GetCreatorFollowRequest struct {
+	UserId    string `validate:"required"`
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+}
+
+type GetCreatorFollowersRequest struct {
+	CreatorId string `json:"creator_id" validate:"required,max=100"`
+	Cursor    string `json:"cursor"`
+	Size      int    `json:"size" validate:"required,min=1,max=50"`
+}
+
+type GetFollowingRequest struct {
+	UserId string `validate:"required"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=50"`
+}
+
+type GetFollowingFeedRequest struct {
+	UserId string `validate:"required"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=100"`
+}
+
+type CreatorFollowResponse struct {
+	CreatorId     string `json:"creator_id"`
+	IsFollowing   bool   `json:"is_following"`
+	FollowerCount int    `json:"follower_count"`
+}
+
+type CreatorFollowerResponse struct {
+	UserId     string    `json:"user_id"`
+	Username   string    `json:"username,omitempty"`
+	Nickname   string    `json:"nickname,omitempty"`
+	ProfileUrl string    `json:"profile_url,omitempty"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+type FollowedCreatorResponse struct {
+	CreatorId     string    `json:"creator_id"`
+	UserId        string    `json:"user_id"`
+	Username      string    `json:"username,omitempty"`
+	Nickname      string    `json:"nickname,omitempty"`
+	ProfileUrl    string    `json:"profile_url,omitempty"`
+	FollowerCount int       `json:"follower_count"`
+	IsVerified    bool      `json:"is_verified"`
+	FollowedAt    time.Time `json:"followed_at"`
+}
+
+type FollowingFeedPhotoResponse struct {
+	PhotoId     string    `json:"photo_id"`
+	CreatorId   string    `json:"creator_id"`
+	BulkPhotoId string    `json:"bulk_photo_id,omitempty"`
+	Title       string    `json:"title"`
+	Price       int32     `json:"price"`
+	PriceStr    string    `json:"price_str"`
+	Url         string    `json:"url"`
+	OriginalAt  time.Time `json:"original_at"`
+	PublishedAt time.Time `json:"published_at"`
 }
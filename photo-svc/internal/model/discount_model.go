@@ -13,6 +13,8 @@ type CreateCreatorDiscountRequest struct {
 	DiscountType enum.DiscountType `json:"discount_type" validate:"required"`
 	Value        int32             `json:"value" validate:"required"`
 	IsActive     bool              `json:"is_active" validate:"required"`
+	StartsAt     *time.Time        `json:"starts_at"`
+	EndsAt       *time.Time        `json:"ends_at"`
 }
 
 type CreatorDiscountResponse struct {
@@ -23,6 +25,8 @@ type CreatorDiscountResponse struct {
 	DiscountType enum.DiscountType `json:"discount_type"`
 	Value        int32             `json:"value"`
 	IsActive     bool              `json:"is_active"`
+	StartsAt     *time.Time        `json:"starts_at"`
+	EndsAt       *time.Time        `json:"ends_at"`
 	CreatedAt    *time.Time        `json:"created_at,omitempty"`
 	UpdatedAt    *time.Time        `json:"updated_at,omitempty"`
 }
@@ -8,3 +8,14 @@ type CreatorEvent struct {
 	CreatedAt *time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at" db:"updated_at"`
 }
+
+// CreatorBatchPublishedEvent tells the followers of a creator that a new batch of photos is available,
+// followers of a big creator are split across several events
+type CreatorBatchPublishedEvent struct {
+	EventID         string    `json:"uuid"`
+	CreatorId       string    `json:"creator_id"`
+	BulkPhotoId     string    `json:"bulk_photo_id"`
+	TotalPhoto      int       `json:"total_photo"`
+	FollowerUserIds []string  `json:"follower_user_ids"`
+	PublishedAt     time.Time `json:"published_at"`
+}
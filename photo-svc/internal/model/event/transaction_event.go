@@ -1,11 +1,13 @@
 package event
 
 type CancelPhotosEvent struct {
-	UserId   string   `json:"user_id"`
-	PhotoIds []string `json:"photo_ids"`
+	UserId        string   `json:"user_id"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids"`
 }
 
 type OwnerOwnPhotosEvent struct {
-	UserId   string   `json:"user_id"`
-	PhotoIds []string `json:"photo_ids"`
+	UserId        string   `json:"user_id"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids"`
 }
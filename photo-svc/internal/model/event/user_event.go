@@ -8,3 +8,14 @@ type UserEvent struct {
 	CreatedAt *time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at" db:"updated_at"`
 }
+
+type UserDeletedEvent struct {
+	Id          string     `json:"id"`
+	PseudonymId string     `json:"pseudonym_id"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+}
+
+type UserProfileUpdatedEvent struct {
+	Id        string     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
@@ -67,14 +67,35 @@ type ExploreUserSimilarResponse struct {
 	OriginalAt time.Time                `json:"original_at"`
 	CreatedAt  time.Time                `json:"created_at"`
 	UpdatedAt  time.Time                `json:"updated_at"`
+	Ranking    *ExploreRankingResponse  `json:"ranking,omitempty"`
+}
+
+// ExploreRankingResponse explains how an explore photo was ranked, it is only returned in debug mode
+type ExploreRankingResponse struct {
+	Score         float64  `json:"score"`
+	Similarity    float64  `json:"similarity"`
+	Recency       float64  `json:"recency"`
+	CreatorRating float64  `json:"creator_rating"`
+	Engagement    float64  `json:"engagement"`
+	Attended      float64  `json:"attended"`
+	Reasons       []string `json:"reasons"`
 }
 
 type GetAllExploreSimilarRequest struct {
 	UserId     string `validate:"required"`
 	Similarity uint32 `validate:"required"`
 	CreatorId  string `validate:"required"`
-	Page       int    `json:"page" validate:"required"`
-	Size       int    `json:"size" validate:"required"`
+	Page       int    `json:"page" validate:"omitempty,min=1"`
+	Cursor     string `json:"cursor"`
+	Size       int    `json:"size" validate:"required,min=1,max=100"`
+	Debug      bool   `json:"debug"`
+}
+
+// ExploreCandidateKey is the position of an explore candidate in the repository scan order
+type ExploreCandidateKey struct {
+	Similarity uint32    `json:"similarity"`
+	OriginalAt time.Time `json:"original_at"`
+	PhotoId    string    `json:"photo_id"`
 }
 
 type GetAllWishlistRequest struct {
@@ -85,6 +106,15 @@ type GetAllWishlistRequest struct {
 	Size       int    `json:"size" validate:"required"`
 }
 
+// GetAllStagePhotoByCursorRequest lists wishlist, favorite or cart photos with keyset pagination
+type GetAllStagePhotoByCursorRequest struct {
+	UserId     string `validate:"required"`
+	Similarity uint32 `validate:"required"`
+	CreatorId  string `validate:"required"`
+	Cursor     string `json:"cursor"`
+	Size       int    `json:"size" validate:"required,min=1,max=100"`
+}
+
 type UserAddWishlistRequest struct {
 	UserId  string `json:"user_id" validate:"required"`
 	PhotoId string `json:"photo_id" validate:"required"`
@@ -1,9 +1,10 @@
 package model
 
 type WebResponse[T any] struct {
-	Success      bool          `json:"success"`
-	Data         T             `json:"data,omitempty"`
-	PageMetadata *PageMetadata `json:"pagination,omitempty"`
+	Success        bool            `json:"success"`
+	Data           T               `json:"data,omitempty"`
+	PageMetadata   *PageMetadata   `json:"pagination,omitempty"`
+	CursorMetadata *CursorMetadata `json:"cursor,omitempty"`
 }
 
 type PageMetadata struct {
@@ -18,6 +19,13 @@ type PageMetadata struct {
 	PreviousPageURL string `json:"previous_page_url"`
 }
 
+type CursorMetadata struct {
+	Size        int    `json:"size"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasMore     bool   `json:"has_more"`
+	NextPageURL string `json:"next_page_url,omitempty"`
+}
+
 type BodyParseErrorResponse struct {
 	Success bool        `json:"success"`
 	Message string      `json:"message,omitempty"`
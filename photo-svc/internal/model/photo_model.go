@@ -12,3 +12,8 @@ type RequestClaimPhoto struct {
 	Id     string
 	UserId string
 }
+
+type GetChatPhotoPreviewsRequest struct {
+	UserId   string
+	PhotoIds []string
+}
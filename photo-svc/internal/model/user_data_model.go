@@ -0,0 +1,6 @@
+package model
+
+type EraseUserDataRequest struct {
+	UserId      string
+	PseudonymId string
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+)
+
+type CreateVoucherRequest struct {
+	// CreatorId is empty on the admin routes and CanFundPlatform comes from the caller permissions,
+	// neither is read from the body.
+	CreatorId         string             `json:"-"`
+	CanFundPlatform   bool               `json:"-"`
+	FundedBy          enum.VoucherFunder `json:"funded_by" validate:"omitempty,oneof=CREATOR PLATFORM"`
+	Code              string             `json:"code" validate:"required,alphanum,min=4,max=32"`
+	Name              string             `json:"name" validate:"required,max=100"`
+	DiscountType      enum.DiscountType  `json:"discount_type" validate:"required"`
+	Value             int32              `json:"value" validate:"required,gt=0"`
+	MaxDiscount       *int32             `json:"max_discount" validate:"omitempty,gt=0"`
+	MinQuantity       int                `json:"min_quantity" validate:"omitempty,gte=1"`
+	UsageLimit        *int32             `json:"usage_limit" validate:"omitempty,gt=0"`
+	UsageLimitPerUser *int32             `json:"usage_limit_per_user" validate:"omitempty,gt=0"`
+	IsStackable       bool               `json:"is_stackable"`
+	IsActive          bool               `json:"is_active"`
+	StartsAt          *time.Time         `json:"starts_at"`
+	EndsAt            *time.Time         `json:"ends_at"`
+}
+
+type VoucherResponse struct {
+	Id                string             `json:"id"`
+	Code              string             `json:"code"`
+	CreatorId         string             `json:"creator_id,omitempty"`
+	FundedBy          enum.VoucherFunder `json:"funded_by"`
+	Name              string             `json:"name"`
+	DiscountType      enum.DiscountType  `json:"discount_type"`
+	Value             int32              `json:"value"`
+	MaxDiscount       *int32             `json:"max_discount"`
+	MinQuantity       int                `json:"min_quantity"`
+	UsageLimit        *int32             `json:"usage_limit"`
+	UsageLimitPerUser *int32             `json:"usage_limit_per_user"`
+	IsStackable       bool               `json:"is_stackable"`
+	IsActive          bool               `json:"is_active"`
+	StartsAt          *time.Time         `json:"starts_at"`
+	EndsAt            *time.Time         `json:"ends_at"`
+	CreatedAt         *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time         `json:"updated_at,omitempty"`
+}
+
+type GetVoucherRequest struct {
+	Id        string `json:"id" validate:"required"`
+	CreatorId string `json:"creator_id"`
+}
+
+type ActivateVoucherRequest struct {
+	Id        string `json:"id" validate:"required"`
+	CreatorId string `json:"creator_id"`
+}
+
+type DeactivateVoucherRequest struct {
+	Id        string `json:"id" validate:"required"`
+	CreatorId string `json:"creator_id"`
+}
@@ -10,6 +10,7 @@ type BulkPhotoRepository interface {
 	Create(ctx context.Context, tx Querier, bulkPhoto *entity.BulkPhoto) (*entity.BulkPhoto, error)
 	FindDetailById(ctx context.Context, tx Querier, bulkPhotoID, creatorID string) (*[]*entity.BulkPhotoDetail, error)
 	Update(ctx context.Context, tx Querier, bulkPhoto *entity.BulkPhoto) (*entity.BulkPhoto, error)
+	FindRecentEventByCreatorId(ctx context.Context, tx Querier, creatorId string, limit int) ([]*entity.CreatorEvent, error)
 }
 type bulkPhotoRepository struct{}
 
@@ -78,3 +79,39 @@ func (r *bulkPhotoRepository) Update(ctx context.Context, tx Querier, bulkPhoto
 
 	return bulkPhoto, err
 }
+
+// FindRecentEventByCreatorId treats every bulk upload as one event and picks the
+// latest watermarked photo of that upload as its cover.
+func (r *bulkPhotoRepository) FindRecentEventByCreatorId(ctx context.Context, tx Querier, creatorId string, limit int) ([]*entity.CreatorEvent, error) {
+	events := make([]*entity.CreatorEvent, 0)
+	query := `
+	SELECT 
+		bp.id AS bulk_photo_id,
+		COUNT(p.id) AS total_photo,
+		cover.file_key AS cover_file_key,
+		MIN(p.original_at) AS started_at,
+		bp.created_at
+
+	FROM bulk_photos AS bp
+	JOIN photos AS p ON p.bulk_photo_id = bp.id
+
+	LEFT JOIN LATERAL (
+		SELECT pd.file_key
+		FROM photos AS cp
+		JOIN photo_details AS pd ON pd.photo_id = cp.id AND pd.your_moments_type = 'YOU'::your_moments_type
+		WHERE cp.bulk_photo_id = bp.id AND cp.owned_by_user_id IS NULL
+		ORDER BY cp.original_at DESC
+		LIMIT 1
+	) cover ON TRUE
+
+	WHERE bp.creator_id = $1
+	AND bp.bulk_photo_status NOT IN ('FAILED', 'CANCELED')
+	GROUP BY bp.id, bp.created_at, cover.file_key
+	ORDER BY bp.created_at DESC
+	LIMIT $2
+	`
+	if err := tx.SelectContext(ctx, &events, query, creatorId, limit); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -15,6 +15,7 @@ type discountPreparedStmt struct {
 func newDiscountPreparedStmt(db *sqlx.DB) (*discountPreparedStmt, error) {
 	findManyByCreatorIdsStmt, err := db.Preparex(`
 	SELECT * FROM creator_discounts WHERE creator_id = ANY($1) AND is_active = true 
+	AND (starts_at IS NULL OR starts_at <= now()) AND (ends_at IS NULL OR ends_at > now())
 	ORDER BY creator_id, min_quantity DESC`)
 	if err != nil {
 		return nil, err
@@ -52,12 +53,12 @@ func NewCreatorDiscountRepository(db *sqlx.DB) (CreatorDiscountRepository, error
 func (r *creatorDiscountRepository) Create(ctx context.Context, tx Querier, discount *entity.CreatorDiscount) (*entity.CreatorDiscount, error) {
 	query := `
 	INSERT INTO creator_discounts 
-	(id, creator_id, name, min_quantity, discount_type, value, is_active, created_at, updated_at) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	(id, creator_id, name, min_quantity, discount_type, value, is_active, starts_at, ends_at, created_at, updated_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
 	`
 	_, err := tx.ExecContext(ctx, query, discount.Id, discount.CreatorId, discount.Name,
 		discount.MinQuantity, discount.DiscountType, discount.Value, discount.IsActive,
-		discount.CreatedAt, discount.UpdatedAt)
+		discount.StartsAt, discount.EndsAt, discount.CreatedAt, discount.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+)
+
+type CreatorFollowRepository interface {
+	Insert(ctx context.Context, tx Querier, creatorFollow *entity.CreatorFollow) (bool, error)
+	Delete(ctx context.Context, tx Querier, followerUserId, creatorId string) (bool, error)
+	IsFollowing(ctx context.Context, tx Querier, followerUserId, creatorId string) (bool, error)
+	FindFollowersByCursor(ctx context.Context, tx Querier, creatorId string, cursor *pagination.Cursor,
+		size int) ([]*entity.CreatorFollow, *model.CursorMetadata, error)
+	FindFollowingByCursor(ctx context.Context, tx Querier, followerUserId string, cursor *pagination.Cursor,
+		size int) ([]*entity.FollowedCreator, *model.CursorMetadata, error)
+	FindFeedByCursor(ctx context.Context, tx Querier, followerUserId string, since time.Time, cursor *pagination.Cursor,
+		size int) ([]*entity.FollowingFeedPhoto, *model.CursorMetadata, error)
+	FindAllFollowerUserIds(ctx context.Context, tx Querier, creatorId string) ([]string, error)
+	DeleteByFollowerUserId(ctx context.Context, tx Querier, followerUserId string) error
+}
+
+type creatorFollowRepository struct{}
+
+func NewCreatorFollowRepository() CreatorFollowRepository {
+	return &creatorFollowRepository{}
+}
+
+// Insert returns false when the user already follows the creator
+func (r *creatorFollowRepository) Insert(ctx context.Context, tx Querier, creatorFollow *entity.CreatorFollow) (bool, error) {
+	query := `
+	INSERT INTO creator_follows (follower_user_id, creator_id, created_at) 
+	VALUES ($1, $2, $3) 
+	ON CONFLICT (follower_user_id, creator_id) DO NOTHING`
+	result, err := tx.ExecContext(ctx, query, creatorFollow.FollowerUserId, creatorFollow.CreatorId, creatorFollow.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// Delete returns false when the user was not following the creator
+func (r *creatorFollowRepository) Delete(ctx context.Context, tx Querier, followerUserId, creatorId string) (bool, error) {
+	query := `DELETE FROM creator_follows WHERE follower_user_id = $1 AND creator_id = $2`
+	result, err := tx.ExecContext(ctx, query, followerUserId, creatorId)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *creatorFollowRepository) IsFollowing(ctx context.Context, tx Querier, followerUserId, creatorId string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM creator_follows WHERE follower_user_id = $1 AND creator_id = $2)`
+	if err := tx.GetContext(ctx, &exists, query, followerUserId, creatorId); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// FindFollowersByCursor lists the followers of a creator from the newest, sorted by follow time and follower user id
+func (r *creatorFollowRepository) FindFollowersByCursor(ctx context.Context, tx Querier, creatorId string, cursor *pagination.Cursor,
+	size int) ([]*entity.CreatorFollow, *model.CursorMetadata, error) {
+	query := `SELECT follower_user_id, creator_id, created_at FROM creator_follows WHERE creator_id = $1`
+	args := []interface{}{creatorId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, follower_user_id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY created_at DESC, follower_user_id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	followers := make([]*entity.CreatorFollow, 0)
+	if err := tx.SelectContext(ctx, &followers, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(followers) > size
+	if hasMore {
+		followers = followers[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := followers[len(followers)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.FollowerUserId)
+	}
+
+	return followers, cursorMetadata, nil
+}
+
+// FindFollowingByCursor lists the creators followed by a user from the latest followed, sorted by follow time and creator id
+func (r *creatorFollowRepository) FindFollowingByCursor(ctx context.Context, tx Querier, followerUserId string, cursor *pagination.Cursor,
+	size int) ([]*entity.FollowedCreator, *model.CursorMetadata, error) {
+	query := `
+	SELECT 
+		c.id AS creator_id,
+		c.user_id,
+		c.follower_count,
+		c.verified_at,
+		cf.created_at AS followed_at
+	FROM creator_follows AS cf
+	JOIN creators AS c ON c.id = cf.creator_id
+	WHERE cf.follower_user_id = $1`
+	args := []interface{}{followerUserId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (cf.created_at, cf.creator_id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY cf.created_at DESC, cf.creator_id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	creators := make([]*entity.FollowedCreator, 0)
+	if err := tx.SelectContext(ctx, &creators, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(creators) > size
+	if hasMore {
+		creators = creators[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := creators[len(creators)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.FollowedAt.Format(time.RFC3339Nano), last.CreatorId)
+	}
+
+	return creators, cursorMetadata, nil
+}
+
+// FindFeedByCursor lists the unsold public previews published since the given time by the creators a user follows,
+// sorted by publish time and photo id from the newest
+func (r *creatorFollowRepository) FindFeedByCursor(ctx context.Context, tx Querier, followerUserId string, since time.Time,
+	cursor *pagination.Cursor, size int) ([]*entity.FollowingFeedPhoto, *model.CursorMetadata, error) {
+	query := `
+	SELECT 
+		p.id AS photo_id,
+		p.creator_id,
+		p.bulk_photo_id,
+		p.title,
+		p.price,
+		p.price_str,
+		pd.file_key,
+		p.original_at,
+		p.created_at
+	FROM creator_follows AS cf
+	JOIN photos AS p ON p.creator_id = cf.creator_id
+	JOIN photo_details AS pd ON pd.photo_id = p.id AND pd.your_moments_type = 'YOU'::your_moments_type
+	WHERE cf.follower_user_id = $1
+	AND p.owned_by_user_id IS NULL
+	AND p.status = $2
+	AND p.created_at >= $3`
+	args := []interface{}{followerUserId, enum.PhotoStatusAvailableEnum, since}
+	argIndex := 4
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (p.created_at, p.id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY p.created_at DESC, p.id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	photos := make([]*entity.FollowingFeedPhoto, 0)
+	if err := tx.SelectContext(ctx, &photos, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(photos) > size
+	if hasMore {
+		photos = photos[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := photos[len(photos)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.PhotoId)
+	}
+
+	return photos, cursorMetadata, nil
+}
+
+func (r *creatorFollowRepository) FindAllFollowerUserIds(ctx context.Context, tx Querier, creatorId string) ([]string, error) {
+	followerUserIds := make([]string, 0)
+	query := `SELECT follower_user_id FROM creator_follows WHERE creator_id = $1`
+	if err := tx.SelectContext(ctx, &followerUserIds, query, creatorId); err != nil {
+		return nil, err
+	}
+	return followerUserIds, nil
+}
+
+// DeleteByFollowerUserId removes every follow of a user and decrements the follower count of the followed creators
+func (r *creatorFollowRepository) DeleteByFollowerUserId(ctx context.Context, tx Querier, followerUserId string) error {
+	query := `
+	WITH deleted AS (
+		DELETE FROM creator_follows WHERE follower_user_id = $1 RETURNING creator_id
+	)
+	UPDATE creators AS c
+	SET follower_count = GREATEST(c.follower_count - 1, 0), updated_at = now()
+	FROM deleted AS d
+	WHERE c.id = d.creator_id`
+	if _, err := tx.ExecContext(ctx, query, followerUserId); err != nil {
+		return err
+	}
+	return nil
+}
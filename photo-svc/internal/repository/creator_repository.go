@@ -7,6 +7,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type creatorPreparedStmt struct {
@@ -27,8 +28,13 @@ func newCreatorPreparedStmt(db *sqlx.DB) (*creatorPreparedStmt, error) {
 type CreatorRepository interface {
 	Create(ctx context.Context, tx Querier, creator *entity.Creator) (*entity.Creator, error)
 	FindByUserId(ctx context.Context, userId string) (*entity.Creator, error)
+	FindById(ctx context.Context, tx Querier, creatorId string) (*entity.Creator, error)
 	FindIdByUserId(ctx context.Context, tx Querier, userId string) (string, error)
+	FindManyByIds(ctx context.Context, tx Querier, creatorIds []string) ([]*entity.Creator, error)
+	FindUserIds(ctx context.Context, tx Querier, afterUserId string, limit int) ([]string, error)
 	UpdateCreatorRating(ctx context.Context, tx Querier, creator *entity.Creator) (*entity.Creator, error)
+	UpdateVerifiedAt(ctx context.Context, tx Querier, creator *entity.Creator) (*entity.Creator, error)
+	AddFollowerCount(ctx context.Context, tx Querier, creatorId string, delta int) error
 }
 
 type creatorRepository struct {
@@ -69,6 +75,16 @@ func (r *creatorRepository) FindByUserId(ctx context.Context, userId string) (*e
 	return creator, nil
 }
 
+func (r *creatorRepository) FindById(ctx context.Context, tx Querier, creatorId string) (*entity.Creator, error) {
+	creator := new(entity.Creator)
+	query := "SELECT * FROM creators WHERE id = $1"
+	if err := tx.GetContext(ctx, creator, query, creatorId); err != nil {
+		return nil, err
+	}
+
+	return creator, nil
+}
+
 func (r *creatorRepository) FindIdByUserId(ctx context.Context, tx Querier, userId string) (string, error) {
 	var creatorId string
 	query := "SELECT id FROM creators WHERE user_id = $1"
@@ -79,6 +95,27 @@ func (r *creatorRepository) FindIdByUserId(ctx context.Context, tx Querier, user
 	return creatorId, nil
 }
 
+func (r *creatorRepository) FindManyByIds(ctx context.Context, tx Querier, creatorIds []string) ([]*entity.Creator, error) {
+	creators := make([]*entity.Creator, 0, len(creatorIds))
+	query := "SELECT * FROM creators WHERE id = ANY($1)"
+	if err := tx.SelectContext(ctx, &creators, query, pq.Array(creatorIds)); err != nil {
+		return nil, err
+	}
+
+	return creators, nil
+}
+
+// FindUserIds lists the user ids owning a creator account ordered by user id, starting after afterUserId
+func (r *creatorRepository) FindUserIds(ctx context.Context, tx Querier, afterUserId string, limit int) ([]string, error) {
+	userIds := make([]string, 0, limit)
+	query := "SELECT user_id FROM creators WHERE user_id > $1 ORDER BY user_id LIMIT $2"
+	if err := tx.SelectContext(ctx, &userIds, query, afterUserId, limit); err != nil {
+		return nil, err
+	}
+
+	return userIds, nil
+}
+
 func (r *creatorRepository) UpdateCreatorRating(ctx context.Context, tx Querier, creator *entity.Creator) (*entity.Creator, error) {
 	query := `
 		UPDATE creators
@@ -97,3 +134,21 @@ func (r *creatorRepository) UpdateCreatorRating(ctx context.Context, tx Querier,
 
 	return creator, nil
 }
+
+func (r *creatorRepository) UpdateVerifiedAt(ctx context.Context, tx Querier, creator *entity.Creator) (*entity.Creator, error) {
+	query := "UPDATE creators SET verified_at = $1, updated_at = $2 WHERE id = $3 RETURNING *"
+	if err := tx.GetContext(ctx, creator, query, creator.VerifiedAt, creator.UpdatedAt, creator.Id); err != nil {
+		return nil, err
+	}
+
+	return creator, nil
+}
+
+func (r *creatorRepository) AddFollowerCount(ctx context.Context, tx Querier, creatorId string, delta int) error {
+	query := "UPDATE creators SET follower_count = GREATEST(follower_count + $1, 0), updated_at = now() WHERE id = $2"
+	if _, err := tx.ExecContext(ctx, query, delta, creatorId); err != nil {
+		return fmt.Errorf("failed to update creator follower count: %w", err)
+	}
+
+	return nil
+}
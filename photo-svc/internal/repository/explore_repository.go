@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
@@ -18,6 +20,11 @@ import (
 type ExploreRepository interface {
 	FindAllExploreSimilar(ctx context.Context, tx Querier, page, size int, similarity uint32, userId, creatorId string, isWishlist, isFavorite,
 		isCart bool) ([]*entity.Explore, *model.PageMetadata, error)
+	FindAllExploreStageByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, similarity uint32, userId, creatorId string,
+		stage enum.PhotoStageEnum) ([]*entity.Explore, *model.CursorMetadata, error)
+	FindExploreCandidates(ctx context.Context, tx Querier, similarity, confidentSimilarity uint32, userId, creatorId string,
+		after *model.ExploreCandidateKey, limit int) ([]*entity.ExploreCandidate, error)
+	CountExploreCandidates(ctx context.Context, tx Querier, similarity uint32, userId, creatorId string) (int64, error)
 	UserAddStage(ctx context.Context, tx Querier, photoId, userId string, stage enum.PhotoStageEnum) error
 	UserDeleteStage(ctx context.Context, tx Querier, photoId, userId string, stage enum.PhotoStageEnum) error
 }
@@ -25,40 +32,7 @@ type ExploreRepository interface {
 type exploreRepository struct {
 }
 
-func NewExploreRepository(db *sqlx.DB) (ExploreRepository, error) {
-	return &exploreRepository{}, nil
-}
-
-func (r *exploreRepository) FindAllExploreSimilar(ctx context.Context, tx Querier, page, size int,
-	similarity uint32, userId, creatorId string, isWishlist, isFavorite,
-	isCart bool) ([]*entity.Explore, *model.PageMetadata, error) {
-	results := make([]*entity.Explore, 0)
-
-	var totalItems int
-	countQuery := `
-	SELECT 
-		COUNT(*) 
-	FROM 
-		user_similar_photos 
-	AS usp 
-	JOIN 
-		photos 
-	AS p on p.id = usp.photo_id 
-	WHERE 
-		usp.user_id = $1
-	AND 
-		usp.similarity >= $2
-	AND 
-		p.creator_id != $3
-	AND 
-		(p.status = 'AVAILABLE' OR p.status= 'SOLD')
-	AND 
-		(p.owned_by_user_id IS NULL)
-	 `
-
-	var countArgs []interface{}
-
-	query := `
+const exploreBaseQuery = `
 	SELECT 
 		usp.photo_id,
 		usp.user_id,
@@ -126,6 +100,41 @@ func (r *exploreRepository) FindAllExploreSimilar(ctx context.Context, tx Querie
 	AND (p.owned_by_user_id IS NULL)
 	`
 
+func NewExploreRepository(db *sqlx.DB) (ExploreRepository, error) {
+	return &exploreRepository{}, nil
+}
+
+func (r *exploreRepository) FindAllExploreSimilar(ctx context.Context, tx Querier, page, size int,
+	similarity uint32, userId, creatorId string, isWishlist, isFavorite,
+	isCart bool) ([]*entity.Explore, *model.PageMetadata, error) {
+	results := make([]*entity.Explore, 0)
+
+	var totalItems int
+	countQuery := `
+	SELECT 
+		COUNT(*) 
+	FROM 
+		user_similar_photos 
+	AS usp 
+	JOIN 
+		photos 
+	AS p on p.id = usp.photo_id 
+	WHERE 
+		usp.user_id = $1
+	AND 
+		usp.similarity >= $2
+	AND 
+		p.creator_id != $3
+	AND 
+		(p.status = 'AVAILABLE' OR p.status= 'SOLD')
+	AND 
+		(p.owned_by_user_id IS NULL)
+	 `
+
+	var countArgs []interface{}
+
+	query := exploreBaseQuery
+
 	if isWishlist {
 		countQuery += " AND usp.is_wishlist = TRUE "
 		query += " AND usp.is_wishlist = TRUE "
@@ -164,6 +173,215 @@ func (r *exploreRepository) FindAllExploreSimilar(ctx context.Context, tx Querie
 	return results, pageMetadata, nil
 }
 
+// FindAllExploreStageByCursor is the keyset paginated version of FindAllExploreSimilar for wishlist, favorite and cart,
+// photos are sorted by original_at and photo id from the newest
+func (r *exploreRepository) FindAllExploreStageByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int,
+	similarity uint32, userId, creatorId string, stage enum.PhotoStageEnum) ([]*entity.Explore, *model.CursorMetadata, error) {
+	query := exploreBaseQuery
+
+	switch stage {
+	case enum.PhotoStageWishlist:
+		query += " AND usp.is_wishlist = TRUE "
+	case enum.PhotoStageFavorite:
+		query += " AND usp.is_favorite = TRUE "
+	case enum.PhotoStageCart:
+		query += " AND usp.is_cart = TRUE "
+	default:
+		return nil, nil, errors.New("invalid photo stage")
+	}
+
+	args := []interface{}{userId, similarity, creatorId}
+	argIndex := 4
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (p.original_at, usp.photo_id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	// One extra row is fetched to know whether there is a next page without counting
+	query += " ORDER BY p.original_at DESC, usp.photo_id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	results := make([]*entity.Explore, 0)
+	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(results) > size
+	if hasMore {
+		results = results[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := results[len(results)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.OriginalAt.Format(time.RFC3339Nano), last.PhotoId)
+	}
+
+	return results, cursorMetadata, nil
+}
+
+// FindExploreCandidates returns the explore photos of a user with the signals used by the explore ranking.
+// A bulk upload is treated as one event, so the event signals count the photos the user owns and the confident matches
+// (similarity >= confidentSimilarity) the user has in the same bulk upload.
+// Candidates are returned most similar and most recent first, after continues the scan from the key of the last candidate
+// of the previous call.
+func (r *exploreRepository) FindExploreCandidates(ctx context.Context, tx Querier, similarity, confidentSimilarity uint32,
+	userId, creatorId string, after *model.ExploreCandidateKey, limit int) ([]*entity.ExploreCandidate, error) {
+	query := `
+	WITH creator_affinity AS (
+		SELECT
+			p2.creator_id,
+			COUNT(*) AS total
+		FROM user_similar_photos AS usp2
+		JOIN photos AS p2 ON p2.id = usp2.photo_id
+		WHERE usp2.user_id = $1
+		AND (usp2.is_wishlist = TRUE OR usp2.is_favorite = TRUE OR usp2.is_cart = TRUE)
+		GROUP BY p2.creator_id
+	),
+	event_owned AS (
+		SELECT
+			bulk_photo_id,
+			COUNT(*) AS total
+		FROM photos
+		WHERE owned_by_user_id = $1
+		AND bulk_photo_id IS NOT NULL
+		GROUP BY bulk_photo_id
+	),
+	event_match AS (
+		SELECT
+			p3.bulk_photo_id,
+			COUNT(*) AS total
+		FROM user_similar_photos AS usp3
+		JOIN photos AS p3 ON p3.id = usp3.photo_id
+		WHERE usp3.user_id = $1
+		AND usp3.similarity >= $4
+		AND p3.bulk_photo_id IS NOT NULL
+		GROUP BY p3.bulk_photo_id
+	)
+	SELECT 
+		usp.photo_id,
+		usp.user_id,
+		usp.similarity,
+		usp.is_wishlist,
+		usp.is_resend,
+		usp.is_cart,
+		usp.is_favorite,
+
+		p.creator_id,
+		p.title,
+		p.is_this_you_url,
+		p.price,
+		p.price_str,
+		p.original_at,
+		p.created_at,
+		p.updated_at,
+
+		cd.name,
+		cd.min_quantity,
+		cd.discount_type,
+		cd.value,
+		cd.is_active,
+
+		pd.file_name,
+		pd.file_key,
+		pd.your_moments_type AS photo_detail_type,
+
+		COALESCE(c.rating, 0) AS creator_rating,
+		COALESCE(ca.total, 0) AS creator_affinity,
+		COALESCE(eo.total, 0) AS event_owned_count,
+		COALESCE(em.total, 0) AS event_match_count
+
+	FROM 
+		user_similar_photos AS usp
+
+	JOIN 
+		photos AS p ON p.id = usp.photo_id
+
+	JOIN
+		creators AS c ON c.id = p.creator_id
+
+	LEFT JOIN creator_affinity AS ca ON ca.creator_id = p.creator_id
+	LEFT JOIN event_owned AS eo ON eo.bulk_photo_id = p.bulk_photo_id
+	LEFT JOIN event_match AS em ON em.bulk_photo_id = p.bulk_photo_id
+
+	LEFT JOIN LATERAL (
+		SELECT *
+		FROM creator_discounts cd
+		WHERE cd.creator_id = p.creator_id AND cd.is_active = TRUE
+		ORDER BY cd.min_quantity ASC, cd.created_at DESC
+		LIMIT 1
+	) cd ON TRUE
+
+	LEFT JOIN LATERAL (
+		SELECT 
+			pd.file_name,
+			pd.file_key,
+			pd.your_moments_type
+		FROM photo_details pd
+		WHERE pd.photo_id = p.id
+		AND pd.your_moments_type = 'YOU'::your_moments_type
+		LIMIT 1
+	) pd ON TRUE
+
+	WHERE usp.user_id = $1
+	AND usp.similarity >= $2
+	AND p.creator_id != $3
+	AND (p.status = 'AVAILABLE' OR p.status= 'SOLD')
+	AND (p.owned_by_user_id IS NULL)
+	`
+
+	args := []interface{}{userId, similarity, creatorId, confidentSimilarity}
+	argIndex := 5
+
+	if after != nil {
+		query += " AND (usp.similarity, p.original_at, usp.photo_id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) +
+			", $" + strconv.Itoa(argIndex+2) + ")"
+		args = append(args, after.Similarity, after.OriginalAt, after.PhotoId)
+		argIndex += 3
+	}
+
+	query += " ORDER BY usp.similarity DESC, p.original_at DESC, usp.photo_id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, limit)
+
+	results := make([]*entity.ExploreCandidate, 0)
+	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *exploreRepository) CountExploreCandidates(ctx context.Context, tx Querier, similarity uint32, userId, creatorId string) (int64, error) {
+	query := `
+	SELECT 
+		COUNT(*) 
+	FROM 
+		user_similar_photos AS usp
+	JOIN 
+		photos AS p ON p.id = usp.photo_id
+	JOIN
+		creators AS c ON c.id = p.creator_id
+	WHERE usp.user_id = $1
+	AND usp.similarity >= $2
+	AND p.creator_id != $3
+	AND (p.status = 'AVAILABLE' OR p.status= 'SOLD')
+	AND (p.owned_by_user_id IS NULL)
+	`
+
+	var total int64
+	if err := tx.GetContext(ctx, &total, query, userId, similarity, creatorId); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
 func (r *exploreRepository) UserAddWishlist(ctx context.Context, tx Querier, similarity uint32, photoId, userId string) error {
 	query := "UPDATE user_similar_photos SET is_wishlist = true WHERE photo_id = $1 AND user_id = $2"
 	_, err := tx.ExecContext(ctx, query, photoId, userId)
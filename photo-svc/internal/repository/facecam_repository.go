@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -10,6 +11,8 @@ import (
 type FacecamRepository interface {
 	Create(tx Querier, facecam *entity.Facecam) (*entity.Facecam, error)
 	UpdatedProcessedFacecam(tx Querier, facecam *entity.Facecam) error
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Facecam, error)
+	FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Facecam, error)
 }
 
 type facecamRepository struct {
@@ -47,6 +50,26 @@ func (r *facecamRepository) UpdatedProcessedFacecam(tx Querier, facecam *entity.
 	return nil
 }
 
+func (r *facecamRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Facecam, error) {
+	facecams := make([]*entity.Facecam, 0)
+	query := `DELETE FROM facecams WHERE user_id = $1 RETURNING id, user_id, file_key`
+	if err := tx.SelectContext(ctx, &facecams, query, userId); err != nil {
+		return nil, fmt.Errorf("failed to delete facecams: %w", err)
+	}
+
+	return facecams, nil
+}
+
+func (r *facecamRepository) FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Facecam, error) {
+	facecams := make([]*entity.Facecam, 0)
+	query := `SELECT id, user_id, file_name, title, size, is_processed, original_at, created_at FROM facecams WHERE user_id = $1 ORDER BY created_at DESC`
+	if err := tx.SelectContext(ctx, &facecams, query, userId); err != nil {
+		return nil, fmt.Errorf("failed to find facecams: %w", err)
+	}
+
+	return facecams, nil
+}
+
 // func (r *facecamRepository) Update(ctx context.Context, db Querier, req *model.RequestUpdatePhoto) error {
 
 // 	query := `UPDATE INTO user_simillars (id, user_id, size, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING (id)`
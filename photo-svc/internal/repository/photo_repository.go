@@ -46,6 +46,10 @@ type PhotoRepository interface {
 	AddPhotoTotal(ctx context.Context, tx Querier, photoID string, count int) error
 	UserGetPhotoWithDetail(ctx context.Context, tx Querier, photoIDs []string, userID string) ([]*entity.PhotoWithDetail, error)
 	UpdatePhotoStatusesByIDs(ctx context.Context, tx Querier, status enum.PhotoStatusEnum, ids []string) error
+	FindSampleByCreatorId(ctx context.Context, tx Querier, creatorId string, limit int) ([]*entity.CreatorSamplePhoto, error)
+	PseudonymizeOwner(ctx context.Context, tx Querier, userId, pseudonymId string) error
+	FindAllOwnedByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Photo, error)
+	FindVisiblePreviewsByIds(ctx context.Context, tx Querier, userId string, photoIds []string) ([]*entity.ChatPhotoPreview, error)
 }
 
 type photoRepository struct {
@@ -237,6 +241,27 @@ func (r *photoRepository) UpdatePhotoOwnerAndStatusByIds(ctx context.Context, tx
 	return nil
 }
 
+// PseudonymizeOwner keeps sold photos sold but detaches them from a deleted user
+func (r *photoRepository) PseudonymizeOwner(ctx context.Context, tx Querier, userId, pseudonymId string) error {
+	query := "UPDATE photos SET owned_by_user_id = $1 WHERE owned_by_user_id = $2"
+	_, err := tx.ExecContext(ctx, query, pseudonymId, userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *photoRepository) FindAllOwnedByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Photo, error) {
+	photos := make([]*entity.Photo, 0)
+	query := `SELECT id, creator_id, title, price, price_str, original_at FROM photos WHERE owned_by_user_id = $1 ORDER BY original_at DESC`
+	if err := tx.SelectContext(ctx, &photos, query, userId); err != nil {
+		return nil, err
+	}
+
+	return photos, nil
+}
+
 // TODO NamedExecContext doesnt use bulk upload
 func (r *photoRepository) BulkCreate(ctx context.Context, tx Querier, items []*entity.Photo) (*[]*entity.Photo, error) {
 	query := `INSERT INTO photos (id, creator_id, bulk_photo_id, title, collection_url, price, price_str, latitude, longitude, description, original_at, created_at, updated_at)
@@ -438,3 +463,62 @@ func (r *photoRepository) UpdatePhotoStatusesByIDs(ctx context.Context, tx Queri
 	}
 	return nil
 }
+
+func (r *photoRepository) FindSampleByCreatorId(ctx context.Context, tx Querier, creatorId string, limit int) ([]*entity.CreatorSamplePhoto, error) {
+	samples := make([]*entity.CreatorSamplePhoto, 0)
+	query := `
+	SELECT 
+		p.id AS photo_id,
+		p.title,
+		p.price,
+		p.price_str,
+		pd.file_key,
+		p.original_at
+	FROM photos AS p
+	JOIN photo_details AS pd ON pd.photo_id = p.id AND pd.your_moments_type = 'YOU'::your_moments_type
+	WHERE p.creator_id = $1
+	AND p.owned_by_user_id IS NULL
+	AND p.status = $2
+	ORDER BY p.original_at DESC, p.id DESC
+	LIMIT $3
+	`
+	if err := tx.SelectContext(ctx, &samples, query, creatorId, enum.PhotoStatusAvailableEnum, limit); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// FindVisiblePreviewsByIds skips the photos the user cannot see. A photo is seen by its creator, its owner and the
+// users found in it while it is still listed in their explore.
+func (r *photoRepository) FindVisiblePreviewsByIds(ctx context.Context, tx Querier, userId string, photoIds []string) ([]*entity.ChatPhotoPreview, error) {
+	previews := make([]*entity.ChatPhotoPreview, 0, len(photoIds))
+	query := `
+	SELECT
+		p.id AS photo_id,
+		p.creator_id,
+		p.title,
+		p.price,
+		p.price_str,
+		pd.file_key,
+		(p.owned_by_user_id IS NULL AND p.status = $3) AS is_buyable
+	FROM photos AS p
+	JOIN photo_details AS pd ON pd.photo_id = p.id AND pd.your_moments_type = 'YOU'::your_moments_type
+	LEFT JOIN creators AS c ON c.id = p.creator_id
+	WHERE p.id = ANY($1)
+	AND (
+		c.user_id = $2
+		OR p.owned_by_user_id = $2
+		OR (
+			p.owned_by_user_id IS NULL
+			AND p.status IN ($3, $4)
+			AND EXISTS (SELECT 1 FROM user_similar_photos AS usp WHERE usp.photo_id = p.id AND usp.user_id = $2)
+		)
+	)`
+
+	if err := tx.SelectContext(ctx, &previews, query, pq.Array(photoIds), userId, enum.PhotoStatusAvailableEnum,
+		enum.PhotoStatusSoldEnum); err != nil {
+		return nil, err
+	}
+
+	return previews, nil
+}
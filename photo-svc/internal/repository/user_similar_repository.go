@@ -15,7 +15,10 @@ import (
 type UserSimilarRepository interface {
 	InsertOrUpdateByPhotoId(tx Querier, photoId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error
 	InserOrUpdateByUserId(tx Querier, userId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error
-	InsertOrUpdateBulk(ctx context.Context, tx Querier, photoUserSimilarMap map[string][]*entity.UserSimilarPhoto) error // UpdateUsersForPhoto(ctx context.Context, db Querier, photoId string, userIds []string) error
+	InsertOrUpdateBulk(ctx context.Context, tx Querier, photoUserSimilarMap map[string][]*entity.UserSimilarPhoto) error
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+	FindUserIdsByBulkPhotoId(ctx context.Context, tx Querier, bulkPhotoId, afterUserId string, limit int) ([]string, error)
+	// UpdateUsersForPhoto(ctx context.Context, db Querier, photoId string, userIds []string) error
 	// GetSimilarPhotosByUser(ctx context.Context, db Querier, userId string) (*UserSimilarPhotosResponse, error)
 	// DeleteSimilarUsers(ctx context.Context, db Querier, photoId string) error
 }
@@ -201,3 +204,32 @@ func (r *userSimilarRepository) InsertOrUpdateBulk(ctx context.Context, tx Queri
 
 	return nil
 }
+
+func (r *userSimilarRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM user_similar_photos WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return fmt.Errorf("failed to delete user similar photos: %w", err)
+	}
+
+	return nil
+}
+
+// FindUserIdsByBulkPhotoId lists the users recognized in the photos of a bulk upload ordered by id, starting after
+// afterUserId
+func (r *userSimilarRepository) FindUserIdsByBulkPhotoId(ctx context.Context, tx Querier, bulkPhotoId, afterUserId string,
+	limit int) ([]string, error) {
+	query := `
+	SELECT DISTINCT us.user_id
+	FROM user_similar_photos us
+	JOIN photos p ON p.id = us.photo_id
+	WHERE p.bulk_photo_id = $1 AND us.user_id > $2
+	ORDER BY us.user_id
+	LIMIT $3`
+
+	userIds := make([]string, 0, limit)
+	if err := tx.SelectContext(ctx, &userIds, query, bulkPhotoId, afterUserId, limit); err != nil {
+		return nil, fmt.Errorf("failed to find user ids by bulk photo id: %w", err)
+	}
+
+	return userIds, nil
+}
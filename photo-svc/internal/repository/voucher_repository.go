@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+)
+
+type VoucherRepository interface {
+	Create(ctx context.Context, tx Querier, voucher *entity.Voucher) (*entity.Voucher, error)
+	UpdateIsActive(ctx context.Context, tx Querier, voucherId, creatorId string, isActive bool) error
+	ExistsByCode(ctx context.Context, tx Querier, code string) (bool, error)
+	FindByIdAndCreatorId(ctx context.Context, tx Querier, voucherId, creatorId string) (*entity.Voucher, error)
+	FindAllByCreatorId(ctx context.Context, tx Querier, creatorId string) (*[]*entity.Voucher, error)
+	FindById(ctx context.Context, tx Querier, voucherId string, forUpdate bool) (*entity.Voucher, error)
+	FindRedeemableByCode(ctx context.Context, tx Querier, code string, forUpdate bool) (*entity.Voucher, error)
+	CountUsage(ctx context.Context, tx Querier, voucherId, userId string) (*entity.VoucherUsage, error)
+	CreateRedemption(ctx context.Context, tx Querier, redemption *entity.VoucherRedemption) error
+	PseudonymizeRedemptions(ctx context.Context, tx Querier, userId, pseudonymId string) error
+	UpdateRedemptionStatus(ctx context.Context, tx Querier, transactionId string, from, to enum.VoucherRedemptionStatus) error
+}
+
+type voucherRepository struct{}
+
+func NewVoucherRepository() VoucherRepository {
+	return &voucherRepository{}
+}
+
+func (r *voucherRepository) Create(ctx context.Context, tx Querier, voucher *entity.Voucher) (*entity.Voucher, error) {
+	query := `
+	INSERT INTO vouchers 
+	(id, code, creator_id, funded_by, name, discount_type, value, max_discount, min_quantity, usage_limit, 
+	usage_limit_per_user, is_stackable, is_active, starts_at, ends_at, created_at, updated_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+	_, err := tx.ExecContext(ctx, query, voucher.Id, voucher.Code, voucher.CreatorId, voucher.FundedBy, voucher.Name,
+		voucher.DiscountType, voucher.Value, voucher.MaxDiscount, voucher.MinQuantity, voucher.UsageLimit,
+		voucher.UsageLimitPerUser, voucher.IsStackable, voucher.IsActive, voucher.StartsAt, voucher.EndsAt,
+		voucher.CreatedAt, voucher.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+// An empty creatorId scopes the voucher queries to platform vouchers, they have no creator
+func (r *voucherRepository) UpdateIsActive(ctx context.Context, tx Querier, voucherId, creatorId string, isActive bool) error {
+	query := `UPDATE vouchers SET is_active = $1, updated_at = now() WHERE id = $2 AND creator_id IS NOT DISTINCT FROM NULLIF($3, '')`
+	_, err := tx.ExecContext(ctx, query, isActive, voucherId, creatorId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *voucherRepository) ExistsByCode(ctx context.Context, tx Querier, code string) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM vouchers WHERE code = $1)`
+	if err := tx.GetContext(ctx, &exists, query, code); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *voucherRepository) FindByIdAndCreatorId(ctx context.Context, tx Querier, voucherId, creatorId string) (*entity.Voucher, error) {
+	voucher := new(entity.Voucher)
+	query := `SELECT * FROM vouchers WHERE id = $1 AND creator_id IS NOT DISTINCT FROM NULLIF($2, '')`
+	if err := tx.GetContext(ctx, voucher, query, voucherId, creatorId); err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+func (r *voucherRepository) FindAllByCreatorId(ctx context.Context, tx Querier, creatorId string) (*[]*entity.Voucher, error) {
+	vouchers := make([]*entity.Voucher, 0)
+	query := `SELECT * FROM vouchers WHERE creator_id IS NOT DISTINCT FROM NULLIF($1, '') ORDER BY created_at DESC`
+	if err := tx.SelectContext(ctx, &vouchers, query, creatorId); err != nil {
+		return nil, err
+	}
+	return &vouchers, nil
+}
+
+func (r *voucherRepository) FindById(ctx context.Context, tx Querier, voucherId string, forUpdate bool) (*entity.Voucher, error) {
+	voucher := new(entity.Voucher)
+	query := `SELECT * FROM vouchers WHERE id = $1`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	if err := tx.GetContext(ctx, voucher, query, voucherId); err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+// FindRedeemableByCode only returns active vouchers inside their time window. forUpdate serializes
+// concurrent reservations so the usage limits can not be overshot.
+func (r *voucherRepository) FindRedeemableByCode(ctx context.Context, tx Querier, code string, forUpdate bool) (*entity.Voucher, error) {
+	voucher := new(entity.Voucher)
+	query := `
+	SELECT * FROM vouchers WHERE code = $1 AND is_active = true
+	AND (starts_at IS NULL OR starts_at <= now()) AND (ends_at IS NULL OR ends_at > now())`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	if err := tx.GetContext(ctx, voucher, query, code); err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+func (r *voucherRepository) CountUsage(ctx context.Context, tx Querier, voucherId, userId string) (*entity.VoucherUsage, error) {
+	usage := new(entity.VoucherUsage)
+	query := `
+	SELECT 
+	COUNT(*) AS total, 
+	COUNT(*) FILTER (WHERE user_id = $2) AS for_user 
+	FROM voucher_redemptions 
+	WHERE voucher_id = $1 AND status IN ('RESERVED', 'REDEEMED')`
+	if err := tx.GetContext(ctx, usage, query, voucherId, userId); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func (r *voucherRepository) CreateRedemption(ctx context.Context, tx Querier, redemption *entity.VoucherRedemption) error {
+	query := `
+	INSERT INTO voucher_redemptions 
+	(id, voucher_id, user_id, transaction_id, amount, status, created_at, updated_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := tx.ExecContext(ctx, query, redemption.Id, redemption.VoucherId, redemption.UserId, redemption.TransactionId,
+		redemption.Amount, redemption.Status, redemption.CreatedAt, redemption.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *voucherRepository) PseudonymizeRedemptions(ctx context.Context, tx Querier, userId, pseudonymId string) error {
+	query := `UPDATE voucher_redemptions SET user_id = $1, updated_at = now() WHERE user_id = $2`
+	_, err := tx.ExecContext(ctx, query, pseudonymId, userId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *voucherRepository) UpdateRedemptionStatus(ctx context.Context, tx Querier, transactionId string, from, to enum.VoucherRedemptionStatus) error {
+	query := `UPDATE voucher_redemptions SET status = $1, updated_at = now() WHERE transaction_id = $2 AND status = $3`
+	_, err := tx.ExecContext(ctx, query, to, transactionId, from)
+	if err != nil {
+		return err
+	}
+	return nil
+}
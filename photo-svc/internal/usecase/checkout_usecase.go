@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
@@ -18,6 +20,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
 )
 
 type CheckoutUseCase interface {
@@ -33,27 +36,33 @@ type checkoutUseCase struct {
 	photoRepository           repository.PhotoRepository
 	creatorRepository         repository.CreatorRepository
 	creatorDiscountRepository repository.CreatorDiscountRepository
+	voucherRepository         repository.VoucherRepository
 	logs                      *logger.Log
 	CDNAdapter                adapter.CDNAdapter
+	priceQuoteAdapter         adapter.PriceQuoteAdapter
 }
 
 func NewCheckoutUseCase(db *sqlx.DB, photoRepository repository.PhotoRepository, creatorRepository repository.CreatorRepository,
-	creatorDiscountRepository repository.CreatorDiscountRepository, logs *logger.Log, CDNAdapter adapter.CDNAdapter) CheckoutUseCase {
+	creatorDiscountRepository repository.CreatorDiscountRepository, voucherRepository repository.VoucherRepository,
+	logs *logger.Log, CDNAdapter adapter.CDNAdapter, priceQuoteAdapter adapter.PriceQuoteAdapter) CheckoutUseCase {
 	return &checkoutUseCase{
 		db:                        db,
 		photoRepository:           photoRepository,
 		creatorRepository:         creatorRepository,
 		creatorDiscountRepository: creatorDiscountRepository,
+		voucherRepository:         voucherRepository,
 		logs:                      logs,
 		CDNAdapter:                CDNAdapter,
+		priceQuoteAdapter:         priceQuoteAdapter,
 	}
 }
 
 func (u *checkoutUseCase) PreviewCheckout(ctx context.Context, previewRequest *model.PreviewCheckoutRequest) (*model.PreviewCheckoutResponse, error) {
 	now := time.Now()
 	request := &model.CalculateRequest{
-		UserId:   previewRequest.UserId,
-		PhotoIds: previewRequest.PhotoIds,
+		UserId:      previewRequest.UserId,
+		PhotoIds:    previewRequest.PhotoIds,
+		VoucherCode: previewRequest.VoucherCode,
 	}
 
 	result, total, err := u.calculatePrice(ctx, u.db, request, false)
@@ -61,7 +70,18 @@ func (u *checkoutUseCase) PreviewCheckout(ctx context.Context, previewRequest *m
 		return nil, err
 	}
 
-	return converter.CheckoutItemToResponse(result, total.Price, total.Discount, &now), nil
+	expiresAt := now.Add(u.priceQuoteAdapter.TTL())
+	quote := converter.CheckoutItemToPriceQuote(ulid.Make().String(), previewRequest.UserId, result, total, &expiresAt)
+	quoteToken, err := u.priceQuoteAdapter.Sign(ctx, quote)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to sign price quote", err)
+	}
+
+	response := converter.CheckoutItemToResponse(result, total, &now)
+	response.QuoteToken = quoteToken
+	response.QuoteExpiresAt = &expiresAt
+
+	return response, nil
 }
 
 func (u *checkoutUseCase) LockPhotosAndCalculatePrice(ctx context.Context, request *model.CalculateRequest) (*[]*model.CheckoutItem, *model.Total, error) {
@@ -107,10 +127,84 @@ func (u *checkoutUseCase) LockPhotosAndCalculatePriceV2(ctx context.Context, req
 		itemMap[item.PhotoId] = item
 	}
 
+	var result *[]*model.CheckoutItem
+	var total *model.Total
+	var quote *model.PriceQuote
+	if request.QuoteToken != "" {
+		result, total, quote, err = u.calculateQuotedPrice(ctx, tx, request, photoIDs)
+	} else {
+		result, total, err = u.calculateAndCompareClientPrice(ctx, tx, request, itemMap, photoIDs)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.photoRepository.UpdatePhotoStatusesByIDs(ctx, tx, enum.PhotoStatusInTransactionEnum, photoIDs); err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to update photo statuses by photo ids with status IN_TRANSACTION ", err)
+	}
+
+	// Voucher is reserved until the transaction is settled (REDEEMED) or canceled/expired (RELEASED)
+	if total.VoucherId != "" {
+		now := time.Now()
+		redemption := &entity.VoucherRedemption{
+			Id:            ulid.Make().String(),
+			VoucherId:     total.VoucherId,
+			UserId:        request.UserId,
+			TransactionId: request.TransactionId,
+			Amount:        total.VoucherDiscount,
+			Status:        enum.VoucherRedemptionStatusReserved,
+			CreatedAt:     &now,
+			UpdatedAt:     &now,
+		}
+
+		if err := u.voucherRepository.CreateRedemption(ctx, tx, redemption); err != nil {
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to reserve voucher redemption", err)
+		}
+	}
+
+	// The quote is consumed right before the commit so a checkout that fails earlier does not burn it,
+	// a failed commit puts it back so the user can retry with the same preview
+	if quote != nil {
+		if err = u.consumePriceQuote(ctx, quote); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = repository.Commit(tx, u.logs); err != nil {
+		if quote != nil {
+			u.restorePriceQuote(ctx, quote)
+		}
+		return nil, nil, err
+	}
+
+	return result, total, nil
+}
+
+func (u *checkoutUseCase) consumePriceQuote(ctx context.Context, quote *model.PriceQuote) error {
+	if err := u.priceQuoteAdapter.Consume(ctx, quote); err != nil {
+		if errors.Is(err, adapter.ErrUsedPriceQuote) {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Price quote has already been used")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to consume price quote", err)
+	}
+	return nil
+}
+
+// restorePriceQuote only logs a failure, the quote then simply expires and the user has to preview again
+func (u *checkoutUseCase) restorePriceQuote(ctx context.Context, quote *model.PriceQuote) {
+	if err := u.priceQuoteAdapter.Restore(ctx, quote); err != nil {
+		u.logs.Error(fmt.Sprintf("failed to restore price quote %s : %v", quote.Id, err))
+	}
+}
+
+// calculateAndCompareClientPrice is used when the client has no price quote, every price sent by the client must match the current price
+func (u *checkoutUseCase) calculateAndCompareClientPrice(ctx context.Context, tx repository.Querier, request *model.CalculateV2Request,
+	itemMap map[string]model.CheckoutItemWeb, photoIDs []string) (*[]*model.CheckoutItem, *model.Total, error) {
 	calculatePriceReq := &model.CalculateRequest{
-		UserId:    request.UserId,
-		CreatorId: request.CreatorId,
-		PhotoIds:  photoIDs,
+		UserId:      request.UserId,
+		CreatorId:   request.CreatorId,
+		PhotoIds:    photoIDs,
+		VoucherCode: request.VoucherCode,
 	}
 
 	result, total, err := u.calculatePrice(ctx, tx, calculatePriceReq, true)
@@ -131,55 +225,138 @@ func (u *checkoutUseCase) LockPhotosAndCalculatePriceV2(ctx context.Context, req
 			}
 			if toCompare.Price != item.Price {
 				u.logs.Log(fmt.Sprintf("[ToComparePrice] tocompare price :%d item price %d", toCompare.Price, item.Price))
-				return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Price has changed")
+				return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Price has changed")
 			}
 			if toCompare.Discount != nil {
 				if item.DiscountId == "" {
-					return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Discount has removed")
+					return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Discount has removed")
 				}
 				if item.DiscountId != toCompare.Discount.Id {
-					return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Discount has changed")
+					return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Discount has changed")
 				}
 				if string(item.DiscountType) != string(toCompare.Discount.Type) {
-					return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Discount has changed")
+					return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Discount has changed")
 				}
 				if item.DiscountMinQuantity != toCompare.Discount.MinQuantity {
-					return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Discount has changed")
+					return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Discount has changed")
 				}
 				if item.DiscountValue != toCompare.Discount.Value {
-					return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Discount has changed")
+					return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Discount has changed")
 				}
 			}
 			if toCompare.FinalPrice != item.FinalPrice {
 				u.logs.Log(fmt.Sprintf("[ToComparePrice] tocompare final price :%d item finasl  price %d", toCompare.FinalPrice, item.FinalPrice))
 
-				return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Price has changed")
+				return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Price has changed")
 			}
 		}
 	}
 
 	if request.TotalPrice != total.Price {
 		u.logs.Log(fmt.Sprintf("[ToComparePrice] tocompare total price :%d item total price %d", request.TotalPrice, total.Price))
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Total price has changed")
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Total price has changed")
 	}
 
 	if request.TotalDiscount != total.Discount {
 		u.logs.Log(fmt.Sprintf("[ToComparePrice] tocompare total discount :%d item total discount %d", request.TotalDiscount, total.Discount))
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Total discount has changed")
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Total discount has changed")
 	}
 
-	if err := u.photoRepository.UpdatePhotoStatusesByIDs(ctx, tx, enum.PhotoStatusInTransactionEnum, photoIDs); err != nil {
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to update photo statuses by photo ids with status IN_TRANSACTION ", err)
+	return result, total, nil
+}
+
+// calculateQuotedPrice honours the discounts and voucher of a signed price quote even if the creator changed them after the preview.
+// The quote is only rejected when it is expired, a photo price changed or the voucher usage limit is reached,
+// the caller consumes the returned quote once the checkout is ready to commit.
+func (u *checkoutUseCase) calculateQuotedPrice(ctx context.Context, tx repository.Querier, request *model.CalculateV2Request,
+	photoIDs []string) (*[]*model.CheckoutItem, *model.Total, *model.PriceQuote, error) {
+	quote, err := u.priceQuoteAdapter.Verify(request.QuoteToken)
+	if err != nil {
+		if errors.Is(err, adapter.ErrExpiredPriceQuote) {
+			return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Price quote has expired")
+		}
+		return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid price quote")
 	}
 
-	if err := repository.Commit(tx, u.logs); err != nil {
-		return nil, nil, err
+	if quote.UserId != request.UserId {
+		return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid price quote")
 	}
 
-	return result, total, err
+	quoteItems := make(map[string]*model.PriceQuoteItem, len(quote.Items))
+	for _, item := range quote.Items {
+		quoteItems[item.PhotoId] = item
+	}
+
+	if len(quoteItems) != len(photoIDs) {
+		return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Price quote does not match the checkout items")
+	}
+
+	for _, photoID := range photoIDs {
+		if _, ok := quoteItems[photoID]; !ok {
+			return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Price quote does not match the checkout items")
+		}
+	}
+
+	calculatePriceReq := &model.CalculateRequest{
+		UserId:    request.UserId,
+		CreatorId: request.CreatorId,
+		PhotoIds:  photoIDs,
+	}
+
+	result, _, err := u.calculatePrice(ctx, tx, calculatePriceReq, true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	total := &model.Total{
+		QuoteId:         quote.Id,
+		VoucherId:       quote.VoucherId,
+		VoucherCode:     quote.VoucherCode,
+		VoucherFundedBy: quote.VoucherFundedBy,
+	}
+
+	for _, item := range *result {
+		quoted := quoteItems[item.PhotoId]
+		if quoted.Price != item.Price || quoted.CreatorId != item.CreatorId {
+			return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Photo price has changed")
+		}
+
+		item.Discount = quoted.Discount
+		item.DiscountId = quoted.DiscountId
+		item.DiscountType = quoted.DiscountType
+		item.DiscountMinQuantity = quoted.DiscountMinQuantity
+		item.DiscountValue = quoted.DiscountValue
+		item.VoucherDiscount = quoted.VoucherDiscount
+		if quoted.VoucherDiscount != 0 {
+			item.VoucherId = quote.VoucherId
+			item.VoucherCode = quote.VoucherCode
+			item.VoucherFundedBy = quote.VoucherFundedBy
+		}
+		item.FinalPrice = quoted.FinalPrice
+
+		total.Price += item.FinalPrice
+		total.Discount += item.Discount + item.VoucherDiscount
+		total.VoucherDiscount += item.VoucherDiscount
+	}
+
+	if quote.VoucherId != "" {
+		voucher, err := u.voucherRepository.FindById(ctx, tx, quote.VoucherId, true)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, nil, helper.NewUseCaseError(errorcode.ErrPriceChanged, "Voucher is no longer available")
+			}
+			return nil, nil, nil, helper.WrapInternalServerError(u.logs, "failed to find voucher by id", err)
+		}
+
+		if err := u.checkVoucherUsage(ctx, tx, voucher, request.UserId, errorcode.ErrPriceChanged); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return result, total, quote, nil
 }
 
-// #M231 ISSUE - Discount consistency (if creator deactivate the discount when user already previewed it)
+// Discount consistency between preview and payment is guaranteed by the signed price quote, see calculateQuotedPrice
 func (u *checkoutUseCase) calculatePrice(ctx context.Context, tx repository.Querier, request *model.CalculateRequest, isTransaction bool) (*[]*model.CheckoutItem, *model.Total, error) {
 	photos, err := u.photoRepository.GetSimilarPhotosByIDs(ctx, tx, request.UserId, request.CreatorId, request.PhotoIds, isTransaction, u.CDNAdapter.GenerateCDN)
 	if err != nil {
@@ -247,69 +424,248 @@ func (u *checkoutUseCase) calculatePrice(ctx context.Context, tx repository.Quer
 		return nil, nil, helper.WrapInternalServerError(u.logs, "error get discount rules by ids", err)
 	}
 
-	//Get best choice for creator discount
-	discountMap := make(map[string]*entity.CreatorDiscount)
+	result := make([]*model.CheckoutItem, 0, len(*photos))
+	photosByCreator := make(map[string][]*model.CheckoutItem)
+	for _, p := range *photos {
+		item := &model.CheckoutItem{
+			PhotoId:        p.Id,
+			CreatorId:      p.CreatorId,
+			Title:          p.Title,
+			YourMomentsUrl: p.YourMomentsUrl.String,
+			Price:          p.Price,
+			FinalPrice:     p.Price,
+		}
+		result = append(result, item)
+		photosByCreator[p.CreatorId] = append(photosByCreator[p.CreatorId], item)
+	}
+
+	rulesByCreator := make(map[string][]*entity.CreatorDiscount)
 	for _, rule := range *discountRules {
-		if photoCount[rule.CreatorId] >= rule.MinQuantity {
-			if _, exists := discountMap[rule.CreatorId]; !exists {
-				discountMap[rule.CreatorId] = rule
+		rulesByCreator[rule.CreatorId] = append(rulesByCreator[rule.CreatorId], rule)
+	}
+
+	// 3. Get best choice for creator discount, the rule that gives the buyer the biggest discount wins
+	for creatorId, items := range photosByCreator {
+		var bestRule *entity.CreatorDiscount
+		var bestAmounts map[string]int32
+		var bestTotal int32
+		for _, rule := range rulesByCreator[creatorId] {
+			if len(items) < rule.MinQuantity {
+				continue
+			}
+			amounts, ruleTotal := creatorDiscountAmounts(rule, items)
+			if ruleTotal > bestTotal {
+				bestRule, bestAmounts, bestTotal = rule, amounts, ruleTotal
+			}
+		}
+
+		if bestRule == nil {
+			continue
+		}
+
+		for _, item := range items {
+			discount, ok := bestAmounts[item.PhotoId]
+			if !ok || discount == 0 {
+				continue
 			}
+			item.Discount = discount
+			item.DiscountMinQuantity = bestRule.MinQuantity
+			item.DiscountValue = bestRule.Value
+			item.DiscountId = bestRule.Id
+			item.DiscountType = bestRule.DiscountType
+			item.FinalPrice = item.Price - discount
 		}
 	}
 
-	result := make([]*model.CheckoutItem, 0)
-	var totalAmount int32 = 0
-	var totalDiscount int32 = 0
+	total := new(model.Total)
 
-	for _, p := range *photos {
-		if disc, ok := discountMap[p.CreatorId]; ok {
-			var discount int32 = 0
-			switch disc.DiscountType {
-			case enum.DiscountTypeFlat:
-				discount = disc.Value
-			case enum.DiscountTypePercent:
-				discount = p.Price * disc.Value / 100
+	// 4. Apply voucher on top of the creator discounts
+	if request.VoucherCode != "" {
+		voucher, err := u.applyVoucher(ctx, tx, request.UserId, request.VoucherCode, result, isTransaction)
+		if err != nil {
+			return nil, nil, err
+		}
+		total.VoucherId = voucher.Id
+		total.VoucherCode = voucher.Code
+		total.VoucherFundedBy = voucher.FundedBy
+	}
+
+	for _, item := range result {
+		total.Price += item.FinalPrice
+		total.Discount += item.Discount + item.VoucherDiscount
+		total.VoucherDiscount += item.VoucherDiscount
+	}
+
+	return &result, total, nil
+}
+
+// creatorDiscountAmounts returns the discount of every photo covered by the rule and the sum of them.
+// BUNDLE rules put the most expensive photos into bundles first.
+func creatorDiscountAmounts(rule *entity.CreatorDiscount, items []*model.CheckoutItem) (map[string]int32, int32) {
+	amounts := make(map[string]int32, len(items))
+	var total int32 = 0
+
+	switch rule.DiscountType {
+	case enum.DiscountTypeFlat:
+		for _, item := range items {
+			amounts[item.PhotoId] = min(rule.Value, item.Price)
+			total += amounts[item.PhotoId]
+		}
+	case enum.DiscountTypePercent:
+		for _, item := range items {
+			amounts[item.PhotoId] = item.Price * rule.Value / 100
+			total += amounts[item.PhotoId]
+		}
+	case enum.DiscountTypeBundle:
+		if rule.MinQuantity <= 0 {
+			return amounts, 0
+		}
+
+		sorted := make([]*model.CheckoutItem, len(items))
+		copy(sorted, items)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].Price == sorted[j].Price {
+				return sorted[i].PhotoId < sorted[j].PhotoId
+			}
+			return sorted[i].Price > sorted[j].Price
+		})
+
+		for start := 0; start+rule.MinQuantity <= len(sorted); start += rule.MinQuantity {
+			bundle := sorted[start : start+rule.MinQuantity]
+			var bundlePrice int32 = 0
+			for _, item := range bundle {
+				bundlePrice += item.Price
 			}
 
-			final := p.Price - discount
-			item := &model.CheckoutItem{
-				PhotoId:             p.Id,
-				CreatorId:           p.CreatorId,
-				Title:               p.Title,
-				YourMomentsUrl:      p.YourMomentsUrl.String,
-				Price:               p.Price,
-				Discount:            discount,
-				DiscountMinQuantity: disc.MinQuantity,
-				DiscountValue:       disc.Value,
-				DiscountId:          disc.Id,
-				DiscountType:        disc.DiscountType,
-				FinalPrice:          final,
+			if bundlePrice <= rule.Value {
+				continue
 			}
 
-			result = append(result, item)
-			totalAmount += final
-			totalDiscount += discount
-		} else {
-			item := &model.CheckoutItem{
-				PhotoId:        p.Id,
-				CreatorId:      p.CreatorId,
-				Title:          p.Title,
-				YourMomentsUrl: p.YourMomentsUrl.String,
-				Price:          p.Price,
-				FinalPrice:     p.Price,
+			for photoId, amount := range splitAmount(bundlePrice-rule.Value, bundle, func(item *model.CheckoutItem) int32 { return item.Price }) {
+				amounts[photoId] = amount
 			}
+			total += bundlePrice - rule.Value
+		}
+	}
+
+	return amounts, total
+}
+
+// splitAmount distributes amount proportionally to the weight of every item, the rounding remainder goes to the last item
+func splitAmount(amount int32, items []*model.CheckoutItem, weight func(item *model.CheckoutItem) int32) map[string]int32 {
+	amounts := make(map[string]int32, len(items))
+	var totalWeight int64 = 0
+	for _, item := range items {
+		totalWeight += int64(weight(item))
+	}
+
+	if totalWeight == 0 {
+		return amounts
+	}
 
-			result = append(result, item)
-			totalAmount += p.Price
+	var distributed int32 = 0
+	for i, item := range items {
+		if i == len(items)-1 {
+			amounts[item.PhotoId] = amount - distributed
+			break
 		}
+		share := int32(int64(amount) * int64(weight(item)) / totalWeight)
+		amounts[item.PhotoId] = share
+		distributed += share
 	}
 
-	total := &model.Total{
-		Price:    totalAmount,
-		Discount: totalDiscount,
+	return amounts
+}
+
+// applyVoucher validates the voucher against its usage limits and writes the voucher discount into the eligible items.
+// Stacking rules :
+//   - a CREATOR voucher only covers photos of its creator, a PLATFORM voucher covers every photo
+//   - a stackable voucher is calculated from the price after creator discount
+//   - a non stackable voucher skips photos that already received a creator discount
+//   - only one voucher can be used per checkout
+func (u *checkoutUseCase) applyVoucher(ctx context.Context, tx repository.Querier, userId, code string, items []*model.CheckoutItem, forUpdate bool) (*entity.Voucher, error) {
+	voucher, err := u.voucherRepository.FindRedeemableByCode(ctx, tx, strings.ToUpper(code), forUpdate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid or expired voucher code")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find voucher by code", err)
 	}
 
-	return &result, total, nil
+	if err := u.checkVoucherUsage(ctx, tx, voucher, userId, errorcode.ErrInvalidArgument); err != nil {
+		return nil, err
+	}
+
+	eligibleItems := make([]*model.CheckoutItem, 0, len(items))
+	hasDiscountedItem := false
+	for _, item := range items {
+		if voucher.FundedBy == enum.VoucherFunderCreator && item.CreatorId != voucher.CreatorId.String {
+			continue
+		}
+		if !voucher.IsStackable && item.Discount != 0 {
+			hasDiscountedItem = true
+			continue
+		}
+		eligibleItems = append(eligibleItems, item)
+	}
+
+	if len(eligibleItems) == 0 && hasDiscountedItem {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Voucher can not be combined with the active creator discount")
+	}
+
+	if len(eligibleItems) == 0 || len(eligibleItems) < voucher.MinQuantity {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, fmt.Sprintf("Voucher requires at least %d eligible photos", max(voucher.MinQuantity, 1)))
+	}
+
+	var base int32 = 0
+	for _, item := range eligibleItems {
+		base += item.FinalPrice
+	}
+
+	var amount int32 = 0
+	switch voucher.DiscountType {
+	case enum.DiscountTypeFlat:
+		amount = voucher.Value
+	case enum.DiscountTypePercent:
+		amount = int32(int64(base) * int64(voucher.Value) / 100)
+	}
+
+	if voucher.MaxDiscount.Valid {
+		amount = min(amount, voucher.MaxDiscount.Int32)
+	}
+	amount = min(amount, base)
+
+	if amount <= 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Voucher is not applicable for the selected photos")
+	}
+
+	voucherAmounts := splitAmount(amount, eligibleItems, func(item *model.CheckoutItem) int32 { return item.FinalPrice })
+	for _, item := range eligibleItems {
+		item.VoucherId = voucher.Id
+		item.VoucherCode = voucher.Code
+		item.VoucherDiscount = voucherAmounts[item.PhotoId]
+		item.VoucherFundedBy = voucher.FundedBy
+		item.FinalPrice -= item.VoucherDiscount
+	}
+
+	return voucher, nil
+}
+
+func (u *checkoutUseCase) checkVoucherUsage(ctx context.Context, tx repository.Querier, voucher *entity.Voucher, userId, errCode string) error {
+	usage, err := u.voucherRepository.CountUsage(ctx, tx, voucher.Id, userId)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to count voucher usage", err)
+	}
+
+	if voucher.UsageLimit.Valid && usage.Total >= int(voucher.UsageLimit.Int32) {
+		return helper.NewUseCaseError(errCode, "Voucher usage limit has been reached")
+	}
+
+	if voucher.UsageLimitPerUser.Valid && usage.ForUser >= int(voucher.UsageLimitPerUser.Int32) {
+		return helper.NewUseCaseError(errCode, "You have reached the usage limit of this voucher")
+	}
+
+	return nil
 }
 
 func (u *checkoutUseCase) OwnerOwnPhotos(ctx context.Context, request *model.OwnerOwnPhotosRequest) error {
@@ -339,6 +695,13 @@ func (u *checkoutUseCase) OwnerOwnPhotos(ctx context.Context, request *model.Own
 		return helper.WrapInternalServerError(u.logs, "error update photo owner and status by photo ids", err)
 	}
 
+	if request.TransactionId != "" {
+		if err := u.voucherRepository.UpdateRedemptionStatus(ctx, tx, request.TransactionId, enum.VoucherRedemptionStatusReserved,
+			enum.VoucherRedemptionStatusRedeemed); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to redeem voucher redemption", err)
+		}
+	}
+
 	if err := repository.Commit(tx, u.logs); err != nil {
 		return err
 	}
@@ -373,6 +736,13 @@ func (u *checkoutUseCase) CancelPhotos(ctx context.Context, request *model.Cance
 		return helper.WrapInternalServerError(u.logs, "failed to update photo statuses by photo ids with status AVAILABLE ", err)
 	}
 
+	if request.TransactionId != "" {
+		if err := u.voucherRepository.UpdateRedemptionStatus(ctx, tx, request.TransactionId, enum.VoucherRedemptionStatusReserved,
+			enum.VoucherRedemptionStatusReleased); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to release voucher redemption", err)
+		}
+	}
+
 	if err := repository.Commit(tx, u.logs); err != nil {
 		return err
 	}
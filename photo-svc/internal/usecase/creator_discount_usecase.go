@@ -11,6 +11,7 @@ import (
 	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
@@ -29,13 +30,16 @@ type CreatorDiscountUseCase interface {
 type creatorDiscountUseCase struct {
 	db                        *sqlx.DB
 	creatorDiscountRepository repository.CreatorDiscountRepository
+	creatorProfileUseCase     CreatorProfileUseCase
 	logs                      *logger.Log
 }
 
-func NewCreatorDiscountUseCase(db *sqlx.DB, creatorDiscountRepository repository.CreatorDiscountRepository, logs *logger.Log) CreatorDiscountUseCase {
+func NewCreatorDiscountUseCase(db *sqlx.DB, creatorDiscountRepository repository.CreatorDiscountRepository, creatorProfileUseCase CreatorProfileUseCase,
+	logs *logger.Log) CreatorDiscountUseCase {
 	return &creatorDiscountUseCase{
 		db:                        db,
 		creatorDiscountRepository: creatorDiscountRepository,
+		creatorProfileUseCase:     creatorProfileUseCase,
 		logs:                      logs,
 	}
 }
@@ -51,11 +55,24 @@ func (u *creatorDiscountUseCase) CreateDiscount(ctx context.Context, request *mo
 		repository.Rollback(err, tx, ctx, u.logs)
 	}()
 
-	if request.DiscountType != enum.DiscountTypeFlat && request.DiscountType != enum.DiscountTypePercent {
+	switch request.DiscountType {
+	case enum.DiscountTypeFlat:
+	case enum.DiscountTypePercent:
+		if request.Value > 100 {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Percent discount value must not be greater than 100")
+		}
+	case enum.DiscountTypeBundle:
+		if request.MinQuantity < 2 {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Bundle discount requires a minimum quantity of at least 2")
+		}
+	default:
 		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid discount type")
 	}
 
 	now := time.Now()
+	if err := validateDiscountWindow(request.StartsAt, request.EndsAt, now); err != nil {
+		return nil, err
+	}
 
 	creatorDiscount := &entity.CreatorDiscount{
 		Id:           ulid.Make().String(),
@@ -65,6 +82,8 @@ func (u *creatorDiscountUseCase) CreateDiscount(ctx context.Context, request *mo
 		DiscountType: request.DiscountType,
 		Value:        request.Value,
 		IsActive:     request.IsActive,
+		StartsAt:     nullable.ToSQLTime(request.StartsAt),
+		EndsAt:       nullable.ToSQLTime(request.EndsAt),
 		CreatedAt:    &now,
 		UpdatedAt:    &now,
 	}
@@ -79,6 +98,8 @@ func (u *creatorDiscountUseCase) CreateDiscount(ctx context.Context, request *mo
 		return nil, err
 	}
 
+	u.invalidateCreatorProfile(ctx, request.CreatorId)
+
 	return converter.CreatorDiscountToResponse(creatorDiscount), nil
 }
 
@@ -108,6 +129,8 @@ func (u *creatorDiscountUseCase) ActivateDiscount(ctx context.Context, request *
 		return err
 	}
 
+	u.invalidateCreatorProfile(ctx, request.CreatorId)
+
 	return nil
 }
 
@@ -137,6 +160,8 @@ func (u *creatorDiscountUseCase) DeactivateDiscount(ctx context.Context, request
 		return err
 	}
 
+	u.invalidateCreatorProfile(ctx, request.CreatorId)
+
 	return nil
 }
 
@@ -159,3 +184,24 @@ func (u *creatorDiscountUseCase) GetAllDiscount(ctx context.Context, creatorId s
 	}
 	return converter.CreatorDiscountsToResponses(*discounts), nil
 }
+
+// invalidateCreatorProfile runs after the discount change is committed, a cache outage leaves the public profile
+// showing the old discounts for at most CreatorProfileCacheTTL instead of failing a change that is already stored
+func (u *creatorDiscountUseCase) invalidateCreatorProfile(ctx context.Context, creatorId string) {
+	if err := u.creatorProfileUseCase.InvalidateCreatorProfile(ctx, creatorId); err != nil {
+		u.logs.CustomError("failed to invalidate creator profile after discount change", err)
+	}
+}
+
+func validateDiscountWindow(startsAt, endsAt *time.Time, now time.Time) error {
+	if endsAt == nil {
+		return nil
+	}
+	if !endsAt.After(now) {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "End time must be in the future")
+	}
+	if startsAt != nil && !endsAt.After(*startsAt) {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "End time must be after start time")
+	}
+	return nil
+}
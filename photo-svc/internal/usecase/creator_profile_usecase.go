@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	CreatorProfileCacheTTL = 5 * time.Minute
+
+	creatorProfileRecentEventLimit = 6
+	creatorProfileSamplePhotoLimit = 12
+)
+
+func CreatorProfileCacheKey(creatorId string) string {
+	return "creator_profile:" + creatorId
+}
+
+type CreatorProfileUseCase interface {
+	GetCreatorProfile(ctx context.Context, request *model.GetCreatorProfileRequest) (*model.CreatorProfileResponse, error)
+	InvalidateCreatorProfile(ctx context.Context, creatorId string) error
+	InvalidateUserCreatorProfile(ctx context.Context, userId string) error
+}
+
+type creatorProfileUseCase struct {
+	db                        *sqlx.DB
+	creatorRepository         repository.CreatorRepository
+	bulkPhotoRepository       repository.BulkPhotoRepository
+	photoRepository           repository.PhotoRepository
+	creatorDiscountRepository repository.CreatorDiscountRepository
+	userAdapter               adapter.UserAdapter
+	transactionAdapter        adapter.TransactionAdapter
+	cacheAdapter              adapter.CacheAdapter
+	cdnAdapter                adapter.CDNAdapter
+	logs                      *logger.Log
+}
+
+func NewCreatorProfileUseCase(db *sqlx.DB, creatorRepository repository.CreatorRepository, bulkPhotoRepository repository.BulkPhotoRepository,
+	photoRepository repository.PhotoRepository, creatorDiscountRepository repository.CreatorDiscountRepository, userAdapter adapter.UserAdapter,
+	transactionAdapter adapter.TransactionAdapter, cacheAdapter adapter.CacheAdapter, cdnAdapter adapter.CDNAdapter, logs *logger.Log) CreatorProfileUseCase {
+	return &creatorProfileUseCase{
+		db:                        db,
+		creatorRepository:         creatorRepository,
+		bulkPhotoRepository:       bulkPhotoRepository,
+		photoRepository:           photoRepository,
+		creatorDiscountRepository: creatorDiscountRepository,
+		userAdapter:               userAdapter,
+		transactionAdapter:        transactionAdapter,
+		cacheAdapter:              cacheAdapter,
+		cdnAdapter:                cdnAdapter,
+		logs:                      logs,
+	}
+}
+
+func (u *creatorProfileUseCase) GetCreatorProfile(ctx context.Context, request *model.GetCreatorProfileRequest) (*model.CreatorProfileResponse, error) {
+	cacheKey := CreatorProfileCacheKey(request.CreatorId)
+	profileJson, err := u.cacheAdapter.Get(ctx, cacheKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// The cache is only a shortcut, a cache outage falls through to building the profile from the source
+		u.logs.CustomError("failed to get cached creator profile", err)
+	}
+
+	if err == nil {
+		response := new(model.CreatorProfileResponse)
+		if err := sonic.ConfigFastest.Unmarshal([]byte(profileJson), response); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to unmarshal creator profile", err)
+		}
+		return response, nil
+	}
+
+	response, err := u.buildCreatorProfile(ctx, request.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	profileByte, err := sonic.ConfigFastest.Marshal(response)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to marshal creator profile", err)
+	}
+
+	// The profile is already built, a cache outage only costs the next request a rebuild
+	if err := u.cacheAdapter.Set(ctx, cacheKey, profileByte, CreatorProfileCacheTTL); err != nil {
+		u.logs.CustomError("failed to save creator profile to cache", err)
+	}
+
+	return response, nil
+}
+
+// InvalidateCreatorProfile drops the cached profile of the creator, the next request rebuilds it
+func (u *creatorProfileUseCase) InvalidateCreatorProfile(ctx context.Context, creatorId string) error {
+	if err := u.cacheAdapter.Del(ctx, CreatorProfileCacheKey(creatorId)); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to invalidate creator profile cache", err)
+	}
+
+	return nil
+}
+
+// InvalidateUserCreatorProfile drops the cached profile of the creator owned by the user, users that are not creators
+// have nothing cached
+func (u *creatorProfileUseCase) InvalidateUserCreatorProfile(ctx context.Context, userId string) error {
+	creatorId, err := u.creatorRepository.FindIdByUserId(ctx, u.db, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find creator id by user id", err)
+	}
+
+	return u.InvalidateCreatorProfile(ctx, creatorId)
+}
+
+func (u *creatorProfileUseCase) buildCreatorProfile(ctx context.Context, creatorId string) (*model.CreatorProfileResponse, error) {
+	creator, err := u.creatorRepository.FindById(ctx, u.db, creatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Creator not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator by id", err)
+	}
+
+	userProfile, err := u.userAdapter.GetPublicUserProfile(ctx, creator.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewSummary, err := u.transactionAdapter.GetCreatorReviewSummary(ctx, creator.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := u.bulkPhotoRepository.FindRecentEventByCreatorId(ctx, u.db, creator.Id, creatorProfileRecentEventLimit)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator recent events", err)
+	}
+
+	samples, err := u.photoRepository.FindSampleByCreatorId(ctx, u.db, creator.Id, creatorProfileSamplePhotoLimit)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator sample photos", err)
+	}
+
+	discounts, err := u.creatorDiscountRepository.GetDiscountRules(ctx, []string{creator.Id})
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator active discounts", err)
+	}
+
+	return converter.ToCreatorProfileResponse(creator, userProfile.GetProfile(), reviewSummary.GetSummary(), events, samples, *discounts,
+		u.cdnAdapter.GenerateCDN), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
 	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
 	producer "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/gateway/messaging"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
@@ -27,23 +28,28 @@ import (
 type CreatorUseCase interface {
 	CreateCreator(ctx context.Context, req *model.CreateCreatorRequest) (*model.CreatorResponse, error)
 	GetCreator(ctx context.Context, req *model.GetCreatorRequest) (*model.CreatorResponse, error)
+	GetCreatorsByIds(ctx context.Context, creatorIds []string) ([]*model.CreatorResponse, error)
+	ListCreatorUserIds(ctx context.Context, request *model.ListCreatorUserIdsRequest) ([]string, error)
 	UpdateCreatorTotalReview(ctx context.Context, req *model.UpdateCreatorTotalRatingRequest) (*model.CreatorResponse, error)
+	VerifyCreator(ctx context.Context, req *model.VerifyCreatorRequest) (*model.CreatorResponse, error)
 }
 
 type creatorUseCase struct {
 	db                *sqlx.DB
 	creatorRepository repository.CreatorRepository
 	cacheAdapter      adapter.CacheAdapter
+	userAdapter       adapter.UserAdapter
 	creatorProducer   producer.CreatorProducer
 	logs              *logger.Log
 }
 
 func NewCreatorUseCase(db *sqlx.DB, creatorRepository repository.CreatorRepository, cacheAdapter adapter.CacheAdapter,
-	creatorProducer producer.CreatorProducer, logs *logger.Log) CreatorUseCase {
+	userAdapter adapter.UserAdapter, creatorProducer producer.CreatorProducer, logs *logger.Log) CreatorUseCase {
 	return &creatorUseCase{
 		db:                db,
 		creatorRepository: creatorRepository,
 		cacheAdapter:      cacheAdapter,
+		userAdapter:       userAdapter,
 		creatorProducer:   creatorProducer,
 		logs:              logs,
 	}
@@ -124,6 +130,36 @@ func (u *creatorUseCase) GetCreator(ctx context.Context, request *model.GetCreat
 	return converter.CreatorToResponse(creator), nil
 }
 
+func (u *creatorUseCase) GetCreatorsByIds(ctx context.Context, creatorIds []string) ([]*model.CreatorResponse, error) {
+	if len(creatorIds) == 0 {
+		return []*model.CreatorResponse{}, nil
+	}
+
+	creators, err := u.creatorRepository.FindManyByIds(ctx, u.db, creatorIds)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creators by ids", err)
+	}
+
+	return converter.CreatorsToResponses(creators), nil
+}
+
+// maxCreatorUserPage caps a page of creator user ids, campaigns page through every creator
+const maxCreatorUserPage = 1000
+
+// ListCreatorUserIds pages through the users owning a creator account, used to target the creators of a campaign
+func (u *creatorUseCase) ListCreatorUserIds(ctx context.Context, request *model.ListCreatorUserIdsRequest) ([]string, error) {
+	if request.Limit <= 0 || request.Limit > maxCreatorUserPage {
+		request.Limit = maxCreatorUserPage
+	}
+
+	userIds, err := u.creatorRepository.FindUserIds(ctx, u.db, request.AfterUserId, request.Limit)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator user ids", err)
+	}
+
+	return userIds, nil
+}
+
 func (u *creatorUseCase) UpdateCreatorTotalReview(ctx context.Context, req *model.UpdateCreatorTotalRatingRequest) (*model.CreatorResponse, error) {
 	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
 	if err != nil {
@@ -152,6 +188,11 @@ func (u *creatorUseCase) UpdateCreatorTotalReview(ctx context.Context, req *mode
 		return nil, err
 	}
 
+	// The profile carries the review summary, drop it first so a failed creator cache write can not leave it stale
+	if err := u.cacheAdapter.Del(ctx, CreatorProfileCacheKey(creator.Id)); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to invalidate creator profile cache", err)
+	}
+
 	creatorByte, err := sonic.ConfigFastest.Marshal(creator)
 	if err != nil {
 		return nil, helper.WrapInternalServerError(u.logs, "failed to marshal creator", err)
@@ -163,3 +204,55 @@ func (u *creatorUseCase) UpdateCreatorTotalReview(ctx context.Context, req *mode
 
 	return converter.CreatorToResponse(creator), nil
 }
+
+// VerifyCreator stamps or clears creators.verified_at and keeps the CREATOR
+// role in user-svc in step with it. The role change happens before commit so
+// a failed gRPC call leaves the creator untouched.
+func (u *creatorUseCase) VerifyCreator(ctx context.Context, req *model.VerifyCreatorRequest) (*model.CreatorResponse, error) {
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	creator, err := u.creatorRepository.FindById(ctx, tx, req.CreatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Creator not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator by id", err)
+	}
+
+	if req.Verified == (creator.VerifiedAt != nil) {
+		return converter.CreatorToResponse(creator), nil
+	}
+
+	now := time.Now()
+	creator.VerifiedAt = nil
+	if req.Verified {
+		creator.VerifiedAt = &now
+	}
+	creator.UpdatedAt = &now
+
+	creator, err = u.creatorRepository.UpdateVerifiedAt(ctx, tx, creator)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to update creator verified at", err)
+	}
+
+	if err = u.userAdapter.SetUserRole(ctx, creator.UserId, string(enum.RoleCreator), req.Verified); err != nil {
+		return nil, err
+	}
+
+	if err := repository.Commit(tx, u.logs); err != nil {
+		return nil, err
+	}
+
+	if err := u.cacheAdapter.Del(ctx, "creator:"+creator.UserId, CreatorProfileCacheKey(creator.Id)); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to invalidate creator cache", err)
+	}
+
+	return converter.CreatorToResponse(creator), nil
+}
@@ -3,9 +3,16 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
 	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
@@ -14,6 +21,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
 
+	"github.com/bytedance/sonic"
 	"github.com/jmoiron/sqlx"
 	oteltrace "go.opentelemetry.io/otel/trace"
 
@@ -24,8 +32,12 @@ import (
 type ExploreUseCase interface {
 	GetUserCart(ctx context.Context, request *model.GetAllCartRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error)
 	GetUserExploreSimilar(ctx context.Context, request *model.GetAllExploreSimilarRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error)
+	GetUserExploreSimilarByCursor(ctx context.Context, request *model.GetAllExploreSimilarRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error)
 	GetUserFavorite(ctx context.Context, request *model.GetAllFavoriteRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error)
 	GetUserWishlist(ctx context.Context, request *model.GetAllWishlistRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error)
+	GetUserCartByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error)
+	GetUserFavoriteByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error)
+	GetUserWishlistByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error)
 	UserAddCart(ctx context.Context, request *model.UserAddCartRequest) error
 	UserAddFavorite(ctx context.Context, request *model.UserAddFavoriteRequest) error
 	UserAddWishlist(ctx context.Context, request *model.UserAddWishlistRequest) error
@@ -55,18 +67,150 @@ func NewExploreUseCase(db *sqlx.DB, exploreRepository repository.ExploreReposito
 	}
 }
 
+// GetUserExploreSimilar serves the page based explore feed, pages are counted across the ranked candidate windows
 func (u *exploreUseCase) GetUserExploreSimilar(ctx context.Context, request *model.GetAllExploreSimilarRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error) {
 	// Memulai span baru jika memang ingin menambahkan tracing khusus di use case
 	_, span := u.tracer.Start(ctx, "exploreUseCase.GetUserExploreSimilar", oteltrace.WithAttributes(attribute.String("user.id", request.UserId)))
 	defer span.End()
 
-	explores, pageMetadata, err := u.exploreRepository.FindAllExploreSimilar(ctx, u.db, request.Page, request.Size, request.Similarity,
-		request.UserId, request.CreatorId, false, false, false)
+	total, err := u.exploreRepository.CountExploreCandidates(ctx, u.db, request.Similarity, request.UserId, request.CreatorId)
 	if err != nil {
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find all explore similar in database", err)
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to count explore candidates in database", err)
 	}
 
-	return converter.ExploresToResponses(&explores, u.CDNAdapter.GenerateCDN), pageMetadata, nil
+	pageMetadata := helper.CalculatePagination(total, request.Page, request.Size)
+	asOf := time.Now()
+
+	window, err := u.loadExploreWindow(ctx, request, nil, asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := pageMetadata.Offset
+	for offset >= len(window.ranked) && window.next != nil {
+		offset -= len(window.ranked)
+		if window, err = u.loadExploreWindow(ctx, request, window.next, asOf); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	page, _, _, err := u.readExplorePage(ctx, request, window, min(offset, len(window.ranked)), asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return u.exploreResponses(request, page), pageMetadata, nil
+}
+
+// GetUserExploreSimilarByCursor serves the keyset paginated explore feed. The cursor keeps the time the first page was
+// ranked so the recency score does not shift between pages.
+func (u *exploreUseCase) GetUserExploreSimilarByCursor(ctx context.Context, request *model.GetAllExploreSimilarRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error) {
+	_, span := u.tracer.Start(ctx, "exploreUseCase.GetUserExploreSimilarByCursor", oteltrace.WithAttributes(attribute.String("user.id", request.UserId)))
+	defer span.End()
+
+	cursor := &exploreCursor{AsOf: time.Now().Unix()}
+	if request.Cursor != "" {
+		decoded, err := decodeExploreCursor(request.Cursor)
+		if err != nil {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+		}
+		cursor = decoded
+	}
+
+	asOf := time.Unix(cursor.AsOf, 0)
+	window, err := u.loadExploreWindow(ctx, request, cursor.Window, asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := 0
+	if cursor.PhotoId != "" {
+		start = len(window.ranked)
+		for i, item := range window.ranked {
+			if item.ranking.Score < cursor.Score || (item.ranking.Score == cursor.Score && item.candidate.PhotoId > cursor.PhotoId) {
+				start = i
+				break
+			}
+		}
+	}
+
+	page, window, next, err := u.readExplorePage(ctx, request, window, start, asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(request.Size, next < len(window.ranked))
+	if cursorMetadata.HasMore {
+		nextCursor := &exploreCursor{AsOf: cursor.AsOf, Window: window.after}
+		if next > 0 {
+			last := window.ranked[next-1]
+			nextCursor.Score, nextCursor.PhotoId = last.ranking.Score, last.candidate.PhotoId
+		}
+
+		encoded, err := encodeExploreCursor(nextCursor)
+		if err != nil {
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to encode explore cursor", err)
+		}
+		cursorMetadata.NextCursor = encoded
+	}
+
+	return u.exploreResponses(request, page), cursorMetadata, nil
+}
+
+// loadExploreWindow ranks the window of candidates that follows after, a nil after loads the first window
+func (u *exploreUseCase) loadExploreWindow(ctx context.Context, request *model.GetAllExploreSimilarRequest,
+	after *model.ExploreCandidateKey, asOf time.Time) (*exploreWindow, error) {
+	candidates, err := u.exploreRepository.FindExploreCandidates(ctx, u.db, request.Similarity, uint32(exploreConfidentSimilarity),
+		request.UserId, request.CreatorId, after, exploreCandidateWindow)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find explore candidates in database", err)
+	}
+
+	window := &exploreWindow{after: after, ranked: rankExploreCandidates(candidates, asOf)}
+	if len(candidates) == exploreCandidateWindow {
+		last := candidates[len(candidates)-1]
+		window.next = &model.ExploreCandidateKey{Similarity: uint32(last.Similarity), OriginalAt: last.OriginalAt, PhotoId: last.PhotoId}
+	}
+
+	return window, nil
+}
+
+// readExplorePage takes request.Size ranked candidates from position start of the window and moves on to the following
+// windows when one runs out. It returns the window and position the next page starts from.
+func (u *exploreUseCase) readExplorePage(ctx context.Context, request *model.GetAllExploreSimilarRequest, window *exploreWindow,
+	start int, asOf time.Time) ([]*rankedExplore, *exploreWindow, int, error) {
+	page := make([]*rankedExplore, 0, request.Size)
+	for {
+		take := min(request.Size-len(page), len(window.ranked)-start)
+		page = append(page, window.ranked[start:start+take]...)
+		start += take
+
+		if start < len(window.ranked) || window.next == nil {
+			return page, window, start, nil
+		}
+
+		next, err := u.loadExploreWindow(ctx, request, window.next, asOf)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		window, start = next, 0
+	}
+}
+
+func (u *exploreUseCase) exploreResponses(request *model.GetAllExploreSimilarRequest, page []*rankedExplore) *[]*model.ExploreUserSimilarResponse {
+	explores := make([]*entity.Explore, 0, len(page))
+	for _, item := range page {
+		explores = append(explores, &item.candidate.Explore)
+	}
+
+	responses := converter.ExploresToResponses(&explores, u.CDNAdapter.GenerateCDN)
+	if request.Debug {
+		for i, response := range *responses {
+			response.Ranking = page[i].ranking
+		}
+	}
+
+	return responses
 }
 
 func (u *exploreUseCase) GetUserWishlist(ctx context.Context, request *model.GetAllWishlistRequest) (*[]*model.ExploreUserSimilarResponse, *model.PageMetadata, error) {
@@ -82,6 +226,43 @@ func (u *exploreUseCase) GetUserWishlist(ctx context.Context, request *model.Get
 	return converter.ExploresToResponses(&explores, u.CDNAdapter.GenerateCDN), pageMetadata, nil
 }
 
+func (u *exploreUseCase) GetUserWishlistByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error) {
+	_, span := u.tracer.Start(ctx, "exploreUseCase.GetUserWishlistByCursor", oteltrace.WithAttributes(attribute.String("user.id", request.UserId)))
+	defer span.End()
+
+	return u.getUserStagePhotoByCursor(ctx, request, enum.PhotoStageWishlist)
+}
+
+func (u *exploreUseCase) GetUserFavoriteByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error) {
+	_, span := u.tracer.Start(ctx, "exploreUseCase.GetUserFavoriteByCursor", oteltrace.WithAttributes(attribute.String("user.id", request.UserId)))
+	defer span.End()
+
+	return u.getUserStagePhotoByCursor(ctx, request, enum.PhotoStageFavorite)
+}
+
+func (u *exploreUseCase) GetUserCartByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error) {
+	_, span := u.tracer.Start(ctx, "exploreUseCase.GetUserCartByCursor", oteltrace.WithAttributes(attribute.String("user.id", request.UserId)))
+	defer span.End()
+
+	return u.getUserStagePhotoByCursor(ctx, request, enum.PhotoStageCart)
+}
+
+func (u *exploreUseCase) getUserStagePhotoByCursor(ctx context.Context, request *model.GetAllStagePhotoByCursorRequest,
+	stage enum.PhotoStageEnum) (*[]*model.ExploreUserSimilarResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	explores, cursorMetadata, err := u.exploreRepository.FindAllExploreStageByCursor(ctx, u.db, cursor, request.Size, request.Similarity,
+		request.UserId, request.CreatorId, stage)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, fmt.Sprintf("failed to find all user %s photo in database", stage), err)
+	}
+
+	return converter.ExploresToResponses(&explores, u.CDNAdapter.GenerateCDN), cursorMetadata, nil
+}
+
 // apa alasanya menggunakan pesimistic lock ?
 func (u *exploreUseCase) UserAddWishlist(ctx context.Context, request *model.UserAddWishlistRequest) error {
 	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
@@ -300,3 +481,135 @@ func (u *exploreUseCase) UserDeleteCart(ctx context.Context, request *model.User
 
 	return nil
 }
+
+const (
+	// exploreCandidateWindow is how many matches are ranked together. The repository returns the most similar and most recent
+	// first, so every window is ranked on its own and the feed continues with the next window
+	exploreCandidateWindow = 500
+	// exploreConfidentSimilarity is the similarity from which a match counts as a sighting of the user at an event
+	exploreConfidentSimilarity = enum.SimilarityLevelSeven
+	// exploreAttendedMinMatch is how many other confident matches in the same bulk upload mark the event as attended
+	exploreAttendedMinMatch = 2
+	exploreRecencyHalfLife  = 30 * 24 * time.Hour
+
+	exploreWeightSimilarity    = 0.40
+	exploreWeightRecency       = 0.20
+	exploreWeightCreatorRating = 0.15
+	exploreWeightEngagement    = 0.15
+	exploreWeightAttended      = 0.10
+)
+
+// exploreCursor points into the ranked window that starts after Window, an empty PhotoId means the start of the window
+type exploreCursor struct {
+	AsOf    int64                      `json:"as_of"`
+	Window  *model.ExploreCandidateKey `json:"window,omitempty"`
+	Score   float64                    `json:"score"`
+	PhotoId string                     `json:"photo_id"`
+}
+
+type exploreWindow struct {
+	after  *model.ExploreCandidateKey
+	ranked []*rankedExplore
+	// next is nil when the window is the last one
+	next *model.ExploreCandidateKey
+}
+
+func encodeExploreCursor(cursor *exploreCursor) (string, error) {
+	payload, err := sonic.ConfigFastest.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func decodeExploreCursor(encoded string) (*exploreCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := new(exploreCursor)
+	if err := sonic.ConfigFastest.Unmarshal(payload, cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.AsOf <= 0 || (cursor.Window == nil && cursor.PhotoId == "") {
+		return nil, fmt.Errorf("incomplete explore cursor")
+	}
+
+	return cursor, nil
+}
+
+type rankedExplore struct {
+	candidate *entity.ExploreCandidate
+	ranking   *model.ExploreRankingResponse
+}
+
+// rankExploreCandidates scores every candidate between 0 and 1 and sorts them by score, ties are broken by photo id
+// so the order is stable for cursor pagination
+func rankExploreCandidates(candidates []*entity.ExploreCandidate, asOf time.Time) []*rankedExplore {
+	ranked := make([]*rankedExplore, 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, &rankedExplore{candidate: candidate, ranking: scoreExploreCandidate(candidate, asOf)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].ranking.Score != ranked[j].ranking.Score {
+			return ranked[i].ranking.Score > ranked[j].ranking.Score
+		}
+		return ranked[i].candidate.PhotoId < ranked[j].candidate.PhotoId
+	})
+
+	return ranked
+}
+
+func scoreExploreCandidate(candidate *entity.ExploreCandidate, asOf time.Time) *model.ExploreRankingResponse {
+	ranking := &model.ExploreRankingResponse{Reasons: make([]string, 0)}
+
+	ranking.Similarity = float64(candidate.Similarity-enum.SimilarityLevelOne) / float64(enum.SimilarityLevelNine-enum.SimilarityLevelOne)
+	if candidate.Similarity >= exploreConfidentSimilarity {
+		ranking.Reasons = append(ranking.Reasons, fmt.Sprintf("high similarity (level %d)", candidate.Similarity))
+	}
+
+	age := max(asOf.Sub(candidate.OriginalAt), 0)
+	ranking.Recency = math.Pow(0.5, float64(age)/float64(exploreRecencyHalfLife))
+	if age <= 7*24*time.Hour {
+		ranking.Reasons = append(ranking.Reasons, "taken in the last 7 days")
+	}
+
+	ranking.CreatorRating = math.Min(float64(candidate.CreatorRating)/5, 1)
+	if candidate.CreatorRating >= 4 {
+		ranking.Reasons = append(ranking.Reasons, fmt.Sprintf("creator rated %.1f", candidate.CreatorRating))
+	}
+
+	switch {
+	case candidate.IsWishlist || candidate.IsFavorite:
+		ranking.Engagement = 1
+		ranking.Reasons = append(ranking.Reasons, "in your wishlist or favorite")
+	case candidate.CreatorAffinity > 0:
+		ranking.Engagement = math.Min(float64(candidate.CreatorAffinity)/5, 1) * 0.7
+		ranking.Reasons = append(ranking.Reasons, fmt.Sprintf("you saved %d photos of this creator", candidate.CreatorAffinity))
+	}
+
+	// The candidate itself is counted in event_match_count when it is a confident match
+	otherMatches := candidate.EventMatchCount
+	if candidate.Similarity >= exploreConfidentSimilarity {
+		otherMatches--
+	}
+
+	if candidate.EventOwnedCount > 0 || otherMatches >= exploreAttendedMinMatch {
+		ranking.Attended = 1
+		ranking.Reasons = append(ranking.Reasons, "from an event you attended")
+	}
+
+	score := exploreWeightSimilarity*ranking.Similarity +
+		exploreWeightRecency*ranking.Recency +
+		exploreWeightCreatorRating*ranking.CreatorRating +
+		exploreWeightEngagement*ranking.Engagement +
+		exploreWeightAttended*ranking.Attended
+
+	// Rounded so the score survives the cursor round trip unchanged
+	ranking.Score = math.Round(score*1e6) / 1e6
+
+	return ranking
+}
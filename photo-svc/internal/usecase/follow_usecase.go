@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	producer "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/gateway/messaging"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	"github.com/jmoiron/sqlx"
+
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+)
+
+const (
+	// followingFeedWindow is how far back the following feed looks for published photos
+	followingFeedWindow = 30 * 24 * time.Hour
+
+	// creatorBatchFollowerChunk bounds the follower ids carried by one creator.batch.published event
+	creatorBatchFollowerChunk = 5000
+)
+
+type FollowUseCase interface {
+	FollowCreator(ctx context.Context, request *model.FollowCreatorRequest) (*model.CreatorFollowResponse, error)
+	UnfollowCreator(ctx context.Context, request *model.UnfollowCreatorRequest) (*model.CreatorFollowResponse, error)
+	GetCreatorFollow(ctx context.Context, request *model.GetCreatorFollowRequest) (*model.CreatorFollowResponse, error)
+	GetFollowers(ctx context.Context, request *model.GetCreatorFollowersRequest) ([]*model.CreatorFollowerResponse, *model.CursorMetadata, error)
+	GetFollowing(ctx context.Context, request *model.GetFollowingRequest) ([]*model.FollowedCreatorResponse, *model.CursorMetadata, error)
+	GetFollowingFeed(ctx context.Context, request *model.GetFollowingFeedRequest) ([]*model.FollowingFeedPhotoResponse, *model.CursorMetadata, error)
+}
+
+type followUseCase struct {
+	db                      *sqlx.DB
+	creatorRepository       repository.CreatorRepository
+	creatorFollowRepository repository.CreatorFollowRepository
+	userAdapter             adapter.UserAdapter
+	cdnAdapter              adapter.CDNAdapter
+	logs                    *logger.Log
+}
+
+func NewFollowUseCase(db *sqlx.DB, creatorRepository repository.CreatorRepository, creatorFollowRepository repository.CreatorFollowRepository,
+	userAdapter adapter.UserAdapter, cdnAdapter adapter.CDNAdapter, logs *logger.Log) FollowUseCase {
+	return &followUseCase{
+		db:                      db,
+		creatorRepository:       creatorRepository,
+		creatorFollowRepository: creatorFollowRepository,
+		userAdapter:             userAdapter,
+		cdnAdapter:              cdnAdapter,
+		logs:                    logs,
+	}
+}
+
+// FollowCreator is idempotent, following a creator twice returns the current follow state
+func (u *followUseCase) FollowCreator(ctx context.Context, request *model.FollowCreatorRequest) (*model.CreatorFollowResponse, error) {
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	var creator *entity.Creator
+	creator, err = u.creatorRepository.FindById(ctx, tx, request.CreatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Creator not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator by id", err)
+	}
+
+	if creator.UserId == request.UserId {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "You cannot follow yourself")
+	}
+
+	creatorFollow := &entity.CreatorFollow{
+		FollowerUserId: request.UserId,
+		CreatorId:      creator.Id,
+		CreatedAt:      time.Now(),
+	}
+
+	var inserted bool
+	inserted, err = u.creatorFollowRepository.Insert(ctx, tx, creatorFollow)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to insert creator follow", err)
+	}
+
+	if inserted {
+		if err = u.creatorRepository.AddFollowerCount(ctx, tx, creator.Id, 1); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to increment creator follower count", err)
+		}
+		creator.FollowerCount++
+	}
+
+	if err = repository.Commit(tx, u.logs); err != nil {
+		return nil, err
+	}
+
+	return &model.CreatorFollowResponse{
+		CreatorId:     creator.Id,
+		IsFollowing:   true,
+		FollowerCount: creator.FollowerCount,
+	}, nil
+}
+
+// UnfollowCreator is idempotent, unfollowing a creator that is not followed returns the current follow state
+func (u *followUseCase) UnfollowCreator(ctx context.Context, request *model.UnfollowCreatorRequest) (*model.CreatorFollowResponse, error) {
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	var creator *entity.Creator
+	creator, err = u.creatorRepository.FindById(ctx, tx, request.CreatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Creator not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator by id", err)
+	}
+
+	var deleted bool
+	deleted, err = u.creatorFollowRepository.Delete(ctx, tx, request.UserId, creator.Id)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to delete creator follow", err)
+	}
+
+	if deleted {
+		if err = u.creatorRepository.AddFollowerCount(ctx, tx, creator.Id, -1); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to decrement creator follower count", err)
+		}
+		creator.FollowerCount = max(creator.FollowerCount-1, 0)
+	}
+
+	if err = repository.Commit(tx, u.logs); err != nil {
+		return nil, err
+	}
+
+	return &model.CreatorFollowResponse{
+		CreatorId:     creator.Id,
+		IsFollowing:   false,
+		FollowerCount: creator.FollowerCount,
+	}, nil
+}
+
+func (u *followUseCase) GetCreatorFollow(ctx context.Context, request *model.GetCreatorFollowRequest) (*model.CreatorFollowResponse, error) {
+	creator, err := u.creatorRepository.FindById(ctx, u.db, request.CreatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Creator not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find creator by id", err)
+	}
+
+	isFollowing, err := u.creatorFollowRepository.IsFollowing(ctx, u.db, request.UserId, creator.Id)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to check creator follow", err)
+	}
+
+	return &model.CreatorFollowResponse{
+		CreatorId:     creator.Id,
+		IsFollowing:   isFollowing,
+		FollowerCount: creator.FollowerCount,
+	}, nil
+}
+
+func (u *followUseCase) GetFollowers(ctx context.Context, request *model.GetCreatorFollowersRequest) ([]*model.CreatorFollowerResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	followers, cursorMetadata, err := u.creatorFollowRepository.FindFollowersByCursor(ctx, u.db, request.CreatorId, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find creator followers", err)
+	}
+
+	userIds := make([]string, 0, len(followers))
+	for _, follower := range followers {
+		userIds = append(userIds, follower.FollowerUserId)
+	}
+
+	return converter.CreatorFollowersToResponses(followers, u.findPublicProfiles(ctx, userIds)), cursorMetadata, nil
+}
+
+func (u *followUseCase) GetFollowing(ctx context.Context, request *model.GetFollowingRequest) ([]*model.FollowedCreatorResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	creators, cursorMetadata, err := u.creatorFollowRepository.FindFollowingByCursor(ctx, u.db, request.UserId, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find followed creators", err)
+	}
+
+	userIds := make([]string, 0, len(creators))
+	for _, creator := range creators {
+		userIds = append(userIds, creator.UserId)
+	}
+
+	return converter.FollowedCreatorsToResponses(creators, u.findPublicProfiles(ctx, userIds)), cursorMetadata, nil
+}
+
+func (u *followUseCase) GetFollowingFeed(ctx context.Context, request *model.GetFollowingFeedRequest) ([]*model.FollowingFeedPhotoResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	since := time.Now().Add(-followingFeedWindow)
+	photos, cursorMetadata, err := u.creatorFollowRepository.FindFeedByCursor(ctx, u.db, request.UserId, since, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find following feed", err)
+	}
+
+	return converter.FollowingFeedPhotosToResponses(photos, u.cdnAdapter.GenerateCDN), cursorMetadata, nil
+}
+
+// findPublicProfiles looks up the public profiles of a page of users concurrently. A failed lookup only leaves
+// the profile out so a deleted user does not break the whole list.
+func (u *followUseCase) findPublicProfiles(ctx context.Context, userIds []string) map[string]*userpb.PublicUserProfile {
+	profiles := make(map[string]*userpb.PublicUserProfile, len(userIds))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, userId := range userIds {
+		wg.Add(1)
+		go func(userId string) {
+			defer wg.Done()
+			response, err := u.userAdapter.GetPublicUserProfile(ctx, userId)
+			if err != nil {
+				u.logs.CustomError("failed to get public user profile", err)
+				return
+			}
+
+			mu.Lock()
+			profiles[userId] = response.GetProfile()
+			mu.Unlock()
+		}(userId)
+	}
+
+	wg.Wait()
+	return profiles
+}
+
+// produceCreatorBatchPublished tells the followers of a creator about a finished bulk upload. Followers who matched
+// a photo of the batch already get the similar photo notification so they are left out.
+func produceCreatorBatchPublished(ctx context.Context, db *sqlx.DB, creatorFollowRepository repository.CreatorFollowRepository,
+	creatorProducer producer.CreatorProducer, logs *logger.Log, creatorId, bulkPhotoId string, totalPhoto int, matchedUserCountMap map[string]int32) {
+	followerUserIds, err := creatorFollowRepository.FindAllFollowerUserIds(ctx, db, creatorId)
+	if err != nil {
+		logs.CustomError("failed to find creator follower user ids", err)
+		return
+	}
+
+	recipients := make([]string, 0, len(followerUserIds))
+	for _, followerUserId := range followerUserIds {
+		if _, matched := matchedUserCountMap[followerUserId]; !matched {
+			recipients = append(recipients, followerUserId)
+		}
+	}
+
+	publishedAt := time.Now()
+	for start := 0; start < len(recipients); start += creatorBatchFollowerChunk {
+		end := min(start+creatorBatchFollowerChunk, len(recipients))
+		batchEvent := &event.CreatorBatchPublishedEvent{
+			EventID:         uuid.NewString(),
+			CreatorId:       creatorId,
+			BulkPhotoId:     bulkPhotoId,
+			TotalPhoto:      totalPhoto,
+			FollowerUserIds: recipients[start:end],
+			PublishedAt:     publishedAt,
+		}
+		if err := creatorProducer.ProduceCreatorBatchPublished(ctx, batchEvent); err != nil {
+			logs.Error(err)
+		}
+	}
+}
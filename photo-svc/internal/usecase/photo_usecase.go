@@ -33,6 +33,7 @@ type PhotoUseCase interface {
 	GetBulkPhotoDetail(ctx context.Context, request *model.GetBulkPhotoDetailRequest) (*model.GetBulkPhotoDetailResponse, error)
 	GetPhotoFile(ctx context.Context, filename string) (io.ReadCloser, error)
 	UserGetPhotoWithDetail(ctx context.Context, photoIDs []string, userID string) (*photopb.GetPhotoWithDetailsResponse, error)
+	GetChatPhotoPreviews(ctx context.Context, request *model.GetChatPhotoPreviewsRequest) ([]*photopb.ChatPhotoPreview, error)
 }
 
 type photoUsecase struct {
@@ -318,3 +319,24 @@ func (u *photoUsecase) UserGetPhotoWithDetail(ctx context.Context, photoIDs []st
 
 	return converter.PhotoWithDetailsToGRPC(&object, u.CDNAdapter.GenerateCDN), nil
 }
+
+// maxChatPhotoPreviews caps the photos shared in a single chat message
+const maxChatPhotoPreviews = 10
+
+// GetChatPhotoPreviews leaves out the photos the user cannot see, the caller decides whether a missing one is an error
+func (u *photoUsecase) GetChatPhotoPreviews(ctx context.Context, request *model.GetChatPhotoPreviewsRequest) ([]*photopb.ChatPhotoPreview, error) {
+	if request.UserId == "" || len(request.PhotoIds) == 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User id and photo ids are required")
+	}
+
+	if len(request.PhotoIds) > maxChatPhotoPreviews {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Too many photo ids")
+	}
+
+	previews, err := u.photoRepo.FindVisiblePreviewsByIds(ctx, u.db, request.UserId, request.PhotoIds)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find visible chat photo previews", err)
+	}
+
+	return converter.ChatPhotoPreviewsToGRPC(previews, u.CDNAdapter.GenerateCDN), nil
+}
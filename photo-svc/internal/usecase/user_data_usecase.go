@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+
+	photopb "github.com/hervibest/be-yourmoments-backup/pb/photo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type UserDataUseCase interface {
+	EraseUserData(ctx context.Context, request *model.EraseUserDataRequest) error
+	GetUserDataExport(ctx context.Context, userId string) (*photopb.GetUserDataExportResponse, error)
+}
+
+type userDataUseCase struct {
+	db                *sqlx.DB
+	facecamRepo       repository.FacecamRepository
+	userSimilarRepo   repository.UserSimilarRepository
+	photoRepo         repository.PhotoRepository
+	voucherRepository repository.VoucherRepository
+	creatorFollowRepo repository.CreatorFollowRepository
+	storageAdapter    adapter.StorageAdapter
+	logs              *logger.Log
+}
+
+func NewUserDataUseCase(db *sqlx.DB, facecamRepo repository.FacecamRepository, userSimilarRepo repository.UserSimilarRepository,
+	photoRepo repository.PhotoRepository, voucherRepository repository.VoucherRepository, creatorFollowRepo repository.CreatorFollowRepository,
+	storageAdapter adapter.StorageAdapter, logs *logger.Log) UserDataUseCase {
+	return &userDataUseCase{
+		db:                db,
+		facecamRepo:       facecamRepo,
+		userSimilarRepo:   userSimilarRepo,
+		photoRepo:         photoRepo,
+		voucherRepository: voucherRepository,
+		creatorFollowRepo: creatorFollowRepo,
+		storageAdapter:    storageAdapter,
+		logs:              logs,
+	}
+}
+
+// EraseUserData removes the facecams, similarity rows and follows of a deleted user and moves
+// purchases and voucher redemptions to the pseudonym shared with the other services.
+// Running it twice for the same user is a no-op.
+func (u *userDataUseCase) EraseUserData(ctx context.Context, request *model.EraseUserDataRequest) error {
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	var facecams []*entity.Facecam
+	facecams, err = u.facecamRepo.DeleteByUserId(ctx, tx, request.UserId)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user facecams", err)
+	}
+
+	if err = u.userSimilarRepo.DeleteByUserId(ctx, tx, request.UserId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user similar photos", err)
+	}
+
+	if err = u.creatorFollowRepo.DeleteByFollowerUserId(ctx, tx, request.UserId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user creator follows", err)
+	}
+
+	if err = u.photoRepo.PseudonymizeOwner(ctx, tx, request.UserId, request.PseudonymId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to pseudonymize photo owner", err)
+	}
+
+	if err = u.voucherRepository.PseudonymizeRedemptions(ctx, tx, request.UserId, request.PseudonymId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to pseudonymize voucher redemptions", err)
+	}
+
+	if err = repository.Commit(tx, u.logs); err != nil {
+		return err
+	}
+
+	for _, facecam := range facecams {
+		if _, err := u.storageAdapter.DeleteFile(ctx, facecam.FileKey); err != nil {
+			u.logs.CustomError("failed to delete facecam file", err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserDataExport collects the purchases and facecams of a user for the data export built by user-svc
+func (u *userDataUseCase) GetUserDataExport(ctx context.Context, userId string) (*photopb.GetUserDataExportResponse, error) {
+	if userId == "" {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User id is required")
+	}
+
+	purchases, err := u.photoRepo.FindAllOwnedByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user purchased photos", err)
+	}
+
+	facecams, err := u.facecamRepo.FindAllByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user facecams", err)
+	}
+
+	return converter.UserDataExportToGRPC(purchases, facecams), nil
+}
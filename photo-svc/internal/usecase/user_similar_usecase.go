@@ -13,9 +13,11 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
 	producer "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/gateway/messaging"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/event"
 	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
 
@@ -29,34 +31,40 @@ type UserSimilarUsecase interface {
 	CreateUserSimilar(ctx context.Context, request *photopb.CreateUserSimilarPhotoRequest) error
 	CreateUserFacecam(ctx context.Context, request *photopb.CreateUserSimilarFacecamRequest) error
 	CreateBulkUserSimilarPhotos(ctx context.Context, request *photopb.CreateBulkUserSimilarPhotoRequest) error
+	ListEventAttendeeUserIds(ctx context.Context, request *model.ListEventAttendeeUserIdsRequest) ([]string, error)
 }
 
 type userSimilarUsecase struct {
-	db              *sqlx.DB
-	photoRepo       repository.PhotoRepository
-	photoDetailRepo repository.PhotoDetailRepository
-	facecamRepo     repository.FacecamRepository
-	userSimilarRepo repository.UserSimilarRepository
-	bulkPhotoRepo   repository.BulkPhotoRepository
-	userAdapter     adapter.UserAdapter
-	photoProducer   producer.PhotoProducer
-	logs            *logger.Log
+	db                *sqlx.DB
+	photoRepo         repository.PhotoRepository
+	photoDetailRepo   repository.PhotoDetailRepository
+	facecamRepo       repository.FacecamRepository
+	userSimilarRepo   repository.UserSimilarRepository
+	bulkPhotoRepo     repository.BulkPhotoRepository
+	creatorFollowRepo repository.CreatorFollowRepository
+	userAdapter       adapter.UserAdapter
+	photoProducer     producer.PhotoProducer
+	creatorProducer   producer.CreatorProducer
+	logs              *logger.Log
 }
 
 func NewUserSimilarUsecase(db *sqlx.DB, photoRepo repository.PhotoRepository,
 	photoDetailRepo repository.PhotoDetailRepository, facecamRepo repository.FacecamRepository,
 	userSimilarRepo repository.UserSimilarRepository, bulkPhotoRepo repository.BulkPhotoRepository,
-	userAdapter adapter.UserAdapter, photoProducer producer.PhotoProducer, logs *logger.Log) UserSimilarUsecase {
+	creatorFollowRepo repository.CreatorFollowRepository, userAdapter adapter.UserAdapter, photoProducer producer.PhotoProducer,
+	creatorProducer producer.CreatorProducer, logs *logger.Log) UserSimilarUsecase {
 	return &userSimilarUsecase{
-		db:              db,
-		photoRepo:       photoRepo,
-		photoDetailRepo: photoDetailRepo,
-		facecamRepo:     facecamRepo,
-		userSimilarRepo: userSimilarRepo,
-		bulkPhotoRepo:   bulkPhotoRepo,
-		userAdapter:     userAdapter,
-		photoProducer:   photoProducer,
-		logs:            logs,
+		db:                db,
+		photoRepo:         photoRepo,
+		photoDetailRepo:   photoDetailRepo,
+		facecamRepo:       facecamRepo,
+		userSimilarRepo:   userSimilarRepo,
+		bulkPhotoRepo:     bulkPhotoRepo,
+		creatorFollowRepo: creatorFollowRepo,
+		userAdapter:       userAdapter,
+		photoProducer:     photoProducer,
+		creatorProducer:   creatorProducer,
+		logs:              logs,
 	}
 }
 
@@ -144,6 +152,7 @@ func (u *userSimilarUsecase) CreateUserSimilar(ctx context.Context, request *pho
 		go func() {
 			singlePhotoEvent := &event.SinglePhotoEvent{
 				EventID: uuid.NewString(),
+				PhotoID: request.GetPhotoDetail().GetPhotoId(),
 				UserIDs: userIds,
 			}
 			if err := u.photoProducer.ProduceSinglePhoto(ctx, singlePhotoEvent); err != nil {
@@ -212,6 +221,7 @@ func (u *userSimilarUsecase) CreateUserFacecam(ctx context.Context, request *pho
 				EventID:     uuid.NewString(),
 				UserID:      request.GetFacecam().GetUserId(),
 				CountPhotos: len(request.GetUserSimilarPhoto()),
+				PhotoIDs:    notificationPhotoIds(photoIDs),
 			}
 			if err := u.photoProducer.ProduceSingleFacecam(ctx, singleFacecamEvent); err != nil {
 				u.logs.Error(err)
@@ -319,8 +329,10 @@ func (u *userSimilarUsecase) CreateBulkUserSimilarPhotos(ctx context.Context, re
 	if countMap != nil {
 		go func() {
 			bulkPhotoEvent := &event.BulkPhotoEvent{
-				EventID:      uuid.NewString(),
-				UserCountMap: countMap,
+				EventID:         uuid.NewString(),
+				BulkPhotoID:     request.GetBulkPhoto().GetId(),
+				UserCountMap:    countMap,
+				UserPhotoIDsMap: groupPhotoIdsByUser(photoUserSimilarMap),
 			}
 			if err := u.photoProducer.ProduceBulkPhoto(ctx, bulkPhotoEvent); err != nil {
 				u.logs.Error(err)
@@ -328,6 +340,9 @@ func (u *userSimilarUsecase) CreateBulkUserSimilarPhotos(ctx context.Context, re
 		}()
 	}
 
+	go produceCreatorBatchPublished(ctx, u.db, u.creatorFollowRepo, u.creatorProducer, u.logs, request.GetBulkPhoto().GetCreatorId(),
+		request.GetBulkPhoto().GetId(), len(request.GetBulkUserSimilarPhoto()), countMap)
+
 	return nil
 }
 
@@ -411,3 +426,48 @@ func (u *userSimilarUsecase) countPhotosParallel(datas []*photopb.BulkUserSimila
 
 	return countMap
 }
+
+// maxEventAttendeePage caps a page of event attendees, campaigns page through bigger events
+const maxEventAttendeePage = 1000
+
+// ListEventAttendeeUserIds pages through the users recognized in the photos of a bulk upload, used to target the
+// attendees of an event
+func (u *userSimilarUsecase) ListEventAttendeeUserIds(ctx context.Context, request *model.ListEventAttendeeUserIdsRequest) ([]string, error) {
+	if request.BulkPhotoId == "" {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Bulk photo id is required")
+	}
+
+	if request.Limit <= 0 || request.Limit > maxEventAttendeePage {
+		request.Limit = maxEventAttendeePage
+	}
+
+	userIds, err := u.userSimilarRepo.FindUserIdsByBulkPhotoId(ctx, u.db, request.BulkPhotoId, request.AfterUserId, request.Limit)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find event attendee user ids", err)
+	}
+
+	return userIds, nil
+}
+
+// maxNotificationPhotoIds caps the photo ids sent for a notification deep link, the app loads the rest from the list
+const maxNotificationPhotoIds = 20
+
+func notificationPhotoIds(photoIds []string) []string {
+	if len(photoIds) > maxNotificationPhotoIds {
+		return photoIds[:maxNotificationPhotoIds]
+	}
+	return photoIds
+}
+
+// groupPhotoIdsByUser turns the photo to similar users map into the photo ids each user appears in
+func groupPhotoIdsByUser(photoUserSimilarMap map[string][]*entity.UserSimilarPhoto) map[string][]string {
+	userPhotoIdsMap := make(map[string][]string)
+	for photoId, userSimilars := range photoUserSimilarMap {
+		for _, userSimilar := range userSimilars {
+			if len(userPhotoIdsMap[userSimilar.UserId]) < maxNotificationPhotoIds {
+				userPhotoIdsMap[userSimilar.UserId] = append(userPhotoIdsMap[userSimilar.UserId], photoId)
+			}
+		}
+	}
+	return userPhotoIdsMap
+}
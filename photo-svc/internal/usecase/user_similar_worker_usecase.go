@@ -30,31 +30,36 @@ type UserSimilarWorkerUseCase interface {
 }
 
 type userSimilarWorkerUseCase struct {
-	db              *sqlx.DB
-	photoRepo       repository.PhotoRepository
-	photoDetailRepo repository.PhotoDetailRepository
-	facecamRepo     repository.FacecamRepository
-	userSimilarRepo repository.UserSimilarRepository
-	bulkPhotoRepo   repository.BulkPhotoRepository
-	userAdapter     adapter.UserAdapter
-	photoProducer   producer.PhotoProducer
-	logs            *logger.Log
+	db                *sqlx.DB
+	photoRepo         repository.PhotoRepository
+	photoDetailRepo   repository.PhotoDetailRepository
+	facecamRepo       repository.FacecamRepository
+	userSimilarRepo   repository.UserSimilarRepository
+	bulkPhotoRepo     repository.BulkPhotoRepository
+	creatorFollowRepo repository.CreatorFollowRepository
+	userAdapter       adapter.UserAdapter
+	photoProducer     producer.PhotoProducer
+	creatorProducer   producer.CreatorProducer
+	logs              *logger.Log
 }
 
 func NewUserSimilarWorkerUseCase(db *sqlx.DB, photoRepo repository.PhotoRepository,
 	photoDetailRepo repository.PhotoDetailRepository, facecamRepo repository.FacecamRepository,
 	userSimilarRepo repository.UserSimilarRepository, bulkPhotoRepo repository.BulkPhotoRepository,
-	userAdapter adapter.UserAdapter, photoProducer producer.PhotoProducer, logs *logger.Log) UserSimilarWorkerUseCase {
+	creatorFollowRepo repository.CreatorFollowRepository, userAdapter adapter.UserAdapter, photoProducer producer.PhotoProducer,
+	creatorProducer producer.CreatorProducer, logs *logger.Log) UserSimilarWorkerUseCase {
 	return &userSimilarWorkerUseCase{
-		db:              db,
-		photoRepo:       photoRepo,
-		photoDetailRepo: photoDetailRepo,
-		facecamRepo:     facecamRepo,
-		userSimilarRepo: userSimilarRepo,
-		bulkPhotoRepo:   bulkPhotoRepo,
-		userAdapter:     userAdapter,
-		photoProducer:   photoProducer,
-		logs:            logs,
+		db:                db,
+		photoRepo:         photoRepo,
+		photoDetailRepo:   photoDetailRepo,
+		facecamRepo:       facecamRepo,
+		userSimilarRepo:   userSimilarRepo,
+		bulkPhotoRepo:     bulkPhotoRepo,
+		creatorFollowRepo: creatorFollowRepo,
+		userAdapter:       userAdapter,
+		photoProducer:     photoProducer,
+		creatorProducer:   creatorProducer,
+		logs:              logs,
 	}
 }
 
@@ -142,6 +147,7 @@ func (u *userSimilarWorkerUseCase) CreateUserSimilar(ctx context.Context, reques
 		go func() {
 			singlePhotoEvent := &event.SinglePhotoEvent{
 				EventID: uuid.NewString(),
+				PhotoID: request.PhotoDetail.PhotoID,
 				UserIDs: userIds,
 			}
 			if err := u.photoProducer.ProduceSinglePhoto(ctx, singlePhotoEvent); err != nil {
@@ -214,6 +220,7 @@ func (u *userSimilarWorkerUseCase) CreateUserFacecam(ctx context.Context, reques
 				EventID:     uuid.NewString(),
 				UserID:      request.Facecam.UserId,
 				CountPhotos: len(request.UserSimilarPhoto),
+				PhotoIDs:    notificationPhotoIds(photoIDs),
 			}
 			if err := u.photoProducer.ProduceSingleFacecam(ctx, singleFacecamEvent); err != nil {
 				u.logs.Error(err)
@@ -338,8 +345,10 @@ func (u *userSimilarWorkerUseCase) CreateBulkUserSimilarPhotos(ctx context.Conte
 	if countMap != nil {
 		go func() {
 			bulkPhotoEvent := &event.BulkPhotoEvent{
-				EventID:      uuid.NewString(),
-				UserCountMap: countMap,
+				EventID:         uuid.NewString(),
+				BulkPhotoID:     request.BulkPhoto.Id,
+				UserCountMap:    countMap,
+				UserPhotoIDsMap: groupPhotoIdsByUser(photoUserSimilarMap),
 			}
 			if err := u.photoProducer.ProduceBulkPhoto(ctx, bulkPhotoEvent); err != nil {
 				u.logs.Error(err)
@@ -347,6 +356,9 @@ func (u *userSimilarWorkerUseCase) CreateBulkUserSimilarPhotos(ctx context.Conte
 		}()
 	}
 
+	go produceCreatorBatchPublished(ctx, u.db, u.creatorFollowRepo, u.creatorProducer, u.logs, request.BulkPhoto.CreatorId, request.BulkPhoto.Id,
+		len(request.BulkUserSimilarPhoto), countMap)
+
 	return nil
 }
 
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+)
+
+type VoucherUseCase interface {
+	CreateVoucher(ctx context.Context, request *model.CreateVoucherRequest) (*model.VoucherResponse, error)
+	ActivateVoucher(ctx context.Context, request *model.ActivateVoucherRequest) error
+	DeactivateVoucher(ctx context.Context, request *model.DeactivateVoucherRequest) error
+	GetVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error)
+	GetAllVoucher(ctx context.Context, creatorId string) (*[]*model.VoucherResponse, error)
+}
+
+type voucherUseCase struct {
+	db                *sqlx.DB
+	voucherRepository repository.VoucherRepository
+	logs              *logger.Log
+}
+
+func NewVoucherUseCase(db *sqlx.DB, voucherRepository repository.VoucherRepository, logs *logger.Log) VoucherUseCase {
+	return &voucherUseCase{
+		db:                db,
+		voucherRepository: voucherRepository,
+		logs:              logs,
+	}
+}
+
+// Creator can only create CREATOR funded vouchers, PLATFORM vouchers are created through the admin
+// routes and are not owned by any creator
+func (u *voucherUseCase) CreateVoucher(ctx context.Context, request *model.CreateVoucherRequest) (*model.VoucherResponse, error) {
+	fundedBy, creatorId, err := resolveVoucherFunder(request)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.DiscountType {
+	case enum.DiscountTypeFlat:
+	case enum.DiscountTypePercent:
+		if request.Value > 100 {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Percent voucher value must not be greater than 100")
+		}
+	default:
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid voucher discount type")
+	}
+
+	now := time.Now()
+	if err := validateDiscountWindow(request.StartsAt, request.EndsAt, now); err != nil {
+		return nil, err
+	}
+
+	code := strings.ToUpper(request.Code)
+	exists, err := u.voucherRepository.ExistsByCode(ctx, u.db, code)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to check voucher code", err)
+	}
+
+	if exists {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Voucher code already exists")
+	}
+
+	minQuantity := request.MinQuantity
+	if minQuantity == 0 {
+		minQuantity = 1
+	}
+
+	voucher := &entity.Voucher{
+		Id:                ulid.Make().String(),
+		Code:              code,
+		CreatorId:         creatorId,
+		FundedBy:          fundedBy,
+		Name:              request.Name,
+		DiscountType:      request.DiscountType,
+		Value:             request.Value,
+		MaxDiscount:       nullable.ToSQLInt32(request.MaxDiscount),
+		MinQuantity:       minQuantity,
+		UsageLimit:        nullable.ToSQLInt32(request.UsageLimit),
+		UsageLimitPerUser: nullable.ToSQLInt32(request.UsageLimitPerUser),
+		IsStackable:       request.IsStackable,
+		IsActive:          request.IsActive,
+		StartsAt:          nullable.ToSQLTime(request.StartsAt),
+		EndsAt:            nullable.ToSQLTime(request.EndsAt),
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
+	}
+
+	voucher, err = u.voucherRepository.Create(ctx, u.db, voucher)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to create voucher to database", err)
+	}
+
+	return converter.VoucherToResponse(voucher), nil
+}
+
+// The funder defaults to the caller scope, a creator funds its own vouchers and the admin routes fund
+// platform vouchers
+func resolveVoucherFunder(request *model.CreateVoucherRequest) (enum.VoucherFunder, sql.NullString, error) {
+	fundedBy := request.FundedBy
+	if fundedBy == "" {
+		fundedBy = enum.VoucherFunderCreator
+		if request.CreatorId == "" {
+			fundedBy = enum.VoucherFunderPlatform
+		}
+	}
+
+	switch fundedBy {
+	case enum.VoucherFunderCreator:
+		if request.CreatorId == "" {
+			return "", sql.NullString{}, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Creator funded voucher must belong to a creator")
+		}
+		return fundedBy, sql.NullString{String: request.CreatorId, Valid: true}, nil
+	case enum.VoucherFunderPlatform:
+		if !request.CanFundPlatform {
+			return "", sql.NullString{}, helper.NewUseCaseError(errorcode.ErrForbidden, "Only admin can create platform funded voucher")
+		}
+		return fundedBy, sql.NullString{}, nil
+	default:
+		return "", sql.NullString{}, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid voucher funder")
+	}
+}
+
+func (u *voucherUseCase) ActivateVoucher(ctx context.Context, request *model.ActivateVoucherRequest) error {
+	return u.updateIsActive(ctx, request.Id, request.CreatorId, true)
+}
+
+func (u *voucherUseCase) DeactivateVoucher(ctx context.Context, request *model.DeactivateVoucherRequest) error {
+	return u.updateIsActive(ctx, request.Id, request.CreatorId, false)
+}
+
+func (u *voucherUseCase) updateIsActive(ctx context.Context, voucherId, creatorId string, isActive bool) error {
+	if _, err := u.voucherRepository.FindByIdAndCreatorId(ctx, u.db, voucherId, creatorId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Invalid voucher id")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find voucher by voucher id", err)
+	}
+
+	if err := u.voucherRepository.UpdateIsActive(ctx, u.db, voucherId, creatorId, isActive); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to update voucher active status in database", err)
+	}
+
+	return nil
+}
+
+func (u *voucherUseCase) GetVoucher(ctx context.Context, request *model.GetVoucherRequest) (*model.VoucherResponse, error) {
+	voucher, err := u.voucherRepository.FindByIdAndCreatorId(ctx, u.db, request.Id, request.CreatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Voucher not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find voucher by voucher id", err)
+	}
+
+	return converter.VoucherToResponse(voucher), nil
+}
+
+func (u *voucherUseCase) GetAllVoucher(ctx context.Context, creatorId string) (*[]*model.VoucherResponse, error) {
+	vouchers, err := u.voucherRepository.FindAllByCreatorId(ctx, u.db, creatorId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find all voucher", err)
+	}
+	return converter.VouchersToResponses(*vouchers), nil
+}
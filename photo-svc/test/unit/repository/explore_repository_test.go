@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newExploreRepository(t *testing.T) repository.ExploreRepository {
+	exploreRepository, err := repository.NewExploreRepository(nil)
+	require.NoError(t, err)
+	return exploreRepository
+}
+
+// explorePhotos returns photos taken one hour apart from the newest
+func explorePhotos(newest time.Time, photoIds ...string) []*entity.Explore {
+	photos := make([]*entity.Explore, 0, len(photoIds))
+	for i, photoId := range photoIds {
+		photos = append(photos, &entity.Explore{PhotoId: photoId, OriginalAt: newest.Add(-time.Duration(i) * time.Hour)})
+	}
+	return photos
+}
+
+// expectSelect returns rows for the next select and records its query and args
+func expectSelect(querier *mockrepository.MockQuerier, rows []*entity.Explore, query *string, args *[]any) {
+	querier.EXPECT().SelectContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, dest any, q string, a ...any) error {
+			*dest.(*[]*entity.Explore) = rows
+			*query = q
+			*args = a
+			return nil
+		})
+}
+
+func TestFindAllExploreStageByCursor(t *testing.T) {
+	ctx := context.Background()
+	newest := time.Date(2025, 1, 10, 8, 0, 0, 0, time.UTC)
+
+	t.Run("First page fetches one extra row and points the cursor at the last photo", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		querier := mockrepository.NewMockQuerier(ctrl)
+
+		var query string
+		var args []any
+		expectSelect(querier, explorePhotos(newest, "photo-3", "photo-2", "photo-1"), &query, &args)
+
+		photos, cursorMetadata, err := newExploreRepository(t).FindAllExploreStageByCursor(ctx, querier, nil, 2, 80, "user-1",
+			"creator-1", enum.PhotoStageWishlist)
+		require.NoError(t, err)
+		assert.Contains(t, query, "AND usp.is_wishlist = TRUE")
+		assert.NotContains(t, query, "(p.original_at, usp.photo_id) <")
+		assert.Contains(t, query, "ORDER BY p.original_at DESC, usp.photo_id DESC LIMIT $4")
+		assert.Equal(t, []any{"user-1", uint32(80), "creator-1", 3}, args)
+
+		require.Len(t, photos, 2)
+		assert.True(t, cursorMetadata.HasMore)
+		assert.Equal(t, pagination.EncodeCursor(newest.Add(-time.Hour).Format(time.RFC3339Nano), "photo-2"), cursorMetadata.NextCursor)
+	})
+
+	t.Run("Next page continues after the cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		querier := mockrepository.NewMockQuerier(ctrl)
+
+		var query string
+		var args []any
+		expectSelect(querier, explorePhotos(newest.Add(-2*time.Hour), "photo-1"), &query, &args)
+
+		sortAt := newest.Add(-time.Hour)
+		cursor := &pagination.Cursor{SortKey: sortAt.Format(time.RFC3339Nano), Id: "photo-2"}
+		photos, cursorMetadata, err := newExploreRepository(t).FindAllExploreStageByCursor(ctx, querier, cursor, 2, 80, "user-1",
+			"creator-1", enum.PhotoStageCart)
+		require.NoError(t, err)
+		assert.Contains(t, query, "AND usp.is_cart = TRUE")
+		assert.Contains(t, query, "AND (p.original_at, usp.photo_id) < ($4, $5)")
+		assert.Contains(t, query, "LIMIT $6")
+		assert.Equal(t, []any{"user-1", uint32(80), "creator-1", sortAt, "photo-2", 3}, args)
+
+		require.Len(t, photos, 1)
+		assert.False(t, cursorMetadata.HasMore)
+		assert.Empty(t, cursorMetadata.NextCursor)
+	})
+
+	t.Run("Cursor with a sort key that is not a time", func(t *testing.T) {
+		querier := mockrepository.NewMockQuerier(gomock.NewController(t))
+
+		cursor := &pagination.Cursor{SortKey: "alina", Id: "photo-2"}
+		_, _, err := newExploreRepository(t).FindAllExploreStageByCursor(ctx, querier, cursor, 2, 80, "user-1", "creator-1",
+			enum.PhotoStageFavorite)
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown stage", func(t *testing.T) {
+		querier := mockrepository.NewMockQuerier(gomock.NewController(t))
+
+		_, _, err := newExploreRepository(t).FindAllExploreStageByCursor(ctx, querier, nil, 2, 80, "user-1", "creator-1",
+			enum.PhotoStageEnum("SOLD"))
+		assert.Error(t, err)
+	})
+}
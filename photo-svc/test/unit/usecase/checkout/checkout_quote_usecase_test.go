@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	txDriverName     = "checkout-tx-stub"
+	commitFailsDSN   = "commit-fails"
+	commitSucceedDSN = "commit-succeeds"
+)
+
+func init() {
+	sql.Register(txDriverName, txDriver{})
+}
+
+// txDriver only begins transactions, queries are answered by the repository mocks. The dsn decides whether Commit fails.
+type txDriver struct{}
+
+func (txDriver) Open(dsn string) (driver.Conn, error) {
+	return txConn{commitFails: dsn == commitFailsDSN}, nil
+}
+
+type txConn struct {
+	commitFails bool
+}
+
+func (c txConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("unexpected query: " + query)
+}
+
+func (c txConn) Close() error              { return nil }
+func (c txConn) Begin() (driver.Tx, error) { return txStub(c), nil }
+
+type txStub struct {
+	commitFails bool
+}
+
+func (t txStub) Commit() error {
+	if t.commitFails {
+		return errors.New("connection reset by peer")
+	}
+	return nil
+}
+
+func (t txStub) Rollback() error { return nil }
+
+func openTxDB(t *testing.T, dsn string) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Open(txDriverName, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func quotedCheckoutRequest() (*model.CalculateV2Request, *model.PriceQuote) {
+	quote := &model.PriceQuote{
+		Id:     "quote-1",
+		UserId: testUserId,
+		Items: []*model.PriceQuoteItem{
+			{PhotoId: "p1", CreatorId: testCreatorA, Price: 20000, FinalPrice: 20000},
+		},
+		TotalPrice: 20000,
+		ExpiresAt:  time.Now().Add(10 * time.Minute).Unix(),
+	}
+
+	request := &model.CalculateV2Request{
+		UserId:        testUserId,
+		CreatorId:     testCreatorA,
+		TransactionId: "transaction-1",
+		QuoteToken:    "signed-quote",
+		Items:         []model.CheckoutItemWeb{{PhotoId: "p1", CreatorId: testCreatorA}},
+	}
+	return request, quote
+}
+
+// expectQuotedLock lets the quote verify and the photos be locked and reserved
+func (m *checkoutMocks) expectQuotedLock(quote *model.PriceQuote) {
+	m.priceQuoteAdapter.EXPECT().Verify("signed-quote").Return(quote, nil)
+	photos := []*entity.Photo{photo("p1", testCreatorA, 20000)}
+	m.photoRepo.EXPECT().GetSimilarPhotosByIDs(gomock.Any(), gomock.Any(), testUserId, testCreatorA, []string{"p1"}, true, gomock.Any()).
+		Return(&photos, nil)
+	m.expectDiscountRules()
+}
+
+func TestLockPhotosAndCalculatePriceV2Quote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Quote is consumed when the checkout is stored", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCaseOnDB(t, openTxDB(t, commitSucceedDSN))
+		request, quote := quotedCheckoutRequest()
+		mocks.expectQuotedLock(quote)
+		gomock.InOrder(
+			mocks.photoRepo.EXPECT().UpdatePhotoStatusesByIDs(ctx, gomock.Any(), gomock.Any(), []string{"p1"}).Return(nil),
+			mocks.priceQuoteAdapter.EXPECT().Consume(ctx, quote).Return(nil),
+		)
+
+		_, total, err := checkoutUC.LockPhotosAndCalculatePriceV2(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "quote-1", total.QuoteId)
+		assert.Equal(t, int32(20000), total.Price)
+	})
+
+	t.Run("Failed photo reservation keeps the quote", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCaseOnDB(t, openTxDB(t, commitSucceedDSN))
+		request, quote := quotedCheckoutRequest()
+		mocks.expectQuotedLock(quote)
+		mocks.photoRepo.EXPECT().UpdatePhotoStatusesByIDs(ctx, gomock.Any(), gomock.Any(), []string{"p1"}).
+			Return(errors.New("deadlock detected"))
+
+		_, _, err := checkoutUC.LockPhotosAndCalculatePriceV2(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInternal, "Something went wrong. Please try again later")
+	})
+
+	t.Run("Failed commit restores the quote", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCaseOnDB(t, openTxDB(t, commitFailsDSN))
+		request, quote := quotedCheckoutRequest()
+		mocks.expectQuotedLock(quote)
+		gomock.InOrder(
+			mocks.photoRepo.EXPECT().UpdatePhotoStatusesByIDs(ctx, gomock.Any(), gomock.Any(), []string{"p1"}).Return(nil),
+			mocks.priceQuoteAdapter.EXPECT().Consume(ctx, quote).Return(nil),
+			mocks.priceQuoteAdapter.EXPECT().Restore(ctx, quote).Return(nil),
+		)
+
+		_, _, err := checkoutUC.LockPhotosAndCalculatePriceV2(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInternal, "Something went wrong. Please try again later")
+	})
+
+	t.Run("Quote used by another checkout", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCaseOnDB(t, openTxDB(t, commitSucceedDSN))
+		request, quote := quotedCheckoutRequest()
+		mocks.expectQuotedLock(quote)
+		mocks.photoRepo.EXPECT().UpdatePhotoStatusesByIDs(ctx, gomock.Any(), gomock.Any(), []string{"p1"}).Return(nil)
+		mocks.priceQuoteAdapter.EXPECT().Consume(ctx, quote).Return(adapter.ErrUsedPriceQuote)
+
+		_, _, err := checkoutUC.LockPhotosAndCalculatePriceV2(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument, "Price quote has already been used")
+	})
+}
@@ -0,0 +1,354 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserId    = "user-1"
+	testCreatorA  = "creator-a"
+	testCreatorB  = "creator-b"
+	testVoucherId = "voucher-1"
+)
+
+type checkoutMocks struct {
+	photoRepo           *mockrepository.MockPhotoRepository
+	creatorDiscountRepo *mockrepository.MockCreatorDiscountRepository
+	voucherRepo         *mockrepository.MockVoucherRepository
+	priceQuoteAdapter   *mockadapter.MockPriceQuoteAdapter
+}
+
+func newCheckoutUseCase(t *testing.T) (usecase.CheckoutUseCase, *checkoutMocks) {
+	return newCheckoutUseCaseOnDB(t, nil)
+}
+
+func newCheckoutUseCaseOnDB(t *testing.T, db *sqlx.DB) (usecase.CheckoutUseCase, *checkoutMocks) {
+	ctrl := gomock.NewController(t)
+
+	mocks := &checkoutMocks{
+		photoRepo:           mockrepository.NewMockPhotoRepository(ctrl),
+		creatorDiscountRepo: mockrepository.NewMockCreatorDiscountRepository(ctrl),
+		voucherRepo:         mockrepository.NewMockVoucherRepository(ctrl),
+		priceQuoteAdapter:   mockadapter.NewMockPriceQuoteAdapter(ctrl),
+	}
+
+	cdnAdapter := mockadapter.NewMockCDNAdapter(ctrl)
+	cdnAdapter.EXPECT().GenerateCDN(gomock.Any()).Return("").AnyTimes()
+
+	mocks.priceQuoteAdapter.EXPECT().TTL().Return(10 * time.Minute).AnyTimes()
+	mocks.priceQuoteAdapter.EXPECT().Sign(gomock.Any(), gomock.Any()).Return("signed-quote", nil).AnyTimes()
+
+	checkoutUC := usecase.NewCheckoutUseCase(db, mocks.photoRepo, nil, mocks.creatorDiscountRepo, mocks.voucherRepo,
+		logger.New("test"), cdnAdapter, mocks.priceQuoteAdapter)
+
+	return checkoutUC, mocks
+}
+
+func (m *checkoutMocks) expectPhotos(photos ...*entity.Photo) {
+	m.photoRepo.EXPECT().GetSimilarPhotosByIDs(gomock.Any(), gomock.Any(), testUserId, gomock.Any(), gomock.Any(), false, gomock.Any()).
+		Return(&photos, nil)
+}
+
+func (m *checkoutMocks) expectDiscountRules(rules ...*entity.CreatorDiscount) {
+	m.creatorDiscountRepo.EXPECT().GetDiscountRules(gomock.Any(), gomock.Any()).Return(&rules, nil)
+}
+
+func (m *checkoutMocks) expectVoucher(voucher *entity.Voucher, usage *entity.VoucherUsage) {
+	m.voucherRepo.EXPECT().FindRedeemableByCode(gomock.Any(), gomock.Any(), voucher.Code, false).Return(voucher, nil)
+	m.voucherRepo.EXPECT().CountUsage(gomock.Any(), gomock.Any(), voucher.Id, testUserId).Return(usage, nil)
+}
+
+func photo(id, creatorId string, price int32) *entity.Photo {
+	return &entity.Photo{Id: id, CreatorId: creatorId, Price: price}
+}
+
+func preview(t *testing.T, checkoutUC usecase.CheckoutUseCase, voucherCode string, photoIds ...string) (map[string]*model.CheckoutItemWeb, *model.PreviewCheckoutResponse, error) {
+	response, err := checkoutUC.PreviewCheckout(context.Background(), &model.PreviewCheckoutRequest{
+		UserId:      testUserId,
+		PhotoIds:    photoIds,
+		VoucherCode: voucherCode,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make(map[string]*model.CheckoutItemWeb, len(*response.Items))
+	for _, item := range *response.Items {
+		items[item.PhotoId] = item
+	}
+	return items, response, nil
+}
+
+func assertUseCaseError(t *testing.T, err error, code, message string) {
+	require.Error(t, err)
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok)
+	assert.Equal(t, code, appErr.Code)
+	assert.Equal(t, message, appErr.Message)
+}
+
+func TestPreviewCheckoutCreatorDiscount(t *testing.T) {
+	t.Run("Flat discount is capped at the photo price", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000), photo("p2", testCreatorA, 3000))
+		mocks.expectDiscountRules(&entity.CreatorDiscount{Id: "d1", CreatorId: testCreatorA, MinQuantity: 1, DiscountType: enum.DiscountTypeFlat, Value: 5000})
+
+		items, response, err := preview(t, checkoutUC, "", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(5000), items["p1"].Discount.Amount)
+		assert.Equal(t, int32(3000), items["p2"].Discount.Amount)
+		assert.Equal(t, int32(0), items["p2"].FinalPrice)
+		assert.Equal(t, int32(15000), response.TotalPrice)
+		assert.Equal(t, int32(8000), response.TotalDiscount)
+	})
+
+	t.Run("Percent discount", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000), photo("p2", testCreatorA, 30000))
+		mocks.expectDiscountRules(&entity.CreatorDiscount{Id: "d1", CreatorId: testCreatorA, MinQuantity: 1, DiscountType: enum.DiscountTypePercent, Value: 10})
+
+		items, response, err := preview(t, checkoutUC, "", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2000), items["p1"].Discount.Amount)
+		assert.Equal(t, int32(3000), items["p2"].Discount.Amount)
+		assert.Equal(t, int32(45000), response.TotalPrice)
+	})
+
+	t.Run("Bundle discount puts the most expensive photos in the bundle", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 10000), photo("p2", testCreatorA, 30000), photo("p3", testCreatorA, 25000))
+		mocks.expectDiscountRules(&entity.CreatorDiscount{Id: "d1", CreatorId: testCreatorA, MinQuantity: 2, DiscountType: enum.DiscountTypeBundle, Value: 40000})
+
+		items, response, err := preview(t, checkoutUC, "", "p1", "p2", "p3")
+		require.NoError(t, err)
+
+		assert.Nil(t, items["p1"].Discount)
+		assert.Equal(t, int32(15000), items["p2"].Discount.Amount+items["p3"].Discount.Amount)
+		assert.Greater(t, items["p2"].Discount.Amount, items["p3"].Discount.Amount)
+		assert.Equal(t, int32(50000), response.TotalPrice)
+	})
+
+	t.Run("Rule with the biggest discount wins", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000), photo("p2", testCreatorA, 20000))
+		mocks.expectDiscountRules(
+			&entity.CreatorDiscount{Id: "flat", CreatorId: testCreatorA, MinQuantity: 1, DiscountType: enum.DiscountTypeFlat, Value: 1000},
+			&entity.CreatorDiscount{Id: "percent", CreatorId: testCreatorA, MinQuantity: 2, DiscountType: enum.DiscountTypePercent, Value: 25},
+			&entity.CreatorDiscount{Id: "unreached", CreatorId: testCreatorA, MinQuantity: 3, DiscountType: enum.DiscountTypePercent, Value: 90},
+		)
+
+		items, response, err := preview(t, checkoutUC, "", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, "percent", items["p1"].Discount.Id)
+		assert.Equal(t, int32(30000), response.TotalPrice)
+	})
+}
+
+func TestPreviewCheckoutVoucherLimit(t *testing.T) {
+	voucher := func() *entity.Voucher {
+		return &entity.Voucher{
+			Id:                testVoucherId,
+			Code:              "PROMO10",
+			FundedBy:          enum.VoucherFunderPlatform,
+			DiscountType:      enum.DiscountTypePercent,
+			Value:             10,
+			MinQuantity:       1,
+			UsageLimit:        sql.NullInt32{Int32: 100, Valid: true},
+			UsageLimitPerUser: sql.NullInt32{Int32: 1, Valid: true},
+			IsStackable:       true,
+		}
+	}
+
+	t.Run("Total usage limit reached", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(voucher(), &entity.VoucherUsage{Total: 100, ForUser: 0})
+
+		_, _, err := preview(t, checkoutUC, "promo10", "p1")
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument, "Voucher usage limit has been reached")
+	})
+
+	t.Run("Per user usage limit reached", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(voucher(), &entity.VoucherUsage{Total: 3, ForUser: 1})
+
+		_, _, err := preview(t, checkoutUC, "promo10", "p1")
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument, "You have reached the usage limit of this voucher")
+	})
+
+	t.Run("Minimum quantity not reached", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		v := voucher()
+		v.MinQuantity = 2
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(v, &entity.VoucherUsage{})
+
+		_, _, err := preview(t, checkoutUC, "promo10", "p1")
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument, "Voucher requires at least 2 eligible photos")
+	})
+
+	t.Run("Max discount caps the voucher amount", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		v := voucher()
+		v.MaxDiscount = sql.NullInt32{Int32: 3000, Valid: true}
+		mocks.expectPhotos(photo("p1", testCreatorA, 40000), photo("p2", testCreatorA, 60000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(v, &entity.VoucherUsage{})
+
+		items, response, err := preview(t, checkoutUC, "promo10", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1200), items["p1"].Voucher.Amount)
+		assert.Equal(t, int32(1800), items["p2"].Voucher.Amount)
+		assert.Equal(t, int32(97000), response.TotalPrice)
+	})
+
+	t.Run("Flat voucher never exceeds the eligible price", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		v := voucher()
+		v.DiscountType = enum.DiscountTypeFlat
+		v.Value = 50000
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(v, &entity.VoucherUsage{})
+
+		_, response, err := preview(t, checkoutUC, "promo10", "p1")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(0), response.TotalPrice)
+		assert.Equal(t, int32(20000), response.Voucher.Amount)
+	})
+}
+
+func TestPreviewCheckoutVoucherFunder(t *testing.T) {
+	t.Run("Creator voucher only covers photos of its creator", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000), photo("p2", testCreatorB, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(&entity.Voucher{
+			Id:           testVoucherId,
+			Code:         "CREATORA",
+			CreatorId:    sql.NullString{String: testCreatorA, Valid: true},
+			FundedBy:     enum.VoucherFunderCreator,
+			DiscountType: enum.DiscountTypeFlat,
+			Value:        5000,
+			MinQuantity:  1,
+		}, &entity.VoucherUsage{})
+
+		items, response, err := preview(t, checkoutUC, "CREATORA", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(5000), items["p1"].Voucher.Amount)
+		assert.Equal(t, enum.VoucherFunderCreator, items["p1"].Voucher.FundedBy)
+		assert.Nil(t, items["p2"].Voucher)
+		assert.Equal(t, int32(35000), response.TotalPrice)
+	})
+
+	t.Run("Creator voucher of another creator is not applicable", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorB, 20000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(&entity.Voucher{
+			Id:           testVoucherId,
+			Code:         "CREATORA",
+			CreatorId:    sql.NullString{String: testCreatorA, Valid: true},
+			FundedBy:     enum.VoucherFunderCreator,
+			DiscountType: enum.DiscountTypeFlat,
+			Value:        5000,
+			MinQuantity:  1,
+		}, &entity.VoucherUsage{})
+
+		_, _, err := preview(t, checkoutUC, "CREATORA", "p1")
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument, "Voucher requires at least 1 eligible photos")
+	})
+
+	t.Run("Platform voucher is split across creators", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 30000), photo("p2", testCreatorB, 10000))
+		mocks.expectDiscountRules()
+		mocks.expectVoucher(&entity.Voucher{
+			Id:           testVoucherId,
+			Code:         "PLATFORM",
+			FundedBy:     enum.VoucherFunderPlatform,
+			DiscountType: enum.DiscountTypeFlat,
+			Value:        8000,
+			MinQuantity:  1,
+		}, &entity.VoucherUsage{})
+
+		items, response, err := preview(t, checkoutUC, "PLATFORM", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(6000), items["p1"].Voucher.Amount)
+		assert.Equal(t, int32(2000), items["p2"].Voucher.Amount)
+		assert.Equal(t, enum.VoucherFunderPlatform, items["p2"].Voucher.FundedBy)
+		assert.Equal(t, int32(32000), response.TotalPrice)
+	})
+
+	t.Run("Non stackable voucher skips photos with creator discount", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000), photo("p2", testCreatorB, 20000))
+		mocks.expectDiscountRules(&entity.CreatorDiscount{Id: "d1", CreatorId: testCreatorA, MinQuantity: 1, DiscountType: enum.DiscountTypeFlat, Value: 2000})
+		mocks.expectVoucher(&entity.Voucher{
+			Id:           testVoucherId,
+			Code:         "PLATFORM",
+			FundedBy:     enum.VoucherFunderPlatform,
+			DiscountType: enum.DiscountTypePercent,
+			Value:        50,
+			MinQuantity:  1,
+		}, &entity.VoucherUsage{})
+
+		items, response, err := preview(t, checkoutUC, "PLATFORM", "p1", "p2")
+		require.NoError(t, err)
+
+		assert.Nil(t, items["p1"].Voucher)
+		assert.Equal(t, int32(10000), items["p2"].Voucher.Amount)
+		assert.Equal(t, int32(28000), response.TotalPrice)
+	})
+
+	t.Run("Stackable voucher is calculated after creator discount", func(t *testing.T) {
+		checkoutUC, mocks := newCheckoutUseCase(t)
+		mocks.expectPhotos(photo("p1", testCreatorA, 20000))
+		mocks.expectDiscountRules(&entity.CreatorDiscount{Id: "d1", CreatorId: testCreatorA, MinQuantity: 1, DiscountType: enum.DiscountTypeFlat, Value: 2000})
+		mocks.expectVoucher(&entity.Voucher{
+			Id:           testVoucherId,
+			Code:         "PLATFORM",
+			FundedBy:     enum.VoucherFunderPlatform,
+			DiscountType: enum.DiscountTypePercent,
+			Value:        50,
+			MinQuantity:  1,
+			IsStackable:  true,
+		}, &entity.VoucherUsage{})
+
+		items, response, err := preview(t, checkoutUC, "PLATFORM", "p1")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(9000), items["p1"].Voucher.Amount)
+		assert.Equal(t, int32(9000), response.TotalPrice)
+		assert.Equal(t, int32(11000), response.TotalDiscount)
+	})
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatorDiscountInvalidatesProfile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Created discount", func(t *testing.T) {
+		creatorDiscountUC, mocks := newCreatorDiscountUseCase(t)
+		gomock.InOrder(
+			mocks.creatorDiscountRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ any, discount *entity.CreatorDiscount) (*entity.CreatorDiscount, error) {
+					return discount, nil
+				}),
+			mocks.creatorProfileUseCase.EXPECT().InvalidateCreatorProfile(ctx, testCreatorId).Return(nil),
+		)
+
+		resp, err := creatorDiscountUC.CreateDiscount(ctx, &model.CreateCreatorDiscountRequest{
+			CreatorId: testCreatorId, Name: "Bundle", MinQuantity: 3, DiscountType: enum.DiscountTypeBundle, Value: 10, IsActive: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, testCreatorId, resp.CreatorId)
+	})
+
+	t.Run("Activated discount", func(t *testing.T) {
+		creatorDiscountUC, mocks := newCreatorDiscountUseCase(t)
+		mocks.creatorDiscountRepo.EXPECT().FindByIdAndCreatorId(ctx, gomock.Any(), "discount-1", testCreatorId).
+			Return(&entity.CreatorDiscount{Id: "discount-1", CreatorId: testCreatorId}, nil)
+		gomock.InOrder(
+			mocks.creatorDiscountRepo.EXPECT().Activate(ctx, gomock.Any(), "discount-1").Return(nil),
+			mocks.creatorProfileUseCase.EXPECT().InvalidateCreatorProfile(ctx, testCreatorId).Return(nil),
+		)
+
+		require.NoError(t, creatorDiscountUC.ActivateDiscount(ctx, &model.ActivateCreatorDiscountRequest{Id: "discount-1", CreatorId: testCreatorId}))
+	})
+
+	t.Run("Deactivated discount", func(t *testing.T) {
+		creatorDiscountUC, mocks := newCreatorDiscountUseCase(t)
+		mocks.creatorDiscountRepo.EXPECT().FindByIdAndCreatorId(ctx, gomock.Any(), "discount-1", testCreatorId).
+			Return(&entity.CreatorDiscount{Id: "discount-1", CreatorId: testCreatorId}, nil)
+		gomock.InOrder(
+			mocks.creatorDiscountRepo.EXPECT().Deactivate(ctx, gomock.Any(), "discount-1").Return(nil),
+			mocks.creatorProfileUseCase.EXPECT().InvalidateCreatorProfile(ctx, testCreatorId).Return(nil),
+		)
+
+		require.NoError(t, creatorDiscountUC.DeactivateDiscount(ctx, &model.DeactivateCreatorDiscountRequest{Id: "discount-1", CreatorId: testCreatorId}))
+	})
+
+	t.Run("Failed invalidation does not fail the stored change", func(t *testing.T) {
+		creatorDiscountUC, mocks := newCreatorDiscountUseCase(t)
+		mocks.creatorDiscountRepo.EXPECT().FindByIdAndCreatorId(ctx, gomock.Any(), "discount-1", testCreatorId).
+			Return(&entity.CreatorDiscount{Id: "discount-1", CreatorId: testCreatorId}, nil)
+		mocks.creatorDiscountRepo.EXPECT().Deactivate(ctx, gomock.Any(), "discount-1").Return(nil)
+		mocks.creatorProfileUseCase.EXPECT().InvalidateCreatorProfile(ctx, testCreatorId).Return(errors.New("redis is down"))
+
+		require.NoError(t, creatorDiscountUC.DeactivateDiscount(ctx, &model.DeactivateCreatorDiscountRequest{Id: "discount-1", CreatorId: testCreatorId}))
+	})
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	transactionpb "github.com/hervibest/be-yourmoments-backup/pb/transaction"
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectBuild lets the profile be built from the repositories and the other services
+func (m *creatorProfileMocks) expectBuild(ctx context.Context) {
+	m.creatorRepo.EXPECT().FindById(ctx, gomock.Any(), testCreatorId).Return(&entity.Creator{Id: testCreatorId, UserId: testUserId}, nil)
+	m.userAdapter.EXPECT().GetPublicUserProfile(ctx, testUserId).
+		Return(&userpb.GetPublicUserProfileResponse{Profile: &userpb.PublicUserProfile{Username: "hervi"}}, nil)
+	m.transactionAdapter.EXPECT().GetCreatorReviewSummary(ctx, testCreatorId).
+		Return(&transactionpb.GetCreatorReviewSummaryResponse{}, nil)
+	m.bulkPhotoRepo.EXPECT().FindRecentEventByCreatorId(ctx, gomock.Any(), testCreatorId, gomock.Any()).Return(nil, nil)
+	m.photoRepo.EXPECT().FindSampleByCreatorId(ctx, gomock.Any(), testCreatorId, gomock.Any()).Return(nil, nil)
+	m.creatorDiscountRepo.EXPECT().GetDiscountRules(ctx, []string{testCreatorId}).Return(&[]*entity.CreatorDiscount{}, nil)
+}
+
+func TestGetCreatorProfile(t *testing.T) {
+	ctx := context.Background()
+	request := &model.GetCreatorProfileRequest{CreatorId: testCreatorId}
+	cacheKey := usecase.CreatorProfileCacheKey(testCreatorId)
+
+	t.Run("Cached profile is returned", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.cacheAdapter.EXPECT().Get(ctx, cacheKey).Return(`{"creator_id":"creator-1","username":"cached"}`, nil)
+
+		resp, err := creatorProfileUC.GetCreatorProfile(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "cached", resp.Username)
+	})
+
+	t.Run("Missing profile is built and cached", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.cacheAdapter.EXPECT().Get(ctx, cacheKey).Return("", redis.Nil)
+		mocks.expectBuild(ctx)
+		mocks.cacheAdapter.EXPECT().Set(ctx, cacheKey, gomock.Any(), usecase.CreatorProfileCacheTTL).Return(nil)
+
+		resp, err := creatorProfileUC.GetCreatorProfile(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "hervi", resp.Username)
+	})
+
+	t.Run("Cache outage falls through to the source", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.cacheAdapter.EXPECT().Get(ctx, cacheKey).Return("", errors.New("dial tcp: connection refused"))
+		mocks.expectBuild(ctx)
+		mocks.cacheAdapter.EXPECT().Set(ctx, cacheKey, gomock.Any(), usecase.CreatorProfileCacheTTL).
+			Return(errors.New("dial tcp: connection refused"))
+
+		resp, err := creatorProfileUC.GetCreatorProfile(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "hervi", resp.Username)
+	})
+
+	t.Run("Unknown creator", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.cacheAdapter.EXPECT().Get(ctx, cacheKey).Return("", redis.Nil)
+		mocks.creatorRepo.EXPECT().FindById(ctx, gomock.Any(), testCreatorId).Return(nil, sql.ErrNoRows)
+
+		_, err := creatorProfileUC.GetCreatorProfile(ctx, request)
+		appErr, ok := err.(*helper.AppError)
+		require.True(t, ok)
+		assert.Equal(t, errorcode.ErrResourceNotFound, appErr.Code)
+	})
+}
+
+func TestInvalidateUserCreatorProfile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Profile of the owned creator is dropped", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.creatorRepo.EXPECT().FindIdByUserId(ctx, gomock.Any(), testUserId).Return(testCreatorId, nil)
+		mocks.cacheAdapter.EXPECT().Del(ctx, usecase.CreatorProfileCacheKey(testCreatorId)).Return(nil)
+
+		require.NoError(t, creatorProfileUC.InvalidateUserCreatorProfile(ctx, testUserId))
+	})
+
+	t.Run("User without a creator has nothing cached", func(t *testing.T) {
+		creatorProfileUC, mocks := newCreatorProfileUseCase(t)
+		mocks.creatorRepo.EXPECT().FindIdByUserId(ctx, gomock.Any(), testUserId).Return("", sql.ErrNoRows)
+
+		require.NoError(t, creatorProfileUC.InvalidateUserCreatorProfile(ctx, testUserId))
+	})
+}
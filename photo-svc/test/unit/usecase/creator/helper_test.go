@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	mockusecase "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/usecase"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCreatorId = "creator-1"
+	testUserId    = "user-1"
+
+	txDriverName = "creator-tx-stub"
+)
+
+func init() {
+	sql.Register(txDriverName, txDriver{})
+}
+
+// txDriver only begins and commits transactions, queries are answered by the repository mocks
+type txDriver struct{}
+
+func (txDriver) Open(dsn string) (driver.Conn, error) { return txConn{}, nil }
+
+type txConn struct{}
+
+func (txConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("unexpected query: " + query)
+}
+
+func (txConn) Close() error              { return nil }
+func (txConn) Begin() (driver.Tx, error) { return txStub{}, nil }
+
+type txStub struct{}
+
+func (txStub) Commit() error   { return nil }
+func (txStub) Rollback() error { return nil }
+
+type creatorProfileMocks struct {
+	creatorRepo         *mockrepository.MockCreatorRepository
+	bulkPhotoRepo       *mockrepository.MockBulkPhotoRepository
+	photoRepo           *mockrepository.MockPhotoRepository
+	creatorDiscountRepo *mockrepository.MockCreatorDiscountRepository
+	userAdapter         *mockadapter.MockUserAdapter
+	transactionAdapter  *mockadapter.MockTransactionAdapter
+	cacheAdapter        *mockadapter.MockCacheAdapter
+	cdnAdapter          *mockadapter.MockCDNAdapter
+}
+
+func newCreatorProfileUseCase(t *testing.T) (usecase.CreatorProfileUseCase, *creatorProfileMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &creatorProfileMocks{
+		creatorRepo:         mockrepository.NewMockCreatorRepository(ctrl),
+		bulkPhotoRepo:       mockrepository.NewMockBulkPhotoRepository(ctrl),
+		photoRepo:           mockrepository.NewMockPhotoRepository(ctrl),
+		creatorDiscountRepo: mockrepository.NewMockCreatorDiscountRepository(ctrl),
+		userAdapter:         mockadapter.NewMockUserAdapter(ctrl),
+		transactionAdapter:  mockadapter.NewMockTransactionAdapter(ctrl),
+		cacheAdapter:        mockadapter.NewMockCacheAdapter(ctrl),
+		cdnAdapter:          mockadapter.NewMockCDNAdapter(ctrl),
+	}
+
+	creatorProfileUC := usecase.NewCreatorProfileUseCase(nil, mocks.creatorRepo, mocks.bulkPhotoRepo, mocks.photoRepo, mocks.creatorDiscountRepo,
+		mocks.userAdapter, mocks.transactionAdapter, mocks.cacheAdapter, mocks.cdnAdapter, logger.New("test"))
+	return creatorProfileUC, mocks
+}
+
+type creatorDiscountMocks struct {
+	creatorDiscountRepo   *mockrepository.MockCreatorDiscountRepository
+	creatorProfileUseCase *mockusecase.MockCreatorProfileUseCase
+}
+
+func newCreatorDiscountUseCase(t *testing.T) (usecase.CreatorDiscountUseCase, *creatorDiscountMocks) {
+	db, err := sqlx.Open(txDriverName, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	ctrl := gomock.NewController(t)
+	mocks := &creatorDiscountMocks{
+		creatorDiscountRepo:   mockrepository.NewMockCreatorDiscountRepository(ctrl),
+		creatorProfileUseCase: mockusecase.NewMockCreatorProfileUseCase(ctrl),
+	}
+
+	creatorDiscountUC := usecase.NewCreatorDiscountUseCase(db, mocks.creatorDiscountRepo, mocks.creatorProfileUseCase, logger.New("test"))
+	return creatorDiscountUC, mocks
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/mock/gomock"
+)
+
+// totalCandidates spans three candidate windows of the explore ranking
+const totalCandidates = 1234
+
+// newCandidates returns the candidates in the repository scan order, similarity, original_at and photo id descending
+func newCandidates() []*entity.ExploreCandidate {
+	candidates := make([]*entity.ExploreCandidate, 0, totalCandidates)
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < totalCandidates; i++ {
+		candidate := new(entity.ExploreCandidate)
+		candidate.PhotoId = fmt.Sprintf("photo-%05d", totalCandidates-i)
+		candidate.Similarity = enum.SimilarityLevelNine - enum.SimilarityLevelEnum(i*5/totalCandidates)
+		candidate.OriginalAt = base.Add(-time.Duration(i%97) * time.Hour)
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return isAfter(candidates[j], &model.ExploreCandidateKey{
+			Similarity: uint32(candidates[i].Similarity),
+			OriginalAt: candidates[i].OriginalAt,
+			PhotoId:    candidates[i].PhotoId,
+		})
+	})
+	return candidates
+}
+
+func isAfter(candidate *entity.ExploreCandidate, key *model.ExploreCandidateKey) bool {
+	if uint32(candidate.Similarity) != key.Similarity {
+		return uint32(candidate.Similarity) < key.Similarity
+	}
+	if !candidate.OriginalAt.Equal(key.OriginalAt) {
+		return candidate.OriginalAt.Before(key.OriginalAt)
+	}
+	return candidate.PhotoId < key.PhotoId
+}
+
+func newExploreUseCase(t *testing.T) usecase.ExploreUseCase {
+	ctrl := gomock.NewController(t)
+	candidates := newCandidates()
+
+	exploreRepo := mockrepository.NewMockExploreRepository(ctrl)
+	exploreRepo.EXPECT().CountExploreCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(int64(len(candidates)), nil).AnyTimes()
+	exploreRepo.EXPECT().FindExploreCandidates(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, tx interface{}, similarity, confidentSimilarity uint32, userId, creatorId string,
+			after *model.ExploreCandidateKey, limit int) ([]*entity.ExploreCandidate, error) {
+			window := make([]*entity.ExploreCandidate, 0, limit)
+			for _, candidate := range candidates {
+				if after != nil && !isAfter(candidate, after) {
+					continue
+				}
+				if len(window) == limit {
+					break
+				}
+				window = append(window, candidate)
+			}
+			return window, nil
+		}).AnyTimes()
+
+	cdnAdapter := mockadapter.NewMockCDNAdapter(ctrl)
+	cdnAdapter.EXPECT().GenerateCDN(gomock.Any()).Return("").AnyTimes()
+
+	return usecase.NewExploreUseCase(nil, exploreRepo, nil, cdnAdapter, noop.NewTracerProvider().Tracer("test"), logger.New("test"))
+}
+
+func newExploreRequest(size int) *model.GetAllExploreSimilarRequest {
+	return &model.GetAllExploreSimilarRequest{
+		UserId:     "user-1",
+		Similarity: uint32(enum.SimilarityLevelOne),
+		CreatorId:  "creator-1",
+		Page:       1,
+		Size:       size,
+	}
+}
+
+func TestGetUserExploreSimilarByCursor(t *testing.T) {
+	exploreUC := newExploreUseCase(t)
+	request := newExploreRequest(100)
+
+	seen := make(map[string]bool, totalCandidates)
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, totalCandidates, "cursor pagination does not terminate")
+
+		responses, cursorMetadata, err := exploreUC.GetUserExploreSimilarByCursor(context.Background(), request)
+		require.NoError(t, err)
+
+		for _, response := range *responses {
+			assert.False(t, seen[response.PhotoId], "photo %s returned twice", response.PhotoId)
+			seen[response.PhotoId] = true
+		}
+
+		if !cursorMetadata.HasMore {
+			break
+		}
+		require.Len(t, *responses, request.Size)
+		request.Cursor = cursorMetadata.NextCursor
+	}
+
+	assert.Len(t, seen, totalCandidates)
+}
+
+func TestGetUserExploreSimilarByPage(t *testing.T) {
+	exploreUC := newExploreUseCase(t)
+
+	seen := make(map[string]bool, totalCandidates)
+	request := newExploreRequest(300)
+	for {
+		responses, pageMetadata, err := exploreUC.GetUserExploreSimilar(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, int64(totalCandidates), pageMetadata.TotalItem)
+
+		for _, response := range *responses {
+			assert.False(t, seen[response.PhotoId], "photo %s returned twice", response.PhotoId)
+			seen[response.PhotoId] = true
+		}
+
+		if !pageMetadata.HasNext {
+			assert.Len(t, *responses, totalCandidates%request.Size)
+			break
+		}
+		request.Page++
+	}
+
+	assert.Len(t, seen, totalCandidates)
+}
+
+func TestGetUserExploreSimilarInvalidCursor(t *testing.T) {
+	exploreUC := newExploreUseCase(t)
+	request := newExploreRequest(10)
+	request.Cursor = "not-a-cursor"
+
+	_, _, err := exploreUC.GetUserExploreSimilarByCursor(context.Background(), request)
+	assert.Error(t, err)
+}
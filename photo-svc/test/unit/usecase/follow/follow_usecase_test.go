@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/adapter"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUserId    = "user-1"
+	testCreatorId = "creator-1"
+)
+
+type followMocks struct {
+	creatorRepo       *mockrepository.MockCreatorRepository
+	creatorFollowRepo *mockrepository.MockCreatorFollowRepository
+	userAdapter       *mockadapter.MockUserAdapter
+}
+
+func newFollowUseCase(t *testing.T) (usecase.FollowUseCase, *followMocks) {
+	ctrl := gomock.NewController(t)
+
+	mocks := &followMocks{
+		creatorRepo:       mockrepository.NewMockCreatorRepository(ctrl),
+		creatorFollowRepo: mockrepository.NewMockCreatorFollowRepository(ctrl),
+		userAdapter:       mockadapter.NewMockUserAdapter(ctrl),
+	}
+
+	cdnAdapter := mockadapter.NewMockCDNAdapter(ctrl)
+	cdnAdapter.EXPECT().GenerateCDN(gomock.Any()).DoAndReturn(func(fileKey string) string {
+		return "https://cdn.test/" + fileKey
+	}).AnyTimes()
+
+	followUC := usecase.NewFollowUseCase(nil, mocks.creatorRepo, mocks.creatorFollowRepo, mocks.userAdapter, cdnAdapter, logger.New("test"))
+	return followUC, mocks
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestGetCreatorFollow(t *testing.T) {
+	ctx := context.Background()
+	request := &model.GetCreatorFollowRequest{UserId: testUserId, CreatorId: testCreatorId}
+
+	t.Run("Unknown creator", func(t *testing.T) {
+		followUC, mocks := newFollowUseCase(t)
+		mocks.creatorRepo.EXPECT().FindById(ctx, gomock.Any(), testCreatorId).Return(nil, sql.ErrNoRows)
+
+		_, err := followUC.GetCreatorFollow(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Followed creator", func(t *testing.T) {
+		followUC, mocks := newFollowUseCase(t)
+		mocks.creatorRepo.EXPECT().FindById(ctx, gomock.Any(), testCreatorId).Return(&entity.Creator{
+			Id:            testCreatorId,
+			UserId:        "user-2",
+			FollowerCount: 3,
+		}, nil)
+		mocks.creatorFollowRepo.EXPECT().IsFollowing(ctx, gomock.Any(), testUserId, testCreatorId).Return(true, nil)
+
+		resp, err := followUC.GetCreatorFollow(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, &model.CreatorFollowResponse{CreatorId: testCreatorId, IsFollowing: true, FollowerCount: 3}, resp)
+	})
+}
+
+func TestGetFollowers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		followUC, _ := newFollowUseCase(t)
+
+		_, _, err := followUC.GetFollowers(ctx, &model.GetCreatorFollowersRequest{CreatorId: testCreatorId, Cursor: "not-a-cursor", Size: 10})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Failed profile lookup only leaves the profile out", func(t *testing.T) {
+		followUC, mocks := newFollowUseCase(t)
+		followedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		mocks.creatorFollowRepo.EXPECT().FindFollowersByCursor(ctx, gomock.Any(), testCreatorId, nil, 10).Return([]*entity.CreatorFollow{
+			{FollowerUserId: "user-1", CreatorId: testCreatorId, CreatedAt: followedAt},
+			{FollowerUserId: "user-2", CreatorId: testCreatorId, CreatedAt: followedAt},
+		}, &model.CursorMetadata{}, nil)
+		mocks.userAdapter.EXPECT().GetPublicUserProfile(gomock.Any(), "user-1").Return(&userpb.GetPublicUserProfileResponse{
+			Profile: &userpb.PublicUserProfile{UserId: "user-1", Username: "first", Nickname: "First"},
+		}, nil)
+		mocks.userAdapter.EXPECT().GetPublicUserProfile(gomock.Any(), "user-2").Return(nil, errors.New("user not found"))
+
+		followers, _, err := followUC.GetFollowers(ctx, &model.GetCreatorFollowersRequest{CreatorId: testCreatorId, Size: 10})
+		require.NoError(t, err)
+		require.Len(t, followers, 2)
+		assert.Equal(t, &model.CreatorFollowerResponse{UserId: "user-1", Username: "first", Nickname: "First", FollowedAt: followedAt}, followers[0])
+		assert.Equal(t, &model.CreatorFollowerResponse{UserId: "user-2", FollowedAt: followedAt}, followers[1])
+	})
+}
+
+func TestGetFollowingFeed(t *testing.T) {
+	ctx := context.Background()
+	followUC, mocks := newFollowUseCase(t)
+
+	publishedAt := time.Now()
+	mocks.creatorFollowRepo.EXPECT().FindFeedByCursor(ctx, gomock.Any(), testUserId, gomock.Any(), nil, 20).DoAndReturn(
+		func(ctx context.Context, tx interface{}, followerUserId string, since time.Time, cursor interface{},
+			size int) ([]*entity.FollowingFeedPhoto, *model.CursorMetadata, error) {
+			// The feed only looks back over the feed window
+			assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), since, time.Minute)
+			return []*entity.FollowingFeedPhoto{
+				{PhotoId: "photo-1", CreatorId: testCreatorId, FileKey: "photo-1.jpg", CreatedAt: publishedAt},
+			}, &model.CursorMetadata{}, nil
+		})
+
+	photos, _, err := followUC.GetFollowingFeed(ctx, &model.GetFollowingFeedRequest{UserId: testUserId, Size: 20})
+	require.NoError(t, err)
+	require.Len(t, photos, 1)
+	assert.Equal(t, "https://cdn.test/photo-1.jpg", photos[0].Url)
+	assert.Equal(t, publishedAt, photos[0].PublishedAt)
+}
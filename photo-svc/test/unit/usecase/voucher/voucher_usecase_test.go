@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/photo-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/photo-svc/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateVoucherFunder(t *testing.T) {
+	ctx := context.Background()
+
+	newRequest := func() *model.CreateVoucherRequest {
+		return &model.CreateVoucherRequest{
+			Code:         "promo10",
+			Name:         "Promo",
+			DiscountType: enum.DiscountTypePercent,
+			Value:        10,
+		}
+	}
+
+	setup := func(t *testing.T) (usecase.VoucherUseCase, *mockrepository.MockVoucherRepository) {
+		ctrl := gomock.NewController(t)
+		voucherRepo := mockrepository.NewMockVoucherRepository(ctrl)
+		return usecase.NewVoucherUseCase(nil, voucherRepo, logger.New("test")), voucherRepo
+	}
+
+	expectCreate := func(voucherRepo *mockrepository.MockVoucherRepository) *entity.Voucher {
+		created := new(entity.Voucher)
+		voucherRepo.EXPECT().ExistsByCode(ctx, gomock.Any(), "PROMO10").Return(false, nil)
+		voucherRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tx interface{}, voucher *entity.Voucher) (*entity.Voucher, error) {
+				*created = *voucher
+				return voucher, nil
+			},
+		)
+		return created
+	}
+
+	t.Run("Creator voucher defaults to creator funder", func(t *testing.T) {
+		voucherUC, voucherRepo := setup(t)
+		created := expectCreate(voucherRepo)
+
+		request := newRequest()
+		request.CreatorId = "creator-a"
+
+		resp, err := voucherUC.CreateVoucher(ctx, request)
+		require.NoError(t, err)
+
+		assert.Equal(t, enum.VoucherFunderCreator, resp.FundedBy)
+		assert.Equal(t, "creator-a", created.CreatorId.String)
+		assert.True(t, created.CreatorId.Valid)
+		assert.Equal(t, 1, created.MinQuantity)
+	})
+
+	t.Run("Creator can not create platform voucher", func(t *testing.T) {
+		voucherUC, _ := setup(t)
+
+		request := newRequest()
+		request.CreatorId = "creator-a"
+		request.FundedBy = enum.VoucherFunderPlatform
+
+		resp, err := voucherUC.CreateVoucher(ctx, request)
+		assert.Nil(t, resp)
+		appErr, ok := err.(*helper.AppError)
+		require.True(t, ok)
+		assert.Equal(t, errorcode.ErrForbidden, appErr.Code)
+	})
+
+	t.Run("Admin creates platform voucher without creator", func(t *testing.T) {
+		voucherUC, voucherRepo := setup(t)
+		created := expectCreate(voucherRepo)
+
+		request := newRequest()
+		request.CanFundPlatform = true
+
+		resp, err := voucherUC.CreateVoucher(ctx, request)
+		require.NoError(t, err)
+
+		assert.Equal(t, enum.VoucherFunderPlatform, resp.FundedBy)
+		assert.False(t, created.CreatorId.Valid)
+	})
+
+	t.Run("Creator funded voucher requires a creator", func(t *testing.T) {
+		voucherUC, _ := setup(t)
+
+		request := newRequest()
+		request.CanFundPlatform = true
+		request.FundedBy = enum.VoucherFunderCreator
+
+		_, err := voucherUC.CreateVoucher(ctx, request)
+		appErr, ok := err.(*helper.AppError)
+		require.True(t, ok)
+		assert.Equal(t, errorcode.ErrInvalidArgument, appErr.Code)
+	})
+
+	t.Run("Percent voucher above 100 is rejected", func(t *testing.T) {
+		voucherUC, _ := setup(t)
+
+		request := newRequest()
+		request.CreatorId = "creator-a"
+		request.Value = 101
+
+		_, err := voucherUC.CreateVoucher(ctx, request)
+		appErr, ok := err.(*helper.AppError)
+		require.True(t, ok)
+		assert.Equal(t, errorcode.ErrInvalidArgument, appErr.Code)
+	})
+}
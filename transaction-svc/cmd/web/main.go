@@ -64,6 +64,8 @@ func webServer(ctx context.Context) error {
 	jetStreamConfig := config.NewJetStream()
 
 	config.InitCreatorStream(jetStreamConfig)
+	config.InitUserDeletionStream(jetStreamConfig)
+	config.InitPublishedStreams(jetStreamConfig)
 
 	registry, err := consul.NewRegistry(serverConfig.ConsulAddr, serverConfig.Name)
 	if err != nil {
@@ -144,9 +146,10 @@ func webServer(ctx context.Context) error {
 	bankUseCase := usecase.NewBankUseCase(dbConfig, bankRepository, logs)
 	bankWalletUseCase := usecase.NewBankWalletUseCase(dbConfig, bankWalletRepoistory, bankRepository, logs)
 	reviewUseCase := usecase.NewReviewUseCase(transactionDetailRepo, creatorReviewRepo, transactionProducer, dbConfig, logs)
-	withdrawalUseCase := usecase.NewWithdrawalUseCase(dbConfig, withdrawalRepository, walletRepository, logs)
+	withdrawalUseCase := usecase.NewWithdrawalUseCase(dbConfig, withdrawalRepository, walletRepository, transactionProducer, logs)
 	transactionWalletUC := usecase.NewTransactionWalletUseCase(dbConfig, transactionWalletRepo, logs)
-	cancelationUseCase := usecase.NewCancelationUseCase(dbConfig, transactionRepo, logs)
+	cancelationUseCase := usecase.NewCancelationUseCase(dbConfig, transactionRepo, transactionProducer, logs)
+	userDataUseCase := usecase.NewUserDataUseCase(dbConfig, transactionRepo, creatorReviewRepo, logs)
 	schedulerUseCase := usecase.NewSchedulerUseCase(dbConfig, transactionRepo, transactionUseCase, cancelationUseCase, paymentAdapter, logs)
 
 	transactionController := http.NewTransactionController(transactionUseCase, customValidator, logs)
@@ -172,7 +175,7 @@ func webServer(ctx context.Context) error {
 		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
 		defer l.Close()
 
-		grpcHandler.NewTransactionGRPCHandler(grpcServer, walletUseCase)
+		grpcHandler.NewTransactionGRPCHandler(grpcServer, walletUseCase, reviewUseCase, userDataUseCase)
 
 		if err := grpcServer.Serve(l); err != nil {
 			logs.Error(fmt.Sprintf("Failed to start gRPC category server: %v", err))
@@ -197,6 +200,13 @@ func webServer(ctx context.Context) error {
 		}
 	}()
 
+	userDeletionSubscriber := messaging.NewUserDeletionSubscriber(jetStreamConfig, userDataUseCase, logs)
+	go func() {
+		if err := userDeletionSubscriber.Start(ctx); err != nil {
+			logs.Error(fmt.Sprintf("Subscriber error: %v", err))
+		}
+	}()
+
 	serverErrors := make(chan error, 1)
 	route := route.NewRoute(app, transactionController, bankController, bankWalletController, reviewController,
 		withdarawlController, walletController, transactionWalletCtrl, authMiddleware, creatorMiddleware, walletMiddleware)
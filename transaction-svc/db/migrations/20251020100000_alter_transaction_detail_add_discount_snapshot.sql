@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transaction_details ALTER COLUMN creator_discount_id DROP NOT NULL;
+ALTER TABLE transaction_details ADD COLUMN IF NOT EXISTS discount_snapshot JSONB;
+UPDATE transaction_details SET creator_discount_id = NULL WHERE creator_discount_id = '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transaction_details DROP COLUMN IF EXISTS discount_snapshot;
+UPDATE transaction_details SET creator_discount_id = '' WHERE creator_discount_id IS NULL;
+ALTER TABLE transaction_details ALTER COLUMN creator_discount_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE withdrawals
+    ADD COLUMN reviewed_by CHAR(26),
+    ADD COLUMN reviewed_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_withdrawals_status_created_at ON withdrawals (status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_withdrawals_status_created_at;
+
+ALTER TABLE withdrawals
+    DROP COLUMN reviewed_at,
+    DROP COLUMN reviewed_by;
+-- +goose StatementEnd
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/discovery"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
@@ -18,7 +20,7 @@ import (
 
 type PhotoAdapter interface {
 	CalculatePhotoPrice(ctx context.Context, userId, creatorId string, photoIds []string) (*[]*model.CheckoutItem, *model.Total, error)
-	CalculatePhotoPriceV2(ctx context.Context, userId, creatorId string, request *model.CreateTransactionV2Request) (*[]*model.CheckoutItem, *model.Total, error)
+	CalculatePhotoPriceV2(ctx context.Context, userId, creatorId, transactionId string, request *model.CreateTransactionV2Request) (*[]*model.CheckoutItem, *model.Total, error)
 	OwnerOwnPhotos(ctx context.Context, ownerId string, photoIds []string) error
 	GetPhotoWithDetails(ctx context.Context, photoIds []string, userId string) (*[]*photopb.Photo, error)
 	CancelPhotos(ctx context.Context, userId string, photoIds []string) error
@@ -200,7 +202,7 @@ func (a *photoAdapter) GetCreator(ctx context.Context, userId string) (*entity.C
 	return creator, nil
 }
 
-func (a *photoAdapter) CalculatePhotoPriceV2(ctx context.Context, userId, creatorId string, request *model.CreateTransactionV2Request) (*[]*model.CheckoutItem, *model.Total, error) {
+func (a *photoAdapter) CalculatePhotoPriceV2(ctx context.Context, userId, creatorId, transactionId string, request *model.CreateTransactionV2Request) (*[]*model.CheckoutItem, *model.Total, error) {
 	checkoutItemPb := make([]*photopb.CheckoutItemWeb, 0, len(request.Items))
 	for _, item := range request.Items {
 		var discount *photopb.Discount
@@ -231,6 +233,9 @@ func (a *photoAdapter) CalculatePhotoPriceV2(ctx context.Context, userId, creato
 		ChekoutItemWeb: checkoutItemPb,
 		TotalPrice:     request.TotalPrice,
 		TotalDiscount:  request.TotalDiscount,
+		TransactionId:  transactionId,
+		VoucherCode:    request.VoucherCode,
+		QuoteToken:     request.QuoteToken,
 	}
 
 	response, err := a.client.CalculatePhotoPriceV2(ctx, processPhotoRequest)
@@ -238,6 +243,10 @@ func (a *photoAdapter) CalculatePhotoPriceV2(ctx context.Context, userId, creato
 		return nil, nil, helper.FromGRPCError(err)
 	}
 
+	if response.GetPriceChanged() {
+		return nil, nil, helper.NewPriceChangedError(priceChangedResponse(response))
+	}
+
 	items := make([]*model.CheckoutItem, 0)
 	for _, item := range response.Items {
 		transactionItem := &model.CheckoutItem{
@@ -251,15 +260,89 @@ func (a *photoAdapter) CalculatePhotoPriceV2(ctx context.Context, userId, creato
 			DiscountValue:       item.GetDiscountValue(),
 			DiscountId:          item.GetDiscountId(),
 			DiscountType:        item.GetDiscountType(),
+			VoucherId:           item.GetVoucherId(),
+			VoucherCode:         item.GetVoucherCode(),
+			VoucherDiscount:     item.GetVoucherDiscount(),
+			VoucherFundedBy:     item.GetVoucherFundedBy(),
 			FinalPrice:          item.GetFinalPrice(),
 		}
 		items = append(items, transactionItem)
 	}
 
 	total := &model.Total{
-		Price:    response.Total.GetPrice(),
-		Discount: response.Total.GetDiscount(),
+		QuoteId:         response.GetQuoteId(),
+		Price:           response.Total.GetPrice(),
+		Discount:        response.Total.GetDiscount(),
+		VoucherId:       response.Total.GetVoucherId(),
+		VoucherCode:     response.Total.GetVoucherCode(),
+		VoucherDiscount: response.Total.GetVoucherDiscount(),
+		VoucherFundedBy: response.Total.GetVoucherFundedBy(),
 	}
 
 	return &items, total, nil
 }
+
+func priceChangedResponse(response *photopb.CalculatePhotoPriceV2Response) *model.PriceChangedResponse {
+	items := make([]model.CheckoutItemWeb, 0, len(response.GetItems()))
+	for _, item := range response.GetItems() {
+		var discount *model.DiscountItem
+		if item.GetDiscountId() != "" && item.GetDiscount() != 0 {
+			discount = &model.DiscountItem{
+				Discount:            item.GetDiscount(),
+				DiscountMinQuantity: int(item.GetDiscountMinQuantity()),
+				DiscountValue:       item.GetDiscountValue(),
+				DiscountId:          item.GetDiscountId(),
+				DiscountType:        item.GetDiscountType(),
+			}
+		}
+
+		var voucher *model.VoucherItem
+		if item.GetVoucherId() != "" && item.GetVoucherDiscount() != 0 {
+			voucher = &model.VoucherItem{
+				Id:       item.GetVoucherId(),
+				Code:     item.GetVoucherCode(),
+				Amount:   item.GetVoucherDiscount(),
+				FundedBy: item.GetVoucherFundedBy(),
+			}
+		}
+
+		items = append(items, model.CheckoutItemWeb{
+			PhotoId:    item.GetPhotoId(),
+			CreatorId:  item.GetCreatorId(),
+			Title:      item.GetTitle(),
+			Price:      item.GetPrice(),
+			Discount:   discount,
+			Voucher:    voucher,
+			FinalPrice: item.GetFinalPrice(),
+		})
+	}
+
+	var voucher *model.VoucherItem
+	if response.GetTotal().GetVoucherId() != "" {
+		voucher = &model.VoucherItem{
+			Id:       response.GetTotal().GetVoucherId(),
+			Code:     response.GetTotal().GetVoucherCode(),
+			Amount:   response.GetTotal().GetVoucherDiscount(),
+			FundedBy: response.GetTotal().GetVoucherFundedBy(),
+		}
+	}
+
+	var quoteExpiresAt *time.Time
+	if response.GetQuoteExpiresAt() != nil {
+		expiresAt := response.GetQuoteExpiresAt().AsTime()
+		quoteExpiresAt = &expiresAt
+	}
+
+	return &model.PriceChangedResponse{
+		Code:   errorcode.ErrPriceChanged,
+		Reason: response.GetPriceChangedReason(),
+		Checkout: &model.PreviewCheckoutResponse{
+			Items:          items,
+			Voucher:        voucher,
+			TotalPrice:     response.GetTotal().GetPrice(),
+			TotalDiscount:  response.GetTotal().GetDiscount(),
+			QuoteToken:     response.GetQuoteToken(),
+			QuoteExpiresAt: quoteExpiresAt,
+		},
+	}
+}
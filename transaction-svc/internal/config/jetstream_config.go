@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
 
@@ -26,6 +27,17 @@ func NewJetStream() nats.JetStreamContext {
 	return js
 }
 
+func InitUserDeletionStream(js nats.JetStreamContext) {
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "USER_DELETION_STREAM",
+		Subjects: []string{"user.deleted"},
+		Storage:  nats.FileStorage,
+	})
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		log.Fatalf("failed to create stream: %v", err)
+	}
+}
+
 func InitCreatorStream(js nats.JetStreamContext) {
 	err := js.DeleteStream("Creator_STREAM")
 	_, err = js.AddStream(&nats.StreamConfig{
@@ -37,3 +49,24 @@ func InitCreatorStream(js nats.JetStreamContext) {
 		log.Fatalf("failed to create stream: %v", err)
 	}
 }
+
+// InitPublishedStreams declares the streams transaction-svc publishes to. It owns their subjects, consumers in
+// other services only bind to them, so a stream left by an older declaration is updated to this subject list.
+func InitPublishedStreams(js nats.JetStreamContext) {
+	streams := []*nats.StreamConfig{
+		{Name: "TRANSACTION_STREAM", Subjects: []string{"transaction.settled", "transaction.canceled"}, Storage: nats.FileStorage},
+		{Name: "CREATOR_REVIEW_STREAM", Subjects: []string{"creator.review.updated"}, Storage: nats.FileStorage},
+		{Name: "REVIEW_STREAM", Subjects: []string{"review.created"}, Storage: nats.FileStorage},
+		{Name: "WITHDRAWAL_STREAM", Subjects: []string{"withdrawal.status.updated"}, Storage: nats.FileStorage},
+	}
+
+	for _, stream := range streams {
+		_, err := js.AddStream(stream)
+		if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+			_, err = js.UpdateStream(stream)
+		}
+		if err != nil {
+			log.Fatalf("failed to declare stream %s: %v", stream.Name, err)
+		}
+	}
+}
@@ -16,13 +16,18 @@ import (
 )
 
 type TransactionGRPCHandler struct {
-	walletUseCase usecase.WalletUseCase
+	walletUseCase   usecase.WalletUseCase
+	reviewUseCase   usecase.ReviewUseCase
+	userDataUseCase usecase.UserDataUseCase
 	transactionpb.UnimplementedTransactionServiceServer
 }
 
-func NewTransactionGRPCHandler(server *grpc.Server, walletUseCase usecase.WalletUseCase) {
+func NewTransactionGRPCHandler(server *grpc.Server, walletUseCase usecase.WalletUseCase, reviewUseCase usecase.ReviewUseCase,
+	userDataUseCase usecase.UserDataUseCase) {
 	handler := &TransactionGRPCHandler{
-		walletUseCase: walletUseCase,
+		walletUseCase:   walletUseCase,
+		reviewUseCase:   reviewUseCase,
+		userDataUseCase: userDataUseCase,
 	}
 
 	transactionpb.RegisterTransactionServiceServer(server, handler)
@@ -87,3 +92,81 @@ func (h *TransactionGRPCHandler) GetWallet(ctx context.Context, pbReq *transacti
 		Wallet: pbWallet,
 	}, nil
 }
+
+func (h *TransactionGRPCHandler) GetCreatorReviewSummary(ctx context.Context, pbReq *transactionpb.GetCreatorReviewSummaryRequest) (
+	*transactionpb.GetCreatorReviewSummaryResponse, error) {
+	log.Println("----  Get Creator Review Summary via GRPC in transaction-svc ------")
+
+	summary, err := h.reviewUseCase.GetReviewSummary(ctx, pbReq.GetCreatorId())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	pbRatingBreakdown := make([]*transactionpb.RatingCount, 0, len(summary.RatingBreakdown))
+	for _, ratingCount := range summary.RatingBreakdown {
+		pbRatingBreakdown = append(pbRatingBreakdown, &transactionpb.RatingCount{
+			Rating: int32(ratingCount.Rating),
+			Count:  int32(ratingCount.Count),
+		})
+	}
+
+	return &transactionpb.GetCreatorReviewSummaryResponse{
+		Status: http.StatusOK,
+		Summary: &transactionpb.CreatorReviewSummary{
+			CreatorId:       summary.CreatorId,
+			AverageRating:   summary.AverageRating,
+			TotalReview:     int32(summary.TotalReview),
+			RatingBreakdown: pbRatingBreakdown,
+		},
+	}, nil
+}
+
+func (h *TransactionGRPCHandler) GetUserDataExport(ctx context.Context, pbReq *transactionpb.GetUserDataExportRequest) (
+	*transactionpb.GetUserDataExportResponse, error) {
+	export, err := h.userDataUseCase.GetUserDataExport(ctx, pbReq.GetUserId())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	pbTransactions := make([]*transactionpb.UserDataExportTransaction, 0, len(export.Transactions))
+	for _, transaction := range export.Transactions {
+		pbTransaction := &transactionpb.UserDataExportTransaction{
+			Id:       transaction.Id,
+			Status:   string(transaction.Status),
+			Amount:   transaction.Amount,
+			PhotoIds: transaction.PhotoIds,
+		}
+		if transaction.CheckoutAt != nil {
+			pbTransaction.CheckoutAt = timestamppb.New(*transaction.CheckoutAt)
+		}
+		if transaction.PaymentAt != nil {
+			pbTransaction.PaymentAt = timestamppb.New(*transaction.PaymentAt)
+		}
+		if transaction.CreatedAt != nil {
+			pbTransaction.CreatedAt = timestamppb.New(*transaction.CreatedAt)
+		}
+		pbTransactions = append(pbTransactions, pbTransaction)
+	}
+
+	pbReviews := make([]*transactionpb.UserDataExportReview, 0, len(export.Reviews))
+	for _, review := range export.Reviews {
+		pbReview := &transactionpb.UserDataExportReview{
+			Id:        review.Id,
+			CreatorId: review.CreatorId,
+			Rating:    int32(review.Rating),
+		}
+		if review.Comment != nil {
+			pbReview.Comment = *review.Comment
+		}
+		if review.CreatedAt != nil {
+			pbReview.CreatedAt = timestamppb.New(*review.CreatedAt)
+		}
+		pbReviews = append(pbReviews, pbReview)
+	}
+
+	return &transactionpb.GetUserDataExportResponse{
+		Status:       http.StatusOK,
+		Transactions: pbTransactions,
+		Reviews:      pbReviews,
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 
 type BankController interface {
 	CreateBank(ctx *fiber.Ctx) error
+	UpdateBank(ctx *fiber.Ctx) error
 	DeleteBank(ctx *fiber.Ctx) error
 	FindAllBank(ctx *fiber.Ctx) error
 	FindBankById(ctx *fiber.Ctx) error
@@ -56,6 +57,32 @@ func (c *bankController) CreateBank(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *bankController) UpdateBank(ctx *fiber.Ctx) error {
+	request := new(model.UpdateBankRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.Id = ctx.Params("bankId")
+	if _, err := ulid.Parse(request.Id); err != nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "The provided Bank ID is not valid")
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.bankUseCase.Update(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Update bank : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.BankResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
 func (c *bankController) FindBankById(ctx *fiber.Ctx) error {
 	request := new(model.FindBankByIdRequest)
 	request.Id = ctx.Params("bankId")
@@ -59,6 +59,11 @@ func (c *reviewController) UserCreateReview(ctx *fiber.Ctx) error {
 }
 
 func (c *reviewController) CreatorGetReview(ctx *fiber.Ctx) error {
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.creatorGetReviewByCursor(ctx)
+	}
+
 	request := &model.GetAllReviewRequest{
 		Rating: ctx.QueryInt("rating", 0),
 		Order:  ctx.Query("order", "DESC"),
@@ -83,3 +88,33 @@ func (c *reviewController) CreatorGetReview(ctx *fiber.Ctx) error {
 		PageMetadata: pageMetadata,
 	})
 }
+
+func (c *reviewController) creatorGetReviewByCursor(ctx *fiber.Ctx) error {
+	request := &model.GetAllReviewByCursorRequest{
+		Rating: ctx.QueryInt("rating", 0),
+		Order:  ctx.Query("order", "DESC"),
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 10),
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.CreatorId = auth.CreatorId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.reviewUseCase.CreatorGetReviewByCursor(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get all review error : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.CreatorReviewResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
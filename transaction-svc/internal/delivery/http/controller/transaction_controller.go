@@ -131,6 +131,12 @@ func (c *transactionController) GetUserTransactionWithDetail(ctx *fiber.Ctx) err
 
 func (c *transactionController) GetAllUserTransaction(ctx *fiber.Ctx) error {
 	auth := middleware.GetUser(ctx)
+
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.getAllUserTransactionByCursor(ctx, auth.UserId)
+	}
+
 	request := &model.GetAllUsertTransaction{
 		UserId: auth.UserId,
 		Order:  ctx.Query("order", "DESC"),
@@ -153,6 +159,33 @@ func (c *transactionController) GetAllUserTransaction(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *transactionController) getAllUserTransactionByCursor(ctx *fiber.Ctx, userId string) error {
+	request := &model.GetAllUserTransactionByCursor{
+		UserId: userId,
+		Order:  ctx.Query("order", "DESC"),
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 10),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.transactionUseCase.GetAllUserTransactionByCursor(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get all user transaction error : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.UserTransaction]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
 func (c *transactionController) CreateTransactionV2(ctx *fiber.Ctx) error {
 	request := new(model.CreateTransactionV2Request)
 	auth := middleware.GetUser(ctx)
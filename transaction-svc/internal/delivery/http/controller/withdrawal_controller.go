@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
@@ -18,6 +19,9 @@ type WithdrawalController interface {
 	// DeleteWithdrawal(ctx *fiber.Ctx) error
 	FindAllWithdrawal(ctx *fiber.Ctx) error
 	FindWithdrawalById(ctx *fiber.Ctx) error
+	FindAllWithdrawalByStatus(ctx *fiber.Ctx) error
+	ApproveWithdrawal(ctx *fiber.Ctx) error
+	RejectWithdrawal(ctx *fiber.Ctx) error
 }
 
 type withdrawalController struct {
@@ -103,6 +107,70 @@ func (c *withdrawalController) FindAllWithdrawal(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *withdrawalController) FindAllWithdrawalByStatus(ctx *fiber.Ctx) error {
+	request := new(model.FindAllWithdrawalByStatusRequest)
+	if err := ctx.QueryParser(request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	if request.Status == "" {
+		request.Status = enum.WithdrawalStatusPending
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.withdrawalUseCase.FindAllByStatus(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Find all withdrawal by status : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *withdrawalController) ApproveWithdrawal(ctx *fiber.Ctx) error {
+	return c.reviewWithdrawal(ctx, true)
+}
+
+func (c *withdrawalController) RejectWithdrawal(ctx *fiber.Ctx) error {
+	return c.reviewWithdrawal(ctx, false)
+}
+
+func (c *withdrawalController) reviewWithdrawal(ctx *fiber.Ctx, approved bool) error {
+	request := new(model.ReviewWithdrawalRequest)
+	if len(ctx.Body()) > 0 {
+		if err := helper.StrictBodyParser(ctx, request); err != nil {
+			return helper.ErrBodyParserResponseJSON(ctx, err)
+		}
+	}
+
+	request.Id = ctx.Params("withdrawalId")
+	request.ReviewerId = middleware.GetUser(ctx).UserId
+	request.Approved = approved
+
+	if _, err := ulid.Parse(request.Id); err != nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "The provided Withdrawal ID is not valid")
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.withdrawalUseCase.Review(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Review withdrawal : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
 // func (c *withdrawalController) DeleteWithdrawal(ctx *fiber.Ctx) error {
 // 	request := new(model.DeleteWithdrawalRequest)
 // 	if err := helper.StrictBodyParser(ctx, request); err != nil {
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"strings"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
@@ -36,6 +37,15 @@ func NewUserAuth(userAdapter adapter.UserAdapter, tracer trace.Tracer, logs *log
 			Similarity:  authResponse.GetUser().GetSimilarity(),
 			// CreatorId:   authResponse.GetUser().GetCreatorId(),
 			// WalletId:    authResponse.GetUser().GetWalletId(),
+			Roles:       authResponse.GetUser().GetRoles(),
+			Permissions: authResponse.GetUser().GetPermissions(),
+
+			TwoFactorEnabled: authResponse.GetUser().GetTwoFactorEnabled(),
+		}
+
+		if verifiedAt := authResponse.GetUser().GetTwoFactorVerifiedAt(); verifiedAt > 0 {
+			twoFactorVerifiedAt := time.Unix(verifiedAt, 0)
+			auth.TwoFactorVerifiedAt = &twoFactorVerifiedAt
 		}
 
 		ctx.Locals("auth", auth)
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRequirePermission must be mounted after NewUserAuth, it only inspects the
+// permissions user-svc resolved for the authenticated user.
+func NewRequirePermission(permission enum.PermissionEnum) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		auth := GetUser(ctx)
+		if !slices.Contains(auth.Permissions, string(permission)) {
+			return fiber.NewError(fiber.StatusForbidden, "Forbidden access")
+		}
+
+		return ctx.Next()
+	}
+}
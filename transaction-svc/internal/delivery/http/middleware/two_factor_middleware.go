@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRequireFreshTwoFactor must be mounted after NewUserAuth. Only users with
+// 2FA enabled and a step-up reported by user-svc on the current session within
+// maxAge get through, a stolen session of a user without 2FA cannot move money.
+func NewRequireFreshTwoFactor(maxAge time.Duration) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		auth := GetUser(ctx)
+		if !auth.TwoFactorEnabled {
+			return fiber.NewError(fiber.StatusForbidden, "Two-factor authentication must be enabled for this action")
+		}
+
+		if auth.TwoFactorVerifiedAt == nil || time.Since(*auth.TwoFactorVerifiedAt) > maxAge {
+			return fiber.NewError(fiber.StatusForbidden, "Two-factor step-up required")
+		}
+
+		return ctx.Next()
+	}
+}
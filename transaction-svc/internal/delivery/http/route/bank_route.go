@@ -1,9 +1,17 @@
 package route
 
+import (
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
+)
+
 func (r *route) setupBankRoute() {
+	bankManage := middleware.NewRequirePermission(enum.PermissionBankManage)
+
 	bankRoute := r.app.Group("/api/bank", r.authMiddleware)
-	bankRoute.Post("/create", r.bankController.CreateBank)
-	bankRoute.Delete("/delete/:bankId", r.bankController.DeleteBank)
+	bankRoute.Post("/create", bankManage, r.bankController.CreateBank)
+	bankRoute.Put("/update/:bankId", bankManage, r.bankController.UpdateBank)
+	bankRoute.Delete("/delete/:bankId", bankManage, r.bankController.DeleteBank)
 	bankRoute.Get("/", r.bankController.FindAllBank)
 	bankRoute.Get("/:bankId", r.bankController.FindBankById)
 }
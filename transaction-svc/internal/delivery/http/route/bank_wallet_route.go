@@ -1,8 +1,12 @@
 package route
 
+import (
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/middleware"
+)
+
 func (r *route) setupBankWalletRoute() {
 	bankWalletRoute := r.app.Group("/api/wallet/bank", r.authMiddleware, r.creatorMiddleware, r.walletMiddleware)
-	bankWalletRoute.Post("/add", r.bankWalletController.CreateBankWallet)
+	bankWalletRoute.Post("/add", middleware.NewRequireFreshTwoFactor(sensitiveActionStepUpMaxAge), r.bankWalletController.CreateBankWallet)
 	bankWalletRoute.Get("/", r.bankWalletController.FindAllBankWallet)
 	bankWalletRoute.Delete("/delete/:bankWalletId", r.bankWalletController.DeleteBankWallet)
 }
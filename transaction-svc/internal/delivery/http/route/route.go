@@ -1,11 +1,17 @@
 package route
 
 import (
+	"time"
+
 	http "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/controller"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// sensitiveActionStepUpMaxAge is how old a 2FA step-up may be for actions that
+// move money out of a wallet
+const sensitiveActionStepUpMaxAge = 5 * time.Minute
+
 type Route interface {
 	SetupRoute()
 }
@@ -1,9 +1,19 @@
 package route
 
+import (
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
+)
+
 func (r *route) setupWithdrawalRoute() {
 	reviewRoute := r.app.Group("/api/withdrawal", r.authMiddleware, r.creatorMiddleware, r.walletMiddleware)
-	reviewRoute.Post("/create", r.withdrawalController.CreateWithdrawal)
+	reviewRoute.Post("/create", middleware.NewRequireFreshTwoFactor(sensitiveActionStepUpMaxAge), r.withdrawalController.CreateWithdrawal)
 	reviewRoute.Get("/", r.withdrawalController.FindAllWithdrawal)
 	reviewRoute.Get("/:withdrawalId", r.withdrawalController.FindWithdrawalById)
 	// reviewRoute.Delete("/delete", r.withdrawalController.DeleteWithdrawal)
+
+	adminRoute := r.app.Group("/api/admin/withdrawal", r.authMiddleware, middleware.NewRequirePermission(enum.PermissionWithdrawalReview))
+	adminRoute.Get("/", r.withdrawalController.FindAllWithdrawalByStatus)
+	adminRoute.Put("/:withdrawalId/approve", r.withdrawalController.ApproveWithdrawal)
+	adminRoute.Put("/:withdrawalId/reject", r.withdrawalController.RejectWithdrawal)
 }
@@ -21,3 +21,8 @@ type TotalReviewAndRating struct {
 	TotalReview int     `db:"total_review"`
 	Rating      float32 `db:"average_rating"`
 }
+
+type RatingCount struct {
+	Rating int `db:"rating"`
+	Count  int `db:"count"`
+}
@@ -24,6 +24,7 @@ type Transaction struct {
 	ExternalCallbackResponse *json.RawMessage       `db:"external_callback_response"`
 	ExternalSettlementAt     *time.Time             `db:"external_settlement_at"`
 	Amount                   int32                  `db:"amount"`
+	PriceQuoteId             sql.NullString         `db:"price_quote_id"`
 	CreatedAt                *time.Time             `db:"created_at"`
 	UpdatedAt                *time.Time             `db:"updated_at"`
 }
@@ -47,10 +48,11 @@ type TransactionWithDetail struct {
 	CreatedAt                *time.Time             `db:"transaction_created_at"`
 	UpdatedAt                *time.Time             `db:"transaction_updated_at"`
 
-	TranscationDetailId string `db:"transaction_detail_id"`
-	CreatorId           string `db:"creator_id"`
-	CreatorDiscountId   string `db:"creator_discount_id"`
-	IsReviewed          bool   `db:"is_reviewed"`
+	TranscationDetailId string           `db:"transaction_detail_id"`
+	CreatorId           string           `db:"creator_id"`
+	CreatorDiscountId   sql.NullString   `db:"creator_discount_id"`
+	DiscountSnapshot    *json.RawMessage `db:"discount_snapshot"`
+	IsReviewed          bool             `db:"is_reviewed"`
 
 	TransactionItemId string        `db:"transaction_item_id"`
 	PhotoId           string        `db:"photo_id"`
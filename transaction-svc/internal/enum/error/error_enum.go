@@ -18,6 +18,7 @@ const (
 
 	// Conflict
 	ErrAlreadyExists string = "ALREADY_EXISTS"
+	ErrPriceChanged  string = "PRICE_CHANGED"
 
 	// Internal
 	ErrInternal        string = "INTERNAL"
@@ -0,0 +1,6 @@
+package enum
+
+var (
+	VoucherFunderCreator  = "CREATOR"
+	VoucherFunderPlatform = "PLATFORM"
+)
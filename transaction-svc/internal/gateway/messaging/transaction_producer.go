@@ -13,11 +13,18 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/event"
 )
 
+const (
+	publishAttempts      = 3
+	publishRetryInterval = 200 * time.Millisecond
+)
+
 type TransactionProducer interface {
 	ScheduleTransactionTaskExpiration(ctx context.Context, transactionID string) error
 	ProduceCreateReviewEvent(ctx context.Context, creatorReviewCountEvent *event.CreatorReviewCountEvent) error
 	ProduceTransactionSettledEvent(ctx context.Context, transactionSettledEvent *event.OwnerOwnPhotosEvent) error
 	ProduceTransactionCanceledEvent(ctx context.Context, transactionCanceledEvent *event.CancelPhotosEvent) error
+	ProduceReviewCreatedEvent(ctx context.Context, reviewCreatedEvent *event.CreatorReviewCreatedEvent) error
+	ProduceWithdrawalStatusEvent(ctx context.Context, withdrawalStatusEvent *event.WithdrawalStatusEvent) error
 }
 
 type transactionProducer struct {
@@ -89,3 +96,52 @@ func (s *transactionProducer) ProduceTransactionCanceledEvent(ctx context.Contex
 	// log.Printf("Published transaction canceled event for transaction %s", transactionCanceledEvent.TransactionId)
 	return nil
 }
+
+func (s *transactionProducer) ProduceReviewCreatedEvent(ctx context.Context, reviewCreatedEvent *event.CreatorReviewCreatedEvent) error {
+	subject := "review.created"
+
+	err := s.publishWithRetry(ctx, subject, reviewCreatedEvent)
+	if err != nil {
+		return fmt.Errorf("failed to publish review created event: %w", err)
+	}
+
+	log.Printf("Published review created event for creator %s", reviewCreatedEvent.CreatorId)
+	return nil
+}
+
+func (s *transactionProducer) ProduceWithdrawalStatusEvent(ctx context.Context, withdrawalStatusEvent *event.WithdrawalStatusEvent) error {
+	subject := "withdrawal.status.updated"
+
+	err := s.publishWithRetry(ctx, subject, withdrawalStatusEvent)
+	if err != nil {
+		return fmt.Errorf("failed to publish withdrawal status event: %w", err)
+	}
+
+	log.Printf("Published withdrawal status event for withdrawal %s", withdrawalStatusEvent.WithdrawalId)
+	return nil
+}
+
+// publishWithRetry is used for events published after the database commit, the
+// change cannot be rolled back anymore so a short broker outage is retried
+// before the caller is told the event was lost.
+func (s *transactionProducer) publishWithRetry(ctx context.Context, subject string, data any) error {
+	var err error
+	for attempt := 1; attempt <= publishAttempts; attempt++ {
+		if err = s.messagingAdapter.Publish(ctx, subject, data); err == nil {
+			return nil
+		}
+
+		if attempt == publishAttempts {
+			break
+		}
+
+		log.Printf("Publish to %s failed (attempt %d/%d): %v", subject, attempt, publishAttempts, err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-time.After(publishRetryInterval * time.Duration(attempt)):
+		}
+	}
+
+	return err
+}
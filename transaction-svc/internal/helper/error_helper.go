@@ -5,6 +5,7 @@ import (
 
 	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -67,7 +68,7 @@ func (e *AppError) HTTPStatus() int {
 		return 403
 	case errorcode.ErrValidationFailed, errorcode.ErrInvalidArgument:
 		return 422
-	case errorcode.ErrAlreadyExists:
+	case errorcode.ErrAlreadyExists, errorcode.ErrPriceChanged:
 		return 409
 	case errorcode.ErrUserNotFound, errorcode.ErrResourceNotFound:
 		return 404
@@ -88,6 +89,8 @@ func (e *AppError) GRPCErrorCode() error {
 		return status.Error(codes.InvalidArgument, e.Message)
 	case errorcode.ErrAlreadyExists:
 		return status.Error(codes.AlreadyExists, e.Message)
+	case errorcode.ErrPriceChanged:
+		return status.Error(codes.FailedPrecondition, e.Message)
 	case errorcode.ErrUserNotFound, errorcode.ErrResourceNotFound:
 		return status.Error(codes.NotFound, e.Message)
 	case errorcode.ErrTooManyRequests:
@@ -97,6 +100,19 @@ func (e *AppError) GRPCErrorCode() error {
 	}
 }
 
+// PriceChangedError rejects a checkout whose price no longer matches the previewed price, Response carries the current price
+type PriceChangedError struct {
+	*AppError
+	Response *model.PriceChangedResponse
+}
+
+func NewPriceChangedError(response *model.PriceChangedResponse) *PriceChangedError {
+	return &PriceChangedError{
+		AppError: NewUseCaseError(errorcode.ErrPriceChanged, response.Reason),
+		Response: response,
+	}
+}
+
 func WrapInternalServerError(logs *logger.Log, internalMsg string, err error) error {
 	logs.Error(fmt.Sprintf("%s %s", internalMsg, err.Error()), &logger.Options{
 		IsPrintStack: true,
@@ -126,6 +142,8 @@ func FromGRPCError(err error) *AppError {
 		return NewAppGRPCError(errorcode.ErrUserNotFound, codes.NotFound, st.Message(), err)
 	case codes.ResourceExhausted:
 		return NewAppGRPCError(errorcode.ErrTooManyRequests, codes.ResourceExhausted, st.Message(), err)
+	case codes.FailedPrecondition:
+		return NewAppGRPCError(errorcode.ErrPriceChanged, codes.FailedPrecondition, st.Message(), err)
 	default:
 		return NewAppGRPCError(errorcode.ErrInternal, st.Code(), st.Message(), err)
 	}
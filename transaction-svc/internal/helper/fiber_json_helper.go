@@ -36,6 +36,15 @@ func ErrValidationResponseJSON(ctx *fiber.Ctx, validatonErrs *UseCaseValError) e
 }
 
 func ErrUseCaseResponseJSON(ctx *fiber.Ctx, msg string, err error, logs *logger.Log) error {
+	if priceErr, ok := err.(*PriceChangedError); ok {
+		logs.Log(fmt.Sprintf("Client error in controller : %s with code [%s]: %v", msg, priceErr.Code, priceErr.Message))
+		return ctx.Status(priceErr.HTTPStatus()).JSON(model.WebResponse[*model.PriceChangedResponse]{
+			Success: false,
+			Message: priceErr.Message,
+			Data:    priceErr.Response,
+		})
+	}
+
 	if appErr, ok := err.(*AppError); ok {
 		if appErr.Err != nil {
 			logs.Error(fmt.Sprintf("Internal error in controller : %s  with code [%s]: %v", msg, appErr.Code, appErr.Err.Error()))
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type AuthResponse struct {
 	UserId      string
 	Username    string
@@ -8,4 +10,9 @@ type AuthResponse struct {
 	Similarity  uint32
 	CreatorId   string
 	WalletId    string
+	Roles       []string
+	Permissions []string
+
+	TwoFactorEnabled    bool
+	TwoFactorVerifiedAt *time.Time
 }
@@ -13,6 +13,7 @@ type CreateBankRequest struct {
 }
 
 type UpdateBankRequest struct {
+	Id        string  `json:"-" validate:"required"`
 	BankCode  string  `json:"bank_code" validate:"required"`
 	Name      string  `json:"name" validate:"required"`
 	Alias     *string `json:"alias" validate:""`
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type CheckoutItem struct {
 	PhotoId             string `json:"photo_id"`
 	CreatorId           string `json:"creator_id"`
@@ -11,12 +13,22 @@ type CheckoutItem struct {
 	DiscountValue       int32  `json:"discount_value"`
 	DiscountId          string `json:"discount_id"`
 	DiscountType        string `json:"discount_type"`
+	VoucherId           string `json:"voucher_id"`
+	VoucherCode         string `json:"voucher_code"`
+	VoucherDiscount     int32  `json:"voucher_discount"`
+	VoucherFundedBy     string `json:"voucher_funded_by"`
 	FinalPrice          int32  `json:"final_price"`
 }
 
+// Total.Discount includes VoucherDiscount, QuoteId is set when photo service honoured a price quote
 type Total struct {
-	Price    int32
-	Discount int32
+	QuoteId         string
+	Price           int32
+	Discount        int32
+	VoucherId       string
+	VoucherCode     string
+	VoucherDiscount int32
+	VoucherFundedBy string
 }
 
 type CheckoutItemWeb struct {
@@ -25,6 +37,7 @@ type CheckoutItemWeb struct {
 	Title      string        `json:"title" validate:"required"`
 	Price      int32         `json:"price" validate:"required,gt=0"`
 	Discount   *DiscountItem `json:"discount,omitempty"`
+	Voucher    *VoucherItem  `json:"voucher,omitempty"`
 	FinalPrice int32         `json:"final_price" validate:"required,gt=0"`
 }
 
@@ -36,10 +49,50 @@ type DiscountItem struct {
 	DiscountType        string `json:"type" validate:"required"`
 }
 
+// VoucherItem is echoed back from the checkout preview, the voucher is recalculated by photo service
+type VoucherItem struct {
+	Id       string `json:"id"`
+	Code     string `json:"code"`
+	Amount   int32  `json:"amount"`
+	FundedBy string `json:"funded_by"`
+}
+
 type PreviewCheckoutResponse struct {
-	Items         []CheckoutItemWeb `json:"items" validate:"required"`
-	TotalPrice    int32             `json:"total_price" validate:"required,gt=0"`
-	TotalDiscount int32             `json:"total_discount" validate:"required,gt=0"`
+	Items          []CheckoutItemWeb `json:"items" validate:"required"`
+	Voucher        *VoucherItem      `json:"voucher,omitempty"`
+	TotalPrice     int32             `json:"total_price" validate:"required,gt=0"`
+	TotalDiscount  int32             `json:"total_discount" validate:"required,gt=0"`
+	QuoteToken     string            `json:"quote_token,omitempty"`
+	QuoteExpiresAt *time.Time        `json:"quote_expires_at,omitempty"`
+}
+
+// PriceChangedResponse is returned when the checkout price no longer matches the previewed price,
+// Checkout holds the current price together with a fresh quote token
+type PriceChangedResponse struct {
+	Code     string                   `json:"code"`
+	Reason   string                   `json:"reason"`
+	Checkout *PreviewCheckoutResponse `json:"checkout"`
+}
+
+// DiscountSnapshot keeps the discount terms applied to a transaction detail, they stay valid even after the creator changes the discount
+type DiscountSnapshot struct {
+	CreatorDiscount *CreatorDiscountSnapshot `json:"creator_discount,omitempty"`
+	Voucher         *VoucherSnapshot         `json:"voucher,omitempty"`
+}
+
+type CreatorDiscountSnapshot struct {
+	Id          string `json:"id"`
+	Type        string `json:"type"`
+	Value       int32  `json:"value"`
+	MinQuantity int    `json:"min_quantity"`
+	Amount      int32  `json:"amount"`
+}
+
+type VoucherSnapshot struct {
+	Id       string `json:"id"`
+	Code     string `json:"code"`
+	FundedBy string `json:"funded_by"`
+	Amount   int32  `json:"amount"`
 }
 
 type CreateTransactionV2Request struct {
@@ -48,4 +101,6 @@ type CreateTransactionV2Request struct {
 	Items         []CheckoutItemWeb `json:"items" validate:"required,dive"`
 	TotalPrice    int32             `json:"total_price" validate:"required,gte=0"`
 	TotalDiscount int32             `json:"total_discount" `
+	VoucherCode   string            `json:"voucher_code" validate:"omitempty,alphanum,max=32"`
+	QuoteToken    string            `json:"quote_token"`
 }
@@ -27,3 +27,27 @@ func ReviewsToResponses(reviews *[]*entity.CreatorReview) *[]*model.CreatorRevie
 	}
 	return &responses
 }
+
+// ToReviewSummaryResponse always returns all five star buckets so clients
+// can render the breakdown without filling gaps themselves.
+func ToReviewSummaryResponse(creatorId string, total *entity.TotalReviewAndRating, ratingCounts []*entity.RatingCount) *model.CreatorReviewSummaryResponse {
+	countByRating := make(map[int]int, len(ratingCounts))
+	for _, ratingCount := range ratingCounts {
+		countByRating[ratingCount.Rating] = ratingCount.Count
+	}
+
+	breakdown := make([]*model.RatingCountResponse, 0, 5)
+	for rating := 5; rating >= 1; rating-- {
+		breakdown = append(breakdown, &model.RatingCountResponse{
+			Rating: rating,
+			Count:  countByRating[rating],
+		})
+	}
+
+	return &model.CreatorReviewSummaryResponse{
+		CreatorId:       creatorId,
+		AverageRating:   total.Rating,
+		TotalReview:     total.TotalReview,
+		RatingBreakdown: breakdown,
+	}
+}
@@ -58,7 +58,8 @@ func TransactionAndPhotoToSingleResponse(
 			detail = &model.TransactionDetailResponse{
 				TransactionDetailId: trx.TranscationDetailId,
 				CreatorId:           trx.CreatorId,
-				CreatorDiscountId:   trx.CreatorDiscountId,
+				CreatorDiscountId:   nullable.SQLStringToPtr(trx.CreatorDiscountId),
+				DiscountSnapshot:    trx.DiscountSnapshot,
 				IsReviewed:          trx.IsReviewed,
 				Photo:               &[]*model.PhotoResponse{},
 			}
@@ -125,6 +126,18 @@ func UserTransactionToResponse(userTransactions *[]*entity.Transaction) *[]*mode
 	return &userTransactionReponses
 }
 
+func TransactionToUserDataExport(transaction *entity.Transaction, photoIds []string) *model.UserDataExportTransaction {
+	return &model.UserDataExportTransaction{
+		Id:         transaction.Id,
+		Status:     transaction.Status,
+		Amount:     transaction.Amount,
+		PhotoIds:   photoIds,
+		CheckoutAt: transaction.CheckoutAt,
+		PaymentAt:  transaction.PaymentAt,
+		CreatedAt:  transaction.CreatedAt,
+	}
+}
+
 func WebhookReqToCheckAndUpdate(webhookReq *model.UpdateTransactionWebhookRequest) *model.CheckAndUpdateTransactionRequest {
 	return &model.CheckAndUpdateTransactionRequest{
 		MidtransTransactionType:   webhookReq.MidtransTransactionType,
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
 )
 
@@ -13,6 +14,8 @@ func WithdrawalToResponse(withdrawal *entity.Withdrawal) *model.WithdrawalRespon
 		Amount:       withdrawal.Amount,
 		Status:       withdrawal.Status,
 		Description:  withdrawal.Description,
+		ReviewedBy:   nullable.SQLStringToPtr(withdrawal.ReviewedBy),
+		ReviewedAt:   withdrawal.ReviewedAt,
 		CreatedAt:    withdrawal.CreatedAt,
 		UpdatedAt:    withdrawal.UpdatedAt,
 	}
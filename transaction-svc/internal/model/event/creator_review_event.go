@@ -1,7 +1,20 @@
 package event
 
+import "time"
+
 type CreatorReviewCountEvent struct {
+	EventID     string  `json:"event_id"`
 	Id          string  `json:"id"`
 	Rating      float32 `json:"rating"`
 	RatingCount int     `json:"rating_count"`
 }
+
+type CreatorReviewCreatedEvent struct {
+	EventID   string     `json:"event_id"`
+	ReviewId  string     `json:"review_id"`
+	CreatorId string     `json:"creator_id"`
+	UserId    string     `json:"user_id"`
+	Rating    int        `json:"rating"`
+	Comment   string     `json:"comment"`
+	CreatedAt *time.Time `json:"created_at"`
+}
@@ -1,11 +1,27 @@
 package event
 
+import "time"
+
+// CancelPhotosEvent status is empty when the transaction failed before the buyer could pay it
 type CancelPhotosEvent struct {
-	UserId   string   `json:"user_id"`
-	PhotoIds []string `json:"photo_ids"`
+	EventID       string   `json:"event_id"`
+	UserId        string   `json:"user_id"`
+	TransactionId string   `json:"transaction_id"`
+	PhotoIds      []string `json:"photo_ids"`
+	Status        string   `json:"status"`
 }
 
 type OwnerOwnPhotosEvent struct {
-	UserId   string   `json:"user_id"`
-	PhotoIds []string `json:"photo_ids"`
+	EventID       string              `json:"event_id"`
+	UserId        string              `json:"user_id"`
+	TransactionId string              `json:"transaction_id"`
+	PhotoIds      []string            `json:"photo_ids"`
+	Amount        int32               `json:"amount"`
+	CreatorSales  []*CreatorSaleEvent `json:"creator_sales"`
+	SettledAt     *time.Time          `json:"settled_at"`
+}
+
+type CreatorSaleEvent struct {
+	CreatorId string `json:"creator_id"`
+	Amount    int32  `json:"amount"`
 }
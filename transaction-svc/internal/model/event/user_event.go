@@ -0,0 +1,9 @@
+package event
+
+import "time"
+
+type UserDeletedEvent struct {
+	Id          string     `json:"id"`
+	PseudonymId string     `json:"pseudonym_id"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+}
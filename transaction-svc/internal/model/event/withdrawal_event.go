@@ -0,0 +1,14 @@
+package event
+
+import "time"
+
+type WithdrawalStatusEvent struct {
+	EventID      string     `json:"event_id"`
+	WithdrawalId string     `json:"withdrawal_id"`
+	WalletId     string     `json:"wallet_id"`
+	CreatorId    string     `json:"creator_id"`
+	Amount       int        `json:"amount"`
+	Status       string     `json:"status"`
+	Description  string     `json:"description"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
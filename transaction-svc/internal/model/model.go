@@ -1,10 +1,11 @@
 package model
 
 type WebResponse[T any] struct {
-	Success      bool          `json:"success"`
-	Data         T             `json:"data,omitempty"`
-	Message      string        `json:"message,omitempty"`
-	PageMetadata *PageMetadata `json:"pagination,omitempty"`
+	Success        bool            `json:"success"`
+	Data           T               `json:"data,omitempty"`
+	Message        string          `json:"message,omitempty"`
+	PageMetadata   *PageMetadata   `json:"pagination,omitempty"`
+	CursorMetadata *CursorMetadata `json:"cursor,omitempty"`
 }
 
 type PageMetadata struct {
@@ -31,6 +32,13 @@ type ValidationErrorResponse struct {
 	Errors  interface{} `json:"errors,omitempty"`
 }
 
+type CursorMetadata struct {
+	Size        int    `json:"size"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasMore     bool   `json:"has_more"`
+	NextPageURL string `json:"next_page_url,omitempty"`
+}
+
 type ErrorResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message,omitempty"`
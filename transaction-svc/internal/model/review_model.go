@@ -28,3 +28,23 @@ type GetAllReviewRequest struct {
 	Page      int    `json:"page" validate:"required"`
 	Size      int    `json:"size" validate:"required"`
 }
+
+type GetAllReviewByCursorRequest struct {
+	Rating    int    `json:"rating"`
+	CreatorId string `json:"creator_id"`
+	Order     string `json:"order"`
+	Cursor    string `json:"cursor"`
+	Size      int    `json:"size" validate:"required,min=1,max=100"`
+}
+
+type RatingCountResponse struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+type CreatorReviewSummaryResponse struct {
+	CreatorId       string                 `json:"creator_id"`
+	AverageRating   float32                `json:"average_rating"`
+	TotalReview     int                    `json:"total_review"`
+	RatingBreakdown []*RatingCountResponse `json:"rating_breakdown"`
+}
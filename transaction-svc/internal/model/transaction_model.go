@@ -150,7 +150,8 @@ type GetTransactionWithDetail struct {
 type TransactionDetailResponse struct {
 	TransactionDetailId string            `json:"transaction_detail_id"`
 	CreatorId           string            `json:"creator_id"`
-	CreatorDiscountId   string            `json:"creator_discount_id"`
+	CreatorDiscountId   *string           `json:"creator_discount_id"`
+	DiscountSnapshot    *json.RawMessage  `json:"discount_snapshot,omitempty"`
 	IsReviewed          bool              `json:"is_reviewed"`
 	Photo               *[]*PhotoResponse `json:"photos"`
 }
@@ -213,3 +214,10 @@ type GetAllUsertTransaction struct {
 	Page   int    `json:"page" validate:"required"`
 	Size   int    `json:"size" validate:"required"`
 }
+
+type GetAllUserTransactionByCursor struct {
+	UserId string `json:"user_id" validate:"required"`
+	Order  string `json:"order" validate:"required"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=100"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
+)
+
+type PseudonymizeUserDataRequest struct {
+	UserId      string
+	PseudonymId string
+}
+
+// UserDataExportResponse is what the transaction service holds about a user for the data export built by user-svc
+type UserDataExportResponse struct {
+	Transactions []*UserDataExportTransaction
+	Reviews      []*CreatorReviewResponse
+}
+
+type UserDataExportTransaction struct {
+	Id         string
+	Status     enum.TransactionStatus
+	Amount     int32
+	PhotoIds   []string
+	CheckoutAt *time.Time
+	PaymentAt  *time.Time
+	CreatedAt  *time.Time
+}
@@ -8,9 +8,15 @@ type CreateWithdrawalRequest struct {
 	Amount       int    `json:"amount" validate:"required"`
 }
 
-type UpdateWithdrawalStatusRequest struct {
-	Id     string `json:"id" validate:"required"`
-	Status string `json:"status" validate:"required"`
+type ReviewWithdrawalRequest struct {
+	Id          string `json:"-" validate:"required"`
+	ReviewerId  string `json:"-" validate:"required"`
+	Approved    bool   `json:"-"`
+	Description string `json:"description" validate:"required_if=Approved false,max=255"`
+}
+
+type FindAllWithdrawalByStatusRequest struct {
+	Status string `query:"status" validate:"required,oneof=PENDING SUCCESS FAILED CANCELED EXPIRED"`
 }
 
 type FindWithdrawalById struct {
@@ -24,6 +30,8 @@ type WithdrawalResponse struct {
 	Amount       int        `json:"amount"`
 	Status       string     `json:"status"`
 	Description  string     `json:"description"`
+	ReviewedBy   *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
 	CreatedAt    *time.Time `json:"created_at"`
 	UpdatedAt    *time.Time `json:"updated_at"`
 }
@@ -33,7 +33,12 @@ func (r *bankRepository) Create(ctx context.Context, db Querier, bank *entity.Ba
 }
 
 func (r *bankRepository) Update(ctx context.Context, db Querier, bank *entity.Bank) (*entity.Bank, error) {
-	return nil, nil
+	query := "UPDATE banks SET bank_code = $1, name = $2, alias = $3, swift_code = $4, logo_url = $5, updated_at = $6 WHERE id = $7 RETURNING *"
+	if err := db.GetContext(ctx, bank, query, bank.BankCode, bank.Name, bank.Alias, bank.SwiftCode, bank.LogoUrl, bank.UpdatedAt, bank.Id); err != nil {
+		return nil, err
+	}
+
+	return bank, nil
 }
 
 func (r *bankRepository) FindById(ctx context.Context, db Querier, bankId string) (*entity.Bank, error) {
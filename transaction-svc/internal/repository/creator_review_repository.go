@@ -4,7 +4,9 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
@@ -13,7 +15,11 @@ import (
 type CreatorReviewRepository interface {
 	Create(ctx context.Context, tx Querier, review *entity.CreatorReview) (*entity.CreatorReview, error)
 	FindAll(ctx context.Context, tx Querier, page int, size int, rating int, creatorId, timeOrder string) ([]*entity.CreatorReview, *model.PageMetadata, error)
+	FindAllByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, rating int, creatorId, timeOrder string) ([]*entity.CreatorReview, *model.CursorMetadata, error)
 	CountTotalReviewAndRating(ctx context.Context, tx Querier, creatorId string) (*entity.TotalReviewAndRating, error)
+	CountByRating(ctx context.Context, tx Querier, creatorId string) ([]*entity.RatingCount, error)
+	PseudonymizeUser(ctx context.Context, tx Querier, userId, pseudonymId string) error
+	FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.CreatorReview, error)
 }
 type creatorReviewRepository struct{}
 
@@ -94,13 +100,64 @@ func (r *creatorReviewRepository) FindAll(ctx context.Context, tx Querier, page,
 	return results, pageMetadata, nil
 }
 
+// FindAllByCursor is the keyset paginated version of FindAll, reviews are sorted by created_at and id
+func (r *creatorReviewRepository) FindAllByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, rating int,
+	creatorId, timeOrder string) ([]*entity.CreatorReview, *model.CursorMetadata, error) {
+	order, comparator := "DESC", "<"
+	if strings.ToUpper(timeOrder) == "ASC" {
+		order, comparator = "ASC", ">"
+	}
+
+	query := `SELECT * FROM creator_reviews WHERE creator_id = $1`
+	args := []interface{}{creatorId}
+	argIndex := 2
+
+	if rating != 0 {
+		query += " AND rating = $" + strconv.Itoa(argIndex)
+		args = append(args, rating)
+		argIndex++
+	}
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) " + comparator + " ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	// One extra row is fetched to know whether there is a next page without counting
+	query += " ORDER BY created_at " + order + ", id " + order + " LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	results := make([]*entity.CreatorReview, 0)
+	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(results) > size
+	if hasMore {
+		results = results[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := results[len(results)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return results, cursorMetadata, nil
+}
+
 func (r *creatorReviewRepository) CountTotalReviewAndRating(ctx context.Context, tx Querier, creatorId string) (*entity.TotalReviewAndRating, error) {
 	totalReviewAndRating := new(entity.TotalReviewAndRating)
 
 	query := `
 	SELECT
 		COUNT(*) AS total_review, 
-		AVG(rating) AS average_rating
+		COALESCE(AVG(rating), 0) AS average_rating
 	FROM 
 		creator_reviews 
 	WHERE 
@@ -113,3 +170,45 @@ func (r *creatorReviewRepository) CountTotalReviewAndRating(ctx context.Context,
 
 	return totalReviewAndRating, nil
 }
+
+func (r *creatorReviewRepository) CountByRating(ctx context.Context, tx Querier, creatorId string) ([]*entity.RatingCount, error) {
+	ratingCounts := make([]*entity.RatingCount, 0)
+
+	query := `
+	SELECT
+		rating, 
+		COUNT(*) AS count
+	FROM 
+		creator_reviews 
+	WHERE 
+		creator_id = $1
+	GROUP BY 
+		rating
+	`
+
+	if err := tx.SelectContext(ctx, &ratingCounts, query, creatorId); err != nil {
+		return nil, err
+	}
+
+	return ratingCounts, nil
+}
+
+func (r *creatorReviewRepository) PseudonymizeUser(ctx context.Context, tx Querier, userId, pseudonymId string) error {
+	query := `UPDATE creator_reviews SET user_id = $1 WHERE user_id = $2`
+	_, err := tx.ExecContext(ctx, query, pseudonymId, userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *creatorReviewRepository) FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.CreatorReview, error) {
+	reviews := make([]*entity.CreatorReview, 0)
+	query := `SELECT * FROM creator_reviews WHERE user_id = $1 ORDER BY created_at DESC`
+	if err := tx.SelectContext(ctx, &reviews, query, userId); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
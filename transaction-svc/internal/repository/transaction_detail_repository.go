@@ -22,8 +22,8 @@ func NewTransactionDetailRepository() TransactionDetailRepository {
 }
 
 func (r *transactionDetailRepository) Create(ctx context.Context, tx Querier, trxId string, details []*entity.TransactionDetail) (*[]*entity.TransactionDetail, error) {
-	query := `INSERT INTO transaction_details (id, transaction_id, creator_id, subtotal_price, creator_discount_id, created_at, updated_at)
-	          VALUES (:id, :transaction_id, :creator_id, :subtotal_price, :creator_discount_id, :created_at, :updated_at)`
+	query := `INSERT INTO transaction_details (id, transaction_id, creator_id, subtotal_price, creator_discount_id, discount_snapshot, created_at, updated_at)
+	          VALUES (:id, :transaction_id, :creator_id, :subtotal_price, :creator_discount_id, :discount_snapshot, :created_at, :updated_at)`
 	for i := range details {
 		details[i].TransactionId = trxId
 	}
@@ -6,7 +6,9 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
@@ -21,8 +23,11 @@ type TransactionRepository interface {
 	UpdateCallback(ctx context.Context, tx Querier, transaction *entity.Transaction) error
 	UserFindWithDetailById(ctx context.Context, tx Querier, transactionId, userId string) (*[]*entity.TransactionWithDetail, error)
 	UserFindAll(ctx context.Context, tx Querier, page, size int, userId string, timeOrder string) (*[]*entity.Transaction, *model.PageMetadata, error)
+	UserFindAllByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, userId string, timeOrder string) (*[]*entity.Transaction, *model.CursorMetadata, error)
 	UpdateStatus(ctx context.Context, tx Querier, transaction *entity.Transaction) error
 	FindManyCheckable(ctx context.Context, tx Querier) (*[]*entity.Transaction, error)
+	PseudonymizeUser(ctx context.Context, tx Querier, userId, pseudonymId string) error
+	FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Transaction, error)
 }
 
 type transactionRepository struct {
@@ -34,11 +39,11 @@ func NewTransactionRepository() TransactionRepository {
 
 func (r *transactionRepository) Create(ctx context.Context, tx Querier, transaction *entity.Transaction) (*entity.Transaction, error) {
 	query := `INSERT INTO transactions 
-			  (id, user_id, internal_status, status, photo_ids, checkout_at, amount, created_at, updated_at) 
-			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+			  (id, user_id, internal_status, status, photo_ids, checkout_at, amount, price_quote_id, created_at, updated_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
 
 	_, err := tx.ExecContext(ctx, query, transaction.Id, transaction.UserId, transaction.InternalStatus, transaction.Status, transaction.PhotoIds, transaction.CheckoutAt,
-		transaction.Amount, transaction.CreatedAt, transaction.UpdatedAt)
+		transaction.Amount, transaction.PriceQuoteId, transaction.CreatedAt, transaction.UpdatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to insert transactions: %w", err)
 	}
@@ -127,6 +132,7 @@ func (r *transactionRepository) UserFindWithDetailById(ctx context.Context, tx Q
 		td.id AS transaction_detail_id,
 		td.creator_id,
 		td.creator_discount_id,
+		td.discount_snapshot,
 		td.is_reviewed,
 
 		ti.id AS transaction_item_id,
@@ -222,6 +228,68 @@ func (r *transactionRepository) UserFindAll(ctx context.Context, tx Querier, pag
 	return &results, pageMetadata, nil
 }
 
+// UserFindAllByCursor is the keyset paginated version of UserFindAll, transactions are sorted by created_at and id
+func (r *transactionRepository) UserFindAllByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, userId string,
+	timeOrder string) (*[]*entity.Transaction, *model.CursorMetadata, error) {
+	order, comparator := "DESC", "<"
+	if strings.ToUpper(timeOrder) == "ASC" {
+		order, comparator = "ASC", ">"
+	}
+
+	query := `
+	SELECT 
+		id,
+		user_id,
+		status,
+		transaction_method_id,
+		transaction_type_id,
+		payment_type_id,
+		payment_at,
+		checkout_at,
+		amount,
+		created_at,
+		updated_at
+	FROM 
+		transactions
+	WHERE
+		user_id = $1`
+
+	args := []interface{}{userId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) " + comparator + " ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	// One extra row is fetched to know whether there is a next page without counting
+	query += " ORDER BY created_at " + order + ", id " + order + " LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	results := make([]*entity.Transaction, 0)
+	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(results) > size
+	if hasMore {
+		results = results[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := results[len(results)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return &results, cursorMetadata, nil
+}
+
 func (r *transactionRepository) UpdateStatus(ctx context.Context, tx Querier, transaction *entity.Transaction) error {
 	query := `UPDATE transactions SET status = $1, internal_status = $2, snap_token = COALESCE($3, snap_token), updated_at = $4 WHERE id = $5`
 
@@ -266,3 +334,25 @@ func (r *transactionRepository) FindManyCheckable(ctx context.Context, tx Querie
 
 	return &transactions, nil
 }
+
+// PseudonymizeUser keeps the transactions of a deleted user for bookkeeping but detaches them from the account
+func (r *transactionRepository) PseudonymizeUser(ctx context.Context, tx Querier, userId, pseudonymId string) error {
+	query := `UPDATE transactions SET user_id = $1 WHERE user_id = $2`
+	_, err := tx.ExecContext(ctx, query, pseudonymId, userId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.Transaction, error) {
+	transactions := make([]*entity.Transaction, 0)
+	query := `SELECT id, user_id, status, internal_status, photo_ids, payment_at, checkout_at, amount, created_at, updated_at
+	FROM transactions WHERE user_id = $1 ORDER BY created_at DESC`
+	if err := tx.SelectContext(ctx, &transactions, query, userId); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
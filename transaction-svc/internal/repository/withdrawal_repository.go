@@ -10,8 +10,10 @@ type WithdrawalRepository interface {
 	Create(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) (*entity.Withdrawal, error)
 	Delete(ctx context.Context, db Querier, withdrawalsId string) error
 	FindAll(ctx context.Context, db Querier) (*[]*entity.Withdrawal, error)
+	FindAllByStatus(ctx context.Context, db Querier, status string) (*[]*entity.Withdrawal, error)
 	FindById(ctx context.Context, db Querier, bankId string) (*entity.Withdrawal, error)
 	UpdateWithdrawalStatus(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) (*entity.Withdrawal, error)
+	UpdateReview(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) (*entity.Withdrawal, error)
 }
 
 type withdrawalRepository struct {
@@ -40,6 +42,20 @@ func (r *withdrawalRepository) UpdateWithdrawalStatus(ctx context.Context, db Qu
 	return withdrawal, nil
 }
 
+// UpdateReview only moves withdrawals that are still pending, so two reviewers
+// racing on the same request can not both settle it. sql.ErrNoRows means the
+// withdrawal is missing or has already been reviewed.
+func (r *withdrawalRepository) UpdateReview(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) (*entity.Withdrawal, error) {
+	query := `UPDATE withdrawals SET status = $1, description = $2, reviewed_by = $3, reviewed_at = $4, updated_at = $5 
+	WHERE id = $6 AND status = 'PENDING' RETURNING *`
+	if err := db.GetContext(ctx, withdrawal, query, withdrawal.Status, withdrawal.Description, withdrawal.ReviewedBy, withdrawal.ReviewedAt,
+		withdrawal.UpdatedAt, withdrawal.Id); err != nil {
+		return nil, err
+	}
+
+	return withdrawal, nil
+}
+
 func (r *withdrawalRepository) FindById(ctx context.Context, db Querier, bankId string) (*entity.Withdrawal, error) {
 	bank := new(entity.Withdrawal)
 	query := "SELECT * FROM withdrawals WHERE id = $1"
@@ -60,6 +76,16 @@ func (r *withdrawalRepository) FindAll(ctx context.Context, db Querier) (*[]*ent
 	return &withdrawals, nil
 }
 
+func (r *withdrawalRepository) FindAllByStatus(ctx context.Context, db Querier, status string) (*[]*entity.Withdrawal, error) {
+	withdrawals := make([]*entity.Withdrawal, 0)
+	query := "SELECT * FROM withdrawals WHERE status = $1 ORDER BY created_at ASC"
+	if err := db.SelectContext(ctx, &withdrawals, query, status); err != nil {
+		return nil, err
+	}
+
+	return &withdrawals, nil
+}
+
 func (r *withdrawalRepository) Delete(ctx context.Context, db Querier, withdrawalsId string) error {
 	query := "DELETE FROM withdrawals WHERE id = $1"
 	_, err := db.ExecContext(ctx, query, withdrawalsId)
@@ -23,7 +23,7 @@ type BankUseCase interface {
 	Create(ctx context.Context, request *model.CreateBankRequest) (*model.BankResponse, error)
 	FindById(ctx context.Context, request *model.FindBankByIdRequest) (*model.BankResponse, error)
 	FindAll(ctx context.Context) (*[]*model.BankResponse, error)
-	Update(ctx context.Context) (*model.BankResponse, error)
+	Update(ctx context.Context, request *model.UpdateBankRequest) (*model.BankResponse, error)
 	Delete(ctx context.Context, request *model.DeleteBankRequest) error
 }
 
@@ -105,8 +105,53 @@ func (u *bankUseCase) FindAll(ctx context.Context) (*[]*model.BankResponse, erro
 	return converter.BanksToResponses(banks), nil
 }
 
-func (u *bankUseCase) Update(ctx context.Context) (*model.BankResponse, error) {
-	return nil, nil
+func (u *bankUseCase) Update(ctx context.Context, request *model.UpdateBankRequest) (*model.BankResponse, error) {
+	bank, err := u.bankRepository.FindById(ctx, u.db, request.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Invalid Bank ID")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find bank by id", err)
+	}
+
+	if bank.BankCode != request.BankCode {
+		existBank, err := u.bankRepository.FindByCode(ctx, u.db, request.BankCode)
+		if err == nil && existBank != nil {
+			return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Bank code already exists")
+		}
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to check existing bank code", err)
+		}
+	}
+
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	now := time.Now()
+	bank.BankCode = request.BankCode
+	bank.Name = request.Name
+	bank.Alias = nullable.ToSQLString(request.Alias)
+	bank.SwiftCode = nullable.ToSQLString(request.SwiftCode)
+	bank.LogoUrl = nullable.ToSQLString(request.LogoUrl)
+	bank.UpdatedAt = &now
+
+	bank, err = u.bankRepository.Update(ctx, tx, bank)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to update bank in database", err)
+	}
+
+	if err := repository.Commit(tx, u.logs); err != nil {
+		return nil, err
+	}
+
+	return converter.BankToResponse(bank), nil
 }
 
 func (u *bankUseCase) Delete(ctx context.Context, request *model.DeleteBankRequest) error {
@@ -7,23 +7,30 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
 	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
+	producer "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/gateway/messaging"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/event"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/repository"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/usecase/contract"
 	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
 )
 
 type cancelationUseCase struct {
-	db              *sqlx.DB
-	transactionRepo repository.TransactionRepository
-	logs            *logger.Log
+	db                  *sqlx.DB
+	transactionRepo     repository.TransactionRepository
+	transactionProducer producer.TransactionProducer
+	logs                *logger.Log
 }
 
-func NewCancelationUseCase(db *sqlx.DB, transactionRepo repository.TransactionRepository, logs *logger.Log) contract.CancelationUseCase {
-	return &cancelationUseCase{db: db, transactionRepo: transactionRepo, logs: logs}
+func NewCancelationUseCase(db *sqlx.DB, transactionRepo repository.TransactionRepository, transactionProducer producer.TransactionProducer,
+	logs *logger.Log) contract.CancelationUseCase {
+	return &cancelationUseCase{db: db, transactionRepo: transactionRepo, transactionProducer: transactionProducer, logs: logs}
 }
 
 func (u *cancelationUseCase) ExpirePendingTransaction(ctx context.Context, transactionId string) error {
@@ -35,13 +42,18 @@ func (u *cancelationUseCase) ExpirePendingTransaction(ctx context.Context, trans
 		repository.Rollback(err, tx, ctx, u.logs)
 	}()
 
-	if err := u.updateTransactionStatusIfPending(ctx, tx, transactionId, enum.TrxInternalStatusExpired); err != nil {
+	transaction, err := u.updateTransactionStatusIfPending(ctx, tx, transactionId, enum.TrxInternalStatusExpired)
+	if err != nil {
 		return helper.WrapInternalServerError(u.logs, "expire failed", err)
 	}
 
 	if err := repository.Commit(tx, u.logs); err != nil {
 		return err
 	}
+
+	if transaction != nil {
+		u.produceTransactionCanceledEvent(ctx, transaction)
+	}
 	u.logs.Log(fmt.Sprintf("success expire pending transaction with id : %s", transactionId))
 	return nil
 }
@@ -55,29 +67,35 @@ func (u *cancelationUseCase) CancelPendingTransaction(ctx context.Context, trans
 		repository.Rollback(err, tx, ctx, u.logs)
 	}()
 
-	if err := u.updateTransactionStatusIfPending(ctx, tx, transactionId, enum.TrxInternalStatusCancelledByUser); err != nil {
+	transaction, err := u.updateTransactionStatusIfPending(ctx, tx, transactionId, enum.TrxInternalStatusCancelledByUser)
+	if err != nil {
 		return helper.WrapInternalServerError(u.logs, "cancel failed", err)
 	}
 
 	if err := repository.Commit(tx, u.logs); err != nil {
 		return err
 	}
+
+	if transaction != nil {
+		u.produceTransactionCanceledEvent(ctx, transaction)
+	}
 	u.logs.Log(fmt.Sprintf("success cancel pending by user transaction with id : %s", transactionId))
 	return nil
 }
 
-func (u *cancelationUseCase) updateTransactionStatusIfPending(ctx context.Context, tx *sqlx.Tx, transactionId string, status enum.TrxInternalStatus) error {
+// updateTransactionStatusIfPending returns the updated transaction, or nil when the transaction is no longer pending
+func (u *cancelationUseCase) updateTransactionStatusIfPending(ctx context.Context, tx *sqlx.Tx, transactionId string, status enum.TrxInternalStatus) (*entity.Transaction, error) {
 	transaction, err := u.transactionRepo.FindById(ctx, tx, transactionId, true)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid transaction id")
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid transaction id")
 		}
-		return err
+		return nil, err
 	}
 
 	if transaction.InternalStatus != enum.TrxInternalStatusPending && transaction.InternalStatus != enum.TrxInternalStatusTokenReady {
 		u.logs.Log(fmt.Sprintf("transaction : %s is not in pending or token ready status, discontinued update transaction process status if pending", transactionId))
-		return nil
+		return nil, nil
 	}
 
 	now := time.Now()
@@ -91,5 +109,30 @@ func (u *cancelationUseCase) updateTransactionStatusIfPending(ctx context.Contex
 	transaction.SnapToken = sql.NullString{Valid: true, String: ""}
 	transaction.UpdatedAt = &now
 
-	return u.transactionRepo.UpdateStatus(ctx, tx, transaction)
+	if err := u.transactionRepo.UpdateStatus(ctx, tx, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// produceTransactionCanceledEvent releases the locked photos and the reserved voucher in photo service
+func (u *cancelationUseCase) produceTransactionCanceledEvent(ctx context.Context, transaction *entity.Transaction) {
+	var photoIds []string
+	if err := sonic.ConfigFastest.Unmarshal(transaction.PhotoIds, &photoIds); err != nil {
+		u.logs.Error(fmt.Sprintf("failed to unmarshal photo ids of transaction %s: %v", transaction.Id, err))
+		return
+	}
+
+	event := &event.CancelPhotosEvent{
+		EventID:       ulid.Make().String(),
+		UserId:        transaction.UserId,
+		TransactionId: transaction.Id,
+		PhotoIds:      photoIds,
+		Status:        string(transaction.Status),
+	}
+
+	if err := u.transactionProducer.ProduceTransactionCanceledEvent(ctx, event); err != nil {
+		u.logs.Error(fmt.Sprintf("failed to publish cancel photos event: %v", err))
+	}
 }
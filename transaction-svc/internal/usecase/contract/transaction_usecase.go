@@ -11,6 +11,7 @@ type TransactionUseCase interface {
 	CheckAndUpdateTransaction(ctx context.Context, request *model.CheckAndUpdateTransactionRequest) error
 	UserGetWithDetail(ctx context.Context, request *model.GetTransactionWithDetail) (*model.TransactionWithDetail, error)
 	GetAllUserTransaction(ctx context.Context, request *model.GetAllUsertTransaction) (*[]*model.UserTransaction, *model.PageMetadata, error)
+	GetAllUserTransactionByCursor(ctx context.Context, request *model.GetAllUserTransactionByCursor) (*[]*model.UserTransaction, *model.CursorMetadata, error)
 	CheckPaymentSignature(signatureKey, transcationId, statusCode, grossAmount string) (bool, string)
 	CreateTransactionV2(ctx context.Context, request *model.CreateTransactionV2Request) (*model.CreateTransactionResponse, error)
 }
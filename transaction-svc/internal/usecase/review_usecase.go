@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
 	producer "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/gateway/messaging"
@@ -24,6 +25,8 @@ import (
 type ReviewUseCase interface {
 	Create(ctx context.Context, request *model.CreateReviewRequest) (*model.CreatorReviewResponse, error)
 	CreatorGetReview(ctx context.Context, request *model.GetAllReviewRequest) (*[]*model.CreatorReviewResponse, *model.PageMetadata, error)
+	CreatorGetReviewByCursor(ctx context.Context, request *model.GetAllReviewByCursorRequest) (*[]*model.CreatorReviewResponse, *model.CursorMetadata, error)
+	GetReviewSummary(ctx context.Context, creatorId string) (*model.CreatorReviewSummaryResponse, error)
 }
 type reviewUseCase struct {
 	transactionDetailRepo repository.TransactionDetailRepository
@@ -101,6 +104,7 @@ func (u *reviewUseCase) Create(ctx context.Context, request *model.CreateReviewR
 	totalReviewAndRating.CreatorId = request.CreatorId
 
 	creatorReviewCountEvent := &event.CreatorReviewCountEvent{
+		EventID:     ulid.Make().String(),
 		Id:          request.CreatorId,
 		Rating:      totalReviewAndRating.Rating,
 		RatingCount: totalReviewAndRating.TotalReview,
@@ -110,7 +114,21 @@ func (u *reviewUseCase) Create(ctx context.Context, request *model.CreateReviewR
 		return nil, err
 	}
 
-	return converter.ReviewToResponse(review), err
+	reviewCreatedEvent := &event.CreatorReviewCreatedEvent{
+		EventID:   ulid.Make().String(),
+		ReviewId:  review.Id,
+		CreatorId: review.CreatorId,
+		UserId:    review.UserId,
+		Rating:    review.Rating,
+		Comment:   review.Comment.String,
+		CreatedAt: review.CreatedAt,
+	}
+
+	if err := u.transactionProducer.ProduceReviewCreatedEvent(ctx, reviewCreatedEvent); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to publish review created event", err)
+	}
+
+	return converter.ReviewToResponse(review), nil
 }
 
 func (u *reviewUseCase) CreatorGetReview(ctx context.Context, request *model.GetAllReviewRequest) (*[]*model.CreatorReviewResponse, *model.PageMetadata, error) {
@@ -121,3 +139,31 @@ func (u *reviewUseCase) CreatorGetReview(ctx context.Context, request *model.Get
 
 	return converter.ReviewsToResponses(&userPublicChat), pageMetadata, nil
 }
+
+func (u *reviewUseCase) CreatorGetReviewByCursor(ctx context.Context, request *model.GetAllReviewByCursorRequest) (*[]*model.CreatorReviewResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	reviews, cursorMetadata, err := u.creatorReviewRepo.FindAllByCursor(ctx, u.db, cursor, request.Size, request.Rating, request.CreatorId, request.Order)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find all creator review by cursor", err)
+	}
+
+	return converter.ReviewsToResponses(&reviews), cursorMetadata, nil
+}
+
+func (u *reviewUseCase) GetReviewSummary(ctx context.Context, creatorId string) (*model.CreatorReviewSummaryResponse, error) {
+	totalReviewAndRating, err := u.creatorReviewRepo.CountTotalReviewAndRating(ctx, u.db, creatorId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count total review and rating", err)
+	}
+
+	ratingCounts, err := u.creatorReviewRepo.CountByRating(ctx, u.db, creatorId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count creator review by rating", err)
+	}
+
+	return converter.ToReviewSummaryResponse(creatorId, totalReviewAndRating, ratingCounts), nil
+}
@@ -11,6 +11,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
@@ -126,12 +127,18 @@ func (u *transactionUseCase) CreateTransaction(ctx context.Context, request *mod
 			subtotal += it.FinalPrice
 		}
 
+		creatorDiscountId, discountSnapshot, err := buildDiscountSnapshot(list)
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to marshal discount snapshot", err)
+		}
+
 		detail := entity.TransactionDetail{
 			Id:                ulid.Make().String(),
 			TransactionId:     transaction.Id,
 			CreatorId:         creatorID,
 			SubTotalPrice:     subtotal,
-			CreatorDiscountId: list[0].DiscountId,
+			CreatorDiscountId: creatorDiscountId,
+			DiscountSnapshot:  discountSnapshot,
 			CreatedAt:         &now,
 			UpdatedAt:         &now,
 		}
@@ -465,8 +472,11 @@ func (u *transactionUseCase) CheckAndUpdateTransaction(ctx context.Context, requ
 		// }
 
 		event := &event.CancelPhotosEvent{
-			UserId:   transaction.UserId,
-			PhotoIds: photoIds,
+			EventID:       ulid.Make().String(),
+			UserId:        transaction.UserId,
+			TransactionId: transaction.Id,
+			PhotoIds:      photoIds,
+			Status:        string(transactionStatus),
 		}
 
 		if err := u.transactionProducer.ProduceTransactionCanceledEvent(ctx, event); err != nil {
@@ -488,9 +498,29 @@ func (u *transactionUseCase) CheckAndUpdateTransaction(ctx context.Context, requ
 	// 	return err
 	// }
 
+	// The settlement is already committed, the buyer still has to own the photos
+	// even when the per creator sales can not be attached to the event.
+	creatorSales := make([]*event.CreatorSaleEvent, 0)
+	details, err := u.transactionDetailRepository.FindManyByTrxID(ctx, u.db, transaction.Id)
+	if err != nil {
+		u.logs.Error(fmt.Sprintf("failed to find transaction details for settled event: %v", err))
+	} else {
+		for _, detail := range *details {
+			creatorSales = append(creatorSales, &event.CreatorSaleEvent{
+				CreatorId: detail.CreatorId,
+				Amount:    detail.SubTotalPrice,
+			})
+		}
+	}
+
 	event := &event.OwnerOwnPhotosEvent{
-		UserId:   transaction.UserId,
-		PhotoIds: photoIds,
+		EventID:       ulid.Make().String(),
+		UserId:        transaction.UserId,
+		TransactionId: transaction.Id,
+		PhotoIds:      photoIds,
+		Amount:        transaction.Amount,
+		CreatorSales:  creatorSales,
+		SettledAt:     &now,
 	}
 
 	if err := u.transactionProducer.ProduceTransactionSettledEvent(ctx, event); err != nil {
@@ -617,10 +647,26 @@ func (u *transactionUseCase) GetAllUserTransaction(ctx context.Context, request
 	return converter.UserTransactionToResponse(userTransactions), pageMetadata, nil
 }
 
+func (u *transactionUseCase) GetAllUserTransactionByCursor(ctx context.Context, request *model.GetAllUserTransactionByCursor) (*[]*model.UserTransaction, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeTimeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	userTransactions, cursorMetadata, err := u.transactionRepository.UserFindAllByCursor(ctx, u.db, cursor, request.Size, request.UserId, request.Order)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find all user transaction by cursor", err)
+	}
+
+	return converter.UserTransactionToResponse(userTransactions), cursorMetadata, nil
+}
+
 func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *model.CreateTransactionV2Request) (*model.CreateTransactionResponse, error) {
 	var outerErr error
 
-	items, total, errAdapter := u.photoAdapter.CalculatePhotoPriceV2(ctx, request.UserId, request.CreatorId, request)
+	// Transaction id is generated up front so photo service can reserve the voucher redemption against it
+	transactionID := uuid.NewString()
+	items, total, errAdapter := u.photoAdapter.CalculatePhotoPriceV2(ctx, request.UserId, request.CreatorId, transactionID, request)
 	if errAdapter != nil {
 		return nil, errAdapter
 	}
@@ -660,21 +706,34 @@ func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *m
 	}
 
 	transaction := &entity.Transaction{
-		Id:             uuid.NewString(),
+		Id:             transactionID,
 		UserId:         request.UserId,
 		InternalStatus: enum.TrxInternalStatusPending,
 		Status:         enum.TransactionStatusPending,
 		PhotoIds:       photoIDsByte,
 		Amount:         total.Price,
+		PriceQuoteId:   sql.NullString{String: total.QuoteId, Valid: total.QuoteId != ""},
 		CheckoutAt:     &now,
 		CreatedAt:      &now,
 		UpdatedAt:      &now,
 	}
 
 	for creatorID, list := range grouped {
+		// Subtotal is the creator payout, a platform funded voucher is paid by the platform not the creator
 		var subtotal int32 = 0
+		var paidByBuyer int32 = 0
 		for _, it := range list {
 			subtotal += it.FinalPrice
+			paidByBuyer += it.FinalPrice
+			if it.VoucherFundedBy == enum.VoucherFunderPlatform {
+				subtotal += it.VoucherDiscount
+			}
+		}
+
+		creatorDiscountId, discountSnapshot, err := buildDiscountSnapshot(list)
+		if err != nil {
+			outerErr = err
+			return nil, helper.WrapInternalServerError(u.logs, "failed to marshal discount snapshot", err)
 		}
 
 		detail := entity.TransactionDetail{
@@ -682,13 +741,14 @@ func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *m
 			TransactionId:     transaction.Id,
 			CreatorId:         creatorID,
 			SubTotalPrice:     subtotal,
-			CreatorDiscountId: list[0].DiscountId,
+			CreatorDiscountId: creatorDiscountId,
+			DiscountSnapshot:  discountSnapshot,
 			CreatedAt:         &now,
 			UpdatedAt:         &now,
 		}
 
 		details = append(details, &detail)
-		totalAmount += subtotal
+		totalAmount += paidByBuyer
 
 		for _, it := range list {
 			item := entity.TransactionItem{
@@ -696,7 +756,7 @@ func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *m
 				TransactionDetailId: detail.Id,
 				PhotoId:             it.PhotoId,
 				Price:               it.Price,
-				Discount:            sql.NullInt32{Int32: it.Discount, Valid: it.Discount != 0},
+				Discount:            sql.NullInt32{Int32: it.Discount + it.VoucherDiscount, Valid: it.Discount+it.VoucherDiscount != 0},
 				FinalPrice:          it.FinalPrice,
 				CreatedAt:           &now,
 				UpdatedAt:           &now,
@@ -741,8 +801,9 @@ func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *m
 	defer func() {
 		if outerErr != nil {
 			event := &event.CancelPhotosEvent{
-				UserId:   request.UserId,
-				PhotoIds: photoIDs,
+				UserId:        request.UserId,
+				TransactionId: transactionID,
+				PhotoIds:      photoIDs,
 			}
 			if err := u.transactionProducer.ProduceTransactionCanceledEvent(ctx, event); err != nil {
 				u.logs.Error(fmt.Sprintf("failed to publish cancel photos event: %v", err))
@@ -767,3 +828,50 @@ func (u *transactionUseCase) CreateTransactionV2(ctx context.Context, request *m
 
 	return converter.TransactionToResponse(transaction, redirectUrl), nil
 }
+
+// buildDiscountSnapshot keeps the discount terms applied to one creator's items, so the transaction
+// does not depend on the creator discount or voucher staying unchanged
+func buildDiscountSnapshot(items []*model.CheckoutItem) (sql.NullString, *json.RawMessage, error) {
+	snapshot := new(model.DiscountSnapshot)
+	for _, item := range items {
+		if item.DiscountId != "" && item.Discount != 0 {
+			if snapshot.CreatorDiscount == nil {
+				snapshot.CreatorDiscount = &model.CreatorDiscountSnapshot{
+					Id:          item.DiscountId,
+					Type:        item.DiscountType,
+					Value:       item.DiscountValue,
+					MinQuantity: item.DiscountMinQuantity,
+				}
+			}
+			snapshot.CreatorDiscount.Amount += item.Discount
+		}
+
+		if item.VoucherId != "" && item.VoucherDiscount != 0 {
+			if snapshot.Voucher == nil {
+				snapshot.Voucher = &model.VoucherSnapshot{
+					Id:       item.VoucherId,
+					Code:     item.VoucherCode,
+					FundedBy: item.VoucherFundedBy,
+				}
+			}
+			snapshot.Voucher.Amount += item.VoucherDiscount
+		}
+	}
+
+	if snapshot.CreatorDiscount == nil && snapshot.Voucher == nil {
+		return sql.NullString{}, nil, nil
+	}
+
+	snapshotByte, err := sonic.ConfigFastest.Marshal(snapshot)
+	if err != nil {
+		return sql.NullString{}, nil, err
+	}
+
+	rawSnapshot := json.RawMessage(snapshotByte)
+	var creatorDiscountId sql.NullString
+	if snapshot.CreatorDiscount != nil {
+		creatorDiscountId = sql.NullString{String: snapshot.CreatorDiscount.Id, Valid: true}
+	}
+
+	return creatorDiscountId, &rawSnapshot, nil
+}
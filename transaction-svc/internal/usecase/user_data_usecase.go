@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type UserDataUseCase interface {
+	PseudonymizeUserData(ctx context.Context, request *model.PseudonymizeUserDataRequest) error
+	GetUserDataExport(ctx context.Context, userId string) (*model.UserDataExportResponse, error)
+}
+
+type userDataUseCase struct {
+	db                *sqlx.DB
+	transactionRepo   repository.TransactionRepository
+	creatorReviewRepo repository.CreatorReviewRepository
+	logs              *logger.Log
+}
+
+func NewUserDataUseCase(db *sqlx.DB, transactionRepo repository.TransactionRepository, creatorReviewRepo repository.CreatorReviewRepository,
+	logs *logger.Log) UserDataUseCase {
+	return &userDataUseCase{db: db, transactionRepo: transactionRepo, creatorReviewRepo: creatorReviewRepo, logs: logs}
+}
+
+// PseudonymizeUserData replaces a deleted user's id on financial records with the pseudonym shared
+// with the other services, the records themselves are kept.
+func (u *userDataUseCase) PseudonymizeUserData(ctx context.Context, request *model.PseudonymizeUserDataRequest) error {
+	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		repository.Rollback(err, tx, ctx, u.logs)
+	}()
+
+	if err = u.transactionRepo.PseudonymizeUser(ctx, tx, request.UserId, request.PseudonymId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to pseudonymize user transactions", err)
+	}
+
+	if err = u.creatorReviewRepo.PseudonymizeUser(ctx, tx, request.UserId, request.PseudonymId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to pseudonymize user reviews", err)
+	}
+
+	if err = repository.Commit(tx, u.logs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetUserDataExport collects the transactions and reviews of a user for the data export built by user-svc
+func (u *userDataUseCase) GetUserDataExport(ctx context.Context, userId string) (*model.UserDataExportResponse, error) {
+	if userId == "" {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User id is required")
+	}
+
+	transactions, err := u.transactionRepo.FindAllByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user transactions", err)
+	}
+
+	exportTransactions := make([]*model.UserDataExportTransaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		var photoIds []string
+		if len(transaction.PhotoIds) > 0 {
+			if err := sonic.ConfigFastest.Unmarshal(transaction.PhotoIds, &photoIds); err != nil {
+				return nil, helper.WrapInternalServerError(u.logs, fmt.Sprintf("failed to unmarshal photo ids of transaction %s", transaction.Id), err)
+			}
+		}
+		exportTransactions = append(exportTransactions, converter.TransactionToUserDataExport(transaction, photoIds))
+	}
+
+	reviews, err := u.creatorReviewRepo.FindAllByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user reviews", err)
+	}
+
+	return &model.UserDataExportResponse{
+		Transactions: exportTransactions,
+		Reviews:      *converter.ReviewsToResponses(&reviews),
+	}, nil
+}
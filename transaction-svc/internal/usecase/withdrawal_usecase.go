@@ -9,10 +9,12 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum"
 	errorcode "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/enum/error"
+	producer "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/gateway/messaging"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/event"
 	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/repository"
 
 	"github.com/jmoiron/sqlx"
@@ -23,22 +25,25 @@ type WithdrawalUseCase interface {
 	Create(ctx context.Context, request *model.CreateWithdrawalRequest) (*model.WithdrawalResponse, error)
 	FindAll(ctx context.Context) (*[]*model.WithdrawalResponse, error)
 	FindById(ctx context.Context, request *model.FindWithdrawalById) (*model.WithdrawalResponse, error)
-	Update(ctx context.Context, request *model.UpdateWithdrawalStatusRequest) (*model.WithdrawalResponse, error)
+	FindAllByStatus(ctx context.Context, request *model.FindAllWithdrawalByStatusRequest) (*[]*model.WithdrawalResponse, error)
+	Review(ctx context.Context, request *model.ReviewWithdrawalRequest) (*model.WithdrawalResponse, error)
 }
 
 type withdrawalUseCase struct {
 	db                   *sqlx.DB
 	withdrawalRepository repository.WithdrawalRepository
 	walletRepository     repository.WalletRepository
+	transactionProducer  producer.TransactionProducer
 	logs                 *logger.Log
 }
 
 func NewWithdrawalUseCase(db *sqlx.DB, withdrawalRepository repository.WithdrawalRepository, walletRepository repository.WalletRepository,
-	logs *logger.Log) WithdrawalUseCase {
+	transactionProducer producer.TransactionProducer, logs *logger.Log) WithdrawalUseCase {
 	return &withdrawalUseCase{
 		db:                   db,
 		withdrawalRepository: withdrawalRepository,
 		walletRepository:     walletRepository,
+		transactionProducer:  transactionProducer,
 		logs:                 logs,
 	}
 }
@@ -90,6 +95,10 @@ func (u *withdrawalUseCase) Create(ctx context.Context, request *model.CreateWit
 		return nil, err
 	}
 
+	if err := u.publishWithdrawalStatus(ctx, withdrawal, wallet.CreatorId); err != nil {
+		return nil, err
+	}
+
 	return converter.WithdrawalToResponse(withdrawal), nil
 }
 
@@ -117,8 +126,19 @@ func (u *withdrawalUseCase) FindById(ctx context.Context, request *model.FindWit
 	return converter.WithdrawalToResponse(withdrawal), nil
 }
 
-// TODO - status
-func (u *withdrawalUseCase) Update(ctx context.Context, request *model.UpdateWithdrawalStatusRequest) (*model.WithdrawalResponse, error) {
+func (u *withdrawalUseCase) FindAllByStatus(ctx context.Context, request *model.FindAllWithdrawalByStatusRequest) (*[]*model.WithdrawalResponse, error) {
+	withdrawals, err := u.withdrawalRepository.FindAllByStatus(ctx, u.db, request.Status)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find all withdrawal by status", err)
+	}
+
+	return converter.WithdrawalsToResponses(withdrawals), nil
+}
+
+// Review settles a pending withdrawal. The balance was already taken from the
+// wallet when the creator requested it, so an approval only records the
+// payout while a rejection gives the amount back.
+func (u *withdrawalUseCase) Review(ctx context.Context, request *model.ReviewWithdrawalRequest) (*model.WithdrawalResponse, error) {
 	tx, err := repository.BeginTxx(u.db, ctx, u.logs)
 	if err != nil {
 		return nil, err
@@ -128,21 +148,76 @@ func (u *withdrawalUseCase) Update(ctx context.Context, request *model.UpdateWit
 		repository.Rollback(err, tx, ctx, u.logs)
 	}()
 
-	updateWitdrawal := &entity.Withdrawal{
-		Id:     request.Id,
-		Status: request.Status,
+	now := time.Now()
+	withdrawal := &entity.Withdrawal{
+		Id:          request.Id,
+		Status:      enum.WithdrawalStatusSuccess,
+		Description: request.Description,
+		ReviewedBy:  sql.NullString{String: request.ReviewerId, Valid: true},
+		ReviewedAt:  &now,
+		UpdatedAt:   &now,
+	}
+
+	if !request.Approved {
+		withdrawal.Status = enum.WithdrawalStatusFailed
 	}
 
-	updateWitdrawal, err = u.withdrawalRepository.UpdateWithdrawalStatus(ctx, tx, updateWitdrawal)
+	withdrawal, err = u.withdrawalRepository.UpdateReview(ctx, tx, withdrawal)
 	if err != nil {
-		return nil, helper.WrapInternalServerError(u.logs, "failed to find update withdrawal status id", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := u.withdrawalRepository.FindById(ctx, tx, request.Id); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Withdrawal not found")
+				}
+				return nil, helper.WrapInternalServerError(u.logs, "failed to find withdrawal by id", err)
+			}
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Withdrawal has already been reviewed")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to update withdrawal review", err)
+	}
+
+	if !request.Approved {
+		if err := u.walletRepository.AddBalance(ctx, tx, withdrawal.WalletId, int64(withdrawal.Amount)); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to refund rejected withdrawal to wallet", err)
+		}
+	}
+
+	wallet, err := u.walletRepository.FindById(ctx, tx, withdrawal.WalletId, false)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find withdrawal wallet by id", err)
 	}
 
 	if err := repository.Commit(tx, u.logs); err != nil {
 		return nil, err
 	}
 
-	return converter.WithdrawalToResponse(updateWitdrawal), nil
+	if err := u.publishWithdrawalStatus(ctx, withdrawal, wallet.CreatorId); err != nil {
+		return nil, err
+	}
+
+	return converter.WithdrawalToResponse(withdrawal), nil
+}
+
+// publishWithdrawalStatus tells the creator about the new withdrawal status. The
+// withdrawal is already committed, the producer retries the publish and only a
+// publish that still fails is reported to the reviewer.
+func (u *withdrawalUseCase) publishWithdrawalStatus(ctx context.Context, withdrawal *entity.Withdrawal, creatorId string) error {
+	withdrawalStatusEvent := &event.WithdrawalStatusEvent{
+		EventID:      ulid.Make().String(),
+		WithdrawalId: withdrawal.Id,
+		WalletId:     withdrawal.WalletId,
+		CreatorId:    creatorId,
+		Amount:       withdrawal.Amount,
+		Status:       withdrawal.Status,
+		Description:  withdrawal.Description,
+		UpdatedAt:    withdrawal.UpdatedAt,
+	}
+
+	if err := u.transactionProducer.ProduceWithdrawalStatusEvent(ctx, withdrawalStatusEvent); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to publish withdrawal status event", err)
+	}
+
+	return nil
 }
 
 //cancel
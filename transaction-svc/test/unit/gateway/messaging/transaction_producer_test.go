@@ -0,0 +1,85 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	producer "github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/gateway/messaging"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBrokerDown = errors.New("nats: no responders available for request")
+
+// fakeMessagingAdapter fails the first `failures` publishes and records every subject it was asked to publish to
+type fakeMessagingAdapter struct {
+	failures int
+	subjects []string
+}
+
+func (f *fakeMessagingAdapter) Publish(ctx context.Context, subject string, data any) error {
+	f.subjects = append(f.subjects, subject)
+	if len(f.subjects) <= f.failures {
+		return errBrokerDown
+	}
+	return nil
+}
+
+func newTransactionProducer(failures int) (producer.TransactionProducer, *fakeMessagingAdapter) {
+	messagingAdapter := &fakeMessagingAdapter{failures: failures}
+	return producer.NewTransactionProducer(nil, messagingAdapter, logger.New("test")), messagingAdapter
+}
+
+func TestProduceReviewCreatedEvent(t *testing.T) {
+	ctx := context.Background()
+	reviewCreatedEvent := &event.CreatorReviewCreatedEvent{ReviewId: "review-1", CreatorId: "creator-1"}
+
+	t.Run("Short broker outage is retried", func(t *testing.T) {
+		transactionProducer, messagingAdapter := newTransactionProducer(2)
+
+		require.NoError(t, transactionProducer.ProduceReviewCreatedEvent(ctx, reviewCreatedEvent))
+		assert.Equal(t, []string{"review.created", "review.created", "review.created"}, messagingAdapter.subjects)
+	})
+
+	t.Run("Publish that keeps failing is returned", func(t *testing.T) {
+		transactionProducer, messagingAdapter := newTransactionProducer(3)
+
+		err := transactionProducer.ProduceReviewCreatedEvent(ctx, reviewCreatedEvent)
+		assert.ErrorIs(t, err, errBrokerDown)
+		assert.Len(t, messagingAdapter.subjects, 3)
+	})
+
+	t.Run("Canceled request stops retrying", func(t *testing.T) {
+		transactionProducer, messagingAdapter := newTransactionProducer(3)
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := transactionProducer.ProduceReviewCreatedEvent(canceledCtx, reviewCreatedEvent)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, errBrokerDown)
+		assert.Len(t, messagingAdapter.subjects, 1)
+	})
+}
+
+func TestProduceWithdrawalStatusEvent(t *testing.T) {
+	ctx := context.Background()
+	withdrawalStatusEvent := &event.WithdrawalStatusEvent{WithdrawalId: "withdrawal-1", CreatorId: "creator-1"}
+
+	t.Run("Short broker outage is retried", func(t *testing.T) {
+		transactionProducer, messagingAdapter := newTransactionProducer(1)
+
+		require.NoError(t, transactionProducer.ProduceWithdrawalStatusEvent(ctx, withdrawalStatusEvent))
+		assert.Equal(t, []string{"withdrawal.status.updated", "withdrawal.status.updated"}, messagingAdapter.subjects)
+	})
+
+	t.Run("Publish that keeps failing is returned", func(t *testing.T) {
+		transactionProducer, messagingAdapter := newTransactionProducer(3)
+
+		err := transactionProducer.ProduceWithdrawalStatusEvent(ctx, withdrawalStatusEvent)
+		assert.ErrorIs(t, err, errBrokerDown)
+		assert.Len(t, messagingAdapter.subjects, 3)
+	})
+}
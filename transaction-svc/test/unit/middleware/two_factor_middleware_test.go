@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/transaction-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTwoFactorApp(auth *model.AuthResponse) *fiber.App {
+	app := fiber.New()
+	app.Use(func(ctx *fiber.Ctx) error {
+		ctx.Locals("auth", auth)
+		return ctx.Next()
+	})
+	app.Post("/withdrawal/create", middleware.NewRequireFreshTwoFactor(5*time.Minute), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusOK)
+	})
+	return app
+}
+
+func verifiedAgo(age time.Duration) *time.Time {
+	verifiedAt := time.Now().Add(-age)
+	return &verifiedAt
+}
+
+func TestRequireFreshTwoFactor(t *testing.T) {
+	tests := []struct {
+		name   string
+		auth   *model.AuthResponse
+		status int
+	}{
+		{
+			name:   "Fresh step-up is allowed",
+			auth:   &model.AuthResponse{UserId: "user-1", TwoFactorEnabled: true, TwoFactorVerifiedAt: verifiedAgo(time.Minute)},
+			status: http.StatusOK,
+		},
+		{
+			name:   "Stale step-up is forbidden",
+			auth:   &model.AuthResponse{UserId: "user-1", TwoFactorEnabled: true, TwoFactorVerifiedAt: verifiedAgo(10 * time.Minute)},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Missing step-up is forbidden",
+			auth:   &model.AuthResponse{UserId: "user-1", TwoFactorEnabled: true},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "User without 2FA is forbidden",
+			auth:   &model.AuthResponse{UserId: "user-1"},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "Step-up reported without 2FA is forbidden",
+			auth:   &model.AuthResponse{UserId: "user-1", TwoFactorVerifiedAt: verifiedAgo(time.Minute)},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTwoFactorApp(tt.auth).Test(httptest.NewRequest(http.MethodPost, "/withdrawal/create", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+		})
+	}
+}
@@ -117,6 +117,9 @@ func webServer(ctx context.Context) error {
 	facecamUsecase := usecase.NewFacecamUseCase(storageAdapter, compressAdapter, uploadProducer, logs)
 	facecamController := http.NewFacecamController(facecamUsecase, logs)
 
+	chatImageUseCase := usecase.NewChatImageUseCase(storageAdapter, logs)
+	chatImageController := http.NewChatImageController(chatImageUseCase, logs)
+
 	go func() {
 		// gRPC server + reflection
 		grpcServer = grpc.NewServer()
@@ -143,7 +146,7 @@ func webServer(ctx context.Context) error {
 		cors.ConfigDefault,
 	))
 
-	routeConfig := route.NewRouteConfig(app, photoController, facecamController, userMiddleware, creatorMiddleware)
+	routeConfig := route.NewRouteConfig(app, photoController, facecamController, chatImageController, userMiddleware, creatorMiddleware)
 	routeConfig.Setup()
 	serverErrors := make(chan error, 1)
 	go func() {
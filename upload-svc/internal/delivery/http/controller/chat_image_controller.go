@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChatImageController interface {
+	UploadChatImage(ctx *fiber.Ctx) error
+}
+
+type chatImageController struct {
+	chatImageUseCase usecase.ChatImageUseCase
+	logs             logger.Log
+}
+
+func NewChatImageController(chatImageUseCase usecase.ChatImageUseCase, logs logger.Log) ChatImageController {
+	return &chatImageController{
+		chatImageUseCase: chatImageUseCase,
+		logs:             logs,
+	}
+}
+
+func (c *chatImageController) UploadChatImage(ctx *fiber.Ctx) error {
+	file, err := ctx.FormFile("image")
+	if err != nil {
+		return helper.ErrFormParserResponseJSON(ctx, "Make sure you have provided image file", err, c.logs)
+	}
+
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.chatImageUseCase.UploadChatImage(ctx.UserContext(), file, auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Upload chat image : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
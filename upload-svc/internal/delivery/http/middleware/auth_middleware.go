@@ -32,6 +32,8 @@ func NewUserAuth(userAdapter adapter.UserAdapter, logs logger.Log) fiber.Handler
 			Similarity:  authResponse.GetUser().GetSimilarity(),
 			CreatorId:   authResponse.GetUser().GetCreatorId(),
 			WalletId:    authResponse.GetUser().GetWalletId(),
+			Roles:       authResponse.GetUser().GetRoles(),
+			Permissions: authResponse.GetUser().GetPermissions(),
 		}
 
 		ctx.Locals("auth", auth)
@@ -0,0 +1,11 @@
+package route
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/config"
+)
+
+// SetupChatImageRoute is open to every user, it is set up before the creator only groups sharing the same prefix so
+// their creator middleware does not run for it
+func (r *routeConfig) SetupChatImageRoute() {
+	r.app.Post(config.EndpointPrefix+"/chat/image", r.authMiddleware, r.chatImageController.UploadChatImage)
+}
@@ -2,10 +2,12 @@ package route
 
 import (
 	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/config"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/enum"
 )
 
 func (r *routeConfig) SetupPhotoRoute() {
 	api := r.app.Group(config.EndpointPrefix, r.authMiddleware, r.creatorMiddleware)
-	api.Post("/single", r.photoController.UploadPhoto)
-	api.Post("/bulk", r.photoController.BulkUploadPhoto)
+	api.Post("/single", middleware.NewRequirePermission(enum.PermissionPhotoUpload), r.photoController.UploadPhoto)
+	api.Post("/bulk", middleware.NewRequirePermission(enum.PermissionPhotoUpload), r.photoController.BulkUploadPhoto)
 }
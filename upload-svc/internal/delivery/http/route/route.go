@@ -11,29 +11,33 @@ type RouteConfig interface {
 }
 
 type routeConfig struct {
-	app               *fiber.App
-	photoController   http.PhotoController
-	facecamController http.FacecamController
-	authMiddleware    fiber.Handler
-	creatorMiddleware fiber.Handler
+	app                 *fiber.App
+	photoController     http.PhotoController
+	facecamController   http.FacecamController
+	chatImageController http.ChatImageController
+	authMiddleware      fiber.Handler
+	creatorMiddleware   fiber.Handler
 }
 
 func NewRouteConfig(app *fiber.App,
 	photoController http.PhotoController,
 	facecamController http.FacecamController,
+	chatImageController http.ChatImageController,
 	authMiddleware fiber.Handler,
 	creatorMiddleware fiber.Handler,
 ) RouteConfig {
 	return &routeConfig{
-		app:               app,
-		photoController:   photoController,
-		facecamController: facecamController,
-		authMiddleware:    authMiddleware,
-		creatorMiddleware: creatorMiddleware,
+		app:                 app,
+		photoController:     photoController,
+		facecamController:   facecamController,
+		chatImageController: chatImageController,
+		authMiddleware:      authMiddleware,
+		creatorMiddleware:   creatorMiddleware,
 	}
 }
 
 func (r *routeConfig) Setup() {
+	r.SetupChatImageRoute()
 	r.SetupPhotoRoute()
 	r.SetupFacecamController()
 }
@@ -0,0 +1,8 @@
+package model
+
+// ChatImageResponse file key is sent back to user-svc with the chat message, the image is only served to room members
+type ChatImageResponse struct {
+	FileKey  string `json:"file_key"`
+	Mimetype string `json:"mimetype"`
+	Size     int64  `json:"size"`
+}
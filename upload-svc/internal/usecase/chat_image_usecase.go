@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/adapter"
+	errorcode "github.com/hervibest/be-yourmoments-backup/upload-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/upload-svc/internal/model"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const maxChatImageSize = 5 * 1024 * 1024
+
+// chatImageTypes is checked against the sniffed content, the content type header of the part is not trusted
+var chatImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+type ChatImageUseCase interface {
+	UploadChatImage(ctx context.Context, file *multipart.FileHeader, userId string) (*model.ChatImageResponse, error)
+}
+
+type chatImageUseCase struct {
+	storageAdapter adapter.StorageAdapter
+	logs           logger.Log
+}
+
+func NewChatImageUseCase(storageAdapter adapter.StorageAdapter, logs logger.Log) ChatImageUseCase {
+	return &chatImageUseCase{
+		storageAdapter: storageAdapter,
+		logs:           logs,
+	}
+}
+
+// UploadChatImage stores the image under the folder of the uploader, user-svc only accepts chat images from the
+// folder of the sender
+func (u *chatImageUseCase) UploadChatImage(ctx context.Context, file *multipart.FileHeader, userId string) (*model.ChatImageResponse, error) {
+	if file.Size > maxChatImageSize {
+		return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Image size exceeds the 5MB limit")
+	}
+
+	srcFile, err := file.Open()
+	if err != nil {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Cannot open uploaded file")
+	}
+	defer srcFile.Close()
+
+	sniffBuf := make([]byte, 512)
+	n, err := io.ReadFull(srcFile, sniffBuf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, helper.NewUseCaseWithInternalError(errorcode.ErrInvalidArgument, "Failed to read image data", err)
+	}
+
+	mimetype := http.DetectContentType(sniffBuf[:n])
+	if !chatImageTypes[mimetype] {
+		return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Only jpeg, png and webp images are allowed")
+	}
+	file.Header.Set("Content-Type", mimetype)
+
+	imageId := ulid.Make().String()
+	uploadPath := fmt.Sprintf("chat/%s", userId)
+	stream := io.MultiReader(bytes.NewReader(sniffBuf[:n]), srcFile)
+	uploaded, err := u.storageAdapter.UploadOriginalFileWithoutMultipart(ctx, file, stream, uploadPath, imageId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to upload chat image", err)
+	}
+
+	return &model.ChatImageResponse{
+		FileKey:  uploaded.FileKey,
+		Mimetype: mimetype,
+		Size:     file.Size,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/config"
 	consumer "github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/delivery/photo"
+	transactionconsumer "github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/delivery/transaction"
 	grpcHandler "github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/grpc"
 	http "github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/controller"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/gateway/producer"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/route"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/websocket"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/discovery"
 
@@ -93,19 +95,35 @@ func webServer(ctx context.Context) error {
 	go startHealthCheckLoop(ctx, registry, HTTPserviceID, serverConfig.Name+"-http")
 
 	cacheAdapter := adapter.NewCacheAdapter(redisConfig)
+	rateLimiterAdapter := adapter.NewRateLimiterAdapter(redisConfig)
 	emailAdapter := adapter.NewEmailAdapter()
-	googleTokenAdapter := adapter.NewGoogleTokenAdapter()
+	identityProviderAdapter := adapter.NewIdentityProviderAdapter()
 	jwtAdapter := adapter.NewJWTAdapter()
 	securityAdapter := adapter.NewSecurityAdapter()
 	uploadAdapter := adapter.NewUploadAdapter(minioConfig, redisConfig)
-	realtimeChatAdapter := adapter.NewRealtimeChatAdapter(ctx, firebaseConfig, logs)
+	realtimeChatAdapter := adapter.NewRealtimeChatAdapter(ctx, firebaseConfig, redisConfig, logs)
+	smsAdapter := adapter.NewSmsAdapter(logs)
+	totpAdapter := adapter.NewTOTPAdapter()
 	authClientAdapter := adapter.NewAuthClientAdapter(firebaseConfig)
 	cloudMessagingAdapter := adapter.NewCloudMessagingAdapter(firebaseConfig)
 	perspectiveAdapter := adapter.NewPerspectiveAdapter()
-	// photoAdapter, _ := adapter.NewPhotoAdapter(ctx, registry, logs)
-	// transactionAdapter, _ := adapter.NewTransactionAdapter(ctx, registry, logs)
+	moderationAdapter := adapter.NewModerationAdapter(perspectiveAdapter, logs)
 	messagingAdapter := adapter.NewMessagingAdapter(jetStreamConfig)
+	notificationAdapter, err := adapter.NewNotificationAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+	photoAdapter, err := adapter.NewPhotoAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+	transactionAdapter, err := adapter.NewTransactionAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
 	config.InitPhotoStream(jetStreamConfig, logs)
+	config.InitUserDeletionStream(jetStreamConfig, logs)
+	config.InitUserProfileStream(jetStreamConfig, logs)
 
 	userProducer := producer.NewUserProducer(messagingAdapter, logs)
 	databaseAdapter := repository.NewDatabaseAdapter(dbConfig)
@@ -134,21 +152,50 @@ func webServer(ctx context.Context) error {
 		log.Fatalf(err.Error())
 	}
 
-	userDeviceRepository := repository.NewUserDeviceRepository()
+	socialMediaRepository, err := repository.NewSocialMediaRepository(dbConfig)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	userSessionRepository := repository.NewUserSessionRepository()
+	userRecoveryCodeRepository := repository.NewUserRecoveryCodeRepository()
+	userDataExportRepository := repository.NewUserDataExportRepository()
+	userIdentityRepository := repository.NewUserIdentityRepository()
+	userSocialLinkRepository := repository.NewUserSocialLinkRepository()
+	chatRoomRepository := repository.NewChatRoomRepository()
+	chatMessageRepository := repository.NewChatMessageRepository()
+	userBlockRepository := repository.NewUserBlockRepository()
+	chatReportRepository := repository.NewChatReportRepository()
 
 	authUseCase := usecase.NewAuthUseCase(databaseAdapter, userRepository, userProfileRepository, emailVerificationRepository, resetPasswordRepository,
-		userDeviceRepository, googleTokenAdapter, emailAdapter, jwtAdapter, securityAdapter, cacheAdapter, realtimeChatAdapter,
-		userProducer, logs)
-	userUseCase := usecase.NewUserUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository, uploadAdapter, cacheAdapter, logs)
-	chatUseCase := usecase.NewChatUseCase(realtimeChatAdapter, authClientAdapter, cloudMessagingAdapter, perspectiveAdapter, logs)
-	notificationUseCase := usecase.NewNotificationUseCase(databaseAdapter, redisConfig, userDeviceRepository, cloudMessagingAdapter, logs)
+		userSessionRepository, userRecoveryCodeRepository, userIdentityRepository, identityProviderAdapter, emailAdapter, jwtAdapter, securityAdapter,
+		cacheAdapter, rateLimiterAdapter, realtimeChatAdapter, smsAdapter, totpAdapter, userProducer, logs)
+	userUseCase := usecase.NewUserUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository, socialMediaRepository,
+		userSocialLinkRepository, uploadAdapter, cacheAdapter, userProducer, logs)
+	chatUseCase := usecase.NewChatUseCase(databaseAdapter, userRepository, chatRoomRepository, chatMessageRepository, userBlockRepository,
+		chatReportRepository, realtimeChatAdapter, authClientAdapter, cloudMessagingAdapter, moderationAdapter, photoAdapter, uploadAdapter, logs)
+	adminUseCase := usecase.NewAdminUseCase(databaseAdapter, userRepository, socialMediaRepository, chatReportRepository, chatMessageRepository,
+		cacheAdapter, logs)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(databaseAdapter, userRepository, userRecoveryCodeRepository, totpAdapter, securityAdapter, cacheAdapter, logs)
+	accountUseCase := usecase.NewAccountUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository,
+		userSocialLinkRepository, userSessionRepository, userRecoveryCodeRepository, userIdentityRepository, emailVerificationRepository, resetPasswordRepository, userDataExportRepository,
+		uploadAdapter, emailAdapter, notificationAdapter, photoAdapter, transactionAdapter, cacheAdapter, userProducer, logs)
+	identityUseCase := usecase.NewIdentityUseCase(databaseAdapter, userRepository, userIdentityRepository, identityProviderAdapter, logs)
 	authController := http.NewAuthController(authUseCase, customValidator, logs)
 	userController := http.NewUserController(userUseCase, customValidator, logs)
 	chatController := http.NewChatController(chatUseCase, customValidator, logs)
+	adminController := http.NewAdminController(adminUseCase, customValidator, logs)
+	twoFactorController := http.NewTwoFactorController(twoFactorUseCase, customValidator, logs)
+	accountController := http.NewAccountController(accountUseCase, customValidator, logs)
+	identityController := http.NewIdentityController(identityUseCase, customValidator, logs)
 	healthController := http.NewHealthController()
 
 	authMiddleware := middleware.NewUserAuth(authUseCase, customValidator, logs)
 
+	chatHub := websocket.NewChatHub(redisConfig, logs)
+	chatGateway := websocket.NewChatGateway(chatHub, chatUseCase, customValidator, logs)
+	go chatHub.Run(ctx)
+
 	photoConsumer := consumer.NewPhotoConsumer(userUseCase, jetStreamConfig, logs)
 	go func() {
 		logs.Log("consume all photo event beginning")
@@ -156,13 +203,34 @@ func webServer(ctx context.Context) error {
 			logs.CustomError("failed to consume all event", err)
 		}
 	}()
+
+	transactionConsumer := transactionconsumer.NewTransactionConsumer(chatUseCase, jetStreamConfig, logs)
+	go func() {
+		if err := config.WaitForStream(ctx, jetStreamConfig, "TRANSACTION_STREAM", logs); err != nil {
+			return
+		}
+		logs.Log("consume all transaction event beginning")
+		if err := transactionConsumer.ConsumeAllEvents(ctx); err != nil {
+			logs.CustomError("failed to consume all transaction event", err)
+		}
+	}()
+
+	go startAccountWorkerLoop(ctx, accountUseCase)
+
 	routeConfig := route.RouteConfig{
-		App:              app,
-		AuthController:   authController,
-		UserController:   userController,
-		AuthMiddleware:   authMiddleware,
-		ChatController:   chatController,
-		HealthController: healthController,
+		App:                 app,
+		AuthController:      authController,
+		UserController:      userController,
+		AuthMiddleware:      authMiddleware,
+		RateLimiterAdapter:  rateLimiterAdapter,
+		Logs:                logs,
+		ChatController:      chatController,
+		AdminController:     adminController,
+		TwoFactorController: twoFactorController,
+		AccountController:   accountController,
+		IdentityController:  identityController,
+		HealthController:    healthController,
+		ChatGateway:         chatGateway,
 	}
 
 	go func() {
@@ -176,7 +244,7 @@ func webServer(ctx context.Context) error {
 		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
 		defer l.Close()
 
-		grpcHandler.NewUserGRPCHandler(grpcServer, authUseCase, notificationUseCase)
+		grpcHandler.NewUserGRPCHandler(grpcServer, authUseCase, userUseCase, adminUseCase)
 
 		if err := grpcServer.Serve(l); err != nil {
 			logs.Error(fmt.Sprintf("Failed to start gRPC server: %v", err))
@@ -222,6 +290,26 @@ func startHealthCheckLoop(ctx context.Context, registry *consul.Registry, servic
 	}
 }
 
+// startAccountWorkerLoop erases accounts past their grace period and builds pending
+// data exports until the server shuts down.
+func startAccountWorkerLoop(ctx context.Context, accountUseCase usecase.AccountUseCase) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := accountUseCase.ProcessDueDeletions(ctx); err != nil {
+				logs.CustomError("failed to process due account deletions", err)
+			}
+			if err := accountUseCase.ProcessPendingDataExports(ctx); err != nil {
+				logs.CustomError("failed to process pending data exports", err)
+			}
+		}
+	}
+}
+
 func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
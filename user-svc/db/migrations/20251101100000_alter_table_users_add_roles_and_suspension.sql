@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN roles TEXT[] NOT NULL DEFAULT '{USER}',
+    ADD COLUMN suspended_at TIMESTAMPTZ,
+    ADD COLUMN suspension_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_users_roles ON users USING GIN (roles);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_roles;
+
+ALTER TABLE users
+    DROP COLUMN suspension_reason,
+    DROP COLUMN suspended_at,
+    DROP COLUMN roles;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_identities (
+    id CHAR(26) PRIMARY KEY,
+    user_id CHAR(26) NOT NULL,
+    provider VARCHAR(20) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+INSERT INTO user_identities (id, user_id, provider, subject, email, created_at, updated_at)
+SELECT id, id, 'GOOGLE', google_id, email, created_at, updated_at FROM users WHERE google_id IS NOT NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS google_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS google_id VARCHAR(26) UNIQUE;
+
+UPDATE users AS u SET google_id = ui.subject
+FROM user_identities AS ui
+WHERE ui.user_id = u.id AND ui.provider = 'GOOGLE';
+
+DROP TABLE IF EXISTS user_identities;
+-- +goose StatementEnd
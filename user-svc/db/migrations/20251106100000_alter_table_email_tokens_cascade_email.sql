@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tokens follow the user when the email changes instead of blocking the update
+ALTER TABLE email_verifications DROP CONSTRAINT IF EXISTS email_verifications_email_fkey;
+ALTER TABLE email_verifications
+    ADD CONSTRAINT email_verifications_email_fkey FOREIGN KEY (email) REFERENCES users(email)
+    ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE reset_passwords DROP CONSTRAINT IF EXISTS reset_passwords_email_fkey;
+ALTER TABLE reset_passwords
+    ADD CONSTRAINT reset_passwords_email_fkey FOREIGN KEY (email) REFERENCES users(email)
+    ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reset_passwords DROP CONSTRAINT IF EXISTS reset_passwords_email_fkey;
+ALTER TABLE reset_passwords
+    ADD CONSTRAINT reset_passwords_email_fkey FOREIGN KEY (email) REFERENCES users(email);
+
+ALTER TABLE email_verifications DROP CONSTRAINT IF EXISTS email_verifications_email_fkey;
+ALTER TABLE email_verifications
+    ADD CONSTRAINT email_verifications_email_fkey FOREIGN KEY (email) REFERENCES users(email);
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The handle is what follows the social media base_url, e.g. "hervibest" for instagram
+ALTER TABLE user_social_links ADD COLUMN IF NOT EXISTS handle VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE user_social_links ALTER COLUMN handle DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_social_links DROP COLUMN IF EXISTS handle;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS chat_rooms (
+    id CHAR(26) PRIMARY KEY,
+    room_user_id VARCHAR(60) NOT NULL,
+    last_message_id CHAR(26),
+    last_message_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_rooms_room_user_id ON chat_rooms (room_user_id);
+
+CREATE TABLE IF NOT EXISTS chat_room_members (
+    room_id CHAR(26) NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    last_read_message_id CHAR(26),
+    last_read_at TIMESTAMPTZ,
+    joined_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (room_id, user_id),
+    FOREIGN KEY (room_id) REFERENCES chat_rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_room_members_user_id ON chat_room_members (user_id);
+
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id CHAR(26) PRIMARY KEY,
+    room_id CHAR(26) NOT NULL,
+    sender_id CHAR(26) NOT NULL,
+    client_message_id VARCHAR(64),
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (room_id) REFERENCES chat_rooms(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_messages_room_id_created_at ON chat_messages (room_id, created_at DESC, id DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_messages_sender_id_client_message_id ON chat_messages (sender_id, client_message_id)
+    WHERE client_message_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_messages;
+DROP TABLE IF EXISTS chat_room_members;
+DROP TABLE IF EXISTS chat_rooms;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chat_messages
+    ADD COLUMN IF NOT EXISTS message_type VARCHAR(10) NOT NULL DEFAULT 'TEXT',
+    ADD COLUMN IF NOT EXISTS attachment JSONB,
+    ALTER COLUMN sender_id DROP NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_messages_room_id_system_client_message_id ON chat_messages (room_id, client_message_id)
+    WHERE sender_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_chat_messages_room_id_system_client_message_id;
+DELETE FROM chat_messages WHERE sender_id IS NULL;
+
+ALTER TABLE chat_messages
+    ALTER COLUMN sender_id SET NOT NULL,
+    DROP COLUMN IF EXISTS attachment,
+    DROP COLUMN IF EXISTS message_type;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id CHAR(26) NOT NULL,
+    blocked_id CHAR(26) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+CREATE TABLE IF NOT EXISTS chat_reports (
+    id CHAR(26) PRIMARY KEY,
+    room_id CHAR(26) NOT NULL,
+    reporter_id CHAR(26) NOT NULL,
+    reported_user_id CHAR(26) NOT NULL,
+    message_id CHAR(26),
+    reason VARCHAR(20) NOT NULL,
+    description TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING',
+    reviewer_id CHAR(26),
+    review_note TEXT,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (room_id) REFERENCES chat_rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (reported_user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_reports_status_created_at ON chat_reports (status, created_at, id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_reports_reporter_id_room_id_pending ON chat_reports (reporter_id, room_id)
+    WHERE status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_reports;
+DROP TABLE IF EXISTS user_blocks;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Device tokens are owned by notification-svc, which copies this table once with its
+-- 20251112100000_move_user_devices_from_user_svc migration. The table is kept for that copy only
+-- and can be dropped once every environment has run it.
+DO $$
+BEGIN
+    IF to_regclass('public.user_devices') IS NOT NULL THEN
+        COMMENT ON TABLE user_devices IS 'Deprecated: device tokens are owned by notification-svc, nothing reads or writes this table';
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF to_regclass('public.user_devices') IS NOT NULL THEN
+        COMMENT ON TABLE user_devices IS NULL;
+    END IF;
+END $$;
+-- +goose StatementEnd
@@ -16,6 +16,9 @@ type CacheAdapter interface {
 	HDel(ctx context.Context, key string, fields ...string) error
 	HSet(ctx context.Context, key string, values ...interface{}) error
 	SAdd(ctx context.Context, key string, members ...interface{}) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
+	Incr(ctx context.Context, key string) (int64, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) error
 }
 
 type cacheAdapter struct {
@@ -59,3 +62,15 @@ func (a *cacheAdapter) HDel(ctx context.Context, key string, fields ...string) e
 func (a *cacheAdapter) SAdd(ctx context.Context, key string, members ...interface{}) error {
 	return a.redisClient.SAdd(ctx, key, members...).Err()
 }
+
+func (a *cacheAdapter) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	return a.redisClient.SetNX(ctx, key, value, expiration).Result()
+}
+
+func (a *cacheAdapter) Incr(ctx context.Context, key string) (int64, error) {
+	return a.redisClient.Incr(ctx, key).Result()
+}
+
+func (a *cacheAdapter) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return a.redisClient.Expire(ctx, key, expiration).Err()
+}
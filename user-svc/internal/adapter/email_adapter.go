@@ -91,6 +91,27 @@ func (a *emailAdapter) SendEmail(email string, token string, category string) er
 	case "new email verification":
 		subject = "User Email Verification"
 		filePath = "registration.html"
+	case "account locked":
+		subject = "Account Temporarily Locked"
+		filePath = "account_locked.html"
+	case "data export":
+		subject = "Your Data Export Is Ready"
+		filePath = "data_export.html"
+	case "email change verification":
+		subject = "Verify Your New Email"
+		filePath = "new_email_verification.html"
+	case "email changed":
+		subject = "Your Account Email Was Changed"
+		filePath = "email_changed.html"
+	case "phone number changed":
+		subject = "Your Account Phone Number Was Changed"
+		filePath = "phone_number_changed.html"
+	case "email already registered":
+		subject = "This Email Is Already Registered"
+		filePath = "email_already_registered.html"
+	case "reauthentication":
+		subject = "Confirm It Is You"
+		filePath = "reauthentication.html"
 	default:
 		return fmt.Errorf("kategori email tidak dikenali: %s", category)
 	}
@@ -0,0 +1,122 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"cloud.google.com/go/firestore"
+	firebase "firebase.google.com/go/v4"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// firestoreChatAdapter mirrors chat into Firestore for the clients still listening to it, documents are keyed by the
+// postgres ids so mirroring the same change twice overwrites it
+type firestoreChatAdapter struct {
+	firestoreClient *firestore.Client
+	logs            logger.Log
+}
+
+func NewFirestoreChatAdapter(ctx context.Context, app *firebase.App, logs logger.Log) RealtimeChatAdapter {
+	firestoreClient, err := app.Firestore(ctx)
+	if err != nil {
+		log.Fatalf("error initializing firebase firestore: %v", err)
+	}
+	return &firestoreChatAdapter{
+		firestoreClient: firestoreClient,
+		logs:            logs,
+	}
+}
+
+func (u *firestoreChatAdapter) CreateChatRoom(ctx context.Context, user *entity.User, userProfile *entity.UserProfile) {
+	userRef := u.firestoreClient.Collection("users").Doc(user.Id)
+	_, err := userRef.Get(ctx)
+	if err != nil {
+		_, err := userRef.Set(ctx, map[string]interface{}{
+			"userId":     user.Id,
+			"profileId":  userProfile.Id,
+			"nickname":   userProfile.Nickname,
+			"profileUrl": nullable.SQLStringToPtr(userProfile.ProfileUrl),
+			"createdAt":  firestore.ServerTimestamp,
+			"updatedAt":  firestore.ServerTimestamp,
+		})
+		if err != nil {
+			u.logs.Error(fmt.Sprintf("Failed to create or get rooms from firebase when create user by google with err : %v and user id : %s", err, user.Id))
+		}
+	}
+}
+
+// FindRoomId finds the room of the pair created before chat was stored in postgres, those rooms have their own ids
+func (u *firestoreChatAdapter) FindRoomId(ctx context.Context, roomUserId string) (string, error) {
+	docs, err := u.firestoreClient.
+		Collection("rooms").
+		Where("roomUserId", "==", roomUserId).
+		Limit(1).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return "", fmt.Errorf("failed to find a firestore room: %w", err)
+	}
+	if len(docs) == 0 {
+		return "", nil
+	}
+	return docs[0].Ref.ID, nil
+}
+
+// CreateRoom leaves an existing room untouched, a room reused from Firestore keeps its history and read state
+func (u *firestoreChatAdapter) CreateRoom(ctx context.Context, room *entity.ChatRoom, memberIds []string) error {
+	_, err := u.firestoreClient.
+		Collection("rooms").
+		Doc(room.Id).
+		Create(ctx, map[string]interface{}{
+			"roomId":       room.Id,
+			"roomUserId":   room.RoomUserId,
+			"participants": memberIds,
+			"createdAt":    room.CreatedAt,
+		})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return fmt.Errorf("failed to create a firestore room: %w", err)
+	}
+	return nil
+}
+
+func (u *firestoreChatAdapter) SendMessage(ctx context.Context, message *entity.ChatMessage, memberIds []string) error {
+	_, err := u.firestoreClient.
+		Collection("rooms").
+		Doc(message.RoomId).
+		Collection("messages").
+		Doc(message.Id).
+		Set(ctx, map[string]interface{}{
+			"messageId": message.Id,
+			"senderId":  message.SenderId.String,
+			"type":      string(message.MessageType),
+			"message":   message.Message,
+			"timestamp": message.CreatedAt,
+		})
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return nil
+}
+
+func (u *firestoreChatAdapter) MarkRead(ctx context.Context, member *entity.ChatRoomMember, memberIds []string) error {
+	_, err := u.firestoreClient.
+		Collection("rooms").
+		Doc(member.RoomId).
+		Set(ctx, map[string]interface{}{
+			"readBy": map[string]interface{}{
+				member.UserId: map[string]interface{}{
+					"messageId": member.LastReadMessageId.String,
+					"readAt":    member.LastReadAt,
+				},
+			},
+		}, firestore.MergeAll)
+	if err != nil {
+		return fmt.Errorf("failed to mark firestore room read: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package adapter
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+
+	"github.com/bytedance/sonic"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	jwksCacheTTL          = time.Hour
+	jwksMinRefreshBackoff = time.Minute
+)
+
+var (
+	ErrIdentityProviderUnsupported = errors.New("identity provider is not supported")
+	ErrInvalidIdentityToken        = errors.New("invalid identity token")
+)
+
+// IdentityProviderConfig describes an OpenID Connect provider whose id tokens are signed
+// with RSA keys published at JWKSUrl. Audiences holds every client id of the app, e.g. the
+// web, iOS and Android ones.
+type IdentityProviderConfig struct {
+	Provider  enum.IdentityProviderEnum
+	JWKSUrl   string
+	Issuers   []string
+	Audiences []string
+}
+
+type IdentityProviderAdapter interface {
+	VerifyIdToken(ctx context.Context, provider enum.IdentityProviderEnum, idToken string) (*model.IdentityClaim, error)
+	IsSupported(provider enum.IdentityProviderEnum) bool
+}
+
+type identityProviderAdapter struct {
+	providers map[enum.IdentityProviderEnum]*jwksVerifier
+}
+
+// NewIdentityProviderAdapter enables every provider that has a client id configured.
+// Facebook is verified through the OpenID Connect token issued by Limited Login.
+func NewIdentityProviderAdapter() IdentityProviderAdapter {
+	configs := []IdentityProviderConfig{
+		{
+			Provider:  enum.IdentityProviderGoogle,
+			JWKSUrl:   utils.GetEnv("GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs"),
+			Issuers:   []string{"accounts.google.com", "https://accounts.google.com"},
+			Audiences: splitEnvList(utils.GetEnv("GOOGLE_CLIENT_ID")),
+		},
+		{
+			Provider:  enum.IdentityProviderApple,
+			JWKSUrl:   utils.GetEnv("APPLE_JWKS_URL", "https://appleid.apple.com/auth/keys"),
+			Issuers:   []string{"https://appleid.apple.com"},
+			Audiences: splitEnvList(utils.GetEnv("APPLE_CLIENT_ID")),
+		},
+		{
+			Provider:  enum.IdentityProviderFacebook,
+			JWKSUrl:   utils.GetEnv("FACEBOOK_JWKS_URL", "https://limited.facebook.com/.well-known/oauth/openid/jwks/"),
+			Issuers:   []string{"https://www.facebook.com", "https://limited.facebook.com"},
+			Audiences: splitEnvList(utils.GetEnv("FACEBOOK_APP_ID")),
+		},
+	}
+
+	return NewIdentityProviderAdapterWithConfig(&http.Client{Timeout: 10 * time.Second}, configs...)
+}
+
+// NewIdentityProviderAdapterWithConfig skips providers without an audience, a token
+// minted for any client id would otherwise be accepted.
+func NewIdentityProviderAdapterWithConfig(httpClient *http.Client, configs ...IdentityProviderConfig) IdentityProviderAdapter {
+	providers := make(map[enum.IdentityProviderEnum]*jwksVerifier, len(configs))
+	for _, config := range configs {
+		if len(config.Audiences) == 0 {
+			continue
+		}
+		providers[config.Provider] = &jwksVerifier{config: config, httpClient: httpClient}
+	}
+
+	return &identityProviderAdapter{
+		providers: providers,
+	}
+}
+
+func (a *identityProviderAdapter) IsSupported(provider enum.IdentityProviderEnum) bool {
+	_, ok := a.providers[provider]
+	return ok
+}
+
+func (a *identityProviderAdapter) VerifyIdToken(ctx context.Context, provider enum.IdentityProviderEnum, idToken string) (*model.IdentityClaim, error) {
+	verifier, ok := a.providers[provider]
+	if !ok {
+		return nil, ErrIdentityProviderUnsupported
+	}
+
+	return verifier.verify(ctx, idToken)
+}
+
+type jwksVerifier struct {
+	config     IdentityProviderConfig
+	httpClient *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func (v *jwksVerifier) verify(ctx context.Context, idToken string) (*model.IdentityClaim, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		return v.publicKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w : %v", ErrInvalidIdentityToken, err)
+	}
+
+	issuer, _ := claims["iss"].(string)
+	if !slices.Contains(v.config.Issuers, issuer) {
+		return nil, fmt.Errorf("%w : unexpected issuer %s", ErrInvalidIdentityToken, issuer)
+	}
+
+	if !slices.ContainsFunc(v.config.Audiences, func(audience string) bool { return claims.VerifyAudience(audience, true) }) {
+		return nil, fmt.Errorf("%w : unexpected audience", ErrInvalidIdentityToken)
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, fmt.Errorf("%w : token has no expiry", ErrInvalidIdentityToken)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w : missing subject", ErrInvalidIdentityToken)
+	}
+
+	identityClaim := &model.IdentityClaim{
+		Provider: v.config.Provider,
+		Subject:  subject,
+	}
+	identityClaim.Email, _ = claims["email"].(string)
+	identityClaim.Name, _ = claims["name"].(string)
+	if givenName, ok := claims["given_name"].(string); ok && givenName != "" {
+		identityClaim.Name = givenName
+	}
+	identityClaim.PictureUrl, _ = claims["picture"].(string)
+
+	// Apple sends email_verified as the string "true"
+	switch emailVerified := claims["email_verified"].(type) {
+	case bool:
+		identityClaim.EmailVerified = emailVerified
+	case string:
+		identityClaim.EmailVerified = emailVerified == "true"
+	}
+
+	return identityClaim, nil
+}
+
+// publicKey serves keys from the cached set and refetches it when kid is unknown, which
+// is how providers roll their signing keys. Refetches are throttled so a forged kid
+// cannot be used to hammer the provider.
+func (v *jwksVerifier) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fresh := time.Since(v.fetchedAt) < jwksCacheTTL
+	recentlyFetched := time.Since(v.fetchedAt) < jwksMinRefreshBackoff
+	v.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	if !ok && recentlyFetched {
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+
+	if err := v.refresh(ctx); err != nil {
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+
+	return key, nil
+}
+
+type jsonWebKeySet struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+func (v *jwksVerifier) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.config.JWKSUrl, nil)
+	if err != nil {
+		return fmt.Errorf("create jwks request : %w", err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch jwks : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch jwks : unexpected status %d", resp.StatusCode)
+	}
+
+	keySet := new(jsonWebKeySet)
+	if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(keySet); err != nil {
+		return fmt.Errorf("decode jwks : %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+
+		key, err := parseRSAPublicKey(jwk.N, jwk.E)
+		if err != nil {
+			return fmt.Errorf("parse jwk %s : %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	return nil
+}
+
+func parseRSAPublicKey(modulus, exponent string) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(exponent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+func splitEnvList(value string) []string {
+	values := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
@@ -14,8 +14,8 @@ import (
 )
 
 type JWTAdapter interface {
-	GenerateAccessToken(userId string) (*entity.AccessToken, error)
-	GenerateRefreshToken(userId string) (*entity.RefreshToken, error)
+	GenerateAccessToken(userId, sessionId string, roles []string) (*entity.AccessToken, error)
+	GenerateRefreshToken(userId, sessionId, tokenId string) (*entity.RefreshToken, error)
 	VerifyAccessToken(token string) (*entity.AccessToken, error)
 	VerifyRefreshToken(token string) (*entity.RefreshToken, error)
 }
@@ -45,12 +45,14 @@ func NewJWTAdapter() JWTAdapter {
 	}
 }
 
-func (c *jwtAdapter) GenerateAccessToken(userId string) (*entity.AccessToken, error) {
+func (c *jwtAdapter) GenerateAccessToken(userId, sessionId string, roles []string) (*entity.AccessToken, error) {
 	expirationTime := time.Now().Add(time.Minute * c.accessExpireTime)
 
 	claims := jwt.MapClaims{}
 	claims["authorized"] = true
 	claims["user_id"] = userId
+	claims["sid"] = sessionId
+	claims["roles"] = roles
 	claims["exp"] = expirationTime.Unix()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
@@ -61,16 +63,20 @@ func (c *jwtAdapter) GenerateAccessToken(userId string) (*entity.AccessToken, er
 
 	return &entity.AccessToken{
 		UserId:    userId,
+		SessionId: sessionId,
+		Roles:     roles,
 		Token:     stringToken,
 		ExpiresAt: expirationTime,
 	}, nil
 }
 
-func (c *jwtAdapter) GenerateRefreshToken(userId string) (*entity.RefreshToken, error) {
+func (c *jwtAdapter) GenerateRefreshToken(userId, sessionId, tokenId string) (*entity.RefreshToken, error) {
 	expirationTime := time.Now().Add(time.Hour * 24 * c.refreshExpireTime)
 
 	claims := jwt.MapClaims{}
 	claims["user_id"] = userId
+	claims["sid"] = sessionId
+	claims["jti"] = tokenId
 	claims["exp"] = expirationTime.Unix()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -81,6 +87,8 @@ func (c *jwtAdapter) GenerateRefreshToken(userId string) (*entity.RefreshToken,
 
 	return &entity.RefreshToken{
 		UserId:    userId,
+		SessionId: sessionId,
+		TokenId:   tokenId,
 		Token:     stringToken,
 		ExpiresAt: expirationTime,
 	}, nil
@@ -121,6 +129,18 @@ func (c *jwtAdapter) VerifyAccessToken(token string) (*entity.AccessToken, error
 		}
 
 		accessTokenDetail.UserId = userIdStr
+		// Tokens issued before sessions existed carry no sid claim
+		accessTokenDetail.SessionId, _ = claims["sid"].(string)
+		// Tokens issued before roles existed carry no roles claim, so a missing
+		// claim is tolerated and the roles are resolved from the user record.
+		if rawRoles, ok := claims["roles"].([]interface{}); ok {
+			for _, rawRole := range rawRoles {
+				if role, ok := rawRole.(string); ok {
+					accessTokenDetail.Roles = append(accessTokenDetail.Roles, role)
+				}
+			}
+		}
+
 		expFloat, ok := claims["exp"].(float64)
 		if !ok {
 			log.Println("exp is not a float")
@@ -160,6 +180,9 @@ func (c *jwtAdapter) VerifyRefreshToken(token string) (*entity.RefreshToken, err
 		}
 
 		refreshTokenDetail.UserId = userIdStr
+		// Legacy refresh tokens carry neither sid nor jti, the use case decides how to treat them
+		refreshTokenDetail.SessionId, _ = claims["sid"].(string)
+		refreshTokenDetail.TokenId, _ = claims["jti"].(string)
 		expFloat, ok := claims["exp"].(float64)
 		if !ok {
 			log.Println("exp is not a float")
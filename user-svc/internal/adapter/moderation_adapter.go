@@ -0,0 +1,85 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+)
+
+// ErrModerationUnavailable is returned when no classifier could check a message and the policy is fail closed
+var ErrModerationUnavailable = errors.New("no moderation classifier could check the message")
+
+// ModerationClassifier decides whether a message is toxic, an error means it could not decide and the next classifier
+// of the pipeline is asked
+type ModerationClassifier interface {
+	IsToxicMessage(msg string) (bool, error)
+}
+
+type ModerationAdapter interface {
+	IsToxicMessage(msg string) (bool, error)
+}
+
+type namedClassifier struct {
+	name       string
+	classifier ModerationClassifier
+}
+
+type moderationAdapter struct {
+	classifiers []namedClassifier
+	failPolicy  enum.ModerationFailPolicyEnum
+	logs        logger.Log
+}
+
+// NewModerationAdapter builds the pipeline from CHAT_MODERATION_CLASSIFIERS, the first classifier that answers
+// decides. The local word list never fails, so the fail policy only matters when it is left out of the pipeline.
+func NewModerationAdapter(perspectiveAdapter PerspectiveAdapter, logs logger.Log) ModerationAdapter {
+	available := map[string]func() ModerationClassifier{
+		"perspective": func() ModerationClassifier { return perspectiveAdapter },
+		"wordlist": func() ModerationClassifier {
+			return NewWordlistClassifier(utils.GetEnv("CHAT_MODERATION_WORDLIST_PATH", ""), logs)
+		},
+	}
+
+	classifiers := make([]namedClassifier, 0, len(available))
+	for _, name := range strings.Split(utils.GetEnv("CHAT_MODERATION_CLASSIFIERS", "perspective,wordlist"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		newClassifier, ok := available[name]
+		if !ok {
+			logs.Error(fmt.Sprintf("unknown chat moderation classifier : %s", name))
+			continue
+		}
+		classifiers = append(classifiers, namedClassifier{name: name, classifier: newClassifier()})
+	}
+
+	failPolicy := enum.ModerationFailPolicyEnum(strings.ToUpper(utils.GetEnv("CHAT_MODERATION_FAIL_POLICY", string(enum.ModerationFailClosed))))
+	if failPolicy != enum.ModerationFailOpen {
+		failPolicy = enum.ModerationFailClosed
+	}
+
+	return &moderationAdapter{
+		classifiers: classifiers,
+		failPolicy:  failPolicy,
+		logs:        logs,
+	}
+}
+
+func (a *moderationAdapter) IsToxicMessage(msg string) (bool, error) {
+	for _, named := range a.classifiers {
+		isToxic, err := named.classifier.IsToxicMessage(msg)
+		if err == nil {
+			return isToxic, nil
+		}
+		a.logs.CustomError(fmt.Sprintf("chat moderation classifier %s failed", named.name), err)
+	}
+
+	if a.failPolicy == enum.ModerationFailOpen {
+		a.logs.Log("no chat moderation classifier could check the message, sending it unchecked")
+		return false, nil
+	}
+
+	return false, ErrModerationUnavailable
+}
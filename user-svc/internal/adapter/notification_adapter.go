@@ -0,0 +1,63 @@
+package adapter
+
+import (
+	"context"
+
+	notificationpb "github.com/hervibest/be-yourmoments-backup/pb/notification"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	discovery "github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/discovery"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+)
+
+type NotificationAdapter interface {
+	GetUserDevices(ctx context.Context, userId string) ([]*entity.UserDevice, error)
+}
+
+type notificationAdapter struct {
+	client notificationpb.NotificationServiceClient
+}
+
+func NewNotificationAdapter(ctx context.Context, registry discovery.Registry, logs logger.Log) (NotificationAdapter, error) {
+	notificationServiceName := utils.GetEnv("NOTIFICATION_SVC_NAME")
+	conn, err := discovery.ServiceConnection(ctx, notificationServiceName, registry, logs)
+	if err != nil {
+		logs.CustomError("failed to connect to the notification service due to an error : ", err)
+		return nil, err
+	}
+	client := notificationpb.NewNotificationServiceClient(conn)
+
+	return &notificationAdapter{
+		client: client,
+	}, nil
+}
+
+func (a *notificationAdapter) GetUserDevices(ctx context.Context, userId string) ([]*entity.UserDevice, error) {
+	pbRequest := &notificationpb.GetUserDevicesRequest{
+		UserId: userId,
+	}
+
+	pbResponse, err := a.client.GetUserDevices(ctx, pbRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	userDevices := make([]*entity.UserDevice, 0, len(pbResponse.GetDevices()))
+	for _, device := range pbResponse.GetDevices() {
+		userDevice := &entity.UserDevice{
+			Id:       device.GetId(),
+			UserId:   device.GetUserId(),
+			Token:    device.GetToken(),
+			Platform: enum.PlatformTypeEnum(device.GetPlatform()),
+		}
+		if device.GetCreatedAt() != nil {
+			createdAt := device.GetCreatedAt().AsTime()
+			userDevice.CreatedAt = &createdAt
+		}
+		userDevices = append(userDevices, userDevice)
+	}
+
+	return userDevices, nil
+}
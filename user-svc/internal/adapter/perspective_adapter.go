@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
 )
@@ -15,12 +16,14 @@ type PerspectiveAdapter interface {
 }
 type perspectiveAdapter struct {
 	perspectiveAPIKey string
+	httpClient        *http.Client
 }
 
 func NewPerspectiveAdapter() PerspectiveAdapter {
 	perspectiveAPIKey := utils.GetEnv("PERSPECTIVE_API_KEY")
 	return &perspectiveAdapter{
 		perspectiveAPIKey: perspectiveAPIKey,
+		httpClient:        &http.Client{Timeout: 5 * time.Second},
 	}
 }
 
@@ -57,18 +60,27 @@ func (a *perspectiveAdapter) IsToxicMessage(msg string) (bool, error) {
 	bodyBytes, _ := json.Marshal(reqBody)
 
 	url := fmt.Sprintf("https://commentanalyzer.googleapis.com/v1alpha1/comments:analyze?key=%s", a.perspectiveAPIKey)
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(bodyBytes))
+	resp, err := a.httpClient.Post(url, "application/json", bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return false, err
 	}
 	defer resp.Body.Close()
 
 	respBytes, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("perspective api responded with status %d : %s", resp.StatusCode, respBytes)
+	}
+
 	var result PerspectiveResponse
 	if err := json.Unmarshal(respBytes, &result); err != nil {
 		return false, err
 	}
 
+	// a quota or language error may still come back without scores, that is not a clean message
+	if len(result.AttributeScores) == 0 {
+		return false, fmt.Errorf("perspective api responded without attribute scores")
+	}
+
 	toxicity := result.AttributeScores["TOXICITY"].SummaryScore.Value
 	severeToxicity := result.AttributeScores["SEVERE_TOXICITY"].SummaryScore.Value
 	identityAttack := result.AttributeScores["IDENTITY_ATTACK"].SummaryScore.Value
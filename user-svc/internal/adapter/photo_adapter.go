@@ -9,11 +9,15 @@ import (
 	discovery "github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/discovery"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 )
 
 type PhotoAdapter interface {
 	CreateCreator(ctx context.Context, userId string) (*entity.Creator, error)
 	GetCreator(ctx context.Context, creatorId string) (*entity.Creator, error)
+	GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error)
+	GetChatPhotoPreviews(ctx context.Context, userId string, photoIds []string) ([]*model.ChatPhotoAttachment, error)
+	GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchivePurchase, []*model.UserDataArchiveFacecam, error)
 }
 
 type photoAdapter struct {
@@ -67,3 +71,89 @@ func (a *photoAdapter) GetCreator(ctx context.Context, userId string) (*entity.C
 
 	return creator, nil
 }
+
+// GetCreatorUserIds maps each creator id to the user id owning the creator account
+func (a *photoAdapter) GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error) {
+	pbRequest := &photopb.GetCreatorsByIdsRequest{
+		CreatorIds: creatorIds,
+	}
+
+	pbResponse, err := a.client.GetCreatorsByIds(ctx, pbRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	creatorUserIds := make(map[string]string, len(pbResponse.GetCreators()))
+	for _, creator := range pbResponse.GetCreators() {
+		creatorUserIds[creator.GetId()] = creator.GetUserId()
+	}
+
+	return creatorUserIds, nil
+}
+
+// GetChatPhotoPreviews only returns the photos the user is allowed to see, the preview url is the watermarked one
+func (a *photoAdapter) GetChatPhotoPreviews(ctx context.Context, userId string, photoIds []string) ([]*model.ChatPhotoAttachment, error) {
+	pbRequest := &photopb.GetChatPhotoPreviewsRequest{
+		UserId:   userId,
+		PhotoIds: photoIds,
+	}
+
+	pbResponse, err := a.client.GetChatPhotoPreviews(ctx, pbRequest)
+	if err != nil {
+		return nil, helper.FromGRPCError(err)
+	}
+
+	previews := make([]*model.ChatPhotoAttachment, 0, len(pbResponse.GetPreviews()))
+	for _, preview := range pbResponse.GetPreviews() {
+		previews = append(previews, &model.ChatPhotoAttachment{
+			PhotoId:    preview.GetPhotoId(),
+			CreatorId:  preview.GetCreatorId(),
+			Title:      preview.GetTitle(),
+			Price:      preview.GetPrice(),
+			PriceStr:   preview.GetPriceStr(),
+			PreviewUrl: preview.GetPreviewUrl(),
+			IsBuyable:  preview.GetIsBuyable(),
+		})
+	}
+
+	return previews, nil
+}
+
+// GetUserDataExport returns the purchases and facecams of the user for the data export
+func (a *photoAdapter) GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchivePurchase, []*model.UserDataArchiveFacecam, error) {
+	pbRequest := &photopb.GetUserDataExportRequest{
+		UserId: userId,
+	}
+
+	pbResponse, err := a.client.GetUserDataExport(ctx, pbRequest)
+	if err != nil {
+		return nil, nil, helper.FromGRPCError(err)
+	}
+
+	purchases := make([]*model.UserDataArchivePurchase, 0, len(pbResponse.GetPurchases()))
+	for _, purchase := range pbResponse.GetPurchases() {
+		purchases = append(purchases, &model.UserDataArchivePurchase{
+			PhotoId:    purchase.GetPhotoId(),
+			CreatorId:  purchase.GetCreatorId(),
+			Title:      purchase.GetTitle(),
+			Price:      purchase.GetPrice(),
+			PriceStr:   purchase.GetPriceStr(),
+			OriginalAt: timestampToTime(purchase.GetOriginalAt()),
+		})
+	}
+
+	facecams := make([]*model.UserDataArchiveFacecam, 0, len(pbResponse.GetFacecams()))
+	for _, facecam := range pbResponse.GetFacecams() {
+		facecams = append(facecams, &model.UserDataArchiveFacecam{
+			Id:          facecam.GetId(),
+			FileName:    facecam.GetFileName(),
+			Title:       facecam.GetTitle(),
+			Size:        facecam.GetSize(),
+			IsProcessed: facecam.GetIsProcessed(),
+			OriginalAt:  timestampToTime(facecam.GetOriginalAt()),
+			CreatedAt:   timestampToTime(facecam.GetCreatedAt()),
+		})
+	}
+
+	return purchases, facecams, nil
+}
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+const rateLimitKeyPrefix = "rate_limit:"
+
+// slidingWindowScript keeps one sorted set member per hit scored by its unix millis.
+// Hits older than the window are trimmed before counting so the limit applies to any
+// window-sized interval instead of fixed buckets. Rejected hits are not recorded.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+if count >= limit then
+	local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+	local retryAfter = window
+	if oldest[2] then
+		retryAfter = tonumber(oldest[2]) + window - now
+	end
+	return {0, retryAfter}
+end
+
+redis.call('ZADD', key, now, member)
+redis.call('PEXPIRE', key, window)
+return {1, 0}
+`)
+
+type RateLimiterAdapter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error)
+	Reset(ctx context.Context, keys ...string) error
+}
+
+type rateLimiterAdapter struct {
+	redisClient *redis.Client
+}
+
+func NewRateLimiterAdapter(redisClient *redis.Client) RateLimiterAdapter {
+	return &rateLimiterAdapter{
+		redisClient: redisClient,
+	}
+}
+
+// Allow records a hit for key and reports whether it is within limit for the sliding
+// window. When it is not, the returned duration tells when the oldest hit expires.
+func (a *rateLimiterAdapter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	now := time.Now().UnixMilli()
+	result, err := slidingWindowScript.Run(ctx, a.redisClient, []string{rateLimitKeyPrefix + key},
+		now, window.Milliseconds(), limit, ulid.Make().String()).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
+
+func (a *rateLimiterAdapter) Reset(ctx context.Context, keys ...string) error {
+	prefixed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, rateLimitKeyPrefix+key)
+	}
+
+	return a.redisClient.Del(ctx, prefixed...).Err()
+}
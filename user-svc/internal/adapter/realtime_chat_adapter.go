@@ -2,102 +2,83 @@ package adapter
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"errors"
+	"strconv"
 
-	"cloud.google.com/go/firestore"
 	firebase "firebase.google.com/go/v4"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
-	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
-	"github.com/oklog/ulid/v2"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+	"github.com/redis/go-redis/v9"
 )
 
+// RealtimeChatAdapter fans stored chat changes out to the clients. Chat is stored in postgres first, a sink only
+// mirrors what is already stored.
 type RealtimeChatAdapter interface {
 	CreateChatRoom(ctx context.Context, user *entity.User, userProfile *entity.UserProfile)
-	GetRoom(ctx context.Context, roomUserId string) ([]*firestore.DocumentSnapshot, error)
-	CreateRoom(ctx context.Context, roomUserId string, participants []string) error
-	SendMessage(ctx context.Context, roomId, senderId, safeMessage string) error
+	FindRoomId(ctx context.Context, roomUserId string) (string, error)
+	CreateRoom(ctx context.Context, room *entity.ChatRoom, memberIds []string) error
+	SendMessage(ctx context.Context, message *entity.ChatMessage, memberIds []string) error
+	MarkRead(ctx context.Context, member *entity.ChatRoomMember, memberIds []string) error
 }
 
 type realtimeChatAdapter struct {
-	firestoreClient *firestore.Client
-	logs            logger.Log
+	sinks []RealtimeChatAdapter
 }
 
-func NewRealtimeChatAdapter(ctx context.Context, app *firebase.App, logs logger.Log) RealtimeChatAdapter {
-	firestoreClient, err := app.Firestore(ctx)
-	if err != nil {
-		log.Fatalf("error initializing firebase firestore: %v", err)
+// NewRealtimeChatAdapter always fans out to the websocket gateway, Firestore is only mirrored when
+// CHAT_FIRESTORE_ENABLED is true so chat runs fully locally without it
+func NewRealtimeChatAdapter(ctx context.Context, app *firebase.App, redisClient *redis.Client, logs logger.Log) RealtimeChatAdapter {
+	sinks := []RealtimeChatAdapter{NewWebSocketChatAdapter(redisClient)}
+	if enabled, _ := strconv.ParseBool(utils.GetEnv("CHAT_FIRESTORE_ENABLED", "false")); enabled {
+		sinks = append(sinks, NewFirestoreChatAdapter(ctx, app, logs))
 	}
+
 	return &realtimeChatAdapter{
-		firestoreClient: firestoreClient,
-		logs:            logs,
+		sinks: sinks,
+	}
+}
+
+func (a *realtimeChatAdapter) CreateChatRoom(ctx context.Context, user *entity.User, userProfile *entity.UserProfile) {
+	for _, sink := range a.sinks {
+		sink.CreateChatRoom(ctx, user, userProfile)
 	}
 }
 
-func (u *realtimeChatAdapter) CreateChatRoom(ctx context.Context, user *entity.User, userProfile *entity.UserProfile) {
-	userRef := u.firestoreClient.Collection("users").Doc(user.Id)
-	_, err := userRef.Get(ctx)
-	if err != nil {
-		_, err := userRef.Set(ctx, map[string]interface{}{
-			"userId":     user.Id,
-			"profileId":  userProfile.Id,
-			"nickname":   userProfile.Nickname,
-			"profileUrl": nullable.SQLStringToPtr(userProfile.ProfileUrl),
-			"createdAt":  firestore.ServerTimestamp,
-			"updatedAt":  firestore.ServerTimestamp,
-		})
+// FindRoomId returns the id of the first sink already holding a room for the pair, empty when none does
+func (a *realtimeChatAdapter) FindRoomId(ctx context.Context, roomUserId string) (string, error) {
+	for _, sink := range a.sinks {
+		roomId, err := sink.FindRoomId(ctx, roomUserId)
 		if err != nil {
-			u.logs.Error(fmt.Sprintf("Failed to create or get rooms from firebase when create user by google with err : %v and user id : %s", err, user.Id))
+			return "", err
+		}
+		if roomId != "" {
+			return roomId, nil
 		}
 	}
+	return "", nil
 }
 
-func (u *realtimeChatAdapter) GetRoom(ctx context.Context, roomUserId string) ([]*firestore.DocumentSnapshot, error) {
-	query := u.firestoreClient.
-		Collection("rooms").
-		Where("roomUserId", "==", roomUserId).
-		Limit(1)
-
-	docs, err := query.Documents(ctx).GetAll()
-	if err != nil {
-		return nil, err
+func (a *realtimeChatAdapter) CreateRoom(ctx context.Context, room *entity.ChatRoom, memberIds []string) error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.CreateRoom(ctx, room, memberIds))
 	}
-	return docs, nil
+	return errors.Join(errs...)
 }
 
-func (u *realtimeChatAdapter) CreateRoom(ctx context.Context, roomUserId string, participants []string) error {
-	roomId := ulid.Make().String()
-
-	_, err := u.firestoreClient.
-		Collection("rooms").
-		Doc(roomId).
-		Set(ctx, map[string]interface{}{
-			"roomId":       roomId,
-			"roomUserId":   roomUserId,
-			"participants": participants,
-			"createdAt":    firestore.ServerTimestamp,
-		})
-
-	if err != nil {
-		return fmt.Errorf("failed to create a firestore room: %w", err)
+func (a *realtimeChatAdapter) SendMessage(ctx context.Context, message *entity.ChatMessage, memberIds []string) error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.SendMessage(ctx, message, memberIds))
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
-func (u *realtimeChatAdapter) SendMessage(ctx context.Context, roomId, senderId, safeMessage string) error {
-	_, _, err := u.firestoreClient.
-		Collection("rooms").
-		Doc(roomId).
-		Collection("messages").
-		Add(ctx, map[string]interface{}{
-			"senderId":  senderId,
-			"message":   safeMessage,
-			"timestamp": firestore.ServerTimestamp,
-		})
-	if err != nil {
-		return fmt.Errorf("failed to send message: %w", err)
+func (a *realtimeChatAdapter) MarkRead(ctx context.Context, member *entity.ChatRoomMember, memberIds []string) error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.MarkRead(ctx, member, memberIds))
 	}
-	return nil
+	return errors.Join(errs...)
 }
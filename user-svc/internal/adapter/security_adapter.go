@@ -3,7 +3,9 @@ package adapter
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 	"log"
@@ -17,6 +19,7 @@ import (
 type SecurityAdapter interface {
 	Encrypt(plaintext string) (string, error)
 	Decrypt(ciphertext string) (string, error)
+	Hash(value string) string
 }
 
 type securityAdapter struct {
@@ -89,6 +92,14 @@ func (c *securityAdapter) Decrypt(ciphertext string) (string, error) {
 	return string(ciphertextBytes), nil
 }
 
+// Hash returns a keyed SHA-256 digest, used for short lived secrets such as OTP
+// codes that only ever need to be compared and never read back.
+func (c *securityAdapter) Hash(value string) string {
+	mac := hmac.New(sha256.New, c.keySecret)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 // TO DO SANITATOR
 func (c *securityAdapter) SanitiseStruct(input interface{}) {
 	val := reflect.ValueOf(input)
@@ -0,0 +1,102 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+)
+
+type SmsAdapter interface {
+	SendOTP(ctx context.Context, phoneNumber, code string, ttl time.Duration) error
+	SendNotice(ctx context.Context, phoneNumber, message string) error
+}
+
+// NewSmsAdapter picks the delivery provider from SMS_PROVIDER. Anything other
+// than "whatsapp" falls back to the local fake, which only logs the code.
+func NewSmsAdapter(logs logger.Log) SmsAdapter {
+	switch utils.GetEnv("SMS_PROVIDER") {
+	case "whatsapp":
+		return &whatsAppSmsAdapter{
+			apiUrl:     utils.GetEnv("SMS_API_URL"),
+			apiToken:   utils.GetEnv("SMS_API_TOKEN"),
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+		}
+	default:
+		return &fakeSmsAdapter{
+			logs: logs,
+		}
+	}
+}
+
+type whatsAppSmsAdapter struct {
+	apiUrl     string
+	apiToken   string
+	httpClient *http.Client
+}
+
+type whatsAppMessageRequest struct {
+	Target  string `json:"target"`
+	Message string `json:"message"`
+}
+
+func (a *whatsAppSmsAdapter) SendOTP(ctx context.Context, phoneNumber, code string, ttl time.Duration) error {
+	message := fmt.Sprintf("Your YourMoments verification code is %s. It expires in %d minutes. Never share this code with anyone.",
+		code, int(ttl.Minutes()))
+
+	return a.send(ctx, phoneNumber, message)
+}
+
+func (a *whatsAppSmsAdapter) SendNotice(ctx context.Context, phoneNumber, message string) error {
+	return a.send(ctx, phoneNumber, message)
+}
+
+func (a *whatsAppSmsAdapter) send(ctx context.Context, phoneNumber, message string) error {
+	bodyBytes, err := json.Marshal(&whatsAppMessageRequest{
+		Target:  phoneNumber,
+		Message: message,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal whatsapp message : %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.apiUrl, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return fmt.Errorf("create whatsapp request : %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", a.apiToken)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send whatsapp message : %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		respBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("whatsapp provider responded with status %d : %s", resp.StatusCode, string(respBytes))
+	}
+
+	return nil
+}
+
+type fakeSmsAdapter struct {
+	logs logger.Log
+}
+
+func (a *fakeSmsAdapter) SendOTP(ctx context.Context, phoneNumber, code string, ttl time.Duration) error {
+	a.logs.Log(fmt.Sprintf("[fake sms] otp for %s is %s (valid for %s)", phoneNumber, code, ttl))
+	return nil
+}
+
+func (a *fakeSmsAdapter) SendNotice(ctx context.Context, phoneNumber, message string) error {
+	a.logs.Log(fmt.Sprintf("[fake sms] notice for %s : %s", phoneNumber, message))
+	return nil
+}
@@ -0,0 +1,100 @@
+package adapter
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
+)
+
+// RFC 6238 parameters, they are the defaults every authenticator app understands
+const (
+	totpPeriod     = 30
+	totpDigits     = 6
+	totpSkewSteps  = 1
+	totpSecretSize = 20
+)
+
+type TOTPAdapter interface {
+	GenerateSecret() (string, error)
+	KeyURI(accountName, secret string) string
+	Validate(secret, code string, at time.Time) bool
+}
+
+type totpAdapter struct {
+	issuer string
+}
+
+func NewTOTPAdapter() TOTPAdapter {
+	issuer := utils.GetEnv("TOTP_ISSUER")
+	if issuer == "" {
+		issuer = "YourMoments"
+	}
+
+	return &totpAdapter{
+		issuer: issuer,
+	}
+}
+
+func (a *totpAdapter) GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret), nil
+}
+
+// KeyURI returns the otpauth URI rendered as QR code by the client
+func (a *totpAdapter) KeyURI(accountName, secret string) string {
+	label := url.PathEscape(a.issuer + ":" + accountName)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", a.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+func (a *totpAdapter) Validate(secret, code string, at time.Time) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return false
+	}
+
+	counter := at.Unix() / totpPeriod
+	for step := -totpSkewSteps; step <= totpSkewSteps; step++ {
+		if hmac.Equal([]byte(generateTOTPCode(key, uint64(counter+int64(step)))), []byte(code)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func generateTOTPCode(key []byte, counter uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
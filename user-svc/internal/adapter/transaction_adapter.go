@@ -8,6 +8,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	discovery "github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/discovery"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 
 	transcationpb "github.com/hervibest/be-yourmoments-backup/pb/transaction"
 )
@@ -15,6 +16,7 @@ import (
 type TransactionAdapter interface {
 	CreateWallet(ctx context.Context, creatorId string) (*entity.Wallet, error)
 	GetWallet(ctx context.Context, creatorId string) (*entity.Wallet, error)
+	GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchiveTransaction, []*model.UserDataArchiveReview, error)
 }
 
 type transactionAdapter struct {
@@ -75,3 +77,45 @@ func (a *transactionAdapter) GetWallet(ctx context.Context, creatorId string) (*
 
 	return wallet, nil
 }
+
+// GetUserDataExport returns the transactions and reviews of the user for the data export
+func (a *transactionAdapter) GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchiveTransaction, []*model.UserDataArchiveReview, error) {
+	pbRequest := &transcationpb.GetUserDataExportRequest{
+		UserId: userId,
+	}
+
+	pbResponse, err := a.client.GetUserDataExport(ctx, pbRequest)
+	if err != nil {
+		return nil, nil, helper.FromGRPCError(err)
+	}
+
+	transactions := make([]*model.UserDataArchiveTransaction, 0, len(pbResponse.GetTransactions()))
+	for _, transaction := range pbResponse.GetTransactions() {
+		transactions = append(transactions, &model.UserDataArchiveTransaction{
+			Id:         transaction.GetId(),
+			Status:     transaction.GetStatus(),
+			Amount:     transaction.GetAmount(),
+			PhotoIds:   transaction.GetPhotoIds(),
+			CheckoutAt: timestampToTime(transaction.GetCheckoutAt()),
+			PaymentAt:  timestampToTime(transaction.GetPaymentAt()),
+			CreatedAt:  timestampToTime(transaction.GetCreatedAt()),
+		})
+	}
+
+	reviews := make([]*model.UserDataArchiveReview, 0, len(pbResponse.GetReviews()))
+	for _, review := range pbResponse.GetReviews() {
+		archiveReview := &model.UserDataArchiveReview{
+			Id:        review.GetId(),
+			CreatorId: review.GetCreatorId(),
+			Rating:    review.GetRating(),
+			CreatedAt: timestampToTime(review.GetCreatedAt()),
+		}
+		if review.GetComment() != "" {
+			comment := review.GetComment()
+			archiveReview.Comment = &comment
+		}
+		reviews = append(reviews, archiveReview)
+	}
+
+	return transactions, reviews, nil
+}
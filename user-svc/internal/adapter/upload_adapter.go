@@ -3,6 +3,7 @@ package adapter
 import (
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"time"
 
@@ -20,6 +21,9 @@ type UploadAdapter interface {
 	DeleteFile(ctx context.Context, fileName string) (bool, error)
 	DeletePublicFile(ctx context.Context, fileName string) (bool, error)
 	GetPresignedUrl(ctx context.Context, fileKey string) (string, error)
+	PutObject(ctx context.Context, fileKey string, reader io.Reader, size int64, contentType string) error
+	GetPresignedUrlWithExpiry(ctx context.Context, fileKey string, expiry time.Duration) (string, error)
+	StatFile(ctx context.Context, fileKey string) (*model.MinioFileResponse, error)
 }
 
 type uploadAdapter struct {
@@ -139,3 +143,45 @@ func (a *uploadAdapter) UploadPublicFile(ctx context.Context, file *multipart.Fi
 
 	return fileResponse, nil
 }
+
+// PutObject stores generated content in the private bucket
+func (a *uploadAdapter) PutObject(ctx context.Context, fileKey string, reader io.Reader, size int64, contentType string) error {
+	_, err := a.minio.MinioClient.PutObject(ctx, a.minio.GetBucketName(), fileKey, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
+// GetPresignedUrlWithExpiry is not cached, it is meant for links handed out once such
+// as the ones sent by email
+func (a *uploadAdapter) GetPresignedUrlWithExpiry(ctx context.Context, fileKey string, expiry time.Duration) (string, error) {
+	url, err := a.minio.MinioClient.PresignedGetObject(ctx, a.minio.GetBucketName(), fileKey, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("minio client error presigned object : %+v", err)
+	}
+
+	return url.String(), nil
+}
+
+// StatFile returns nil without an error when the private bucket has no such file
+func (a *uploadAdapter) StatFile(ctx context.Context, fileKey string) (*model.MinioFileResponse, error) {
+	info, err := a.minio.MinioClient.StatObject(ctx, a.minio.GetBucketName(), fileKey, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("minio client error stat object : %+v", err)
+	}
+
+	return &model.MinioFileResponse{
+		ETag:      info.ETag,
+		FileKey:   info.Key,
+		Mimetype:  info.ContentType,
+		Size:      info.Size,
+		UpdatedAt: info.LastModified,
+	}, nil
+}
@@ -1,6 +1,11 @@
 package adapter
 
-import "crypto/rand"
+import (
+	"crypto/rand"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 func RandomNumber(numLength int) string {
 	token := make([]byte, numLength)
@@ -14,3 +19,13 @@ func RandomNumber(numLength int) string {
 	}
 	return string(token)
 }
+
+// timestampToTime keeps unset timestamps nil instead of turning them into the unix epoch
+func timestampToTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	value := timestamp.AsTime()
+	return &value
+}
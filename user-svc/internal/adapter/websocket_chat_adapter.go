@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/redis/go-redis/v9"
+)
+
+// ChatEventChannel is the redis channel every instance of the websocket gateway listens to, a member may be connected
+// to any instance
+const ChatEventChannel = "chat:events"
+
+type webSocketChatAdapter struct {
+	redisClient *redis.Client
+}
+
+func NewWebSocketChatAdapter(redisClient *redis.Client) RealtimeChatAdapter {
+	return &webSocketChatAdapter{
+		redisClient: redisClient,
+	}
+}
+
+// CreateChatRoom does nothing, user profiles are only mirrored to Firestore
+func (a *webSocketChatAdapter) CreateChatRoom(ctx context.Context, user *entity.User, userProfile *entity.UserProfile) {
+}
+
+// FindRoomId finds nothing, the gateway keeps no rooms
+func (a *webSocketChatAdapter) FindRoomId(ctx context.Context, roomUserId string) (string, error) {
+	return "", nil
+}
+
+func (a *webSocketChatAdapter) CreateRoom(ctx context.Context, room *entity.ChatRoom, memberIds []string) error {
+	return a.publish(ctx, memberIds, &model.ChatEvent{
+		Type: enum.ChatEventRoomCreated,
+		Data: &model.GetOrCreateRoomResponse{RoomId: room.Id, Created: true},
+	})
+}
+
+func (a *webSocketChatAdapter) SendMessage(ctx context.Context, message *entity.ChatMessage, memberIds []string) error {
+	return a.publish(ctx, memberIds, &model.ChatEvent{
+		Type: enum.ChatEventMessageCreated,
+		Data: converter.ChatMessageToResponse(message),
+	})
+}
+
+func (a *webSocketChatAdapter) MarkRead(ctx context.Context, member *entity.ChatRoomMember, memberIds []string) error {
+	return a.publish(ctx, memberIds, &model.ChatEvent{
+		Type: enum.ChatEventRoomRead,
+		Data: converter.ChatRoomMemberToReadResponse(member),
+	})
+}
+
+func (a *webSocketChatAdapter) publish(ctx context.Context, memberIds []string, event *model.ChatEvent) error {
+	eventBytes, err := sonic.ConfigFastest.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chat event: %w", err)
+	}
+
+	envelopeBytes, err := sonic.ConfigFastest.Marshal(&model.ChatEventEnvelope{MemberIds: memberIds, Event: eventBytes})
+	if err != nil {
+		return fmt.Errorf("failed to marshal chat event envelope: %w", err)
+	}
+
+	if err := a.redisClient.Publish(ctx, ChatEventChannel, envelopeBytes).Err(); err != nil {
+		return fmt.Errorf("failed to publish chat event: %w", err)
+	}
+	return nil
+}
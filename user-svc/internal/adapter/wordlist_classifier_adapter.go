@@ -0,0 +1,101 @@
+package adapter
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+)
+
+// defaultBlockedWords covers the common english and indonesian profanity, a deployment adds its own words through the
+// word list file
+var defaultBlockedWords = []string{
+	"fuck", "shit", "bitch", "bastard", "asshole", "cunt", "motherfucker",
+	"anjing", "bangsat", "bajingan", "kontol", "memek", "ngentot", "goblok", "tolol", "jancok", "keparat",
+}
+
+// leetReplacer undoes the usual character swaps before matching
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s", "!", "i")
+
+// wordlistClassifier is cruder than perspective but needs no network, so it stays available as the fallback. Every
+// word is matched at the start of a word with its letters allowed to repeat, so fuuuck and fucking are caught while
+// words that merely contain a blocked word in the middle are not.
+type wordlistClassifier struct {
+	patterns []*regexp.Regexp
+}
+
+func NewWordlistClassifier(path string, logs logger.Log) ModerationClassifier {
+	words := append([]string{}, defaultBlockedWords...)
+	if path != "" {
+		fileWords, err := readWordlist(path)
+		if err != nil {
+			logs.CustomError("failed to read chat moderation word list, using the built in words only", err)
+		}
+		words = append(words, fileWords...)
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(words))
+	for _, word := range words {
+		if pattern := wordPattern(word); pattern != nil {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return &wordlistClassifier{
+		patterns: patterns,
+	}
+}
+
+func (c *wordlistClassifier) IsToxicMessage(msg string) (bool, error) {
+	normalized := leetReplacer.Replace(strings.ToLower(msg))
+	for _, pattern := range c.patterns {
+		if pattern.MatchString(normalized) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// wordPattern turns fuck into \bf+u+c+k+, a word with anything but letters is skipped
+func wordPattern(word string) *regexp.Regexp {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" {
+		return nil
+	}
+
+	var builder strings.Builder
+	builder.WriteString(`\b`)
+	for _, letter := range word {
+		if letter < 'a' || letter > 'z' {
+			return nil
+		}
+		builder.WriteRune(letter)
+		builder.WriteString("+")
+	}
+
+	return regexp.MustCompile(builder.String())
+}
+
+// readWordlist reads one word per line, empty lines and lines starting with # are ignored
+func readWordlist(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	words := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+
+	return words, scanner.Err()
+}
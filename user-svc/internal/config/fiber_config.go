@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/utils"
 
@@ -11,13 +12,19 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 )
 
+// NewApp only reads the client ip from X-Forwarded-For when the request comes from TRUSTED_PROXIES (the ingress),
+// a request sent straight to the pod is identified by its remote address so the ip rate limit cannot be dodged
 func NewApp() *fiber.App {
 	app := fiber.New(fiber.Config{
-		Prefork:      false,
-		AppName:      utils.GetEnv("SERVICE_NAME"),
-		ErrorHandler: CustomError(),
-		JSONEncoder:  sonic.ConfigStd.Marshal,
-		JSONDecoder:  sonic.ConfigStd.Unmarshal,
+		Prefork:                 false,
+		AppName:                 utils.GetEnv("SERVICE_NAME"),
+		ErrorHandler:            CustomError(),
+		JSONEncoder:             sonic.ConfigStd.Marshal,
+		JSONDecoder:             sonic.ConfigStd.Unmarshal,
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          trustedProxies(),
+		ProxyHeader:             fiber.HeaderXForwardedFor,
+		EnableIPValidation:      true,
 	})
 
 	app.Use(cors.New(cors.Config{
@@ -28,6 +35,16 @@ func NewApp() *fiber.App {
 	return app
 }
 
+func trustedProxies() []string {
+	proxies := []string{}
+	for _, proxy := range strings.Split(utils.GetEnv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
 func CustomError() fiber.ErrorHandler {
 	return func(ctx *fiber.Ctx, err error) error {
 		code := http.StatusInternalServerError
@@ -0,0 +1,34 @@
+package consumer
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/nats-io/nats.go"
+)
+
+// TransactionConsumer durable names are prefixed with the service, photo-svc consumes the same stream
+type TransactionConsumer struct {
+	chatUseCase  usecase.ChatUseCase
+	js           nats.JetStreamContext
+	logs         logger.Log
+	subjects     []string
+	durableNames map[string]string
+}
+
+func NewTransactionConsumer(
+	chatUseCase usecase.ChatUseCase,
+	js nats.JetStreamContext,
+	logs logger.Log,
+) *TransactionConsumer {
+	return &TransactionConsumer{
+		chatUseCase: chatUseCase,
+		js:          js,
+		logs:        logs,
+		subjects: []string{
+			"transaction.settled",
+		},
+		durableNames: map[string]string{
+			"transaction.settled": "user_svc_transaction_settled_consumer",
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bytedance/sonic"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/event"
+	"github.com/nats-io/nats.go"
+)
+
+func (s *TransactionConsumer) setupConsumer(subject string) error {
+	consumerConfig := &nats.ConsumerConfig{
+		Durable:       s.durableNames[subject],
+		AckPolicy:     nats.AckExplicitPolicy,
+		MaxDeliver:    5,
+		BackOff:       []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second},
+		DeliverPolicy: nats.DeliverAllPolicy,
+		AckWait:       30 * time.Second,
+		FilterSubject: subject,
+	}
+
+	_, err := s.js.AddConsumer("TRANSACTION_STREAM", consumerConfig)
+	return err
+}
+
+func (s *TransactionConsumer) handleMessage(ctx context.Context, msg *nats.Msg) {
+	switch msg.Subject {
+	case "transaction.settled":
+		event := new(event.OwnerOwnPhotosEvent)
+		if err := sonic.ConfigStd.Unmarshal(msg.Data, event); err != nil {
+			_ = msg.Nak()
+			s.logs.Error(fmt.Sprintf("failed to unmarshal message : %s", err))
+			return
+		}
+
+		if err := s.chatUseCase.NotifyTransactionPaid(ctx, event); err != nil {
+			s.handleError(msg, err)
+			return
+		}
+
+	default:
+		s.logs.Error(fmt.Sprintf("unknown subject: %s", msg.Subject))
+	}
+
+	if err := msg.Ack(); err != nil {
+		s.logs.Error(fmt.Sprintf("failed to ACK message : %s", err))
+	}
+}
+
+func (s *TransactionConsumer) handleError(msg *nats.Msg, err error) {
+	appErr, ok := err.(*helper.AppError)
+	if !ok {
+		appErr = &helper.AppError{Code: errorcode.ErrInternal}
+	}
+
+	switch appErr.Code {
+	case errorcode.ErrInvalidArgument:
+		s.logs.Error(fmt.Sprintf("invalid to ACK message : %s", err))
+		_ = msg.Term()
+	default:
+		if err := msg.NakWithDelay(10 * time.Second); err != nil {
+			s.logs.Error(fmt.Sprintf("failed to NAK message : %s", err))
+		}
+	}
+}
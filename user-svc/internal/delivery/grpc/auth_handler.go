@@ -34,6 +34,14 @@ func (h *UserGRPCHandler) Authenticate(ctx context.Context,
 		Similarity:  uint32(response.Similarity),
 		CreatorId:   response.CreatorId,
 		WalletId:    response.WalletId,
+		Roles:       response.Roles,
+		Permissions: response.Permissions,
+
+		TwoFactorEnabled: response.TwoFactorEnabled,
+	}
+
+	if response.TwoFactorVerifiedAt != nil {
+		userPb.TwoFactorVerifiedAt = response.TwoFactorVerifiedAt.Unix()
 	}
 
 	return &userpb.AuthenticateResponse{
@@ -8,15 +8,18 @@ import (
 )
 
 type UserGRPCHandler struct {
-	authUseCase         usecase.AuthUseCase
-	notificationUseCase usecase.NotificationUseCase
+	authUseCase  usecase.AuthUseCase
+	userUseCase  usecase.UserUseCase
+	adminUseCase usecase.AdminUseCase
 	userpb.UnimplementedUserServiceServer
 }
 
-func NewUserGRPCHandler(server *grpc.Server, authUseCase usecase.AuthUseCase, notificationUseCase usecase.NotificationUseCase) {
+func NewUserGRPCHandler(server *grpc.Server, authUseCase usecase.AuthUseCase, userUseCase usecase.UserUseCase,
+	adminUseCase usecase.AdminUseCase) {
 	handler := &UserGRPCHandler{
-		authUseCase:         authUseCase,
-		notificationUseCase: notificationUseCase,
+		authUseCase:  authUseCase,
+		userUseCase:  userUseCase,
+		adminUseCase: adminUseCase,
 	}
 
 	userpb.RegisterUserServiceServer(server, handler)
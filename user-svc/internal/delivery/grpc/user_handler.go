@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+
+	userpb "github.com/hervibest/be-yourmoments-backup/pb/user"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (h *UserGRPCHandler) GetPublicUserProfile(ctx context.Context,
+	pbReq *userpb.GetPublicUserProfileRequest) (*userpb.GetPublicUserProfileResponse, error) {
+
+	log.Println("---- Get Public User Profile via gRPC in user-svc ------")
+
+	response, err := h.userUseCase.GetPublicUserProfile(ctx, pbReq.GetUserId())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	profilePb := &userpb.PublicUserProfile{
+		UserId:   response.UserId,
+		Username: response.Username,
+		Nickname: response.Nickname,
+	}
+
+	if response.Biography != nil {
+		profilePb.Biography = *response.Biography
+	}
+
+	if response.ProfileUrl != nil {
+		profilePb.ProfileUrl = *response.ProfileUrl
+	}
+
+	if response.ProfileCoverUrl != nil {
+		profilePb.ProfileCoverUrl = *response.ProfileCoverUrl
+	}
+
+	for _, socialLink := range response.SocialLinks {
+		socialLinkPb := &userpb.SocialLink{
+			SocialMediaId: socialLink.SocialMediaId,
+			Name:          socialLink.Name,
+			Handle:        socialLink.Handle,
+			Url:           socialLink.Url,
+		}
+
+		if socialLink.LogoUrl != nil {
+			socialLinkPb.LogoUrl = *socialLink.LogoUrl
+		}
+
+		profilePb.SocialLinks = append(profilePb.SocialLinks, socialLinkPb)
+	}
+
+	return &userpb.GetPublicUserProfileResponse{
+		Status:  int64(codes.OK),
+		Profile: profilePb,
+	}, nil
+}
+
+func (h *UserGRPCHandler) GetUserContacts(ctx context.Context,
+	pbReq *userpb.GetUserContactsRequest) (*userpb.GetUserContactsResponse, error) {
+
+	log.Println("---- Get User Contacts via gRPC in user-svc ------")
+
+	response, err := h.userUseCase.GetUserContacts(ctx, pbReq.GetUserIds())
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	contactsPb := make([]*userpb.UserContact, 0, len(response))
+	for _, contact := range response {
+		contactsPb = append(contactsPb, &userpb.UserContact{
+			UserId:   contact.UserId,
+			Username: contact.Username,
+			Email:    contact.Email,
+		})
+	}
+
+	return &userpb.GetUserContactsResponse{
+		Status:   int64(codes.OK),
+		Contacts: contactsPb,
+	}, nil
+}
+
+func (h *UserGRPCHandler) ListSegmentUserIds(ctx context.Context,
+	pbReq *userpb.ListSegmentUserIdsRequest) (*userpb.ListSegmentUserIdsResponse, error) {
+
+	log.Println("---- List Segment User Ids via gRPC in user-svc ------")
+
+	request := &model.ListSegmentUserIdsRequest{
+		Segment:      enum.AudienceSegmentEnum(pbReq.GetSegment()),
+		InactiveDays: int(pbReq.GetInactiveDays()),
+		AfterUserId:  pbReq.GetAfterUserId(),
+		Limit:        int(pbReq.GetLimit()),
+	}
+
+	userIds, err := h.userUseCase.ListSegmentUserIds(ctx, request)
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &userpb.ListSegmentUserIdsResponse{
+		Status:  int64(codes.OK),
+		UserIds: userIds,
+	}, nil
+}
+
+func (h *UserGRPCHandler) SetUserRole(ctx context.Context,
+	pbReq *userpb.SetUserRoleRequest) (*userpb.SetUserRoleResponse, error) {
+
+	log.Println("---- Set User Role via gRPC in user-svc ------")
+
+	request := &model.UserRoleRequest{
+		UserId: pbReq.GetUserId(),
+		Role:   pbReq.GetRole(),
+	}
+
+	var err error
+	if pbReq.GetGranted() {
+		_, err = h.adminUseCase.GrantUserRole(ctx, request)
+	} else {
+		_, err = h.adminUseCase.RevokeUserRole(ctx, request)
+	}
+	if err != nil {
+		return nil, helper.ErrGRPC(err)
+	}
+
+	return &userpb.SetUserRoleResponse{
+		Status: int64(codes.OK),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AccountController interface {
+	RequestDeletion(ctx *fiber.Ctx) error
+	CancelDeletion(ctx *fiber.Ctx) error
+	GetDeletionStatus(ctx *fiber.Ctx) error
+	RequestDataExport(ctx *fiber.Ctx) error
+	GetDataExport(ctx *fiber.Ctx) error
+}
+
+type accountController struct {
+	accountUseCase  usecase.AccountUseCase
+	customValidator helper.CustomValidator
+	logs            logger.Log
+}
+
+func NewAccountController(accountUseCase usecase.AccountUseCase, customValidator helper.CustomValidator, logs logger.Log) AccountController {
+	return &accountController{accountUseCase: accountUseCase, customValidator: customValidator, logs: logs}
+}
+
+func (c *accountController) RequestDeletion(ctx *fiber.Ctx) error {
+	request := new(model.RequestAccountDeletionRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.accountUseCase.RequestDeletion(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request account deletion : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AccountDeletionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *accountController) CancelDeletion(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.accountUseCase.CancelDeletion(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Cancel account deletion : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AccountDeletionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *accountController) GetDeletionStatus(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.accountUseCase.GetDeletionStatus(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get account deletion status : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AccountDeletionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *accountController) RequestDataExport(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.accountUseCase.RequestDataExport(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request data export : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusAccepted).JSON(model.WebResponse[*model.DataExportResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *accountController) GetDataExport(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.GetDataExportRequest{
+		UserId:   auth.UserId,
+		ExportId: ctx.Params("exportId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.accountUseCase.GetDataExport(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get data export : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.DataExportResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -0,0 +1,264 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AdminController interface {
+	CreateSocialMedia(ctx *fiber.Ctx) error
+	GetSocialMedia(ctx *fiber.Ctx) error
+	GetAllSocialMedia(ctx *fiber.Ctx) error
+	UpdateSocialMedia(ctx *fiber.Ctx) error
+	DeleteSocialMedia(ctx *fiber.Ctx) error
+
+	SuspendUser(ctx *fiber.Ctx) error
+	UnsuspendUser(ctx *fiber.Ctx) error
+	GrantUserRole(ctx *fiber.Ctx) error
+	RevokeUserRole(ctx *fiber.Ctx) error
+
+	GetChatReports(ctx *fiber.Ctx) error
+	GetChatReport(ctx *fiber.Ctx) error
+	ReviewChatReport(ctx *fiber.Ctx) error
+}
+
+type adminController struct {
+	adminUseCase    usecase.AdminUseCase
+	customValidator helper.CustomValidator
+	logs            logger.Log
+}
+
+func NewAdminController(adminUseCase usecase.AdminUseCase, customValidator helper.CustomValidator, logs logger.Log) AdminController {
+	return &adminController{adminUseCase: adminUseCase, customValidator: customValidator, logs: logs}
+}
+
+func (c *adminController) CreateSocialMedia(ctx *fiber.Ctx) error {
+	request := new(model.CreateSocialMediaRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.CreateSocialMedia(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Create social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.SocialMediaResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) GetSocialMedia(ctx *fiber.Ctx) error {
+	response, err := c.adminUseCase.GetSocialMedia(ctx.UserContext(), ctx.Params("socialMediaId"))
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.SocialMediaResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) GetAllSocialMedia(ctx *fiber.Ctx) error {
+	response, err := c.adminUseCase.GetAllSocialMedia(ctx.UserContext())
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get all social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.SocialMediaResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) UpdateSocialMedia(ctx *fiber.Ctx) error {
+	request := new(model.UpdateSocialMediaRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.Id = ctx.Params("socialMediaId")
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.UpdateSocialMedia(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Update social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.SocialMediaResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) DeleteSocialMedia(ctx *fiber.Ctx) error {
+	if err := c.adminUseCase.DeleteSocialMedia(ctx.UserContext(), ctx.Params("socialMediaId")); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Delete social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *adminController) SuspendUser(ctx *fiber.Ctx) error {
+	request := new(model.SuspendUserRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.AdminId = middleware.GetUser(ctx).UserId
+	request.UserId = ctx.Params("userId")
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.SuspendUser(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Suspend user : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AdminUserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) UnsuspendUser(ctx *fiber.Ctx) error {
+	request := &model.UnsuspendUserRequest{UserId: ctx.Params("userId")}
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.UnsuspendUser(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Unsuspend user : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AdminUserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) GrantUserRole(ctx *fiber.Ctx) error {
+	request := new(model.UserRoleRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.AdminId = middleware.GetUser(ctx).UserId
+	request.UserId = ctx.Params("userId")
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.GrantUserRole(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Grant user role : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AdminUserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) RevokeUserRole(ctx *fiber.Ctx) error {
+	request := &model.UserRoleRequest{
+		AdminId: middleware.GetUser(ctx).UserId,
+		UserId:  ctx.Params("userId"),
+		Role:    ctx.Params("role"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.RevokeUserRole(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Revoke user role : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.AdminUserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) GetChatReports(ctx *fiber.Ctx) error {
+	request := &model.GetChatReportsRequest{
+		Status: enum.ChatReportStatusEnum(ctx.Query("status")),
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.adminUseCase.GetChatReports(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get chat reports : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.ChatReportResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *adminController) GetChatReport(ctx *fiber.Ctx) error {
+	response, err := c.adminUseCase.GetChatReport(ctx.UserContext(), ctx.Params("reportId"))
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get chat report : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ChatReportResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *adminController) ReviewChatReport(ctx *fiber.Ctx) error {
+	request := new(model.ReviewChatReportRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.ReviewerId = middleware.GetUser(ctx).UserId
+	request.ReportId = ctx.Params("reportId")
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.adminUseCase.ReviewChatReport(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Review chat report : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ChatReportResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
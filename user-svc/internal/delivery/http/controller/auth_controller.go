@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/message"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
@@ -21,7 +22,7 @@ type AuthController interface {
 	Login(ctx *fiber.Ctx) error
 	Logout(ctx *fiber.Ctx) error
 	RegisterByEmail(ctx *fiber.Ctx) error
-	RegisterOrLoginByGoogle(ctx *fiber.Ctx) error
+	RegisterOrLoginByProvider(ctx *fiber.Ctx) error
 	RegisterByPhoneNumber(ctx *fiber.Ctx) error
 	RequestAccessToken(ctx *fiber.Ctx) error
 	RequestResetPassword(ctx *fiber.Ctx) error
@@ -29,6 +30,19 @@ type AuthController interface {
 	ResetPassword(ctx *fiber.Ctx) error
 	ValidateResetPassword(ctx *fiber.Ctx) error
 	VerifyEmail(ctx *fiber.Ctx) error
+	RequestPhoneOTP(ctx *fiber.Ctx) error
+	VerifyPhoneNumber(ctx *fiber.Ctx) error
+	LoginByOTP(ctx *fiber.Ctx) error
+	LoginByTwoFactor(ctx *fiber.Ctx) error
+	ListSessions(ctx *fiber.Ctx) error
+	RevokeSession(ctx *fiber.Ctx) error
+	RevokeAllSessions(ctx *fiber.Ctx) error
+	ChangeUsername(ctx *fiber.Ctx) error
+	RequestReauthOTP(ctx *fiber.Ctx) error
+	RequestEmailChange(ctx *fiber.Ctx) error
+	ConfirmEmailChange(ctx *fiber.Ctx) error
+	RequestPhoneChange(ctx *fiber.Ctx) error
+	ConfirmPhoneChange(ctx *fiber.Ctx) error
 }
 
 type authController struct {
@@ -73,19 +87,30 @@ func (c *authController) RegisterByPhoneNumber(ctx *fiber.Ctx) error {
 	})
 }
 
-func (c *authController) RegisterOrLoginByGoogle(ctx *fiber.Ctx) error {
-	request := new(model.RegisterByGoogleRequest)
+// RegisterOrLoginByProvider serves both /register/google and /oauth/:provider, the
+// former predates the provider param and defaults to google.
+func (c *authController) RegisterOrLoginByProvider(ctx *fiber.Ctx) error {
+	request := new(model.RegisterByProviderRequest)
 	if err := helper.StrictBodyParser(ctx, request); err != nil {
 		return helper.ErrBodyParserResponseJSON(ctx, err)
 	}
 
+	provider, ok := enum.ParseIdentityProvider(ctx.Params("provider", string(enum.IdentityProviderGoogle)))
+	if !ok {
+		return helper.ErrBodyResponseJSON(ctx, "Unsupported identity provider")
+	}
+	request.Provider = provider
+
+	request.IpAddress = ctx.IP()
+	request.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+
 	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
 		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
 	}
 
-	response, token, err := c.authUseCase.RegisterOrLoginByGoogle(ctx.Context(), request)
+	response, token, err := c.authUseCase.RegisterOrLoginByProvider(ctx.Context(), request)
 	if err != nil {
-		return helper.ErrUseCaseResponseJSON(ctx, "Register by google sign in error : ", err, c.logs)
+		return helper.ErrUseCaseResponseJSON(ctx, "Register by provider sign in error : ", err, c.logs)
 	}
 
 	responses := map[string]interface{}{
@@ -165,6 +190,75 @@ func (c *authController) VerifyEmail(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *authController) RequestPhoneOTP(ctx *fiber.Ctx) error {
+	request := new(model.RequestPhoneOTPRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.RequestPhoneOTP(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request phone otp error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.OTPResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) VerifyPhoneNumber(ctx *fiber.Ctx) error {
+	request := new(model.VerifyPhoneOTPRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.authUseCase.VerifyPhoneNumber(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Verify phone number : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *authController) LoginByOTP(ctx *fiber.Ctx) error {
+	request := new(model.LoginByOTPRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.IpAddress = ctx.IP()
+	request.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	userResponse, tokenResponse, err := c.authUseCase.LoginByOTP(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Login by otp error : ", err, c.logs)
+	}
+
+	response := map[string]interface{}{
+		"user":  userResponse,
+		"token": tokenResponse,
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+		Data:    response,
+	})
+}
+
 func (c *authController) RequestResetPassword(ctx *fiber.Ctx) error {
 	request := new(model.SendResetPasswordRequest)
 	if err := helper.StrictBodyParser(ctx, request); err != nil {
@@ -234,6 +328,9 @@ func (c *authController) Login(ctx *fiber.Ctx) error {
 		return helper.ErrBodyParserResponseJSON(ctx, err)
 	}
 
+	request.IpAddress = ctx.IP()
+	request.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+
 	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
 		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
 	}
@@ -296,11 +393,14 @@ func (c *authController) RequestAccessToken(ctx *fiber.Ctx) error {
 		return helper.ErrBodyParserResponseJSON(ctx, err)
 	}
 
+	request.IpAddress = ctx.IP()
+	request.UserAgent = ctx.Get(fiber.HeaderUserAgent)
+
 	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
 		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
 	}
 
-	userResponse, tokenResponse, err := c.authUseCase.AccessTokenRequest(ctx.Context(), request.RefreshToken)
+	userResponse, tokenResponse, err := c.authUseCase.AccessTokenRequest(ctx.Context(), request)
 	if err != nil {
 		return helper.ErrUseCaseResponseJSON(ctx, "Request access token error : ", err, c.logs)
 	}
@@ -325,6 +425,7 @@ func (c *authController) Logout(ctx *fiber.Ctx) error {
 	}
 
 	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
 	request.AccessToken = auth.Token
 	request.ExpiresAt = auth.ExpiresAt
 
@@ -340,3 +441,212 @@ func (c *authController) Logout(ctx *fiber.Ctx) error {
 		},
 	})
 }
+
+func (c *authController) LoginByTwoFactor(ctx *fiber.Ctx) error {
+	request := new(model.LoginByTwoFactorRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	userResponse, tokenResponse, err := c.authUseCase.LoginByTwoFactor(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Login by two factor error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+		Data: map[string]interface{}{
+			"user":  userResponse,
+			"token": tokenResponse,
+		},
+	})
+}
+
+func (c *authController) ListSessions(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	sessions, err := c.authUseCase.ListSessions(ctx.Context(), auth.UserId, auth.SessionId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "List sessions error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.SessionResponse]{
+		Success: true,
+		Data:    sessions,
+	})
+}
+
+func (c *authController) RevokeSession(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RevokeSessionRequest{
+		UserId:    auth.UserId,
+		SessionId: ctx.Params("sessionId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.authUseCase.RevokeSession(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Revoke session error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *authController) RevokeAllSessions(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	if err := c.authUseCase.RevokeAllSessions(ctx.Context(), auth.UserId); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Revoke all sessions error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *authController) ChangeUsername(ctx *fiber.Ctx) error {
+	request := new(model.ChangeUsernameRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.ChangeUsername(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Change username error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) RequestReauthOTP(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.authUseCase.RequestReauthOTP(ctx.Context(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request reauthentication otp error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.OTPResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) RequestEmailChange(ctx *fiber.Ctx) error {
+	request := new(model.RequestEmailChangeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.RequestEmailChange(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request email change error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.OTPResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) ConfirmEmailChange(ctx *fiber.Ctx) error {
+	request := new(model.ConfirmContactChangeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.ConfirmEmailChange(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Confirm email change error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) RequestPhoneChange(ctx *fiber.Ctx) error {
+	request := new(model.RequestPhoneChangeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.RequestPhoneChange(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Request phone number change error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.OTPResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *authController) ConfirmPhoneChange(ctx *fiber.Ctx) error {
+	request := new(model.ConfirmContactChangeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.authUseCase.ConfirmPhoneChange(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Confirm phone number change error : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
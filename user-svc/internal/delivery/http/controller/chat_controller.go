@@ -16,6 +16,15 @@ type ChatController interface {
 	GetCustomToken(ctx *fiber.Ctx) error
 	GetOrCreateRoom(ctx *fiber.Ctx) error
 	SendMessage(ctx *fiber.Ctx) error
+	GetRooms(ctx *fiber.Ctx) error
+	GetMessages(ctx *fiber.Ctx) error
+	MarkRead(ctx *fiber.Ctx) error
+	CountUnread(ctx *fiber.Ctx) error
+	GetMessageImage(ctx *fiber.Ctx) error
+	BlockUser(ctx *fiber.Ctx) error
+	UnblockUser(ctx *fiber.Ctx) error
+	GetBlockedUsers(ctx *fiber.Ctx) error
+	ReportChat(ctx *fiber.Ctx) error
 }
 
 type chatController struct {
@@ -86,11 +95,230 @@ func (c *chatController) SendMessage(ctx *fiber.Ctx) error {
 		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
 	}
 
-	if err := c.chatUseCase.SendMessage(ctx.Context(), request); err != nil {
+	response, err := c.chatUseCase.SendMessage(ctx.Context(), request)
+	if err != nil {
 		return helper.ErrUseCaseResponseJSON(ctx, "Send message : ", err, c.logs)
 	}
 
-	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.GetOrCreateRoomResponse]{
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.ChatMessageResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *chatController) GetRooms(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RequestGetChatRooms{
+		UserId: auth.UserId,
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.chatUseCase.GetRooms(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get chat rooms : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.ChatRoomResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *chatController) GetMessages(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RequestGetChatMessages{
+		UserId: auth.UserId,
+		RoomId: ctx.Params("roomId"),
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 30),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.chatUseCase.GetMessages(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get chat messages : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.ChatMessageResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *chatController) MarkRead(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := new(model.RequestMarkChatRead)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.UserId = auth.UserId
+	request.RoomId = ctx.Params("roomId")
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.chatUseCase.MarkRead(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Mark chat read : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ChatReadResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *chatController) CountUnread(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.chatUseCase.CountUnread(ctx.Context(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Count unread chat : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ChatUnreadCountResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetMessageImage redirects a room member to a short lived link of the image
+func (c *chatController) GetMessageImage(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RequestGetChatMessageImage{
+		UserId:    auth.UserId,
+		RoomId:    ctx.Params("roomId"),
+		MessageId: ctx.Params("messageId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	url, err := c.chatUseCase.GetMessageImageUrl(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get chat message image : ", err, c.logs)
+	}
+
+	return ctx.Redirect(url, http.StatusTemporaryRedirect)
+}
+
+func (c *chatController) BlockUser(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := new(model.RequestBlockUser)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.UserId = auth.UserId
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.chatUseCase.BlockUser(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Block user : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.BlockedUserResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *chatController) UnblockUser(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RequestUnblockUser{
+		UserId:        auth.UserId,
+		BlockedUserId: ctx.Params("userId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.chatUseCase.UnblockUser(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Unblock user : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
 		Success: true,
 	})
 }
+
+func (c *chatController) GetBlockedUsers(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := &model.RequestGetBlockedUsers{
+		UserId: auth.UserId,
+		Cursor: ctx.Query("cursor"),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.chatUseCase.GetBlockedUsers(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get blocked users : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.BlockedUserResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
+func (c *chatController) ReportChat(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	request := new(model.RequestReportChat)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	request.ReporterId = auth.UserId
+	request.RoomId = ctx.Params("roomId")
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.chatUseCase.ReportChat(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Report chat : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.ChatReportResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
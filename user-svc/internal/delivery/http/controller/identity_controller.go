@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IdentityController interface {
+	ListIdentities(ctx *fiber.Ctx) error
+	LinkIdentity(ctx *fiber.Ctx) error
+	UnlinkIdentity(ctx *fiber.Ctx) error
+}
+
+type identityController struct {
+	identityUseCase usecase.IdentityUseCase
+	customValidator helper.CustomValidator
+	logs            logger.Log
+}
+
+func NewIdentityController(identityUseCase usecase.IdentityUseCase, customValidator helper.CustomValidator, logs logger.Log) IdentityController {
+	return &identityController{identityUseCase: identityUseCase, customValidator: customValidator, logs: logs}
+}
+
+func (c *identityController) ListIdentities(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.identityUseCase.ListIdentities(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "List identities : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.UserIdentityResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *identityController) LinkIdentity(ctx *fiber.Ctx) error {
+	request := new(model.LinkIdentityRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	provider, ok := enum.ParseIdentityProvider(ctx.Params("provider"))
+	if !ok {
+		return helper.ErrBodyResponseJSON(ctx, "Unsupported identity provider")
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.Provider = provider
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.identityUseCase.LinkIdentity(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Link identity : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.UserIdentityResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *identityController) UnlinkIdentity(ctx *fiber.Ctx) error {
+	provider, ok := enum.ParseIdentityProvider(ctx.Params("provider"))
+	if !ok {
+		return helper.ErrBodyResponseJSON(ctx, "Unsupported identity provider")
+	}
+
+	auth := middleware.GetUser(ctx)
+	request := &model.UnlinkIdentityRequest{
+		UserId:   auth.UserId,
+		Provider: provider,
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.identityUseCase.UnlinkIdentity(ctx.UserContext(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Unlink identity : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
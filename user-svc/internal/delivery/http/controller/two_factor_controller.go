@@ -0,0 +1,158 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TwoFactorController interface {
+	GetStatus(ctx *fiber.Ctx) error
+	Enroll(ctx *fiber.Ctx) error
+	Enable(ctx *fiber.Ctx) error
+	Disable(ctx *fiber.Ctx) error
+	RegenerateRecoveryCodes(ctx *fiber.Ctx) error
+	StepUp(ctx *fiber.Ctx) error
+}
+
+type twoFactorController struct {
+	twoFactorUseCase usecase.TwoFactorUseCase
+	customValidator  helper.CustomValidator
+	logs             logger.Log
+}
+
+func NewTwoFactorController(twoFactorUseCase usecase.TwoFactorUseCase, customValidator helper.CustomValidator, logs logger.Log) TwoFactorController {
+	return &twoFactorController{twoFactorUseCase: twoFactorUseCase, customValidator: customValidator, logs: logs}
+}
+
+func (c *twoFactorController) GetStatus(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.twoFactorUseCase.GetStatus(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get two factor status : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.TwoFactorStatusResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *twoFactorController) Enroll(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+
+	response, err := c.twoFactorUseCase.Enroll(ctx.UserContext(), auth.UserId)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Enroll two factor : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.TwoFactorEnrollResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *twoFactorController) Enable(ctx *fiber.Ctx) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.twoFactorUseCase.Enable(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Enable two factor : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.RecoveryCodesResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *twoFactorController) Disable(ctx *fiber.Ctx) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.twoFactorUseCase.Disable(ctx.UserContext(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Disable two factor : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *twoFactorController) RegenerateRecoveryCodes(ctx *fiber.Ctx) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.twoFactorUseCase.RegenerateRecoveryCodes(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Regenerate recovery codes : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.RecoveryCodesResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *twoFactorController) StepUp(ctx *fiber.Ctx) error {
+	request := new(model.TwoFactorCodeRequest)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.SessionId = auth.SessionId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.twoFactorUseCase.StepUp(ctx.UserContext(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Two factor step up : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.StepUpResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
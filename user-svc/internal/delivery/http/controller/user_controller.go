@@ -21,6 +21,10 @@ type UserController interface {
 	UploadUserCoverImage(ctx *fiber.Ctx) error
 	UploadUserProfileImage(ctx *fiber.Ctx) error
 	UpdateUserSimilarity(ctx *fiber.Ctx) error
+	GetActiveSocialMedias(ctx *fiber.Ctx) error
+	GetSocialLinks(ctx *fiber.Ctx) error
+	SetSocialLink(ctx *fiber.Ctx) error
+	DeleteSocialLink(ctx *fiber.Ctx) error
 }
 
 type userController struct {
@@ -90,7 +94,7 @@ func (c *userController) UploadUserProfileImage(ctx *fiber.Ctx) error {
 	}
 
 	auth := middleware.GetUser(ctx)
-	profileURL, err := c.userUseCase.UploadUserProfileImage(ctx.Context(), file, auth.UserProfileID)
+	profileURL, err := c.userUseCase.UploadUserProfileImage(ctx.Context(), file, auth.UserId, auth.UserProfileID)
 	if err != nil {
 		return helper.ErrUseCaseResponseJSON(ctx, "Update user profile image : ", err, c.logs)
 	}
@@ -115,7 +119,7 @@ func (c *userController) UploadUserCoverImage(ctx *fiber.Ctx) error {
 	}
 
 	auth := middleware.GetUser(ctx)
-	profileCoverURL, err := c.userUseCase.UploadUserCoverImage(ctx.Context(), file, auth.UserProfileID)
+	profileCoverURL, err := c.userUseCase.UploadUserCoverImage(ctx.Context(), file, auth.UserId, auth.UserProfileID)
 	if err != nil {
 		return helper.ErrUseCaseResponseJSON(ctx, "Update user cover image : ", err, c.logs)
 	}
@@ -129,6 +133,11 @@ func (c *userController) UploadUserCoverImage(ctx *fiber.Ctx) error {
 }
 
 func (c *userController) GetAllPublicUserChat(ctx *fiber.Ctx) error {
+	// Page based pagination is the default, keyset pagination is opt in through the cursor param
+	if helper.IsCursorPagination(ctx) {
+		return c.getAllPublicUserChatByCursor(ctx)
+	}
+
 	request := &model.RequestGetAllPublicUser{
 		Username: ctx.Query("username", ""),
 		Page:     ctx.QueryInt("page", 1),
@@ -150,6 +159,32 @@ func (c *userController) GetAllPublicUserChat(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *userController) getAllPublicUserChatByCursor(ctx *fiber.Ctx) error {
+	request := &model.RequestGetAllPublicUserByCursor{
+		Username: ctx.Query("username", ""),
+		Cursor:   ctx.Query("cursor"),
+		Size:     ctx.QueryInt("size", 10),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, cursorMetadata, err := c.userUseCase.GetPublicUserChatByCursor(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get all public user chat : ", err, c.logs)
+	}
+
+	baseURL := ctx.BaseURL() + ctx.Path()
+	helper.GenerateCursorURL(baseURL, cursorMetadata)
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.GetAllPublicUserResponse]{
+		Success:        true,
+		Data:           response,
+		CursorMetadata: cursorMetadata,
+	})
+}
+
 func (c *userController) UpdateUserSimilarity(ctx *fiber.Ctx) error {
 	request := new(model.RequestUpdateSimilarity)
 	if err := helper.StrictBodyParser(ctx, request); err != nil {
@@ -173,3 +208,75 @@ func (c *userController) UpdateUserSimilarity(ctx *fiber.Ctx) error {
 		Data:    similarity,
 	})
 }
+
+func (c *userController) GetActiveSocialMedias(ctx *fiber.Ctx) error {
+	response, err := c.userUseCase.GetActiveSocialMedias(ctx.Context())
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get active social media : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*[]*model.SocialMediaResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *userController) GetSocialLinks(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	response, err := c.userUseCase.GetSocialLinks(ctx.Context(), auth.UserProfileID)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Get social links : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.SocialLinkResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *userController) SetSocialLink(ctx *fiber.Ctx) error {
+	request := new(model.RequestSetSocialLink)
+	if err := helper.StrictBodyParser(ctx, request); err != nil {
+		return helper.ErrBodyParserResponseJSON(ctx, err)
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+	request.UserProfileId = auth.UserProfileID
+	request.SocialMediaId = ctx.Params("socialMediaId")
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	response, err := c.userUseCase.SetSocialLink(ctx.Context(), request)
+	if err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Set social link : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.SocialLinkResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *userController) DeleteSocialLink(ctx *fiber.Ctx) error {
+	auth := middleware.GetUser(ctx)
+	request := &model.RequestDeleteSocialLink{
+		UserId:        auth.UserId,
+		UserProfileId: auth.UserProfileID,
+		SocialMediaId: ctx.Params("socialMediaId"),
+	}
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return helper.ErrValidationResponseJSON(ctx, validatonErrs)
+	}
+
+	if err := c.userUseCase.DeleteSocialLink(ctx.Context(), request); err != nil {
+		return helper.ErrUseCaseResponseJSON(ctx, "Delete social link : ", err, c.logs)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
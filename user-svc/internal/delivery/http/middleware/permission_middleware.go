@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewRequirePermission must be mounted after NewUserAuth, it only inspects the
+// permissions already resolved for the authenticated user.
+func NewRequirePermission(permission enum.PermissionEnum) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		auth := GetUser(ctx)
+		if !slices.Contains(auth.Permissions, string(permission)) {
+			return fiber.NewError(http.StatusForbidden, "Forbidden access")
+		}
+
+		return ctx.Next()
+	}
+}
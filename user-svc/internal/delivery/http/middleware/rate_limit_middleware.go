@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewIPRateLimit limits requests per client ip for a route scope. It fails open when
+// redis is unavailable so an outage does not lock every user out of authentication.
+func NewIPRateLimit(rateLimiterAdapter adapter.RateLimiterAdapter, logs logger.Log, scope string, limit int, window time.Duration) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		key := fmt.Sprintf("ip:%s:%s", scope, ctx.IP())
+		allowed, retryAfter, err := rateLimiterAdapter.Allow(ctx.UserContext(), key, limit, window)
+		if err != nil {
+			logs.CustomError("failed to check ip rate limit", err)
+			return ctx.Next()
+		}
+
+		if !allowed {
+			ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			return fiber.NewError(http.StatusTooManyRequests, "Too many requests, please try again later")
+		}
+
+		return ctx.Next()
+	}
+}
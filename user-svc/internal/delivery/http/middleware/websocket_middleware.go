@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewWebSocketUpgrade only lets websocket upgrades through. Browsers cannot set headers on a websocket, so the access
+// token may be sent as the token query param and is moved to the authorization header for the auth middleware.
+func NewWebSocketUpgrade() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if !websocket.IsWebSocketUpgrade(ctx) {
+			return fiber.NewError(http.StatusUpgradeRequired, "Websocket upgrade required")
+		}
+
+		if token := ctx.Query("token"); token != "" && ctx.Get("Authorization") == "" {
+			ctx.Request().Header.Set("Authorization", "Bearer "+token)
+		}
+
+		return ctx.Next()
+	}
+}
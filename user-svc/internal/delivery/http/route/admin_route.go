@@ -0,0 +1,27 @@
+package route
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+func (c *RouteConfig) SetupAdminRoute() {
+	adminRoutes := c.App.Group("/api/admin", c.AuthMiddleware)
+
+	socialMediaRoutes := adminRoutes.Group("/social-media", middleware.NewRequirePermission(enum.PermissionSocialMediaManage))
+	socialMediaRoutes.Post("/", c.AdminController.CreateSocialMedia)
+	socialMediaRoutes.Get("/", c.AdminController.GetAllSocialMedia)
+	socialMediaRoutes.Get("/:socialMediaId", c.AdminController.GetSocialMedia)
+	socialMediaRoutes.Put("/:socialMediaId", c.AdminController.UpdateSocialMedia)
+	socialMediaRoutes.Delete("/:socialMediaId", c.AdminController.DeleteSocialMedia)
+
+	adminRoutes.Post("/users/:userId/suspend", middleware.NewRequirePermission(enum.PermissionUserSuspend), c.AdminController.SuspendUser)
+	adminRoutes.Delete("/users/:userId/suspend", middleware.NewRequirePermission(enum.PermissionUserSuspend), c.AdminController.UnsuspendUser)
+	adminRoutes.Post("/users/:userId/roles", middleware.NewRequirePermission(enum.PermissionUserRoleManage), c.AdminController.GrantUserRole)
+	adminRoutes.Delete("/users/:userId/roles/:role", middleware.NewRequirePermission(enum.PermissionUserRoleManage), c.AdminController.RevokeUserRole)
+
+	chatReportRoutes := adminRoutes.Group("/chat-reports", middleware.NewRequirePermission(enum.PermissionChatModerate))
+	chatReportRoutes.Get("/", c.AdminController.GetChatReports)
+	chatReportRoutes.Get("/:reportId", c.AdminController.GetChatReport)
+	chatReportRoutes.Put("/:reportId/review", c.AdminController.ReviewChatReport)
+}
@@ -1,19 +1,44 @@
 package route
 
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+)
+
+// Per ip limits for unauthenticated endpoints, identifiers are limited in the usecase
+const (
+	authIPLimitWindow   = 15 * time.Minute
+	loginIPLimit        = 30
+	registerIPLimit     = 10
+	emailRequestIPLimit = 10
+	otpRequestIPLimit   = 10
+)
+
 func (c *RouteConfig) SetupAuthRoute() {
+	loginLimit := middleware.NewIPRateLimit(c.RateLimiterAdapter, c.Logs, "login", loginIPLimit, authIPLimitWindow)
+	registerLimit := middleware.NewIPRateLimit(c.RateLimiterAdapter, c.Logs, "register", registerIPLimit, authIPLimitWindow)
+	emailRequestLimit := middleware.NewIPRateLimit(c.RateLimiterAdapter, c.Logs, "email_request", emailRequestIPLimit, authIPLimitWindow)
+	otpRequestLimit := middleware.NewIPRateLimit(c.RateLimiterAdapter, c.Logs, "otp_request", otpRequestIPLimit, authIPLimitWindow)
 
 	userRoutes := c.App.Group("/api/user")
-	userRoutes.Post("/register/email", c.AuthController.RegisterByEmail)
-	userRoutes.Post("/register/google", c.AuthController.RegisterOrLoginByGoogle)
-	userRoutes.Post("/register/phone", c.AuthController.RegisterByPhoneNumber)
-	userRoutes.Post("/request-resend-email", c.AuthController.ResendEmailVerification)
+	userRoutes.Post("/register/email", registerLimit, c.AuthController.RegisterByEmail)
+	userRoutes.Post("/register/google", registerLimit, c.AuthController.RegisterOrLoginByProvider)
+	userRoutes.Post("/oauth/:provider", registerLimit, c.AuthController.RegisterOrLoginByProvider)
+	userRoutes.Post("/register/phone", registerLimit, c.AuthController.RegisterByPhoneNumber)
+	userRoutes.Post("/request-resend-email", emailRequestLimit, c.AuthController.ResendEmailVerification)
 	userRoutes.Post("/verify/:token", c.AuthController.VerifyEmail)
 
-	userRoutes.Post("/login", c.AuthController.Login)
+	userRoutes.Post("/otp/request", otpRequestLimit, c.AuthController.RequestPhoneOTP)
+	userRoutes.Post("/otp/verify-phone", c.AuthController.VerifyPhoneNumber)
+	userRoutes.Post("/otp/login", loginLimit, c.AuthController.LoginByOTP)
+
+	userRoutes.Post("/login", loginLimit, c.AuthController.Login)
+	userRoutes.Post("/login/2fa", loginLimit, c.AuthController.LoginByTwoFactor)
 	userRoutes.Post("/device-token", c.AuthMiddleware, c.AuthController.CreateDeviceToken)
 	userRoutes.Post("/request-access-token", c.AuthController.RequestAccessToken)
 
-	userRoutes.Post("/reset-password/request", c.AuthController.RequestResetPassword)
+	userRoutes.Post("/reset-password/request", emailRequestLimit, c.AuthController.RequestResetPassword)
 	userRoutes.Post("/reset-password/validate", c.AuthController.ValidateResetPassword)
 	userRoutes.Post("/reset-password/reset/:token", c.AuthController.ResetPassword)
 }
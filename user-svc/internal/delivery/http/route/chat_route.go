@@ -0,0 +1,11 @@
+package route
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+)
+
+// SetupChatRoute keeps the websocket outside the /api/users group, the token query param has to be lifted before the
+// auth middleware of that group runs
+func (c *RouteConfig) SetupChatRoute() {
+	c.App.Get("/api/chat/ws", middleware.NewWebSocketUpgrade(), c.AuthMiddleware, c.ChatGateway.Handler())
+}
@@ -1,22 +1,34 @@
 package route
 
 import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
 	http "github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/controller"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/websocket"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 type RouteConfig struct {
-	App              *fiber.App
-	AuthController   http.AuthController
-	UserController   http.UserController
-	ChatController   http.ChatController
-	HealthController http.HealthController
-	AuthMiddleware   fiber.Handler
+	App                 *fiber.App
+	AuthController      http.AuthController
+	UserController      http.UserController
+	ChatController      http.ChatController
+	AdminController     http.AdminController
+	TwoFactorController http.TwoFactorController
+	AccountController   http.AccountController
+	IdentityController  http.IdentityController
+	HealthController    http.HealthController
+	ChatGateway         *websocket.ChatGateway
+	AuthMiddleware      fiber.Handler
+	RateLimiterAdapter  adapter.RateLimiterAdapter
+	Logs                logger.Log
 }
 
 func (r *RouteConfig) Setup() {
 	r.SetupAuthRoute()
 	r.SetupUserRoute()
+	r.SetupChatRoute()
+	r.SetupAdminRoute()
 	r.SetupHealthRoute()
 }
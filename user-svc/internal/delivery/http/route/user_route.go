@@ -4,16 +4,57 @@ func (c *RouteConfig) SetupUserRoute() {
 	userRoutes := c.App.Group("/api/users", c.AuthMiddleware)
 	userRoutes.Get("/current", c.AuthController.Current)
 	userRoutes.Delete("/logout", c.AuthController.Logout)
+	userRoutes.Get("/sessions", c.AuthController.ListSessions)
+	userRoutes.Delete("/sessions", c.AuthController.RevokeAllSessions)
+	userRoutes.Delete("/sessions/:sessionId", c.AuthController.RevokeSession)
+
+	userRoutes.Get("/2fa", c.TwoFactorController.GetStatus)
+	userRoutes.Post("/2fa/enroll", c.TwoFactorController.Enroll)
+	userRoutes.Post("/2fa/enable", c.TwoFactorController.Enable)
+	userRoutes.Post("/2fa/disable", c.TwoFactorController.Disable)
+	userRoutes.Post("/2fa/recovery-codes", c.TwoFactorController.RegenerateRecoveryCodes)
+	userRoutes.Post("/2fa/step-up", c.TwoFactorController.StepUp)
+
+	userRoutes.Get("/account/deletion", c.AccountController.GetDeletionStatus)
+	userRoutes.Post("/account/deletion", c.AccountController.RequestDeletion)
+	userRoutes.Delete("/account/deletion", c.AccountController.CancelDeletion)
+	userRoutes.Post("/account/export", c.AccountController.RequestDataExport)
+	userRoutes.Get("/account/export/:exportId", c.AccountController.GetDataExport)
+
+	userRoutes.Put("/account/username", c.AuthController.ChangeUsername)
+	userRoutes.Post("/account/reauth", c.AuthController.RequestReauthOTP)
+	userRoutes.Post("/account/email", c.AuthController.RequestEmailChange)
+	userRoutes.Post("/account/email/verify", c.AuthController.ConfirmEmailChange)
+	userRoutes.Post("/account/phone", c.AuthController.RequestPhoneChange)
+	userRoutes.Post("/account/phone/verify", c.AuthController.ConfirmPhoneChange)
+
+	userRoutes.Get("/identities", c.IdentityController.ListIdentities)
+	userRoutes.Post("/identities/:provider", c.IdentityController.LinkIdentity)
+	userRoutes.Delete("/identities/:provider", c.IdentityController.UnlinkIdentity)
+
 	userRoutes.Get("/profile", c.UserController.GetUserProfile)
 
 	userRoutes.Put("/profile", c.UserController.UpdateUserProfile)
 	userRoutes.Post("/profile/upload-profile-image", c.UserController.UploadUserProfileImage)
 	userRoutes.Post("/profile/upload-profile-cover", c.UserController.UploadUserCoverImage)
+	userRoutes.Get("/profile/social-links", c.UserController.GetSocialLinks)
+	userRoutes.Put("/profile/social-links/:socialMediaId", c.UserController.SetSocialLink)
+	userRoutes.Delete("/profile/social-links/:socialMediaId", c.UserController.DeleteSocialLink)
+	userRoutes.Get("/social-media", c.UserController.GetActiveSocialMedias)
 	userRoutes.Get("/dm", c.UserController.GetAllPublicUserChat)
 
 	userRoutes.Post("/room", c.ChatController.GetOrCreateRoom)
 	userRoutes.Get("/token/:uid", c.ChatController.GetCustomToken)
 	userRoutes.Post("/send-message", c.ChatController.SendMessage)
+	userRoutes.Get("/chat/rooms", c.ChatController.GetRooms)
+	userRoutes.Get("/chat/rooms/:roomId/messages", c.ChatController.GetMessages)
+	userRoutes.Get("/chat/rooms/:roomId/messages/:messageId/image", c.ChatController.GetMessageImage)
+	userRoutes.Put("/chat/rooms/:roomId/read", c.ChatController.MarkRead)
+	userRoutes.Post("/chat/rooms/:roomId/reports", c.ChatController.ReportChat)
+	userRoutes.Get("/chat/blocks", c.ChatController.GetBlockedUsers)
+	userRoutes.Post("/chat/blocks", c.ChatController.BlockUser)
+	userRoutes.Delete("/chat/blocks/:userId", c.ChatController.UnblockUser)
+	userRoutes.Get("/chat/unread-count", c.ChatController.CountUnread)
 
 	userRoutes.Put("/similarity", c.UserController.UpdateUserSimilarity)
 }
@@ -0,0 +1,87 @@
+package websocket
+
+import (
+	"sync"
+	"time"
+
+	fiberws "github.com/gofiber/contrib/websocket"
+)
+
+const (
+	chatWriteWait      = 10 * time.Second
+	chatPongWait       = 60 * time.Second
+	chatPingPeriod     = (chatPongWait * 9) / 10
+	chatMaxFrameSize   = 4 << 10
+	chatSendBufferSize = 64
+)
+
+type chatClient struct {
+	userId    string
+	conn      *fiberws.Conn
+	send      chan []byte
+	done      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+func newChatClient(userId string, conn *fiberws.Conn) *chatClient {
+	return &chatClient{
+		userId:  userId,
+		conn:    conn,
+		send:    make(chan []byte, chatSendBufferSize),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// enqueue returns false when the send buffer of the client is full
+func (c *chatClient) enqueue(frame []byte) bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+	}
+
+	select {
+	case c.send <- frame:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *chatClient) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// writePump is the only writer of the connection, it also pings the client so a dead connection is dropped. Closing
+// the connection on the way out unblocks the reader.
+func (c *chatClient) writePump() {
+	ticker := time.NewTicker(chatPingPeriod)
+	defer func() {
+		ticker.Stop()
+		_ = c.conn.Close()
+		close(c.stopped)
+	}()
+
+	for {
+		select {
+		case <-c.done:
+			_ = c.conn.WriteControl(fiberws.CloseMessage, fiberws.FormatCloseMessage(fiberws.CloseNormalClosure, ""),
+				time.Now().Add(chatWriteWait))
+			return
+		case frame := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(chatWriteWait))
+			if err := c.conn.WriteMessage(fiberws.TextMessage, frame); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(chatWriteWait))
+			if err := c.conn.WriteMessage(fiberws.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
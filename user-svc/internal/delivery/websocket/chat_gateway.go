@@ -0,0 +1,166 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/message"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+
+	fiberws "github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+const chatRequestTimeout = 10 * time.Second
+
+// ChatGateway serves the chat websocket. Sending and reading go through the same usecase as the http endpoints, the
+// resulting events reach every member through the hub.
+type ChatGateway struct {
+	hub             *ChatHub
+	chatUseCase     usecase.ChatUseCase
+	customValidator helper.CustomValidator
+	logs            logger.Log
+}
+
+func NewChatGateway(hub *ChatHub, chatUseCase usecase.ChatUseCase, customValidator helper.CustomValidator, logs logger.Log) *ChatGateway {
+	return &ChatGateway{
+		hub:             hub,
+		chatUseCase:     chatUseCase,
+		customValidator: customValidator,
+		logs:            logs,
+	}
+}
+
+// Handler upgrades an authenticated request, the auth middleware must run before it
+func (g *ChatGateway) Handler() fiber.Handler {
+	return fiberws.New(g.serve)
+}
+
+func (g *ChatGateway) serve(conn *fiberws.Conn) {
+	auth, ok := conn.Locals("auth").(*model.AuthResponse)
+	if !ok {
+		_ = conn.Close()
+		return
+	}
+
+	client := newChatClient(auth.UserId, conn)
+	g.hub.register(client)
+	defer func() {
+		g.hub.unregister(client)
+		client.close()
+		<-client.stopped
+	}()
+
+	go client.writePump()
+
+	conn.SetReadLimit(chatMaxFrameSize)
+	_ = conn.SetReadDeadline(time.Now().Add(chatPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(chatPongWait))
+	})
+
+	for {
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			if fiberws.IsUnexpectedCloseError(err, fiberws.CloseNormalClosure, fiberws.CloseGoingAway) {
+				g.logs.CustomError("chat websocket closed unexpectedly", err)
+			}
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(chatPongWait))
+
+		frame := new(model.ChatFrame)
+		if err := sonic.ConfigFastest.Unmarshal(payload, frame); err != nil {
+			g.reply(client, &model.ChatEvent{Type: enum.ChatEventError, Error: "Invalid frame"})
+			continue
+		}
+
+		g.reply(client, g.handleFrame(auth.UserId, frame))
+	}
+}
+
+func (g *ChatGateway) handleFrame(userId string, frame *model.ChatFrame) *model.ChatEvent {
+	ctx, cancel := context.WithTimeout(context.Background(), chatRequestTimeout)
+	defer cancel()
+
+	switch frame.Type {
+	case enum.ChatEventSendMessage:
+		request := &model.RequestSendMessage{
+			RoomId:          frame.RoomId,
+			SenderId:        userId,
+			ClientMessageId: frame.ClientMessageId,
+			Type:            frame.MessageType,
+			Message:         frame.Message,
+			PhotoId:         frame.PhotoId,
+			FileKey:         frame.FileKey,
+		}
+		if validatonErrs := g.customValidator.ValidateUseCase(request); validatonErrs != nil {
+			return g.errorEvent(frame, validatonErrs)
+		}
+
+		response, err := g.chatUseCase.SendMessage(ctx, request)
+		if err != nil {
+			return g.errorEvent(frame, err)
+		}
+		return &model.ChatEvent{Type: enum.ChatEventMessageCreated, RequestId: frame.RequestId, Data: response}
+
+	case enum.ChatEventRoomRead:
+		request := &model.RequestMarkChatRead{
+			UserId:    userId,
+			RoomId:    frame.RoomId,
+			MessageId: frame.MessageId,
+		}
+		if validatonErrs := g.customValidator.ValidateUseCase(request); validatonErrs != nil {
+			return g.errorEvent(frame, validatonErrs)
+		}
+
+		response, err := g.chatUseCase.MarkRead(ctx, request)
+		if err != nil {
+			return g.errorEvent(frame, err)
+		}
+		return &model.ChatEvent{Type: enum.ChatEventRoomRead, RequestId: frame.RequestId, Data: response}
+
+	case enum.ChatEventPing:
+		return &model.ChatEvent{Type: enum.ChatEventPong, RequestId: frame.RequestId}
+
+	default:
+		return &model.ChatEvent{Type: enum.ChatEventError, RequestId: frame.RequestId, Error: "Unknown frame type"}
+	}
+}
+
+// errorEvent only exposes the message of a usecase or validation error, anything else is reported as internal
+func (g *ChatGateway) errorEvent(frame *model.ChatFrame, err error) *model.ChatEvent {
+	event := &model.ChatEvent{Type: enum.ChatEventError, RequestId: frame.RequestId, Error: message.StatusInternalServerError}
+
+	var valErr *helper.UseCaseValError
+	var appErr *helper.AppError
+	switch {
+	case errors.As(err, &valErr):
+		event.Error = "Validation failed"
+		event.Data = valErr.GetValidationErrors()
+	case errors.As(err, &appErr):
+		event.Error = appErr.Message
+	default:
+		g.logs.CustomError("failed to handle chat frame", err)
+	}
+
+	return event
+}
+
+func (g *ChatGateway) reply(client *chatClient, event *model.ChatEvent) {
+	eventBytes, err := sonic.ConfigFastest.Marshal(event)
+	if err != nil {
+		g.logs.CustomError("failed to marshal chat event", err)
+		return
+	}
+
+	if !client.enqueue(eventBytes) {
+		client.close()
+	}
+}
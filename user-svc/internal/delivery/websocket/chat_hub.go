@@ -0,0 +1,95 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/redis/go-redis/v9"
+)
+
+// ChatHub keeps the websocket clients connected to this instance and delivers the chat events published by every
+// instance to the clients of the room members
+type ChatHub struct {
+	redisClient *redis.Client
+	logs        logger.Log
+
+	mu      sync.RWMutex
+	clients map[string]map[*chatClient]struct{}
+}
+
+func NewChatHub(redisClient *redis.Client, logs logger.Log) *ChatHub {
+	return &ChatHub{
+		redisClient: redisClient,
+		logs:        logs,
+		clients:     make(map[string]map[*chatClient]struct{}),
+	}
+}
+
+// Run listens to the chat events until the context is canceled
+func (h *ChatHub) Run(ctx context.Context) {
+	pubsub := h.redisClient.Subscribe(ctx, adapter.ChatEventChannel)
+	defer pubsub.Close()
+
+	h.logs.Log(fmt.Sprintf("started chat hub for channel : %s", adapter.ChatEventChannel))
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			h.logs.Log(fmt.Sprintf("stopping chat hub for channel : %s", adapter.ChatEventChannel))
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			envelope := new(model.ChatEventEnvelope)
+			if err := sonic.ConfigFastest.UnmarshalFromString(message.Payload, envelope); err != nil {
+				h.logs.CustomError("failed to unmarshal chat event envelope", err)
+				continue
+			}
+
+			h.deliver(envelope.MemberIds, envelope.Event)
+		}
+	}
+}
+
+func (h *ChatHub) register(client *chatClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.clients[client.userId] == nil {
+		h.clients[client.userId] = make(map[*chatClient]struct{})
+	}
+	h.clients[client.userId][client] = struct{}{}
+}
+
+func (h *ChatHub) unregister(client *chatClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.clients[client.userId], client)
+	if len(h.clients[client.userId]) == 0 {
+		delete(h.clients, client.userId)
+	}
+}
+
+// deliver never blocks on a slow client, a client whose buffer is full is disconnected and catches up through the
+// history once it reconnects
+func (h *ChatHub) deliver(userIds []string, event []byte) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, userId := range userIds {
+		for client := range h.clients[userId] {
+			if !client.enqueue(event) {
+				client.close()
+			}
+		}
+	}
+}
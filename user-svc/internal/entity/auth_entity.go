@@ -1,10 +1,12 @@
 package entity
 
 type Auth struct {
-	Id            string `json:"id"`
-	Username      string `json:"username"`
-	Email         string `json:"email" db:"email"`
-	PhoneNumber   string `json:"phone_number"`
-	UserProfileID string `json:"user_profile_id"`
-	Similarity    uint   `json:"similarity"`
+	Id               string   `json:"id"`
+	Username         string   `json:"username"`
+	Email            string   `json:"email" db:"email"`
+	PhoneNumber      string   `json:"phone_number"`
+	UserProfileID    string   `json:"user_profile_id"`
+	Similarity       uint     `json:"similarity"`
+	Roles            []string `json:"roles"`
+	TwoFactorEnabled bool     `json:"two_factor_enabled"`
 }
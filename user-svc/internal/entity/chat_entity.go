@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+// ChatRoom is a direct conversation, RoomUserId is the sorted pair of member ids so a pair has a single room
+type ChatRoom struct {
+	Id            string         `db:"id"`
+	RoomUserId    string         `db:"room_user_id"`
+	LastMessageId sql.NullString `db:"last_message_id"`
+	LastMessageAt *time.Time     `db:"last_message_at"`
+	CreatedAt     *time.Time     `db:"created_at"`
+	UpdatedAt     *time.Time     `db:"updated_at"`
+}
+
+// ChatRoomMember holds the read marker of a member, messages after LastReadAt sent by someone else are unread
+type ChatRoomMember struct {
+	RoomId            string         `db:"room_id"`
+	UserId            string         `db:"user_id"`
+	LastReadMessageId sql.NullString `db:"last_read_message_id"`
+	LastReadAt        *time.Time     `db:"last_read_at"`
+	JoinedAt          *time.Time     `db:"joined_at"`
+}
+
+// ChatMessage client message id is chosen by the sender app so a message sent again after a lost ack is stored once.
+// A system message has no sender, its client message id is derived from the event that created it. The attachment is
+// a snapshot whose shape depends on the message type, the message is the caption of an attachment.
+type ChatMessage struct {
+	Id              string                   `db:"id"`
+	RoomId          string                   `db:"room_id"`
+	SenderId        sql.NullString           `db:"sender_id"`
+	ClientMessageId sql.NullString           `db:"client_message_id"`
+	MessageType     enum.ChatMessageTypeEnum `db:"message_type"`
+	Message         string                   `db:"message"`
+	Attachment      *json.RawMessage         `db:"attachment"`
+	CreatedAt       *time.Time               `db:"created_at"`
+}
+
+// ChatRoomSummary is a room of the inbox seen by one member, the peer is the other member of the room. ActiveAt is
+// the last message time or the creation time of a room without messages.
+type ChatRoomSummary struct {
+	RoomId            string         `db:"room_id"`
+	PeerId            string         `db:"peer_id"`
+	PeerUsername      sql.NullString `db:"peer_username"`
+	LastMessageId     sql.NullString `db:"last_message_id"`
+	LastMessageSender sql.NullString `db:"last_message_sender_id"`
+	LastMessageType   sql.NullString `db:"last_message_type"`
+	LastMessage       sql.NullString `db:"last_message"`
+	LastMessageAt     *time.Time     `db:"last_message_at"`
+	LastReadMessageId sql.NullString `db:"last_read_message_id"`
+	UnreadCount       int            `db:"unread_count"`
+	ActiveAt          *time.Time     `db:"active_at"`
+}
+
+// UserBlock is one sided, either side blocking the other stops the chat between them
+type UserBlock struct {
+	BlockerId string     `db:"blocker_id"`
+	BlockedId string     `db:"blocked_id"`
+	CreatedAt *time.Time `db:"created_at"`
+}
+
+type BlockedUser struct {
+	UserId    string         `db:"user_id"`
+	Username  sql.NullString `db:"username"`
+	BlockedAt *time.Time     `db:"blocked_at"`
+}
+
+// ChatReport message id is set when a single message is reported instead of the whole conversation
+type ChatReport struct {
+	Id             string                    `db:"id"`
+	RoomId         string                    `db:"room_id"`
+	ReporterId     string                    `db:"reporter_id"`
+	ReportedUserId string                    `db:"reported_user_id"`
+	MessageId      sql.NullString            `db:"message_id"`
+	Reason         enum.ChatReportReasonEnum `db:"reason"`
+	Description    sql.NullString            `db:"description"`
+	Status         enum.ChatReportStatusEnum `db:"status"`
+	ReviewerId     sql.NullString            `db:"reviewer_id"`
+	ReviewNote     sql.NullString            `db:"review_note"`
+	ReviewedAt     *time.Time                `db:"reviewed_at"`
+	CreatedAt      *time.Time                `db:"created_at"`
+	UpdatedAt      *time.Time                `db:"updated_at"`
+}
@@ -6,6 +6,8 @@ import (
 
 type AccessToken struct {
 	UserId    string
+	SessionId string
+	Roles     []string
 	Token     string
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
@@ -14,6 +16,8 @@ type AccessToken struct {
 
 type RefreshToken struct {
 	UserId    string
+	SessionId string
+	TokenId   string
 	Token     string
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
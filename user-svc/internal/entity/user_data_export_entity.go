@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type UserDataExport struct {
+	Id            string                    `db:"id"`
+	UserId        string                    `db:"user_id"`
+	Status        enum.DataExportStatusEnum `db:"status"`
+	FileKey       sql.NullString            `db:"file_key"`
+	FailureReason sql.NullString            `db:"failure_reason"`
+	CompletedAt   *time.Time                `db:"completed_at"`
+	CreatedAt     *time.Time                `db:"created_at"`
+	UpdatedAt     *time.Time                `db:"updated_at"`
+}
+
+func (e *UserDataExport) IsActive() bool {
+	return e.Status == enum.DataExportStatusPending || e.Status == enum.DataExportStatusProcessing
+}
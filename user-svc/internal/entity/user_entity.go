@@ -2,7 +2,10 @@ package entity
 
 import (
 	"database/sql"
+	"slices"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type User struct {
@@ -13,8 +16,15 @@ type User struct {
 	Password              sql.NullString `json:"password" db:"password"`
 	PhoneNumber           sql.NullString `json:"phone_number" db:"phone_number"`
 	PhoneNumberVerifiedAt *time.Time     `json:"phone_number_verified_at" db:"phone_number_verified_at"`
-	GoogleId              sql.NullString `json:"google_id" db:"google_id"`
 	HasFacecam            bool           `json:"has_facecam" db:"has_facecam"`
+	Roles                 pq.StringArray `json:"roles" db:"roles"`
+	SuspendedAt           *time.Time     `json:"suspended_at" db:"suspended_at"`
+	SuspensionReason      sql.NullString `json:"suspension_reason" db:"suspension_reason"`
+	TotpSecret            sql.NullString `json:"-" db:"totp_secret"`
+	TwoFactorEnabledAt    *time.Time     `json:"two_factor_enabled_at" db:"two_factor_enabled_at"`
+	DeletionRequestedAt   *time.Time     `json:"deletion_requested_at" db:"deletion_requested_at"`
+	DeletionScheduledAt   *time.Time     `json:"deletion_scheduled_at" db:"deletion_scheduled_at"`
+	DeletedAt             *time.Time     `json:"deleted_at" db:"deleted_at"`
 	CreatedAt             *time.Time     `json:"created_at" db:"created_at"`
 	UpdatedAt             *time.Time     `json:"updated_at" db:"updated_at"`
 }
@@ -35,6 +45,26 @@ func (u *User) HasVerifiedPhoneNumber() bool {
 	return u.PhoneNumberVerifiedAt != nil
 }
 
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+func (u *User) HasTwoFactor() bool {
+	return u.TwoFactorEnabledAt != nil && u.TotpSecret.Valid
+}
+
+func (u *User) HasPendingDeletion() bool {
+	return u.DeletionScheduledAt != nil && u.DeletedAt == nil
+}
+
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+func (u *User) HasRole(role string) bool {
+	return slices.Contains(u.Roles, role)
+}
+
 type UserPublicChat struct {
 	UserId   string         `db:"user_id"`
 	Username string         `db:"username"`
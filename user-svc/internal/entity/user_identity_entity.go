@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type UserIdentity struct {
+	Id        string                    `db:"id"`
+	UserId    string                    `db:"user_id"`
+	Provider  enum.IdentityProviderEnum `db:"provider"`
+	Subject   string                    `db:"subject"`
+	Email     sql.NullString            `db:"email"`
+	CreatedAt *time.Time                `db:"created_at"`
+	UpdatedAt *time.Time                `db:"updated_at"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+type UserRecoveryCode struct {
+	Id        string     `db:"id"`
+	UserId    string     `db:"user_id"`
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt *time.Time `db:"created_at"`
+}
+
+// TwoFactorChallenge is kept in cache between a successful password step and the
+// second factor, it carries what is needed to open the session afterwards.
+type TwoFactorChallenge struct {
+	UserId    string `json:"user_id"`
+	Device    string `json:"device"`
+	Platform  string `json:"platform"`
+	IpAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserSession is a single signed-in device. The session id doubles as the
+// refresh token family id, every rotated refresh token of the same login
+// shares it.
+type UserSession struct {
+	Id             string         `db:"id"`
+	UserId         string         `db:"user_id"`
+	RefreshTokenId string         `db:"refresh_token_id"`
+	Device         sql.NullString `db:"device"`
+	Platform       sql.NullString `db:"platform"`
+	IpAddress      sql.NullString `db:"ip_address"`
+	UserAgent      sql.NullString `db:"user_agent"`
+	LastSeenAt     *time.Time     `db:"last_seen_at"`
+	ExpiresAt      *time.Time     `db:"expires_at"`
+	RevokedAt      *time.Time     `db:"revoked_at"`
+	RevokedReason  sql.NullString `db:"revoked_reason"`
+	CreatedAt      *time.Time     `db:"created_at"`
+	UpdatedAt      *time.Time     `db:"updated_at"`
+}
+
+func (s *UserSession) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type UserSocialLink struct {
+	UserProfileId string     `db:"user_profile_id"`
+	SocialMediaId string     `db:"social_media_id"`
+	Handle        string     `db:"handle"`
+	CreatedAt     *time.Time `db:"created_at"`
+	UpdatedAt     *time.Time `db:"updated_at"`
+}
+
+// UserSocialLinkDetail is a link joined with the social media it belongs to
+type UserSocialLinkDetail struct {
+	SocialMediaId string         `db:"social_media_id"`
+	Name          string         `db:"name"`
+	BaseUrl       sql.NullString `db:"base_url"`
+	LogoUrl       sql.NullString `db:"logo_url"`
+	Handle        string         `db:"handle"`
+	CreatedAt     *time.Time     `db:"created_at"`
+	UpdatedAt     *time.Time     `db:"updated_at"`
+}
@@ -0,0 +1,10 @@
+package enum
+
+// AudienceSegmentEnum is a campaign audience resolved from the users table, the creator segment is owned by photo-svc
+type AudienceSegmentEnum string
+
+const (
+	AudienceSegmentAll      AudienceSegmentEnum = "ALL"
+	AudienceSegmentFacecam  AudienceSegmentEnum = "FACECAM"
+	AudienceSegmentInactive AudienceSegmentEnum = "INACTIVE"
+)
@@ -0,0 +1,15 @@
+package enum
+
+// ChatEventTypeEnum is the type of a websocket frame. Clients send message.send, room.read and ping, the server sends
+// the other types and room.read as the read receipt of a member.
+type ChatEventTypeEnum string
+
+const (
+	ChatEventSendMessage    ChatEventTypeEnum = "message.send"
+	ChatEventRoomRead       ChatEventTypeEnum = "room.read"
+	ChatEventPing           ChatEventTypeEnum = "ping"
+	ChatEventRoomCreated    ChatEventTypeEnum = "room.created"
+	ChatEventMessageCreated ChatEventTypeEnum = "message.created"
+	ChatEventPong           ChatEventTypeEnum = "pong"
+	ChatEventError          ChatEventTypeEnum = "error"
+)
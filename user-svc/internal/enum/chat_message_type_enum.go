@@ -0,0 +1,18 @@
+package enum
+
+// ChatMessageTypeEnum decides the shape of the attachment of a message. System messages have no sender and are only
+// created by the service itself.
+type ChatMessageTypeEnum string
+
+const (
+	ChatMessageTypeText   ChatMessageTypeEnum = "TEXT"
+	ChatMessageTypePhoto  ChatMessageTypeEnum = "PHOTO"
+	ChatMessageTypeImage  ChatMessageTypeEnum = "IMAGE"
+	ChatMessageTypeSystem ChatMessageTypeEnum = "SYSTEM"
+)
+
+type ChatSystemEventEnum string
+
+const (
+	ChatSystemEventTransactionPaid ChatSystemEventEnum = "TRANSACTION_PAID"
+)
@@ -0,0 +1,29 @@
+package enum
+
+type ChatReportReasonEnum string
+
+const (
+	ChatReportReasonSpam          ChatReportReasonEnum = "SPAM"
+	ChatReportReasonHarassment    ChatReportReasonEnum = "HARASSMENT"
+	ChatReportReasonHateSpeech    ChatReportReasonEnum = "HATE_SPEECH"
+	ChatReportReasonScam          ChatReportReasonEnum = "SCAM"
+	ChatReportReasonInappropriate ChatReportReasonEnum = "INAPPROPRIATE"
+	ChatReportReasonOther         ChatReportReasonEnum = "OTHER"
+)
+
+// ChatReportStatusEnum a report stays pending until a moderator resolves or dismisses it
+type ChatReportStatusEnum string
+
+const (
+	ChatReportStatusPending   ChatReportStatusEnum = "PENDING"
+	ChatReportStatusResolved  ChatReportStatusEnum = "RESOLVED"
+	ChatReportStatusDismissed ChatReportStatusEnum = "DISMISSED"
+)
+
+// ModerationFailPolicyEnum decides whether a message is sent when no moderation classifier could check it
+type ModerationFailPolicyEnum string
+
+const (
+	ModerationFailOpen   ModerationFailPolicyEnum = "OPEN"
+	ModerationFailClosed ModerationFailPolicyEnum = "CLOSED"
+)
@@ -0,0 +1,10 @@
+package enum
+
+type DataExportStatusEnum string
+
+const (
+	DataExportStatusPending    DataExportStatusEnum = "PENDING"
+	DataExportStatusProcessing DataExportStatusEnum = "PROCESSING"
+	DataExportStatusCompleted  DataExportStatusEnum = "COMPLETED"
+	DataExportStatusFailed     DataExportStatusEnum = "FAILED"
+)
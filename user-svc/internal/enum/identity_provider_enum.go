@@ -0,0 +1,21 @@
+package enum
+
+import "strings"
+
+type IdentityProviderEnum string
+
+const (
+	IdentityProviderGoogle   IdentityProviderEnum = "GOOGLE"
+	IdentityProviderApple    IdentityProviderEnum = "APPLE"
+	IdentityProviderFacebook IdentityProviderEnum = "FACEBOOK"
+)
+
+// ParseIdentityProvider accepts the provider name in any case, as used in route params
+func ParseIdentityProvider(provider string) (IdentityProviderEnum, bool) {
+	switch p := IdentityProviderEnum(strings.ToUpper(provider)); p {
+	case IdentityProviderGoogle, IdentityProviderApple, IdentityProviderFacebook:
+		return p, true
+	default:
+		return "", false
+	}
+}
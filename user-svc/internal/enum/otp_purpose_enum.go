@@ -0,0 +1,11 @@
+package enum
+
+type OTPPurposeEnum string
+
+const (
+	OTPPurposeVerifyPhone OTPPurposeEnum = "VERIFY_PHONE"
+	OTPPurposeLogin       OTPPurposeEnum = "LOGIN"
+	OTPPurposeChangeEmail OTPPurposeEnum = "CHANGE_EMAIL"
+	OTPPurposeChangePhone OTPPurposeEnum = "CHANGE_PHONE"
+	OTPPurposeReauth      OTPPurposeEnum = "REAUTH"
+)
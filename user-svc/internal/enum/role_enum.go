@@ -0,0 +1,68 @@
+package enum
+
+type RoleEnum string
+
+const (
+	RoleUser    RoleEnum = "USER"
+	RoleCreator RoleEnum = "CREATOR"
+	RoleAdmin   RoleEnum = "ADMIN"
+	RoleFinance RoleEnum = "FINANCE"
+)
+
+type PermissionEnum string
+
+const (
+	PermissionPhotoUpload        PermissionEnum = "photo:upload"
+	PermissionBankManage         PermissionEnum = "bank:manage"
+	PermissionSocialMediaManage  PermissionEnum = "social_media:manage"
+	PermissionCreatorVerify      PermissionEnum = "creator:verify"
+	PermissionWithdrawalReview   PermissionEnum = "withdrawal:review"
+	PermissionUserSuspend        PermissionEnum = "user:suspend"
+	PermissionUserRoleManage     PermissionEnum = "user:role:manage"
+	PermissionNotificationManage PermissionEnum = "notification:manage"
+	PermissionChatModerate       PermissionEnum = "chat:moderate"
+	PermissionVoucherPlatform    PermissionEnum = "voucher:platform:manage"
+)
+
+// RolePermissions is the single source of truth for what each role may do.
+// Other services never see this table, they only receive the resolved
+// permissions through the Authenticate response.
+var RolePermissions = map[RoleEnum][]PermissionEnum{
+	RoleUser:    {PermissionPhotoUpload},
+	RoleCreator: {PermissionPhotoUpload},
+	RoleFinance: {PermissionBankManage, PermissionWithdrawalReview},
+	RoleAdmin: {
+		PermissionPhotoUpload,
+		PermissionBankManage,
+		PermissionSocialMediaManage,
+		PermissionCreatorVerify,
+		PermissionWithdrawalReview,
+		PermissionUserSuspend,
+		PermissionUserRoleManage,
+		PermissionNotificationManage,
+		PermissionChatModerate,
+		PermissionVoucherPlatform,
+	},
+}
+
+func IsValidRole(role string) bool {
+	_, ok := RolePermissions[RoleEnum(role)]
+	return ok
+}
+
+// ResolvePermissions flattens the permissions granted by every role the user
+// holds, dropping duplicates while keeping a stable order.
+func ResolvePermissions(roles []string) []string {
+	seen := make(map[PermissionEnum]struct{})
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, permission := range RolePermissions[RoleEnum(role)] {
+			if _, ok := seen[permission]; ok {
+				continue
+			}
+			seen[permission] = struct{}{}
+			permissions = append(permissions, string(permission))
+		}
+	}
+	return permissions
+}
@@ -0,0 +1,12 @@
+package enum
+
+type SessionRevokeReasonEnum string
+
+const (
+	SessionRevokeReasonLogout        SessionRevokeReasonEnum = "LOGOUT"
+	SessionRevokeReasonUserRevoked   SessionRevokeReasonEnum = "USER_REVOKED"
+	SessionRevokeReasonPasswordReset SessionRevokeReasonEnum = "PASSWORD_RESET"
+	SessionRevokeReasonTokenReuse    SessionRevokeReasonEnum = "REFRESH_TOKEN_REUSE"
+	SessionRevokeReasonAccountDelete SessionRevokeReasonEnum = "ACCOUNT_DELETED"
+	SessionRevokeReasonContactChange SessionRevokeReasonEnum = "CONTACT_CHANGED"
+)
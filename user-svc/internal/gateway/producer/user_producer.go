@@ -13,6 +13,8 @@ import (
 type UserProducer interface {
 	ProduceUserCreated(ctx context.Context, userEvent *event.UserEvent) error
 	ProduceUserDeviceCreated(ctx context.Context, userDeviceEvent *event.UserDeviceEvent) error
+	ProduceUserDeleted(ctx context.Context, userDeletedEvent *event.UserDeletedEvent) error
+	ProduceUserProfileUpdated(ctx context.Context, userProfileUpdatedEvent *event.UserProfileUpdatedEvent) error
 }
 
 type userProducer struct {
@@ -50,3 +52,27 @@ func (s *userProducer) ProduceUserDeviceCreated(ctx context.Context, userDeviceE
 	log.Printf("Published create user device event for user id %s", userDeviceEvent.UserID)
 	return nil
 }
+
+func (s *userProducer) ProduceUserDeleted(ctx context.Context, userDeletedEvent *event.UserDeletedEvent) error {
+	subject := "user.deleted"
+
+	err := s.messagingAdapter.Publish(ctx, subject, userDeletedEvent)
+	if err != nil {
+		return fmt.Errorf("failed to publish user deleted event: %w", err)
+	}
+
+	log.Printf("Published user deleted event for user id %s", userDeletedEvent.Id)
+	return nil
+}
+
+func (s *userProducer) ProduceUserProfileUpdated(ctx context.Context, userProfileUpdatedEvent *event.UserProfileUpdatedEvent) error {
+	subject := "user.profile.updated"
+
+	err := s.messagingAdapter.Publish(ctx, subject, userProfileUpdatedEvent)
+	if err != nil {
+		return fmt.Errorf("failed to publish user profile updated event: %w", err)
+	}
+
+	log.Printf("Published user profile updated event for user id %s", userProfileUpdatedEvent.Id)
+	return nil
+}
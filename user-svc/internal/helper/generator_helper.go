@@ -1,7 +1,9 @@
 package helper
 
 import (
+	cryptorand "crypto/rand"
 	"fmt"
+	"math/big"
 	"math/rand/v2"
 	"time"
 )
@@ -22,3 +24,31 @@ func GenerateNickname() string {
 
 	return fmt.Sprintf("%s%s%d", adj, noun, number)
 }
+
+// GenerateOTPCode returns a six digit numeric code from a cryptographically
+// secure source.
+func GenerateOTPCode() (string, error) {
+	n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateRecoveryCode returns a one time 2FA recovery code formatted as
+// xxxxx-xxxxx, ambiguous characters are left out of the alphabet.
+func GenerateRecoveryCode() (string, error) {
+	code := make([]byte, 10)
+	for i := range code {
+		n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(len(recoveryCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = recoveryCodeAlphabet[n.Int64()]
+	}
+
+	return string(code[:5]) + "-" + string(code[5:]), nil
+}
@@ -102,3 +102,14 @@ func ToSQLString(input *string) sql.NullString {
 		Valid:  true,
 	}
 }
+
+// ToSQLStringOmitEmpty mengonversi string ke sql.NullString, string kosong dianggap NULL
+func ToSQLStringOmitEmpty(input string) sql.NullString {
+	if input == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{
+		String: input,
+		Valid:  true,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 )
 
@@ -41,3 +42,28 @@ func GeneratePageURLs(baseURL string, metadata *model.PageMetadata) {
 		metadata.PreviousPageURL = parsedURL.String()
 	}
 }
+
+func GenerateCursorURL(baseURL string, metadata *model.CursorMetadata) {
+	if !metadata.HasMore {
+		return
+	}
+
+	parsedURL, _ := url.Parse(baseURL)
+	q := parsedURL.Query()
+	q.Set("cursor", metadata.NextCursor)
+	q.Set("size", strconv.Itoa(metadata.Size))
+	parsedURL.RawQuery = q.Encode()
+	metadata.NextPageURL = parsedURL.String()
+}
+
+func NewCursorMetadata(size int, hasMore bool) *model.CursorMetadata {
+	return &model.CursorMetadata{
+		Size:    size,
+		HasMore: hasMore,
+	}
+}
+
+// IsCursorPagination reports whether the client opted in to keyset pagination, an empty cursor param asks for the first page
+func IsCursorPagination(ctx *fiber.Ctx) bool {
+	return ctx.Context().QueryArgs().Has("cursor")
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/auth_client_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/auth_client_adapter.go -destination=./mocks/adapter/mock_auth_client_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auth "firebase.google.com/go/v4/auth"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthClientAdapter is a mock of AuthClientAdapter interface.
+type MockAuthClientAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthClientAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthClientAdapterMockRecorder is the mock recorder for MockAuthClientAdapter.
+type MockAuthClientAdapterMockRecorder struct {
+	mock *MockAuthClientAdapter
+}
+
+// NewMockAuthClientAdapter creates a new mock instance.
+func NewMockAuthClientAdapter(ctrl *gomock.Controller) *MockAuthClientAdapter {
+	mock := &MockAuthClientAdapter{ctrl: ctrl}
+	mock.recorder = &MockAuthClientAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthClientAdapter) EXPECT() *MockAuthClientAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreateOIDCProviderConfig mocks base method.
+func (m *MockAuthClientAdapter) CreateOIDCProviderConfig(ctx context.Context, config *auth.OIDCProviderConfigToCreate) (*auth.OIDCProviderConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOIDCProviderConfig", ctx, config)
+	ret0, _ := ret[0].(*auth.OIDCProviderConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOIDCProviderConfig indicates an expected call of CreateOIDCProviderConfig.
+func (mr *MockAuthClientAdapterMockRecorder) CreateOIDCProviderConfig(ctx, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOIDCProviderConfig", reflect.TypeOf((*MockAuthClientAdapter)(nil).CreateOIDCProviderConfig), ctx, config)
+}
+
+// CustomToken mocks base method.
+func (m *MockAuthClientAdapter) CustomToken(ctx context.Context, uid string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomToken", ctx, uid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CustomToken indicates an expected call of CustomToken.
+func (mr *MockAuthClientAdapterMockRecorder) CustomToken(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomToken", reflect.TypeOf((*MockAuthClientAdapter)(nil).CustomToken), ctx, uid)
+}
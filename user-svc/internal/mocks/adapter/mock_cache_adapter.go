@@ -60,6 +60,20 @@ func (mr *MockCacheAdapterMockRecorder) Del(ctx any, keys ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockCacheAdapter)(nil).Del), varargs...)
 }
 
+// Expire mocks base method.
+func (m *MockCacheAdapter) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, key, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockCacheAdapterMockRecorder) Expire(ctx, key, expiration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockCacheAdapter)(nil).Expire), ctx, key, expiration)
+}
+
 // Get mocks base method.
 func (m *MockCacheAdapter) Get(ctx context.Context, key string) (string, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +147,21 @@ func (mr *MockCacheAdapterMockRecorder) HSet(ctx, key any, values ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockCacheAdapter)(nil).HSet), varargs...)
 }
 
+// Incr mocks base method.
+func (m *MockCacheAdapter) Incr(ctx context.Context, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Incr indicates an expected call of Incr.
+func (mr *MockCacheAdapterMockRecorder) Incr(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockCacheAdapter)(nil).Incr), ctx, key)
+}
+
 // SAdd mocks base method.
 func (m *MockCacheAdapter) SAdd(ctx context.Context, key string, members ...any) error {
 	m.ctrl.T.Helper()
@@ -166,6 +195,21 @@ func (mr *MockCacheAdapterMockRecorder) Set(ctx, key, value, expiration any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheAdapter)(nil).Set), ctx, key, value, expiration)
 }
 
+// SetNX mocks base method.
+func (m *MockCacheAdapter) SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, key, value, expiration)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockCacheAdapterMockRecorder) SetNX(ctx, key, value, expiration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockCacheAdapter)(nil).SetNX), ctx, key, value, expiration)
+}
+
 // TTL mocks base method.
 func (m *MockCacheAdapter) TTL(ctx context.Context, key string) (time.Duration, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/identity_provider_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/identity_provider_adapter.go -destination=./mocks/adapter/mock_identity_provider_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	enum "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdentityProviderAdapter is a mock of IdentityProviderAdapter interface.
+type MockIdentityProviderAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityProviderAdapterMockRecorder is the mock recorder for MockIdentityProviderAdapter.
+type MockIdentityProviderAdapterMockRecorder struct {
+	mock *MockIdentityProviderAdapter
+}
+
+// NewMockIdentityProviderAdapter creates a new mock instance.
+func NewMockIdentityProviderAdapter(ctrl *gomock.Controller) *MockIdentityProviderAdapter {
+	mock := &MockIdentityProviderAdapter{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProviderAdapter) EXPECT() *MockIdentityProviderAdapterMockRecorder {
+	return m.recorder
+}
+
+// IsSupported mocks base method.
+func (m *MockIdentityProviderAdapter) IsSupported(provider enum.IdentityProviderEnum) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSupported", provider)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSupported indicates an expected call of IsSupported.
+func (mr *MockIdentityProviderAdapterMockRecorder) IsSupported(provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSupported", reflect.TypeOf((*MockIdentityProviderAdapter)(nil).IsSupported), provider)
+}
+
+// VerifyIdToken mocks base method.
+func (m *MockIdentityProviderAdapter) VerifyIdToken(ctx context.Context, provider enum.IdentityProviderEnum, idToken string) (*model.IdentityClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIdToken", ctx, provider, idToken)
+	ret0, _ := ret[0].(*model.IdentityClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIdToken indicates an expected call of VerifyIdToken.
+func (mr *MockIdentityProviderAdapterMockRecorder) VerifyIdToken(ctx, provider, idToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIdToken", reflect.TypeOf((*MockIdentityProviderAdapter)(nil).VerifyIdToken), ctx, provider, idToken)
+}
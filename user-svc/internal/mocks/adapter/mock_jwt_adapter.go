@@ -41,33 +41,33 @@ func (m *MockJWTAdapter) EXPECT() *MockJWTAdapterMockRecorder {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockJWTAdapter) GenerateAccessToken(userId string) (*entity.AccessToken, error) {
+func (m *MockJWTAdapter) GenerateAccessToken(userId, sessionId string, roles []string) (*entity.AccessToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", userId)
+	ret := m.ctrl.Call(m, "GenerateAccessToken", userId, sessionId, roles)
 	ret0, _ := ret[0].(*entity.AccessToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockJWTAdapterMockRecorder) GenerateAccessToken(userId any) *gomock.Call {
+func (mr *MockJWTAdapterMockRecorder) GenerateAccessToken(userId, sessionId, roles any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTAdapter)(nil).GenerateAccessToken), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockJWTAdapter)(nil).GenerateAccessToken), userId, sessionId, roles)
 }
 
 // GenerateRefreshToken mocks base method.
-func (m *MockJWTAdapter) GenerateRefreshToken(userId string) (*entity.RefreshToken, error) {
+func (m *MockJWTAdapter) GenerateRefreshToken(userId, sessionId, tokenId string) (*entity.RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateRefreshToken", userId)
+	ret := m.ctrl.Call(m, "GenerateRefreshToken", userId, sessionId, tokenId)
 	ret0, _ := ret[0].(*entity.RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
-func (mr *MockJWTAdapterMockRecorder) GenerateRefreshToken(userId any) *gomock.Call {
+func (mr *MockJWTAdapterMockRecorder) GenerateRefreshToken(userId, sessionId, tokenId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockJWTAdapter)(nil).GenerateRefreshToken), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockJWTAdapter)(nil).GenerateRefreshToken), userId, sessionId, tokenId)
 }
 
 // VerifyAccessToken mocks base method.
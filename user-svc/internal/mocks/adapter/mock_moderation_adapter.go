@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/moderation_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/moderation_adapter.go -destination=./mocks/adapter/mock_moderation_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModerationClassifier is a mock of ModerationClassifier interface.
+type MockModerationClassifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationClassifierMockRecorder
+	isgomock struct{}
+}
+
+// MockModerationClassifierMockRecorder is the mock recorder for MockModerationClassifier.
+type MockModerationClassifierMockRecorder struct {
+	mock *MockModerationClassifier
+}
+
+// NewMockModerationClassifier creates a new mock instance.
+func NewMockModerationClassifier(ctrl *gomock.Controller) *MockModerationClassifier {
+	mock := &MockModerationClassifier{ctrl: ctrl}
+	mock.recorder = &MockModerationClassifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationClassifier) EXPECT() *MockModerationClassifierMockRecorder {
+	return m.recorder
+}
+
+// IsToxicMessage mocks base method.
+func (m *MockModerationClassifier) IsToxicMessage(msg string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsToxicMessage", msg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsToxicMessage indicates an expected call of IsToxicMessage.
+func (mr *MockModerationClassifierMockRecorder) IsToxicMessage(msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsToxicMessage", reflect.TypeOf((*MockModerationClassifier)(nil).IsToxicMessage), msg)
+}
+
+// MockModerationAdapter is a mock of ModerationAdapter interface.
+type MockModerationAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockModerationAdapterMockRecorder is the mock recorder for MockModerationAdapter.
+type MockModerationAdapterMockRecorder struct {
+	mock *MockModerationAdapter
+}
+
+// NewMockModerationAdapter creates a new mock instance.
+func NewMockModerationAdapter(ctrl *gomock.Controller) *MockModerationAdapter {
+	mock := &MockModerationAdapter{ctrl: ctrl}
+	mock.recorder = &MockModerationAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationAdapter) EXPECT() *MockModerationAdapterMockRecorder {
+	return m.recorder
+}
+
+// IsToxicMessage mocks base method.
+func (m *MockModerationAdapter) IsToxicMessage(msg string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsToxicMessage", msg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsToxicMessage indicates an expected call of IsToxicMessage.
+func (mr *MockModerationAdapterMockRecorder) IsToxicMessage(msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsToxicMessage", reflect.TypeOf((*MockModerationAdapter)(nil).IsToxicMessage), msg)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/notification_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/notification_adapter.go -destination=./mocks/adapter/mock_notification_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationAdapter is a mock of NotificationAdapter interface.
+type MockNotificationAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationAdapterMockRecorder is the mock recorder for MockNotificationAdapter.
+type MockNotificationAdapterMockRecorder struct {
+	mock *MockNotificationAdapter
+}
+
+// NewMockNotificationAdapter creates a new mock instance.
+func NewMockNotificationAdapter(ctrl *gomock.Controller) *MockNotificationAdapter {
+	mock := &MockNotificationAdapter{ctrl: ctrl}
+	mock.recorder = &MockNotificationAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationAdapter) EXPECT() *MockNotificationAdapterMockRecorder {
+	return m.recorder
+}
+
+// GetUserDevices mocks base method.
+func (m *MockNotificationAdapter) GetUserDevices(ctx context.Context, userId string) ([]*entity.UserDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDevices", ctx, userId)
+	ret0, _ := ret[0].([]*entity.UserDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDevices indicates an expected call of GetUserDevices.
+func (mr *MockNotificationAdapterMockRecorder) GetUserDevices(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDevices", reflect.TypeOf((*MockNotificationAdapter)(nil).GetUserDevices), ctx, userId)
+}
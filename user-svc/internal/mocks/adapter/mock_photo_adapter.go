@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/photo_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/photo_adapter.go -destination=./mocks/adapter/mock_photo_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPhotoAdapter is a mock of PhotoAdapter interface.
+type MockPhotoAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockPhotoAdapterMockRecorder is the mock recorder for MockPhotoAdapter.
+type MockPhotoAdapterMockRecorder struct {
+	mock *MockPhotoAdapter
+}
+
+// NewMockPhotoAdapter creates a new mock instance.
+func NewMockPhotoAdapter(ctrl *gomock.Controller) *MockPhotoAdapter {
+	mock := &MockPhotoAdapter{ctrl: ctrl}
+	mock.recorder = &MockPhotoAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoAdapter) EXPECT() *MockPhotoAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreateCreator mocks base method.
+func (m *MockPhotoAdapter) CreateCreator(ctx context.Context, userId string) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCreator", ctx, userId)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCreator indicates an expected call of CreateCreator.
+func (mr *MockPhotoAdapterMockRecorder) CreateCreator(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCreator", reflect.TypeOf((*MockPhotoAdapter)(nil).CreateCreator), ctx, userId)
+}
+
+// GetChatPhotoPreviews mocks base method.
+func (m *MockPhotoAdapter) GetChatPhotoPreviews(ctx context.Context, userId string, photoIds []string) ([]*model.ChatPhotoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatPhotoPreviews", ctx, userId, photoIds)
+	ret0, _ := ret[0].([]*model.ChatPhotoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatPhotoPreviews indicates an expected call of GetChatPhotoPreviews.
+func (mr *MockPhotoAdapterMockRecorder) GetChatPhotoPreviews(ctx, userId, photoIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatPhotoPreviews", reflect.TypeOf((*MockPhotoAdapter)(nil).GetChatPhotoPreviews), ctx, userId, photoIds)
+}
+
+// GetCreator mocks base method.
+func (m *MockPhotoAdapter) GetCreator(ctx context.Context, creatorId string) (*entity.Creator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreator", ctx, creatorId)
+	ret0, _ := ret[0].(*entity.Creator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreator indicates an expected call of GetCreator.
+func (mr *MockPhotoAdapterMockRecorder) GetCreator(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreator", reflect.TypeOf((*MockPhotoAdapter)(nil).GetCreator), ctx, creatorId)
+}
+
+// GetCreatorUserIds mocks base method.
+func (m *MockPhotoAdapter) GetCreatorUserIds(ctx context.Context, creatorIds []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreatorUserIds", ctx, creatorIds)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCreatorUserIds indicates an expected call of GetCreatorUserIds.
+func (mr *MockPhotoAdapterMockRecorder) GetCreatorUserIds(ctx, creatorIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreatorUserIds", reflect.TypeOf((*MockPhotoAdapter)(nil).GetCreatorUserIds), ctx, creatorIds)
+}
+
+// GetUserDataExport mocks base method.
+func (m *MockPhotoAdapter) GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchivePurchase, []*model.UserDataArchiveFacecam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDataExport", ctx, userId)
+	ret0, _ := ret[0].([]*model.UserDataArchivePurchase)
+	ret1, _ := ret[1].([]*model.UserDataArchiveFacecam)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataExport indicates an expected call of GetUserDataExport.
+func (mr *MockPhotoAdapterMockRecorder) GetUserDataExport(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataExport", reflect.TypeOf((*MockPhotoAdapter)(nil).GetUserDataExport), ctx, userId)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/rate_limiter_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/rate_limiter_adapter.go -destination=./mocks/adapter/mock_rate_limiter_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRateLimiterAdapter is a mock of RateLimiterAdapter interface.
+type MockRateLimiterAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimiterAdapterMockRecorder is the mock recorder for MockRateLimiterAdapter.
+type MockRateLimiterAdapterMockRecorder struct {
+	mock *MockRateLimiterAdapter
+}
+
+// NewMockRateLimiterAdapter creates a new mock instance.
+func NewMockRateLimiterAdapter(ctrl *gomock.Controller) *MockRateLimiterAdapter {
+	mock := &MockRateLimiterAdapter{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiterAdapter) EXPECT() *MockRateLimiterAdapterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimiterAdapter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit, window)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterAdapterMockRecorder) Allow(ctx, key, limit, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiterAdapter)(nil).Allow), ctx, key, limit, window)
+}
+
+// Reset mocks base method.
+func (m *MockRateLimiterAdapter) Reset(ctx context.Context, keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reset", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockRateLimiterAdapterMockRecorder) Reset(ctx any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockRateLimiterAdapter)(nil).Reset), varargs...)
+}
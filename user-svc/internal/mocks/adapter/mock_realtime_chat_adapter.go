@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=./adapter/realtime_chat_adapter.go -destination=./mocks/adapter/realtime_chat_adapter.go -package=mockadapter
+//	mockgen -source=./adapter/realtime_chat_adapter.go -destination=./mocks/adapter/mock_realtime_chat_adapter.go -package=mockadapter
 //
 
 // Package mockadapter is a generated GoMock package.
@@ -13,7 +13,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	firestore "cloud.google.com/go/firestore"
 	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,44 +54,58 @@ func (mr *MockRealtimeChatAdapterMockRecorder) CreateChatRoom(ctx, user, userPro
 }
 
 // CreateRoom mocks base method.
-func (m *MockRealtimeChatAdapter) CreateRoom(ctx context.Context, roomUserId string, participants []string) error {
+func (m *MockRealtimeChatAdapter) CreateRoom(ctx context.Context, room *entity.ChatRoom, memberIds []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoom", ctx, roomUserId, participants)
+	ret := m.ctrl.Call(m, "CreateRoom", ctx, room, memberIds)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateRoom indicates an expected call of CreateRoom.
-func (mr *MockRealtimeChatAdapterMockRecorder) CreateRoom(ctx, roomUserId, participants any) *gomock.Call {
+func (mr *MockRealtimeChatAdapterMockRecorder) CreateRoom(ctx, room, memberIds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).CreateRoom), ctx, roomUserId, participants)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).CreateRoom), ctx, room, memberIds)
 }
 
-// GetRoom mocks base method.
-func (m *MockRealtimeChatAdapter) GetRoom(ctx context.Context, roomUserId string) ([]*firestore.DocumentSnapshot, error) {
+// FindRoomId mocks base method.
+func (m *MockRealtimeChatAdapter) FindRoomId(ctx context.Context, roomUserId string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRoom", ctx, roomUserId)
-	ret0, _ := ret[0].([]*firestore.DocumentSnapshot)
+	ret := m.ctrl.Call(m, "FindRoomId", ctx, roomUserId)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRoom indicates an expected call of GetRoom.
-func (mr *MockRealtimeChatAdapterMockRecorder) GetRoom(ctx, roomUserId any) *gomock.Call {
+// FindRoomId indicates an expected call of FindRoomId.
+func (mr *MockRealtimeChatAdapterMockRecorder) FindRoomId(ctx, roomUserId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoom", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).GetRoom), ctx, roomUserId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRoomId", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).FindRoomId), ctx, roomUserId)
+}
+
+// MarkRead mocks base method.
+func (m *MockRealtimeChatAdapter) MarkRead(ctx context.Context, member *entity.ChatRoomMember, memberIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, member, memberIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRealtimeChatAdapterMockRecorder) MarkRead(ctx, member, memberIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).MarkRead), ctx, member, memberIds)
 }
 
 // SendMessage mocks base method.
-func (m *MockRealtimeChatAdapter) SendMessage(ctx context.Context, roomId, senderId, safeMessage string) error {
+func (m *MockRealtimeChatAdapter) SendMessage(ctx context.Context, message *entity.ChatMessage, memberIds []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", ctx, roomId, senderId, safeMessage)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, message, memberIds)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockRealtimeChatAdapterMockRecorder) SendMessage(ctx, roomId, senderId, safeMessage any) *gomock.Call {
+func (mr *MockRealtimeChatAdapterMockRecorder) SendMessage(ctx, message, memberIds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).SendMessage), ctx, roomId, senderId, safeMessage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockRealtimeChatAdapter)(nil).SendMessage), ctx, message, memberIds)
 }
@@ -68,3 +68,17 @@ func (mr *MockSecurityAdapterMockRecorder) Encrypt(plaintext any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockSecurityAdapter)(nil).Encrypt), plaintext)
 }
+
+// Hash mocks base method.
+func (m *MockSecurityAdapter) Hash(value string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", value)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockSecurityAdapterMockRecorder) Hash(value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockSecurityAdapter)(nil).Hash), value)
+}
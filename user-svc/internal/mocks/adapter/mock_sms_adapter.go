@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/sms_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/sms_adapter.go -destination=./mocks/adapter/mock_sms_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSmsAdapter is a mock of SmsAdapter interface.
+type MockSmsAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSmsAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockSmsAdapterMockRecorder is the mock recorder for MockSmsAdapter.
+type MockSmsAdapterMockRecorder struct {
+	mock *MockSmsAdapter
+}
+
+// NewMockSmsAdapter creates a new mock instance.
+func NewMockSmsAdapter(ctrl *gomock.Controller) *MockSmsAdapter {
+	mock := &MockSmsAdapter{ctrl: ctrl}
+	mock.recorder = &MockSmsAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSmsAdapter) EXPECT() *MockSmsAdapterMockRecorder {
+	return m.recorder
+}
+
+// SendNotice mocks base method.
+func (m *MockSmsAdapter) SendNotice(ctx context.Context, phoneNumber, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNotice", ctx, phoneNumber, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendNotice indicates an expected call of SendNotice.
+func (mr *MockSmsAdapterMockRecorder) SendNotice(ctx, phoneNumber, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNotice", reflect.TypeOf((*MockSmsAdapter)(nil).SendNotice), ctx, phoneNumber, message)
+}
+
+// SendOTP mocks base method.
+func (m *MockSmsAdapter) SendOTP(ctx context.Context, phoneNumber, code string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOTP", ctx, phoneNumber, code, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendOTP indicates an expected call of SendOTP.
+func (mr *MockSmsAdapterMockRecorder) SendOTP(ctx, phoneNumber, code, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOTP", reflect.TypeOf((*MockSmsAdapter)(nil).SendOTP), ctx, phoneNumber, code, ttl)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/totp_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/totp_adapter.go -destination=./mocks/adapter/mock_totp_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTOTPAdapter is a mock of TOTPAdapter interface.
+type MockTOTPAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTOTPAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockTOTPAdapterMockRecorder is the mock recorder for MockTOTPAdapter.
+type MockTOTPAdapterMockRecorder struct {
+	mock *MockTOTPAdapter
+}
+
+// NewMockTOTPAdapter creates a new mock instance.
+func NewMockTOTPAdapter(ctrl *gomock.Controller) *MockTOTPAdapter {
+	mock := &MockTOTPAdapter{ctrl: ctrl}
+	mock.recorder = &MockTOTPAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTOTPAdapter) EXPECT() *MockTOTPAdapterMockRecorder {
+	return m.recorder
+}
+
+// GenerateSecret mocks base method.
+func (m *MockTOTPAdapter) GenerateSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockTOTPAdapterMockRecorder) GenerateSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockTOTPAdapter)(nil).GenerateSecret))
+}
+
+// KeyURI mocks base method.
+func (m *MockTOTPAdapter) KeyURI(accountName, secret string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyURI", accountName, secret)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// KeyURI indicates an expected call of KeyURI.
+func (mr *MockTOTPAdapterMockRecorder) KeyURI(accountName, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyURI", reflect.TypeOf((*MockTOTPAdapter)(nil).KeyURI), accountName, secret)
+}
+
+// Validate mocks base method.
+func (m *MockTOTPAdapter) Validate(secret, code string, at time.Time) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", secret, code, at)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockTOTPAdapterMockRecorder) Validate(secret, code, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockTOTPAdapter)(nil).Validate), secret, code, at)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/transaction_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/transaction_adapter.go -destination=./mocks/adapter/mock_transaction_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransactionAdapter is a mock of TransactionAdapter interface.
+type MockTransactionAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionAdapterMockRecorder is the mock recorder for MockTransactionAdapter.
+type MockTransactionAdapterMockRecorder struct {
+	mock *MockTransactionAdapter
+}
+
+// NewMockTransactionAdapter creates a new mock instance.
+func NewMockTransactionAdapter(ctrl *gomock.Controller) *MockTransactionAdapter {
+	mock := &MockTransactionAdapter{ctrl: ctrl}
+	mock.recorder = &MockTransactionAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionAdapter) EXPECT() *MockTransactionAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockTransactionAdapter) CreateWallet(ctx context.Context, creatorId string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, creatorId)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockTransactionAdapterMockRecorder) CreateWallet(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockTransactionAdapter)(nil).CreateWallet), ctx, creatorId)
+}
+
+// GetUserDataExport mocks base method.
+func (m *MockTransactionAdapter) GetUserDataExport(ctx context.Context, userId string) ([]*model.UserDataArchiveTransaction, []*model.UserDataArchiveReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDataExport", ctx, userId)
+	ret0, _ := ret[0].([]*model.UserDataArchiveTransaction)
+	ret1, _ := ret[1].([]*model.UserDataArchiveReview)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataExport indicates an expected call of GetUserDataExport.
+func (mr *MockTransactionAdapterMockRecorder) GetUserDataExport(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataExport", reflect.TypeOf((*MockTransactionAdapter)(nil).GetUserDataExport), ctx, userId)
+}
+
+// GetWallet mocks base method.
+func (m *MockTransactionAdapter) GetWallet(ctx context.Context, creatorId string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, creatorId)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockTransactionAdapterMockRecorder) GetWallet(ctx, creatorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockTransactionAdapter)(nil).GetWallet), ctx, creatorId)
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/upload_adapter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./adapter/upload_adapter.go -destination=./mocks/adapter/mock_upload_adapter.go -package=mockadapter
+//
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	context "context"
+	io "io"
+	multipart "mime/multipart"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUploadAdapter is a mock of UploadAdapter interface.
+type MockUploadAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadAdapterMockRecorder
+	isgomock struct{}
+}
+
+// MockUploadAdapterMockRecorder is the mock recorder for MockUploadAdapter.
+type MockUploadAdapterMockRecorder struct {
+	mock *MockUploadAdapter
+}
+
+// NewMockUploadAdapter creates a new mock instance.
+func NewMockUploadAdapter(ctrl *gomock.Controller) *MockUploadAdapter {
+	mock := &MockUploadAdapter{ctrl: ctrl}
+	mock.recorder = &MockUploadAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadAdapter) EXPECT() *MockUploadAdapterMockRecorder {
+	return m.recorder
+}
+
+// DeleteFile mocks base method.
+func (m *MockUploadAdapter) DeleteFile(ctx context.Context, fileName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockUploadAdapterMockRecorder) DeleteFile(ctx, fileName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockUploadAdapter)(nil).DeleteFile), ctx, fileName)
+}
+
+// DeletePublicFile mocks base method.
+func (m *MockUploadAdapter) DeletePublicFile(ctx context.Context, fileName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePublicFile", ctx, fileName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePublicFile indicates an expected call of DeletePublicFile.
+func (mr *MockUploadAdapterMockRecorder) DeletePublicFile(ctx, fileName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublicFile", reflect.TypeOf((*MockUploadAdapter)(nil).DeletePublicFile), ctx, fileName)
+}
+
+// GetPresignedUrl mocks base method.
+func (m *MockUploadAdapter) GetPresignedUrl(ctx context.Context, fileKey string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresignedUrl", ctx, fileKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresignedUrl indicates an expected call of GetPresignedUrl.
+func (mr *MockUploadAdapterMockRecorder) GetPresignedUrl(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedUrl", reflect.TypeOf((*MockUploadAdapter)(nil).GetPresignedUrl), ctx, fileKey)
+}
+
+// GetPresignedUrlWithExpiry mocks base method.
+func (m *MockUploadAdapter) GetPresignedUrlWithExpiry(ctx context.Context, fileKey string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresignedUrlWithExpiry", ctx, fileKey, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresignedUrlWithExpiry indicates an expected call of GetPresignedUrlWithExpiry.
+func (mr *MockUploadAdapterMockRecorder) GetPresignedUrlWithExpiry(ctx, fileKey, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedUrlWithExpiry", reflect.TypeOf((*MockUploadAdapter)(nil).GetPresignedUrlWithExpiry), ctx, fileKey, expiry)
+}
+
+// PutObject mocks base method.
+func (m *MockUploadAdapter) PutObject(ctx context.Context, fileKey string, reader io.Reader, size int64, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", ctx, fileKey, reader, size, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockUploadAdapterMockRecorder) PutObject(ctx, fileKey, reader, size, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockUploadAdapter)(nil).PutObject), ctx, fileKey, reader, size, contentType)
+}
+
+// StatFile mocks base method.
+func (m *MockUploadAdapter) StatFile(ctx context.Context, fileKey string) (*model.MinioFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatFile", ctx, fileKey)
+	ret0, _ := ret[0].(*model.MinioFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatFile indicates an expected call of StatFile.
+func (mr *MockUploadAdapterMockRecorder) StatFile(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatFile", reflect.TypeOf((*MockUploadAdapter)(nil).StatFile), ctx, fileKey)
+}
+
+// UploadFile mocks base method.
+func (m *MockUploadAdapter) UploadFile(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, file, uploadFile, path)
+	ret0, _ := ret[0].(*model.MinioFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockUploadAdapterMockRecorder) UploadFile(ctx, file, uploadFile, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockUploadAdapter)(nil).UploadFile), ctx, file, uploadFile, path)
+}
+
+// UploadPublicFile mocks base method.
+func (m *MockUploadAdapter) UploadPublicFile(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPublicFile", ctx, file, uploadFile, path)
+	ret0, _ := ret[0].(*model.MinioFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPublicFile indicates an expected call of UploadPublicFile.
+func (mr *MockUploadAdapterMockRecorder) UploadPublicFile(ctx, file, uploadFile, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPublicFile", reflect.TypeOf((*MockUploadAdapter)(nil).UploadPublicFile), ctx, file, uploadFile, path)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=./gateway/producer/user_producer.go -destination=./mocks/gateway/producer/user_producer.go -package=mockproducer
+//	mockgen -source=./gateway/producer/user_producer.go -destination=./mocks/gateway/producer/mock_user_producer.go -package=mockproducer
 //
 
 // Package mockproducer is a generated GoMock package.
@@ -54,3 +54,45 @@ func (mr *MockUserProducerMockRecorder) ProduceUserCreated(ctx, userEvent any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceUserCreated", reflect.TypeOf((*MockUserProducer)(nil).ProduceUserCreated), ctx, userEvent)
 }
+
+// ProduceUserDeleted mocks base method.
+func (m *MockUserProducer) ProduceUserDeleted(ctx context.Context, userDeletedEvent *event.UserDeletedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceUserDeleted", ctx, userDeletedEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceUserDeleted indicates an expected call of ProduceUserDeleted.
+func (mr *MockUserProducerMockRecorder) ProduceUserDeleted(ctx, userDeletedEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceUserDeleted", reflect.TypeOf((*MockUserProducer)(nil).ProduceUserDeleted), ctx, userDeletedEvent)
+}
+
+// ProduceUserDeviceCreated mocks base method.
+func (m *MockUserProducer) ProduceUserDeviceCreated(ctx context.Context, userDeviceEvent *event.UserDeviceEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceUserDeviceCreated", ctx, userDeviceEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceUserDeviceCreated indicates an expected call of ProduceUserDeviceCreated.
+func (mr *MockUserProducerMockRecorder) ProduceUserDeviceCreated(ctx, userDeviceEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceUserDeviceCreated", reflect.TypeOf((*MockUserProducer)(nil).ProduceUserDeviceCreated), ctx, userDeviceEvent)
+}
+
+// ProduceUserProfileUpdated mocks base method.
+func (m *MockUserProducer) ProduceUserProfileUpdated(ctx context.Context, userProfileUpdatedEvent *event.UserProfileUpdatedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceUserProfileUpdated", ctx, userProfileUpdatedEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceUserProfileUpdated indicates an expected call of ProduceUserProfileUpdated.
+func (mr *MockUserProducerMockRecorder) ProduceUserProfileUpdated(ctx, userProfileUpdatedEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceUserProfileUpdated", reflect.TypeOf((*MockUserProducer)(nil).ProduceUserProfileUpdated), ctx, userProfileUpdatedEvent)
+}
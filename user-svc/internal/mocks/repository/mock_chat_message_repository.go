@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/chat_message_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/chat_message_repository.go -destination=./mocks/repository/mock_chat_message_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatMessageRepository is a mock of ChatMessageRepository interface.
+type MockChatMessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatMessageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatMessageRepositoryMockRecorder is the mock recorder for MockChatMessageRepository.
+type MockChatMessageRepositoryMockRecorder struct {
+	mock *MockChatMessageRepository
+}
+
+// NewMockChatMessageRepository creates a new mock instance.
+func NewMockChatMessageRepository(ctrl *gomock.Controller) *MockChatMessageRepository {
+	mock := &MockChatMessageRepository{ctrl: ctrl}
+	mock.recorder = &MockChatMessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatMessageRepository) EXPECT() *MockChatMessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChatMessageRepository) Create(ctx context.Context, tx repository.Querier, message *entity.ChatMessage) (*entity.ChatMessage, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, message)
+	ret0, _ := ret[0].(*entity.ChatMessage)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChatMessageRepositoryMockRecorder) Create(ctx, tx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChatMessageRepository)(nil).Create), ctx, tx, message)
+}
+
+// CreateSystem mocks base method.
+func (m *MockChatMessageRepository) CreateSystem(ctx context.Context, tx repository.Querier, message *entity.ChatMessage) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSystem", ctx, tx, message)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSystem indicates an expected call of CreateSystem.
+func (mr *MockChatMessageRepositoryMockRecorder) CreateSystem(ctx, tx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystem", reflect.TypeOf((*MockChatMessageRepository)(nil).CreateSystem), ctx, tx, message)
+}
+
+// FindByCursor mocks base method.
+func (m *MockChatMessageRepository) FindByCursor(ctx context.Context, tx repository.Querier, roomId string, cursor *pagination.Cursor, size int) ([]*entity.ChatMessage, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, tx, roomId, cursor, size)
+	ret0, _ := ret[0].([]*entity.ChatMessage)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockChatMessageRepositoryMockRecorder) FindByCursor(ctx, tx, roomId, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockChatMessageRepository)(nil).FindByCursor), ctx, tx, roomId, cursor, size)
+}
+
+// FindById mocks base method.
+func (m *MockChatMessageRepository) FindById(ctx context.Context, tx repository.Querier, messageId string) (*entity.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, messageId)
+	ret0, _ := ret[0].(*entity.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockChatMessageRepositoryMockRecorder) FindById(ctx, tx, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockChatMessageRepository)(nil).FindById), ctx, tx, messageId)
+}
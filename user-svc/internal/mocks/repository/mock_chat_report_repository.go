@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/chat_report_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/chat_report_repository.go -destination=./mocks/repository/mock_chat_report_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatReportRepository is a mock of ChatReportRepository interface.
+type MockChatReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatReportRepositoryMockRecorder is the mock recorder for MockChatReportRepository.
+type MockChatReportRepositoryMockRecorder struct {
+	mock *MockChatReportRepository
+}
+
+// NewMockChatReportRepository creates a new mock instance.
+func NewMockChatReportRepository(ctrl *gomock.Controller) *MockChatReportRepository {
+	mock := &MockChatReportRepository{ctrl: ctrl}
+	mock.recorder = &MockChatReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatReportRepository) EXPECT() *MockChatReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChatReportRepository) Create(ctx context.Context, tx repository.Querier, report *entity.ChatReport) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, report)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChatReportRepositoryMockRecorder) Create(ctx, tx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChatReportRepository)(nil).Create), ctx, tx, report)
+}
+
+// FindByCursor mocks base method.
+func (m *MockChatReportRepository) FindByCursor(ctx context.Context, tx repository.Querier, status enum.ChatReportStatusEnum, cursor *pagination.Cursor, size int) ([]*entity.ChatReport, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, tx, status, cursor, size)
+	ret0, _ := ret[0].([]*entity.ChatReport)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockChatReportRepositoryMockRecorder) FindByCursor(ctx, tx, status, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockChatReportRepository)(nil).FindByCursor), ctx, tx, status, cursor, size)
+}
+
+// FindById mocks base method.
+func (m *MockChatReportRepository) FindById(ctx context.Context, tx repository.Querier, reportId string) (*entity.ChatReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, reportId)
+	ret0, _ := ret[0].(*entity.ChatReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockChatReportRepositoryMockRecorder) FindById(ctx, tx, reportId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockChatReportRepository)(nil).FindById), ctx, tx, reportId)
+}
+
+// Review mocks base method.
+func (m *MockChatReportRepository) Review(ctx context.Context, tx repository.Querier, report *entity.ChatReport) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review", ctx, tx, report)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockChatReportRepositoryMockRecorder) Review(ctx, tx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockChatReportRepository)(nil).Review), ctx, tx, report)
+}
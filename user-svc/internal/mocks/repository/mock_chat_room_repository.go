@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/chat_room_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/chat_room_repository.go -destination=./mocks/repository/mock_chat_room_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatRoomRepository is a mock of ChatRoomRepository interface.
+type MockChatRoomRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatRoomRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatRoomRepositoryMockRecorder is the mock recorder for MockChatRoomRepository.
+type MockChatRoomRepositoryMockRecorder struct {
+	mock *MockChatRoomRepository
+}
+
+// NewMockChatRoomRepository creates a new mock instance.
+func NewMockChatRoomRepository(ctrl *gomock.Controller) *MockChatRoomRepository {
+	mock := &MockChatRoomRepository{ctrl: ctrl}
+	mock.recorder = &MockChatRoomRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatRoomRepository) EXPECT() *MockChatRoomRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockChatRoomRepository) CountUnread(ctx context.Context, tx repository.Querier, userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, tx, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockChatRoomRepositoryMockRecorder) CountUnread(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockChatRoomRepository)(nil).CountUnread), ctx, tx, userId)
+}
+
+// Create mocks base method.
+func (m *MockChatRoomRepository) Create(ctx context.Context, tx repository.Querier, room *entity.ChatRoom, memberIds []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, room, memberIds)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChatRoomRepositoryMockRecorder) Create(ctx, tx, room, memberIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChatRoomRepository)(nil).Create), ctx, tx, room, memberIds)
+}
+
+// FindById mocks base method.
+func (m *MockChatRoomRepository) FindById(ctx context.Context, tx repository.Querier, roomId string) (*entity.ChatRoom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, roomId)
+	ret0, _ := ret[0].(*entity.ChatRoom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockChatRoomRepositoryMockRecorder) FindById(ctx, tx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockChatRoomRepository)(nil).FindById), ctx, tx, roomId)
+}
+
+// FindByRoomUserId mocks base method.
+func (m *MockChatRoomRepository) FindByRoomUserId(ctx context.Context, tx repository.Querier, roomUserId string) (*entity.ChatRoom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRoomUserId", ctx, tx, roomUserId)
+	ret0, _ := ret[0].(*entity.ChatRoom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRoomUserId indicates an expected call of FindByRoomUserId.
+func (mr *MockChatRoomRepositoryMockRecorder) FindByRoomUserId(ctx, tx, roomUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRoomUserId", reflect.TypeOf((*MockChatRoomRepository)(nil).FindByRoomUserId), ctx, tx, roomUserId)
+}
+
+// FindMember mocks base method.
+func (m *MockChatRoomRepository) FindMember(ctx context.Context, tx repository.Querier, roomId, userId string) (*entity.ChatRoomMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMember", ctx, tx, roomId, userId)
+	ret0, _ := ret[0].(*entity.ChatRoomMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMember indicates an expected call of FindMember.
+func (mr *MockChatRoomRepositoryMockRecorder) FindMember(ctx, tx, roomId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMember", reflect.TypeOf((*MockChatRoomRepository)(nil).FindMember), ctx, tx, roomId, userId)
+}
+
+// FindMemberIds mocks base method.
+func (m *MockChatRoomRepository) FindMemberIds(ctx context.Context, tx repository.Querier, roomId string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMemberIds", ctx, tx, roomId)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMemberIds indicates an expected call of FindMemberIds.
+func (mr *MockChatRoomRepositoryMockRecorder) FindMemberIds(ctx, tx, roomId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMemberIds", reflect.TypeOf((*MockChatRoomRepository)(nil).FindMemberIds), ctx, tx, roomId)
+}
+
+// FindSummariesByCursor mocks base method.
+func (m *MockChatRoomRepository) FindSummariesByCursor(ctx context.Context, tx repository.Querier, userId string, cursor *pagination.Cursor, size int) ([]*entity.ChatRoomSummary, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSummariesByCursor", ctx, tx, userId, cursor, size)
+	ret0, _ := ret[0].([]*entity.ChatRoomSummary)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindSummariesByCursor indicates an expected call of FindSummariesByCursor.
+func (mr *MockChatRoomRepositoryMockRecorder) FindSummariesByCursor(ctx, tx, userId, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummariesByCursor", reflect.TypeOf((*MockChatRoomRepository)(nil).FindSummariesByCursor), ctx, tx, userId, cursor, size)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatRoomRepository) MarkRead(ctx context.Context, tx repository.Querier, roomId, userId string, message *entity.ChatMessage) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, tx, roomId, userId, message)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatRoomRepositoryMockRecorder) MarkRead(ctx, tx, roomId, userId, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatRoomRepository)(nil).MarkRead), ctx, tx, roomId, userId, message)
+}
+
+// UpdateLastMessage mocks base method.
+func (m *MockChatRoomRepository) UpdateLastMessage(ctx context.Context, tx repository.Querier, message *entity.ChatMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastMessage", ctx, tx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastMessage indicates an expected call of UpdateLastMessage.
+func (mr *MockChatRoomRepositoryMockRecorder) UpdateLastMessage(ctx, tx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastMessage", reflect.TypeOf((*MockChatRoomRepository)(nil).UpdateLastMessage), ctx, tx, message)
+}
@@ -56,6 +56,20 @@ func (mr *MockEmailVerificationRepositoryMockRecorder) Delete(ctx, tx, emailVeri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmailVerificationRepository)(nil).Delete), ctx, tx, emailVerification)
 }
 
+// DeleteByEmail mocks base method.
+func (m *MockEmailVerificationRepository) DeleteByEmail(ctx context.Context, tx repository.Querier, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByEmail", ctx, tx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByEmail indicates an expected call of DeleteByEmail.
+func (mr *MockEmailVerificationRepositoryMockRecorder) DeleteByEmail(ctx, tx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByEmail", reflect.TypeOf((*MockEmailVerificationRepository)(nil).DeleteByEmail), ctx, tx, email)
+}
+
 // FindByEmail mocks base method.
 func (m *MockEmailVerificationRepository) FindByEmail(ctx context.Context, email string) (*entity.EmailVerification, error) {
 	m.ctrl.T.Helper()
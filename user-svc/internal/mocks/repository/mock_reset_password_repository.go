@@ -71,6 +71,20 @@ func (mr *MockResetPasswordRepositoryMockRecorder) Delete(ctx, tx, resetPassword
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockResetPasswordRepository)(nil).Delete), ctx, tx, resetPassword)
 }
 
+// DeleteByEmail mocks base method.
+func (m *MockResetPasswordRepository) DeleteByEmail(ctx context.Context, tx repository.Querier, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByEmail", ctx, tx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByEmail indicates an expected call of DeleteByEmail.
+func (mr *MockResetPasswordRepositoryMockRecorder) DeleteByEmail(ctx, tx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByEmail", reflect.TypeOf((*MockResetPasswordRepository)(nil).DeleteByEmail), ctx, tx, email)
+}
+
 // FindByEmail mocks base method.
 func (m *MockResetPasswordRepository) FindByEmail(ctx context.Context, email string) (*entity.ResetPassword, error) {
 	m.ctrl.T.Helper()
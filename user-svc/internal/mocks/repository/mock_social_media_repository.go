@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/social_media_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/social_media_repository.go -destination=./mocks/repository/mock_social_media_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSocialMediaRepository is a mock of SocialMediaRepository interface.
+type MockSocialMediaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSocialMediaRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSocialMediaRepositoryMockRecorder is the mock recorder for MockSocialMediaRepository.
+type MockSocialMediaRepositoryMockRecorder struct {
+	mock *MockSocialMediaRepository
+}
+
+// NewMockSocialMediaRepository creates a new mock instance.
+func NewMockSocialMediaRepository(ctrl *gomock.Controller) *MockSocialMediaRepository {
+	mock := &MockSocialMediaRepository{ctrl: ctrl}
+	mock.recorder = &MockSocialMediaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSocialMediaRepository) EXPECT() *MockSocialMediaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByName mocks base method.
+func (m *MockSocialMediaRepository) CountByName(ctx context.Context, name string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByName", ctx, name)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByName indicates an expected call of CountByName.
+func (mr *MockSocialMediaRepositoryMockRecorder) CountByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByName", reflect.TypeOf((*MockSocialMediaRepository)(nil).CountByName), ctx, name)
+}
+
+// CountUserLinks mocks base method.
+func (m *MockSocialMediaRepository) CountUserLinks(ctx context.Context, id string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserLinks", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserLinks indicates an expected call of CountUserLinks.
+func (mr *MockSocialMediaRepositoryMockRecorder) CountUserLinks(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserLinks", reflect.TypeOf((*MockSocialMediaRepository)(nil).CountUserLinks), ctx, id)
+}
+
+// Delete mocks base method.
+func (m *MockSocialMediaRepository) Delete(ctx context.Context, tx repository.Querier, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSocialMediaRepositoryMockRecorder) Delete(ctx, tx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSocialMediaRepository)(nil).Delete), ctx, tx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockSocialMediaRepository) FindAll(ctx context.Context) (*[]*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].(*[]*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockSocialMediaRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockSocialMediaRepository)(nil).FindAll), ctx)
+}
+
+// FindAllActive mocks base method.
+func (m *MockSocialMediaRepository) FindAllActive(ctx context.Context) (*[]*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].(*[]*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockSocialMediaRepositoryMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockSocialMediaRepository)(nil).FindAllActive), ctx)
+}
+
+// FindById mocks base method.
+func (m *MockSocialMediaRepository) FindById(ctx context.Context, id string) (*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockSocialMediaRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSocialMediaRepository)(nil).FindById), ctx, id)
+}
+
+// FindByName mocks base method.
+func (m *MockSocialMediaRepository) FindByName(ctx context.Context, name string) (*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockSocialMediaRepositoryMockRecorder) FindByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockSocialMediaRepository)(nil).FindByName), ctx, name)
+}
+
+// Insert mocks base method.
+func (m *MockSocialMediaRepository) Insert(ctx context.Context, tx repository.Querier, socialMedia *entity.SocialMedia) (*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, tx, socialMedia)
+	ret0, _ := ret[0].(*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSocialMediaRepositoryMockRecorder) Insert(ctx, tx, socialMedia any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSocialMediaRepository)(nil).Insert), ctx, tx, socialMedia)
+}
+
+// Update mocks base method.
+func (m *MockSocialMediaRepository) Update(ctx context.Context, tx repository.Querier, socialMedia *entity.SocialMedia) (*entity.SocialMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, socialMedia)
+	ret0, _ := ret[0].(*entity.SocialMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSocialMediaRepositoryMockRecorder) Update(ctx, tx, socialMedia any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSocialMediaRepository)(nil).Update), ctx, tx, socialMedia)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_block_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_block_repository.go -destination=./mocks/repository/mock_user_block_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserBlockRepository is a mock of UserBlockRepository interface.
+type MockUserBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserBlockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserBlockRepositoryMockRecorder is the mock recorder for MockUserBlockRepository.
+type MockUserBlockRepositoryMockRecorder struct {
+	mock *MockUserBlockRepository
+}
+
+// NewMockUserBlockRepository creates a new mock instance.
+func NewMockUserBlockRepository(ctrl *gomock.Controller) *MockUserBlockRepository {
+	mock := &MockUserBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockUserBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserBlockRepository) EXPECT() *MockUserBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserBlockRepository) Create(ctx context.Context, tx repository.Querier, block *entity.UserBlock) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, block)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserBlockRepositoryMockRecorder) Create(ctx, tx, block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserBlockRepository)(nil).Create), ctx, tx, block)
+}
+
+// Delete mocks base method.
+func (m *MockUserBlockRepository) Delete(ctx context.Context, tx repository.Querier, blockerId, blockedId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, blockerId, blockedId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserBlockRepositoryMockRecorder) Delete(ctx, tx, blockerId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserBlockRepository)(nil).Delete), ctx, tx, blockerId, blockedId)
+}
+
+// ExistsBetween mocks base method.
+func (m *MockUserBlockRepository) ExistsBetween(ctx context.Context, tx repository.Querier, userId string, peerIds []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBetween", ctx, tx, userId, peerIds)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBetween indicates an expected call of ExistsBetween.
+func (mr *MockUserBlockRepositoryMockRecorder) ExistsBetween(ctx, tx, userId, peerIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBetween", reflect.TypeOf((*MockUserBlockRepository)(nil).ExistsBetween), ctx, tx, userId, peerIds)
+}
+
+// FindBlockedByCursor mocks base method.
+func (m *MockUserBlockRepository) FindBlockedByCursor(ctx context.Context, tx repository.Querier, blockerId string, cursor *pagination.Cursor, size int) ([]*entity.BlockedUser, *model.CursorMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlockedByCursor", ctx, tx, blockerId, cursor, size)
+	ret0, _ := ret[0].([]*entity.BlockedUser)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBlockedByCursor indicates an expected call of FindBlockedByCursor.
+func (mr *MockUserBlockRepositoryMockRecorder) FindBlockedByCursor(ctx, tx, blockerId, cursor, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlockedByCursor", reflect.TypeOf((*MockUserBlockRepository)(nil).FindBlockedByCursor), ctx, tx, blockerId, cursor, size)
+}
@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_data_export_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_data_export_repository.go -destination=./mocks/repository/mock_user_data_export_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserDataExportRepository is a mock of UserDataExportRepository interface.
+type MockUserDataExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDataExportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserDataExportRepositoryMockRecorder is the mock recorder for MockUserDataExportRepository.
+type MockUserDataExportRepositoryMockRecorder struct {
+	mock *MockUserDataExportRepository
+}
+
+// NewMockUserDataExportRepository creates a new mock instance.
+func NewMockUserDataExportRepository(ctrl *gomock.Controller) *MockUserDataExportRepository {
+	mock := &MockUserDataExportRepository{ctrl: ctrl}
+	mock.recorder = &MockUserDataExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataExportRepository) EXPECT() *MockUserDataExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimNextPending mocks base method.
+func (m *MockUserDataExportRepository) ClaimNextPending(ctx context.Context, tx repository.Querier, staleBefore time.Time) (*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNextPending", ctx, tx, staleBefore)
+	ret0, _ := ret[0].(*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNextPending indicates an expected call of ClaimNextPending.
+func (mr *MockUserDataExportRepositoryMockRecorder) ClaimNextPending(ctx, tx, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNextPending", reflect.TypeOf((*MockUserDataExportRepository)(nil).ClaimNextPending), ctx, tx, staleBefore)
+}
+
+// Create mocks base method.
+func (m *MockUserDataExportRepository) Create(ctx context.Context, tx repository.Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, dataExport)
+	ret0, _ := ret[0].(*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserDataExportRepositoryMockRecorder) Create(ctx, tx, dataExport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserDataExportRepository)(nil).Create), ctx, tx, dataExport)
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockUserDataExportRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) ([]*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].([]*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockUserDataExportRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserDataExportRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
+// FindByIdAndUserId mocks base method.
+func (m *MockUserDataExportRepository) FindByIdAndUserId(ctx context.Context, tx repository.Querier, id, userId string) (*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdAndUserId", ctx, tx, id, userId)
+	ret0, _ := ret[0].(*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdAndUserId indicates an expected call of FindByIdAndUserId.
+func (mr *MockUserDataExportRepositoryMockRecorder) FindByIdAndUserId(ctx, tx, id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdAndUserId", reflect.TypeOf((*MockUserDataExportRepository)(nil).FindByIdAndUserId), ctx, tx, id, userId)
+}
+
+// FindLatestByUserId mocks base method.
+func (m *MockUserDataExportRepository) FindLatestByUserId(ctx context.Context, tx repository.Querier, userId string) (*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestByUserId indicates an expected call of FindLatestByUserId.
+func (mr *MockUserDataExportRepositoryMockRecorder) FindLatestByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestByUserId", reflect.TypeOf((*MockUserDataExportRepository)(nil).FindLatestByUserId), ctx, tx, userId)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockUserDataExportRepository) UpdateStatus(ctx context.Context, tx repository.Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, tx, dataExport)
+	ret0, _ := ret[0].(*entity.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockUserDataExportRepositoryMockRecorder) UpdateStatus(ctx, tx, dataExport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockUserDataExportRepository)(nil).UpdateStatus), ctx, tx, dataExport)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_identity_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_identity_repository.go -destination=./mocks/repository/mock_user_identity_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserIdentityRepository is a mock of UserIdentityRepository interface.
+type MockUserIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserIdentityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserIdentityRepositoryMockRecorder is the mock recorder for MockUserIdentityRepository.
+type MockUserIdentityRepositoryMockRecorder struct {
+	mock *MockUserIdentityRepository
+}
+
+// NewMockUserIdentityRepository creates a new mock instance.
+func NewMockUserIdentityRepository(ctrl *gomock.Controller) *MockUserIdentityRepository {
+	mock := &MockUserIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockUserIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserIdentityRepository) EXPECT() *MockUserIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserIdentityRepository) Create(ctx context.Context, tx repository.Querier, identity *entity.UserIdentity) (*entity.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, identity)
+	ret0, _ := ret[0].(*entity.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserIdentityRepositoryMockRecorder) Create(ctx, tx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserIdentityRepository)(nil).Create), ctx, tx, identity)
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockUserIdentityRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockUserIdentityRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserIdentityRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
+// DeleteByUserIdAndProvider mocks base method.
+func (m *MockUserIdentityRepository) DeleteByUserIdAndProvider(ctx context.Context, tx repository.Querier, userId string, provider enum.IdentityProviderEnum) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserIdAndProvider", ctx, tx, userId, provider)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByUserIdAndProvider indicates an expected call of DeleteByUserIdAndProvider.
+func (mr *MockUserIdentityRepositoryMockRecorder) DeleteByUserIdAndProvider(ctx, tx, userId, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserIdAndProvider", reflect.TypeOf((*MockUserIdentityRepository)(nil).DeleteByUserIdAndProvider), ctx, tx, userId, provider)
+}
+
+// FindAllByUserId mocks base method.
+func (m *MockUserIdentityRepository) FindAllByUserId(ctx context.Context, tx repository.Querier, userId string) ([]*entity.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].([]*entity.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserId indicates an expected call of FindAllByUserId.
+func (mr *MockUserIdentityRepositoryMockRecorder) FindAllByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserId", reflect.TypeOf((*MockUserIdentityRepository)(nil).FindAllByUserId), ctx, tx, userId)
+}
+
+// FindByProviderAndSubject mocks base method.
+func (m *MockUserIdentityRepository) FindByProviderAndSubject(ctx context.Context, tx repository.Querier, provider enum.IdentityProviderEnum, subject string) (*entity.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProviderAndSubject", ctx, tx, provider, subject)
+	ret0, _ := ret[0].(*entity.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProviderAndSubject indicates an expected call of FindByProviderAndSubject.
+func (mr *MockUserIdentityRepositoryMockRecorder) FindByProviderAndSubject(ctx, tx, provider, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProviderAndSubject", reflect.TypeOf((*MockUserIdentityRepository)(nil).FindByProviderAndSubject), ctx, tx, provider, subject)
+}
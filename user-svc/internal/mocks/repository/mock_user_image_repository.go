@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_image_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_image_repository.go -destination=./mocks/repository/mock_user_image_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserImageRepository is a mock of UserImageRepository interface.
+type MockUserImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserImageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserImageRepositoryMockRecorder is the mock recorder for MockUserImageRepository.
+type MockUserImageRepositoryMockRecorder struct {
+	mock *MockUserImageRepository
+}
+
+// NewMockUserImageRepository creates a new mock instance.
+func NewMockUserImageRepository(ctrl *gomock.Controller) *MockUserImageRepository {
+	mock := &MockUserImageRepository{ctrl: ctrl}
+	mock.recorder = &MockUserImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserImageRepository) EXPECT() *MockUserImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserImageRepository) Create(ctx context.Context, tx repository.Querier, userImage *entity.UserImage) (*entity.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, userImage)
+	ret0, _ := ret[0].(*entity.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserImageRepositoryMockRecorder) Create(ctx, tx, userImage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserImageRepository)(nil).Create), ctx, tx, userImage)
+}
+
+// DeleteByUserProfId mocks base method.
+func (m *MockUserImageRepository) DeleteByUserProfId(ctx context.Context, tx repository.Querier, userProfId string) ([]*entity.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserProfId", ctx, tx, userProfId)
+	ret0, _ := ret[0].([]*entity.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByUserProfId indicates an expected call of DeleteByUserProfId.
+func (mr *MockUserImageRepositoryMockRecorder) DeleteByUserProfId(ctx, tx, userProfId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserProfId", reflect.TypeOf((*MockUserImageRepository)(nil).DeleteByUserProfId), ctx, tx, userProfId)
+}
+
+// FindByUserProfId mocks base method.
+func (m *MockUserImageRepository) FindByUserProfId(ctx context.Context, userProfId string) (*[]*entity.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserProfId", ctx, userProfId)
+	ret0, _ := ret[0].(*[]*entity.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserProfId indicates an expected call of FindByUserProfId.
+func (mr *MockUserImageRepositoryMockRecorder) FindByUserProfId(ctx, userProfId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserProfId", reflect.TypeOf((*MockUserImageRepository)(nil).FindByUserProfId), ctx, userProfId)
+}
+
+// FindByUserProfIdAndType mocks base method.
+func (m *MockUserImageRepository) FindByUserProfIdAndType(ctx context.Context, userProfId, imageType string) (*entity.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserProfIdAndType", ctx, userProfId, imageType)
+	ret0, _ := ret[0].(*entity.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserProfIdAndType indicates an expected call of FindByUserProfIdAndType.
+func (mr *MockUserImageRepositoryMockRecorder) FindByUserProfIdAndType(ctx, userProfId, imageType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserProfIdAndType", reflect.TypeOf((*MockUserImageRepository)(nil).FindByUserProfIdAndType), ctx, userProfId, imageType)
+}
+
+// Update mocks base method.
+func (m *MockUserImageRepository) Update(ctx context.Context, tx repository.Querier, userImage *entity.UserImage) (*entity.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx, userImage)
+	ret0, _ := ret[0].(*entity.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserImageRepositoryMockRecorder) Update(ctx, tx, userImage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserImageRepository)(nil).Update), ctx, tx, userImage)
+}
@@ -43,20 +43,6 @@ func (m *MockUserProfileRepository) EXPECT() *MockUserProfileRepositoryMockRecor
 	return m.recorder
 }
 
-// Close mocks base method.
-func (m *MockUserProfileRepository) Close() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Close")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Close indicates an expected call of Close.
-func (mr *MockUserProfileRepositoryMockRecorder) Close() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockUserProfileRepository)(nil).Close))
-}
-
 // Create mocks base method.
 func (m *MockUserProfileRepository) Create(ctx context.Context, tx repository.Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +73,20 @@ func (mr *MockUserProfileRepositoryMockRecorder) CreateWithProfileUrl(ctx, tx, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithProfileUrl", reflect.TypeOf((*MockUserProfileRepository)(nil).CreateWithProfileUrl), ctx, tx, userProfile)
 }
 
+// DeleteByUserId mocks base method.
+func (m *MockUserProfileRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockUserProfileRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserProfileRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
+
 // FindByUserId mocks base method.
 func (m *MockUserProfileRepository) FindByUserId(ctx context.Context, userId string) (*entity.UserProfile, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +117,20 @@ func (mr *MockUserProfileRepositoryMockRecorder) Update(ctx, tx, userProfile any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserProfileRepository)(nil).Update), ctx, tx, userProfile)
 }
 
+// UpdateImageURL mocks base method.
+func (m *MockUserProfileRepository) UpdateImageURL(ctx context.Context, tx repository.Querier, url, userProfId string, imageType enum.ImageTypeEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageURL", ctx, tx, url, userProfId, imageType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImageURL indicates an expected call of UpdateImageURL.
+func (mr *MockUserProfileRepositoryMockRecorder) UpdateImageURL(ctx, tx, url, userProfId, imageType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageURL", reflect.TypeOf((*MockUserProfileRepository)(nil).UpdateImageURL), ctx, tx, url, userProfId, imageType)
+}
+
 // UpdateSimilarity mocks base method.
 func (m *MockUserProfileRepository) UpdateSimilarity(ctx context.Context, tx repository.Querier, similarity enum.SimilarityLevelEnum, userID string) error {
 	m.ctrl.T.Helper()
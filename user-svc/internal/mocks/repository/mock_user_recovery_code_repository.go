@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_recovery_code_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_recovery_code_repository.go -destination=./mocks/repository/mock_user_recovery_code_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRecoveryCodeRepository is a mock of UserRecoveryCodeRepository interface.
+type MockUserRecoveryCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRecoveryCodeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRecoveryCodeRepositoryMockRecorder is the mock recorder for MockUserRecoveryCodeRepository.
+type MockUserRecoveryCodeRepositoryMockRecorder struct {
+	mock *MockUserRecoveryCodeRepository
+}
+
+// NewMockUserRecoveryCodeRepository creates a new mock instance.
+func NewMockUserRecoveryCodeRepository(ctrl *gomock.Controller) *MockUserRecoveryCodeRepository {
+	mock := &MockUserRecoveryCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRecoveryCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRecoveryCodeRepository) EXPECT() *MockUserRecoveryCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockUserRecoveryCodeRepository) Consume(ctx context.Context, tx repository.Querier, userId, codeHash string, usedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, tx, userId, codeHash, usedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockUserRecoveryCodeRepositoryMockRecorder) Consume(ctx, tx, userId, codeHash, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockUserRecoveryCodeRepository)(nil).Consume), ctx, tx, userId, codeHash, usedAt)
+}
+
+// CountUnusedByUserId mocks base method.
+func (m *MockUserRecoveryCodeRepository) CountUnusedByUserId(ctx context.Context, tx repository.Querier, userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnusedByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnusedByUserId indicates an expected call of CountUnusedByUserId.
+func (mr *MockUserRecoveryCodeRepositoryMockRecorder) CountUnusedByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnusedByUserId", reflect.TypeOf((*MockUserRecoveryCodeRepository)(nil).CountUnusedByUserId), ctx, tx, userId)
+}
+
+// Create mocks base method.
+func (m *MockUserRecoveryCodeRepository) Create(ctx context.Context, tx repository.Querier, recoveryCode *entity.UserRecoveryCode) (*entity.UserRecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, recoveryCode)
+	ret0, _ := ret[0].(*entity.UserRecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRecoveryCodeRepositoryMockRecorder) Create(ctx, tx, recoveryCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRecoveryCodeRepository)(nil).Create), ctx, tx, recoveryCode)
+}
+
+// DeleteByUserId mocks base method.
+func (m *MockUserRecoveryCodeRepository) DeleteByUserId(ctx context.Context, tx repository.Querier, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserId indicates an expected call of DeleteByUserId.
+func (mr *MockUserRecoveryCodeRepositoryMockRecorder) DeleteByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserRecoveryCodeRepository)(nil).DeleteByUserId), ctx, tx, userId)
+}
@@ -12,8 +12,11 @@ package mockrepository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	pagination "github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
 	model "github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
 	gomock "go.uber.org/mock/gomock"
@@ -43,49 +46,49 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CountByEmail mocks base method.
-func (m *MockUserRepository) CountByEmail(ctx context.Context, email string) (int, error) {
+// AddRole mocks base method.
+func (m *MockUserRepository) AddRole(ctx context.Context, tx repository.Querier, userId, role string) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByEmail", ctx, email)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "AddRole", ctx, tx, userId, role)
+	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountByEmail indicates an expected call of CountByEmail.
-func (mr *MockUserRepositoryMockRecorder) CountByEmail(ctx, email any) *gomock.Call {
+// AddRole indicates an expected call of AddRole.
+func (mr *MockUserRepositoryMockRecorder) AddRole(ctx, tx, userId, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByEmail", reflect.TypeOf((*MockUserRepository)(nil).CountByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockUserRepository)(nil).AddRole), ctx, tx, userId, role)
 }
 
-// CountByEmailGoogleId mocks base method.
-func (m *MockUserRepository) CountByEmailGoogleId(ctx context.Context, email, googleId string) (int, error) {
+// Anonymize mocks base method.
+func (m *MockUserRepository) Anonymize(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByEmailGoogleId", ctx, email, googleId)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "Anonymize", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountByEmailGoogleId indicates an expected call of CountByEmailGoogleId.
-func (mr *MockUserRepositoryMockRecorder) CountByEmailGoogleId(ctx, email, googleId any) *gomock.Call {
+// Anonymize indicates an expected call of Anonymize.
+func (mr *MockUserRepositoryMockRecorder) Anonymize(ctx, tx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByEmailGoogleId", reflect.TypeOf((*MockUserRepository)(nil).CountByEmailGoogleId), ctx, email, googleId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymize", reflect.TypeOf((*MockUserRepository)(nil).Anonymize), ctx, tx, user)
 }
 
-// CountByEmailNotGoogle mocks base method.
-func (m *MockUserRepository) CountByEmailNotGoogle(ctx context.Context, email string) (int, error) {
+// CountByEmail mocks base method.
+func (m *MockUserRepository) CountByEmail(ctx context.Context, email string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByEmailNotGoogle", ctx, email)
+	ret := m.ctrl.Call(m, "CountByEmail", ctx, email)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountByEmailNotGoogle indicates an expected call of CountByEmailNotGoogle.
-func (mr *MockUserRepositoryMockRecorder) CountByEmailNotGoogle(ctx, email any) *gomock.Call {
+// CountByEmail indicates an expected call of CountByEmail.
+func (mr *MockUserRepositoryMockRecorder) CountByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByEmailNotGoogle", reflect.TypeOf((*MockUserRepository)(nil).CountByEmailNotGoogle), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByEmail", reflect.TypeOf((*MockUserRepository)(nil).CountByEmail), ctx, email)
 }
 
 // CountByPhoneNumber mocks base method.
@@ -133,19 +136,19 @@ func (mr *MockUserRepositoryMockRecorder) CreateByEmail(ctx, tx, user any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateByEmail", reflect.TypeOf((*MockUserRepository)(nil).CreateByEmail), ctx, tx, user)
 }
 
-// CreateByGoogleSignIn mocks base method.
-func (m *MockUserRepository) CreateByGoogleSignIn(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+// CreateByIdentity mocks base method.
+func (m *MockUserRepository) CreateByIdentity(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateByGoogleSignIn", ctx, tx, user)
+	ret := m.ctrl.Call(m, "CreateByIdentity", ctx, tx, user)
 	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateByGoogleSignIn indicates an expected call of CreateByGoogleSignIn.
-func (mr *MockUserRepositoryMockRecorder) CreateByGoogleSignIn(ctx, tx, user any) *gomock.Call {
+// CreateByIdentity indicates an expected call of CreateByIdentity.
+func (mr *MockUserRepositoryMockRecorder) CreateByIdentity(ctx, tx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateByGoogleSignIn", reflect.TypeOf((*MockUserRepository)(nil).CreateByGoogleSignIn), ctx, tx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateByIdentity", reflect.TypeOf((*MockUserRepository)(nil).CreateByIdentity), ctx, tx, user)
 }
 
 // CreateByPhoneNumber mocks base method.
@@ -179,34 +182,35 @@ func (mr *MockUserRepositoryMockRecorder) FindAllPublicChat(ctx, tx, page, size,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPublicChat", reflect.TypeOf((*MockUserRepository)(nil).FindAllPublicChat), ctx, tx, page, size, username)
 }
 
-// FindByEmail mocks base method.
-func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+// FindAllPublicChatByCursor mocks base method.
+func (m *MockUserRepository) FindAllPublicChatByCursor(ctx context.Context, tx repository.Querier, cursor *pagination.Cursor, size int, username string) ([]*entity.UserPublicChat, *model.CursorMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
-	ret0, _ := ret[0].(*entity.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "FindAllPublicChatByCursor", ctx, tx, cursor, size, username)
+	ret0, _ := ret[0].([]*entity.UserPublicChat)
+	ret1, _ := ret[1].(*model.CursorMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+// FindAllPublicChatByCursor indicates an expected call of FindAllPublicChatByCursor.
+func (mr *MockUserRepositoryMockRecorder) FindAllPublicChatByCursor(ctx, tx, cursor, size, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPublicChatByCursor", reflect.TypeOf((*MockUserRepository)(nil).FindAllPublicChatByCursor), ctx, tx, cursor, size, username)
 }
 
-// FindByEmailNotGoogle mocks base method.
-func (m *MockUserRepository) FindByEmailNotGoogle(ctx context.Context, email string) (*entity.User, error) {
+// FindByEmail mocks base method.
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByEmailNotGoogle", ctx, email)
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
 	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByEmailNotGoogle indicates an expected call of FindByEmailNotGoogle.
-func (mr *MockUserRepositoryMockRecorder) FindByEmailNotGoogle(ctx, email any) *gomock.Call {
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmailNotGoogle", reflect.TypeOf((*MockUserRepository)(nil).FindByEmailNotGoogle), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, email)
 }
 
 // FindById mocks base method.
@@ -239,6 +243,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByMultipleParam(ctx, multipleParam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMultipleParam", reflect.TypeOf((*MockUserRepository)(nil).FindByMultipleParam), ctx, multipleParam)
 }
 
+// FindByPhoneNumber mocks base method.
+func (m *MockUserRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPhoneNumber indicates an expected call of FindByPhoneNumber.
+func (mr *MockUserRepositoryMockRecorder) FindByPhoneNumber(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhoneNumber", reflect.TypeOf((*MockUserRepository)(nil).FindByPhoneNumber), ctx, phoneNumber)
+}
+
 // FindDetailByEmail mocks base method.
 func (m *MockUserRepository) FindDetailByEmail(ctx context.Context, email string) (*entity.UserDetail, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +273,96 @@ func (mr *MockUserRepositoryMockRecorder) FindDetailByEmail(ctx, email any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDetailByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindDetailByEmail), ctx, email)
 }
 
+// FindIdsBySegment mocks base method.
+func (m *MockUserRepository) FindIdsBySegment(ctx context.Context, tx repository.Querier, segment enum.AudienceSegmentEnum, inactiveSince time.Time, afterUserId string, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdsBySegment", ctx, tx, segment, inactiveSince, afterUserId, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdsBySegment indicates an expected call of FindIdsBySegment.
+func (mr *MockUserRepositoryMockRecorder) FindIdsBySegment(ctx, tx, segment, inactiveSince, afterUserId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdsBySegment", reflect.TypeOf((*MockUserRepository)(nil).FindIdsBySegment), ctx, tx, segment, inactiveSince, afterUserId, limit)
+}
+
+// FindManyWithVerifiedEmail mocks base method.
+func (m *MockUserRepository) FindManyWithVerifiedEmail(ctx context.Context, tx repository.Querier, userIds []string) ([]*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManyWithVerifiedEmail", ctx, tx, userIds)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindManyWithVerifiedEmail indicates an expected call of FindManyWithVerifiedEmail.
+func (mr *MockUserRepositoryMockRecorder) FindManyWithVerifiedEmail(ctx, tx, userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManyWithVerifiedEmail", reflect.TypeOf((*MockUserRepository)(nil).FindManyWithVerifiedEmail), ctx, tx, userIds)
+}
+
+// FindNextDueDeletion mocks base method.
+func (m *MockUserRepository) FindNextDueDeletion(ctx context.Context, tx repository.Querier, now time.Time) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNextDueDeletion", ctx, tx, now)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNextDueDeletion indicates an expected call of FindNextDueDeletion.
+func (mr *MockUserRepositoryMockRecorder) FindNextDueDeletion(ctx, tx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNextDueDeletion", reflect.TypeOf((*MockUserRepository)(nil).FindNextDueDeletion), ctx, tx, now)
+}
+
+// RemoveRole mocks base method.
+func (m *MockUserRepository) RemoveRole(ctx context.Context, tx repository.Querier, userId, role string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", ctx, tx, userId, role)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockUserRepositoryMockRecorder) RemoveRole(ctx, tx, userId, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockUserRepository)(nil).RemoveRole), ctx, tx, userId, role)
+}
+
+// UpdateDeletionSchedule mocks base method.
+func (m *MockUserRepository) UpdateDeletionSchedule(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeletionSchedule", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeletionSchedule indicates an expected call of UpdateDeletionSchedule.
+func (mr *MockUserRepositoryMockRecorder) UpdateDeletionSchedule(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeletionSchedule", reflect.TypeOf((*MockUserRepository)(nil).UpdateDeletionSchedule), ctx, tx, user)
+}
+
+// UpdateEmail mocks base method.
+func (m *MockUserRepository) UpdateEmail(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail.
+func (mr *MockUserRepositoryMockRecorder) UpdateEmail(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepository)(nil).UpdateEmail), ctx, tx, user)
+}
+
 // UpdateEmailVerifiedAt mocks base method.
 func (m *MockUserRepository) UpdateEmailVerifiedAt(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -269,6 +378,20 @@ func (mr *MockUserRepositoryMockRecorder) UpdateEmailVerifiedAt(ctx, tx, user an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailVerifiedAt", reflect.TypeOf((*MockUserRepository)(nil).UpdateEmailVerifiedAt), ctx, tx, user)
 }
 
+// UpdateHasFacecam mocks base method.
+func (m *MockUserRepository) UpdateHasFacecam(ctx context.Context, tx repository.Querier, userId string, hasFacecam bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHasFacecam", ctx, tx, userId, hasFacecam)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHasFacecam indicates an expected call of UpdateHasFacecam.
+func (mr *MockUserRepositoryMockRecorder) UpdateHasFacecam(ctx, tx, userId, hasFacecam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHasFacecam", reflect.TypeOf((*MockUserRepository)(nil).UpdateHasFacecam), ctx, tx, userId, hasFacecam)
+}
+
 // UpdatePassword mocks base method.
 func (m *MockUserRepository) UpdatePassword(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -283,3 +406,78 @@ func (mr *MockUserRepositoryMockRecorder) UpdatePassword(ctx, tx, user any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepository)(nil).UpdatePassword), ctx, tx, user)
 }
+
+// UpdatePhoneNumber mocks base method.
+func (m *MockUserRepository) UpdatePhoneNumber(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoneNumber", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhoneNumber indicates an expected call of UpdatePhoneNumber.
+func (mr *MockUserRepositoryMockRecorder) UpdatePhoneNumber(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoneNumber", reflect.TypeOf((*MockUserRepository)(nil).UpdatePhoneNumber), ctx, tx, user)
+}
+
+// UpdatePhoneNumberVerifiedAt mocks base method.
+func (m *MockUserRepository) UpdatePhoneNumberVerifiedAt(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoneNumberVerifiedAt", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhoneNumberVerifiedAt indicates an expected call of UpdatePhoneNumberVerifiedAt.
+func (mr *MockUserRepositoryMockRecorder) UpdatePhoneNumberVerifiedAt(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoneNumberVerifiedAt", reflect.TypeOf((*MockUserRepository)(nil).UpdatePhoneNumberVerifiedAt), ctx, tx, user)
+}
+
+// UpdateSuspension mocks base method.
+func (m *MockUserRepository) UpdateSuspension(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSuspension", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSuspension indicates an expected call of UpdateSuspension.
+func (mr *MockUserRepositoryMockRecorder) UpdateSuspension(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSuspension", reflect.TypeOf((*MockUserRepository)(nil).UpdateSuspension), ctx, tx, user)
+}
+
+// UpdateTwoFactor mocks base method.
+func (m *MockUserRepository) UpdateTwoFactor(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTwoFactor", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTwoFactor indicates an expected call of UpdateTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) UpdateTwoFactor(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).UpdateTwoFactor), ctx, tx, user)
+}
+
+// UpdateUsername mocks base method.
+func (m *MockUserRepository) UpdateUsername(ctx context.Context, tx repository.Querier, user *entity.User) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsername", ctx, tx, user)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUsername indicates an expected call of UpdateUsername.
+func (mr *MockUserRepositoryMockRecorder) UpdateUsername(ctx, tx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsername", reflect.TypeOf((*MockUserRepository)(nil).UpdateUsername), ctx, tx, user)
+}
@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_session_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_session_repository.go -destination=./mocks/repository/mock_user_session_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	enum "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserSessionRepository is a mock of UserSessionRepository interface.
+type MockUserSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserSessionRepositoryMockRecorder is the mock recorder for MockUserSessionRepository.
+type MockUserSessionRepositoryMockRecorder struct {
+	mock *MockUserSessionRepository
+}
+
+// NewMockUserSessionRepository creates a new mock instance.
+func NewMockUserSessionRepository(ctrl *gomock.Controller) *MockUserSessionRepository {
+	mock := &MockUserSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUserSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSessionRepository) EXPECT() *MockUserSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserSessionRepository) Create(ctx context.Context, tx repository.Querier, session *entity.UserSession) (*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, session)
+	ret0, _ := ret[0].(*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserSessionRepositoryMockRecorder) Create(ctx, tx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserSessionRepository)(nil).Create), ctx, tx, session)
+}
+
+// FindAllActiveByUserId mocks base method.
+func (m *MockUserSessionRepository) FindAllActiveByUserId(ctx context.Context, tx repository.Querier, userId string) ([]*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActiveByUserId", ctx, tx, userId)
+	ret0, _ := ret[0].([]*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActiveByUserId indicates an expected call of FindAllActiveByUserId.
+func (mr *MockUserSessionRepositoryMockRecorder) FindAllActiveByUserId(ctx, tx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActiveByUserId", reflect.TypeOf((*MockUserSessionRepository)(nil).FindAllActiveByUserId), ctx, tx, userId)
+}
+
+// FindById mocks base method.
+func (m *MockUserSessionRepository) FindById(ctx context.Context, tx repository.Querier, sessionId string) (*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, tx, sessionId)
+	ret0, _ := ret[0].(*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUserSessionRepositoryMockRecorder) FindById(ctx, tx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUserSessionRepository)(nil).FindById), ctx, tx, sessionId)
+}
+
+// Revoke mocks base method.
+func (m *MockUserSessionRepository) Revoke(ctx context.Context, tx repository.Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) (*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tx, userId, sessionId, reason, revokedAt)
+	ret0, _ := ret[0].(*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockUserSessionRepositoryMockRecorder) Revoke(ctx, tx, userId, sessionId, reason, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockUserSessionRepository)(nil).Revoke), ctx, tx, userId, sessionId, reason, revokedAt)
+}
+
+// RevokeAllByUserId mocks base method.
+func (m *MockUserSessionRepository) RevokeAllByUserId(ctx context.Context, tx repository.Querier, userId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserId", ctx, tx, userId, reason, revokedAt)
+	ret0, _ := ret[0].([]*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllByUserId indicates an expected call of RevokeAllByUserId.
+func (mr *MockUserSessionRepositoryMockRecorder) RevokeAllByUserId(ctx, tx, userId, reason, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserId", reflect.TypeOf((*MockUserSessionRepository)(nil).RevokeAllByUserId), ctx, tx, userId, reason, revokedAt)
+}
+
+// RevokeAllByUserIdExcept mocks base method.
+func (m *MockUserSessionRepository) RevokeAllByUserIdExcept(ctx context.Context, tx repository.Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUserIdExcept", ctx, tx, userId, sessionId, reason, revokedAt)
+	ret0, _ := ret[0].([]*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAllByUserIdExcept indicates an expected call of RevokeAllByUserIdExcept.
+func (mr *MockUserSessionRepositoryMockRecorder) RevokeAllByUserIdExcept(ctx, tx, userId, sessionId, reason, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUserIdExcept", reflect.TypeOf((*MockUserSessionRepository)(nil).RevokeAllByUserIdExcept), ctx, tx, userId, sessionId, reason, revokedAt)
+}
+
+// Rotate mocks base method.
+func (m *MockUserSessionRepository) Rotate(ctx context.Context, tx repository.Querier, session *entity.UserSession, previousTokenId string) (*entity.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, tx, session, previousTokenId)
+	ret0, _ := ret[0].(*entity.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockUserSessionRepositoryMockRecorder) Rotate(ctx, tx, session, previousTokenId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockUserSessionRepository)(nil).Rotate), ctx, tx, session, previousTokenId)
+}
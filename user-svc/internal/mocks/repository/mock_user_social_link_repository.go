@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/user_social_link_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./repository/user_social_link_repository.go -destination=./mocks/repository/mock_user_social_link_repository.go -package=mockrepository
+//
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	repository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserSocialLinkRepository is a mock of UserSocialLinkRepository interface.
+type MockUserSocialLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSocialLinkRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserSocialLinkRepositoryMockRecorder is the mock recorder for MockUserSocialLinkRepository.
+type MockUserSocialLinkRepositoryMockRecorder struct {
+	mock *MockUserSocialLinkRepository
+}
+
+// NewMockUserSocialLinkRepository creates a new mock instance.
+func NewMockUserSocialLinkRepository(ctrl *gomock.Controller) *MockUserSocialLinkRepository {
+	mock := &MockUserSocialLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockUserSocialLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSocialLinkRepository) EXPECT() *MockUserSocialLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUserSocialLinkRepository) Delete(ctx context.Context, tx repository.Querier, userProfileId, socialMediaId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tx, userProfileId, socialMediaId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserSocialLinkRepositoryMockRecorder) Delete(ctx, tx, userProfileId, socialMediaId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserSocialLinkRepository)(nil).Delete), ctx, tx, userProfileId, socialMediaId)
+}
+
+// DeleteByUserProfileId mocks base method.
+func (m *MockUserSocialLinkRepository) DeleteByUserProfileId(ctx context.Context, tx repository.Querier, userProfileId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserProfileId", ctx, tx, userProfileId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserProfileId indicates an expected call of DeleteByUserProfileId.
+func (mr *MockUserSocialLinkRepositoryMockRecorder) DeleteByUserProfileId(ctx, tx, userProfileId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserProfileId", reflect.TypeOf((*MockUserSocialLinkRepository)(nil).DeleteByUserProfileId), ctx, tx, userProfileId)
+}
+
+// FindAllActiveByUserProfileId mocks base method.
+func (m *MockUserSocialLinkRepository) FindAllActiveByUserProfileId(ctx context.Context, tx repository.Querier, userProfileId string) ([]*entity.UserSocialLinkDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActiveByUserProfileId", ctx, tx, userProfileId)
+	ret0, _ := ret[0].([]*entity.UserSocialLinkDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActiveByUserProfileId indicates an expected call of FindAllActiveByUserProfileId.
+func (mr *MockUserSocialLinkRepositoryMockRecorder) FindAllActiveByUserProfileId(ctx, tx, userProfileId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActiveByUserProfileId", reflect.TypeOf((*MockUserSocialLinkRepository)(nil).FindAllActiveByUserProfileId), ctx, tx, userProfileId)
+}
+
+// Upsert mocks base method.
+func (m *MockUserSocialLinkRepository) Upsert(ctx context.Context, tx repository.Querier, link *entity.UserSocialLink) (*entity.UserSocialLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, tx, link)
+	ret0, _ := ret[0].(*entity.UserSocialLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockUserSocialLinkRepositoryMockRecorder) Upsert(ctx, tx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserSocialLinkRepository)(nil).Upsert), ctx, tx, link)
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type RequestAccountDeletionRequest struct {
+	UserId   string `validate:"required"`
+	Password string `json:"password" validate:"omitempty,max=100"`
+}
+
+type AccountDeletionResponse struct {
+	DeletionRequested bool       `json:"deletion_requested"`
+	RequestedAt       *time.Time `json:"requested_at,omitempty"`
+	ScheduledAt       *time.Time `json:"scheduled_at,omitempty"`
+}
+
+type GetDataExportRequest struct {
+	UserId   string `validate:"required"`
+	ExportId string `validate:"required"`
+}
+
+type DataExportResponse struct {
+	Id            string                    `json:"id"`
+	Status        enum.DataExportStatusEnum `json:"status"`
+	DownloadUrl   string                    `json:"download_url,omitempty"`
+	FailureReason *string                   `json:"failure_reason,omitempty"`
+	CompletedAt   *time.Time                `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time                `json:"created_at"`
+}
+
+// UserDataArchiveAccount is the account file of a data export, unlike the entity it
+// never carries credentials or secrets
+type UserDataArchiveAccount struct {
+	Id                    string     `json:"id"`
+	Username              string     `json:"username"`
+	Email                 *string    `json:"email,omitempty"`
+	EmailVerifiedAt       *time.Time `json:"email_verified_at,omitempty"`
+	PhoneNumber           *string    `json:"phone_number,omitempty"`
+	PhoneNumberVerifiedAt *time.Time `json:"phone_number_verified_at,omitempty"`
+	HasFacecam            bool       `json:"has_facecam"`
+	Roles                 []string   `json:"roles"`
+	TwoFactorEnabledAt    *time.Time `json:"two_factor_enabled_at,omitempty"`
+	DeletionScheduledAt   *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt             *time.Time `json:"created_at"`
+	UpdatedAt             *time.Time `json:"updated_at"`
+}
+
+type UserDataArchiveImage struct {
+	FileName  string             `json:"file_name"`
+	ImageType enum.ImageTypeEnum `json:"image_type"`
+	Size      int64              `json:"size"`
+	Url       *string            `json:"url,omitempty"`
+	CreatedAt *time.Time         `json:"created_at"`
+}
+
+type UserDataArchiveDevice struct {
+	Platform  enum.PlatformTypeEnum `json:"platform"`
+	CreatedAt *time.Time            `json:"created_at"`
+}
+
+// UserDataArchivePurchase is a photo bought by the user, it is held by photo-svc
+type UserDataArchivePurchase struct {
+	PhotoId    string     `json:"photo_id"`
+	CreatorId  string     `json:"creator_id"`
+	Title      string     `json:"title"`
+	Price      int32      `json:"price"`
+	PriceStr   string     `json:"price_str"`
+	OriginalAt *time.Time `json:"original_at,omitempty"`
+}
+
+type UserDataArchiveFacecam struct {
+	Id          string     `json:"id"`
+	FileName    string     `json:"file_name"`
+	Title       string     `json:"title"`
+	Size        int64      `json:"size"`
+	IsProcessed bool       `json:"is_processed"`
+	OriginalAt  *time.Time `json:"original_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+// UserDataArchiveTransaction is a checkout of the user, it is held by transaction-svc
+type UserDataArchiveTransaction struct {
+	Id         string     `json:"id"`
+	Status     string     `json:"status"`
+	Amount     int32      `json:"amount"`
+	PhotoIds   []string   `json:"photo_ids"`
+	CheckoutAt *time.Time `json:"checkout_at,omitempty"`
+	PaymentAt  *time.Time `json:"payment_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at"`
+}
+
+type UserDataArchiveReview struct {
+	Id        string     `json:"id"`
+	CreatorId string     `json:"creator_id"`
+	Rating    int32      `json:"rating"`
+	Comment   *string    `json:"comment,omitempty"`
+	CreatedAt *time.Time `json:"created_at"`
+}
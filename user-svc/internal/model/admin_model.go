@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type CreateSocialMediaRequest struct {
+	Name        string  `json:"name" validate:"required,max=100"`
+	BaseUrl     *string `json:"base_url" validate:"omitempty,url"`
+	LogoUrl     *string `json:"logo_url" validate:"omitempty,url"`
+	Description *string `json:"description" validate:"omitempty,max=255"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+type UpdateSocialMediaRequest struct {
+	Id          string  `json:"-" validate:"required"`
+	Name        string  `json:"name" validate:"required,max=100"`
+	BaseUrl     *string `json:"base_url" validate:"omitempty,url"`
+	LogoUrl     *string `json:"logo_url" validate:"omitempty,url"`
+	Description *string `json:"description" validate:"omitempty,max=255"`
+	IsActive    bool    `json:"is_active"`
+}
+
+type SocialMediaResponse struct {
+	Id          string     `json:"id"`
+	Name        string     `json:"name"`
+	BaseUrl     *string    `json:"base_url,omitempty"`
+	LogoUrl     *string    `json:"logo_url,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	IsActive    bool       `json:"is_active"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+type SuspendUserRequest struct {
+	AdminId string `json:"-" validate:"required"`
+	UserId  string `json:"-" validate:"required"`
+	Reason  string `json:"reason" validate:"required,max=255"`
+}
+
+type UnsuspendUserRequest struct {
+	UserId string `json:"-" validate:"required"`
+}
+
+type UserRoleRequest struct {
+	AdminId string `json:"-"`
+	UserId  string `json:"-" validate:"required"`
+	Role    string `json:"role" validate:"required,oneof=CREATOR ADMIN FINANCE"`
+}
+
+type AdminUserResponse struct {
+	Id               string     `json:"id"`
+	Username         string     `json:"username"`
+	Roles            []string   `json:"roles"`
+	Permissions      []string   `json:"permissions"`
+	SuspendedAt      *time.Time `json:"suspended_at,omitempty"`
+	SuspensionReason *string    `json:"suspension_reason,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+// GetChatReportsRequest status defaults to pending, the moderation queue
+type GetChatReportsRequest struct {
+	Status enum.ChatReportStatusEnum `json:"status" validate:"omitempty,oneof=PENDING RESOLVED DISMISSED"`
+	Cursor string                    `json:"cursor"`
+	Size   int                       `json:"size" validate:"required,min=1,max=100"`
+}
+
+type ReviewChatReportRequest struct {
+	ReviewerId string                    `json:"-" validate:"required"`
+	ReportId   string                    `json:"-" validate:"required,max=26"`
+	Status     enum.ChatReportStatusEnum `json:"status" validate:"required,oneof=RESOLVED DISMISSED"`
+	Note       string                    `json:"note" validate:"max=1000"`
+}
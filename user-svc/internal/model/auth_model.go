@@ -15,17 +15,12 @@ type RegisterByPhoneRequest struct {
 	//TODO
 }
 
-type RegisterByGoogleRequest struct {
-	Token       string                `json:"token" validate:"required"`
-	DeviceToken string                `json:"device_token" validate:"required"`
-	Platform    enum.PlatformTypeEnum `json:"platform" validate:"required"`
-}
-
-type GoogleSignInClaim struct {
-	Email             string `json:"email" validate:"required,max=255"`
-	Username          string `json:"username" validate:"required,max=255"`
-	ProfilePictureUrl string `json:"picture" validate:"required"`
-	GoogleId          string `json:"sub" validate:"required,max=15"`
+type RegisterByProviderRequest struct {
+	Provider    enum.IdentityProviderEnum `json:"-" validate:"required"`
+	Token       string                    `json:"token" validate:"required"`
+	DeviceToken string                    `json:"device_token" validate:"required"`
+	Platform    enum.PlatformTypeEnum     `json:"platform" validate:"required"`
+	SessionMetadata
 }
 
 type RegisterByEmailRequest struct {
@@ -61,8 +56,69 @@ type ResetPasswordUserRequest struct {
 }
 
 type LoginUserRequest struct {
-	MultipleParam string `json:"multiple_param" validate:"required,email,max=100"`
+	MultipleParam string `json:"multiple_param" validate:"required,max=100"`
 	Password      string `json:"password" validate:"required,max=100"`
+	SessionMetadata
+}
+
+type RequestPhoneOTPRequest struct {
+	PhoneNumber string              `json:"phone_number" validate:"required,min=10,max=15"`
+	Purpose     enum.OTPPurposeEnum `json:"purpose" validate:"required,oneof=VERIFY_PHONE LOGIN"`
+}
+
+type VerifyPhoneOTPRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,min=10,max=15"`
+	Code        string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type LoginByOTPRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,min=10,max=15"`
+	Code        string `json:"code" validate:"required,len=6,numeric"`
+	SessionMetadata
+}
+
+// SessionMetadata describes the device a session is opened from. Device is
+// an optional client supplied label, the rest is filled from the request.
+type SessionMetadata struct {
+	Device    string `json:"device" validate:"omitempty,max=255"`
+	IpAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type ChangeUsernameRequest struct {
+	UserId   string `validate:"required"`
+	Username string `json:"username" validate:"required,min=3,max=30"`
+}
+
+// Password re-authenticates the change for accounts that have one. Accounts without a
+// password confirm it with a 2FA step-up of SessionId or with the Code sent by RequestReauthOTP.
+type RequestEmailChangeRequest struct {
+	UserId    string `validate:"required"`
+	SessionId string
+	Email     string `json:"email" validate:"required,email,max=255"`
+	Password  string `json:"password" validate:"omitempty,max=100"`
+	Code      string `json:"code" validate:"omitempty,len=6,numeric"`
+}
+
+type RequestPhoneChangeRequest struct {
+	UserId      string `validate:"required"`
+	SessionId   string
+	PhoneNumber string `json:"phone_number" validate:"required,min=10,max=15"`
+	Password    string `json:"password" validate:"omitempty,max=100"`
+	Code        string `json:"code" validate:"omitempty,len=6,numeric"`
+}
+
+// ConfirmContactChangeRequest keeps SessionId signed in while every other session
+// of the user is revoked.
+type ConfirmContactChangeRequest struct {
+	UserId    string `validate:"required"`
+	SessionId string
+	Code      string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type OTPResponse struct {
+	ExpiresIn int `json:"expires_in"`
+	ResendIn  int `json:"resend_in"`
 }
 
 type DeviceRequest struct {
@@ -83,12 +139,19 @@ type AuthResponse struct {
 	Similarity    uint
 	CreatorId     string
 	WalletId      string
-	Token         string
-	ExpiresAt     time.Time
+	Roles         []string
+	Permissions   []string
+	SessionId     string
+	// TwoFactorVerifiedAt is set while the session holds a fresh step-up
+	TwoFactorEnabled    bool
+	TwoFactorVerifiedAt *time.Time
+	Token               string
+	ExpiresAt           time.Time
 }
 
 type LogoutUserRequest struct {
 	UserId       string
+	SessionId    string
 	AccessToken  string
 	ExpiresAt    time.Time
 	RefreshToken string `json:"refresh_token" validate:"required"`
@@ -96,11 +159,31 @@ type LogoutUserRequest struct {
 
 type AccessTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
+	IpAddress    string `json:"-"`
+	UserAgent    string `json:"-"`
+}
+
+type RevokeSessionRequest struct {
+	UserId    string `validate:"required"`
+	SessionId string `validate:"required"`
+}
+
+type SessionResponse struct {
+	Id         string     `json:"id"`
+	Device     string     `json:"device,omitempty"`
+	Platform   string     `json:"platform,omitempty"`
+	IpAddress  string     `json:"ip_address,omitempty"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	Current    bool       `json:"current"`
+	LastSeenAt *time.Time `json:"last_seen_at"`
+	CreatedAt  *time.Time `json:"created_at"`
 }
 
 type TokenResponse struct {
-	AccessToken  string `json:"access_token,omitempty"`
-	RefreshToken string `json:"refresh_token,omitempty"`
+	AccessToken       string `json:"access_token,omitempty"`
+	RefreshToken      string `json:"refresh_token,omitempty"`
+	TwoFactorRequired bool   `json:"two_factor_required,omitempty"`
+	ChallengeToken    string `json:"challenge_token,omitempty"`
 }
 
 // type UpdateUserRequest struct {
@@ -127,7 +210,6 @@ type UserResponse struct {
 	// EmailVerifiedAt       *time.Time `json:"email_verified_at,omitempty"`
 	PhoneNumber *string `json:"phone_number,omitempty"`
 	// PhoneNumberVerifiedAt *time.Time `json:"phone_number_verified_at,omitempty"`
-	GoogleId   *string    `json:"google_id,omitempty"`
 	HasFacecam bool       `json:"has_facecam"`
 	CreatedAt  *time.Time `json:"created_at,omitempty"`
 	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
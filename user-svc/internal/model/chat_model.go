@@ -1,8 +1,15 @@
 package model
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
 type RequestGetOrCreateRoom struct {
 	SenderId   string `validate:"required"`
-	ReceiverId string `json:"receiver_id" validate:"required"`
+	ReceiverId string `json:"receiver_id" validate:"required,max=26,nefield=SenderId"`
 }
 
 type GetOrCreateRoomResponse struct {
@@ -14,13 +21,188 @@ type RequestCustomToken struct {
 	UserId string `json:"user_id" validate:"required"`
 }
 
-// TODO memastikan snake_case bukan pascalCase
+// RequestSendMessage client message id is optional, a message sent again with the same one is stored once. The type
+// defaults to text, the message is the caption of a photo or an image.
 type RequestSendMessage struct {
-	RoomId   string `json:"room_id" validate:"required"`
-	SenderId string `validate:"required"`
-	Message  string `json:"message" validate:"required"`
+	RoomId          string                   `json:"room_id" validate:"required,max=26"`
+	SenderId        string                   `validate:"required"`
+	ClientMessageId string                   `json:"client_message_id" validate:"max=64"`
+	Type            enum.ChatMessageTypeEnum `json:"type" validate:"omitempty,oneof=TEXT PHOTO IMAGE"`
+	Message         string                   `json:"message"`
+	PhotoId         string                   `json:"photo_id" validate:"required_if=Type PHOTO,max=26"`
+	FileKey         string                   `json:"file_key" validate:"required_if=Type IMAGE,max=255"`
 }
 
 type CustomTokenResponse struct {
 	Token string `json:"token"`
 }
+
+type RequestGetChatRooms struct {
+	UserId string `validate:"required"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=50"`
+}
+
+type RequestGetChatMessages struct {
+	UserId string `validate:"required"`
+	RoomId string `json:"room_id" validate:"required,max=26"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=100"`
+}
+
+// RequestMarkChatRead marks the message and every message before it as read
+type RequestMarkChatRead struct {
+	UserId    string `validate:"required"`
+	RoomId    string `json:"room_id" validate:"required,max=26"`
+	MessageId string `json:"message_id" validate:"required,max=26"`
+}
+
+// ChatMessageResponse sender id is nil for a system message
+type ChatMessageResponse struct {
+	Id              string                   `json:"id"`
+	RoomId          string                   `json:"room_id"`
+	SenderId        *string                  `json:"sender_id"`
+	ClientMessageId *string                  `json:"client_message_id,omitempty"`
+	Type            enum.ChatMessageTypeEnum `json:"type"`
+	Message         string                   `json:"message"`
+	Attachment      any                      `json:"attachment,omitempty"`
+	CreatedAt       *time.Time               `json:"created_at"`
+}
+
+// ChatPhotoAttachment is the preview of the photo when it was shared, the buy action is checked again at checkout
+type ChatPhotoAttachment struct {
+	PhotoId    string `json:"photo_id"`
+	CreatorId  string `json:"creator_id"`
+	Title      string `json:"title"`
+	Price      int32  `json:"price"`
+	PriceStr   string `json:"price_str"`
+	PreviewUrl string `json:"preview_url"`
+	IsBuyable  bool   `json:"is_buyable"`
+}
+
+// ChatImageAttachment is stored with the message, the file key is never sent to the clients
+type ChatImageAttachment struct {
+	FileKey  string `json:"file_key"`
+	Mimetype string `json:"mimetype"`
+	Size     int64  `json:"size"`
+}
+
+// ChatImageAttachmentResponse image url redirects the room members to a short lived link of the image
+type ChatImageAttachmentResponse struct {
+	ImageUrl string `json:"image_url"`
+	Mimetype string `json:"mimetype"`
+	Size     int64  `json:"size"`
+}
+
+type ChatSystemAttachment struct {
+	Event         enum.ChatSystemEventEnum `json:"event"`
+	TransactionId string                   `json:"transaction_id,omitempty"`
+	Amount        int32                    `json:"amount,omitempty"`
+	PhotoIds      []string                 `json:"photo_ids,omitempty"`
+}
+
+type RequestGetChatMessageImage struct {
+	UserId    string `validate:"required"`
+	RoomId    string `json:"room_id" validate:"required,max=26"`
+	MessageId string `json:"message_id" validate:"required,max=26"`
+}
+
+type ChatRoomResponse struct {
+	RoomId            string               `json:"room_id"`
+	PeerId            string               `json:"peer_id"`
+	PeerUsername      *string              `json:"peer_username"`
+	LastMessage       *ChatMessageResponse `json:"last_message"`
+	LastReadMessageId *string              `json:"last_read_message_id"`
+	UnreadCount       int                  `json:"unread_count"`
+	ActiveAt          *time.Time           `json:"active_at"`
+}
+
+type ChatReadResponse struct {
+	RoomId    string     `json:"room_id"`
+	UserId    string     `json:"user_id"`
+	MessageId string     `json:"message_id"`
+	ReadAt    *time.Time `json:"read_at"`
+}
+
+type ChatUnreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+// ChatFrame is a websocket frame sent by a client, the request id is echoed on the error frame of a failed request
+type ChatFrame struct {
+	Type            enum.ChatEventTypeEnum   `json:"type"`
+	RequestId       string                   `json:"request_id"`
+	RoomId          string                   `json:"room_id"`
+	ClientMessageId string                   `json:"client_message_id"`
+	MessageType     enum.ChatMessageTypeEnum `json:"message_type"`
+	Message         string                   `json:"message"`
+	PhotoId         string                   `json:"photo_id"`
+	FileKey         string                   `json:"file_key"`
+	MessageId       string                   `json:"message_id"`
+}
+
+// ChatEvent is a websocket frame sent to the clients of the room members
+type ChatEvent struct {
+	Type      enum.ChatEventTypeEnum `json:"type"`
+	RequestId string                 `json:"request_id,omitempty"`
+	Data      any                    `json:"data,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// ChatEventEnvelope carries a chat event between the instances, every instance delivers it to the members connected
+// to it
+type ChatEventEnvelope struct {
+	MemberIds []string        `json:"member_ids"`
+	Event     json.RawMessage `json:"event"`
+}
+
+type RequestBlockUser struct {
+	UserId        string `validate:"required"`
+	BlockedUserId string `json:"user_id" validate:"required,max=26,nefield=UserId"`
+}
+
+type RequestUnblockUser struct {
+	UserId        string `validate:"required"`
+	BlockedUserId string `json:"user_id" validate:"required,max=26"`
+}
+
+type RequestGetBlockedUsers struct {
+	UserId string `validate:"required"`
+	Cursor string `json:"cursor"`
+	Size   int    `json:"size" validate:"required,min=1,max=50"`
+}
+
+type BlockedUserResponse struct {
+	UserId    string     `json:"user_id"`
+	Username  *string    `json:"username"`
+	BlockedAt *time.Time `json:"blocked_at"`
+}
+
+// RequestReportChat reports the other member of the room, the message id narrows the report to one of their messages.
+// Block also blocks the reported user.
+type RequestReportChat struct {
+	ReporterId  string                    `validate:"required"`
+	RoomId      string                    `json:"room_id" validate:"required,max=26"`
+	MessageId   string                    `json:"message_id" validate:"omitempty,max=26"`
+	Reason      enum.ChatReportReasonEnum `json:"reason" validate:"required,oneof=SPAM HARASSMENT HATE_SPEECH SCAM INAPPROPRIATE OTHER"`
+	Description string                    `json:"description" validate:"max=1000"`
+	Block       bool                      `json:"block"`
+}
+
+// ChatReportResponse reported message is only filled for the moderators looking at a single report
+type ChatReportResponse struct {
+	Id              string                    `json:"id"`
+	RoomId          string                    `json:"room_id"`
+	ReporterId      string                    `json:"reporter_id"`
+	ReportedUserId  string                    `json:"reported_user_id"`
+	MessageId       *string                   `json:"message_id,omitempty"`
+	Reason          enum.ChatReportReasonEnum `json:"reason"`
+	Description     *string                   `json:"description,omitempty"`
+	Status          enum.ChatReportStatusEnum `json:"status"`
+	ReviewerId      *string                   `json:"reviewer_id,omitempty"`
+	ReviewNote      *string                   `json:"review_note,omitempty"`
+	ReviewedAt      *time.Time                `json:"reviewed_at,omitempty"`
+	ReportedMessage *ChatMessageResponse      `json:"reported_message,omitempty"`
+	CreatedAt       *time.Time                `json:"created_at"`
+	UpdatedAt       *time.Time                `json:"updated_at"`
+}
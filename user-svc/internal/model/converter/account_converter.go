@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+func UserToAccountDeletionResponse(user *entity.User) *model.AccountDeletionResponse {
+	return &model.AccountDeletionResponse{
+		DeletionRequested: user.HasPendingDeletion(),
+		RequestedAt:       user.DeletionRequestedAt,
+		ScheduledAt:       user.DeletionScheduledAt,
+	}
+}
+
+func DataExportToResponse(dataExport *entity.UserDataExport, downloadUrl string) *model.DataExportResponse {
+	return &model.DataExportResponse{
+		Id:            dataExport.Id,
+		Status:        dataExport.Status,
+		DownloadUrl:   downloadUrl,
+		FailureReason: nullable.SQLStringToPtr(dataExport.FailureReason),
+		CompletedAt:   dataExport.CompletedAt,
+		CreatedAt:     dataExport.CreatedAt,
+	}
+}
+
+func UserToArchiveAccount(user *entity.User) *model.UserDataArchiveAccount {
+	return &model.UserDataArchiveAccount{
+		Id:                    user.Id,
+		Username:              user.Username,
+		Email:                 nullable.SQLStringToPtr(user.Email),
+		EmailVerifiedAt:       user.EmailVerifiedAt,
+		PhoneNumber:           nullable.SQLStringToPtr(user.PhoneNumber),
+		PhoneNumberVerifiedAt: user.PhoneNumberVerifiedAt,
+		HasFacecam:            user.HasFacecam,
+		Roles:                 user.Roles,
+		TwoFactorEnabledAt:    user.TwoFactorEnabledAt,
+		DeletionScheduledAt:   user.DeletionScheduledAt,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+	}
+}
+
+func UserImagesToArchiveImages(userImages []*entity.UserImage) []*model.UserDataArchiveImage {
+	images := make([]*model.UserDataArchiveImage, 0, len(userImages))
+	for _, userImage := range userImages {
+		images = append(images, &model.UserDataArchiveImage{
+			FileName:  userImage.FileName,
+			ImageType: userImage.ImageType,
+			Size:      userImage.Size,
+			Url:       nullable.SQLStringToPtr(userImage.Url),
+			CreatedAt: userImage.CreatedAt,
+		})
+	}
+
+	return images
+}
+
+func UserDevicesToArchiveDevices(userDevices []*entity.UserDevice) []*model.UserDataArchiveDevice {
+	devices := make([]*model.UserDataArchiveDevice, 0, len(userDevices))
+	for _, userDevice := range userDevices {
+		devices = append(devices, &model.UserDataArchiveDevice{
+			Platform:  userDevice.Platform,
+			CreatedAt: userDevice.CreatedAt,
+		})
+	}
+
+	return devices
+}
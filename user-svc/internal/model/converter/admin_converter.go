@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+func SocialMediaToResponse(socialMedia *entity.SocialMedia) *model.SocialMediaResponse {
+	return &model.SocialMediaResponse{
+		Id:          socialMedia.Id,
+		Name:        socialMedia.Name,
+		BaseUrl:     nullable.SQLStringToPtr(socialMedia.BaseUrl),
+		LogoUrl:     nullable.SQLStringToPtr(socialMedia.LogoUrl),
+		Description: nullable.SQLStringToPtr(socialMedia.Description),
+		IsActive:    socialMedia.IsActive,
+		CreatedAt:   socialMedia.CreatedAt,
+		UpdatedAt:   socialMedia.UpdatedAt,
+	}
+}
+
+func SocialMediasToResponses(socialMedias *[]*entity.SocialMedia) *[]*model.SocialMediaResponse {
+	responses := make([]*model.SocialMediaResponse, 0, len(*socialMedias))
+	for _, socialMedia := range *socialMedias {
+		responses = append(responses, SocialMediaToResponse(socialMedia))
+	}
+	return &responses
+}
+
+func UserToAdminResponse(user *entity.User) *model.AdminUserResponse {
+	return &model.AdminUserResponse{
+		Id:               user.Id,
+		Username:         user.Username,
+		Roles:            user.Roles,
+		Permissions:      enum.ResolvePermissions(user.Roles),
+		SuspendedAt:      user.SuspendedAt,
+		SuspensionReason: nullable.SQLStringToPtr(user.SuspensionReason),
+		UpdatedAt:        user.UpdatedAt,
+	}
+}
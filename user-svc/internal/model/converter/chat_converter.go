@@ -1 +1,126 @@
 package converter
+
+import (
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+func ChatMessageToResponse(message *entity.ChatMessage) *model.ChatMessageResponse {
+	response := &model.ChatMessageResponse{
+		Id:              message.Id,
+		RoomId:          message.RoomId,
+		SenderId:        nullable.SQLStringToPtr(message.SenderId),
+		ClientMessageId: nullable.SQLStringToPtr(message.ClientMessageId),
+		Type:            message.MessageType,
+		Message:         message.Message,
+		CreatedAt:       message.CreatedAt,
+	}
+
+	// the file key of an image stays private, the members get the image through the image endpoint
+	switch {
+	case message.Attachment == nil:
+	case message.MessageType == enum.ChatMessageTypeImage:
+		image := new(model.ChatImageAttachment)
+		if err := sonic.ConfigFastest.Unmarshal(*message.Attachment, image); err == nil {
+			response.Attachment = &model.ChatImageAttachmentResponse{
+				ImageUrl: ChatMessageImageUrl(message.RoomId, message.Id),
+				Mimetype: image.Mimetype,
+				Size:     image.Size,
+			}
+		}
+	default:
+		response.Attachment = message.Attachment
+	}
+
+	return response
+}
+
+func ChatMessageImageUrl(roomId, messageId string) string {
+	return fmt.Sprintf("/api/users/chat/rooms/%s/messages/%s/image", roomId, messageId)
+}
+
+func ChatMessagesToResponses(messages []*entity.ChatMessage) []*model.ChatMessageResponse {
+	responses := make([]*model.ChatMessageResponse, 0, len(messages))
+	for _, message := range messages {
+		responses = append(responses, ChatMessageToResponse(message))
+	}
+	return responses
+}
+
+func ChatRoomSummariesToResponses(summaries []*entity.ChatRoomSummary) []*model.ChatRoomResponse {
+	responses := make([]*model.ChatRoomResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		response := &model.ChatRoomResponse{
+			RoomId:            summary.RoomId,
+			PeerId:            summary.PeerId,
+			PeerUsername:      nullable.SQLStringToPtr(summary.PeerUsername),
+			LastReadMessageId: nullable.SQLStringToPtr(summary.LastReadMessageId),
+			UnreadCount:       summary.UnreadCount,
+			ActiveAt:          summary.ActiveAt,
+		}
+		if summary.LastMessageId.Valid {
+			response.LastMessage = &model.ChatMessageResponse{
+				Id:        summary.LastMessageId.String,
+				RoomId:    summary.RoomId,
+				SenderId:  nullable.SQLStringToPtr(summary.LastMessageSender),
+				Type:      enum.ChatMessageTypeEnum(summary.LastMessageType.String),
+				Message:   summary.LastMessage.String,
+				CreatedAt: summary.LastMessageAt,
+			}
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+func ChatRoomMemberToReadResponse(member *entity.ChatRoomMember) *model.ChatReadResponse {
+	return &model.ChatReadResponse{
+		RoomId:    member.RoomId,
+		UserId:    member.UserId,
+		MessageId: member.LastReadMessageId.String,
+		ReadAt:    member.LastReadAt,
+	}
+}
+
+func BlockedUsersToResponses(blockedUsers []*entity.BlockedUser) []*model.BlockedUserResponse {
+	responses := make([]*model.BlockedUserResponse, 0, len(blockedUsers))
+	for _, blockedUser := range blockedUsers {
+		responses = append(responses, &model.BlockedUserResponse{
+			UserId:    blockedUser.UserId,
+			Username:  nullable.SQLStringToPtr(blockedUser.Username),
+			BlockedAt: blockedUser.BlockedAt,
+		})
+	}
+	return responses
+}
+
+func ChatReportToResponse(report *entity.ChatReport) *model.ChatReportResponse {
+	return &model.ChatReportResponse{
+		Id:             report.Id,
+		RoomId:         report.RoomId,
+		ReporterId:     report.ReporterId,
+		ReportedUserId: report.ReportedUserId,
+		MessageId:      nullable.SQLStringToPtr(report.MessageId),
+		Reason:         report.Reason,
+		Description:    nullable.SQLStringToPtr(report.Description),
+		Status:         report.Status,
+		ReviewerId:     nullable.SQLStringToPtr(report.ReviewerId),
+		ReviewNote:     nullable.SQLStringToPtr(report.ReviewNote),
+		ReviewedAt:     report.ReviewedAt,
+		CreatedAt:      report.CreatedAt,
+		UpdatedAt:      report.UpdatedAt,
+	}
+}
+
+func ChatReportsToResponses(reports []*entity.ChatReport) []*model.ChatReportResponse {
+	responses := make([]*model.ChatReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, ChatReportToResponse(report))
+	}
+	return responses
+}
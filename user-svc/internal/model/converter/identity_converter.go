@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+func UserIdentityToResponse(identity *entity.UserIdentity) *model.UserIdentityResponse {
+	return &model.UserIdentityResponse{
+		Provider:  identity.Provider,
+		Email:     nullable.SQLStringToPtr(identity.Email),
+		CreatedAt: identity.CreatedAt,
+	}
+}
+
+func UserIdentitiesToResponses(identities []*entity.UserIdentity) []*model.UserIdentityResponse {
+	responses := make([]*model.UserIdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		responses = append(responses, UserIdentityToResponse(identity))
+	}
+
+	return responses
+}
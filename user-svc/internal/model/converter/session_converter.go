@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+func SessionToResponse(session *entity.UserSession, currentSessionId string) *model.SessionResponse {
+	return &model.SessionResponse{
+		Id:         session.Id,
+		Device:     session.Device.String,
+		Platform:   session.Platform.String,
+		IpAddress:  session.IpAddress.String,
+		UserAgent:  session.UserAgent.String,
+		Current:    session.Id == currentSessionId,
+		LastSeenAt: session.LastSeenAt,
+		CreatedAt:  session.CreatedAt,
+	}
+}
+
+func SessionsToResponses(sessions []*entity.UserSession, currentSessionId string) []*model.SessionResponse {
+	responses := make([]*model.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		responses = append(responses, SessionToResponse(session, currentSessionId))
+	}
+	return responses
+}
@@ -0,0 +1,43 @@
+package converter
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+// SocialLinkUrl appends the handle to the social media base url. Social medias without
+// a base url store the full link as the handle.
+func SocialLinkUrl(baseUrl sql.NullString, handle string) string {
+	if !baseUrl.Valid || baseUrl.String == "" {
+		return handle
+	}
+
+	if strings.HasSuffix(baseUrl.String, "/") || strings.HasSuffix(baseUrl.String, "@") {
+		return baseUrl.String + handle
+	}
+
+	return baseUrl.String + "/" + handle
+}
+
+func SocialLinkToResponse(link *entity.UserSocialLinkDetail) *model.SocialLinkResponse {
+	return &model.SocialLinkResponse{
+		SocialMediaId: link.SocialMediaId,
+		Name:          link.Name,
+		LogoUrl:       nullable.SQLStringToPtr(link.LogoUrl),
+		Handle:        link.Handle,
+		Url:           SocialLinkUrl(link.BaseUrl, link.Handle),
+		UpdatedAt:     link.UpdatedAt,
+	}
+}
+
+func SocialLinksToResponses(links []*entity.UserSocialLinkDetail) []*model.SocialLinkResponse {
+	responses := make([]*model.SocialLinkResponse, 0, len(links))
+	for _, link := range links {
+		responses = append(responses, SocialLinkToResponse(link))
+	}
+	return responses
+}
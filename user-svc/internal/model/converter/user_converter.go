@@ -15,8 +15,19 @@ func UserToResponse(u *entity.User) *model.UserResponse {
 		PhoneNumber: nullable.SQLStringToPtr(u.PhoneNumber),
 		// PhoneNumberVerifiedAt: u.PhoneNumberVerifiedAt,
 		HasFacecam: u.HasFacecam,
-		GoogleId:   nullable.SQLStringToPtr(u.GoogleId),
 		CreatedAt:  u.CreatedAt,
 		UpdatedAt:  u.UpdatedAt,
 	}
 }
+
+func UsersToContactResponses(users []*entity.User) []*model.UserContactResponse {
+	responses := make([]*model.UserContactResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, &model.UserContactResponse{
+			UserId:   user.Id,
+			Username: user.Username,
+			Email:    user.Email.String,
+		})
+	}
+	return responses
+}
@@ -22,3 +22,14 @@ func UserProfileToResponse(userProfile *entity.UserProfile) *model.UserProfileRe
 		UpdatedAt:       userProfile.UpdatedAt,
 	}
 }
+
+func ToPublicUserProfileResponse(user *entity.User, userProfile *entity.UserProfile) *model.PublicUserProfileResponse {
+	return &model.PublicUserProfileResponse{
+		UserId:          user.Id,
+		Username:        user.Username,
+		Nickname:        userProfile.Nickname,
+		Biography:       nullable.SQLStringToPtr(userProfile.Biography),
+		ProfileUrl:      nullable.SQLStringToPtr(userProfile.ProfileUrl),
+		ProfileCoverUrl: nullable.SQLStringToPtr(userProfile.ProfileCoverUrl),
+	}
+}
@@ -0,0 +1,18 @@
+package event
+
+import "time"
+
+type OwnerOwnPhotosEvent struct {
+	EventID       string              `json:"event_id"`
+	UserId        string              `json:"user_id"`
+	TransactionId string              `json:"transaction_id"`
+	PhotoIds      []string            `json:"photo_ids"`
+	Amount        int32               `json:"amount"`
+	CreatorSales  []*CreatorSaleEvent `json:"creator_sales"`
+	SettledAt     *time.Time          `json:"settled_at"`
+}
+
+type CreatorSaleEvent struct {
+	CreatorId string `json:"creator_id"`
+	Amount    int32  `json:"amount"`
+}
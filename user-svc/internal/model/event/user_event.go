@@ -18,3 +18,19 @@ type UserDeviceEvent struct {
 	DeviceToken string                `json:"device_token" validate:"required"`
 	Platform    enum.PlatformTypeEnum `json:"platform" validate:"required"`
 }
+
+// UserDeletedEvent is fanned out once an account is erased. PseudonymId replaces
+// the user id wherever a service has to keep records, it is the same for every
+// service so those records stay consistent with each other.
+type UserDeletedEvent struct {
+	Id          string     `json:"id"`
+	PseudonymId string     `json:"pseudonym_id"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+}
+
+// UserProfileUpdatedEvent tells the other services that the public profile of a user changed,
+// including the images and social links, so they can drop what they cached from it.
+type UserProfileUpdatedEvent struct {
+	Id        string     `json:"id"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
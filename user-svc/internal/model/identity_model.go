@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+// IdentityClaim is what an identity provider asserts about the user in a verified id token
+type IdentityClaim struct {
+	Provider      enum.IdentityProviderEnum
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	PictureUrl    string
+}
+
+type LinkIdentityRequest struct {
+	UserId   string                    `validate:"required"`
+	Provider enum.IdentityProviderEnum `validate:"required"`
+	Token    string                    `json:"token" validate:"required"`
+}
+
+type UnlinkIdentityRequest struct {
+	UserId   string                    `validate:"required"`
+	Provider enum.IdentityProviderEnum `validate:"required"`
+}
+
+type UserIdentityResponse struct {
+	Provider  enum.IdentityProviderEnum `json:"provider"`
+	Email     *string                   `json:"email,omitempty"`
+	CreatedAt *time.Time                `json:"created_at"`
+}
@@ -4,7 +4,8 @@ type WebResponse[T any] struct {
 	Success bool `json:"success"`
 	Data    T    `json:"data,omitempty"`
 	// Token        *TokenResponse `json:"token,omitempty"`
-	PageMetadata *PageMetadata `json:"pagination,omitempty"`
+	PageMetadata   *PageMetadata   `json:"pagination,omitempty"`
+	CursorMetadata *CursorMetadata `json:"cursor,omitempty"`
 }
 
 type PageMetadata struct {
@@ -19,6 +20,13 @@ type PageMetadata struct {
 	PreviousPageURL string
 }
 
+type CursorMetadata struct {
+	Size        int    `json:"size"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+	HasMore     bool   `json:"has_more"`
+	NextPageURL string `json:"next_page_url,omitempty"`
+}
+
 type ValidationError struct {
 	Field   string `json:"field"`
 	Rule    string `json:"rule"`
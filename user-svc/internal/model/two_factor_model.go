@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type TwoFactorCodeRequest struct {
+	UserId    string `validate:"required"`
+	SessionId string
+	Code      string `json:"code" validate:"required,min=6,max=16"`
+}
+
+type LoginByTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,min=6,max=16"`
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+}
+
+type TwoFactorEnrollResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUrl string `json:"otpauth_url"`
+	ExpiresIn  int    `json:"expires_in"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type StepUpResponse struct {
+	VerifiedAt time.Time `json:"verified_at"`
+	ExpiresIn  int       `json:"expires_in"`
+}
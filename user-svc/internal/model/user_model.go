@@ -19,16 +19,55 @@ type RequestUpdateUserProfile struct {
 }
 
 type UserProfileResponse struct {
-	Id              string     `json:"id"`
-	UserId          string     `json:"user_id"`
-	BirthDate       string     `json:"birth_date,omitempty"`
-	Nickname        string     `json:"nickname"`
-	Biography       *string    `json:"biography"`
-	ProfileUrl      *string    `json:"profile_url"`
-	ProfileCoverUrl *string    `json:"profile_cover_url"`
-	Similarity      uint       `json:"similarity"`
-	CreatedAt       *time.Time `json:"created_at,omitempty"`
-	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+	Id              string                `json:"id"`
+	UserId          string                `json:"user_id"`
+	BirthDate       string                `json:"birth_date,omitempty"`
+	Nickname        string                `json:"nickname"`
+	Biography       *string               `json:"biography"`
+	ProfileUrl      *string               `json:"profile_url"`
+	ProfileCoverUrl *string               `json:"profile_cover_url"`
+	Similarity      uint                  `json:"similarity"`
+	SocialLinks     []*SocialLinkResponse `json:"social_links"`
+	CreatedAt       *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time            `json:"updated_at,omitempty"`
+}
+
+type PublicUserProfileResponse struct {
+	UserId          string                `json:"user_id"`
+	Username        string                `json:"username"`
+	Nickname        string                `json:"nickname"`
+	Biography       *string               `json:"biography"`
+	ProfileUrl      *string               `json:"profile_url"`
+	ProfileCoverUrl *string               `json:"profile_cover_url"`
+	SocialLinks     []*SocialLinkResponse `json:"social_links"`
+}
+
+type UserContactResponse struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+type RequestSetSocialLink struct {
+	UserId        string `validate:"required"`
+	UserProfileId string `validate:"required"`
+	SocialMediaId string `json:"-" validate:"required,max=26"`
+	Handle        string `json:"handle" validate:"required,max=255"`
+}
+
+type RequestDeleteSocialLink struct {
+	UserId        string `validate:"required"`
+	UserProfileId string `validate:"required"`
+	SocialMediaId string `validate:"required,max=26"`
+}
+
+type SocialLinkResponse struct {
+	SocialMediaId string     `json:"social_media_id"`
+	Name          string     `json:"name"`
+	LogoUrl       *string    `json:"logo_url,omitempty"`
+	Handle        string     `json:"handle"`
+	Url           string     `json:"url"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
 }
 
 type RequestGetAllPublicUser struct {
@@ -37,6 +76,12 @@ type RequestGetAllPublicUser struct {
 	Size     int    `json:"size" validate:"required"`
 }
 
+type RequestGetAllPublicUserByCursor struct {
+	Username string `json:"username"`
+	Cursor   string `json:"cursor"`
+	Size     int    `json:"size" validate:"required,min=1,max=100"`
+}
+
 type GetAllPublicUserResponse struct {
 	UserId     string `json:"user_id"`
 	Username   string `json:"username"`
@@ -51,3 +96,10 @@ type RequestUpdateSimilarity struct {
 type UpdateSeimilarityResponse struct {
 	Similarity enum.SimilarityLevelEnum `json:"similarity"`
 }
+
+type ListSegmentUserIdsRequest struct {
+	Segment      enum.AudienceSegmentEnum
+	InactiveDays int
+	AfterUserId  string
+	Limit        int
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+type ChatMessageRepository interface {
+	Create(ctx context.Context, tx Querier, message *entity.ChatMessage) (*entity.ChatMessage, bool, error)
+	CreateSystem(ctx context.Context, tx Querier, message *entity.ChatMessage) (bool, error)
+	FindById(ctx context.Context, tx Querier, messageId string) (*entity.ChatMessage, error)
+	FindByCursor(ctx context.Context, tx Querier, roomId string, cursor *pagination.Cursor, size int) ([]*entity.ChatMessage, *model.CursorMetadata, error)
+}
+
+type chatMessageRepository struct{}
+
+func NewChatMessageRepository() ChatMessageRepository {
+	return &chatMessageRepository{}
+}
+
+// Create returns the message already stored under the same client message id of the sender with false, so a resent
+// message is not stored twice
+func (r *chatMessageRepository) Create(ctx context.Context, tx Querier, message *entity.ChatMessage) (*entity.ChatMessage, bool, error) {
+	query := `
+	INSERT INTO chat_messages (id, room_id, sender_id, client_message_id, message_type, message, attachment, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT (sender_id, client_message_id) WHERE client_message_id IS NOT NULL DO NOTHING
+	RETURNING *
+	`
+	created := new(entity.ChatMessage)
+	err := tx.GetContext(ctx, created, query, message.Id, message.RoomId, message.SenderId, message.ClientMessageId,
+		message.MessageType, message.Message, message.Attachment, message.CreatedAt)
+	if err == nil {
+		return created, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	existing := new(entity.ChatMessage)
+	existingQuery := `SELECT * FROM chat_messages WHERE sender_id = $1 AND client_message_id = $2`
+	if err := tx.GetContext(ctx, existing, existingQuery, message.SenderId, message.ClientMessageId); err != nil {
+		return nil, false, err
+	}
+
+	return existing, false, nil
+}
+
+// CreateSystem returns false when the room already has a system message with the same client message id, so an event
+// delivered again does not post twice
+func (r *chatMessageRepository) CreateSystem(ctx context.Context, tx Querier, message *entity.ChatMessage) (bool, error) {
+	query := `
+	INSERT INTO chat_messages (id, room_id, sender_id, client_message_id, message_type, message, attachment, created_at)
+	VALUES ($1, $2, NULL, $3, $4, $5, $6, $7)
+	ON CONFLICT (room_id, client_message_id) WHERE sender_id IS NULL DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, query, message.Id, message.RoomId, message.ClientMessageId, message.MessageType,
+		message.Message, message.Attachment, message.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *chatMessageRepository) FindById(ctx context.Context, tx Querier, messageId string) (*entity.ChatMessage, error) {
+	message := new(entity.ChatMessage)
+	query := `SELECT * FROM chat_messages WHERE id = $1`
+	if err := tx.GetContext(ctx, message, query, messageId); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// FindByCursor lists the messages of a room from the newest, sorted by created time and id
+func (r *chatMessageRepository) FindByCursor(ctx context.Context, tx Querier, roomId string, cursor *pagination.Cursor,
+	size int) ([]*entity.ChatMessage, *model.CursorMetadata, error) {
+	query := `SELECT * FROM chat_messages WHERE room_id = $1`
+	args := []interface{}{roomId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY created_at DESC, id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	messages := make([]*entity.ChatMessage, 0)
+	if err := tx.SelectContext(ctx, &messages, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(messages) > size
+	if hasMore {
+		messages = messages[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := messages[len(messages)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return messages, cursorMetadata, nil
+}
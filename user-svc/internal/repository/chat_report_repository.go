@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+)
+
+type ChatReportRepository interface {
+	Create(ctx context.Context, tx Querier, report *entity.ChatReport) (bool, error)
+	FindById(ctx context.Context, tx Querier, reportId string) (*entity.ChatReport, error)
+	FindByCursor(ctx context.Context, tx Querier, status enum.ChatReportStatusEnum, cursor *pagination.Cursor, size int) ([]*entity.ChatReport, *model.CursorMetadata, error)
+	Review(ctx context.Context, tx Querier, report *entity.ChatReport) (bool, error)
+}
+
+type chatReportRepository struct{}
+
+func NewChatReportRepository() ChatReportRepository {
+	return &chatReportRepository{}
+}
+
+// Create returns false when the reporter already has a pending report of the room
+func (r *chatReportRepository) Create(ctx context.Context, tx Querier, report *entity.ChatReport) (bool, error) {
+	query := `
+	INSERT INTO chat_reports (id, room_id, reporter_id, reported_user_id, message_id, reason, description, status,
+		created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	ON CONFLICT (reporter_id, room_id) WHERE status = 'PENDING' DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, query, report.Id, report.RoomId, report.ReporterId, report.ReportedUserId,
+		report.MessageId, report.Reason, report.Description, report.Status, report.CreatedAt, report.UpdatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *chatReportRepository) FindById(ctx context.Context, tx Querier, reportId string) (*entity.ChatReport, error) {
+	report := new(entity.ChatReport)
+	query := `SELECT * FROM chat_reports WHERE id = $1`
+	if err := tx.GetContext(ctx, report, query, reportId); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// FindByCursor lists the reports of a status from the oldest, so the moderation queue is worked first in first out
+func (r *chatReportRepository) FindByCursor(ctx context.Context, tx Querier, status enum.ChatReportStatusEnum, cursor *pagination.Cursor,
+	size int) ([]*entity.ChatReport, *model.CursorMetadata, error) {
+	query := `SELECT * FROM chat_reports WHERE status = $1`
+	args := []interface{}{status}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (created_at, id) > ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY created_at ASC, id ASC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	reports := make([]*entity.ChatReport, 0)
+	if err := tx.SelectContext(ctx, &reports, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(reports) > size
+	if hasMore {
+		reports = reports[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := reports[len(reports)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.Id)
+	}
+
+	return reports, cursorMetadata, nil
+}
+
+// Review returns false when the report was already reviewed, two moderators can not both close the same report
+func (r *chatReportRepository) Review(ctx context.Context, tx Querier, report *entity.ChatReport) (bool, error) {
+	query := `
+	UPDATE chat_reports
+	SET status = $2, reviewer_id = $3, review_note = $4, reviewed_at = $5, updated_at = $5
+	WHERE id = $1 AND status = 'PENDING'
+	`
+	result, err := tx.ExecContext(ctx, query, report.Id, report.Status, report.ReviewerId, report.ReviewNote, report.ReviewedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
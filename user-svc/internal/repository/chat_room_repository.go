@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/lib/pq"
+)
+
+type ChatRoomRepository interface {
+	Create(ctx context.Context, tx Querier, room *entity.ChatRoom, memberIds []string) (bool, error)
+	FindById(ctx context.Context, tx Querier, roomId string) (*entity.ChatRoom, error)
+	FindByRoomUserId(ctx context.Context, tx Querier, roomUserId string) (*entity.ChatRoom, error)
+	FindMember(ctx context.Context, tx Querier, roomId, userId string) (*entity.ChatRoomMember, error)
+	FindMemberIds(ctx context.Context, tx Querier, roomId string) ([]string, error)
+	FindSummariesByCursor(ctx context.Context, tx Querier, userId string, cursor *pagination.Cursor, size int) ([]*entity.ChatRoomSummary, *model.CursorMetadata, error)
+	CountUnread(ctx context.Context, tx Querier, userId string) (int, error)
+	UpdateLastMessage(ctx context.Context, tx Querier, message *entity.ChatMessage) error
+	MarkRead(ctx context.Context, tx Querier, roomId, userId string, message *entity.ChatMessage) (bool, error)
+}
+
+type chatRoomRepository struct{}
+
+func NewChatRoomRepository() ChatRoomRepository {
+	return &chatRoomRepository{}
+}
+
+// Create returns false when the pair already has a room, the members are only added with a new room
+func (r *chatRoomRepository) Create(ctx context.Context, tx Querier, room *entity.ChatRoom, memberIds []string) (bool, error) {
+	query := `
+	INSERT INTO chat_rooms (id, room_user_id, created_at, updated_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (room_user_id) DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, query, room.Id, room.RoomUserId, room.CreatedAt, room.UpdatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	memberQuery := `
+	INSERT INTO chat_room_members (room_id, user_id, joined_at)
+	SELECT $1, user_id, $3 FROM unnest($2::text[]) AS t(user_id)
+	`
+	if _, err := tx.ExecContext(ctx, memberQuery, room.Id, pq.Array(memberIds), room.CreatedAt); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *chatRoomRepository) FindById(ctx context.Context, tx Querier, roomId string) (*entity.ChatRoom, error) {
+	room := new(entity.ChatRoom)
+	query := `SELECT * FROM chat_rooms WHERE id = $1`
+	if err := tx.GetContext(ctx, room, query, roomId); err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+func (r *chatRoomRepository) FindByRoomUserId(ctx context.Context, tx Querier, roomUserId string) (*entity.ChatRoom, error) {
+	room := new(entity.ChatRoom)
+	query := `SELECT * FROM chat_rooms WHERE room_user_id = $1`
+	if err := tx.GetContext(ctx, room, query, roomUserId); err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+func (r *chatRoomRepository) FindMember(ctx context.Context, tx Querier, roomId, userId string) (*entity.ChatRoomMember, error) {
+	member := new(entity.ChatRoomMember)
+	query := `SELECT * FROM chat_room_members WHERE room_id = $1 AND user_id = $2`
+	if err := tx.GetContext(ctx, member, query, roomId, userId); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (r *chatRoomRepository) FindMemberIds(ctx context.Context, tx Querier, roomId string) ([]string, error) {
+	memberIds := make([]string, 0, 2)
+	query := `SELECT user_id FROM chat_room_members WHERE room_id = $1`
+	if err := tx.SelectContext(ctx, &memberIds, query, roomId); err != nil {
+		return nil, err
+	}
+
+	return memberIds, nil
+}
+
+// unreadCountColumn counts the messages of the others and the system after the read marker of the member aliased me
+const unreadCountColumn = `
+	(
+		SELECT COUNT(*) FROM chat_messages m
+		WHERE m.room_id = me.room_id AND m.sender_id IS DISTINCT FROM me.user_id
+		AND (me.last_read_at IS NULL OR (m.created_at, m.id) > (me.last_read_at, me.last_read_message_id))
+	)`
+
+// FindSummariesByCursor lists the rooms of a user from the most recently active, sorted by active time and room id
+func (r *chatRoomRepository) FindSummariesByCursor(ctx context.Context, tx Querier, userId string, cursor *pagination.Cursor,
+	size int) ([]*entity.ChatRoomSummary, *model.CursorMetadata, error) {
+	query := `
+	SELECT
+		r.id AS room_id,
+		peer.user_id AS peer_id,
+		u.username AS peer_username,
+		r.last_message_id,
+		lm.sender_id AS last_message_sender_id,
+		lm.message_type AS last_message_type,
+		lm.message AS last_message,
+		r.last_message_at,
+		me.last_read_message_id,
+		` + unreadCountColumn + ` AS unread_count,
+		COALESCE(r.last_message_at, r.created_at) AS active_at
+	FROM chat_room_members me
+	JOIN chat_rooms r ON r.id = me.room_id
+	JOIN chat_room_members peer ON peer.room_id = me.room_id AND peer.user_id <> me.user_id
+	LEFT JOIN users u ON u.id = peer.user_id
+	LEFT JOIN chat_messages lm ON lm.id = r.last_message_id
+	WHERE me.user_id = $1`
+	args := []interface{}{userId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (COALESCE(r.last_message_at, r.created_at), r.id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY active_at DESC, r.id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	summaries := make([]*entity.ChatRoomSummary, 0)
+	if err := tx.SelectContext(ctx, &summaries, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(summaries) > size
+	if hasMore {
+		summaries = summaries[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := summaries[len(summaries)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.ActiveAt.Format(time.RFC3339Nano), last.RoomId)
+	}
+
+	return summaries, cursorMetadata, nil
+}
+
+// CountUnread sums the unread messages of every room of the user
+func (r *chatRoomRepository) CountUnread(ctx context.Context, tx Querier, userId string) (int, error) {
+	var count int
+	query := `SELECT COALESCE(SUM(` + unreadCountColumn + `), 0) FROM chat_room_members me WHERE me.user_id = $1`
+	if err := tx.GetContext(ctx, &count, query, userId); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpdateLastMessage never moves the last message of the room back to an older one
+func (r *chatRoomRepository) UpdateLastMessage(ctx context.Context, tx Querier, message *entity.ChatMessage) error {
+	query := `
+	UPDATE chat_rooms
+	SET last_message_id = $2, last_message_at = $3, updated_at = $3
+	WHERE id = $1 AND (last_message_at IS NULL OR (last_message_at, last_message_id) < ($3, $2))
+	`
+	if _, err := tx.ExecContext(ctx, query, message.RoomId, message.Id, message.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkRead only moves the read marker forward, it returns false when the marker is already at or after the message
+func (r *chatRoomRepository) MarkRead(ctx context.Context, tx Querier, roomId, userId string, message *entity.ChatMessage) (bool, error) {
+	query := `
+	UPDATE chat_room_members
+	SET last_read_message_id = $3, last_read_at = $4
+	WHERE room_id = $1 AND user_id = $2 AND (last_read_at IS NULL OR (last_read_at, last_read_message_id) < ($4, $3))
+	`
+	result, err := tx.ExecContext(ctx, query, roomId, userId, message.Id, message.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
@@ -36,6 +36,7 @@ type EmailVerificationRepository interface {
 	FindByEmail(ctx context.Context, email string) (*entity.EmailVerification, error)
 	FindByEmailAndToken(ctx context.Context, email, token string) (*entity.EmailVerification, error)
 	Delete(ctx context.Context, tx Querier, emailVerification *entity.EmailVerification) error
+	DeleteByEmail(ctx context.Context, tx Querier, email string) error
 }
 
 type emailVerificationRepository struct {
@@ -102,3 +103,12 @@ func (r *emailVerificationRepository) Delete(ctx context.Context, tx Querier, em
 
 	return nil
 }
+
+func (r *emailVerificationRepository) DeleteByEmail(ctx context.Context, tx Querier, email string) error {
+	query := `DELETE FROM email_verifications WHERE email = $1`
+	if _, err := tx.ExecContext(ctx, query, email); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -44,6 +44,7 @@ type ResetPasswordRepository interface {
 	FindByEmail(ctx context.Context, email string) (*entity.ResetPassword, error)
 	FindByEmailAndToken(ctx context.Context, email, token string) (*entity.ResetPassword, error)
 	Delete(ctx context.Context, tx Querier, resetPassword *entity.ResetPassword) error
+	DeleteByEmail(ctx context.Context, tx Querier, email string) error
 
 	CountByEmail(ctx context.Context, email string) (int, error)
 }
@@ -129,3 +130,12 @@ func (r *resetPasswordRepository) CountByEmail(ctx context.Context, email string
 
 	return total, nil
 }
+
+func (r *resetPasswordRepository) DeleteByEmail(ctx context.Context, tx Querier, email string) error {
+	query := `DELETE FROM reset_passwords WHERE email = $1`
+	if _, err := tx.ExecContext(ctx, query, email); err != nil {
+		return err
+	}
+
+	return nil
+}
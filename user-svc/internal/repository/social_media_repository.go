@@ -10,10 +10,12 @@ import (
 )
 
 type socialMediaPreparedStmt struct {
-	findAll     *sqlx.Stmt
-	findById    *sqlx.Stmt
-	findByName  *sqlx.Stmt
-	countByName *sqlx.Stmt
+	findAll        *sqlx.Stmt
+	findAllActive  *sqlx.Stmt
+	findById       *sqlx.Stmt
+	findByName     *sqlx.Stmt
+	countByName    *sqlx.Stmt
+	countUserLinks *sqlx.Stmt
 }
 
 func newSocialMediaStmt(db *sqlx.DB) (*socialMediaPreparedStmt, error) {
@@ -22,6 +24,11 @@ func newSocialMediaStmt(db *sqlx.DB) (*socialMediaPreparedStmt, error) {
 		return nil, err
 	}
 
+	findAllActiveStmt, err := db.Preparex("SELECT * FROM social_medias WHERE is_active = TRUE ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+
 	findByIdStmt, err := db.Preparex("SELECT * FROM social_medias WHERE id=$1")
 	if err != nil {
 		return nil, err
@@ -37,11 +44,18 @@ func newSocialMediaStmt(db *sqlx.DB) (*socialMediaPreparedStmt, error) {
 		return nil, err
 	}
 
+	countUserLinksStmt, err := db.Preparex("SELECT COUNT(*) FROM user_social_links WHERE social_media_id=$1")
+	if err != nil {
+		return nil, err
+	}
+
 	return &socialMediaPreparedStmt{
-		findAll:     findAllStmt,
-		findById:    findByIdStmt,
-		findByName:  findByNameStmt,
-		countByName: countByNameStmt,
+		findAll:        findAllStmt,
+		findAllActive:  findAllActiveStmt,
+		findById:       findByIdStmt,
+		findByName:     findByNameStmt,
+		countByName:    countByNameStmt,
+		countUserLinks: countUserLinksStmt,
 	}, nil
 }
 
@@ -49,8 +63,12 @@ type SocialMediaRepository interface {
 	Insert(ctx context.Context, tx Querier, socialMedia *entity.SocialMedia) (*entity.SocialMedia, error)
 	Update(ctx context.Context, tx Querier, socialMedia *entity.SocialMedia) (*entity.SocialMedia, error)
 	FindAll(ctx context.Context) (*[]*entity.SocialMedia, error)
+	FindAllActive(ctx context.Context) (*[]*entity.SocialMedia, error)
+	FindById(ctx context.Context, id string) (*entity.SocialMedia, error)
 	FindByName(ctx context.Context, name string) (*entity.SocialMedia, error)
-	Delete(ctx context.Context, tx Querier, name string) error
+	CountByName(ctx context.Context, name string) (int, error)
+	CountUserLinks(ctx context.Context, id string) (int, error)
+	Delete(ctx context.Context, tx Querier, id string) error
 }
 
 type socialMediaRepository struct {
@@ -82,9 +100,9 @@ func (r *socialMediaRepository) Insert(ctx context.Context, tx Querier, socialMe
 }
 
 func (r *socialMediaRepository) Update(ctx context.Context, tx Querier, socialMedia *entity.SocialMedia) (*entity.SocialMedia, error) {
-	query := `UPDATE social_medias  SET base_url = $1, logo_url = $2, description = $3, is_active = $4, updated_at = $5 WHERE id = $6`
+	query := `UPDATE social_medias  SET name = $1, base_url = $2, logo_url = $3, description = $4, is_active = $5, updated_at = $6 WHERE id = $7`
 
-	_, err := tx.ExecContext(ctx, query, socialMedia.BaseUrl, socialMedia.LogoUrl, socialMedia.Description, socialMedia.IsActive,
+	_, err := tx.ExecContext(ctx, query, socialMedia.Name, socialMedia.BaseUrl, socialMedia.LogoUrl, socialMedia.Description, socialMedia.IsActive,
 		socialMedia.UpdatedAt, socialMedia.Id)
 	if err != nil {
 		return nil, err
@@ -94,9 +112,17 @@ func (r *socialMediaRepository) Update(ctx context.Context, tx Querier, socialMe
 }
 
 func (r *socialMediaRepository) FindAll(ctx context.Context) (*[]*entity.SocialMedia, error) {
+	return r.findAllByStmt(ctx, r.socialMediaStmt.findAll)
+}
+
+func (r *socialMediaRepository) FindAllActive(ctx context.Context) (*[]*entity.SocialMedia, error) {
+	return r.findAllByStmt(ctx, r.socialMediaStmt.findAllActive)
+}
+
+func (r *socialMediaRepository) findAllByStmt(ctx context.Context, stmt *sqlx.Stmt) (*[]*entity.SocialMedia, error) {
 	socialMedias := make([]*entity.SocialMedia, 0)
 
-	rows, err := r.socialMediaStmt.findAll.QueryxContext(ctx)
+	rows, err := stmt.QueryxContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +139,17 @@ func (r *socialMediaRepository) FindAll(ctx context.Context) (*[]*entity.SocialM
 	return &socialMedias, nil
 }
 
+func (r *socialMediaRepository) FindById(ctx context.Context, id string) (*entity.SocialMedia, error) {
+	socialMedia := new(entity.SocialMedia)
+
+	row := r.socialMediaStmt.findById.QueryRowxContext(ctx, id)
+	if err := row.StructScan(socialMedia); err != nil {
+		return nil, err
+	}
+
+	return socialMedia, nil
+}
+
 func (r *socialMediaRepository) FindByName(ctx context.Context, name string) (*entity.SocialMedia, error) {
 	socialMedia := new(entity.SocialMedia)
 
@@ -124,9 +161,27 @@ func (r *socialMediaRepository) FindByName(ctx context.Context, name string) (*e
 	return socialMedia, nil
 }
 
-func (r *socialMediaRepository) Delete(ctx context.Context, tx Querier, name string) error {
-	query := `DELETE FROM social_medias WHERE name = $1`
-	_, err := tx.ExecContext(ctx, query, name)
+func (r *socialMediaRepository) CountByName(ctx context.Context, name string) (int, error) {
+	var total int
+	if err := r.socialMediaStmt.countByName.QueryRowxContext(ctx, name).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *socialMediaRepository) CountUserLinks(ctx context.Context, id string) (int, error) {
+	var total int
+	if err := r.socialMediaStmt.countUserLinks.QueryRowxContext(ctx, id).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *socialMediaRepository) Delete(ctx context.Context, tx Querier, id string) error {
+	query := `DELETE FROM social_medias WHERE id = $1`
+	_, err := tx.ExecContext(ctx, query, id)
 
 	if err != nil {
 		return fmt.Errorf("failed to delete socialMedia: %w", err)
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/lib/pq"
+)
+
+type UserBlockRepository interface {
+	Create(ctx context.Context, tx Querier, block *entity.UserBlock) (bool, error)
+	Delete(ctx context.Context, tx Querier, blockerId, blockedId string) (bool, error)
+	ExistsBetween(ctx context.Context, tx Querier, userId string, peerIds []string) (bool, error)
+	FindBlockedByCursor(ctx context.Context, tx Querier, blockerId string, cursor *pagination.Cursor, size int) ([]*entity.BlockedUser, *model.CursorMetadata, error)
+}
+
+type userBlockRepository struct{}
+
+func NewUserBlockRepository() UserBlockRepository {
+	return &userBlockRepository{}
+}
+
+// Create returns false when the user was already blocked
+func (r *userBlockRepository) Create(ctx context.Context, tx Querier, block *entity.UserBlock) (bool, error) {
+	query := `
+	INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`
+	result, err := tx.ExecContext(ctx, query, block.BlockerId, block.BlockedId, block.CreatedAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *userBlockRepository) Delete(ctx context.Context, tx Querier, blockerId, blockedId string) (bool, error) {
+	query := `DELETE FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2`
+	result, err := tx.ExecContext(ctx, query, blockerId, blockedId)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// ExistsBetween checks both directions, a block by either side counts
+func (r *userBlockRepository) ExistsBetween(ctx context.Context, tx Querier, userId string, peerIds []string) (bool, error) {
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM user_blocks
+		WHERE (blocker_id = $1 AND blocked_id = ANY($2)) OR (blocked_id = $1 AND blocker_id = ANY($2))
+	)
+	`
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, query, userId, pq.Array(peerIds)); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// FindBlockedByCursor lists the users blocked by the blocker from the most recently blocked
+func (r *userBlockRepository) FindBlockedByCursor(ctx context.Context, tx Querier, blockerId string, cursor *pagination.Cursor,
+	size int) ([]*entity.BlockedUser, *model.CursorMetadata, error) {
+	query := `
+	SELECT b.blocked_id AS user_id, u.username, b.created_at AS blocked_at
+	FROM user_blocks b
+	LEFT JOIN users u ON u.id = b.blocked_id
+	WHERE b.blocker_id = $1`
+	args := []interface{}{blockerId}
+	argIndex := 2
+
+	if cursor != nil {
+		sortAt, err := time.Parse(time.RFC3339Nano, cursor.SortKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		query += " AND (b.created_at, b.blocked_id) < ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, sortAt, cursor.Id)
+		argIndex += 2
+	}
+
+	query += " ORDER BY b.created_at DESC, b.blocked_id DESC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	blockedUsers := make([]*entity.BlockedUser, 0)
+	if err := tx.SelectContext(ctx, &blockedUsers, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(blockedUsers) > size
+	if hasMore {
+		blockedUsers = blockedUsers[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := blockedUsers[len(blockedUsers)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.BlockedAt.Format(time.RFC3339Nano), last.UserId)
+	}
+
+	return blockedUsers, cursorMetadata, nil
+}
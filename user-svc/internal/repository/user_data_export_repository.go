@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type UserDataExportRepository interface {
+	Create(ctx context.Context, tx Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error)
+	FindByIdAndUserId(ctx context.Context, tx Querier, id, userId string) (*entity.UserDataExport, error)
+	FindLatestByUserId(ctx context.Context, tx Querier, userId string) (*entity.UserDataExport, error)
+	ClaimNextPending(ctx context.Context, tx Querier, staleBefore time.Time) (*entity.UserDataExport, error)
+	UpdateStatus(ctx context.Context, tx Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserDataExport, error)
+}
+
+type userDataExportRepository struct{}
+
+func NewUserDataExportRepository() UserDataExportRepository {
+	return &userDataExportRepository{}
+}
+
+func (r *userDataExportRepository) Create(ctx context.Context, tx Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error) {
+	query := `
+	INSERT INTO user_data_exports
+	(id, user_id, status, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := tx.ExecContext(ctx, query, dataExport.Id, dataExport.UserId, dataExport.Status, dataExport.CreatedAt, dataExport.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataExport, nil
+}
+
+func (r *userDataExportRepository) FindByIdAndUserId(ctx context.Context, tx Querier, id, userId string) (*entity.UserDataExport, error) {
+	dataExport := new(entity.UserDataExport)
+	query := `SELECT * FROM user_data_exports WHERE id = $1 AND user_id = $2`
+	if err := tx.GetContext(ctx, dataExport, query, id, userId); err != nil {
+		return nil, err
+	}
+
+	return dataExport, nil
+}
+
+func (r *userDataExportRepository) FindLatestByUserId(ctx context.Context, tx Querier, userId string) (*entity.UserDataExport, error) {
+	dataExport := new(entity.UserDataExport)
+	query := `SELECT * FROM user_data_exports WHERE user_id = $1 ORDER BY created_at DESC LIMIT 1`
+	if err := tx.GetContext(ctx, dataExport, query, userId); err != nil {
+		return nil, err
+	}
+
+	return dataExport, nil
+}
+
+// ClaimNextPending moves the oldest pending export to processing, exports claimed by
+// another worker are skipped. An export still processing since before staleBefore was
+// left behind by a worker that stopped and is claimed again.
+func (r *userDataExportRepository) ClaimNextPending(ctx context.Context, tx Querier, staleBefore time.Time) (*entity.UserDataExport, error) {
+	dataExport := new(entity.UserDataExport)
+	query := `
+	UPDATE user_data_exports SET status = $1, updated_at = NOW()
+	WHERE id = (
+		SELECT id FROM user_data_exports
+		WHERE status = $2 OR (status = $1 AND updated_at < $3)
+		ORDER BY created_at LIMIT 1 FOR UPDATE SKIP LOCKED
+	)
+	RETURNING *
+	`
+	if err := tx.GetContext(ctx, dataExport, query, enum.DataExportStatusProcessing, enum.DataExportStatusPending, staleBefore); err != nil {
+		return nil, err
+	}
+
+	return dataExport, nil
+}
+
+func (r *userDataExportRepository) UpdateStatus(ctx context.Context, tx Querier, dataExport *entity.UserDataExport) (*entity.UserDataExport, error) {
+	query := `UPDATE user_data_exports SET status = $1, file_key = $2, failure_reason = $3, completed_at = $4, updated_at = $5
+	WHERE id = $6 RETURNING *`
+	if err := tx.GetContext(ctx, dataExport, query, dataExport.Status, dataExport.FileKey, dataExport.FailureReason,
+		dataExport.CompletedAt, dataExport.UpdatedAt, dataExport.Id); err != nil {
+		return nil, err
+	}
+
+	return dataExport, nil
+}
+
+func (r *userDataExportRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserDataExport, error) {
+	dataExports := make([]*entity.UserDataExport, 0)
+	query := `DELETE FROM user_data_exports WHERE user_id = $1 RETURNING *`
+	if err := tx.SelectContext(ctx, &dataExports, query, userId); err != nil {
+		return nil, err
+	}
+
+	return dataExports, nil
+}
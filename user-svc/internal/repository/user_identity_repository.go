@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, tx Querier, identity *entity.UserIdentity) (*entity.UserIdentity, error)
+	FindByProviderAndSubject(ctx context.Context, tx Querier, provider enum.IdentityProviderEnum, subject string) (*entity.UserIdentity, error)
+	FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserIdentity, error)
+	DeleteByUserIdAndProvider(ctx context.Context, tx Querier, userId string, provider enum.IdentityProviderEnum) (bool, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type userIdentityRepository struct{}
+
+func NewUserIdentityRepository() UserIdentityRepository {
+	return &userIdentityRepository{}
+}
+
+func (r *userIdentityRepository) Create(ctx context.Context, tx Querier, identity *entity.UserIdentity) (*entity.UserIdentity, error) {
+	query := `
+	INSERT INTO user_identities
+	(id, user_id, provider, subject, email, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := tx.ExecContext(ctx, query, identity.Id, identity.UserId, identity.Provider, identity.Subject, identity.Email,
+		identity.CreatedAt, identity.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (r *userIdentityRepository) FindByProviderAndSubject(ctx context.Context, tx Querier, provider enum.IdentityProviderEnum, subject string) (*entity.UserIdentity, error) {
+	identity := new(entity.UserIdentity)
+	query := `SELECT * FROM user_identities WHERE provider = $1 AND subject = $2`
+	if err := tx.GetContext(ctx, identity, query, provider, subject); err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (r *userIdentityRepository) FindAllByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserIdentity, error) {
+	identities := make([]*entity.UserIdentity, 0)
+	query := `SELECT * FROM user_identities WHERE user_id = $1 ORDER BY created_at`
+	if err := tx.SelectContext(ctx, &identities, query, userId); err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+func (r *userIdentityRepository) DeleteByUserIdAndProvider(ctx context.Context, tx Querier, userId string, provider enum.IdentityProviderEnum) (bool, error) {
+	query := `DELETE FROM user_identities WHERE user_id = $1 AND provider = $2`
+	result, err := tx.ExecContext(ctx, query, userId, provider)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *userIdentityRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM user_identities WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -35,6 +35,7 @@ type UserImageRepository interface {
 	FindByUserProfId(ctx context.Context, userProfId string) (*[]*entity.UserImage, error)
 	Create(ctx context.Context, tx Querier, userImage *entity.UserImage) (*entity.UserImage, error)
 	Update(ctx context.Context, tx Querier, userImage *entity.UserImage) (*entity.UserImage, error)
+	DeleteByUserProfId(ctx context.Context, tx Querier, userProfId string) ([]*entity.UserImage, error)
 }
 
 type userImageRepository struct {
@@ -110,3 +111,13 @@ func (r *userImageRepository) Update(ctx context.Context, tx Querier, userImage
 
 	return userImage, nil
 }
+
+func (r *userImageRepository) DeleteByUserProfId(ctx context.Context, tx Querier, userProfId string) ([]*entity.UserImage, error) {
+	userImages := make([]*entity.UserImage, 0)
+	query := `DELETE FROM user_images WHERE user_profile_id = $1 RETURNING *`
+	if err := tx.SelectContext(ctx, &userImages, query, userProfId); err != nil {
+		return nil, err
+	}
+
+	return userImages, nil
+}
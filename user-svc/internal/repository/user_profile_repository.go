@@ -38,6 +38,7 @@ type UserProfileRepository interface {
 	FindByUserId(ctx context.Context, userId string) (*entity.UserProfile, error)
 	UpdateSimilarity(ctx context.Context, tx Querier, similarity enum.SimilarityLevelEnum, userID string) error
 	UpdateImageURL(ctx context.Context, tx Querier, url, userProfId string, imageType enum.ImageTypeEnum) error
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
 }
 
 type userProfileRepository struct {
@@ -151,3 +152,19 @@ func (r *userProfileRepository) FindByUserId(ctx context.Context, userId string)
 
 	return userProfile, nil
 }
+
+// DeleteByUserId removes the profile together with its social links, images have to
+// be deleted beforehand through the user image repository.
+func (r *userProfileRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM user_social_links WHERE user_profile_id IN (SELECT id FROM user_profiles WHERE user_id = $1)`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+
+	query = `DELETE FROM user_profiles WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+)
+
+type UserRecoveryCodeRepository interface {
+	Create(ctx context.Context, tx Querier, recoveryCode *entity.UserRecoveryCode) (*entity.UserRecoveryCode, error)
+	CountUnusedByUserId(ctx context.Context, tx Querier, userId string) (int, error)
+	Consume(ctx context.Context, tx Querier, userId, codeHash string, usedAt time.Time) (bool, error)
+	DeleteByUserId(ctx context.Context, tx Querier, userId string) error
+}
+
+type userRecoveryCodeRepository struct{}
+
+func NewUserRecoveryCodeRepository() UserRecoveryCodeRepository {
+	return &userRecoveryCodeRepository{}
+}
+
+func (r *userRecoveryCodeRepository) Create(ctx context.Context, tx Querier, recoveryCode *entity.UserRecoveryCode) (*entity.UserRecoveryCode, error) {
+	query := `
+	INSERT INTO user_recovery_codes
+	(id, user_id, code_hash, created_at)
+	VALUES ($1, $2, $3, $4)
+	`
+	_, err := tx.ExecContext(ctx, query, recoveryCode.Id, recoveryCode.UserId, recoveryCode.CodeHash, recoveryCode.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCode, nil
+}
+
+func (r *userRecoveryCodeRepository) CountUnusedByUserId(ctx context.Context, tx Querier, userId string) (int, error) {
+	var total int
+	query := `SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL`
+	if err := tx.GetContext(ctx, &total, query, userId); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// Consume marks a recovery code as used, it returns false when the code does
+// not exist or was already used.
+func (r *userRecoveryCodeRepository) Consume(ctx context.Context, tx Querier, userId, codeHash string, usedAt time.Time) (bool, error) {
+	query := `
+	UPDATE user_recovery_codes
+	SET used_at = $1
+	WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL
+	`
+	result, err := tx.ExecContext(ctx, query, usedAt, userId, codeHash)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *userRecoveryCodeRepository) DeleteByUserId(ctx context.Context, tx Querier, userId string) error {
+	query := `DELETE FROM user_recovery_codes WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userId); err != nil {
+		return err
+	}
+
+	return nil
+}
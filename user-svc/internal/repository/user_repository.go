@@ -4,26 +4,28 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type userPreparedStmt struct {
-	findById             *sqlx.Stmt
-	findByEmail          *sqlx.Stmt
-	findByEmailNotGoogle *sqlx.Stmt
-	findDetailByEmail    *sqlx.Stmt
-	findByMultipleParam  *sqlx.Stmt
-
-	countByEmail          *sqlx.Stmt
-	countByUsername       *sqlx.Stmt
-	countByPhoneNumber    *sqlx.Stmt
-	countByEmailGoogleId  *sqlx.Stmt
-	countByEmailNotGoogle *sqlx.Stmt
+	findById            *sqlx.Stmt
+	findByEmail         *sqlx.Stmt
+	findDetailByEmail   *sqlx.Stmt
+	findByMultipleParam *sqlx.Stmt
+	findByPhoneNumber   *sqlx.Stmt
+
+	countByEmail       *sqlx.Stmt
+	countByUsername    *sqlx.Stmt
+	countByPhoneNumber *sqlx.Stmt
 }
 
 func newUserPreparedStmt(db *sqlx.DB) (*userPreparedStmt, error) {
@@ -33,11 +35,6 @@ func newUserPreparedStmt(db *sqlx.DB) (*userPreparedStmt, error) {
 		return nil, err
 	}
 
-	findByEmailNotGoogleStmt, err := db.Preparex("SELECT * FROM users WHERE email = $1 AND google_id IS NULL")
-	if err != nil {
-		return nil, err
-	}
-
 	findByEmailNotStmt, err := db.Preparex("SELECT * FROM users WHERE email = $1")
 	if err != nil {
 		return nil, err
@@ -49,7 +46,7 @@ func newUserPreparedStmt(db *sqlx.DB) (*userPreparedStmt, error) {
 	}
 
 	findByMultipleParamStmt, err := db.Preparex(`SELECT * FROM users WHERE email = $1 
-	OR username = $1 OR phone_number = $1 AND google_id IS NULL`)
+	OR username = $1 OR phone_number = $1`)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +56,6 @@ func newUserPreparedStmt(db *sqlx.DB) (*userPreparedStmt, error) {
 		return nil, err
 	}
 
-	countByEmailNotGoogleStmt, err := db.Preparex("SELECT COUNT(*) FROM users WHERE email = $1 AND google_id IS NULL")
-	if err != nil {
-		return nil, err
-	}
-
 	countByUsernameStmt, err := db.Preparex("SELECT COUNT(*) FROM users WHERE username = $1")
 	if err != nil {
 		return nil, err
@@ -74,47 +66,59 @@ func newUserPreparedStmt(db *sqlx.DB) (*userPreparedStmt, error) {
 		return nil, err
 	}
 
-	countByEmailGoogleIdStmt, err := db.Preparex("SELECT COUNT(*) FROM users WHERE email = $1 AND google_id = $2")
+	findByPhoneNumberStmt, err := db.Preparex("SELECT * FROM users WHERE phone_number = $1")
 	if err != nil {
 		return nil, err
 	}
 
 	return &userPreparedStmt{
-		findById:              findByIdStmt,
-		findByEmail:           findByEmailNotStmt,
-		findByEmailNotGoogle:  findByEmailNotGoogleStmt,
-		findDetailByEmail:     findDetailByEmail,
-		findByMultipleParam:   findByMultipleParamStmt,
-		countByEmail:          countByEmailStmt,
-		countByUsername:       countByUsernameStmt,
-		countByPhoneNumber:    countByPhoneNumberStmt,
-		countByEmailGoogleId:  countByEmailGoogleIdStmt,
-		countByEmailNotGoogle: countByEmailNotGoogleStmt,
+		findById:            findByIdStmt,
+		findByEmail:         findByEmailNotStmt,
+		findDetailByEmail:   findDetailByEmail,
+		findByMultipleParam: findByMultipleParamStmt,
+		findByPhoneNumber:   findByPhoneNumberStmt,
+		countByEmail:        countByEmailStmt,
+		countByUsername:     countByUsernameStmt,
+		countByPhoneNumber:  countByPhoneNumberStmt,
 	}, nil
 }
 
 type UserRepository interface {
 	CreateByPhoneNumber(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
-	CreateByGoogleSignIn(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	CreateByIdentity(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
 	CreateByEmail(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
 
 	CountByEmail(ctx context.Context, email string) (int, error)
 	CountByUsername(ctx context.Context, email string) (int, error)
 	CountByPhoneNumber(ctx context.Context, email string) (int, error)
-	CountByEmailGoogleId(ctx context.Context, email, googleId string) (int, error)
-	CountByEmailNotGoogle(ctx context.Context, email string) (int, error)
 
 	FindById(ctx context.Context, userId string) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	FindDetailByEmail(ctx context.Context, email string) (*entity.UserDetail, error)
-	FindByEmailNotGoogle(ctx context.Context, email string) (*entity.User, error)
 	FindByMultipleParam(ctx context.Context, multipleParam string) (*entity.User, error)
+	FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error)
+	FindManyWithVerifiedEmail(ctx context.Context, tx Querier, userIds []string) ([]*entity.User, error)
 	FindAllPublicChat(ctx context.Context, tx Querier, page, size int, username string) ([]*entity.UserPublicChat, *model.PageMetadata, error)
+	FindAllPublicChatByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int, username string) ([]*entity.UserPublicChat, *model.CursorMetadata, error)
+	FindIdsBySegment(ctx context.Context, tx Querier, segment enum.AudienceSegmentEnum, inactiveSince time.Time, afterUserId string,
+		limit int) ([]string, error)
 
 	UpdateEmailVerifiedAt(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	UpdatePhoneNumberVerifiedAt(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
 	UpdatePassword(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	UpdateUsername(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	UpdateEmail(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	UpdatePhoneNumber(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
 
 	UpdateHasFacecam(ctx context.Context, tx Querier, userId string, hasFacecam bool) error
+	UpdateSuspension(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	UpdateTwoFactor(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	AddRole(ctx context.Context, tx Querier, userId, role string) (*entity.User, error)
+	RemoveRole(ctx context.Context, tx Querier, userId, role string) (*entity.User, error)
+
+	UpdateDeletionSchedule(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
+	FindNextDueDeletion(ctx context.Context, tx Querier, now time.Time) (*entity.User, error)
+	Anonymize(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error)
 }
 
 type userRepository struct {
@@ -137,7 +141,7 @@ func (r *userRepository) Close() error {
 		return err
 	}
 
-	if err := r.userPreparedStmt.findByEmailNotGoogle.Close(); err != nil {
+	if err := r.userPreparedStmt.findByEmail.Close(); err != nil {
 		return err
 	}
 
@@ -169,12 +173,12 @@ func (r *userRepository) CreateByPhoneNumber(ctx context.Context, tx Querier, us
 	return user, nil
 }
 
-func (r *userRepository) CreateByGoogleSignIn(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+func (r *userRepository) CreateByIdentity(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
 	query := `INSERT INTO users 
-	(id, email, username, google_id, created_at, updated_at) 
+	(id, email, email_verified_at, username, created_at, updated_at) 
 	VALUES ($1, $2, $3, $4, $5, $6)`
 
-	_, err := tx.ExecContext(ctx, query, user.Id, user.Email, user.Username, user.GoogleId, user.CreatedAt, user.UpdatedAt)
+	_, err := tx.ExecContext(ctx, query, user.Id, user.Email, user.EmailVerifiedAt, user.Username, user.CreatedAt, user.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -231,29 +235,6 @@ func (r *userRepository) CountByPhoneNumber(ctx context.Context, phoneNumber str
 	return total, nil
 }
 
-func (r *userRepository) CountByEmailGoogleId(ctx context.Context, email, googleId string) (int, error) {
-	var total int
-
-	row := r.userPreparedStmt.countByEmailGoogleId.QueryRowxContext(ctx, email, googleId)
-	if err := row.Scan(&total); err != nil {
-
-		return 0, err
-	}
-
-	return total, nil
-}
-
-func (r *userRepository) CountByEmailNotGoogle(ctx context.Context, email string) (int, error) {
-	var total int
-
-	row := r.userPreparedStmt.countByEmailNotGoogle.QueryRowxContext(ctx, email)
-	if err := row.Scan(&total); err != nil {
-		return 0, err
-	}
-
-	return total, nil
-}
-
 func (r *userRepository) FindById(ctx context.Context, userId string) (*entity.User, error) {
 	user := new(entity.User)
 
@@ -277,29 +258,70 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity
 	return user, nil
 }
 
-func (r *userRepository) FindByEmailNotGoogle(ctx context.Context, email string) (*entity.User, error) {
+func (r *userRepository) FindByMultipleParam(ctx context.Context, multipleParam string) (*entity.User, error) {
 	user := new(entity.User)
 
-	row := r.userPreparedStmt.findByEmailNotGoogle.QueryRowxContext(ctx, email)
+	row := r.userPreparedStmt.findByMultipleParam.QueryRowxContext(ctx, multipleParam)
 	if err := row.StructScan(user); err != nil {
+
 		return nil, err
 	}
 
 	return user, nil
 }
 
-func (r *userRepository) FindByMultipleParam(ctx context.Context, multipleParam string) (*entity.User, error) {
+func (r *userRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
 	user := new(entity.User)
 
-	row := r.userPreparedStmt.findByMultipleParam.QueryRowxContext(ctx, multipleParam)
+	row := r.userPreparedStmt.findByPhoneNumber.QueryRowxContext(ctx, phoneNumber)
 	if err := row.StructScan(user); err != nil {
-
 		return nil, err
 	}
 
 	return user, nil
 }
 
+func (r *userRepository) FindManyWithVerifiedEmail(ctx context.Context, tx Querier, userIds []string) ([]*entity.User, error) {
+	users := make([]*entity.User, 0, len(userIds))
+	query := `SELECT * FROM users WHERE id = ANY($1) AND email IS NOT NULL AND email_verified_at IS NOT NULL 
+	AND deleted_at IS NULL`
+	if err := tx.SelectContext(ctx, &users, query, pq.Array(userIds)); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// FindIdsBySegment lists the ids of the active users in a segment ordered by id, starting after afterUserId. Deleted
+// and suspended users are never part of a segment. inactiveSince is only used by the INACTIVE segment.
+func (r *userRepository) FindIdsBySegment(ctx context.Context, tx Querier, segment enum.AudienceSegmentEnum, inactiveSince time.Time,
+	afterUserId string, limit int) ([]string, error) {
+	query := `
+	SELECT id FROM users
+	WHERE id > $1 AND deleted_at IS NULL AND suspended_at IS NULL`
+
+	args := []interface{}{afterUserId, limit}
+	switch segment {
+	case enum.AudienceSegmentFacecam:
+		query += ` AND has_facecam`
+	case enum.AudienceSegmentInactive:
+		query += ` AND created_at < $3 AND NOT EXISTS (
+			SELECT 1 FROM user_sessions s WHERE s.user_id = users.id AND s.last_seen_at >= $3
+		)`
+		args = append(args, inactiveSince)
+	}
+	query += `
+	ORDER BY id
+	LIMIT $2`
+
+	userIds := make([]string, 0, limit)
+	if err := tx.SelectContext(ctx, &userIds, query, args...); err != nil {
+		return nil, err
+	}
+
+	return userIds, nil
+}
+
 func (r *userRepository) FindDetailByEmail(ctx context.Context, email string) (*entity.UserDetail, error) {
 	userDetail := new(entity.UserDetail)
 
@@ -374,6 +396,52 @@ func (r *userRepository) FindAllPublicChat(ctx context.Context, tx Querier, page
 	return results, pageMetadata, nil
 }
 
+// FindAllPublicChatByCursor is the keyset paginated version of FindAllPublicChat, users are sorted by username and id
+func (r *userRepository) FindAllPublicChatByCursor(ctx context.Context, tx Querier, cursor *pagination.Cursor, size int,
+	username string) ([]*entity.UserPublicChat, *model.CursorMetadata, error) {
+	query := `SELECT users.id as user_id, username, ui.file_key from users 
+	JOIN public.user_profiles  up on users.id = up.user_id 
+	JOIN public.user_images ui on up.id = ui.user_profile_id 
+	WHERE image_type = 'PROFILE' `
+
+	var args []interface{}
+	argIndex := 1
+
+	if username != "" {
+		query += " AND username LIKE $" + strconv.Itoa(argIndex)
+		args = append(args, "%"+username+"%")
+		argIndex++
+	}
+
+	if cursor != nil {
+		query += " AND (username, users.id) > ($" + strconv.Itoa(argIndex) + ", $" + strconv.Itoa(argIndex+1) + ")"
+		args = append(args, cursor.SortKey, cursor.Id)
+		argIndex += 2
+	}
+
+	// One extra row is fetched to know whether there is a next page without counting
+	query += " ORDER BY username ASC, users.id ASC LIMIT $" + strconv.Itoa(argIndex)
+	args = append(args, size+1)
+
+	results := make([]*entity.UserPublicChat, 0)
+	if err := tx.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	hasMore := len(results) > size
+	if hasMore {
+		results = results[:size]
+	}
+
+	cursorMetadata := helper.NewCursorMetadata(size, hasMore)
+	if hasMore {
+		last := results[len(results)-1]
+		cursorMetadata.NextCursor = pagination.EncodeCursor(last.Username, last.UserId)
+	}
+
+	return results, cursorMetadata, nil
+}
+
 func (r *userRepository) UpdateEmailVerifiedAt(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
 	query := `UPDATE users set email_verified_at = $1, updated_at = $2 WHERE email = $3 RETURNING *`
 	if err := tx.GetContext(ctx, user, query, user.EmailVerifiedAt, user.UpdatedAt, user.Email); err != nil {
@@ -383,6 +451,15 @@ func (r *userRepository) UpdateEmailVerifiedAt(ctx context.Context, tx Querier,
 	return user, nil
 }
 
+func (r *userRepository) UpdatePhoneNumberVerifiedAt(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set phone_number_verified_at = $1, updated_at = $2 WHERE phone_number = $3 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.PhoneNumberVerifiedAt, user.UpdatedAt, user.PhoneNumber); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (r *userRepository) UpdatePassword(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
 	query := `UPDATE users set password = $1, updated_at = $2 WHERE email = $3`
 
@@ -394,6 +471,33 @@ func (r *userRepository) UpdatePassword(ctx context.Context, tx Querier, user *e
 	return user, nil
 }
 
+func (r *userRepository) UpdateUsername(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set username = $1, updated_at = $2 WHERE id = $3 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.Username, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) UpdateEmail(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set email = $1, email_verified_at = $2, updated_at = $3 WHERE id = $4 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.Email, user.EmailVerifiedAt, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) UpdatePhoneNumber(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set phone_number = $1, phone_number_verified_at = $2, updated_at = $3 WHERE id = $4 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.PhoneNumber, user.PhoneNumberVerifiedAt, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (r *userRepository) UpdateHasFacecam(ctx context.Context, tx Querier, userId string, hasFacecam bool) error {
 	query := `UPDATE users set has_facecam = $1, updated_at = NOW() WHERE id = $2`
 
@@ -404,3 +508,78 @@ func (r *userRepository) UpdateHasFacecam(ctx context.Context, tx Querier, userI
 
 	return nil
 }
+
+func (r *userRepository) UpdateSuspension(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set suspended_at = $1, suspension_reason = $2, updated_at = $3 WHERE id = $4 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.SuspendedAt, user.SuspensionReason, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) UpdateTwoFactor(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set totp_secret = $1, two_factor_enabled_at = $2, updated_at = $3 WHERE id = $4 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.TotpSecret, user.TwoFactorEnabledAt, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) AddRole(ctx context.Context, tx Querier, userId, role string) (*entity.User, error) {
+	user := new(entity.User)
+	query := `UPDATE users set roles = CASE WHEN $1 = ANY(roles) THEN roles ELSE array_append(roles, $1) END, updated_at = NOW() 
+	WHERE id = $2 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, role, userId); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) RemoveRole(ctx context.Context, tx Querier, userId, role string) (*entity.User, error) {
+	user := new(entity.User)
+	query := `UPDATE users set roles = array_remove(roles, $1), updated_at = NOW() WHERE id = $2 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, role, userId); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) UpdateDeletionSchedule(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set deletion_requested_at = $1, deletion_scheduled_at = $2, updated_at = $3 
+	WHERE id = $4 AND deleted_at IS NULL RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.DeletionRequestedAt, user.DeletionScheduledAt, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// FindNextDueDeletion locks the next user whose grace period is over, rows locked by
+// another worker are skipped so replicas can process deletions concurrently.
+func (r *userRepository) FindNextDueDeletion(ctx context.Context, tx Querier, now time.Time) (*entity.User, error) {
+	user := new(entity.User)
+	query := `SELECT * FROM users WHERE deletion_scheduled_at <= $1 AND deleted_at IS NULL 
+	ORDER BY deletion_scheduled_at LIMIT 1 FOR UPDATE SKIP LOCKED`
+	if err := tx.GetContext(ctx, user, query, now); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Anonymize keeps the row as a tombstone so ids referenced by other services stay
+// valid, every column identifying the person is cleared.
+func (r *userRepository) Anonymize(ctx context.Context, tx Querier, user *entity.User) (*entity.User, error) {
+	query := `UPDATE users set username = $1, email = NULL, email_verified_at = NULL, password = NULL, phone_number = NULL, 
+	phone_number_verified_at = NULL, has_facecam = false, totp_secret = NULL, two_factor_enabled_at = NULL, 
+	suspension_reason = NULL, deleted_at = $2, updated_at = $3 WHERE id = $4 RETURNING *`
+	if err := tx.GetContext(ctx, user, query, user.Username, user.DeletedAt, user.UpdatedAt, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+)
+
+type UserSessionRepository interface {
+	Create(ctx context.Context, tx Querier, session *entity.UserSession) (*entity.UserSession, error)
+	FindById(ctx context.Context, tx Querier, sessionId string) (*entity.UserSession, error)
+	FindAllActiveByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserSession, error)
+	Rotate(ctx context.Context, tx Querier, session *entity.UserSession, previousTokenId string) (*entity.UserSession, error)
+	Revoke(ctx context.Context, tx Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) (*entity.UserSession, error)
+	RevokeAllByUserId(ctx context.Context, tx Querier, userId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error)
+	RevokeAllByUserIdExcept(ctx context.Context, tx Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error)
+}
+
+type userSessionRepository struct{}
+
+func NewUserSessionRepository() UserSessionRepository {
+	return &userSessionRepository{}
+}
+
+func (r *userSessionRepository) Create(ctx context.Context, tx Querier, session *entity.UserSession) (*entity.UserSession, error) {
+	query := `
+	INSERT INTO user_sessions
+	(id, user_id, refresh_token_id, device, platform, ip_address, user_agent, last_seen_at, expires_at, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	_, err := tx.ExecContext(ctx, query, session.Id, session.UserId, session.RefreshTokenId, session.Device, session.Platform,
+		session.IpAddress, session.UserAgent, session.LastSeenAt, session.ExpiresAt, session.CreatedAt, session.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *userSessionRepository) FindById(ctx context.Context, tx Querier, sessionId string) (*entity.UserSession, error) {
+	session := new(entity.UserSession)
+	query := `SELECT * FROM user_sessions WHERE id = $1`
+	if err := tx.GetContext(ctx, session, query, sessionId); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *userSessionRepository) FindAllActiveByUserId(ctx context.Context, tx Querier, userId string) ([]*entity.UserSession, error) {
+	sessions := make([]*entity.UserSession, 0)
+	query := `
+	SELECT * FROM user_sessions
+	WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+	ORDER BY last_seen_at DESC
+	`
+	if err := tx.SelectContext(ctx, &sessions, query, userId); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Rotate swaps the current refresh token id only when the caller presented the
+// latest one. sql.ErrNoRows means the session is revoked, expired or the
+// presented token was already rotated away.
+func (r *userSessionRepository) Rotate(ctx context.Context, tx Querier, session *entity.UserSession, previousTokenId string) (*entity.UserSession, error) {
+	query := `
+	UPDATE user_sessions
+	SET refresh_token_id = $1, ip_address = COALESCE($2, ip_address), user_agent = COALESCE($3, user_agent),
+	last_seen_at = $4, expires_at = $5, updated_at = $6
+	WHERE id = $7 AND refresh_token_id = $8 AND revoked_at IS NULL AND expires_at > NOW()
+	RETURNING *
+	`
+	rotated := new(entity.UserSession)
+	if err := tx.GetContext(ctx, rotated, query, session.RefreshTokenId, session.IpAddress, session.UserAgent,
+		session.LastSeenAt, session.ExpiresAt, session.UpdatedAt, session.Id, previousTokenId); err != nil {
+		return nil, err
+	}
+
+	return rotated, nil
+}
+
+func (r *userSessionRepository) Revoke(ctx context.Context, tx Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) (*entity.UserSession, error) {
+	query := `
+	UPDATE user_sessions
+	SET revoked_at = $1, revoked_reason = $2, updated_at = $1
+	WHERE id = $3 AND user_id = $4 AND revoked_at IS NULL
+	RETURNING *
+	`
+	session := new(entity.UserSession)
+	if err := tx.GetContext(ctx, session, query, revokedAt, reason, sessionId, userId); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *userSessionRepository) RevokeAllByUserId(ctx context.Context, tx Querier, userId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error) {
+	sessions := make([]*entity.UserSession, 0)
+	query := `
+	UPDATE user_sessions
+	SET revoked_at = $1, revoked_reason = $2, updated_at = $1
+	WHERE user_id = $3 AND revoked_at IS NULL
+	RETURNING *
+	`
+	if err := tx.SelectContext(ctx, &sessions, query, revokedAt, reason, userId); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// RevokeAllByUserIdExcept signs the user out everywhere but the session making the request
+func (r *userSessionRepository) RevokeAllByUserIdExcept(ctx context.Context, tx Querier, userId, sessionId string, reason enum.SessionRevokeReasonEnum, revokedAt time.Time) ([]*entity.UserSession, error) {
+	sessions := make([]*entity.UserSession, 0)
+	query := `
+	UPDATE user_sessions
+	SET revoked_at = $1, revoked_reason = $2, updated_at = $1
+	WHERE user_id = $3 AND id <> $4 AND revoked_at IS NULL
+	RETURNING *
+	`
+	if err := tx.SelectContext(ctx, &sessions, query, revokedAt, reason, userId, sessionId); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+)
+
+type UserSocialLinkRepository interface {
+	Upsert(ctx context.Context, tx Querier, link *entity.UserSocialLink) (*entity.UserSocialLink, error)
+	FindAllActiveByUserProfileId(ctx context.Context, tx Querier, userProfileId string) ([]*entity.UserSocialLinkDetail, error)
+	Delete(ctx context.Context, tx Querier, userProfileId, socialMediaId string) (bool, error)
+	DeleteByUserProfileId(ctx context.Context, tx Querier, userProfileId string) error
+}
+
+type userSocialLinkRepository struct{}
+
+func NewUserSocialLinkRepository() UserSocialLinkRepository {
+	return &userSocialLinkRepository{}
+}
+
+// Upsert keeps a single link per social media, setting it again replaces the handle
+func (r *userSocialLinkRepository) Upsert(ctx context.Context, tx Querier, link *entity.UserSocialLink) (*entity.UserSocialLink, error) {
+	query := `
+	INSERT INTO user_social_links
+	(user_profile_id, social_media_id, handle, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_profile_id, social_media_id)
+	DO UPDATE SET handle = EXCLUDED.handle, updated_at = EXCLUDED.updated_at
+	RETURNING *
+	`
+	if err := tx.GetContext(ctx, link, query, link.UserProfileId, link.SocialMediaId, link.Handle, link.CreatedAt,
+		link.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// FindAllActiveByUserProfileId hides links of social medias an admin has deactivated
+func (r *userSocialLinkRepository) FindAllActiveByUserProfileId(ctx context.Context, tx Querier, userProfileId string) ([]*entity.UserSocialLinkDetail, error) {
+	links := make([]*entity.UserSocialLinkDetail, 0)
+	query := `
+	SELECT usl.social_media_id, sm.name, sm.base_url, sm.logo_url, usl.handle, usl.created_at, usl.updated_at
+	FROM user_social_links usl
+	JOIN social_medias sm ON sm.id = usl.social_media_id
+	WHERE usl.user_profile_id = $1 AND sm.is_active = TRUE
+	ORDER BY sm.name
+	`
+	if err := tx.SelectContext(ctx, &links, query, userProfileId); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (r *userSocialLinkRepository) Delete(ctx context.Context, tx Querier, userProfileId, socialMediaId string) (bool, error) {
+	query := `DELETE FROM user_social_links WHERE user_profile_id = $1 AND social_media_id = $2`
+	result, err := tx.ExecContext(ctx, query, userProfileId, socialMediaId)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *userSocialLinkRepository) DeleteByUserProfileId(ctx context.Context, tx Querier, userProfileId string) error {
+	query := `DELETE FROM user_social_links WHERE user_profile_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userProfileId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,501 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/gateway/producer"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+
+	"github.com/bytedance/sonic"
+	"github.com/oklog/ulid/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	accountDeletionGracePeriod = 30 * 24 * time.Hour
+	accountWorkerBatchSize     = 20
+	dataExportCooldown         = 24 * time.Hour
+	dataExportLinkExpiry       = 72 * time.Hour
+	dataExportClaimTimeout     = 30 * time.Minute
+)
+
+type AccountUseCase interface {
+	RequestDeletion(ctx context.Context, request *model.RequestAccountDeletionRequest) (*model.AccountDeletionResponse, error)
+	CancelDeletion(ctx context.Context, userId string) (*model.AccountDeletionResponse, error)
+	GetDeletionStatus(ctx context.Context, userId string) (*model.AccountDeletionResponse, error)
+	RequestDataExport(ctx context.Context, userId string) (*model.DataExportResponse, error)
+	GetDataExport(ctx context.Context, request *model.GetDataExportRequest) (*model.DataExportResponse, error)
+	ProcessDueDeletions(ctx context.Context) error
+	ProcessPendingDataExports(ctx context.Context) error
+}
+
+type accountUseCase struct {
+	db                    repository.BeginTx
+	userRepository        repository.UserRepository
+	userProfileRepository repository.UserProfileRepository
+	userImageRepository   repository.UserImageRepository
+	userSocialLinkRepo    repository.UserSocialLinkRepository
+	userSessionRepository repository.UserSessionRepository
+	recoveryCodeRepo      repository.UserRecoveryCodeRepository
+	userIdentityRepo      repository.UserIdentityRepository
+	emailVerificationRepo repository.EmailVerificationRepository
+	resetPasswordRepo     repository.ResetPasswordRepository
+	userDataExportRepo    repository.UserDataExportRepository
+	uploadAdapter         adapter.UploadAdapter
+	emailAdapter          adapter.EmailAdapter
+	notificationAdapter   adapter.NotificationAdapter
+	photoAdapter          adapter.PhotoAdapter
+	transactionAdapter    adapter.TransactionAdapter
+	cacheAdapter          adapter.CacheAdapter
+	userProducer          producer.UserProducer
+	logs                  logger.Log
+}
+
+func NewAccountUseCase(db repository.BeginTx, userRepository repository.UserRepository, userProfileRepository repository.UserProfileRepository,
+	userImageRepository repository.UserImageRepository, userSocialLinkRepo repository.UserSocialLinkRepository, userSessionRepository repository.UserSessionRepository, recoveryCodeRepo repository.UserRecoveryCodeRepository,
+	userIdentityRepo repository.UserIdentityRepository, emailVerificationRepo repository.EmailVerificationRepository, resetPasswordRepo repository.ResetPasswordRepository,
+	userDataExportRepo repository.UserDataExportRepository, uploadAdapter adapter.UploadAdapter, emailAdapter adapter.EmailAdapter,
+	notificationAdapter adapter.NotificationAdapter, photoAdapter adapter.PhotoAdapter, transactionAdapter adapter.TransactionAdapter,
+	cacheAdapter adapter.CacheAdapter, userProducer producer.UserProducer, logs logger.Log) AccountUseCase {
+	return &accountUseCase{
+		db:                    db,
+		userRepository:        userRepository,
+		userProfileRepository: userProfileRepository,
+		userImageRepository:   userImageRepository,
+		userSocialLinkRepo:    userSocialLinkRepo,
+		userSessionRepository: userSessionRepository,
+		recoveryCodeRepo:      recoveryCodeRepo,
+		userIdentityRepo:      userIdentityRepo,
+		emailVerificationRepo: emailVerificationRepo,
+		resetPasswordRepo:     resetPasswordRepo,
+		userDataExportRepo:    userDataExportRepo,
+		uploadAdapter:         uploadAdapter,
+		emailAdapter:          emailAdapter,
+		notificationAdapter:   notificationAdapter,
+		photoAdapter:          photoAdapter,
+		transactionAdapter:    transactionAdapter,
+		cacheAdapter:          cacheAdapter,
+		userProducer:          userProducer,
+		logs:                  logs,
+	}
+}
+
+func (u *accountUseCase) findUser(ctx context.Context, userId string) (*entity.User, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrUserNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	return user, nil
+}
+
+// RequestDeletion schedules the account for erasure after the grace period, the user
+// keeps access until then and can cancel it through CancelDeletion.
+func (u *accountUseCase) RequestDeletion(ctx context.Context, request *model.RequestAccountDeletionRequest) (*model.AccountDeletionResponse, error) {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasPendingDeletion() {
+		return converter.UserToAccountDeletionResponse(user), nil
+	}
+
+	if user.Password.Valid {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password.String), []byte(request.Password)); err != nil {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid password")
+		}
+	}
+
+	now := time.Now()
+	scheduledAt := now.Add(accountDeletionGracePeriod)
+	user.DeletionRequestedAt = &now
+	user.DeletionScheduledAt = &scheduledAt
+	user.UpdatedAt = &now
+
+	user, err = u.userRepository.UpdateDeletionSchedule(ctx, u.db, user)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to schedule account deletion", err)
+	}
+
+	return converter.UserToAccountDeletionResponse(user), nil
+}
+
+func (u *accountUseCase) CancelDeletion(ctx context.Context, userId string) (*model.AccountDeletionResponse, error) {
+	user, err := u.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasPendingDeletion() {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Account deletion has not been requested")
+	}
+
+	now := time.Now()
+	user.DeletionRequestedAt = nil
+	user.DeletionScheduledAt = nil
+	user.UpdatedAt = &now
+
+	user, err = u.userRepository.UpdateDeletionSchedule(ctx, u.db, user)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to cancel account deletion", err)
+	}
+
+	return converter.UserToAccountDeletionResponse(user), nil
+}
+
+func (u *accountUseCase) GetDeletionStatus(ctx context.Context, userId string) (*model.AccountDeletionResponse, error) {
+	user, err := u.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.UserToAccountDeletionResponse(user), nil
+}
+
+// ProcessDueDeletions erases accounts whose grace period is over, it is called
+// periodically by the account worker.
+func (u *accountUseCase) ProcessDueDeletions(ctx context.Context) error {
+	for range accountWorkerBatchSize {
+		deleted, err := u.deleteNextDueAccount(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !deleted {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (u *accountUseCase) deleteNextDueAccount(ctx context.Context) (bool, error) {
+	now := time.Now()
+	var (
+		user            *entity.User
+		userImages      []*entity.UserImage
+		dataExports     []*entity.UserDataExport
+		revokedSessions []*entity.UserSession
+	)
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		var err error
+		user, err = u.userRepository.FindNextDueDeletion(ctx, tx, now)
+		if err != nil {
+			return err
+		}
+
+		userProfile, err := u.userProfileRepository.FindByUserId(ctx, user.Id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("find user profile : %w", err)
+		}
+
+		if userProfile != nil {
+			userImages, err = u.userImageRepository.DeleteByUserProfId(ctx, tx, userProfile.Id)
+			if err != nil {
+				return fmt.Errorf("delete user images : %w", err)
+			}
+
+			if err := u.userSocialLinkRepo.DeleteByUserProfileId(ctx, tx, userProfile.Id); err != nil {
+				return fmt.Errorf("delete user social links : %w", err)
+			}
+		}
+
+		if err := u.userProfileRepository.DeleteByUserId(ctx, tx, user.Id); err != nil {
+			return fmt.Errorf("delete user profile : %w", err)
+		}
+
+		if err := u.recoveryCodeRepo.DeleteByUserId(ctx, tx, user.Id); err != nil {
+			return fmt.Errorf("delete recovery codes : %w", err)
+		}
+
+		if err := u.userIdentityRepo.DeleteByUserId(ctx, tx, user.Id); err != nil {
+			return fmt.Errorf("delete user identities : %w", err)
+		}
+
+		dataExports, err = u.userDataExportRepo.DeleteByUserId(ctx, tx, user.Id)
+		if err != nil {
+			return fmt.Errorf("delete data exports : %w", err)
+		}
+
+		revokedSessions, err = u.userSessionRepository.RevokeAllByUserId(ctx, tx, user.Id, enum.SessionRevokeReasonAccountDelete, now)
+		if err != nil {
+			return fmt.Errorf("revoke user sessions : %w", err)
+		}
+
+		// Both tables reference users(email) so they go before the email is cleared
+		if user.HasEmail() {
+			if err := u.emailVerificationRepo.DeleteByEmail(ctx, tx, user.Email.String); err != nil {
+				return fmt.Errorf("delete email verifications : %w", err)
+			}
+
+			if err := u.resetPasswordRepo.DeleteByEmail(ctx, tx, user.Email.String); err != nil {
+				return fmt.Errorf("delete reset passwords : %w", err)
+			}
+		}
+
+		user.Username = "deleted_" + strings.ToLower(user.Id)
+		user.DeletedAt = &now
+		user.UpdatedAt = &now
+		user, err = u.userRepository.Anonymize(ctx, tx, user)
+		if err != nil {
+			return fmt.Errorf("anonymize user : %w", err)
+		}
+
+		// Published before commit, if it fails nothing is erased and the account is
+		// picked up again on the next run. Consumers are idempotent.
+		userDeletedEvent := &event.UserDeletedEvent{
+			Id:          user.Id,
+			PseudonymId: ulid.Make().String(),
+			DeletedAt:   &now,
+		}
+
+		if err := u.userProducer.ProduceUserDeleted(ctx, userDeletedEvent); err != nil {
+			return fmt.Errorf("produce user deleted : %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, helper.WrapInternalServerError(u.logs, "failed to delete account", err)
+	}
+
+	if err := markSessionsRevoked(ctx, u.cacheAdapter, revokedSessions); err != nil {
+		u.logs.CustomError("failed to mark revoked sessions in cache", err)
+	}
+
+	if err := u.cacheAdapter.Del(ctx, user.Id, fmt.Sprintf("fcm_tokens:%s", user.Id)); err != nil {
+		u.logs.CustomError("failed to delete user cache", err)
+	}
+
+	for _, userImage := range userImages {
+		if _, err := u.uploadAdapter.DeletePublicFile(ctx, userImage.FileKey); err != nil {
+			u.logs.CustomError("failed to delete user image file", err)
+		}
+	}
+
+	for _, dataExport := range dataExports {
+		if !dataExport.FileKey.Valid {
+			continue
+		}
+
+		if _, err := u.uploadAdapter.DeleteFile(ctx, dataExport.FileKey.String); err != nil {
+			u.logs.CustomError("failed to delete data export file", err)
+		}
+	}
+
+	u.logs.CustomLog("account deleted", fmt.Sprintf("user_id=%s", user.Id))
+	return true, nil
+}
+
+func (u *accountUseCase) RequestDataExport(ctx context.Context, userId string) (*model.DataExportResponse, error) {
+	latest, err := u.userDataExportRepo.FindLatestByUserId(ctx, u.db, userId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find latest data export", err)
+	}
+
+	if latest != nil {
+		if latest.IsActive() {
+			return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "A data export is already in progress")
+		}
+
+		if latest.Status == enum.DataExportStatusCompleted && time.Since(*latest.CreatedAt) < dataExportCooldown {
+			return nil, helper.NewUseCaseError(errorcode.ErrTooManyRequests, "A data export can only be requested once every 24 hours")
+		}
+	}
+
+	now := time.Now()
+	dataExport := &entity.UserDataExport{
+		Id:        ulid.Make().String(),
+		UserId:    userId,
+		Status:    enum.DataExportStatusPending,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	dataExport, err = u.userDataExportRepo.Create(ctx, u.db, dataExport)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to create data export", err)
+	}
+
+	return converter.DataExportToResponse(dataExport, ""), nil
+}
+
+// GetDataExport returns a fresh download link once the archive is ready, this is the
+// only way to get it for accounts without an email address.
+func (u *accountUseCase) GetDataExport(ctx context.Context, request *model.GetDataExportRequest) (*model.DataExportResponse, error) {
+	dataExport, err := u.userDataExportRepo.FindByIdAndUserId(ctx, u.db, request.ExportId, request.UserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Data export not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find data export", err)
+	}
+
+	downloadUrl := ""
+	if dataExport.Status == enum.DataExportStatusCompleted && dataExport.FileKey.Valid {
+		downloadUrl, err = u.uploadAdapter.GetPresignedUrlWithExpiry(ctx, dataExport.FileKey.String, dataExportLinkExpiry)
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to get data export download url", err)
+		}
+	}
+
+	return converter.DataExportToResponse(dataExport, downloadUrl), nil
+}
+
+// ProcessPendingDataExports builds the archives of pending exports, it is called
+// periodically by the account worker. An export a stopped worker left processing for
+// longer than dataExportClaimTimeout is built again, otherwise its user could never
+// request another export.
+func (u *accountUseCase) ProcessPendingDataExports(ctx context.Context) error {
+	for range accountWorkerBatchSize {
+		dataExport, err := u.userDataExportRepo.ClaimNextPending(ctx, u.db, time.Now().Add(-dataExportClaimTimeout))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return helper.WrapInternalServerError(u.logs, "failed to claim pending data export", err)
+		}
+
+		now := time.Now()
+		dataExport.UpdatedAt = &now
+		if err := u.buildDataExport(ctx, dataExport); err != nil {
+			u.logs.CustomError("failed to build data export", err)
+			dataExport.Status = enum.DataExportStatusFailed
+			dataExport.FailureReason = nullable.ToSQLStringOmitEmpty("Failed to build the archive, please request a new export")
+		}
+
+		if _, err := u.userDataExportRepo.UpdateStatus(ctx, u.db, dataExport); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update data export status", err)
+		}
+	}
+
+	return nil
+}
+
+func (u *accountUseCase) buildDataExport(ctx context.Context, dataExport *entity.UserDataExport) error {
+	user, err := u.userRepository.FindById(ctx, dataExport.UserId)
+	if err != nil {
+		return fmt.Errorf("find user : %w", err)
+	}
+
+	files := map[string]any{
+		"account.json": converter.UserToArchiveAccount(user),
+	}
+
+	userProfile, err := u.userProfileRepository.FindByUserId(ctx, user.Id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("find user profile : %w", err)
+	}
+
+	if userProfile != nil {
+		files["profile.json"] = converter.UserProfileToResponse(userProfile)
+
+		userImages, err := u.userImageRepository.FindByUserProfId(ctx, userProfile.Id)
+		if err != nil {
+			return fmt.Errorf("find user images : %w", err)
+		}
+		files["images.json"] = converter.UserImagesToArchiveImages(*userImages)
+	}
+
+	identities, err := u.userIdentityRepo.FindAllByUserId(ctx, u.db, user.Id)
+	if err != nil {
+		return fmt.Errorf("find user identities : %w", err)
+	}
+	files["identities.json"] = converter.UserIdentitiesToResponses(identities)
+
+	sessions, err := u.userSessionRepository.FindAllActiveByUserId(ctx, u.db, user.Id)
+	if err != nil {
+		return fmt.Errorf("find user sessions : %w", err)
+	}
+	files["sessions.json"] = converter.SessionsToResponses(sessions, "")
+
+	userDevices, err := u.notificationAdapter.GetUserDevices(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("find user devices : %w", err)
+	}
+	files["devices.json"] = converter.UserDevicesToArchiveDevices(userDevices)
+
+	// Purchases, facecams, transactions and reviews live in the other services
+	purchases, facecams, err := u.photoAdapter.GetUserDataExport(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("get photo data : %w", err)
+	}
+	files["purchases.json"] = purchases
+	files["facecams.json"] = facecams
+
+	transactions, reviews, err := u.transactionAdapter.GetUserDataExport(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("get transaction data : %w", err)
+	}
+	files["transactions.json"] = transactions
+	files["reviews.json"] = reviews
+
+	archive := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(archive)
+	for name, content := range files {
+		jsonValue, err := sonic.ConfigStd.MarshalIndent(content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal %s : %w", name, err)
+		}
+
+		fileWriter, err := zipWriter.Create(name)
+		if err != nil {
+			return fmt.Errorf("create %s in archive : %w", name, err)
+		}
+
+		if _, err := fileWriter.Write(jsonValue); err != nil {
+			return fmt.Errorf("write %s in archive : %w", name, err)
+		}
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("close archive : %w", err)
+	}
+
+	fileKey := fmt.Sprintf("user/export/%s/%s.zip", user.Id, dataExport.Id)
+	if err := u.uploadAdapter.PutObject(ctx, fileKey, archive, int64(archive.Len()), "application/zip"); err != nil {
+		return fmt.Errorf("upload archive : %w", err)
+	}
+
+	now := time.Now()
+	dataExport.Status = enum.DataExportStatusCompleted
+	dataExport.FileKey = nullable.ToSQLStringOmitEmpty(fileKey)
+	dataExport.CompletedAt = &now
+
+	if user.HasEmail() {
+		downloadUrl, err := u.uploadAdapter.GetPresignedUrlWithExpiry(ctx, fileKey, dataExportLinkExpiry)
+		if err != nil {
+			return fmt.Errorf("get archive download url : %w", err)
+		}
+
+		if err := u.emailAdapter.SendEmail(user.Email.String, downloadUrl, "data export"); err != nil {
+			u.logs.CustomError("failed to send data export email", err)
+		}
+	}
+
+	return nil
+}
@@ -1,23 +1,408 @@
 package usecase
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+
+	"github.com/oklog/ulid/v2"
+)
 
 type AdminUseCase interface {
-	CreateSocialMedia(ctx context.Context)
-	GetSocialMedia(ctx context.Context)
-	GetAllSocialMedia(ctx context.Context)
-	UpdateSocialMedia(ctx context.Context)
-	DeleteSocialMedia(ctx context.Context)
+	CreateSocialMedia(ctx context.Context, request *model.CreateSocialMediaRequest) (*model.SocialMediaResponse, error)
+	GetSocialMedia(ctx context.Context, socialMediaId string) (*model.SocialMediaResponse, error)
+	GetAllSocialMedia(ctx context.Context) (*[]*model.SocialMediaResponse, error)
+	UpdateSocialMedia(ctx context.Context, request *model.UpdateSocialMediaRequest) (*model.SocialMediaResponse, error)
+	DeleteSocialMedia(ctx context.Context, socialMediaId string) error
+
+	SuspendUser(ctx context.Context, request *model.SuspendUserRequest) (*model.AdminUserResponse, error)
+	UnsuspendUser(ctx context.Context, request *model.UnsuspendUserRequest) (*model.AdminUserResponse, error)
+	GrantUserRole(ctx context.Context, request *model.UserRoleRequest) (*model.AdminUserResponse, error)
+	RevokeUserRole(ctx context.Context, request *model.UserRoleRequest) (*model.AdminUserResponse, error)
+
+	GetChatReports(ctx context.Context, request *model.GetChatReportsRequest) ([]*model.ChatReportResponse, *model.CursorMetadata, error)
+	GetChatReport(ctx context.Context, reportId string) (*model.ChatReportResponse, error)
+	ReviewChatReport(ctx context.Context, request *model.ReviewChatReportRequest) (*model.ChatReportResponse, error)
+}
+
+type adminUseCase struct {
+	db                    repository.BeginTx
+	userRepository        repository.UserRepository
+	socialMediaRepository repository.SocialMediaRepository
+	chatReportRepository  repository.ChatReportRepository
+	chatMessageRepository repository.ChatMessageRepository
+	cacheAdapter          adapter.CacheAdapter
+	logs                  logger.Log
+}
+
+func NewAdminUseCase(db repository.BeginTx, userRepository repository.UserRepository, socialMediaRepository repository.SocialMediaRepository,
+	chatReportRepository repository.ChatReportRepository, chatMessageRepository repository.ChatMessageRepository,
+	cacheAdapter adapter.CacheAdapter, logs logger.Log) AdminUseCase {
+	return &adminUseCase{
+		db:                    db,
+		userRepository:        userRepository,
+		socialMediaRepository: socialMediaRepository,
+		chatReportRepository:  chatReportRepository,
+		chatMessageRepository: chatMessageRepository,
+		cacheAdapter:          cacheAdapter,
+		logs:                  logs,
+	}
+}
+
+func (u *adminUseCase) CreateSocialMedia(ctx context.Context, request *model.CreateSocialMediaRequest) (*model.SocialMediaResponse, error) {
+	total, err := u.socialMediaRepository.CountByName(ctx, request.Name)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count social media by name", err)
+	}
+
+	if total > 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Social media name has already been taken")
+	}
+
+	isActive := true
+	if request.IsActive != nil {
+		isActive = *request.IsActive
+	}
+
+	now := time.Now()
+	socialMedia := &entity.SocialMedia{
+		Id:          ulid.Make().String(),
+		Name:        request.Name,
+		BaseUrl:     nullable.ToSQLString(request.BaseUrl),
+		LogoUrl:     nullable.ToSQLString(request.LogoUrl),
+		Description: nullable.ToSQLString(request.Description),
+		IsActive:    isActive,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.socialMediaRepository.Insert(ctx, tx, socialMedia); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to insert social media", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return converter.SocialMediaToResponse(socialMedia), nil
+}
+
+func (u *adminUseCase) GetSocialMedia(ctx context.Context, socialMediaId string) (*model.SocialMediaResponse, error) {
+	socialMedia, err := u.socialMediaRepository.FindById(ctx, socialMediaId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Social media not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find social media by id", err)
+	}
+
+	return converter.SocialMediaToResponse(socialMedia), nil
+}
+
+func (u *adminUseCase) GetAllSocialMedia(ctx context.Context) (*[]*model.SocialMediaResponse, error) {
+	socialMedias, err := u.socialMediaRepository.FindAll(ctx)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find all social media", err)
+	}
+
+	return converter.SocialMediasToResponses(socialMedias), nil
 }
 
-type adminUseCase struct{}
+func (u *adminUseCase) UpdateSocialMedia(ctx context.Context, request *model.UpdateSocialMediaRequest) (*model.SocialMediaResponse, error) {
+	socialMedia, err := u.socialMediaRepository.FindById(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Social media not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find social media by id", err)
+	}
+
+	if socialMedia.Name != request.Name {
+		total, err := u.socialMediaRepository.CountByName(ctx, request.Name)
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to count social media by name", err)
+		}
+
+		if total > 0 {
+			return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Social media name has already been taken")
+		}
+	}
+
+	now := time.Now()
+	socialMedia.Name = request.Name
+	socialMedia.BaseUrl = nullable.ToSQLString(request.BaseUrl)
+	socialMedia.LogoUrl = nullable.ToSQLString(request.LogoUrl)
+	socialMedia.Description = nullable.ToSQLString(request.Description)
+	socialMedia.IsActive = request.IsActive
+	socialMedia.UpdatedAt = &now
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.socialMediaRepository.Update(ctx, tx, socialMedia); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update social media", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return converter.SocialMediaToResponse(socialMedia), nil
+}
+
+// DeleteSocialMedia only removes entries nobody links to yet. Once users have
+// linked a platform it has to be deactivated instead, so their profiles keep
+// rendering.
+func (u *adminUseCase) DeleteSocialMedia(ctx context.Context, socialMediaId string) error {
+	if _, err := u.socialMediaRepository.FindById(ctx, socialMediaId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Social media not found")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find social media by id", err)
+	}
+
+	totalLinks, err := u.socialMediaRepository.CountUserLinks(ctx, socialMediaId)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to count social media user links", err)
+	}
+
+	if totalLinks > 0 {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Social media is still linked by users, deactivate it instead")
+	}
+
+	return repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if err := u.socialMediaRepository.Delete(ctx, tx, socialMediaId); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete social media", err)
+		}
+		return nil
+	})
+}
+
+func (u *adminUseCase) SuspendUser(ctx context.Context, request *model.SuspendUserRequest) (*model.AdminUserResponse, error) {
+	if request.AdminId == request.UserId {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Admin can not suspend their own account")
+	}
+
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsSuspended() {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User has already been suspended")
+	}
+
+	now := time.Now()
+	user.SuspendedAt = &now
+	user.SuspensionReason = nullable.ToSQLString(&request.Reason)
+	user.UpdatedAt = &now
+
+	return u.updateSuspension(ctx, user)
+}
+
+func (u *adminUseCase) UnsuspendUser(ctx context.Context, request *model.UnsuspendUserRequest) (*model.AdminUserResponse, error) {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsSuspended() {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User is not suspended")
+	}
+
+	now := time.Now()
+	user.SuspendedAt = nil
+	user.SuspensionReason = sql.NullString{}
+	user.UpdatedAt = &now
 
-func NewAdminUseCase() AdminUseCase {
-	return &adminUseCase{}
+	return u.updateSuspension(ctx, user)
 }
 
-func (u *adminUseCase) CreateSocialMedia(ctx context.Context) {}
-func (u *adminUseCase) GetSocialMedia(ctx context.Context)    {}
-func (u *adminUseCase) GetAllSocialMedia(ctx context.Context) {}
-func (u *adminUseCase) UpdateSocialMedia(ctx context.Context) {}
-func (u *adminUseCase) DeleteSocialMedia(ctx context.Context) {}
+func (u *adminUseCase) updateSuspension(ctx context.Context, user *entity.User) (*model.AdminUserResponse, error) {
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.userRepository.UpdateSuspension(ctx, tx, user); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update user suspension", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.invalidateAuthCache(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	return converter.UserToAdminResponse(user), nil
+}
+
+func (u *adminUseCase) GrantUserRole(ctx context.Context, request *model.UserRoleRequest) (*model.AdminUserResponse, error) {
+	if !enum.IsValidRole(request.Role) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid role")
+	}
+
+	if _, err := u.findUser(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	var user *entity.User
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		var err error
+		user, err = u.userRepository.AddRole(ctx, tx, request.UserId, request.Role)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to add user role", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.invalidateAuthCache(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	return converter.UserToAdminResponse(user), nil
+}
+
+func (u *adminUseCase) RevokeUserRole(ctx context.Context, request *model.UserRoleRequest) (*model.AdminUserResponse, error) {
+	if !enum.IsValidRole(request.Role) || request.Role == string(enum.RoleUser) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid role")
+	}
+
+	if request.AdminId == request.UserId && request.Role == string(enum.RoleAdmin) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Admin can not revoke their own admin role")
+	}
+
+	if _, err := u.findUser(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	var user *entity.User
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		var err error
+		user, err = u.userRepository.RemoveRole(ctx, tx, request.UserId, request.Role)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to remove user role", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.invalidateAuthCache(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	return converter.UserToAdminResponse(user), nil
+}
+
+// GetChatReports lists the moderation queue from the oldest report
+func (u *adminUseCase) GetChatReports(ctx context.Context, request *model.GetChatReportsRequest) ([]*model.ChatReportResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	if request.Status == "" {
+		request.Status = enum.ChatReportStatusPending
+	}
+
+	reports, cursorMetadata, err := u.chatReportRepository.FindByCursor(ctx, u.db, request.Status, cursor, request.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find chat reports", err)
+	}
+
+	return converter.ChatReportsToResponses(reports), cursorMetadata, nil
+}
+
+// GetChatReport includes the reported message so the moderator does not have to open the conversation
+func (u *adminUseCase) GetChatReport(ctx context.Context, reportId string) (*model.ChatReportResponse, error) {
+	report, err := u.findChatReport(ctx, reportId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := converter.ChatReportToResponse(report)
+	if report.MessageId.Valid {
+		message, err := u.chatMessageRepository.FindById(ctx, u.db, report.MessageId.String)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to find chat message by id", err)
+		}
+
+		if message != nil {
+			response.ReportedMessage = converter.ChatMessageToResponse(message)
+		}
+	}
+
+	return response, nil
+}
+
+// ReviewChatReport closes a pending report, acting on the reported user is done through the suspension endpoints
+func (u *adminUseCase) ReviewChatReport(ctx context.Context, request *model.ReviewChatReportRequest) (*model.ChatReportResponse, error) {
+	report, err := u.findChatReport(ctx, request.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report.Status = request.Status
+	report.ReviewerId = sql.NullString{String: request.ReviewerId, Valid: true}
+	report.ReviewNote = nullable.ToSQLStringOmitEmpty(request.Note)
+	report.ReviewedAt = &now
+	report.UpdatedAt = &now
+
+	reviewed, err := u.chatReportRepository.Review(ctx, u.db, report)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to review chat report", err)
+	}
+
+	if !reviewed {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Chat report has already been reviewed")
+	}
+
+	return converter.ChatReportToResponse(report), nil
+}
+
+func (u *adminUseCase) findChatReport(ctx context.Context, reportId string) (*entity.ChatReport, error) {
+	report, err := u.chatReportRepository.FindById(ctx, u.db, reportId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat report not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find chat report by id", err)
+	}
+
+	return report, nil
+}
+
+func (u *adminUseCase) findUser(ctx context.Context, userId string) (*entity.User, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrUserNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	return user, nil
+}
+
+// invalidateAuthCache drops the cached auth body so the next Verify call
+// reloads roles and suspension state from the database.
+func (u *adminUseCase) invalidateAuthCache(ctx context.Context, userId string) error {
+	if err := u.cacheAdapter.Del(ctx, userId); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete cached auth", err)
+	}
+	return nil
+}
@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	"crypto/hmac"
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +19,7 @@ import (
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/gateway/producer"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/event"
@@ -23,18 +28,19 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/oklog/ulid/v2"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthUseCase interface {
-	AccessTokenRequest(ctx context.Context, refreshToken string) (*model.UserResponse, *model.TokenResponse, error)
+	AccessTokenRequest(ctx context.Context, request *model.AccessTokenRequest) (*model.UserResponse, *model.TokenResponse, error)
 	CreateDeviceToken(ctx context.Context, request *model.DeviceRequest) error
 	Current(ctx context.Context, email string) (*model.UserResponse, error)
 	Login(ctx context.Context, request *model.LoginUserRequest) (*model.UserResponse, *model.TokenResponse, error)
 	Logout(ctx context.Context, request *model.LogoutUserRequest) (bool, error)
 	RegisterByEmail(ctx context.Context, request *model.RegisterByEmailRequest) (*model.UserResponse, error)
-	RegisterOrLoginByGoogle(ctx context.Context, request *model.RegisterByGoogleRequest) (*model.UserResponse, *model.TokenResponse, error)
+	RegisterOrLoginByProvider(ctx context.Context, request *model.RegisterByProviderRequest) (*model.UserResponse, *model.TokenResponse, error)
 	RegisterByPhoneNumber(ctx context.Context, request *model.RegisterByPhoneRequest) (*model.UserResponse, error)
 	RequestResetPassword(ctx context.Context, email string) error
 	ResendEmailVerification(ctx context.Context, email string) error
@@ -42,21 +48,41 @@ type AuthUseCase interface {
 	ValidateResetPassword(ctx context.Context, request *model.ValidateResetTokenRequest) (bool, error)
 	Verify(ctx context.Context, request *model.VerifyUserRequest) (*model.AuthResponse, error)
 	VerifyEmail(ctx context.Context, request *model.VerifyEmailUserRequest) error
+	RequestPhoneOTP(ctx context.Context, request *model.RequestPhoneOTPRequest) (*model.OTPResponse, error)
+	VerifyPhoneNumber(ctx context.Context, request *model.VerifyPhoneOTPRequest) error
+	LoginByOTP(ctx context.Context, request *model.LoginByOTPRequest) (*model.UserResponse, *model.TokenResponse, error)
+	LoginByTwoFactor(ctx context.Context, request *model.LoginByTwoFactorRequest) (*model.UserResponse, *model.TokenResponse, error)
+	ListSessions(ctx context.Context, userId, currentSessionId string) ([]*model.SessionResponse, error)
+	RevokeSession(ctx context.Context, request *model.RevokeSessionRequest) error
+	RevokeAllSessions(ctx context.Context, userId string) error
+	ChangeUsername(ctx context.Context, request *model.ChangeUsernameRequest) (*model.UserResponse, error)
+	RequestReauthOTP(ctx context.Context, userId string) (*model.OTPResponse, error)
+	RequestEmailChange(ctx context.Context, request *model.RequestEmailChangeRequest) (*model.OTPResponse, error)
+	ConfirmEmailChange(ctx context.Context, request *model.ConfirmContactChangeRequest) (*model.UserResponse, error)
+	RequestPhoneChange(ctx context.Context, request *model.RequestPhoneChangeRequest) (*model.OTPResponse, error)
+	ConfirmPhoneChange(ctx context.Context, request *model.ConfirmContactChangeRequest) (*model.UserResponse, error)
 }
 
+const revokedSessionKeyPrefix = "session:revoked:"
+
 type authUseCase struct {
 	db                    repository.BeginTx
 	userRepository        repository.UserRepository
 	userProfileRepository repository.UserProfileRepository
 	emailVerificationRepo repository.EmailVerificationRepository
 	resetPasswordRepo     repository.ResetPasswordRepository
-	userDeviceRepository  repository.UserDeviceRepository
-	googleTokenAdapter    adapter.GoogleTokenAdapter
+	userSessionRepository repository.UserSessionRepository
+	recoveryCodeRepo      repository.UserRecoveryCodeRepository
+	userIdentityRepo      repository.UserIdentityRepository
+	identityAdapter       adapter.IdentityProviderAdapter
 	emailAdapter          adapter.EmailAdapter
 	securityAdapter       adapter.SecurityAdapter
 	jwtAdapter            adapter.JWTAdapter
 	cacheAdapter          adapter.CacheAdapter
+	rateLimiterAdapter    adapter.RateLimiterAdapter
 	realtimeChatAdapter   adapter.RealtimeChatAdapter
+	smsAdapter            adapter.SmsAdapter
+	totpAdapter           adapter.TOTPAdapter
 	// photoAdapter          adapter.PhotoAdapter
 	// transactionAdapter    adapter.TransactionAdapter
 	userProducer producer.UserProducer
@@ -66,9 +92,11 @@ type authUseCase struct {
 
 func NewAuthUseCase(db repository.BeginTx, userRepository repository.UserRepository, userProfileRepository repository.UserProfileRepository,
 	emailVerificationRepo repository.EmailVerificationRepository, resetPasswordRepo repository.ResetPasswordRepository,
-	userDeviceRepository repository.UserDeviceRepository, googleTokenAdapter adapter.GoogleTokenAdapter,
+	userSessionRepository repository.UserSessionRepository, recoveryCodeRepo repository.UserRecoveryCodeRepository,
+	userIdentityRepo repository.UserIdentityRepository,
+	identityAdapter adapter.IdentityProviderAdapter,
 	emailAdapter adapter.EmailAdapter, jwtAdapter adapter.JWTAdapter, securityAdapter adapter.SecurityAdapter,
-	cacheAdapter adapter.CacheAdapter, realtimeChatAdapter adapter.RealtimeChatAdapter,
+	cacheAdapter adapter.CacheAdapter, rateLimiterAdapter adapter.RateLimiterAdapter, realtimeChatAdapter adapter.RealtimeChatAdapter, smsAdapter adapter.SmsAdapter, totpAdapter adapter.TOTPAdapter,
 	// photoAdapter adapter.PhotoAdapter, transactionAdapter adapter.TransactionAdapter,
 	userProducer producer.UserProducer, logs logger.Log) AuthUseCase {
 	return &authUseCase{
@@ -77,13 +105,18 @@ func NewAuthUseCase(db repository.BeginTx, userRepository repository.UserReposit
 		userProfileRepository: userProfileRepository,
 		emailVerificationRepo: emailVerificationRepo,
 		resetPasswordRepo:     resetPasswordRepo,
-		userDeviceRepository:  userDeviceRepository,
-		googleTokenAdapter:    googleTokenAdapter,
+		userSessionRepository: userSessionRepository,
+		recoveryCodeRepo:      recoveryCodeRepo,
+		userIdentityRepo:      userIdentityRepo,
+		identityAdapter:       identityAdapter,
 		emailAdapter:          emailAdapter,
 		securityAdapter:       securityAdapter,
 		jwtAdapter:            jwtAdapter,
 		cacheAdapter:          cacheAdapter,
+		rateLimiterAdapter:    rateLimiterAdapter,
 		realtimeChatAdapter:   realtimeChatAdapter,
+		smsAdapter:            smsAdapter,
+		totpAdapter:           totpAdapter,
 		// photoAdapter:          photoAdapter,
 		// transactionAdapter:    transactionAdapter,
 		userProducer: userProducer,
@@ -92,17 +125,14 @@ func NewAuthUseCase(db repository.BeginTx, userRepository repository.UserReposit
 }
 
 // WHAT TO DO WHATS APP BUSINESS VERIF OTP
+// RegisterByPhoneNumber answers the same way whether or not the phone number is registered so
+// it cannot be used to enumerate accounts, the owner finds out when asking for a verification code.
 func (u *authUseCase) RegisterByPhoneNumber(ctx context.Context, request *model.RegisterByPhoneRequest) (*model.UserResponse, error) {
-	countByNumberTotal, err := u.userRepository.CountByPhoneNumber(ctx, request.PhoneNumber)
-	if err != nil {
-		return nil, helper.WrapInternalServerError(u.logs, "failed to send count user by phone number", err)
-	}
-
-	//TODO query check cukup sekali saja untuk checking username dan phonenumber
-	if countByNumberTotal > 0 {
-		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Phone number has already been taken")
+	if err := u.limitIdentifierRequest(ctx, "register_phone", request.PhoneNumber, registerIdentifierLimit); err != nil {
+		return nil, err
 	}
 
+	// Usernames are shown on public profiles, telling that one is taken reveals no account
 	countByUsernameTotal, err := u.userRepository.CountByUsername(ctx, request.Username)
 	if err != nil {
 		return nil, helper.WrapInternalServerError(u.logs, "failed to send count user by username", err)
@@ -130,6 +160,15 @@ func (u *authUseCase) RegisterByPhoneNumber(ctx context.Context, request *model.
 		UpdatedAt: &now,
 	}
 
+	countByNumberTotal, err := u.userRepository.CountByPhoneNumber(ctx, request.PhoneNumber)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to send count user by phone number", err)
+	}
+
+	if countByNumberTotal > 0 {
+		return converter.UserToResponse(user), nil
+	}
+
 	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
 		user, err = u.userRepository.CreateByPhoneNumber(ctx, tx, user)
 		if err != nil {
@@ -157,84 +196,113 @@ func (u *authUseCase) RegisterByPhoneNumber(ctx context.Context, request *model.
 	return converter.UserToResponse(user), nil
 }
 
-// TODO EFICIENT QUERY ISSUE (COUNT COUNT AND COUNT)
-func (u *authUseCase) RegisterOrLoginByGoogle(ctx context.Context, request *model.RegisterByGoogleRequest) (*model.UserResponse, *model.TokenResponse, error) {
+// RegisterOrLoginByProvider signs in the user linked to the provider identity, or creates
+// an account for it. An existing account with the same email is never linked implicitly,
+// the owner has to sign in and link the provider from their account.
+func (u *authUseCase) RegisterOrLoginByProvider(ctx context.Context, request *model.RegisterByProviderRequest) (*model.UserResponse, *model.TokenResponse, error) {
 	if request.Platform != enum.PlatformTypeWeb &&
 		request.Platform != enum.PlatformTypeIOS &&
 		request.Platform != enum.PlatformTypeAndroid {
 		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid Platform Type")
 	}
 
-	claims, err := u.googleTokenAdapter.ValidateGoogleToken(ctx, request.Token)
-	if err != nil {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, err.Error())
+	if !u.identityAdapter.IsSupported(request.Provider) {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Unsupported identity provider")
 	}
 
-	countByNotGoogleTotal, err := u.userRepository.CountByEmailNotGoogle(ctx, claims.Email)
+	claims, err := u.identityAdapter.VerifyIdToken(ctx, request.Provider, request.Token)
 	if err != nil {
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to count by email not google", err)
-	}
-
-	if countByNotGoogleTotal > 0 {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Email has already been taken")
+		u.logs.CustomError("failed to verify identity token", err)
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid identity token")
 	}
 
-	countByGoogleTotal, err := u.userRepository.CountByEmailGoogleId(ctx, claims.Email, claims.GoogleId)
-	if err != nil {
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to count by email google id", err)
+	if err := u.limitIdentifierRequest(ctx, "register_"+strings.ToLower(string(claims.Provider)), claims.Subject, registerIdentifierLimit); err != nil {
+		return nil, nil, err
 	}
 
 	var user *entity.User
 	var userProfile *entity.UserProfile
 
+	identity, err := u.userIdentityRepo.FindByProviderAndSubject(ctx, u.db, claims.Provider, claims.Subject)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user identity", err)
+	}
+
 	// If user already registered
-	if countByGoogleTotal > 0 {
-		user, err = u.userRepository.FindByEmail(ctx, claims.Email)
+	if identity != nil {
+		user, err = u.userRepository.FindById(ctx, identity.UserId)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email")
-			}
-			return nil, nil, helper.WrapInternalServerError(u.logs, "failed find user by email not google", err)
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user by identity", err)
+		}
+
+		if user.IsSuspended() {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
 		}
 
 		userProfRes, err := u.getUserProfile(ctx, user.Id)
 		if err != nil {
-			return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email")
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid identity")
 		}
 
 		userProfile = userProfRes
 	} else {
+		if claims.Email != "" {
+			countByEmailTotal, err := u.userRepository.CountByEmail(ctx, claims.Email)
+			if err != nil {
+				return nil, nil, helper.WrapInternalServerError(u.logs, "failed to count user by email", err)
+			}
+
+			if countByEmailTotal > 0 {
+				return nil, nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists,
+					"Email has already been taken, sign in and link this provider from your account instead")
+			}
+		}
+
+		username, err := u.generateIdentityUsername(ctx, claims)
+		if err != nil {
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate username", err)
+		}
+
 		if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
 			now := time.Now()
 			user = &entity.User{
-				Id: ulid.Make().String(),
-				Email: sql.NullString{
-					Valid:  true,
-					String: claims.Email,
-				},
-				Username: claims.Username,
-				GoogleId: sql.NullString{
-					Valid:  true,
-					String: claims.GoogleId,
-				},
+				Id:        ulid.Make().String(),
+				Email:     nullable.ToSQLStringOmitEmpty(claims.Email),
+				Username:  username,
+				Roles:     pq.StringArray{string(enum.RoleUser)},
 				CreatedAt: &now,
 				UpdatedAt: &now,
 			}
 
-			user, err = u.userRepository.CreateByGoogleSignIn(ctx, tx, user)
+			if claims.Email != "" && claims.EmailVerified {
+				user.EmailVerifiedAt = &now
+			}
+
+			user, err = u.userRepository.CreateByIdentity(ctx, tx, user)
 			if err != nil {
-				return helper.WrapInternalServerError(u.logs, "failed to create user by google sign in", err)
+				return helper.WrapInternalServerError(u.logs, "failed to create user by identity", err)
+			}
+
+			identity = &entity.UserIdentity{
+				Id:        ulid.Make().String(),
+				UserId:    user.Id,
+				Provider:  claims.Provider,
+				Subject:   claims.Subject,
+				Email:     nullable.ToSQLStringOmitEmpty(claims.Email),
+				CreatedAt: &now,
+				UpdatedAt: &now,
+			}
+
+			if _, err := u.userIdentityRepo.Create(ctx, tx, identity); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to create user identity", err)
 			}
 
 			userProfile = &entity.UserProfile{
 				Id:     ulid.Make().String(),
 				UserId: user.Id,
 				// BirthDate: request.BirthDate,
-				Nickname: helper.GenerateNickname(),
-				ProfileUrl: sql.NullString{
-					Valid:  true,
-					String: claims.ProfilePictureUrl,
-				},
+				Nickname:   helper.GenerateNickname(),
+				ProfileUrl: nullable.ToSQLStringOmitEmpty(claims.PictureUrl),
 				Similarity: uint(enum.DefaultSimilarityLevel),
 				CreatedAt:  &now,
 				UpdatedAt:  &now,
@@ -276,15 +344,26 @@ func (u *authUseCase) RegisterOrLoginByGoogle(ctx context.Context, request *mode
 	}
 
 	auth := &entity.Auth{
-		Id:            user.Id,
-		Username:      user.Username,
-		Email:         user.Email.String,
-		PhoneNumber:   user.PhoneNumber.String,
-		UserProfileID: userProfile.Id,
-		Similarity:    userProfile.Similarity,
+		Id:               user.Id,
+		Username:         user.Username,
+		Email:            user.Email.String,
+		PhoneNumber:      user.PhoneNumber.String,
+		UserProfileID:    userProfile.Id,
+		Similarity:       userProfile.Similarity,
+		Roles:            user.Roles,
+		TwoFactorEnabled: user.HasTwoFactor(),
+	}
+
+	session := newUserSession(request.SessionMetadata, request.Platform)
+	if user.HasTwoFactor() {
+		token, err := u.issueTwoFactorChallenge(ctx, user.Id, session)
+		if err != nil {
+			return nil, nil, err
+		}
+		return converter.UserToResponse(user), token, nil
 	}
 
-	token, err := u.generateToken(ctx, auth)
+	token, err := u.generateToken(ctx, auth, session)
 	if err != nil {
 		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate token :", err)
 	}
@@ -292,6 +371,51 @@ func (u *authUseCase) RegisterOrLoginByGoogle(ctx context.Context, request *mode
 	return converter.UserToResponse(user), token, nil
 }
 
+// generateIdentityUsername derives a username from the provider profile, falling back to a
+// random suffix when it is taken since provider names are not unique.
+func (u *authUseCase) generateIdentityUsername(ctx context.Context, claims *model.IdentityClaim) (string, error) {
+	base := claims.Name
+	if base == "" && claims.Email != "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	base = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		case r == ' ' || r == '-':
+			return '_'
+		default:
+			return -1
+		}
+	}, base)
+	if len(base) > identityUsernameMaxLength {
+		base = base[:identityUsernameMaxLength]
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	username := base
+	for range identityUsernameAttempts {
+		countByUsernameTotal, err := u.userRepository.CountByUsername(ctx, username)
+		if err != nil {
+			return "", err
+		}
+
+		if countByUsernameTotal == 0 {
+			return username, nil
+		}
+
+		id := ulid.Make().String()
+		username = base + "_" + strings.ToLower(id[len(id)-6:])
+	}
+
+	return "", fmt.Errorf("no available username for %s", base)
+}
+
 func (u *authUseCase) getUserProfile(ctx context.Context, userId string) (*entity.UserProfile, error) {
 	profile, err := u.userProfileRepository.FindByUserId(ctx, userId)
 	if err != nil {
@@ -305,16 +429,14 @@ func (u *authUseCase) getUserProfile(ctx context.Context, userId string) (*entit
 	return profile, nil
 }
 
+// RegisterByEmail answers the same way whether or not the email is registered so it cannot
+// be used to enumerate accounts, the owner of a registered email is told about the attempt.
 func (u *authUseCase) RegisterByEmail(ctx context.Context, request *model.RegisterByEmailRequest) (*model.UserResponse, error) {
-	countByNumberTotal, err := u.userRepository.CountByEmail(ctx, request.Email)
-	if err != nil {
-		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by email", err)
-	}
-
-	if countByNumberTotal > 0 {
-		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Email has already been taken")
+	if err := u.limitIdentifierRequest(ctx, "register_email", request.Email, emailRequestIdentifierLimit); err != nil {
+		return nil, err
 	}
 
+	// Usernames are shown on public profiles, telling that one is taken reveals no account
 	countByUsernameTotal, err := u.userRepository.CountByUsername(ctx, request.Username)
 	if err != nil {
 		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by username", err)
@@ -324,29 +446,40 @@ func (u *authUseCase) RegisterByEmail(ctx context.Context, request *model.Regist
 		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Username has already been taken")
 	}
 
-	user := new(entity.User)
-	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
-		if err != nil {
-			return helper.WrapInternalServerError(u.logs, "failed to generate hashed bcrypt", err)
-		}
-
-		now := time.Now()
-		user = &entity.User{
-			Id:       ulid.Make().String(),
-			Username: request.Username,
-			Email: sql.NullString{
-				Valid:  true,
-				String: request.Email,
-			},
-			Password: sql.NullString{
-				Valid:  true,
-				String: string(hashedPassword),
-			},
-			CreatedAt: &now,
-			UpdatedAt: &now,
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to generate hashed bcrypt", err)
+	}
+
+	now := time.Now()
+	user := &entity.User{
+		Id:       ulid.Make().String(),
+		Username: request.Username,
+		Email: sql.NullString{
+			Valid:  true,
+			String: request.Email,
+		},
+		Password: sql.NullString{
+			Valid:  true,
+			String: string(hashedPassword),
+		},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	countByEmailTotal, err := u.userRepository.CountByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by email", err)
+	}
+
+	if countByEmailTotal > 0 {
+		if err := u.emailAdapter.SendEmail(request.Email, "", "email already registered"); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to send email already registered notice", err)
 		}
+		return converter.UserToResponse(user), nil
+	}
 
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
 		user, err = u.userRepository.CreateByEmail(ctx, tx, user)
 		if err != nil {
 			return helper.WrapInternalServerError(u.logs, "failed to create user by email verification :", err)
@@ -417,23 +550,29 @@ func (u *authUseCase) RequestEmailVerification(ctx context.Context, email string
 	return nil
 }
 
+// ResendEmailVerification answers the same way whether or not the email is registered
+// or already verified so it cannot be used to enumerate accounts.
 func (u *authUseCase) ResendEmailVerification(ctx context.Context, email string) error {
-	user, err := u.userRepository.FindByEmailNotGoogle(ctx, email)
+	if err := u.limitIdentifierRequest(ctx, "resend_email", email, emailRequestIdentifierLimit); err != nil {
+		return err
+	}
+
+	user, err := u.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email or password")
+			return nil
 		}
 		return helper.WrapInternalServerError(u.logs, "failed to find user by email :", err)
 	}
 
 	if user.HasVerifiedEmail() {
-		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "User already verified")
+		return nil
 	}
 
 	_, err = u.emailVerificationRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "The selected email is invalid.")
+			return nil
 		}
 		return helper.WrapInternalServerError(u.logs, "failed to find email verification by email", err)
 	}
@@ -513,218 +652,1125 @@ func (u *authUseCase) VerifyEmail(ctx context.Context, request *model.VerifyEmai
 	return nil
 }
 
-func (u *authUseCase) RequestResetPassword(ctx context.Context, email string) error {
-	_, err := u.userRepository.FindByEmailNotGoogle(ctx, email)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email")
-		}
-		return helper.WrapInternalServerError(u.logs, "failed to find email", err)
-	}
+const (
+	otpTTL             = 5 * time.Minute
+	otpResendCooldown  = 60 * time.Second
+	otpMaxAttempts     = 5
+	otpMaxSendPerHour  = 5
+	otpSendLimitWindow = time.Hour
+)
 
-	countByEmailTotal, err := u.resetPasswordRepo.CountByEmail(ctx, email)
-	if err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed count reset password by email", err)
-	}
+// OTP keys are scoped by purpose. The identifier is the phone number the code was
+// sent to, except for contact changes where it is the user id.
+func otpKey(purpose enum.OTPPurposeEnum, identifier string) string {
+	return fmt.Sprintf("otp:%s:%s", purpose, identifier)
+}
 
-	token := uuid.NewString()
-	now := time.Now()
-	resetPassword := &entity.ResetPassword{
-		Email:     email,
-		Token:     token,
-		CreatedAt: &now,
-		UpdatedAt: &now,
-	}
+func otpAttemptKey(purpose enum.OTPPurposeEnum, identifier string) string {
+	return fmt.Sprintf("otp_attempt:%s:%s", purpose, identifier)
+}
 
-	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
-		if countByEmailTotal > 0 {
-			_, err := u.resetPasswordRepo.Update(ctx, tx, resetPassword)
-			if err != nil {
-				return helper.WrapInternalServerError(u.logs, "failed to update reset password", err)
-			}
-		} else {
-			_, err := u.resetPasswordRepo.Insert(ctx, tx, resetPassword)
-			if err != nil {
-				return helper.WrapInternalServerError(u.logs, "failed to insert reset password", err)
-			}
-		}
-		return nil
-	}); err != nil {
-		return err
-	}
+func otpCooldownKey(purpose enum.OTPPurposeEnum, identifier string) string {
+	return fmt.Sprintf("otp_cooldown:%s:%s", purpose, identifier)
+}
 
-	encryptedToken, err := u.securityAdapter.Encrypt(token)
-	if err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed to encrypt reset password token", err)
+func otpSendCountKey(phoneNumber string) string {
+	return "otp_send_count:" + phoneNumber
+}
+
+// RequestPhoneOTP answers the same way whether or not the number belongs to an eligible
+// account so the endpoint can not be used to enumerate phone numbers, the code is only sent
+// to eligible ones
+func (u *authUseCase) RequestPhoneOTP(ctx context.Context, request *model.RequestPhoneOTPRequest) (*model.OTPResponse, error) {
+	if request.Purpose != enum.OTPPurposeVerifyPhone && request.Purpose != enum.OTPPurposeLogin {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid OTP purpose")
 	}
 
-	if err := u.emailAdapter.SendEmail(email, encryptedToken, "reset password"); err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed to send reset password email", err)
+	if err := u.countOTPSend(ctx, request.PhoneNumber); err != nil {
+		return nil, err
 	}
 
-	return nil
-}
+	if err := u.acquireOTPCooldown(ctx, request.Purpose, request.PhoneNumber); err != nil {
+		return nil, err
+	}
 
-func (u *authUseCase) ValidateResetPassword(ctx context.Context, request *model.ValidateResetTokenRequest) (bool, error) {
-	decryptedToken, err := u.securityAdapter.Decrypt(request.Token)
-	if err != nil {
-		return false, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid reset password token")
+	response := &model.OTPResponse{
+		ExpiresIn: int(otpTTL.Seconds()),
+		ResendIn:  int(otpResendCooldown.Seconds()),
 	}
 
-	_, err = u.resetPasswordRepo.FindByEmailAndToken(ctx, request.Email, decryptedToken)
+	user, err := u.userRepository.FindByPhoneNumber(ctx, request.PhoneNumber)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "invalid email")
+			return response, nil
 		}
-		return false, helper.WrapInternalServerError(u.logs, "failed to find by email and token", err)
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by phone number", err)
 	}
 
-	return true, nil
-}
-
-func (u *authUseCase) ResetPassword(ctx context.Context, request *model.ResetPasswordUserRequest) error {
-	decryptedToken, err := u.securityAdapter.Decrypt(request.Token)
-	if err != nil {
-		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid token")
+	// Verification is only for numbers not verified yet and login only for verified ones
+	if user.HasVerifiedPhoneNumber() != (request.Purpose == enum.OTPPurposeLogin) {
+		return response, nil
 	}
 
-	resetPassword, err := u.resetPasswordRepo.FindByEmailAndToken(ctx, request.Email, decryptedToken)
+	code, err := u.storeOTP(ctx, request.Purpose, request.PhoneNumber)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email or token")
-		}
-		return helper.WrapInternalServerError(u.logs, "failed to find reset password by email and token", err)
+		return nil, err
 	}
 
-	if time.Since(*resetPassword.UpdatedAt) > 15*time.Minute {
-		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Reset password token expired")
+	if err := u.smsAdapter.SendOTP(ctx, request.PhoneNumber, code, otpTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to send otp code", err)
 	}
 
-	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
-	now := time.Now()
-	user := &entity.User{
-		Email: sql.NullString{
-			Valid:  true,
-			String: request.Email,
-		},
-		Password: sql.NullString{
-			Valid:  true,
-			String: string(hashedPassword),
-		},
-		UpdatedAt: &now,
+	return response, nil
+}
+
+func (u *authUseCase) acquireOTPCooldown(ctx context.Context, purpose enum.OTPPurposeEnum, identifier string) error {
+	cooldownKey := otpCooldownKey(purpose, identifier)
+	acquired, err := u.cacheAdapter.SetNX(ctx, cooldownKey, 1, otpResendCooldown)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to set otp resend cooldown", err)
 	}
 
-	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
-		_, err = u.userRepository.UpdatePassword(ctx, tx, user)
+	if !acquired {
+		remaining, err := u.cacheAdapter.TTL(ctx, cooldownKey)
 		if err != nil {
-			return helper.WrapInternalServerError(u.logs, "failed to update reset password", err)
-		}
-
-		if err := u.resetPasswordRepo.Delete(ctx, tx, resetPassword); err != nil {
-			return helper.WrapInternalServerError(u.logs, "failed to delete reset password", err)
+			return helper.WrapInternalServerError(u.logs, "failed to get otp resend cooldown", err)
 		}
-		return nil
-	}); err != nil {
-		return err
+		return helper.NewUseCaseError(errorcode.ErrTooManyRequests,
+			fmt.Sprintf("Please wait %d seconds before requesting another code", int(remaining.Seconds())))
 	}
 
 	return nil
 }
 
-func (u *authUseCase) Login(ctx context.Context, request *model.LoginUserRequest) (*model.UserResponse, *model.TokenResponse, error) {
-	user, err := u.userRepository.FindByMultipleParam(ctx, request.MultipleParam)
+// countOTPSend caps the codes sent to a phone number whatever the purpose, sms are paid
+func (u *authUseCase) countOTPSend(ctx context.Context, phoneNumber string) error {
+	sendCount, err := u.cacheAdapter.Incr(ctx, otpSendCountKey(phoneNumber))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "invalid email or password")
-		}
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to send reset password email", err)
+		return helper.WrapInternalServerError(u.logs, "failed to increment otp send count", err)
 	}
 
-	if user.HasEmail() && strings.EqualFold(user.Email.String, request.MultipleParam) {
-		if !user.HasVerifiedEmail() {
-			return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Email must be verified")
+	if sendCount == 1 {
+		if err := u.cacheAdapter.Expire(ctx, otpSendCountKey(phoneNumber), otpSendLimitWindow); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to set otp send count expiration", err)
 		}
-	} else if user.HasPhoneNumber() && user.PhoneNumber.String == request.MultipleParam {
-		if !user.HasVerifiedPhoneNumber() {
-			return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Phone number must be verified")
-		}
-	} else {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Invalid login identifier")
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password.String), []byte(request.Password)); err != nil {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Invalid password")
+	if sendCount > otpMaxSendPerHour {
+		return helper.NewUseCaseError(errorcode.ErrTooManyRequests, "Too many OTP requests, please try again later")
 	}
 
-	userProfile, err := u.getUserProfile(ctx, user.Id)
+	return nil
+}
+
+func (u *authUseCase) storeOTP(ctx context.Context, purpose enum.OTPPurposeEnum, identifier string) (string, error) {
+	code, err := helper.GenerateOTPCode()
 	if err != nil {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email")
+		return "", helper.WrapInternalServerError(u.logs, "failed to generate otp code", err)
 	}
 
-	auth := &entity.Auth{
-		Id:            user.Id,
-		Username:      user.Username,
-		Email:         user.Email.String,
-		PhoneNumber:   user.PhoneNumber.String,
-		UserProfileID: userProfile.Id,
-		Similarity:    userProfile.Similarity,
+	if err := u.cacheAdapter.Set(ctx, otpKey(purpose, identifier), u.securityAdapter.Hash(code), otpTTL); err != nil {
+		return "", helper.WrapInternalServerError(u.logs, "failed to save otp code", err)
 	}
 
-	token, err := u.generateToken(ctx, auth)
-	if err != nil {
-		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate token", err)
+	if err := u.cacheAdapter.Del(ctx, otpAttemptKey(purpose, identifier)); err != nil {
+		return "", helper.WrapInternalServerError(u.logs, "failed to reset otp attempt", err)
 	}
 
-	return converter.UserToResponse(user), token, nil
+	return code, nil
 }
 
-func (u *authUseCase) generateToken(ctx context.Context, auth *entity.Auth) (*model.TokenResponse, error) {
-	accessTokenDetail, err := u.jwtAdapter.GenerateAccessToken(auth.Id)
+// consumeOTP checks the code against the stored hash. A code is single use and is
+// discarded once it matches or once the attempt limit has been reached.
+func (u *authUseCase) consumeOTP(ctx context.Context, purpose enum.OTPPurposeEnum, identifier, code string) error {
+	key := otpKey(purpose, identifier)
+	attemptKey := otpAttemptKey(purpose, identifier)
+
+	hashedCode, err := u.cacheAdapter.Get(ctx, key)
 	if err != nil {
-		return nil, fmt.Errorf("generate access token : %+v", err)
+		if errors.Is(err, redis.Nil) {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "OTP code expired or was never requested")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to get otp code", err)
 	}
 
-	refreshTokenDetail, err := u.jwtAdapter.GenerateRefreshToken(auth.Id)
+	attempt, err := u.cacheAdapter.Incr(ctx, attemptKey)
 	if err != nil {
-		return nil, fmt.Errorf("generate refresh token : %+v", err)
+		return helper.WrapInternalServerError(u.logs, "failed to increment otp attempt", err)
 	}
 
-	jsonValue, err := sonic.ConfigFastest.Marshal(auth)
-	if err != nil {
-		return nil, fmt.Errorf("marshal user : %+v", err)
+	if attempt == 1 {
+		if err := u.cacheAdapter.Expire(ctx, attemptKey, otpTTL); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to set otp attempt expiration", err)
+		}
 	}
 
-	if err := u.cacheAdapter.Set(ctx, refreshTokenDetail.Token, auth.Id, time.Until(refreshTokenDetail.ExpiresAt)); err != nil {
-		return nil, fmt.Errorf("save refresh token into cache : %+v", err)
+	if attempt > otpMaxAttempts {
+		if err := u.cacheAdapter.Del(ctx, key, attemptKey); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete otp code", err)
+		}
+		return helper.NewUseCaseError(errorcode.ErrTooManyRequests, "Too many invalid attempts, please request a new code")
 	}
 
-	//TODO -- SYNC with update ()
-	//set user persistence data in cache (redis) for better verify auth flow (should be synced with update profile usecase)
-	if err := u.cacheAdapter.Set(ctx, auth.Id, jsonValue, time.Until(accessTokenDetail.ExpiresAt)); err != nil {
-		return nil, fmt.Errorf("save user body into cache : %+v", err)
+	if !hmac.Equal([]byte(hashedCode), []byte(u.securityAdapter.Hash(code))) {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid OTP code")
 	}
 
-	token := &model.TokenResponse{
-		AccessToken:  accessTokenDetail.Token,
-		RefreshToken: refreshTokenDetail.Token,
+	if err := u.cacheAdapter.Del(ctx, key, attemptKey); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete otp code", err)
 	}
 
-	return token, nil
+	return nil
 }
 
-func (u *authUseCase) CreateDeviceToken(ctx context.Context, request *model.DeviceRequest) error {
-	userDeviceEvent := &event.UserDeviceEvent{
-		UserID:      request.UserId,
-		DeviceToken: request.DeviceToken,
-		Platform:    request.Platform,
+func (u *authUseCase) VerifyPhoneNumber(ctx context.Context, request *model.VerifyPhoneOTPRequest) error {
+	if err := u.consumeOTP(ctx, enum.OTPPurposeVerifyPhone, request.PhoneNumber, request.Code); err != nil {
+		return err
 	}
 
-	if err := u.userProducer.ProduceUserDeviceCreated(ctx, userDeviceEvent); err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed to produce user device event", err)
+	now := time.Now()
+	user := &entity.User{
+		PhoneNumber: sql.NullString{
+			Valid:  true,
+			String: request.PhoneNumber,
+		},
+		PhoneNumberVerifiedAt: &now,
+		UpdatedAt:             &now,
 	}
 
-	return nil
-}
+	var err error
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		user, err = u.userRepository.UpdatePhoneNumberVerifiedAt(ctx, tx, user)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid phone number")
+			}
+			return helper.WrapInternalServerError(u.logs, "failed to update user phone number verified_at", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	userProfile, err := u.userProfileRepository.FindByUserId(ctx, user.Id)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get user profile", err)
+	}
+
+	go func() {
+		u.realtimeChatAdapter.CreateChatRoom(context.Background(), user, userProfile)
+	}()
+
+	event := &event.UserEvent{
+		Id:        user.Id,
+		Username:  user.Username,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.CreatedAt,
+	}
+
+	if err := u.userProducer.ProduceUserCreated(ctx, event); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to produce user create event", err)
+	}
+
+	return nil
+}
+
+func (u *authUseCase) LoginByOTP(ctx context.Context, request *model.LoginByOTPRequest) (*model.UserResponse, *model.TokenResponse, error) {
+	if err := u.consumeOTP(ctx, enum.OTPPurposeLogin, request.PhoneNumber, request.Code); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := u.userRepository.FindByPhoneNumber(ctx, request.PhoneNumber)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid phone number")
+		}
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user by phone number", err)
+	}
+
+	if !user.HasVerifiedPhoneNumber() {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Phone number must be verified")
+	}
+
+	if user.IsSuspended() {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
+	}
+
+	userProfile, err := u.getUserProfile(ctx, user.Id)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid phone number")
+	}
+
+	auth := &entity.Auth{
+		Id:               user.Id,
+		Username:         user.Username,
+		Email:            user.Email.String,
+		PhoneNumber:      user.PhoneNumber.String,
+		UserProfileID:    userProfile.Id,
+		Similarity:       userProfile.Similarity,
+		Roles:            user.Roles,
+		TwoFactorEnabled: user.HasTwoFactor(),
+	}
+
+	session := newUserSession(request.SessionMetadata, "")
+	if user.HasTwoFactor() {
+		token, err := u.issueTwoFactorChallenge(ctx, user.Id, session)
+		if err != nil {
+			return nil, nil, err
+		}
+		return converter.UserToResponse(user), token, nil
+	}
+
+	token, err := u.generateToken(ctx, auth, session)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate token", err)
+	}
+
+	return converter.UserToResponse(user), token, nil
+}
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+// contactChangeKey holds the email or phone number waiting for its code, the code itself
+// lives under the otp keys of the change purpose scoped by user id.
+func contactChangeKey(purpose enum.OTPPurposeEnum, userId string) string {
+	return fmt.Sprintf("contact_change:%s:%s", purpose, userId)
+}
+
+func (u *authUseCase) findUserById(ctx context.Context, userId string) (*entity.User, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrUserNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	return user, nil
+}
+
+// confirmIdentity re-authenticates sensitive changes with the password. Accounts without
+// one sign in through a provider or an OTP, they confirm with a 2FA step-up of the session
+// or with the code sent to their current contact by RequestReauthOTP.
+func (u *authUseCase) confirmIdentity(ctx context.Context, user *entity.User, sessionId, password, code string) error {
+	if user.Password.Valid {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password.String), []byte(password)); err != nil {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid password")
+		}
+		return nil
+	}
+
+	if user.HasTwoFactor() {
+		_, err := u.cacheAdapter.Get(ctx, twoFactorStepUpKey(user.Id, sessionId))
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			return helper.WrapInternalServerError(u.logs, "failed to get two factor step up", err)
+		}
+	}
+
+	if code == "" {
+		return helper.NewUseCaseError(errorcode.ErrValidationFailed, "Confirm the change with the code sent to your current phone number or email")
+	}
+
+	return u.consumeOTP(ctx, enum.OTPPurposeReauth, user.Id, code)
+}
+
+// RequestReauthOTP sends the code confirming a sensitive change to the current verified phone
+// number, or email when there is none, of an account without a password
+func (u *authUseCase) RequestReauthOTP(ctx context.Context, userId string) (*model.OTPResponse, error) {
+	user, err := u.findUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Password.Valid {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Confirm the change with your password")
+	}
+
+	if !user.HasVerifiedPhoneNumber() && !user.HasVerifiedEmail() {
+		return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Account has no verified phone number or email to send the code to")
+	}
+
+	if user.HasVerifiedPhoneNumber() {
+		if err := u.countOTPSend(ctx, user.PhoneNumber.String); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.acquireOTPCooldown(ctx, enum.OTPPurposeReauth, user.Id); err != nil {
+		return nil, err
+	}
+
+	code, err := u.storeOTP(ctx, enum.OTPPurposeReauth, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasVerifiedPhoneNumber() {
+		if err := u.smsAdapter.SendOTP(ctx, user.PhoneNumber.String, code, otpTTL); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to send otp code", err)
+		}
+	} else if err := u.emailAdapter.SendEmail(user.Email.String, code, "reauthentication"); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to send reauthentication email", err)
+	}
+
+	return &model.OTPResponse{
+		ExpiresIn: int(otpTTL.Seconds()),
+		ResendIn:  int(otpResendCooldown.Seconds()),
+	}, nil
+}
+
+func (u *authUseCase) ChangeUsername(ctx context.Context, request *model.ChangeUsernameRequest) (*model.UserResponse, error) {
+	if !usernamePattern.MatchString(request.Username) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Username may only contain letters, numbers, underscores and dots")
+	}
+
+	user, err := u.findUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Username == request.Username {
+		return converter.UserToResponse(user), nil
+	}
+
+	countByUsernameTotal, err := u.userRepository.CountByUsername(ctx, request.Username)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by username", err)
+	}
+
+	if countByUsernameTotal > 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Username has already been taken")
+	}
+
+	now := time.Now()
+	user.Username = request.Username
+	user.UpdatedAt = &now
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		user, err = u.userRepository.UpdateUsername(ctx, tx, user)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update username", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.cacheAdapter.Del(ctx, user.Id); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to delete cached user", err)
+	}
+
+	return converter.UserToResponse(user), nil
+}
+
+// RequestEmailChange sends a code to the new address, the account keeps its current
+// email until the code is confirmed. An address owned by another account gets a notice
+// instead of a code and the response stays the same, so accounts cannot be enumerated.
+func (u *authUseCase) RequestEmailChange(ctx context.Context, request *model.RequestEmailChangeRequest) (*model.OTPResponse, error) {
+	user, err := u.findUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasEmail() && strings.EqualFold(user.Email.String, request.Email) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "New email must be different from the current one")
+	}
+
+	if err := u.confirmIdentity(ctx, user, request.SessionId, request.Password, request.Code); err != nil {
+		return nil, err
+	}
+
+	if err := u.limitIdentifierRequest(ctx, "change_email", request.Email, emailRequestIdentifierLimit); err != nil {
+		return nil, err
+	}
+
+	if err := u.acquireOTPCooldown(ctx, enum.OTPPurposeChangeEmail, user.Id); err != nil {
+		return nil, err
+	}
+
+	response := &model.OTPResponse{
+		ExpiresIn: int(otpTTL.Seconds()),
+		ResendIn:  int(otpResendCooldown.Seconds()),
+	}
+
+	countByEmailTotal, err := u.userRepository.CountByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by email", err)
+	}
+
+	if countByEmailTotal > 0 {
+		if err := u.emailAdapter.SendEmail(request.Email, "", "email already registered"); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to send email already registered notice", err)
+		}
+		return response, nil
+	}
+
+	code, err := u.storeOTP(ctx, enum.OTPPurposeChangeEmail, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.cacheAdapter.Set(ctx, contactChangeKey(enum.OTPPurposeChangeEmail, user.Id), request.Email, otpTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to save pending email change", err)
+	}
+
+	if err := u.emailAdapter.SendEmail(request.Email, code, "email change verification"); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to send email change verification", err)
+	}
+
+	return response, nil
+}
+
+// ConfirmEmailChange swaps the email once the code sent to it is confirmed. Tokens issued
+// for the previous address are dropped and every other session is signed out. Only the owner
+// of the mailbox gets this far, so an address taken since the request is reported as such.
+func (u *authUseCase) ConfirmEmailChange(ctx context.Context, request *model.ConfirmContactChangeRequest) (*model.UserResponse, error) {
+	newEmail, err := u.consumeContactChange(ctx, enum.OTPPurposeChangeEmail, request)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.findUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	countByEmailTotal, err := u.userRepository.CountByEmail(ctx, newEmail)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by email", err)
+	}
+
+	if countByEmailTotal > 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Email has already been taken")
+	}
+
+	previousEmail := user.Email
+	now := time.Now()
+	user.Email = sql.NullString{Valid: true, String: newEmail}
+	user.EmailVerifiedAt = &now
+	user.UpdatedAt = &now
+
+	var revokedSessions []*entity.UserSession
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if previousEmail.Valid {
+			if err := u.emailVerificationRepo.DeleteByEmail(ctx, tx, previousEmail.String); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to delete email verification", err)
+			}
+
+			if err := u.resetPasswordRepo.DeleteByEmail(ctx, tx, previousEmail.String); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to delete reset password", err)
+			}
+		}
+
+		user, err = u.userRepository.UpdateEmail(ctx, tx, user)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update user email", err)
+		}
+
+		revokedSessions, err = u.userSessionRepository.RevokeAllByUserIdExcept(ctx, tx, user.Id, request.SessionId,
+			enum.SessionRevokeReasonContactChange, now)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to revoke user sessions", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.invalidateIdentityCaches(ctx, user.Id, previousEmail.String, revokedSessions); err != nil {
+		return nil, err
+	}
+
+	if previousEmail.Valid {
+		if err := u.emailAdapter.SendEmail(previousEmail.String, "", "email changed"); err != nil {
+			u.logs.CustomError("failed to send email changed notice", err)
+		}
+	}
+
+	return converter.UserToResponse(user), nil
+}
+
+// RequestPhoneChange sends a code to the new phone number, the account keeps its current
+// number until the code is confirmed. A number owned by another account gets a notice
+// instead of a code and the response stays the same, so accounts cannot be enumerated.
+func (u *authUseCase) RequestPhoneChange(ctx context.Context, request *model.RequestPhoneChangeRequest) (*model.OTPResponse, error) {
+	user, err := u.findUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasPhoneNumber() && user.PhoneNumber.String == request.PhoneNumber {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "New phone number must be different from the current one")
+	}
+
+	if err := u.confirmIdentity(ctx, user, request.SessionId, request.Password, request.Code); err != nil {
+		return nil, err
+	}
+
+	if err := u.countOTPSend(ctx, request.PhoneNumber); err != nil {
+		return nil, err
+	}
+
+	if err := u.acquireOTPCooldown(ctx, enum.OTPPurposeChangePhone, user.Id); err != nil {
+		return nil, err
+	}
+
+	response := &model.OTPResponse{
+		ExpiresIn: int(otpTTL.Seconds()),
+		ResendIn:  int(otpResendCooldown.Seconds()),
+	}
+
+	countByPhoneTotal, err := u.userRepository.CountByPhoneNumber(ctx, request.PhoneNumber)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by phone number", err)
+	}
+
+	if countByPhoneTotal > 0 {
+		if err := u.smsAdapter.SendNotice(ctx, request.PhoneNumber,
+			"Someone tried to move a YourMoments account to this phone number, it already belongs to your account. If this was not you, you can ignore this message."); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to send phone number already registered notice", err)
+		}
+		return response, nil
+	}
+
+	code, err := u.storeOTP(ctx, enum.OTPPurposeChangePhone, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.cacheAdapter.Set(ctx, contactChangeKey(enum.OTPPurposeChangePhone, user.Id), request.PhoneNumber, otpTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to save pending phone number change", err)
+	}
+
+	if err := u.smsAdapter.SendOTP(ctx, request.PhoneNumber, code, otpTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to send otp code", err)
+	}
+
+	return response, nil
+}
+
+// ConfirmPhoneChange swaps the phone number once the code sent to it is confirmed and
+// signs out every other session. Only the owner of the number gets this far, so a number
+// taken since the request is reported as such.
+func (u *authUseCase) ConfirmPhoneChange(ctx context.Context, request *model.ConfirmContactChangeRequest) (*model.UserResponse, error) {
+	newPhoneNumber, err := u.consumeContactChange(ctx, enum.OTPPurposeChangePhone, request)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.findUserById(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	countByPhoneTotal, err := u.userRepository.CountByPhoneNumber(ctx, newPhoneNumber)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count user by phone number", err)
+	}
+
+	if countByPhoneTotal > 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Phone number has already been taken")
+	}
+
+	previousPhoneNumber := user.PhoneNumber
+	previousPhoneVerified := user.HasVerifiedPhoneNumber()
+	now := time.Now()
+	user.PhoneNumber = sql.NullString{Valid: true, String: newPhoneNumber}
+	user.PhoneNumberVerifiedAt = &now
+	user.UpdatedAt = &now
+
+	var revokedSessions []*entity.UserSession
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		user, err = u.userRepository.UpdatePhoneNumber(ctx, tx, user)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update user phone number", err)
+		}
+
+		revokedSessions, err = u.userSessionRepository.RevokeAllByUserIdExcept(ctx, tx, user.Id, request.SessionId,
+			enum.SessionRevokeReasonContactChange, now)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to revoke user sessions", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.invalidateIdentityCaches(ctx, user.Id, previousPhoneNumber.String, revokedSessions); err != nil {
+		return nil, err
+	}
+
+	if previousPhoneNumber.Valid && previousPhoneVerified {
+		if err := u.smsAdapter.SendNotice(ctx, previousPhoneNumber.String,
+			"The phone number of your YourMoments account has been changed. If this was not you, reset your password and contact our support."); err != nil {
+			u.logs.CustomError("failed to send phone number changed notice", err)
+		}
+	}
+
+	if user.HasVerifiedEmail() {
+		if err := u.emailAdapter.SendEmail(user.Email.String, "", "phone number changed"); err != nil {
+			u.logs.CustomError("failed to send phone number changed notice", err)
+		}
+	}
+
+	return converter.UserToResponse(user), nil
+}
+
+// consumeContactChange returns the pending email or phone number once its code matches
+func (u *authUseCase) consumeContactChange(ctx context.Context, purpose enum.OTPPurposeEnum, request *model.ConfirmContactChangeRequest) (string, error) {
+	key := contactChangeKey(purpose, request.UserId)
+	value, err := u.cacheAdapter.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Code expired or no change was requested")
+		}
+		return "", helper.WrapInternalServerError(u.logs, "failed to get pending contact change", err)
+	}
+
+	if err := u.consumeOTP(ctx, purpose, request.UserId, request.Code); err != nil {
+		return "", err
+	}
+
+	if err := u.cacheAdapter.Del(ctx, key); err != nil {
+		return "", helper.WrapInternalServerError(u.logs, "failed to delete pending contact change", err)
+	}
+
+	return value, nil
+}
+
+// invalidateIdentityCaches drops what is cached under the user or the identifier it no
+// longer owns, so the next request rebuilds the auth cache and a future owner of the
+// identifier does not inherit its lockouts.
+func (u *authUseCase) invalidateIdentityCaches(ctx context.Context, userId, previousIdentifier string, revokedSessions []*entity.UserSession) error {
+	if err := markSessionsRevoked(ctx, u.cacheAdapter, revokedSessions); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to mark revoked sessions in cache", err)
+	}
+
+	keys := []string{userId}
+	if previousIdentifier != "" {
+		identifier := normalizeIdentifier(previousIdentifier)
+		keys = append(keys, loginFailureKey(identifier), loginLockKey(identifier), loginLockoutCountKey(identifier),
+			otpKey(enum.OTPPurposeLogin, previousIdentifier), otpKey(enum.OTPPurposeVerifyPhone, previousIdentifier))
+	}
+
+	if err := u.cacheAdapter.Del(ctx, keys...); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete identity caches", err)
+	}
+
+	return nil
+}
+
+// RequestResetPassword succeeds silently for unknown emails so it cannot be used to
+// enumerate accounts.
+func (u *authUseCase) RequestResetPassword(ctx context.Context, email string) error {
+	if err := u.limitIdentifierRequest(ctx, "reset_password", email, emailRequestIdentifierLimit); err != nil {
+		return err
+	}
+
+	_, err := u.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find email", err)
+	}
+
+	countByEmailTotal, err := u.resetPasswordRepo.CountByEmail(ctx, email)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed count reset password by email", err)
+	}
+
+	token := uuid.NewString()
+	now := time.Now()
+	resetPassword := &entity.ResetPassword{
+		Email:     email,
+		Token:     token,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if countByEmailTotal > 0 {
+			_, err := u.resetPasswordRepo.Update(ctx, tx, resetPassword)
+			if err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to update reset password", err)
+			}
+		} else {
+			_, err := u.resetPasswordRepo.Insert(ctx, tx, resetPassword)
+			if err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to insert reset password", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	encryptedToken, err := u.securityAdapter.Encrypt(token)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to encrypt reset password token", err)
+	}
+
+	if err := u.emailAdapter.SendEmail(email, encryptedToken, "reset password"); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to send reset password email", err)
+	}
+
+	return nil
+}
+
+func (u *authUseCase) ValidateResetPassword(ctx context.Context, request *model.ValidateResetTokenRequest) (bool, error) {
+	decryptedToken, err := u.securityAdapter.Decrypt(request.Token)
+	if err != nil {
+		return false, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid reset password token")
+	}
+
+	_, err = u.resetPasswordRepo.FindByEmailAndToken(ctx, request.Email, decryptedToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "invalid email")
+		}
+		return false, helper.WrapInternalServerError(u.logs, "failed to find by email and token", err)
+	}
+
+	return true, nil
+}
+
+func (u *authUseCase) ResetPassword(ctx context.Context, request *model.ResetPasswordUserRequest) error {
+	decryptedToken, err := u.securityAdapter.Decrypt(request.Token)
+	if err != nil {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid token")
+	}
+
+	resetPassword, err := u.resetPasswordRepo.FindByEmailAndToken(ctx, request.Email, decryptedToken)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email or token")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find reset password by email and token", err)
+	}
+
+	if time.Since(*resetPassword.UpdatedAt) > 15*time.Minute {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Reset password token expired")
+	}
+
+	existingUser, err := u.userRepository.FindByEmail(ctx, request.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email or token")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find user by email", err)
+	}
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	now := time.Now()
+	user := &entity.User{
+		Email: sql.NullString{
+			Valid:  true,
+			String: request.Email,
+		},
+		Password: sql.NullString{
+			Valid:  true,
+			String: string(hashedPassword),
+		},
+		UpdatedAt: &now,
+	}
+
+	var revokedSessions []*entity.UserSession
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		_, err = u.userRepository.UpdatePassword(ctx, tx, user)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to update reset password", err)
+		}
+
+		if err := u.resetPasswordRepo.Delete(ctx, tx, resetPassword); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete reset password", err)
+		}
+
+		revokedSessions, err = u.userSessionRepository.RevokeAllByUserId(ctx, tx, existingUser.Id, enum.SessionRevokeReasonPasswordReset, now)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to revoke user sessions", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := markSessionsRevoked(ctx, u.cacheAdapter, revokedSessions); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to mark revoked sessions in cache", err)
+	}
+
+	return nil
+}
+
+func (u *authUseCase) Login(ctx context.Context, request *model.LoginUserRequest) (*model.UserResponse, *model.TokenResponse, error) {
+	identifier := normalizeIdentifier(request.MultipleParam)
+	if err := u.checkLoginLock(ctx, identifier); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := u.userRepository.FindByMultipleParam(ctx, request.MultipleParam)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Unknown identifiers pay the same bcrypt cost as a wrong password
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(request.Password))
+			return nil, nil, u.recordLoginFailure(ctx, identifier, nil)
+		}
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user by multiple param", err)
+	}
+
+	passwordHash := dummyPasswordHash
+	if user.Password.Valid {
+		passwordHash = []byte(user.Password.String)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(passwordHash, []byte(request.Password)); err != nil || !user.Password.Valid {
+		return nil, nil, u.recordLoginFailure(ctx, identifier, user)
+	}
+
+	if err := u.rateLimiterAdapter.Reset(ctx, loginFailureKey(identifier)); err != nil {
+		u.logs.CustomError("failed to reset login failures", err)
+	}
+
+	if user.HasEmail() && strings.EqualFold(user.Email.String, request.MultipleParam) {
+		if !user.HasVerifiedEmail() {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Email must be verified")
+		}
+	} else if user.HasPhoneNumber() && user.PhoneNumber.String == request.MultipleParam {
+		if !user.HasVerifiedPhoneNumber() {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Phone number must be verified")
+		}
+	} else {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, invalidCredentialsMessage)
+	}
+
+	if user.IsSuspended() {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
+	}
+
+	userProfile, err := u.getUserProfile(ctx, user.Id)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid email")
+	}
+
+	auth := &entity.Auth{
+		Id:               user.Id,
+		Username:         user.Username,
+		Email:            user.Email.String,
+		PhoneNumber:      user.PhoneNumber.String,
+		UserProfileID:    userProfile.Id,
+		Similarity:       userProfile.Similarity,
+		Roles:            user.Roles,
+		TwoFactorEnabled: user.HasTwoFactor(),
+	}
+
+	session := newUserSession(request.SessionMetadata, "")
+	if user.HasTwoFactor() {
+		token, err := u.issueTwoFactorChallenge(ctx, user.Id, session)
+		if err != nil {
+			return nil, nil, err
+		}
+		return converter.UserToResponse(user), token, nil
+	}
+
+	token, err := u.generateToken(ctx, auth, session)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate token", err)
+	}
+
+	return converter.UserToResponse(user), token, nil
+}
+
+// Brute-force protection. Failures are counted per login identifier whether or not an
+// account exists, so a lockout does not reveal registered emails or phone numbers.
+// Every lockout within loginLockoutMemory doubles the next one up to loginLockoutMax.
+const (
+	invalidCredentialsMessage   = "Invalid email, phone number or password"
+	loginMaxFailures            = 5
+	loginFailureWindow          = 15 * time.Minute
+	loginLockoutBase            = 15 * time.Minute
+	loginLockoutMax             = 24 * time.Hour
+	loginLockoutMemory          = 24 * time.Hour
+	emailRequestIdentifierLimit = 3
+	registerIdentifierLimit     = 10
+	identifierRequestWindow     = time.Hour
+	identityUsernameMaxLength   = 20
+	identityUsernameAttempts    = 5
+)
+
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+
+func loginFailureKey(identifier string) string {
+	return "login_failure:" + identifier
+}
+
+func loginLockKey(identifier string) string {
+	return "login_lock:" + identifier
+}
+
+func loginLockoutCountKey(identifier string) string {
+	return "login_lockout_count:" + identifier
+}
+
+func identifierRequestKey(scope, identifier string) string {
+	return fmt.Sprintf("identifier:%s:%s", scope, identifier)
+}
+
+func normalizeIdentifier(identifier string) string {
+	return strings.ToLower(strings.TrimSpace(identifier))
+}
+
+func loginLockoutDuration(lockouts int64) time.Duration {
+	duration := loginLockoutBase
+	for i := int64(1); i < lockouts && duration < loginLockoutMax; i++ {
+		duration *= 2
+	}
+
+	return min(duration, loginLockoutMax)
+}
+
+func tooManyAttemptsError(message string, retryAfter time.Duration) error {
+	minutes := int(math.Ceil(retryAfter.Minutes()))
+	return helper.NewUseCaseError(errorcode.ErrTooManyRequests, fmt.Sprintf("%s, please try again in %d minute(s)", message, max(minutes, 1)))
+}
+
+func (u *authUseCase) checkLoginLock(ctx context.Context, identifier string) error {
+	ttl, err := u.cacheAdapter.TTL(ctx, loginLockKey(identifier))
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get login lock ttl", err)
+	}
+
+	if ttl > 0 {
+		return tooManyAttemptsError("Too many failed login attempts", ttl)
+	}
+
+	return nil
+}
+
+// recordLoginFailure always returns the error to respond with. Once the identifier
+// exceeds loginMaxFailures within the window it is locked and the owner, if any, is
+// notified by email.
+func (u *authUseCase) recordLoginFailure(ctx context.Context, identifier string, user *entity.User) error {
+	allowed, _, err := u.rateLimiterAdapter.Allow(ctx, loginFailureKey(identifier), loginMaxFailures, loginFailureWindow)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to record login failure", err)
+	}
+
+	if allowed {
+		return helper.NewUseCaseError(errorcode.ErrValidationFailed, invalidCredentialsMessage)
+	}
+
+	lockouts, err := u.cacheAdapter.Incr(ctx, loginLockoutCountKey(identifier))
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to increment login lockout count", err)
+	}
+
+	if err := u.cacheAdapter.Expire(ctx, loginLockoutCountKey(identifier), loginLockoutMemory); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to set login lockout count expiration", err)
+	}
+
+	duration := loginLockoutDuration(lockouts)
+	if err := u.cacheAdapter.Set(ctx, loginLockKey(identifier), lockouts, duration); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to lock login identifier", err)
+	}
+
+	if err := u.rateLimiterAdapter.Reset(ctx, loginFailureKey(identifier)); err != nil {
+		u.logs.CustomError("failed to reset login failures", err)
+	}
+
+	u.logs.CustomLog("login identifier locked", fmt.Sprintf("identifier=%s lockouts=%d duration=%s", identifier, lockouts, duration))
+	if user != nil && user.HasEmail() {
+		if err := u.emailAdapter.SendEmail(user.Email.String, "", "account locked"); err != nil {
+			u.logs.CustomError("failed to send account locked email", err)
+		}
+	}
+
+	return tooManyAttemptsError("Too many failed login attempts", duration)
+}
+
+// limitIdentifierRequest throttles unauthenticated requests targeting one email or
+// phone number, it complements the per ip limit applied in the http routes.
+func (u *authUseCase) limitIdentifierRequest(ctx context.Context, scope, identifier string, limit int) error {
+	allowed, retryAfter, err := u.rateLimiterAdapter.Allow(ctx, identifierRequestKey(scope, normalizeIdentifier(identifier)), limit, identifierRequestWindow)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to check identifier rate limit", err)
+	}
+
+	if !allowed {
+		return tooManyAttemptsError("Too many requests", retryAfter)
+	}
+
+	return nil
+}
+
+func newUserSession(metadata model.SessionMetadata, platform enum.PlatformTypeEnum) *entity.UserSession {
+	return &entity.UserSession{
+		Device:    nullable.ToSQLStringOmitEmpty(metadata.Device),
+		Platform:  nullable.ToSQLStringOmitEmpty(string(platform)),
+		IpAddress: nullable.ToSQLStringOmitEmpty(metadata.IpAddress),
+		UserAgent: nullable.ToSQLStringOmitEmpty(metadata.UserAgent),
+	}
+}
+
+// generateToken opens a new session (refresh token family) for the given device
+func (u *authUseCase) generateToken(ctx context.Context, auth *entity.Auth, session *entity.UserSession) (*model.TokenResponse, error) {
+	now := time.Now()
+	session.Id = ulid.Make().String()
+	session.UserId = auth.Id
+	session.RefreshTokenId = ulid.Make().String()
+
+	accessTokenDetail, err := u.jwtAdapter.GenerateAccessToken(auth.Id, session.Id, auth.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("generate access token : %+v", err)
+	}
+
+	refreshTokenDetail, err := u.jwtAdapter.GenerateRefreshToken(auth.Id, session.Id, session.RefreshTokenId)
+	if err != nil {
+		return nil, fmt.Errorf("generate refresh token : %+v", err)
+	}
+
+	jsonValue, err := sonic.ConfigFastest.Marshal(auth)
+	if err != nil {
+		return nil, fmt.Errorf("marshal user : %+v", err)
+	}
+
+	session.LastSeenAt = &now
+	session.ExpiresAt = &refreshTokenDetail.ExpiresAt
+	session.CreatedAt = &now
+	session.UpdatedAt = &now
+	if _, err := u.userSessionRepository.Create(ctx, u.db, session); err != nil {
+		return nil, fmt.Errorf("create user session : %+v", err)
+	}
+
+	//TODO -- SYNC with update ()
+	//set user persistence data in cache (redis) for better verify auth flow (should be synced with update profile usecase)
+	if err := u.cacheAdapter.Set(ctx, auth.Id, jsonValue, time.Until(accessTokenDetail.ExpiresAt)); err != nil {
+		return nil, fmt.Errorf("save user body into cache : %+v", err)
+	}
+
+	token := &model.TokenResponse{
+		AccessToken:  accessTokenDetail.Token,
+		RefreshToken: refreshTokenDetail.Token,
+	}
+
+	return token, nil
+}
+
+func (u *authUseCase) CreateDeviceToken(ctx context.Context, request *model.DeviceRequest) error {
+	userDeviceEvent := &event.UserDeviceEvent{
+		UserID:      request.UserId,
+		DeviceToken: request.DeviceToken,
+		Platform:    request.Platform,
+	}
+
+	if err := u.userProducer.ProduceUserDeviceCreated(ctx, userDeviceEvent); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to produce user device event", err)
+	}
+
+	return nil
+}
 
 func (u *authUseCase) Current(ctx context.Context, email string) (*model.UserResponse, error) {
 	user, err := u.userRepository.FindByEmail(ctx, email)
@@ -744,19 +1790,35 @@ func (u *authUseCase) Verify(ctx context.Context, request *model.VerifyUserReque
 		return nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid access token")
 	}
 
-	userId, _ := u.cacheAdapter.Get(ctx, request.Token)
+	userId, err := u.cacheAdapter.Get(ctx, request.Token)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to get signed out access token", err)
+	}
+
 	if userId != "" {
 		return nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "User has already signed out")
 	}
 
+	if accessTokenDetail.SessionId != "" {
+		if err := u.checkSessionRevoked(ctx, accessTokenDetail.UserId, accessTokenDetail.SessionId); err != nil {
+			return nil, err
+		}
+	}
+
 	cachedUserStr, err := u.cacheAdapter.Get(ctx, accessTokenDetail.UserId)
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return nil, helper.WrapInternalServerError(u.logs, "failed to get cached user", err)
 	}
 
 	auth := new(entity.Auth)
-	//If redis stale, get from db
-	if errors.Is(err, redis.Nil) {
+	if !errors.Is(err, redis.Nil) {
+		if err := sonic.ConfigFastest.Unmarshal([]byte(cachedUserStr), &auth); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to unmarshal user body from cached", err)
+		}
+	}
+
+	//If redis stale or cached before roles existed, get from db
+	if len(auth.Roles) == 0 {
 		user, err := u.userRepository.FindById(ctx, accessTokenDetail.UserId)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -766,59 +1828,106 @@ func (u *authUseCase) Verify(ctx context.Context, request *model.VerifyUserReque
 			return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
 		}
 
-		if err = u.setAuthCache(ctx, user, auth, accessTokenDetail); err != nil {
+		auth, err = u.setAuthCache(ctx, user, accessTokenDetail)
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		if err := sonic.ConfigFastest.Unmarshal([]byte(cachedUserStr), &auth); err != nil {
-			return nil, helper.WrapInternalServerError(u.logs, "failed to unmarshal user body from cached", err)
+	}
+
+	var twoFactorVerifiedAt *time.Time
+	if auth.TwoFactorEnabled {
+		if verifiedAtStr, _ := u.cacheAdapter.Get(ctx, twoFactorStepUpKey(auth.Id, accessTokenDetail.SessionId)); verifiedAtStr != "" {
+			if verifiedAtUnix, err := strconv.ParseInt(verifiedAtStr, 10, 64); err == nil {
+				verifiedAt := time.Unix(verifiedAtUnix, 0)
+				twoFactorVerifiedAt = &verifiedAt
+			}
 		}
 	}
 
 	authResponse := &model.AuthResponse{
-		UserId:        auth.Id,
-		Username:      auth.Username,
-		Email:         auth.Email,
-		PhoneNumber:   auth.PhoneNumber,
-		UserProfileID: auth.UserProfileID,
-		Similarity:    auth.Similarity,
-		Token:         request.Token,
-		ExpiresAt:     accessTokenDetail.ExpiresAt,
+		UserId:              auth.Id,
+		Username:            auth.Username,
+		Email:               auth.Email,
+		PhoneNumber:         auth.PhoneNumber,
+		UserProfileID:       auth.UserProfileID,
+		Similarity:          auth.Similarity,
+		Roles:               auth.Roles,
+		Permissions:         enum.ResolvePermissions(auth.Roles),
+		SessionId:           accessTokenDetail.SessionId,
+		TwoFactorEnabled:    auth.TwoFactorEnabled,
+		TwoFactorVerifiedAt: twoFactorVerifiedAt,
+		Token:               request.Token,
+		ExpiresAt:           accessTokenDetail.ExpiresAt,
 	}
 
 	return authResponse, nil
 }
 
-func (u *authUseCase) setAuthCache(ctx context.Context, user *entity.User, auth *entity.Auth, accessTokenDetail *entity.AccessToken) error {
-	if auth == nil {
-		auth = new(entity.Auth)
+// checkSessionRevoked reads the revoked marker written by markSessionsRevoked. When the cache
+// can not answer, the session row decides instead so a revoked session never passes on a redis error.
+func (u *authUseCase) checkSessionRevoked(ctx context.Context, userId, sessionId string) error {
+	// The marker holds the revoke reason which may be empty, only its presence matters
+	_, err := u.cacheAdapter.Get(ctx, revokedSessionKeyPrefix+sessionId)
+	if err == nil {
+		return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Session has been revoked")
+	}
+
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	u.logs.CustomError("failed to get revoked session from cache, checking the session in database", err)
+	session, err := u.userSessionRepository.FindById(ctx, u.db, sessionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Session has been revoked")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find user session by id", err)
+	}
+
+	if session.UserId != userId || session.IsRevoked() || time.Now().After(*session.ExpiresAt) {
+		return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Session has been revoked")
+	}
+
+	return nil
+}
+
+func (u *authUseCase) setAuthCache(ctx context.Context, user *entity.User, accessTokenDetail *entity.AccessToken) (*entity.Auth, error) {
+	if user.IsDeleted() {
+		return nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Account has been deleted")
+	}
+
+	if user.IsSuspended() {
+		return nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
 	}
 
 	userProfile, err := u.getUserProfile(ctx, user.Id)
 	if err != nil {
 		u.logs.Debug("Err no get user profile")
-		return helper.NewUseCaseError(errorcode.ErrUnauthorized, "invalid refresh token")
+		return nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "invalid refresh token")
 	}
 
-	auth = &entity.Auth{
-		Id:            user.Id,
-		Username:      user.Username,
-		Email:         user.Email.String,
-		PhoneNumber:   user.PhoneNumber.String,
-		UserProfileID: userProfile.Id,
-		Similarity:    userProfile.Similarity,
+	auth := &entity.Auth{
+		Id:               user.Id,
+		Username:         user.Username,
+		Email:            user.Email.String,
+		PhoneNumber:      user.PhoneNumber.String,
+		UserProfileID:    userProfile.Id,
+		Similarity:       userProfile.Similarity,
+		Roles:            user.Roles,
+		TwoFactorEnabled: user.HasTwoFactor(),
 	}
 
 	jsonValue, err := sonic.ConfigFastest.Marshal(auth)
 	if err != nil {
-		return fmt.Errorf("marshal user : %+v", err)
+		return nil, fmt.Errorf("marshal user : %+v", err)
 	}
 
 	if err := u.cacheAdapter.Set(ctx, auth.Id, jsonValue, time.Until(accessTokenDetail.ExpiresAt)); err != nil {
-		return fmt.Errorf("save user body into cache : %+v", err)
+		return nil, fmt.Errorf("save user body into cache : %+v", err)
 	}
 
-	return nil
+	return auth, nil
 }
 
 func (u *authUseCase) Logout(ctx context.Context, request *model.LogoutUserRequest) (bool, error) {
@@ -826,24 +1935,36 @@ func (u *authUseCase) Logout(ctx context.Context, request *model.LogoutUserReque
 		return false, helper.WrapInternalServerError(u.logs, "failed to save access token to cache for logout : ", err)
 	}
 
+	// Legacy refresh tokens were stored as bare cache keys
 	if err := u.cacheAdapter.Del(ctx, request.RefreshToken); err != nil {
 		return false, helper.WrapInternalServerError(u.logs, "failed to delete refresh token from cache for logout : ", err)
 	}
 
+	if request.SessionId != "" {
+		session, err := u.userSessionRepository.Revoke(ctx, u.db, request.UserId, request.SessionId, enum.SessionRevokeReasonLogout, time.Now())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, helper.WrapInternalServerError(u.logs, "failed to revoke user session for logout : ", err)
+		}
+
+		if session != nil {
+			if err := markSessionsRevoked(ctx, u.cacheAdapter, []*entity.UserSession{session}); err != nil {
+				return false, helper.WrapInternalServerError(u.logs, "failed to mark revoked session in cache for logout : ", err)
+			}
+		}
+	}
+
 	return true, nil
 }
 
-func (u *authUseCase) AccessTokenRequest(ctx context.Context, refreshToken string) (*model.UserResponse, *model.TokenResponse, error) {
-	refreshTokenDetail, err := u.jwtAdapter.VerifyRefreshToken(refreshToken)
+// AccessTokenRequest rotates the refresh token on every call. Presenting a refresh
+// token that has already been rotated away is treated as token theft and revokes
+// the whole session (token family).
+func (u *authUseCase) AccessTokenRequest(ctx context.Context, request *model.AccessTokenRequest) (*model.UserResponse, *model.TokenResponse, error) {
+	refreshTokenDetail, err := u.jwtAdapter.VerifyRefreshToken(request.RefreshToken)
 	if err != nil {
 		return nil, nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid refresh token")
 	}
 
-	userId, err := u.cacheAdapter.Get(ctx, refreshToken)
-	if userId == "" {
-		return nil, nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid refresh token")
-	}
-
 	user, err := u.userRepository.FindById(ctx, refreshTokenDetail.UserId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -853,34 +1974,248 @@ func (u *authUseCase) AccessTokenRequest(ctx context.Context, refreshToken strin
 		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
 	}
 
-	accessTokenDetail, err := u.jwtAdapter.GenerateAccessToken(user.Id)
+	if user.IsSuspended() {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
+	}
+
+	legacyToken := refreshTokenDetail.SessionId == "" || refreshTokenDetail.TokenId == ""
+	if legacyToken {
+		userId, _ := u.cacheAdapter.Get(ctx, request.RefreshToken)
+		if userId != user.Id {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid refresh token")
+		}
+
+		if err := u.cacheAdapter.Del(ctx, request.RefreshToken); err != nil {
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to delete legacy refresh token from cache", err)
+		}
+	}
+
+	now := time.Now()
+	session := newUserSession(model.SessionMetadata{IpAddress: request.IpAddress, UserAgent: request.UserAgent}, "")
+	session.Id = refreshTokenDetail.SessionId
+	if legacyToken {
+		session.Id = ulid.Make().String()
+	}
+	session.UserId = user.Id
+	session.RefreshTokenId = ulid.Make().String()
+
+	newRefreshTokenDetail, err := u.jwtAdapter.GenerateRefreshToken(user.Id, session.Id, session.RefreshTokenId)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate refresh token", err)
+	}
+
+	session.LastSeenAt = &now
+	session.ExpiresAt = &newRefreshTokenDetail.ExpiresAt
+	session.UpdatedAt = &now
+
+	if legacyToken {
+		session.CreatedAt = &now
+		if _, err := u.userSessionRepository.Create(ctx, u.db, session); err != nil {
+			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to create user session", err)
+		}
+	} else if _, err := u.userSessionRepository.Rotate(ctx, u.db, session, refreshTokenDetail.TokenId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, u.handleRefreshTokenReuse(ctx, refreshTokenDetail)
+		}
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to rotate user session", err)
+	}
+
+	accessTokenDetail, err := u.jwtAdapter.GenerateAccessToken(user.Id, session.Id, user.Roles)
 	if err != nil {
 		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate access token", err)
 	}
 
-	if err := u.setAuthCache(ctx, user, nil, accessTokenDetail); err != nil {
+	if _, err := u.setAuthCache(ctx, user, accessTokenDetail); err != nil {
 		return nil, nil, err
 	}
 
 	tokenResponse := &model.TokenResponse{
-		AccessToken: accessTokenDetail.Token,
+		AccessToken:  accessTokenDetail.Token,
+		RefreshToken: newRefreshTokenDetail.Token,
 	}
 
-	//TODO time duration refresh token to be renewed
-	if time.Until(refreshTokenDetail.ExpiresAt) < time.Hour*24 {
-		newRefreshTokenDetail, err := u.jwtAdapter.GenerateRefreshToken(user.Id)
-		if err != nil {
-			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate refresh token", err)
+	return converter.UserToResponse(user), tokenResponse, nil
+}
+
+// handleRefreshTokenReuse is called when rotation found no live session holding the
+// presented token id. If the session is still live the token was replayed, so the
+// whole family is revoked.
+func (u *authUseCase) handleRefreshTokenReuse(ctx context.Context, refreshTokenDetail *entity.RefreshToken) error {
+	session, err := u.userSessionRepository.FindById(ctx, u.db, refreshTokenDetail.SessionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid refresh token")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find user session by id", err)
+	}
+
+	if session.UserId != refreshTokenDetail.UserId {
+		return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Invalid refresh token")
+	}
+
+	if session.IsRevoked() || time.Now().After(*session.ExpiresAt) {
+		return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Session has been revoked")
+	}
+
+	u.logs.CustomLog("refresh token reuse detected", fmt.Sprintf("user_id=%s session_id=%s", session.UserId, session.Id))
+	if err := u.revokeSession(ctx, session.UserId, session.Id, enum.SessionRevokeReasonTokenReuse); err != nil {
+		return err
+	}
+
+	return helper.NewUseCaseError(errorcode.ErrUnauthorized, "Refresh token has already been used, please sign in again")
+}
+
+func (u *authUseCase) ListSessions(ctx context.Context, userId, currentSessionId string) ([]*model.SessionResponse, error) {
+	sessions, err := u.userSessionRepository.FindAllActiveByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find all active user sessions", err)
+	}
+
+	return converter.SessionsToResponses(sessions, currentSessionId), nil
+}
+
+func (u *authUseCase) RevokeSession(ctx context.Context, request *model.RevokeSessionRequest) error {
+	return u.revokeSession(ctx, request.UserId, request.SessionId, enum.SessionRevokeReasonUserRevoked)
+}
+
+func (u *authUseCase) RevokeAllSessions(ctx context.Context, userId string) error {
+	sessions, err := u.userSessionRepository.RevokeAllByUserId(ctx, u.db, userId, enum.SessionRevokeReasonUserRevoked, time.Now())
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to revoke all user sessions", err)
+	}
+
+	if err := markSessionsRevoked(ctx, u.cacheAdapter, sessions); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to mark revoked sessions in cache", err)
+	}
+
+	return nil
+}
+
+func (u *authUseCase) revokeSession(ctx context.Context, userId, sessionId string, reason enum.SessionRevokeReasonEnum) error {
+	session, err := u.userSessionRepository.Revoke(ctx, u.db, userId, sessionId, reason, time.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Session not found")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to revoke user session", err)
+	}
+
+	if err := markSessionsRevoked(ctx, u.cacheAdapter, []*entity.UserSession{session}); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to mark revoked session in cache", err)
+	}
+
+	return nil
+}
+
+// markSessionsRevoked lets Verify reject access tokens of revoked sessions before they expire
+func markSessionsRevoked(ctx context.Context, cacheAdapter adapter.CacheAdapter, sessions []*entity.UserSession) error {
+	for _, session := range sessions {
+		ttl := time.Until(*session.ExpiresAt)
+		if ttl <= 0 {
+			continue
 		}
 
-		if err := u.cacheAdapter.Set(ctx, newRefreshTokenDetail.Token, user.Id, time.Until(refreshTokenDetail.ExpiresAt)); err != nil {
-			return nil, nil, helper.WrapInternalServerError(u.logs, "failed to save refresh token to cache", err)
+		if err := cacheAdapter.Set(ctx, revokedSessionKeyPrefix+session.Id, session.RevokedReason.String, ttl); err != nil {
+			return err
 		}
+	}
 
-		tokenResponse.RefreshToken = newRefreshTokenDetail.Token
-	} else {
-		tokenResponse.RefreshToken = refreshTokenDetail.Token
+	return nil
+}
+
+func (u *authUseCase) issueTwoFactorChallenge(ctx context.Context, userId string, session *entity.UserSession) (*model.TokenResponse, error) {
+	challenge := &entity.TwoFactorChallenge{
+		UserId:    userId,
+		Device:    session.Device.String,
+		Platform:  session.Platform.String,
+		IpAddress: session.IpAddress.String,
+		UserAgent: session.UserAgent.String,
 	}
 
-	return converter.UserToResponse(user), tokenResponse, nil
+	jsonValue, err := sonic.ConfigFastest.Marshal(challenge)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to marshal two factor challenge", err)
+	}
+
+	challengeToken := uuid.NewString()
+	if err := u.cacheAdapter.Set(ctx, twoFactorChallengeKey(challengeToken), jsonValue, twoFactorChallengeTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to save two factor challenge", err)
+	}
+
+	return &model.TokenResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    challengeToken,
+	}, nil
+}
+
+// LoginByTwoFactor completes a login that was answered with a two factor challenge.
+// The resulting session starts with a fresh step-up.
+func (u *authUseCase) LoginByTwoFactor(ctx context.Context, request *model.LoginByTwoFactorRequest) (*model.UserResponse, *model.TokenResponse, error) {
+	challengeStr, err := u.cacheAdapter.Get(ctx, twoFactorChallengeKey(request.ChallengeToken))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Two-factor challenge expired, please sign in again")
+		}
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to get two factor challenge", err)
+	}
+
+	challenge := new(entity.TwoFactorChallenge)
+	if err := sonic.ConfigFastest.Unmarshal([]byte(challengeStr), challenge); err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to unmarshal two factor challenge", err)
+	}
+
+	user, err := u.userRepository.FindById(ctx, challenge.UserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, helper.NewUseCaseError(errorcode.ErrUnauthorized, "Two-factor challenge expired, please sign in again")
+		}
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	if user.IsSuspended() {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Account is suspended")
+	}
+
+	if user.HasTwoFactor() {
+		if err := verifySecondFactor(ctx, u.db, u.cacheAdapter, u.securityAdapter, u.totpAdapter, u.recoveryCodeRepo, u.logs, user, request.Code); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := u.cacheAdapter.Del(ctx, twoFactorChallengeKey(request.ChallengeToken)); err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to delete two factor challenge", err)
+	}
+
+	userProfile, err := u.getUserProfile(ctx, user.Id)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid user")
+	}
+
+	auth := &entity.Auth{
+		Id:               user.Id,
+		Username:         user.Username,
+		Email:            user.Email.String,
+		PhoneNumber:      user.PhoneNumber.String,
+		UserProfileID:    userProfile.Id,
+		Similarity:       userProfile.Similarity,
+		Roles:            user.Roles,
+		TwoFactorEnabled: user.HasTwoFactor(),
+	}
+
+	session := newUserSession(model.SessionMetadata{
+		Device:    challenge.Device,
+		IpAddress: challenge.IpAddress,
+		UserAgent: challenge.UserAgent,
+	}, enum.PlatformTypeEnum(challenge.Platform))
+
+	token, err := u.generateToken(ctx, auth, session)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to generate token", err)
+	}
+
+	if err := u.cacheAdapter.Set(ctx, twoFactorStepUpKey(user.Id, session.Id), time.Now().Unix(), twoFactorStepUpTTL); err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to save two factor step up", err)
+	}
+
+	return converter.UserToResponse(user), token, nil
 }
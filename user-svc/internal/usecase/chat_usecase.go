@@ -2,73 +2,167 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"html"
 	"strings"
+	"time"
 
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
 	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/event"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+
+	"github.com/oklog/ulid/v2"
 )
 
 type ChatUseCase interface {
 	GetCustomToken(ctx context.Context, req *model.RequestCustomToken) (*model.CustomTokenResponse, error)
 	GetOrCreateRoom(ctx context.Context, req *model.RequestGetOrCreateRoom) (*model.GetOrCreateRoomResponse, error)
-	SendMessage(ctx context.Context, req *model.RequestSendMessage) error
+	GetRooms(ctx context.Context, req *model.RequestGetChatRooms) ([]*model.ChatRoomResponse, *model.CursorMetadata, error)
+	GetMessages(ctx context.Context, req *model.RequestGetChatMessages) ([]*model.ChatMessageResponse, *model.CursorMetadata, error)
+	SendMessage(ctx context.Context, req *model.RequestSendMessage) (*model.ChatMessageResponse, error)
+	MarkRead(ctx context.Context, req *model.RequestMarkChatRead) (*model.ChatReadResponse, error)
+	CountUnread(ctx context.Context, userId string) (*model.ChatUnreadCountResponse, error)
+	GetMessageImageUrl(ctx context.Context, req *model.RequestGetChatMessageImage) (string, error)
+	NotifyTransactionPaid(ctx context.Context, event *event.OwnerOwnPhotosEvent) error
+	BlockUser(ctx context.Context, req *model.RequestBlockUser) (*model.BlockedUserResponse, error)
+	UnblockUser(ctx context.Context, req *model.RequestUnblockUser) error
+	GetBlockedUsers(ctx context.Context, req *model.RequestGetBlockedUsers) ([]*model.BlockedUserResponse, *model.CursorMetadata, error)
+	ReportChat(ctx context.Context, req *model.RequestReportChat) (*model.ChatReportResponse, error)
 }
 
 type chatUseCase struct {
+	db                    repository.BeginTx
+	userRepository        repository.UserRepository
+	chatRoomRepository    repository.ChatRoomRepository
+	chatMessageRepository repository.ChatMessageRepository
+	userBlockRepository   repository.UserBlockRepository
+	chatReportRepository  repository.ChatReportRepository
 	realtimeChatAdapter   adapter.RealtimeChatAdapter
 	authClientAdapter     adapter.AuthClientAdapter
 	cloudMessagingAdapter adapter.CloudMessagingAdapter
-	perspectiveAdapter    adapter.PerspectiveAdapter
+	moderationAdapter     adapter.ModerationAdapter
+	photoAdapter          adapter.PhotoAdapter
+	uploadAdapter         adapter.UploadAdapter
 	logs                  logger.Log
 }
 
-func NewChatUseCase(realtimeChatAdapter adapter.RealtimeChatAdapter,
-	authClientAdapter adapter.AuthClientAdapter, cloudMessagingAdapter adapter.CloudMessagingAdapter, perspectiveAdapter adapter.PerspectiveAdapter, logs logger.Log) ChatUseCase {
+func NewChatUseCase(db repository.BeginTx, userRepository repository.UserRepository, chatRoomRepository repository.ChatRoomRepository,
+	chatMessageRepository repository.ChatMessageRepository, userBlockRepository repository.UserBlockRepository,
+	chatReportRepository repository.ChatReportRepository, realtimeChatAdapter adapter.RealtimeChatAdapter,
+	authClientAdapter adapter.AuthClientAdapter, cloudMessagingAdapter adapter.CloudMessagingAdapter, moderationAdapter adapter.ModerationAdapter,
+	photoAdapter adapter.PhotoAdapter, uploadAdapter adapter.UploadAdapter, logs logger.Log) ChatUseCase {
 	return &chatUseCase{
+		db:                    db,
+		userRepository:        userRepository,
+		chatRoomRepository:    chatRoomRepository,
+		chatMessageRepository: chatMessageRepository,
+		userBlockRepository:   userBlockRepository,
+		chatReportRepository:  chatReportRepository,
 		realtimeChatAdapter:   realtimeChatAdapter,
 		authClientAdapter:     authClientAdapter,
 		cloudMessagingAdapter: cloudMessagingAdapter,
-		perspectiveAdapter:    perspectiveAdapter,
+		moderationAdapter:     moderationAdapter,
+		photoAdapter:          photoAdapter,
+		uploadAdapter:         uploadAdapter,
 		logs:                  logs,
 	}
 }
 
 func (u *chatUseCase) GetOrCreateRoom(ctx context.Context, req *model.RequestGetOrCreateRoom) (*model.GetOrCreateRoomResponse, error) {
-	roomUserId := generateRoomId(req.SenderId, req.ReceiverId)
-	created := false
-	var roomId string
+	receiver, err := u.userRepository.FindById(ctx, req.ReceiverId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Receiver not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find receiver by id", err)
+	}
+
+	if receiver.IsDeleted() || receiver.IsSuspended() {
+		return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Receiver not found")
+	}
+
+	if err := u.checkNotBlocked(ctx, req.SenderId, []string{req.ReceiverId}); err != nil {
+		return nil, err
+	}
+
+	room, created, err := u.getOrCreateRoom(ctx, req.SenderId, req.ReceiverId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GetOrCreateRoomResponse{
+		RoomId:  room.Id,
+		Created: created,
+	}, nil
+}
+
+// getOrCreateRoom does not check the users, the callers decide who may talk to whom. A pair that only chatted before
+// chat was stored in postgres gets the id of its Firestore room, so the mirrored conversation stays in one room.
+func (u *chatUseCase) getOrCreateRoom(ctx context.Context, userId, peerId string) (*entity.ChatRoom, bool, error) {
+	roomUserId := generateRoomId(userId, peerId)
+	room, err := u.chatRoomRepository.FindByRoomUserId(ctx, u.db, roomUserId)
+	if err == nil {
+		return room, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, helper.WrapInternalServerError(u.logs, "failed to find chat room by room user id", err)
+	}
 
-	docs, err := u.realtimeChatAdapter.GetRoom(ctx, roomUserId)
+	roomId, err := u.realtimeChatAdapter.FindRoomId(ctx, roomUserId)
 	if err != nil {
-		return nil, helper.WrapInternalServerError(u.logs, "failed to query firestore : ", err)
+		return nil, false, helper.WrapInternalServerError(u.logs, "failed to find realtime chat room", err)
+	}
+	if roomId == "" {
+		roomId = ulid.Make().String()
 	}
 
-	if len(docs) > 0 {
-		data := docs[0].Data()
+	now := time.Now()
+	room = &entity.ChatRoom{
+		Id:         roomId,
+		RoomUserId: roomUserId,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+	memberIds := []string{userId, peerId}
 
-		if rn, ok := data["roomId"].(string); ok {
-			roomId = rn
-		} else {
-			return nil, helper.WrapInternalServerError(u.logs, "failed to get roomId : roomId missing in documment : ", err)
+	created := false
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		var err error
+		created, err = u.chatRoomRepository.Create(ctx, tx, room, memberIds)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to create chat room", err)
 		}
-	} else {
-		participants := []string{req.SenderId, req.ReceiverId}
 
-		if err := u.realtimeChatAdapter.CreateRoom(ctx, roomUserId, participants); err != nil {
-			return nil, helper.WrapInternalServerError(u.logs, "failed to create a firestore room : ", err)
+		if !created {
+			room, err = u.chatRoomRepository.FindByRoomUserId(ctx, tx, room.RoomUserId)
+			if err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to find chat room by room user id", err)
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, false, err
+	}
 
-		created = true
+	if created {
+		if err := u.realtimeChatAdapter.CreateRoom(ctx, room, memberIds); err != nil {
+			u.logs.CustomError("failed to fan out created chat room", err)
+		}
 	}
 
-	return &model.GetOrCreateRoomResponse{
-		RoomId:  roomId,
-		Created: created,
-	}, nil
+	return room, created, nil
 }
 
 func generateRoomId(userA, userB string) string {
@@ -91,29 +185,522 @@ func (u *chatUseCase) GetCustomToken(ctx context.Context, req *model.RequestCust
 	return response, nil
 }
 
-func (u *chatUseCase) SendMessage(ctx context.Context, req *model.RequestSendMessage) error {
+// GetRooms lists the rooms of the user from the most recently active with the unread count of each room
+func (u *chatUseCase) GetRooms(ctx context.Context, req *model.RequestGetChatRooms) ([]*model.ChatRoomResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	summaries, cursorMetadata, err := u.chatRoomRepository.FindSummariesByCursor(ctx, u.db, req.UserId, cursor, req.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find chat room summaries", err)
+	}
+
+	return converter.ChatRoomSummariesToResponses(summaries), cursorMetadata, nil
+}
+
+// GetMessages lists the messages of a room from the newest, a room the user is not a member of is not found
+func (u *chatUseCase) GetMessages(ctx context.Context, req *model.RequestGetChatMessages) ([]*model.ChatMessageResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	if _, err := u.findMember(ctx, req.RoomId, req.UserId); err != nil {
+		return nil, nil, err
+	}
+
+	messages, cursorMetadata, err := u.chatMessageRepository.FindByCursor(ctx, u.db, req.RoomId, cursor, req.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find chat messages", err)
+	}
+
+	return converter.ChatMessagesToResponses(messages), cursorMetadata, nil
+}
+
+// SendMessage the message of a photo or an image is an optional caption, only text needs a message
+func (u *chatUseCase) SendMessage(ctx context.Context, req *model.RequestSendMessage) (*model.ChatMessageResponse, error) {
+	if req.Type == "" {
+		req.Type = enum.ChatMessageTypeText
+	}
+
 	trimmed := strings.TrimSpace(req.Message)
-	if trimmed == "" {
-		return helper.NewUseCaseError(errorcode.ErrValidationFailed, "Empty message not allowed")
+	if trimmed == "" && req.Type == enum.ChatMessageTypeText {
+		return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Empty message not allowed")
 	}
 
 	if len(trimmed) > 500 {
-		return helper.NewUseCaseError(errorcode.ErrValidationFailed, "Message too long. Make sure only 500 words")
+		return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Message too long. Make sure only 500 words")
+	}
+
+	if _, err := u.findMember(ctx, req.RoomId, req.SenderId); err != nil {
+		return nil, err
+	}
+
+	peerIds, err := u.findPeerIds(ctx, req.RoomId, req.SenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkNotBlocked(ctx, req.SenderId, peerIds); err != nil {
+		return nil, err
+	}
+
+	if trimmed != "" {
+		isToxic, err := u.moderationAdapter.IsToxicMessage(trimmed)
+		if err != nil {
+			if errors.Is(err, adapter.ErrModerationUnavailable) {
+				return nil, helper.NewUseCaseError(errorcode.ErrExternal, "Message could not be checked, please try again later")
+			}
+			return nil, helper.WrapInternalServerError(u.logs, "failed to check toxic message", err)
+		}
+
+		if isToxic {
+			return nil, helper.NewUseCaseError(errorcode.ErrValidationFailed, "Toxic content detected")
+		}
 	}
 
-	isToxic, err := u.perspectiveAdapter.IsToxicMessage(trimmed)
+	attachment, err := u.buildAttachment(ctx, req)
 	if err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed to check toxic from perpsective api", err)
+		return nil, err
+	}
+
+	now := time.Now()
+	message := &entity.ChatMessage{
+		Id:              ulid.Make().String(),
+		RoomId:          req.RoomId,
+		SenderId:        sql.NullString{String: req.SenderId, Valid: true},
+		ClientMessageId: sql.NullString{String: req.ClientMessageId, Valid: req.ClientMessageId != ""},
+		MessageType:     req.Type,
+		Message:         html.EscapeString(trimmed),
+		Attachment:      attachment,
+		CreatedAt:       &now,
 	}
 
-	if isToxic {
-		return helper.NewUseCaseError(errorcode.ErrValidationFailed, "Toxic content detected")
+	created := false
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		message, created, err = u.chatMessageRepository.Create(ctx, tx, message)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to create chat message", err)
+		}
+
+		if created {
+			if err := u.chatRoomRepository.UpdateLastMessage(ctx, tx, message); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to update chat room last message", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if created {
+		u.fanOut(ctx, message.RoomId, func(memberIds []string) error {
+			return u.realtimeChatAdapter.SendMessage(ctx, message, memberIds)
+		})
+	}
+
+	return converter.ChatMessageToResponse(message), nil
+}
+
+// buildAttachment snapshots what the message shares. A photo is only shared when photo-svc lets the sender see it,
+// so its watermarked preview cannot leak to someone it was never shown to, and an image must be one the sender
+// uploaded to their own chat folder.
+func (u *chatUseCase) buildAttachment(ctx context.Context, req *model.RequestSendMessage) (*json.RawMessage, error) {
+	var attachment any
+	switch req.Type {
+	case enum.ChatMessageTypePhoto:
+		previews, err := u.photoAdapter.GetChatPhotoPreviews(ctx, req.SenderId, []string{req.PhotoId})
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to get chat photo previews from photo service", err)
+		}
+
+		if len(previews) == 0 {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Photo not found")
+		}
+		attachment = previews[0]
+
+	case enum.ChatMessageTypeImage:
+		if !strings.HasPrefix(req.FileKey, chatImageFolder(req.SenderId)) {
+			return nil, helper.NewUseCaseError(errorcode.ErrForbidden, "Image was not uploaded by the sender")
+		}
+
+		file, err := u.uploadAdapter.StatFile(ctx, req.FileKey)
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to stat chat image", err)
+		}
+
+		if file == nil {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Image not found")
+		}
+		attachment = &model.ChatImageAttachment{
+			FileKey:  file.FileKey,
+			Mimetype: file.Mimetype,
+			Size:     file.Size,
+		}
+
+	default:
+		return nil, nil
 	}
 
-	safeMessage := html.EscapeString(trimmed)
-	if err := u.realtimeChatAdapter.SendMessage(ctx, req.RoomId, req.SenderId, safeMessage); err != nil {
-		return helper.WrapInternalServerError(u.logs, "failed to send message to firestore : ", err)
+	return u.marshalAttachment(attachment)
+}
+
+// chatImageFolder must match the folder upload-svc stores chat images in
+func chatImageFolder(userId string) string {
+	return "chat/" + userId + "/"
+}
+
+func (u *chatUseCase) marshalAttachment(attachment any) (*json.RawMessage, error) {
+	attachmentBytes, err := sonic.ConfigFastest.Marshal(attachment)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to marshal chat attachment", err)
+	}
+
+	raw := json.RawMessage(attachmentBytes)
+	return &raw, nil
+}
+
+// MarkRead moves the read marker of the user to the message, marking an older message than the marker changes nothing
+func (u *chatUseCase) MarkRead(ctx context.Context, req *model.RequestMarkChatRead) (*model.ChatReadResponse, error) {
+	member, err := u.findMember(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := u.chatMessageRepository.FindById(ctx, u.db, req.MessageId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find chat message by id", err)
+	}
+
+	if message == nil || message.RoomId != req.RoomId {
+		return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat message not found")
+	}
+
+	moved, err := u.chatRoomRepository.MarkRead(ctx, u.db, req.RoomId, req.UserId, message)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to mark chat room read", err)
+	}
+
+	if moved {
+		member.LastReadMessageId = sql.NullString{String: message.Id, Valid: true}
+		member.LastReadAt = message.CreatedAt
+		u.fanOut(ctx, req.RoomId, func(memberIds []string) error {
+			return u.realtimeChatAdapter.MarkRead(ctx, member, memberIds)
+		})
+	}
+
+	return converter.ChatRoomMemberToReadResponse(member), nil
+}
+
+func (u *chatUseCase) CountUnread(ctx context.Context, userId string) (*model.ChatUnreadCountResponse, error) {
+	count, err := u.chatRoomRepository.CountUnread(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to count unread chat messages", err)
+	}
+
+	return &model.ChatUnreadCountResponse{UnreadCount: count}, nil
+}
+
+// GetMessageImageUrl returns a short lived link of an image message, only the room members can get it
+func (u *chatUseCase) GetMessageImageUrl(ctx context.Context, req *model.RequestGetChatMessageImage) (string, error) {
+	if _, err := u.findMember(ctx, req.RoomId, req.UserId); err != nil {
+		return "", err
+	}
+
+	message, err := u.chatMessageRepository.FindById(ctx, u.db, req.MessageId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", helper.WrapInternalServerError(u.logs, "failed to find chat message by id", err)
+	}
+
+	if message == nil || message.RoomId != req.RoomId || message.MessageType != enum.ChatMessageTypeImage || message.Attachment == nil {
+		return "", helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat image not found")
+	}
+
+	image := new(model.ChatImageAttachment)
+	if err := sonic.ConfigFastest.Unmarshal(*message.Attachment, image); err != nil {
+		return "", helper.WrapInternalServerError(u.logs, "failed to unmarshal chat image attachment", err)
+	}
+
+	url, err := u.uploadAdapter.GetPresignedUrl(ctx, image.FileKey)
+	if err != nil {
+		return "", helper.WrapInternalServerError(u.logs, "failed to get chat image presigned url", err)
+	}
+
+	return url, nil
+}
+
+// NotifyTransactionPaid posts a system message in the room of the buyer with every creator of the transaction. The
+// client message id is derived from the transaction, a settled event delivered again posts nothing new.
+func (u *chatUseCase) NotifyTransactionPaid(ctx context.Context, event *event.OwnerOwnPhotosEvent) error {
+	if event.TransactionId == "" || event.UserId == "" {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid transaction settled event")
+	}
+
+	if len(event.CreatorSales) == 0 {
+		return nil
+	}
+
+	creatorIds := make([]string, 0, len(event.CreatorSales))
+	for _, sale := range event.CreatorSales {
+		creatorIds = append(creatorIds, sale.CreatorId)
+	}
+
+	creatorUserIds, err := u.photoAdapter.GetCreatorUserIds(ctx, creatorIds)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to get creator user ids from photo service", err)
+	}
+
+	for _, sale := range event.CreatorSales {
+		creatorUserId, ok := creatorUserIds[sale.CreatorId]
+		if !ok || creatorUserId == event.UserId {
+			continue
+		}
+
+		blocked, err := u.userBlockRepository.ExistsBetween(ctx, u.db, event.UserId, []string{creatorUserId})
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to check user blocks", err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		room, _, err := u.getOrCreateRoom(ctx, event.UserId, creatorUserId)
+		if err != nil {
+			return err
+		}
+
+		attachment, err := u.marshalAttachment(&model.ChatSystemAttachment{
+			Event:         enum.ChatSystemEventTransactionPaid,
+			TransactionId: event.TransactionId,
+			Amount:        sale.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := u.sendSystemMessage(ctx, room.Id, "transaction-paid:"+event.TransactionId, "Transaction paid", attachment); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func (u *chatUseCase) sendSystemMessage(ctx context.Context, roomId, clientMessageId, text string, attachment *json.RawMessage) error {
+	now := time.Now()
+	message := &entity.ChatMessage{
+		Id:              ulid.Make().String(),
+		RoomId:          roomId,
+		ClientMessageId: sql.NullString{String: clientMessageId, Valid: true},
+		MessageType:     enum.ChatMessageTypeSystem,
+		Message:         text,
+		Attachment:      attachment,
+		CreatedAt:       &now,
+	}
+
+	created := false
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		var err error
+		created, err = u.chatMessageRepository.CreateSystem(ctx, tx, message)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to create chat system message", err)
+		}
+
+		if created {
+			if err := u.chatRoomRepository.UpdateLastMessage(ctx, tx, message); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to update chat room last message", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if created {
+		u.fanOut(ctx, roomId, func(memberIds []string) error {
+			return u.realtimeChatAdapter.SendMessage(ctx, message, memberIds)
+		})
+	}
+
+	return nil
+}
+
+// BlockUser blocking a user again changes nothing, the first block time is kept
+func (u *chatUseCase) BlockUser(ctx context.Context, req *model.RequestBlockUser) (*model.BlockedUserResponse, error) {
+	blockedUser, err := u.userRepository.FindById(ctx, req.BlockedUserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	now := time.Now()
+	block := &entity.UserBlock{
+		BlockerId: req.UserId,
+		BlockedId: req.BlockedUserId,
+		CreatedAt: &now,
+	}
+
+	if _, err := u.userBlockRepository.Create(ctx, u.db, block); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to create user block", err)
+	}
+
+	return &model.BlockedUserResponse{
+		UserId:    blockedUser.Id,
+		Username:  &blockedUser.Username,
+		BlockedAt: block.CreatedAt,
+	}, nil
+}
+
+func (u *chatUseCase) UnblockUser(ctx context.Context, req *model.RequestUnblockUser) error {
+	deleted, err := u.userBlockRepository.Delete(ctx, u.db, req.UserId, req.BlockedUserId)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete user block", err)
+	}
+
+	if !deleted {
+		return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Blocked user not found")
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) GetBlockedUsers(ctx context.Context, req *model.RequestGetBlockedUsers) ([]*model.BlockedUserResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	blockedUsers, cursorMetadata, err := u.userBlockRepository.FindBlockedByCursor(ctx, u.db, req.UserId, cursor, req.Size)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find blocked users", err)
+	}
+
+	return converter.BlockedUsersToResponses(blockedUsers), cursorMetadata, nil
+}
+
+// ReportChat puts the conversation in the moderation queue, a reporter has at most one pending report per room
+func (u *chatUseCase) ReportChat(ctx context.Context, req *model.RequestReportChat) (*model.ChatReportResponse, error) {
+	if _, err := u.findMember(ctx, req.RoomId, req.ReporterId); err != nil {
+		return nil, err
+	}
+
+	peerIds, err := u.findPeerIds(ctx, req.RoomId, req.ReporterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peerIds) == 0 {
+		return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat room not found")
+	}
+	reportedUserId := peerIds[0]
+
+	if req.MessageId != "" {
+		message, err := u.chatMessageRepository.FindById(ctx, u.db, req.MessageId)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to find chat message by id", err)
+		}
+
+		if message == nil || message.RoomId != req.RoomId || message.SenderId.String != reportedUserId {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat message not found")
+		}
+	}
+
+	now := time.Now()
+	report := &entity.ChatReport{
+		Id:             ulid.Make().String(),
+		RoomId:         req.RoomId,
+		ReporterId:     req.ReporterId,
+		ReportedUserId: reportedUserId,
+		MessageId:      nullable.ToSQLStringOmitEmpty(req.MessageId),
+		Reason:         req.Reason,
+		Description:    nullable.ToSQLStringOmitEmpty(strings.TrimSpace(req.Description)),
+		Status:         enum.ChatReportStatusPending,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		created, err := u.chatReportRepository.Create(ctx, tx, report)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to create chat report", err)
+		}
+
+		if !created {
+			return helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Chat room has already been reported")
+		}
+
+		if req.Block {
+			block := &entity.UserBlock{BlockerId: req.ReporterId, BlockedId: reportedUserId, CreatedAt: &now}
+			if _, err := u.userBlockRepository.Create(ctx, tx, block); err != nil {
+				return helper.WrapInternalServerError(u.logs, "failed to create user block", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return converter.ChatReportToResponse(report), nil
+}
+
+// findPeerIds returns the other members of the room
+func (u *chatUseCase) findPeerIds(ctx context.Context, roomId, userId string) ([]string, error) {
+	memberIds, err := u.chatRoomRepository.FindMemberIds(ctx, u.db, roomId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find chat room member ids", err)
+	}
+
+	peerIds := make([]string, 0, len(memberIds))
+	for _, memberId := range memberIds {
+		if memberId != userId {
+			peerIds = append(peerIds, memberId)
+		}
+	}
+
+	return peerIds, nil
+}
+
+// checkNotBlocked gives the same answer to both sides, a blocked user is not told who blocked whom
+func (u *chatUseCase) checkNotBlocked(ctx context.Context, userId string, peerIds []string) error {
+	blocked, err := u.userBlockRepository.ExistsBetween(ctx, u.db, userId, peerIds)
+	if err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to check user blocks", err)
+	}
+
+	if blocked {
+		return helper.NewUseCaseError(errorcode.ErrForbidden, "Chat with this user is not available")
+	}
+
+	return nil
+}
+
+// findMember hides rooms the user is not a member of behind not found
+func (u *chatUseCase) findMember(ctx context.Context, roomId, userId string) (*entity.ChatRoomMember, error) {
+	member, err := u.chatRoomRepository.FindMember(ctx, u.db, roomId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Chat room not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find chat room member", err)
+	}
+
+	return member, nil
+}
+
+// fanOut only logs a failed fan out, the change is already stored and clients catch up through the history
+func (u *chatUseCase) fanOut(ctx context.Context, roomId string, publish func(memberIds []string) error) {
+	memberIds, err := u.chatRoomRepository.FindMemberIds(ctx, u.db, roomId)
+	if err != nil {
+		u.logs.CustomError("failed to find chat room member ids", err)
+		return
+	}
+
+	if err := publish(memberIds); err != nil {
+		u.logs.CustomError("failed to fan out chat event", err)
+	}
+}
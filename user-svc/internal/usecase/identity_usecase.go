@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type IdentityUseCase interface {
+	ListIdentities(ctx context.Context, userId string) ([]*model.UserIdentityResponse, error)
+	LinkIdentity(ctx context.Context, request *model.LinkIdentityRequest) (*model.UserIdentityResponse, error)
+	UnlinkIdentity(ctx context.Context, request *model.UnlinkIdentityRequest) error
+}
+
+type identityUseCase struct {
+	db               repository.BeginTx
+	userRepository   repository.UserRepository
+	userIdentityRepo repository.UserIdentityRepository
+	identityAdapter  adapter.IdentityProviderAdapter
+	logs             logger.Log
+}
+
+func NewIdentityUseCase(db repository.BeginTx, userRepository repository.UserRepository, userIdentityRepo repository.UserIdentityRepository,
+	identityAdapter adapter.IdentityProviderAdapter, logs logger.Log) IdentityUseCase {
+	return &identityUseCase{
+		db:               db,
+		userRepository:   userRepository,
+		userIdentityRepo: userIdentityRepo,
+		identityAdapter:  identityAdapter,
+		logs:             logs,
+	}
+}
+
+func (u *identityUseCase) ListIdentities(ctx context.Context, userId string) ([]*model.UserIdentityResponse, error) {
+	identities, err := u.userIdentityRepo.FindAllByUserId(ctx, u.db, userId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user identities", err)
+	}
+
+	return converter.UserIdentitiesToResponses(identities), nil
+}
+
+// LinkIdentity attaches a provider account to the signed in user. This is the only way an
+// existing account gets a provider identity, sign in never links by email.
+func (u *identityUseCase) LinkIdentity(ctx context.Context, request *model.LinkIdentityRequest) (*model.UserIdentityResponse, error) {
+	if !u.identityAdapter.IsSupported(request.Provider) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Unsupported identity provider")
+	}
+
+	claims, err := u.identityAdapter.VerifyIdToken(ctx, request.Provider, request.Token)
+	if err != nil {
+		u.logs.CustomError("failed to verify identity token", err)
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid identity token")
+	}
+
+	identity, err := u.userIdentityRepo.FindByProviderAndSubject(ctx, u.db, claims.Provider, claims.Subject)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user identity", err)
+	}
+
+	if identity != nil {
+		if identity.UserId == request.UserId {
+			return converter.UserIdentityToResponse(identity), nil
+		}
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "This account is already linked to another user")
+	}
+
+	identities, err := u.userIdentityRepo.FindAllByUserId(ctx, u.db, request.UserId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user identities", err)
+	}
+
+	for _, linked := range identities {
+		if linked.Provider == claims.Provider {
+			return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Another account of this provider is already linked")
+		}
+	}
+
+	now := time.Now()
+	identity = &entity.UserIdentity{
+		Id:        ulid.Make().String(),
+		UserId:    request.UserId,
+		Provider:  claims.Provider,
+		Subject:   claims.Subject,
+		Email:     nullable.ToSQLStringOmitEmpty(claims.Email),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	if _, err := u.userIdentityRepo.Create(ctx, u.db, identity); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to create user identity", err)
+	}
+
+	return converter.UserIdentityToResponse(identity), nil
+}
+
+// UnlinkIdentity refuses to remove the last way the user can sign in
+func (u *identityUseCase) UnlinkIdentity(ctx context.Context, request *model.UnlinkIdentityRequest) error {
+	user, err := u.userRepository.FindById(ctx, request.UserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helper.NewUseCaseError(errorcode.ErrUserNotFound, "User not found")
+		}
+		return helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	return repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		identities, err := u.userIdentityRepo.FindAllByUserId(ctx, tx, request.UserId)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to find user identities", err)
+		}
+
+		linked := false
+		for _, identity := range identities {
+			if identity.Provider == request.Provider {
+				linked = true
+				break
+			}
+		}
+
+		if !linked {
+			return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Identity provider is not linked")
+		}
+
+		hasOtherSignIn := user.Password.Valid || user.HasVerifiedPhoneNumber() || len(identities) > 1
+		if !hasOtherSignIn {
+			return helper.NewUseCaseError(errorcode.ErrForbidden, "Set a password or link another provider before unlinking this one")
+		}
+
+		if _, err := u.userIdentityRepo.DeleteByUserIdAndProvider(ctx, tx, request.UserId, request.Provider); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete user identity", err)
+		}
+
+		return nil
+	})
+}
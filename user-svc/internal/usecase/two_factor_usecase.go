@@ -0,0 +1,424 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+type TwoFactorUseCase interface {
+	GetStatus(ctx context.Context, userId string) (*model.TwoFactorStatusResponse, error)
+	Enroll(ctx context.Context, userId string) (*model.TwoFactorEnrollResponse, error)
+	Enable(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error)
+	Disable(ctx context.Context, request *model.TwoFactorCodeRequest) error
+	RegenerateRecoveryCodes(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error)
+	StepUp(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.StepUpResponse, error)
+}
+
+const (
+	twoFactorEnrollTTL        = 10 * time.Minute
+	twoFactorChallengeTTL     = 5 * time.Minute
+	twoFactorStepUpTTL        = 5 * time.Minute
+	twoFactorAttemptWindow    = 5 * time.Minute
+	twoFactorMaxAttempts      = 5
+	twoFactorUsedCodeTTL      = 90 * time.Second
+	twoFactorRecoveryCodeSize = 10
+)
+
+func twoFactorEnrollKey(userId string) string {
+	return "2fa_enroll:" + userId
+}
+
+func twoFactorChallengeKey(challengeToken string) string {
+	return "2fa_challenge:" + challengeToken
+}
+
+func twoFactorAttemptKey(userId string) string {
+	return "2fa_attempt:" + userId
+}
+
+func twoFactorUsedCodeKey(userId, code string) string {
+	return fmt.Sprintf("2fa_used:%s:%s", userId, code)
+}
+
+// twoFactorStepUpKey is bound to the session so a step-up on one device does not
+// unlock sensitive actions on another. Tokens issued before sessions existed fall
+// back to the user id.
+func twoFactorStepUpKey(userId, sessionId string) string {
+	if sessionId == "" {
+		return "2fa_step_up:user:" + userId
+	}
+	return "2fa_step_up:" + sessionId
+}
+
+type twoFactorUseCase struct {
+	db                         repository.BeginTx
+	userRepository             repository.UserRepository
+	userRecoveryCodeRepository repository.UserRecoveryCodeRepository
+	totpAdapter                adapter.TOTPAdapter
+	securityAdapter            adapter.SecurityAdapter
+	cacheAdapter               adapter.CacheAdapter
+	logs                       logger.Log
+}
+
+func NewTwoFactorUseCase(db repository.BeginTx, userRepository repository.UserRepository,
+	userRecoveryCodeRepository repository.UserRecoveryCodeRepository, totpAdapter adapter.TOTPAdapter,
+	securityAdapter adapter.SecurityAdapter, cacheAdapter adapter.CacheAdapter, logs logger.Log) TwoFactorUseCase {
+	return &twoFactorUseCase{
+		db:                         db,
+		userRepository:             userRepository,
+		userRecoveryCodeRepository: userRecoveryCodeRepository,
+		totpAdapter:                totpAdapter,
+		securityAdapter:            securityAdapter,
+		cacheAdapter:               cacheAdapter,
+		logs:                       logs,
+	}
+}
+
+func (u *twoFactorUseCase) findUser(ctx context.Context, userId string) (*entity.User, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrUserNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	return user, nil
+}
+
+func (u *twoFactorUseCase) GetStatus(ctx context.Context, userId string) (*model.TwoFactorStatusResponse, error) {
+	user, err := u.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.TwoFactorStatusResponse{
+		Enabled: user.HasTwoFactor(),
+	}
+
+	if response.Enabled {
+		total, err := u.userRecoveryCodeRepository.CountUnusedByUserId(ctx, u.db, user.Id)
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to count unused recovery codes", err)
+		}
+		response.EnabledAt = user.TwoFactorEnabledAt
+		response.RecoveryCodesRemaining = total
+	}
+
+	return response, nil
+}
+
+func (u *twoFactorUseCase) Enroll(ctx context.Context, userId string) (*model.TwoFactorEnrollResponse, error) {
+	user, err := u.findUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasTwoFactor() {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Two-factor authentication is already enabled")
+	}
+
+	secret, err := u.totpAdapter.GenerateSecret()
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to generate totp secret", err)
+	}
+
+	encryptedSecret, err := u.securityAdapter.Encrypt(secret)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to encrypt totp secret", err)
+	}
+
+	// The secret only becomes active once the user proves the authenticator works
+	if err := u.cacheAdapter.Set(ctx, twoFactorEnrollKey(user.Id), encryptedSecret, twoFactorEnrollTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to save pending totp secret", err)
+	}
+
+	accountName := user.Username
+	if user.HasEmail() {
+		accountName = user.Email.String
+	}
+
+	return &model.TwoFactorEnrollResponse{
+		Secret:     secret,
+		OtpauthUrl: u.totpAdapter.KeyURI(accountName, secret),
+		ExpiresIn:  int(twoFactorEnrollTTL.Seconds()),
+	}, nil
+}
+
+func (u *twoFactorUseCase) Enable(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.HasTwoFactor() {
+		return nil, helper.NewUseCaseError(errorcode.ErrAlreadyExists, "Two-factor authentication is already enabled")
+	}
+
+	encryptedSecret, err := u.cacheAdapter.Get(ctx, twoFactorEnrollKey(user.Id))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Two-factor enrollment expired or was never started")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to get pending totp secret", err)
+	}
+
+	if err := countTwoFactorAttempt(ctx, u.cacheAdapter, u.logs, user.Id); err != nil {
+		return nil, err
+	}
+
+	secret, err := u.securityAdapter.Decrypt(encryptedSecret)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to decrypt pending totp secret", err)
+	}
+
+	if !u.totpAdapter.Validate(secret, normalizeTwoFactorCode(request.Code), time.Now()) {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid two-factor code")
+	}
+
+	now := time.Now()
+	user.TotpSecret = sql.NullString{Valid: true, String: encryptedSecret}
+	user.TwoFactorEnabledAt = &now
+	user.UpdatedAt = &now
+
+	var recoveryCodes []string
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.userRepository.UpdateTwoFactor(ctx, tx, user); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to enable two factor", err)
+		}
+
+		recoveryCodes, err = u.replaceRecoveryCodes(ctx, tx, user.Id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := u.cacheAdapter.Del(ctx, twoFactorEnrollKey(user.Id), twoFactorAttemptKey(user.Id), user.Id); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to delete pending totp secret and auth cache", err)
+	}
+
+	return &model.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (u *twoFactorUseCase) Disable(ctx context.Context, request *model.TwoFactorCodeRequest) error {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return err
+	}
+
+	if !user.HasTwoFactor() {
+		return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Two-factor authentication is not enabled")
+	}
+
+	if err := verifySecondFactor(ctx, u.db, u.cacheAdapter, u.securityAdapter, u.totpAdapter, u.userRecoveryCodeRepository, u.logs, user, request.Code); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.TotpSecret = sql.NullString{}
+	user.TwoFactorEnabledAt = nil
+	user.UpdatedAt = &now
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.userRepository.UpdateTwoFactor(ctx, tx, user); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to disable two factor", err)
+		}
+
+		if err := u.userRecoveryCodeRepository.DeleteByUserId(ctx, tx, user.Id); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete recovery codes", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := u.cacheAdapter.Del(ctx, user.Id, twoFactorStepUpKey(user.Id, request.SessionId)); err != nil {
+		return helper.WrapInternalServerError(u.logs, "failed to delete auth cache", err)
+	}
+
+	return nil
+}
+
+func (u *twoFactorUseCase) RegenerateRecoveryCodes(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.RecoveryCodesResponse, error) {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasTwoFactor() {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Two-factor authentication is not enabled")
+	}
+
+	if err := verifySecondFactor(ctx, u.db, u.cacheAdapter, u.securityAdapter, u.totpAdapter, u.userRecoveryCodeRepository, u.logs, user, request.Code); err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		recoveryCodes, err = u.replaceRecoveryCodes(ctx, tx, user.Id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &model.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// StepUp marks the current session as freshly verified, sensitive actions in
+// other services only go through while the mark is alive.
+func (u *twoFactorUseCase) StepUp(ctx context.Context, request *model.TwoFactorCodeRequest) (*model.StepUpResponse, error) {
+	user, err := u.findUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasTwoFactor() {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Two-factor authentication is not enabled")
+	}
+
+	if err := verifySecondFactor(ctx, u.db, u.cacheAdapter, u.securityAdapter, u.totpAdapter, u.userRecoveryCodeRepository, u.logs, user, request.Code); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := u.cacheAdapter.Set(ctx, twoFactorStepUpKey(user.Id, request.SessionId), now.Unix(), twoFactorStepUpTTL); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to save two factor step up", err)
+	}
+
+	return &model.StepUpResponse{
+		VerifiedAt: now,
+		ExpiresIn:  int(twoFactorStepUpTTL.Seconds()),
+	}, nil
+}
+
+func (u *twoFactorUseCase) replaceRecoveryCodes(ctx context.Context, tx repository.TransactionTx, userId string) ([]string, error) {
+	if err := u.userRecoveryCodeRepository.DeleteByUserId(ctx, tx, userId); err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to delete recovery codes", err)
+	}
+
+	now := time.Now()
+	recoveryCodes := make([]string, 0, twoFactorRecoveryCodeSize)
+	for i := 0; i < twoFactorRecoveryCodeSize; i++ {
+		code, err := helper.GenerateRecoveryCode()
+		if err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to generate recovery code", err)
+		}
+
+		recoveryCode := &entity.UserRecoveryCode{
+			Id:        ulid.Make().String(),
+			UserId:    userId,
+			CodeHash:  u.securityAdapter.Hash(normalizeTwoFactorCode(code)),
+			CreatedAt: &now,
+		}
+
+		if _, err := u.userRecoveryCodeRepository.Create(ctx, tx, recoveryCode); err != nil {
+			return nil, helper.WrapInternalServerError(u.logs, "failed to create recovery code", err)
+		}
+
+		recoveryCodes = append(recoveryCodes, code)
+	}
+
+	return recoveryCodes, nil
+}
+
+func normalizeTwoFactorCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code. It is
+// shared by the login challenge and the authenticated 2FA endpoints so both are
+// covered by the same attempt limit.
+func verifySecondFactor(ctx context.Context, db repository.BeginTx, cacheAdapter adapter.CacheAdapter,
+	securityAdapter adapter.SecurityAdapter, totpAdapter adapter.TOTPAdapter,
+	userRecoveryCodeRepository repository.UserRecoveryCodeRepository, logs logger.Log, user *entity.User, code string) error {
+	if err := countTwoFactorAttempt(ctx, cacheAdapter, logs, user.Id); err != nil {
+		return err
+	}
+
+	code = normalizeTwoFactorCode(code)
+	if isTOTPCode(code) {
+		secret, err := securityAdapter.Decrypt(user.TotpSecret.String)
+		if err != nil {
+			return helper.WrapInternalServerError(logs, "failed to decrypt totp secret", err)
+		}
+
+		if !totpAdapter.Validate(secret, code, time.Now()) {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid two-factor code")
+		}
+
+		// A TOTP code stays valid for its whole window, refuse to accept it twice
+		fresh, err := cacheAdapter.SetNX(ctx, twoFactorUsedCodeKey(user.Id, code), 1, twoFactorUsedCodeTTL)
+		if err != nil {
+			return helper.WrapInternalServerError(logs, "failed to save used totp code", err)
+		}
+
+		if !fresh {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Two-factor code has already been used")
+		}
+	} else {
+		consumed, err := userRecoveryCodeRepository.Consume(ctx, db, user.Id, securityAdapter.Hash(code), time.Now())
+		if err != nil {
+			return helper.WrapInternalServerError(logs, "failed to consume recovery code", err)
+		}
+
+		if !consumed {
+			return helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid two-factor code")
+		}
+	}
+
+	if err := cacheAdapter.Del(ctx, twoFactorAttemptKey(user.Id)); err != nil {
+		return helper.WrapInternalServerError(logs, "failed to delete two factor attempt", err)
+	}
+
+	return nil
+}
+
+// countTwoFactorAttempt counts a code check against the attempt limit of the user, the
+// counter is only cleared by a successful check so guessing is capped per window.
+func countTwoFactorAttempt(ctx context.Context, cacheAdapter adapter.CacheAdapter, logs logger.Log, userId string) error {
+	attemptKey := twoFactorAttemptKey(userId)
+	attempt, err := cacheAdapter.Incr(ctx, attemptKey)
+	if err != nil {
+		return helper.WrapInternalServerError(logs, "failed to increment two factor attempt", err)
+	}
+
+	if attempt == 1 {
+		if err := cacheAdapter.Expire(ctx, attemptKey, twoFactorAttemptWindow); err != nil {
+			return helper.WrapInternalServerError(logs, "failed to set two factor attempt expiration", err)
+		}
+	}
+
+	if attempt > twoFactorMaxAttempts {
+		return helper.NewUseCaseError(errorcode.ErrTooManyRequests, "Too many invalid two-factor attempts, please try again later")
+	}
+
+	return nil
+}
@@ -6,19 +6,25 @@ import (
 	"errors"
 	"fmt"
 	"mime/multipart"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
 	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/gateway/producer"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/nullable"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/converter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model/event"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
 
 	"github.com/oklog/ulid/v2"
@@ -26,35 +32,50 @@ import (
 
 type UserUseCase interface {
 	GetPublicUserChat(ctx context.Context, request *model.RequestGetAllPublicUser) (*[]*model.GetAllPublicUserResponse, *model.PageMetadata, error)
+	GetPublicUserChatByCursor(ctx context.Context, request *model.RequestGetAllPublicUserByCursor) (*[]*model.GetAllPublicUserResponse, *model.CursorMetadata, error)
 	GetUserProfile(ctx context.Context, userId string) (*model.UserProfileResponse, error)
-	UploadUserCoverImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (string, error)
+	GetPublicUserProfile(ctx context.Context, userId string) (*model.PublicUserProfileResponse, error)
+	GetUserContacts(ctx context.Context, userIds []string) ([]*model.UserContactResponse, error)
+	ListSegmentUserIds(ctx context.Context, request *model.ListSegmentUserIdsRequest) ([]string, error)
+	UploadUserCoverImage(ctx context.Context, file *multipart.FileHeader, userId, userProfId string) (string, error)
 	UpdateUserProfile(ctx context.Context, request *model.RequestUpdateUserProfile) (*model.UserProfileResponse, error)
-	UploadUserProfileImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (string, error)
+	UploadUserProfileImage(ctx context.Context, file *multipart.FileHeader, userId, userProfId string) (string, error)
 	UpdateUserSimilarity(ctx context.Context, request *model.RequestUpdateSimilarity) (*model.UpdateSeimilarityResponse, error)
 	UpdateHasFacecam(ctx context.Context, userId string) error
+	GetActiveSocialMedias(ctx context.Context) (*[]*model.SocialMediaResponse, error)
+	GetSocialLinks(ctx context.Context, userProfileId string) ([]*model.SocialLinkResponse, error)
+	SetSocialLink(ctx context.Context, request *model.RequestSetSocialLink) (*model.SocialLinkResponse, error)
+	DeleteSocialLink(ctx context.Context, request *model.RequestDeleteSocialLink) error
 }
 
 type userUseCase struct {
-	db                    repository.BeginTx
-	userRepository        repository.UserRepository
-	userProfileRepository repository.UserProfileRepository
-	userImageRepository   repository.UserImageRepository
-	uploadAdapter         adapter.UploadAdapter
-	cacheAdapter          adapter.CacheAdapter
-	logs                  logger.Log
+	db                       repository.BeginTx
+	userRepository           repository.UserRepository
+	userProfileRepository    repository.UserProfileRepository
+	userImageRepository      repository.UserImageRepository
+	socialMediaRepository    repository.SocialMediaRepository
+	userSocialLinkRepository repository.UserSocialLinkRepository
+	uploadAdapter            adapter.UploadAdapter
+	cacheAdapter             adapter.CacheAdapter
+	userProducer             producer.UserProducer
+	logs                     logger.Log
 }
 
 func NewUserUseCase(db repository.BeginTx, userRepository repository.UserRepository, userProfileRepository repository.UserProfileRepository,
-	userImageRepository repository.UserImageRepository, uploadAdapter adapter.UploadAdapter, cacheAdapter adapter.CacheAdapter,
-	logs logger.Log) UserUseCase {
+	userImageRepository repository.UserImageRepository, socialMediaRepository repository.SocialMediaRepository,
+	userSocialLinkRepository repository.UserSocialLinkRepository, uploadAdapter adapter.UploadAdapter, cacheAdapter adapter.CacheAdapter,
+	userProducer producer.UserProducer, logs logger.Log) UserUseCase {
 	return &userUseCase{
-		db:                    db,
-		userRepository:        userRepository,
-		userProfileRepository: userProfileRepository,
-		userImageRepository:   userImageRepository,
-		uploadAdapter:         uploadAdapter,
-		cacheAdapter:          cacheAdapter,
-		logs:                  logs,
+		db:                       db,
+		userRepository:           userRepository,
+		userProfileRepository:    userProfileRepository,
+		userImageRepository:      userImageRepository,
+		socialMediaRepository:    socialMediaRepository,
+		userSocialLinkRepository: userSocialLinkRepository,
+		uploadAdapter:            uploadAdapter,
+		cacheAdapter:             cacheAdapter,
+		userProducer:             userProducer,
+		logs:                     logs,
 	}
 }
 
@@ -78,6 +99,7 @@ func (u *userUseCase) UpdateUserProfile(ctx context.Context, request *model.Requ
 		return nil, err
 	}
 
+	u.produceUserProfileUpdated(ctx, request.UserId)
 	return converter.UserProfileToResponse(userProfile), nil
 }
 
@@ -87,6 +109,34 @@ func (u *userUseCase) GetPublicUserChat(ctx context.Context, request *model.Requ
 		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find all public chat (user list)", err)
 	}
 
+	responses, err := u.publicUserChatToResponses(ctx, userPublicChat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return responses, pageMetadata, nil
+}
+
+func (u *userUseCase) GetPublicUserChatByCursor(ctx context.Context, request *model.RequestGetAllPublicUserByCursor) (*[]*model.GetAllPublicUserResponse, *model.CursorMetadata, error) {
+	cursor, err := pagination.DecodeCursor(request.Cursor)
+	if err != nil {
+		return nil, nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid cursor")
+	}
+
+	userPublicChat, cursorMetadata, err := u.userRepository.FindAllPublicChatByCursor(ctx, u.db, cursor, request.Size, request.Username)
+	if err != nil {
+		return nil, nil, helper.WrapInternalServerError(u.logs, "failed to find all public chat (user list) by cursor", err)
+	}
+
+	responses, err := u.publicUserChatToResponses(ctx, userPublicChat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return responses, cursorMetadata, nil
+}
+
+func (u *userUseCase) publicUserChatToResponses(ctx context.Context, userPublicChat []*entity.UserPublicChat) (*[]*model.GetAllPublicUserResponse, error) {
 	responses := make([]*model.GetAllPublicUserResponse, 0)
 
 	for _, entity := range userPublicChat {
@@ -98,7 +148,7 @@ func (u *userUseCase) GetPublicUserChat(ctx context.Context, request *model.Requ
 		if entity.FileKey.Valid {
 			profileUrl, err := u.uploadAdapter.GetPresignedUrl(ctx, entity.FileKey.String)
 			if err != nil {
-				return nil, nil, helper.WrapInternalServerError(u.logs, "failed to get presigned url", err)
+				return nil, helper.WrapInternalServerError(u.logs, "failed to get presigned url", err)
 			}
 			response.ProfileUrl = profileUrl
 		}
@@ -106,7 +156,7 @@ func (u *userUseCase) GetPublicUserChat(ctx context.Context, request *model.Requ
 		responses = append(responses, response)
 	}
 
-	return &responses, pageMetadata, nil
+	return &responses, nil
 }
 
 func (u *userUseCase) UpdateUserSimilarity(ctx context.Context, request *model.RequestUpdateSimilarity) (*model.UpdateSeimilarityResponse, error) {
@@ -150,21 +200,23 @@ func (u *userUseCase) UpdateUserSimilarity(ctx context.Context, request *model.R
 	}, nil
 }
 
-func (u *userUseCase) UploadUserProfileImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (string, error) {
+func (u *userUseCase) UploadUserProfileImage(ctx context.Context, file *multipart.FileHeader, userId, userProfId string) (string, error) {
 	profileURL, err := u.updateUserImage(ctx, file, userProfId, enum.ImageTypeProfile)
 	if err != nil {
 		return "", err
 	}
 
+	u.produceUserProfileUpdated(ctx, userId)
 	return profileURL, err
 }
 
-func (u *userUseCase) UploadUserCoverImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (string, error) {
+func (u *userUseCase) UploadUserCoverImage(ctx context.Context, file *multipart.FileHeader, userId, userProfId string) (string, error) {
 	profileCoverURL, err := u.updateUserImage(ctx, file, userProfId, enum.ImageTypeCover)
 	if err != nil {
 		return "", err
 	}
 
+	u.produceUserProfileUpdated(ctx, userId)
 	return profileCoverURL, err
 }
 
@@ -254,7 +306,84 @@ func (u *userUseCase) GetUserProfile(ctx context.Context, userId string) (*model
 		return nil, helper.WrapInternalServerError(u.logs, "failed to find user profile by user id", err)
 	}
 
-	return converter.UserProfileToResponse(userProfile), nil
+	socialLinks, err := u.userSocialLinkRepository.FindAllActiveByUserProfileId(ctx, u.db, userProfile.Id)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user social links", err)
+	}
+
+	response := converter.UserProfileToResponse(userProfile)
+	response.SocialLinks = converter.SocialLinksToResponses(socialLinks)
+	return response, nil
+}
+
+func (u *userUseCase) GetPublicUserProfile(ctx context.Context, userId string) (*model.PublicUserProfileResponse, error) {
+	user, err := u.userRepository.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "User not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user by id", err)
+	}
+
+	userProfile, err := u.userProfileRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "User profile not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user profile by user id", err)
+	}
+
+	socialLinks, err := u.userSocialLinkRepository.FindAllActiveByUserProfileId(ctx, u.db, userProfile.Id)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user social links", err)
+	}
+
+	response := converter.ToPublicUserProfileResponse(user, userProfile)
+	response.SocialLinks = converter.SocialLinksToResponses(socialLinks)
+	return response, nil
+}
+
+// GetUserContacts returns the email contact of the given users, users without a verified
+// email are left out so other services never mail an unconfirmed address.
+func (u *userUseCase) GetUserContacts(ctx context.Context, userIds []string) ([]*model.UserContactResponse, error) {
+	if len(userIds) == 0 {
+		return []*model.UserContactResponse{}, nil
+	}
+
+	users, err := u.userRepository.FindManyWithVerifiedEmail(ctx, u.db, userIds)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find users with verified email", err)
+	}
+
+	return converter.UsersToContactResponses(users), nil
+}
+
+// maxSegmentUserPage caps a page of segment user ids, campaigns page through bigger segments
+const maxSegmentUserPage = 1000
+
+// ListSegmentUserIds pages through the users of an audience segment, used by campaigns to resolve their audience
+func (u *userUseCase) ListSegmentUserIds(ctx context.Context, request *model.ListSegmentUserIdsRequest) ([]string, error) {
+	switch request.Segment {
+	case enum.AudienceSegmentAll, enum.AudienceSegmentFacecam:
+	case enum.AudienceSegmentInactive:
+		if request.InactiveDays <= 0 {
+			return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Inactive days must be positive")
+		}
+	default:
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Invalid audience segment")
+	}
+
+	if request.Limit <= 0 || request.Limit > maxSegmentUserPage {
+		request.Limit = maxSegmentUserPage
+	}
+
+	inactiveSince := time.Now().AddDate(0, 0, -request.InactiveDays)
+	userIds, err := u.userRepository.FindIdsBySegment(ctx, u.db, request.Segment, inactiveSince, request.AfterUserId, request.Limit)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find segment user ids", err)
+	}
+
+	return userIds, nil
 }
 
 func (u *userUseCase) UpdateHasFacecam(ctx context.Context, userId string) error {
@@ -270,3 +399,151 @@ func (u *userUseCase) UpdateHasFacecam(ctx context.Context, userId string) error
 
 	return nil
 }
+
+func (u *userUseCase) GetActiveSocialMedias(ctx context.Context) (*[]*model.SocialMediaResponse, error) {
+	socialMedias, err := u.socialMediaRepository.FindAllActive(ctx)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find all active social media", err)
+	}
+
+	return converter.SocialMediasToResponses(socialMedias), nil
+}
+
+func (u *userUseCase) GetSocialLinks(ctx context.Context, userProfileId string) ([]*model.SocialLinkResponse, error) {
+	socialLinks, err := u.userSocialLinkRepository.FindAllActiveByUserProfileId(ctx, u.db, userProfileId)
+	if err != nil {
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find user social links", err)
+	}
+
+	return converter.SocialLinksToResponses(socialLinks), nil
+}
+
+// SetSocialLink creates or replaces the handle of the user on a social media
+func (u *userUseCase) SetSocialLink(ctx context.Context, request *model.RequestSetSocialLink) (*model.SocialLinkResponse, error) {
+	socialMedia, err := u.socialMediaRepository.FindById(ctx, request.SocialMediaId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Social media not found")
+		}
+		return nil, helper.WrapInternalServerError(u.logs, "failed to find social media by id", err)
+	}
+
+	if !socialMedia.IsActive {
+		return nil, helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Social media is not available")
+	}
+
+	handle, err := normalizeSocialHandle(socialMedia, request.Handle)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	socialLink := &entity.UserSocialLink{
+		UserProfileId: request.UserProfileId,
+		SocialMediaId: socialMedia.Id,
+		Handle:        handle,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		if _, err := u.userSocialLinkRepository.Upsert(ctx, tx, socialLink); err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to upsert user social link", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	u.produceUserProfileUpdated(ctx, request.UserId)
+
+	return converter.SocialLinkToResponse(&entity.UserSocialLinkDetail{
+		SocialMediaId: socialMedia.Id,
+		Name:          socialMedia.Name,
+		BaseUrl:       socialMedia.BaseUrl,
+		LogoUrl:       socialMedia.LogoUrl,
+		Handle:        socialLink.Handle,
+		CreatedAt:     socialLink.CreatedAt,
+		UpdatedAt:     socialLink.UpdatedAt,
+	}), nil
+}
+
+func (u *userUseCase) DeleteSocialLink(ctx context.Context, request *model.RequestDeleteSocialLink) error {
+	if err := repository.BeginTransaction(ctx, u.logs, u.db, func(tx repository.TransactionTx) error {
+		deleted, err := u.userSocialLinkRepository.Delete(ctx, tx, request.UserProfileId, request.SocialMediaId)
+		if err != nil {
+			return helper.WrapInternalServerError(u.logs, "failed to delete user social link", err)
+		}
+
+		if !deleted {
+			return helper.NewUseCaseError(errorcode.ErrResourceNotFound, "Social link not found")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	u.produceUserProfileUpdated(ctx, request.UserId)
+	return nil
+}
+
+// produceUserProfileUpdated runs after the change is committed, a failed publish is only logged
+// since the cached copies in other services still expire on their own.
+func (u *userUseCase) produceUserProfileUpdated(ctx context.Context, userId string) {
+	now := time.Now()
+	userProfileUpdatedEvent := &event.UserProfileUpdatedEvent{
+		Id:        userId,
+		UpdatedAt: &now,
+	}
+
+	if err := u.userProducer.ProduceUserProfileUpdated(ctx, userProfileUpdatedEvent); err != nil {
+		u.logs.CustomError("failed to produce user profile updated event", err)
+	}
+}
+
+var socialHandlePattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]{1,100}$`)
+
+// normalizeSocialHandle accepts either a bare handle or a full link and returns the
+// handle to store. A full link has to point under the base url of the social media,
+// social medias without a base url accept any http(s) link as is.
+func normalizeSocialHandle(socialMedia *entity.SocialMedia, input string) (string, error) {
+	input = strings.TrimSpace(input)
+
+	if !socialMedia.BaseUrl.Valid || socialMedia.BaseUrl.String == "" {
+		link, err := url.ParseRequestURI(input)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Social link must be a valid http or https url")
+		}
+		return input, nil
+	}
+
+	baseUrl, err := url.Parse(socialMedia.BaseUrl.String)
+	if err != nil {
+		return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Social media has an invalid base url")
+	}
+	basePath := strings.TrimSuffix(baseUrl.Path, "/")
+
+	handle := input
+	if strings.Contains(input, "://") {
+		link, err := url.Parse(input)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+			return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument, "Social link must be a valid http or https url")
+		}
+
+		if !strings.EqualFold(strings.TrimPrefix(link.Hostname(), "www."), strings.TrimPrefix(baseUrl.Hostname(), "www.")) ||
+			!strings.HasPrefix(link.Path, basePath) {
+			return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument,
+				fmt.Sprintf("Social link must start with %s", socialMedia.BaseUrl.String))
+		}
+
+		handle = strings.Trim(strings.TrimPrefix(link.Path, basePath), "/")
+	}
+
+	handle = strings.TrimPrefix(handle, "@")
+	if !socialHandlePattern.MatchString(handle) {
+		return "", helper.NewUseCaseError(errorcode.ErrInvalidArgument,
+			"Social handle may only contain letters, numbers, underscores, dots and dashes")
+	}
+
+	return handle, nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/route"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/websocket"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
 	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/discovery"
 
@@ -91,18 +92,32 @@ func webServer(ctx context.Context) error {
 	go startHealthCheckLoop(ctx, registry, HTTPserviceID, serverConfig.Name+"-http")
 
 	cacheAdapter := adapter.NewCacheAdapter(redisConfig)
+	rateLimiterAdapter := adapter.NewRateLimiterAdapter(redisConfig)
 	emailAdapter := adapter.NewEmailAdapter()
-	googleTokenAdapter := adapter.NewGoogleTokenAdapter()
+	identityProviderAdapter := adapter.NewIdentityProviderAdapter()
 	jwtAdapter := adapter.NewJWTAdapter()
 	securityAdapter := adapter.NewSecurityAdapter()
 	uploadAdapter := adapter.NewUploadAdapter(minioConfig, redisConfig)
-	realtimeChatAdapter := adapter.NewRealtimeChatAdapter(ctx, firebaseConfig, logs)
+	realtimeChatAdapter := adapter.NewRealtimeChatAdapter(ctx, firebaseConfig, redisConfig, logs)
+	smsAdapter := adapter.NewSmsAdapter(logs)
+	totpAdapter := adapter.NewTOTPAdapter()
 	authClientAdapter := adapter.NewAuthClientAdapter(firebaseConfig)
 	cloudMessagingAdapter := adapter.NewCloudMessagingAdapter(firebaseConfig)
 	perspectiveAdapter := adapter.NewPerspectiveAdapter()
-	// photoAdapter, _ := adapter.NewPhotoAdapter(ctx, registry, logs)
-	// transactionAdapter, _ := adapter.NewTransactionAdapter(ctx, registry, logs)
+	moderationAdapter := adapter.NewModerationAdapter(perspectiveAdapter, logs)
 	messagingAdapter := adapter.NewMessagingAdapter(jetStreamConfig)
+	notificationAdapter, err := adapter.NewNotificationAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+	photoAdapter, err := adapter.NewPhotoAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
+	transactionAdapter, err := adapter.NewTransactionAdapter(ctx, registry, logs)
+	if err != nil {
+		return err
+	}
 
 	userProducer := producer.NewUserProducer(messagingAdapter, logs)
 	databaseAdapter := repository.NewDatabaseAdapter(dbConfig)
@@ -131,28 +146,64 @@ func webServer(ctx context.Context) error {
 		log.Fatalf(err.Error())
 	}
 
-	userDeviceRepository := repository.NewUserDeviceRepository()
+	socialMediaRepository, err := repository.NewSocialMediaRepository(dbConfig)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	userSessionRepository := repository.NewUserSessionRepository()
+	userRecoveryCodeRepository := repository.NewUserRecoveryCodeRepository()
+	userDataExportRepository := repository.NewUserDataExportRepository()
+	userIdentityRepository := repository.NewUserIdentityRepository()
+	userSocialLinkRepository := repository.NewUserSocialLinkRepository()
+	chatRoomRepository := repository.NewChatRoomRepository()
+	chatMessageRepository := repository.NewChatMessageRepository()
+	userBlockRepository := repository.NewUserBlockRepository()
+	chatReportRepository := repository.NewChatReportRepository()
 
 	authUseCase := usecase.NewAuthUseCase(databaseAdapter, userRepository, userProfileRepository, emailVerificationRepository, resetPasswordRepository,
-		userDeviceRepository, googleTokenAdapter, emailAdapter, jwtAdapter, securityAdapter, cacheAdapter, realtimeChatAdapter,
-		userProducer, logs)
-	userUseCase := usecase.NewUserUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository, uploadAdapter, cacheAdapter, logs)
-	chatUseCase := usecase.NewChatUseCase(realtimeChatAdapter, authClientAdapter, cloudMessagingAdapter, perspectiveAdapter, logs)
-	notificationUseCase := usecase.NewNotificationUseCase(databaseAdapter, redisConfig, userDeviceRepository, cloudMessagingAdapter, logs)
+		userSessionRepository, userRecoveryCodeRepository, userIdentityRepository, identityProviderAdapter, emailAdapter, jwtAdapter, securityAdapter,
+		cacheAdapter, rateLimiterAdapter, realtimeChatAdapter, smsAdapter, totpAdapter, userProducer, logs)
+	userUseCase := usecase.NewUserUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository, socialMediaRepository,
+		userSocialLinkRepository, uploadAdapter, cacheAdapter, userProducer, logs)
+	chatUseCase := usecase.NewChatUseCase(databaseAdapter, userRepository, chatRoomRepository, chatMessageRepository, userBlockRepository,
+		chatReportRepository, realtimeChatAdapter, authClientAdapter, cloudMessagingAdapter, moderationAdapter, photoAdapter, uploadAdapter, logs)
+	adminUseCase := usecase.NewAdminUseCase(databaseAdapter, userRepository, socialMediaRepository, chatReportRepository, chatMessageRepository,
+		cacheAdapter, logs)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(databaseAdapter, userRepository, userRecoveryCodeRepository, totpAdapter, securityAdapter, cacheAdapter, logs)
+	accountUseCase := usecase.NewAccountUseCase(databaseAdapter, userRepository, userProfileRepository, userImageRepository,
+		userSocialLinkRepository, userSessionRepository, userRecoveryCodeRepository, userIdentityRepository, emailVerificationRepository, resetPasswordRepository, userDataExportRepository,
+		uploadAdapter, emailAdapter, notificationAdapter, photoAdapter, transactionAdapter, cacheAdapter, userProducer, logs)
+	identityUseCase := usecase.NewIdentityUseCase(databaseAdapter, userRepository, userIdentityRepository, identityProviderAdapter, logs)
 	authController := httphandler.NewAuthController(authUseCase, customValidator, logs)
 	userController := httphandler.NewUserController(userUseCase, customValidator, logs)
 	chatController := httphandler.NewChatController(chatUseCase, customValidator, logs)
+	adminController := httphandler.NewAdminController(adminUseCase, customValidator, logs)
+	twoFactorController := httphandler.NewTwoFactorController(twoFactorUseCase, customValidator, logs)
+	accountController := httphandler.NewAccountController(accountUseCase, customValidator, logs)
+	identityController := httphandler.NewIdentityController(identityUseCase, customValidator, logs)
 	healthController := httphandler.NewHealthController()
 
 	authMiddleware := middleware.NewUserAuth(authUseCase, customValidator, logs)
 
+	chatHub := websocket.NewChatHub(redisConfig, logs)
+	chatGateway := websocket.NewChatGateway(chatHub, chatUseCase, customValidator, logs)
+	go chatHub.Run(ctx)
+
 	routeConfig := route.RouteConfig{
-		App:              app,
-		AuthController:   authController,
-		UserController:   userController,
-		AuthMiddleware:   authMiddleware,
-		ChatController:   chatController,
-		HealthController: healthController,
+		App:                 app,
+		AuthController:      authController,
+		UserController:      userController,
+		AuthMiddleware:      authMiddleware,
+		RateLimiterAdapter:  rateLimiterAdapter,
+		Logs:                logs,
+		ChatController:      chatController,
+		AdminController:     adminController,
+		TwoFactorController: twoFactorController,
+		AccountController:   accountController,
+		IdentityController:  identityController,
+		HealthController:    healthController,
+		ChatGateway:         chatGateway,
 	}
 
 	go func() {
@@ -166,7 +217,7 @@ func webServer(ctx context.Context) error {
 		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
 		defer l.Close()
 
-		grpcHandler.NewUserGRPCHandler(grpcServer, authUseCase, notificationUseCase)
+		grpcHandler.NewUserGRPCHandler(grpcServer, authUseCase, userUseCase, adminUseCase)
 
 		if err := grpcServer.Serve(l); err != nil {
 			logs.Error(fmt.Sprintf("Failed to start gRPC server: %v", err))
@@ -88,7 +88,7 @@ func TestRegisterByPhoneNumber(t *testing.T) {
 func TestDupicatePhoneRegisterByPhoneNumber(t *testing.T) {
 	TestRegisterByPhoneNumber(t)
 	requestBody := model.RegisterByPhoneRequest{
-		Username:     "hervi",
+		Username:     "hervi2",
 		Password:     "hervi12345!",
 		PhoneNumber:  "085228561067",
 		BirthDateStr: "2001-10-05",
@@ -107,12 +107,13 @@ func TestDupicatePhoneRegisterByPhoneNumber(t *testing.T) {
 	bytes, err := io.ReadAll(response.Body)
 	assert.Nil(t, err)
 
-	responseBody := new(model.ErrorResponse)
+	// A registered phone number is answered like a new one so accounts cannot be enumerated
+	responseBody := new(model.WebResponse[model.UserResponse])
 	err = json.Unmarshal(bytes, responseBody)
 	assert.Nil(t, err)
 
-	assert.Equal(t, http.StatusConflict, response.StatusCode)
-	assert.Equal(t, "Phone number has already been taken", responseBody.Message)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, requestBody.Username, responseBody.Data.Username)
 }
 
 func TestDupicateUsernameRegisterByPhoneNumber(t *testing.T) {
@@ -216,7 +217,7 @@ func TestRegisterByEmail(t *testing.T) {
 func TestDuplicateEmailRegisterByEmail(t *testing.T) {
 	TestRegisterByEmail(t)
 	requestBody := model.RegisterByEmailRequest{
-		Username:     "hervi",
+		Username:     "hervi2",
 		Password:     "hervi12345!",
 		Email:        "hervipro@gmail.com",
 		BirthDateStr: "2001-10-05",
@@ -235,12 +236,13 @@ func TestDuplicateEmailRegisterByEmail(t *testing.T) {
 	bytes, err := io.ReadAll(response.Body)
 	assert.Nil(t, err)
 
-	responseBody := new(model.ErrorResponse)
+	// A registered email is answered like a new one so accounts cannot be enumerated
+	responseBody := new(model.WebResponse[model.UserResponse])
 	err = json.Unmarshal(bytes, responseBody)
 	assert.Nil(t, err)
 
-	assert.Equal(t, http.StatusConflict, response.StatusCode)
-	assert.Equal(t, "Email has already been taken", responseBody.Message)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, requestBody.Username, responseBody.Data.Username)
 }
 
 func TestDuplicateUsernameRegisterByEmail(t *testing.T) {
@@ -0,0 +1,127 @@
+package adapter
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKeyId    = "test-key"
+	testIssuer   = "https://appleid.apple.com"
+	testAudience = "com.yourmoments.app"
+)
+
+func newTestIdentityProvider(t *testing.T) (adapter.IdentityProviderAdapter, *rsa.PrivateKey) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes())
+		e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes())
+		fmt.Fprintf(w, `{"keys":[{"kid":%q,"kty":"RSA","alg":"RS256","n":%q,"e":%q}]}`, testKeyId, n, e)
+	}))
+	t.Cleanup(server.Close)
+
+	identityAdapter := adapter.NewIdentityProviderAdapterWithConfig(server.Client(), adapter.IdentityProviderConfig{
+		Provider:  enum.IdentityProviderApple,
+		JWKSUrl:   server.URL,
+		Issuers:   []string{testIssuer},
+		Audiences: []string{testAudience},
+	})
+
+	return identityAdapter, privateKey
+}
+
+func signTestToken(t *testing.T, privateKey *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyId
+
+	signed, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func validTestClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            testIssuer,
+		"aud":            testAudience,
+		"sub":            "001234.abcdef",
+		"email":          "user@privaterelay.appleid.com",
+		"email_verified": "true",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifyIdToken_Success(t *testing.T) {
+	identityAdapter, privateKey := newTestIdentityProvider(t)
+
+	claim, err := identityAdapter.VerifyIdToken(context.Background(), enum.IdentityProviderApple, signTestToken(t, privateKey, validTestClaims()))
+
+	require.NoError(t, err)
+	assert.Equal(t, enum.IdentityProviderApple, claim.Provider)
+	assert.Equal(t, "001234.abcdef", claim.Subject)
+	assert.Equal(t, "user@privaterelay.appleid.com", claim.Email)
+	assert.True(t, claim.EmailVerified)
+}
+
+func TestVerifyIdToken_InvalidClaims(t *testing.T) {
+	identityAdapter, privateKey := newTestIdentityProvider(t)
+
+	tests := []struct {
+		name   string
+		modify func(claims jwt.MapClaims)
+	}{
+		{name: "wrong audience", modify: func(claims jwt.MapClaims) { claims["aud"] = "com.other.app" }},
+		{name: "wrong issuer", modify: func(claims jwt.MapClaims) { claims["iss"] = "https://accounts.google.com" }},
+		{name: "expired", modify: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "missing expiry", modify: func(claims jwt.MapClaims) { delete(claims, "exp") }},
+		{name: "missing subject", modify: func(claims jwt.MapClaims) { delete(claims, "sub") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validTestClaims()
+			tt.modify(claims)
+
+			_, err := identityAdapter.VerifyIdToken(context.Background(), enum.IdentityProviderApple, signTestToken(t, privateKey, claims))
+
+			assert.ErrorIs(t, err, adapter.ErrInvalidIdentityToken)
+		})
+	}
+}
+
+func TestVerifyIdToken_ForeignSigningKey(t *testing.T) {
+	identityAdapter, _ := newTestIdentityProvider(t)
+	foreignKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = identityAdapter.VerifyIdToken(context.Background(), enum.IdentityProviderApple, signTestToken(t, foreignKey, validTestClaims()))
+
+	assert.ErrorIs(t, err, adapter.ErrInvalidIdentityToken)
+}
+
+func TestVerifyIdToken_UnsupportedProvider(t *testing.T) {
+	identityAdapter, privateKey := newTestIdentityProvider(t)
+
+	assert.False(t, identityAdapter.IsSupported(enum.IdentityProviderFacebook))
+
+	_, err := identityAdapter.VerifyIdToken(context.Background(), enum.IdentityProviderFacebook, signTestToken(t, privateKey, validTestClaims()))
+
+	assert.ErrorIs(t, err, adapter.ErrIdentityProviderUnsupported)
+}
@@ -0,0 +1,91 @@
+package adapter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPerspectiveAdapter struct {
+	isToxic bool
+	err     error
+	calls   int
+}
+
+func (s *stubPerspectiveAdapter) IsToxicMessage(msg string) (bool, error) {
+	s.calls++
+	return s.isToxic, s.err
+}
+
+func TestWordlistClassifier(t *testing.T) {
+	classifier := adapter.NewWordlistClassifier("", logger.New("test"))
+
+	cases := map[string]bool{
+		"see you tomorrow at the event": false,
+		"what the FUCK":                 true,
+		"fuuuuck this":                  true,
+		"this is fucking great":         true,
+		"sh!t happens":                  true,
+		"dasar b4ngs4t":                 true,
+		"scunthorpe is a town":          false,
+	}
+
+	for msg, want := range cases {
+		isToxic, err := classifier.IsToxicMessage(msg)
+		require.NoError(t, err)
+		assert.Equal(t, want, isToxic, msg)
+	}
+}
+
+func TestWordlistClassifierReadsWordlistFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# custom words\n\nspamword\n"), 0o600))
+
+	classifier := adapter.NewWordlistClassifier(path, logger.New("test"))
+
+	isToxic, err := classifier.IsToxicMessage("buy now spamword")
+	require.NoError(t, err)
+	assert.True(t, isToxic)
+}
+
+func TestModerationFallsBackToWordlist(t *testing.T) {
+	t.Setenv("CHAT_MODERATION_CLASSIFIERS", "perspective,wordlist")
+	perspective := &stubPerspectiveAdapter{err: errors.New("perspective unreachable")}
+	moderation := adapter.NewModerationAdapter(perspective, logger.New("test"))
+
+	isToxic, err := moderation.IsToxicMessage("what the fuck")
+	require.NoError(t, err)
+	assert.True(t, isToxic)
+	assert.Equal(t, 1, perspective.calls)
+}
+
+func TestModerationFirstAnswerDecides(t *testing.T) {
+	t.Setenv("CHAT_MODERATION_CLASSIFIERS", "perspective,wordlist")
+	perspective := &stubPerspectiveAdapter{isToxic: false}
+	moderation := adapter.NewModerationAdapter(perspective, logger.New("test"))
+
+	isToxic, err := moderation.IsToxicMessage("what the fuck")
+	require.NoError(t, err)
+	assert.False(t, isToxic)
+}
+
+func TestModerationFailPolicy(t *testing.T) {
+	t.Setenv("CHAT_MODERATION_CLASSIFIERS", "perspective")
+	perspective := &stubPerspectiveAdapter{err: errors.New("perspective unreachable")}
+
+	t.Setenv("CHAT_MODERATION_FAIL_POLICY", "CLOSED")
+	_, err := adapter.NewModerationAdapter(perspective, logger.New("test")).IsToxicMessage("hello")
+	assert.ErrorIs(t, err, adapter.ErrModerationUnavailable)
+
+	t.Setenv("CHAT_MODERATION_FAIL_POLICY", "OPEN")
+	isToxic, err := adapter.NewModerationAdapter(perspective, logger.New("test")).IsToxicMessage("hello")
+	require.NoError(t, err)
+	assert.False(t, isToxic)
+}
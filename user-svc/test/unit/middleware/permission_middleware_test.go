@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPermissionApp(roles ...string) *fiber.App {
+	app := fiber.New()
+	app.Use(func(ctx *fiber.Ctx) error {
+		ctx.Locals("auth", &model.AuthResponse{UserId: "user-1", Permissions: enum.ResolvePermissions(roles)})
+		return ctx.Next()
+	})
+	app.Get("/admin/users", middleware.NewRequirePermission(enum.PermissionUserSuspend), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusOK)
+	})
+	return app
+}
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		roles  []string
+		status int
+	}{
+		{name: "Admin is allowed", roles: []string{string(enum.RoleUser), string(enum.RoleAdmin)}, status: http.StatusOK},
+		{name: "Finance is forbidden", roles: []string{string(enum.RoleUser), string(enum.RoleFinance)}, status: http.StatusForbidden},
+		{name: "User is forbidden", roles: []string{string(enum.RoleUser)}, status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newPermissionApp(tt.roles...).Test(httptest.NewRequest(http.MethodGet, "/admin/users", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/config"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/delivery/http/middleware"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// app.Test connects from 0.0.0.0, so listing it in TRUSTED_PROXIES makes the test request come from the ingress
+const testRemoteIP = "0.0.0.0"
+
+func TestIPRateLimitClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies string
+		forwardedFor   string
+		key            string
+	}{
+		{name: "Request from the ingress is keyed on the forwarded client", trustedProxies: "10.0.0.0/8, " + testRemoteIP,
+			forwardedFor: "203.0.113.7", key: "ip:login:203.0.113.7"},
+		{name: "First valid forwarded ip is the client", trustedProxies: testRemoteIP,
+			forwardedFor: "unknown, 203.0.113.7, 10.1.2.3", key: "ip:login:203.0.113.7"},
+		{name: "Forwarded header from an untrusted peer is ignored", trustedProxies: "10.0.0.0/8",
+			forwardedFor: "203.0.113.7", key: "ip:login:" + testRemoteIP},
+		{name: "No trusted proxy configured", trustedProxies: "",
+			forwardedFor: "203.0.113.7", key: "ip:login:" + testRemoteIP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRUSTED_PROXIES", tt.trustedProxies)
+			ctrl := gomock.NewController(t)
+			rateLimiter := mockadapter.NewMockRateLimiterAdapter(ctrl)
+			rateLimiter.EXPECT().Allow(gomock.Any(), tt.key, 10, time.Minute).Return(true, time.Duration(0), nil)
+
+			app := config.NewApp()
+			app.Post("/login", middleware.NewIPRateLimit(rateLimiter, mocklogger.NewMockLog(ctrl), "login", 10, time.Minute),
+				func(ctx *fiber.Ctx) error {
+					return ctx.SendStatus(http.StatusOK)
+				})
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.Header.Set(fiber.HeaderXForwardedFor, tt.forwardedFor)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClaimNextPending(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	querier := mockrepository.NewMockQuerier(ctrl)
+	staleBefore := time.Date(2025, 1, 1, 11, 30, 0, 0, time.UTC)
+
+	var query string
+	var args []any
+	querier.EXPECT().GetContext(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, dest any, q string, a ...any) error {
+			dest.(*entity.UserDataExport).Id = "export-1"
+			query = q
+			args = a
+			return nil
+		})
+
+	dataExport, err := repository.NewUserDataExportRepository().ClaimNextPending(ctx, querier, staleBefore)
+	require.NoError(t, err)
+	assert.Equal(t, "export-1", dataExport.Id)
+	assert.Contains(t, query, "WHERE status = $2 OR (status = $1 AND updated_at < $3)")
+	assert.Contains(t, query, "FOR UPDATE SKIP LOCKED")
+	assert.Equal(t, []any{enum.DataExportStatusProcessing, enum.DataExportStatusPending, staleBefore}, args)
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/pb/pagination"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// prepareOnlyDriver lets the repository prepare its statements without a database, the queries under test run on
+// a mocked querier
+type prepareOnlyDriver struct{}
+
+type prepareOnlyConn struct{}
+
+type prepareOnlyStmt struct{}
+
+var errNoDatabase = errors.New("no database in unit tests")
+
+func (prepareOnlyDriver) Open(string) (driver.Conn, error) { return prepareOnlyConn{}, nil }
+
+func (prepareOnlyConn) Prepare(string) (driver.Stmt, error) { return prepareOnlyStmt{}, nil }
+func (prepareOnlyConn) Close() error                        { return nil }
+func (prepareOnlyConn) Begin() (driver.Tx, error)           { return nil, errNoDatabase }
+
+func (prepareOnlyStmt) Close() error                               { return nil }
+func (prepareOnlyStmt) NumInput() int                              { return -1 }
+func (prepareOnlyStmt) Exec([]driver.Value) (driver.Result, error) { return nil, errNoDatabase }
+func (prepareOnlyStmt) Query([]driver.Value) (driver.Rows, error)  { return nil, errNoDatabase }
+
+func init() {
+	sql.Register("prepare-only", prepareOnlyDriver{})
+}
+
+func newUserRepository(t *testing.T) repository.UserRepository {
+	db, err := sqlx.Open("prepare-only", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	userRepository, err := repository.NewUserRepository(db)
+	require.NoError(t, err)
+	return userRepository
+}
+
+func publicChatUsers(usernames ...string) []*entity.UserPublicChat {
+	users := make([]*entity.UserPublicChat, 0, len(usernames))
+	for _, username := range usernames {
+		users = append(users, &entity.UserPublicChat{UserId: "id-" + username, Username: username})
+	}
+	return users
+}
+
+// expectSelect returns rows for the next select and records its query and args
+func expectSelect(querier *mockrepository.MockQuerier, rows []*entity.UserPublicChat, query *string, args *[]any) {
+	querier.EXPECT().SelectContext(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, dest any, q string, a ...any) error {
+			*dest.(*[]*entity.UserPublicChat) = rows
+			*query = q
+			*args = a
+			return nil
+		})
+}
+
+func TestFindAllPublicChatByCursor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("First page fetches one extra row and points the cursor at the last user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		querier := mockrepository.NewMockQuerier(ctrl)
+
+		var query string
+		var args []any
+		expectSelect(querier, publicChatUsers("alice", "alina", "alya"), &query, &args)
+
+		users, cursorMetadata, err := newUserRepository(t).FindAllPublicChatByCursor(ctx, querier, nil, 2, "al")
+		require.NoError(t, err)
+		assert.NotContains(t, query, "(username, users.id) >")
+		assert.Contains(t, query, "ORDER BY username ASC, users.id ASC LIMIT $2")
+		assert.Equal(t, []any{"%al%", 3}, args)
+
+		require.Len(t, users, 2)
+		assert.True(t, cursorMetadata.HasMore)
+		assert.Equal(t, pagination.EncodeCursor("alina", "id-alina"), cursorMetadata.NextCursor)
+	})
+
+	t.Run("Next page continues after the cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		querier := mockrepository.NewMockQuerier(ctrl)
+
+		var query string
+		var args []any
+		expectSelect(querier, publicChatUsers("alya"), &query, &args)
+
+		cursor := &pagination.Cursor{SortKey: "alina", Id: "id-alina"}
+		users, cursorMetadata, err := newUserRepository(t).FindAllPublicChatByCursor(ctx, querier, cursor, 2, "al")
+		require.NoError(t, err)
+		assert.Contains(t, query, "AND (username, users.id) > ($2, $3)")
+		assert.Contains(t, query, "LIMIT $4")
+		assert.Equal(t, []any{"%al%", "alina", "id-alina", 3}, args)
+
+		require.Len(t, users, 1)
+		assert.False(t, cursorMetadata.HasMore)
+		assert.Empty(t, cursorMetadata.NextCursor)
+	})
+}
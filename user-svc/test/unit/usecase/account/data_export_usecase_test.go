@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	userId   = "user-1"
+	exportId = "export-1"
+)
+
+func dataExport(status enum.DataExportStatusEnum, createdAgo time.Duration) *entity.UserDataExport {
+	createdAt := time.Now().Add(-createdAgo)
+	return &entity.UserDataExport{Id: exportId, UserId: userId, Status: status, CreatedAt: &createdAt, UpdatedAt: &createdAt}
+}
+
+// expectArchive lets the export of the user be built and uploaded
+func (m *accountMocks) expectArchive(ctx context.Context, user *entity.User) {
+	m.userRepo.EXPECT().FindById(ctx, user.Id).Return(user, nil)
+	m.userProfileRepo.EXPECT().FindByUserId(ctx, user.Id).Return(nil, sql.ErrNoRows)
+	m.userIdentityRepo.EXPECT().FindAllByUserId(ctx, gomock.Any(), user.Id).Return([]*entity.UserIdentity{}, nil)
+	m.userSessionRepo.EXPECT().FindAllActiveByUserId(ctx, gomock.Any(), user.Id).Return([]*entity.UserSession{}, nil)
+	m.notificationAdapter.EXPECT().GetUserDevices(ctx, user.Id).Return([]*entity.UserDevice{}, nil)
+	m.photoAdapter.EXPECT().GetUserDataExport(ctx, user.Id).Return(nil, nil, nil)
+	m.transactionAdapter.EXPECT().GetUserDataExport(ctx, user.Id).Return(nil, nil, nil)
+	m.uploadAdapter.EXPECT().PutObject(ctx, "user/export/user-1/export-1.zip", gomock.Any(), gomock.Any(), "application/zip").DoAndReturn(
+		func(_ context.Context, _ string, reader io.Reader, size int64, _ string) error {
+			archive, err := io.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+			if int64(len(archive)) != size {
+				return errors.New("archive size does not match")
+			}
+			return nil
+		})
+}
+
+// expectClaims hands out the export on the first claim and reports nothing pending afterwards
+func (m *accountMocks) expectClaims(ctx context.Context, claimed *entity.UserDataExport) {
+	gomock.InOrder(
+		m.userDataExportRepo.EXPECT().ClaimNextPending(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, _ time.Time) (*entity.UserDataExport, error) {
+				claimed.Status = enum.DataExportStatusProcessing
+				return claimed, nil
+			}),
+		m.userDataExportRepo.EXPECT().ClaimNextPending(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, _ time.Time) (*entity.UserDataExport, error) {
+				return nil, sql.ErrNoRows
+			}),
+	)
+}
+
+func TestRequestDataExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("First export is queued", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.userDataExportRepo.EXPECT().FindLatestByUserId(ctx, gomock.Any(), userId).Return(nil, sql.ErrNoRows)
+		mocks.userDataExportRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, created *entity.UserDataExport) (*entity.UserDataExport, error) {
+				assert.Equal(t, userId, created.UserId)
+				assert.Equal(t, enum.DataExportStatusPending, created.Status)
+				return created, nil
+			})
+
+		resp, err := accountUC.RequestDataExport(ctx, userId)
+		require.NoError(t, err)
+		assert.Equal(t, enum.DataExportStatusPending, resp.Status)
+		assert.Empty(t, resp.DownloadUrl)
+	})
+
+	t.Run("Export in progress is not queued twice", func(t *testing.T) {
+		for _, status := range []enum.DataExportStatusEnum{enum.DataExportStatusPending, enum.DataExportStatusProcessing} {
+			accountUC, mocks := newAccountUseCase(t)
+			mocks.userDataExportRepo.EXPECT().FindLatestByUserId(ctx, gomock.Any(), userId).Return(dataExport(status, time.Minute), nil)
+
+			_, err := accountUC.RequestDataExport(ctx, userId)
+			assertUseCaseError(t, err, errorcode.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("Completed export blocks a new one for a day", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.userDataExportRepo.EXPECT().FindLatestByUserId(ctx, gomock.Any(), userId).
+			Return(dataExport(enum.DataExportStatusCompleted, 23*time.Hour), nil)
+
+		_, err := accountUC.RequestDataExport(ctx, userId)
+		assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+	})
+
+	t.Run("Export can be requested again after a day or a failure", func(t *testing.T) {
+		for _, latest := range []*entity.UserDataExport{
+			dataExport(enum.DataExportStatusCompleted, 25*time.Hour),
+			dataExport(enum.DataExportStatusFailed, time.Minute),
+		} {
+			accountUC, mocks := newAccountUseCase(t)
+			mocks.userDataExportRepo.EXPECT().FindLatestByUserId(ctx, gomock.Any(), userId).Return(latest, nil)
+			mocks.userDataExportRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ any, created *entity.UserDataExport) (*entity.UserDataExport, error) {
+					return created, nil
+				})
+
+			_, err := accountUC.RequestDataExport(ctx, userId)
+			require.NoError(t, err)
+		}
+	})
+}
+
+func TestGetDataExport(t *testing.T) {
+	ctx := context.Background()
+	request := &model.GetDataExportRequest{UserId: userId, ExportId: exportId}
+
+	t.Run("Completed export gets a download link valid for three days", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		completed := dataExport(enum.DataExportStatusCompleted, time.Hour)
+		completed.FileKey = sql.NullString{String: "user/export/user-1/export-1.zip", Valid: true}
+		mocks.userDataExportRepo.EXPECT().FindByIdAndUserId(ctx, gomock.Any(), exportId, userId).Return(completed, nil)
+		mocks.uploadAdapter.EXPECT().GetPresignedUrlWithExpiry(ctx, "user/export/user-1/export-1.zip", 72*time.Hour).
+			Return("https://minio.example.com/export-1.zip", nil)
+
+		resp, err := accountUC.GetDataExport(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "https://minio.example.com/export-1.zip", resp.DownloadUrl)
+	})
+
+	t.Run("Processing export has no download link", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.userDataExportRepo.EXPECT().FindByIdAndUserId(ctx, gomock.Any(), exportId, userId).
+			Return(dataExport(enum.DataExportStatusProcessing, time.Minute), nil)
+
+		resp, err := accountUC.GetDataExport(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, enum.DataExportStatusProcessing, resp.Status)
+		assert.Empty(t, resp.DownloadUrl)
+	})
+
+	t.Run("Export of another user is not found", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.userDataExportRepo.EXPECT().FindByIdAndUserId(ctx, gomock.Any(), exportId, userId).Return(nil, sql.ErrNoRows)
+
+		_, err := accountUC.GetDataExport(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+}
+
+func TestProcessPendingDataExports(t *testing.T) {
+	ctx := context.Background()
+	user := &entity.User{Id: userId, Email: sql.NullString{String: "user@example.com", Valid: true}}
+
+	t.Run("Claims take over exports processing for longer than the claim timeout", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.userDataExportRepo.EXPECT().ClaimNextPending(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, staleBefore time.Time) (*entity.UserDataExport, error) {
+				assert.WithinDuration(t, time.Now().Add(-30*time.Minute), staleBefore, time.Minute)
+				return nil, sql.ErrNoRows
+			})
+
+		require.NoError(t, accountUC.ProcessPendingDataExports(ctx))
+	})
+
+	t.Run("Pending export is built and sent by email", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.expectClaims(ctx, dataExport(enum.DataExportStatusPending, time.Minute))
+		mocks.expectArchive(ctx, user)
+		mocks.uploadAdapter.EXPECT().GetPresignedUrlWithExpiry(ctx, "user/export/user-1/export-1.zip", 72*time.Hour).
+			Return("https://minio.example.com/export-1.zip", nil)
+		mocks.emailAdapter.EXPECT().SendEmail("user@example.com", "https://minio.example.com/export-1.zip", "data export").Return(nil)
+		mocks.userDataExportRepo.EXPECT().UpdateStatus(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, updated *entity.UserDataExport) (*entity.UserDataExport, error) {
+				assert.Equal(t, enum.DataExportStatusCompleted, updated.Status)
+				assert.Equal(t, "user/export/user-1/export-1.zip", updated.FileKey.String)
+				assert.NotNil(t, updated.CompletedAt)
+				return updated, nil
+			})
+
+		require.NoError(t, accountUC.ProcessPendingDataExports(ctx))
+	})
+
+	t.Run("Export left processing by a stopped worker is built again", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		stale := dataExport(enum.DataExportStatusProcessing, 2*time.Hour)
+		mocks.expectClaims(ctx, stale)
+		mocks.expectArchive(ctx, &entity.User{Id: userId})
+		mocks.userDataExportRepo.EXPECT().UpdateStatus(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, updated *entity.UserDataExport) (*entity.UserDataExport, error) {
+				assert.Equal(t, enum.DataExportStatusCompleted, updated.Status)
+				assert.WithinDuration(t, time.Now(), *updated.UpdatedAt, time.Minute)
+				return updated, nil
+			})
+
+		require.NoError(t, accountUC.ProcessPendingDataExports(ctx))
+	})
+
+	t.Run("Export that cannot be built fails so a new one can be requested", func(t *testing.T) {
+		accountUC, mocks := newAccountUseCase(t)
+		mocks.expectClaims(ctx, dataExport(enum.DataExportStatusPending, time.Minute))
+		mocks.userRepo.EXPECT().FindById(ctx, userId).Return(nil, errors.New("database is down"))
+		mocks.userDataExportRepo.EXPECT().UpdateStatus(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, updated *entity.UserDataExport) (*entity.UserDataExport, error) {
+				assert.Equal(t, enum.DataExportStatusFailed, updated.Status)
+				assert.True(t, updated.FailureReason.Valid)
+				return updated, nil
+			})
+
+		require.NoError(t, accountUC.ProcessPendingDataExports(ctx))
+	})
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mockproducer "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/gateway/producer"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type accountMocks struct {
+	userRepo            *mockrepository.MockUserRepository
+	userProfileRepo     *mockrepository.MockUserProfileRepository
+	userIdentityRepo    *mockrepository.MockUserIdentityRepository
+	userSessionRepo     *mockrepository.MockUserSessionRepository
+	userDataExportRepo  *mockrepository.MockUserDataExportRepository
+	uploadAdapter       *mockadapter.MockUploadAdapter
+	emailAdapter        *mockadapter.MockEmailAdapter
+	notificationAdapter *mockadapter.MockNotificationAdapter
+	photoAdapter        *mockadapter.MockPhotoAdapter
+	transactionAdapter  *mockadapter.MockTransactionAdapter
+}
+
+// newAccountUseCase wires the account use case on mocks, the logger accepts anything so each test only sets up the
+// calls it cares about
+func newAccountUseCase(t *testing.T) (usecase.AccountUseCase, *accountMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &accountMocks{
+		userRepo:            mockrepository.NewMockUserRepository(ctrl),
+		userProfileRepo:     mockrepository.NewMockUserProfileRepository(ctrl),
+		userIdentityRepo:    mockrepository.NewMockUserIdentityRepository(ctrl),
+		userSessionRepo:     mockrepository.NewMockUserSessionRepository(ctrl),
+		userDataExportRepo:  mockrepository.NewMockUserDataExportRepository(ctrl),
+		uploadAdapter:       mockadapter.NewMockUploadAdapter(ctrl),
+		emailAdapter:        mockadapter.NewMockEmailAdapter(ctrl),
+		notificationAdapter: mockadapter.NewMockNotificationAdapter(ctrl),
+		photoAdapter:        mockadapter.NewMockPhotoAdapter(ctrl),
+		transactionAdapter:  mockadapter.NewMockTransactionAdapter(ctrl),
+	}
+
+	logs := mocklogger.NewMockLog(ctrl)
+	logs.EXPECT().CustomError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().CustomLog(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	accountUC := usecase.NewAccountUseCase(mockrepository.NewMockBeginTx(ctrl), mocks.userRepo, mocks.userProfileRepo,
+		mockrepository.NewMockUserImageRepository(ctrl), mockrepository.NewMockUserSocialLinkRepository(ctrl), mocks.userSessionRepo,
+		mockrepository.NewMockUserRecoveryCodeRepository(ctrl), mocks.userIdentityRepo, mockrepository.NewMockEmailVerificationRepository(ctrl),
+		mockrepository.NewMockResetPasswordRepository(ctrl), mocks.userDataExportRepo, mocks.uploadAdapter, mocks.emailAdapter,
+		mocks.notificationAdapter, mocks.photoAdapter, mocks.transactionAdapter, mockadapter.NewMockCacheAdapter(ctrl),
+		mockproducer.NewMockUserProducer(ctrl), logs)
+
+	return accountUC, mocks
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
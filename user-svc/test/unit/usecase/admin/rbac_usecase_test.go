@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	t.Run("Admin holds every permission", func(t *testing.T) {
+		permissions := enum.ResolvePermissions([]string{string(enum.RoleAdmin)})
+		assert.Len(t, permissions, len(enum.RolePermissions[enum.RoleAdmin]))
+		assert.Contains(t, permissions, string(enum.PermissionUserRoleManage))
+	})
+
+	t.Run("Roles are merged without duplicates", func(t *testing.T) {
+		permissions := enum.ResolvePermissions([]string{string(enum.RoleUser), string(enum.RoleFinance), string(enum.RoleCreator)})
+		assert.Equal(t, []string{
+			string(enum.PermissionPhotoUpload),
+			string(enum.PermissionBankManage),
+			string(enum.PermissionWithdrawalReview),
+		}, permissions)
+	})
+
+	t.Run("Unknown role grants nothing", func(t *testing.T) {
+		assert.Empty(t, enum.ResolvePermissions([]string{"ROOT"}))
+		assert.False(t, enum.IsValidRole("ROOT"))
+	})
+
+	t.Run("User can not manage admin resources", func(t *testing.T) {
+		permissions := enum.ResolvePermissions([]string{string(enum.RoleUser), string(enum.RoleCreator)})
+		assert.NotContains(t, permissions, string(enum.PermissionUserSuspend))
+		assert.NotContains(t, permissions, string(enum.PermissionUserRoleManage))
+	})
+}
+
+type adminMocks struct {
+	userRepo *mockrepository.MockUserRepository
+	db       *mockrepository.MockBeginTx
+	tx       *mockrepository.MockTransactionTx
+	cache    *mockadapter.MockCacheAdapter
+}
+
+func newAdminUseCase(t *testing.T) (usecase.AdminUseCase, *adminMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &adminMocks{
+		userRepo: mockrepository.NewMockUserRepository(ctrl),
+		db:       mockrepository.NewMockBeginTx(ctrl),
+		tx:       mockrepository.NewMockTransactionTx(ctrl),
+		cache:    mockadapter.NewMockCacheAdapter(ctrl),
+	}
+
+	logs := mocklogger.NewMockLog(ctrl)
+	logs.EXPECT().CustomError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return usecase.NewAdminUseCase(mocks.db, mocks.userRepo, nil, nil, nil, mocks.cache, logs), mocks
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestGrantUserRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Invalid role", func(t *testing.T) {
+		adminUC, _ := newAdminUseCase(t)
+
+		_, err := adminUC.GrantUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "user-1", Role: "ROOT"})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Unknown user", func(t *testing.T) {
+		adminUC, mocks := newAdminUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, "user-1").Return(nil, sql.ErrNoRows)
+
+		_, err := adminUC.GrantUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "user-1", Role: string(enum.RoleFinance)})
+		assertUseCaseError(t, err, errorcode.ErrUserNotFound)
+	})
+
+	t.Run("Granted role drops the cached auth", func(t *testing.T) {
+		adminUC, mocks := newAdminUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, "user-1").Return(&entity.User{Id: "user-1", Roles: []string{"USER"}}, nil)
+		mocks.db.EXPECT().BeginTxx(ctx, gomock.Any()).Return(mocks.tx, nil)
+		mocks.userRepo.EXPECT().AddRole(ctx, mocks.tx, "user-1", string(enum.RoleFinance)).
+			Return(&entity.User{Id: "user-1", Roles: []string{"USER", "FINANCE"}}, nil)
+		mocks.tx.EXPECT().Commit().Return(nil)
+		mocks.cache.EXPECT().Del(ctx, "user-1").Return(nil)
+
+		resp, err := adminUC.GrantUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "user-1", Role: string(enum.RoleFinance)})
+		require.NoError(t, err)
+		assert.Contains(t, resp.Roles, string(enum.RoleFinance))
+	})
+}
+
+func TestRevokeUserRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Base user role can not be revoked", func(t *testing.T) {
+		adminUC, _ := newAdminUseCase(t)
+
+		_, err := adminUC.RevokeUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "user-1", Role: string(enum.RoleUser)})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Admin can not revoke their own admin role", func(t *testing.T) {
+		adminUC, _ := newAdminUseCase(t)
+
+		_, err := adminUC.RevokeUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "admin-1", Role: string(enum.RoleAdmin)})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Revoked role drops the cached auth", func(t *testing.T) {
+		adminUC, mocks := newAdminUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, "admin-2").Return(&entity.User{Id: "admin-2", Roles: []string{"USER", "ADMIN"}}, nil)
+		mocks.db.EXPECT().BeginTxx(ctx, gomock.Any()).Return(mocks.tx, nil)
+		mocks.userRepo.EXPECT().RemoveRole(ctx, mocks.tx, "admin-2", string(enum.RoleAdmin)).
+			Return(&entity.User{Id: "admin-2", Roles: []string{"USER"}}, nil)
+		mocks.tx.EXPECT().Commit().Return(nil)
+		mocks.cache.EXPECT().Del(ctx, "admin-2").Return(nil)
+
+		resp, err := adminUC.RevokeUserRole(ctx, &model.UserRoleRequest{AdminId: "admin-1", UserId: "admin-2", Role: string(enum.RoleAdmin)})
+		require.NoError(t, err)
+		assert.NotContains(t, resp.Roles, string(enum.RoleAdmin))
+	})
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	contactUserId      = "user-1"
+	currentPhoneNumber = "08123456789"
+	newPhoneNumber     = "08987654321"
+	newEmail           = "new@yourmoments.id"
+)
+
+// passwordlessUser signed up through an OTP or an identity provider
+func passwordlessUser() *entity.User {
+	verifiedAt := time.Now().Add(-time.Hour)
+	return &entity.User{
+		Id:                    contactUserId,
+		Username:              "testuser",
+		PhoneNumber:           sql.NullString{Valid: true, String: currentPhoneNumber},
+		PhoneNumberVerifiedAt: &verifiedAt,
+	}
+}
+
+// expectPhoneChangeSent lets the change go through up to the code sent to the new number
+func expectPhoneChangeSent(mocks *authMocks) {
+	mocks.userRepo.EXPECT().CountByPhoneNumber(gomock.Any(), newPhoneNumber).Return(0, nil)
+	mocks.smsAdapter.EXPECT().SendOTP(gomock.Any(), newPhoneNumber, gomock.Any(), gomock.Any()).Return(nil)
+}
+
+func phoneChangeRequest(code string) *model.RequestPhoneChangeRequest {
+	return &model.RequestPhoneChangeRequest{
+		UserId:      contactUserId,
+		SessionId:   "session-1",
+		PhoneNumber: newPhoneNumber,
+		Code:        code,
+	}
+}
+
+func TestRequestPhoneChangeReauthentication(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Passwordless account without a code is rejected", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(passwordlessUser(), nil)
+
+		_, err := authUC.RequestPhoneChange(ctx, phoneChangeRequest(""))
+		assertUseCaseError(t, err, errorcode.ErrValidationFailed)
+	})
+
+	t.Run("Passwordless account confirms with the code sent to the current number", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(passwordlessUser(), nil).Times(3)
+
+		var reauthCode string
+		mocks.smsAdapter.EXPECT().SendOTP(ctx, currentPhoneNumber, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, phoneNumber, code string, ttl time.Duration) error {
+				reauthCode = code
+				return nil
+			})
+		_, err := authUC.RequestReauthOTP(ctx, contactUserId)
+		require.NoError(t, err)
+
+		_, err = authUC.RequestPhoneChange(ctx, phoneChangeRequest(wrongOTPCode(reauthCode)))
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+
+		expectPhoneChangeSent(mocks)
+		_, err = authUC.RequestPhoneChange(ctx, phoneChangeRequest(reauthCode))
+		require.NoError(t, err)
+	})
+
+	t.Run("Passwordless account with two factor confirms with a step-up", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		user := passwordlessUser()
+		enabledAt := time.Now().Add(-time.Hour)
+		user.TotpSecret = sql.NullString{Valid: true, String: "secret"}
+		user.TwoFactorEnabledAt = &enabledAt
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(user, nil).Times(2)
+
+		// A step-up of another session does not count
+		mocks.cache.set("2fa_step_up:session-2", time.Now().Unix(), 5*time.Minute)
+		_, err := authUC.RequestPhoneChange(ctx, phoneChangeRequest(""))
+		assertUseCaseError(t, err, errorcode.ErrValidationFailed)
+
+		mocks.cache.set("2fa_step_up:session-1", time.Now().Unix(), 5*time.Minute)
+		expectPhoneChangeSent(mocks)
+		_, err = authUC.RequestPhoneChange(ctx, phoneChangeRequest(""))
+		require.NoError(t, err)
+	})
+
+	t.Run("Password account confirms with its password only", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+		require.NoError(t, err)
+		user := passwordlessUser()
+		user.Password = sql.NullString{Valid: true, String: string(hashedPassword)}
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(user, nil).Times(3)
+
+		_, err = authUC.RequestReauthOTP(ctx, contactUserId)
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+
+		request := phoneChangeRequest("")
+		request.Password = "wrong-password"
+		_, err = authUC.RequestPhoneChange(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+
+		expectPhoneChangeSent(mocks)
+		request.Password = "Secret123!"
+		_, err = authUC.RequestPhoneChange(ctx, request)
+		require.NoError(t, err)
+	})
+}
+
+func TestRequestContactChangeToTakenContact(t *testing.T) {
+	ctx := context.Background()
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordUser := func() *entity.User {
+		user := passwordlessUser()
+		user.Password = sql.NullString{Valid: true, String: string(hashedPassword)}
+		return user
+	}
+
+	t.Run("Number of another account gets a notice instead of a code", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(passwordUser(), nil)
+		mocks.userRepo.EXPECT().CountByPhoneNumber(ctx, newPhoneNumber).Return(1, nil)
+		mocks.smsAdapter.EXPECT().SendNotice(ctx, newPhoneNumber, gomock.Any()).Return(nil)
+
+		request := phoneChangeRequest("")
+		request.Password = "Secret123!"
+		response, err := authUC.RequestPhoneChange(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, 300, response.ExpiresIn)
+		assert.False(t, mocks.cache.has("contact_change:CHANGE_PHONE:"+contactUserId))
+	})
+
+	t.Run("Email of another account gets a notice instead of a code", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, contactUserId).Return(passwordUser(), nil)
+		mocks.rateLimiterAdapter.EXPECT().Allow(ctx, "identifier:change_email:"+newEmail, gomock.Any(), gomock.Any()).
+			Return(true, time.Duration(0), nil)
+		mocks.userRepo.EXPECT().CountByEmail(ctx, newEmail).Return(1, nil)
+		mocks.emailAdapter.EXPECT().SendEmail(newEmail, "", "email already registered").Return(nil)
+
+		response, err := authUC.RequestEmailChange(ctx, &model.RequestEmailChangeRequest{
+			UserId:    contactUserId,
+			SessionId: "session-1",
+			Email:     newEmail,
+			Password:  "Secret123!",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 300, response.ExpiresIn)
+		assert.False(t, mocks.cache.has("contact_change:CHANGE_EMAIL:"+contactUserId))
+	})
+}
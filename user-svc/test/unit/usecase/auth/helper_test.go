@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mockproducer "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/gateway/producer"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/mock/gomock"
+)
+
+// memoryCache backs the cache adapter mock with a map so the redis keys the use case
+// relies on (otp codes, attempts, cooldowns, locks) behave like they do in redis
+type memoryCache struct {
+	mu       sync.Mutex
+	values   map[string]string
+	ttls     map[string]time.Duration
+	failures map[string]error
+}
+
+func newMemoryCache(ctrl *gomock.Controller) (*mockadapter.MockCacheAdapter, *memoryCache) {
+	store := &memoryCache{values: map[string]string{}, ttls: map[string]time.Duration{}, failures: map[string]error{}}
+	cache := mockadapter.NewMockCacheAdapter(ctrl)
+
+	cache.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string) (string, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		if err, ok := store.failures[key]; ok {
+			return "", err
+		}
+		value, ok := store.values[key]
+		if !ok {
+			return "", redis.Nil
+		}
+		return value, nil
+	}).AnyTimes()
+
+	cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+			store.set(key, value, expiration)
+			return nil
+		}).AnyTimes()
+
+	cache.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+			if store.has(key) {
+				return false, nil
+			}
+			store.set(key, value, expiration)
+			return true, nil
+		}).AnyTimes()
+
+	cache.EXPECT().Del(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, keys ...string) error {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		for _, key := range keys {
+			delete(store.values, key)
+			delete(store.ttls, key)
+		}
+		return nil
+	}).AnyTimes()
+
+	cache.EXPECT().Incr(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string) (int64, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		value, _ := strconv.ParseInt(store.values[key], 10, 64)
+		value++
+		store.values[key] = strconv.FormatInt(value, 10)
+		return value, nil
+	}).AnyTimes()
+
+	cache.EXPECT().Expire(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, expiration time.Duration) error {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+			store.ttls[key] = expiration
+			return nil
+		}).AnyTimes()
+
+	cache.EXPECT().TTL(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string) (time.Duration, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return store.ttls[key], nil
+	}).AnyTimes()
+
+	return cache, store
+}
+
+func (c *memoryCache) set(key string, value interface{}, expiration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := value.([]byte); ok {
+		c.values[key] = string(v)
+	} else {
+		c.values[key] = fmt.Sprint(value)
+	}
+	c.ttls[key] = expiration
+}
+
+// failGet makes every read of the key fail as if redis was unreachable
+func (c *memoryCache) failGet(key string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures[key] = err
+}
+
+func (c *memoryCache) del(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	delete(c.ttls, key)
+}
+
+func (c *memoryCache) has(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.values[key]
+	return ok
+}
+
+type authMocks struct {
+	userRepo            *mockrepository.MockUserRepository
+	userProfileRepo     *mockrepository.MockUserProfileRepository
+	userSessionRepo     *mockrepository.MockUserSessionRepository
+	recoveryCodeRepo    *mockrepository.MockUserRecoveryCodeRepository
+	db                  *mockrepository.MockBeginTx
+	tx                  *mockrepository.MockTransactionTx
+	cache               *memoryCache
+	jwtAdapter          *mockadapter.MockJWTAdapter
+	securityAdapter     *mockadapter.MockSecurityAdapter
+	emailAdapter        *mockadapter.MockEmailAdapter
+	smsAdapter          *mockadapter.MockSmsAdapter
+	totpAdapter         *mockadapter.MockTOTPAdapter
+	rateLimiterAdapter  *mockadapter.MockRateLimiterAdapter
+	realtimeChatAdapter *mockadapter.MockRealtimeChatAdapter
+	userProducer        *mockproducer.MockUserProducer
+}
+
+// newAuthUseCase wires the auth use case on mocks, hashing is deterministic and the
+// logger accepts anything so each test only sets up the calls it cares about
+func newAuthUseCase(t *testing.T) (usecase.AuthUseCase, *authMocks) {
+	authUC, _, mocks := newAuthUseCases(t)
+	return authUC, mocks
+}
+
+// newAuthUseCases wires the auth and two factor use cases on the same mocks and cache
+func newAuthUseCases(t *testing.T) (usecase.AuthUseCase, usecase.TwoFactorUseCase, *authMocks) {
+	ctrl := gomock.NewController(t)
+	cache, store := newMemoryCache(ctrl)
+
+	mocks := &authMocks{
+		userRepo:            mockrepository.NewMockUserRepository(ctrl),
+		userProfileRepo:     mockrepository.NewMockUserProfileRepository(ctrl),
+		userSessionRepo:     mockrepository.NewMockUserSessionRepository(ctrl),
+		recoveryCodeRepo:    mockrepository.NewMockUserRecoveryCodeRepository(ctrl),
+		db:                  mockrepository.NewMockBeginTx(ctrl),
+		tx:                  mockrepository.NewMockTransactionTx(ctrl),
+		cache:               store,
+		jwtAdapter:          mockadapter.NewMockJWTAdapter(ctrl),
+		securityAdapter:     mockadapter.NewMockSecurityAdapter(ctrl),
+		emailAdapter:        mockadapter.NewMockEmailAdapter(ctrl),
+		smsAdapter:          mockadapter.NewMockSmsAdapter(ctrl),
+		totpAdapter:         mockadapter.NewMockTOTPAdapter(ctrl),
+		rateLimiterAdapter:  mockadapter.NewMockRateLimiterAdapter(ctrl),
+		realtimeChatAdapter: mockadapter.NewMockRealtimeChatAdapter(ctrl),
+		userProducer:        mockproducer.NewMockUserProducer(ctrl),
+	}
+
+	mocks.securityAdapter.EXPECT().Hash(gomock.Any()).DoAndReturn(func(value string) string {
+		return "hashed:" + value
+	}).AnyTimes()
+
+	logs := mocklogger.NewMockLog(ctrl)
+	logs.EXPECT().CustomError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().CustomLog(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	authUC := usecase.NewAuthUseCase(mocks.db, mocks.userRepo, mocks.userProfileRepo, mockrepository.NewMockEmailVerificationRepository(ctrl),
+		mockrepository.NewMockResetPasswordRepository(ctrl), mocks.userSessionRepo, mocks.recoveryCodeRepo,
+		mockrepository.NewMockUserIdentityRepository(ctrl), mockadapter.NewMockIdentityProviderAdapter(ctrl), mocks.emailAdapter,
+		mocks.jwtAdapter, mocks.securityAdapter, cache, mocks.rateLimiterAdapter, mocks.realtimeChatAdapter, mocks.smsAdapter, mocks.totpAdapter,
+		mocks.userProducer, logs)
+	twoFactorUC := usecase.NewTwoFactorUseCase(mocks.db, mocks.userRepo, mocks.recoveryCodeRepo, mocks.totpAdapter, mocks.securityAdapter,
+		cache, logs)
+
+	return authUC, twoFactorUC, mocks
+}
+
+// expectTransaction lets one transaction begin and commit on the mocked database
+func (m *authMocks) expectTransaction() {
+	m.db.EXPECT().BeginTxx(gomock.Any(), gomock.Any()).Return(m.tx, nil)
+	m.tx.EXPECT().Commit().Return(nil)
+}
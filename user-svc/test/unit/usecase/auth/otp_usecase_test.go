@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const otpPhoneNumber = "08123456789"
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func unverifiedPhoneUser() *entity.User {
+	return &entity.User{
+		Id:          "user-1",
+		Username:    "testuser",
+		PhoneNumber: sql.NullString{Valid: true, String: otpPhoneNumber},
+	}
+}
+
+// requestVerifyPhoneOTP sends a verification code and returns the code the sms adapter received
+func requestVerifyPhoneOTP(t *testing.T, authUC usecase.AuthUseCase, mocks *authMocks) string {
+	t.Helper()
+	ctx := context.Background()
+
+	var sentCode string
+	mocks.userRepo.EXPECT().FindByPhoneNumber(ctx, otpPhoneNumber).Return(unverifiedPhoneUser(), nil)
+	mocks.smsAdapter.EXPECT().SendOTP(ctx, otpPhoneNumber, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, phoneNumber, code string, ttl time.Duration) error {
+			sentCode = code
+			return nil
+		})
+
+	resp, err := authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{
+		PhoneNumber: otpPhoneNumber,
+		Purpose:     enum.OTPPurposeVerifyPhone,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, sentCode, 6)
+	return sentCode
+}
+
+func wrongOTPCode(code string) string {
+	if code == "000000" {
+		return "111111"
+	}
+	return "000000"
+}
+
+func TestVerifyPhoneNumberOTP(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Code never requested", func(t *testing.T) {
+		authUC, _ := newAuthUseCase(t)
+
+		err := authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: "123456"})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Valid code verifies the phone number once", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		code := requestVerifyPhoneOTP(t, authUC, mocks)
+
+		user := unverifiedPhoneUser()
+		mocks.expectTransaction()
+		mocks.userRepo.EXPECT().UpdatePhoneNumberVerifiedAt(ctx, mocks.tx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tx interface{}, updated *entity.User) (*entity.User, error) {
+				assert.Equal(t, otpPhoneNumber, updated.PhoneNumber.String)
+				assert.NotNil(t, updated.PhoneNumberVerifiedAt)
+				user.PhoneNumberVerifiedAt = updated.PhoneNumberVerifiedAt
+				return user, nil
+			})
+		mocks.userProfileRepo.EXPECT().FindByUserId(ctx, user.Id).Return(&entity.UserProfile{Id: "profile-1", UserId: user.Id}, nil)
+		mocks.realtimeChatAdapter.EXPECT().CreateChatRoom(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mocks.userProducer.EXPECT().ProduceUserCreated(ctx, gomock.Any()).Return(nil)
+
+		err := authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: code})
+		require.NoError(t, err)
+
+		err = authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: code})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Wrong codes burn the code after the attempt limit", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		code := requestVerifyPhoneOTP(t, authUC, mocks)
+		wrongCode := wrongOTPCode(code)
+
+		for attempt := 0; attempt < 5; attempt++ {
+			err := authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: wrongCode})
+			assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+		}
+
+		err := authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: wrongCode})
+		assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+
+		// The right code no longer works once the attempts are spent
+		err = authUC.VerifyPhoneNumber(ctx, &model.VerifyPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Code: code})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Code of another purpose is rejected", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		code := requestVerifyPhoneOTP(t, authUC, mocks)
+
+		_, _, err := authUC.LoginByOTP(ctx, &model.LoginByOTPRequest{PhoneNumber: otpPhoneNumber, Code: code})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+}
+
+func TestRequestPhoneOTPCooldown(t *testing.T) {
+	ctx := context.Background()
+	authUC, mocks := newAuthUseCase(t)
+	requestVerifyPhoneOTP(t, authUC, mocks)
+
+	_, err := authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Purpose: enum.OTPPurposeVerifyPhone})
+	assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+}
+
+func TestRequestPhoneOTPUniformResponse(t *testing.T) {
+	ctx := context.Background()
+	authUC, mocks := newAuthUseCase(t)
+
+	mocks.userRepo.EXPECT().FindByPhoneNumber(ctx, otpPhoneNumber).Return(unverifiedPhoneUser(), nil)
+	mocks.smsAdapter.EXPECT().SendOTP(ctx, otpPhoneNumber, gomock.Any(), gomock.Any()).Return(nil)
+	expected, err := authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Purpose: enum.OTPPurposeVerifyPhone})
+	require.NoError(t, err)
+
+	verifiedUser := unverifiedPhoneUser()
+	verifiedAt := time.Now()
+	verifiedUser.PhoneNumberVerifiedAt = &verifiedAt
+
+	tests := []struct {
+		name        string
+		phoneNumber string
+		purpose     enum.OTPPurposeEnum
+		user        *entity.User
+	}{
+		{name: "Unknown number", phoneNumber: "08100000001", purpose: enum.OTPPurposeLogin},
+		{name: "Verify an already verified number", phoneNumber: "08100000002", purpose: enum.OTPPurposeVerifyPhone, user: verifiedUser},
+		{name: "Login with an unverified number", phoneNumber: "08100000003", purpose: enum.OTPPurposeLogin, user: unverifiedPhoneUser()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.user == nil {
+				mocks.userRepo.EXPECT().FindByPhoneNumber(ctx, tt.phoneNumber).Return(nil, sql.ErrNoRows)
+			} else {
+				mocks.userRepo.EXPECT().FindByPhoneNumber(ctx, tt.phoneNumber).Return(tt.user, nil)
+			}
+
+			// No sms is expected, the mock fails the test if one is sent
+			resp, err := authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{PhoneNumber: tt.phoneNumber, Purpose: tt.purpose})
+			require.NoError(t, err)
+			assert.Equal(t, expected, resp)
+
+			// The resend cooldown applies the same way as for an eligible number
+			_, err = authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{PhoneNumber: tt.phoneNumber, Purpose: tt.purpose})
+			assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+		})
+	}
+}
+
+func TestRequestPhoneOTPSendLimit(t *testing.T) {
+	ctx := context.Background()
+	authUC, mocks := newAuthUseCase(t)
+	request := &model.RequestPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Purpose: enum.OTPPurposeVerifyPhone}
+	cooldownKey := "otp_cooldown:" + string(enum.OTPPurposeVerifyPhone) + ":" + otpPhoneNumber
+
+	for send := 0; send < 5; send++ {
+		mocks.cache.del(cooldownKey)
+		requestVerifyPhoneOTP(t, authUC, mocks)
+	}
+
+	// Past the hourly cap the request is refused without taking a new cooldown or sending
+	mocks.cache.del(cooldownKey)
+	_, err := authUC.RequestPhoneOTP(ctx, request)
+	assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+	assert.False(t, mocks.cache.has(cooldownKey))
+
+	// The cap is per phone number whatever the purpose
+	_, err = authUC.RequestPhoneOTP(ctx, &model.RequestPhoneOTPRequest{PhoneNumber: otpPhoneNumber, Purpose: enum.OTPPurposeLogin})
+	assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+}
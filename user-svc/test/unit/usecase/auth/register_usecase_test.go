@@ -34,24 +34,29 @@ func TestRegisterByPhoneNumber(t *testing.T) {
 	mockUserProfileRepo := mockrepository.NewMockUserProfileRepository(ctrl)
 	mockEmailVerificationRepo := mockrepository.NewMockEmailVerificationRepository(ctrl)
 	mockResetPasswordRepo := mockrepository.NewMockResetPasswordRepository(ctrl)
-	mockUserDeviceRepo := mockrepository.NewMockUserDeviceRepository(ctrl)
+	mockUserSessionRepo := mockrepository.NewMockUserSessionRepository(ctrl)
+	mockRecoveryCodeRepo := mockrepository.NewMockUserRecoveryCodeRepository(ctrl)
+	mockUserIdentityRepo := mockrepository.NewMockUserIdentityRepository(ctrl)
 
 	mockDB := mockrepository.NewMockBeginTx(ctrl)
 	mockTx := mockrepository.NewMockTransactionTx(ctrl)
 
 	mockCacheAdapter := mockadapter.NewMockCacheAdapter(ctrl)
 	mockEmailAdapter := mockadapter.NewMockEmailAdapter(ctrl)
-	mockGoogleTokenAdapter := mockadapter.NewMockGoogleTokenAdapter(ctrl)
+	mockIdentityProviderAdapter := mockadapter.NewMockIdentityProviderAdapter(ctrl)
 	mockJwtAdapter := mockadapter.NewMockJWTAdapter(ctrl)
 	mockSecurityAdapter := mockadapter.NewMockSecurityAdapter(ctrl)
 	mockRealtimeChatAdapter := mockadapter.NewMockRealtimeChatAdapter(ctrl)
+	mockSmsAdapter := mockadapter.NewMockSmsAdapter(ctrl)
+	mockTOTPAdapter := mockadapter.NewMockTOTPAdapter(ctrl)
+	mockRateLimiterAdapter := mockadapter.NewMockRateLimiterAdapter(ctrl)
 	mockUserProducer := mockproducer.NewMockUserProducer(ctrl)
 
 	mockLog := mocklogger.NewMockLog(ctrl)
 
 	authUC := usecase.NewAuthUseCase(mockDB, mockUserRepo, mockUserProfileRepo, mockEmailVerificationRepo, mockResetPasswordRepo,
-		mockUserDeviceRepo, mockGoogleTokenAdapter, mockEmailAdapter, mockJwtAdapter, mockSecurityAdapter, mockCacheAdapter,
-		mockRealtimeChatAdapter, mockUserProducer, mockLog)
+		mockUserSessionRepo, mockRecoveryCodeRepo, mockUserIdentityRepo, mockIdentityProviderAdapter, mockEmailAdapter, mockJwtAdapter, mockSecurityAdapter,
+		mockCacheAdapter, mockRateLimiterAdapter, mockRealtimeChatAdapter, mockSmsAdapter, mockTOTPAdapter, mockUserProducer, mockLog)
 	// Data request testing
 	now := time.Now()
 	req := &model.RegisterByPhoneRequest{
@@ -61,24 +66,20 @@ func TestRegisterByPhoneNumber(t *testing.T) {
 		BirthDate:   &now,
 	}
 
+	mockRateLimiterAdapter.EXPECT().Allow(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, time.Duration(0), nil).AnyTimes()
+
 	t.Run("Phone number already taken", func(t *testing.T) {
-		// Ekspektasi: jumlah data berdasarkan phone number > 0
+		// Nomor telepon yang sudah terdaftar dijawab sama seperti registrasi baru
+		mockUserRepo.EXPECT().CountByUsername(ctx, req.Username).Return(0, nil)
 		mockUserRepo.EXPECT().CountByPhoneNumber(ctx, req.PhoneNumber).Return(1, nil)
 
 		resp, err := authUC.RegisterByPhoneNumber(ctx, req)
-		assert.Error(t, err)
-		assert.Nil(t, resp)
-
-		// Pastikan error-nya sesuai dengan fiber.NewError(http.StatusBadRequest, "phone number has already taken")
-		appErr, ok := err.(*helper.AppError)
-		assert.True(t, ok)
-		assert.Equal(t, errorcode.ErrAlreadyExists, appErr.Code)
-		assert.Equal(t, "Phone number has already been taken", appErr.Message)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, req.Username, resp.Username)
 	})
 
 	t.Run("Username already taken", func(t *testing.T) {
-		// Nomor telepon tidak terdaftar
-		mockUserRepo.EXPECT().CountByPhoneNumber(ctx, req.PhoneNumber).Return(0, nil)
 		// Ekspektasi: username sudah ada
 		mockUserRepo.EXPECT().CountByUsername(ctx, req.Username).Return(1, nil)
 
@@ -214,24 +215,29 @@ func TestRegisterByEmail(t *testing.T) {
 	mockUserProfileRepo := mockrepository.NewMockUserProfileRepository(ctrl)
 	mockEmailVerificationRepo := mockrepository.NewMockEmailVerificationRepository(ctrl)
 	mockResetPasswordRepo := mockrepository.NewMockResetPasswordRepository(ctrl)
-	mockUserDeviceRepo := mockrepository.NewMockUserDeviceRepository(ctrl)
+	mockUserSessionRepo := mockrepository.NewMockUserSessionRepository(ctrl)
+	mockRecoveryCodeRepo := mockrepository.NewMockUserRecoveryCodeRepository(ctrl)
+	mockUserIdentityRepo := mockrepository.NewMockUserIdentityRepository(ctrl)
 
 	mockDB := mockrepository.NewMockBeginTx(ctrl)
 	mockTx := mockrepository.NewMockTransactionTx(ctrl)
 
 	mockCacheAdapter := mockadapter.NewMockCacheAdapter(ctrl)
 	mockEmailAdapter := mockadapter.NewMockEmailAdapter(ctrl)
-	mockGoogleTokenAdapter := mockadapter.NewMockGoogleTokenAdapter(ctrl)
+	mockIdentityProviderAdapter := mockadapter.NewMockIdentityProviderAdapter(ctrl)
 	mockJwtAdapter := mockadapter.NewMockJWTAdapter(ctrl)
 	mockSecurityAdapter := mockadapter.NewMockSecurityAdapter(ctrl)
 	mockRealtimeChatAdapter := mockadapter.NewMockRealtimeChatAdapter(ctrl)
+	mockSmsAdapter := mockadapter.NewMockSmsAdapter(ctrl)
+	mockTOTPAdapter := mockadapter.NewMockTOTPAdapter(ctrl)
+	mockRateLimiterAdapter := mockadapter.NewMockRateLimiterAdapter(ctrl)
 	mockUserProducer := mockproducer.NewMockUserProducer(ctrl)
 
 	mockLog := mocklogger.NewMockLog(ctrl)
 
 	authUC := usecase.NewAuthUseCase(mockDB, mockUserRepo, mockUserProfileRepo, mockEmailVerificationRepo, mockResetPasswordRepo,
-		mockUserDeviceRepo, mockGoogleTokenAdapter, mockEmailAdapter, mockJwtAdapter, mockSecurityAdapter, mockCacheAdapter,
-		mockRealtimeChatAdapter, mockUserProducer, mockLog)
+		mockUserSessionRepo, mockRecoveryCodeRepo, mockUserIdentityRepo, mockIdentityProviderAdapter, mockEmailAdapter, mockJwtAdapter, mockSecurityAdapter,
+		mockCacheAdapter, mockRateLimiterAdapter, mockRealtimeChatAdapter, mockSmsAdapter, mockTOTPAdapter, mockUserProducer, mockLog)
 	// Data request testing
 	now := time.Now()
 	req := &model.RegisterByEmailRequest{
@@ -241,24 +247,22 @@ func TestRegisterByEmail(t *testing.T) {
 		BirthDate: &now,
 	}
 
+	mockRateLimiterAdapter.EXPECT().Allow(ctx, "identifier:register_email:hervipro@gmail.com", gomock.Any(), gomock.Any()).
+		Return(true, time.Duration(0), nil).MinTimes(1)
+
 	t.Run("Email already taken", func(t *testing.T) {
-		// Ekspektasi: jumlah data berdasarkan phone number > 0
+		// Email yang sudah terdaftar dijawab sama seperti registrasi baru, pemiliknya diberi tahu lewat email
+		mockUserRepo.EXPECT().CountByUsername(ctx, req.Username).Return(0, nil)
 		mockUserRepo.EXPECT().CountByEmail(ctx, req.Email).Return(1, nil)
+		mockEmailAdapter.EXPECT().SendEmail(req.Email, "", "email already registered").Return(nil)
 
 		resp, err := authUC.RegisterByEmail(ctx, req)
-		assert.Error(t, err)
-		assert.Nil(t, resp)
-
-		// Pastikan error-nya sesuai dengan fiber.NewError(http.StatusBadRequest, "phone number has already taken")
-		appErr, ok := err.(*helper.AppError)
-		assert.True(t, ok)
-		assert.Equal(t, errorcode.ErrAlreadyExists, appErr.Code)
-		assert.Equal(t, "Email has already been taken", appErr.Message)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, req.Username, resp.Username)
 	})
 
 	t.Run("Username already taken", func(t *testing.T) {
-		// Nomor telepon tidak terdaftar
-		mockUserRepo.EXPECT().CountByEmail(ctx, req.Email).Return(0, nil)
 		// Ekspektasi: username sudah ada
 		mockUserRepo.EXPECT().CountByUsername(ctx, req.Username).Return(1, nil)
 
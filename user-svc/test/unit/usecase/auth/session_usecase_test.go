@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionUserId = "user-1"
+	sessionId     = "session-1"
+	accessToken   = "access-token"
+)
+
+var errRedisDown = errors.New("dial tcp: connection refused")
+
+// expectAccessToken makes the jwt adapter accept the access token of the test session and
+// caches the auth body so Verify does not have to reload the user
+func expectAccessToken(t *testing.T, mocks *authMocks) {
+	t.Helper()
+	mocks.jwtAdapter.EXPECT().VerifyAccessToken(accessToken).Return(&entity.AccessToken{
+		UserId:    sessionUserId,
+		SessionId: sessionId,
+		Token:     accessToken,
+		ExpiresAt: time.Now().Add(15 * time.Minute),
+	}, nil)
+
+	authJson, err := sonic.ConfigFastest.Marshal(&entity.Auth{Id: sessionUserId, Username: "testuser", Roles: []string{"USER"}})
+	require.NoError(t, err)
+	mocks.cache.set(sessionUserId, authJson, time.Hour)
+}
+
+func liveSession() *entity.UserSession {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	return &entity.UserSession{Id: sessionId, UserId: sessionUserId, ExpiresAt: &expiresAt}
+}
+
+func TestVerifySessionRevocation(t *testing.T) {
+	ctx := context.Background()
+	request := &model.VerifyUserRequest{Token: accessToken}
+
+	t.Run("Live session is accepted", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+
+		resp, err := authUC.Verify(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, sessionUserId, resp.UserId)
+		assert.Equal(t, sessionId, resp.SessionId)
+	})
+
+	t.Run("Revoked session is rejected", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+
+		revokedAt := time.Now()
+		session := liveSession()
+		session.RevokedAt = &revokedAt
+		mocks.userSessionRepo.EXPECT().Revoke(ctx, gomock.Any(), sessionUserId, sessionId, gomock.Any(), gomock.Any()).Return(session, nil)
+		require.NoError(t, authUC.RevokeSession(ctx, &model.RevokeSessionRequest{UserId: sessionUserId, SessionId: sessionId}))
+
+		_, err := authUC.Verify(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrUnauthorized)
+	})
+
+	t.Run("Cache failure falls back to the revoked session row", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+		mocks.cache.failGet("session:revoked:"+sessionId, errRedisDown)
+
+		revokedAt := time.Now()
+		session := liveSession()
+		session.RevokedAt = &revokedAt
+		mocks.userSessionRepo.EXPECT().FindById(ctx, gomock.Any(), sessionId).Return(session, nil)
+
+		_, err := authUC.Verify(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrUnauthorized)
+	})
+
+	t.Run("Cache failure accepts a live session row", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+		mocks.cache.failGet("session:revoked:"+sessionId, errRedisDown)
+		mocks.userSessionRepo.EXPECT().FindById(ctx, gomock.Any(), sessionId).Return(liveSession(), nil)
+
+		_, err := authUC.Verify(ctx, request)
+		require.NoError(t, err)
+	})
+
+	t.Run("Cache and database failures fail closed", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+		mocks.cache.failGet("session:revoked:"+sessionId, errRedisDown)
+		mocks.userSessionRepo.EXPECT().FindById(ctx, gomock.Any(), sessionId).Return(nil, errors.New("database is down"))
+
+		_, err := authUC.Verify(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInternal)
+	})
+
+	t.Run("Missing session row is rejected", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+		mocks.cache.failGet("session:revoked:"+sessionId, errRedisDown)
+		mocks.userSessionRepo.EXPECT().FindById(ctx, gomock.Any(), sessionId).Return(nil, sql.ErrNoRows)
+
+		_, err := authUC.Verify(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrUnauthorized)
+	})
+
+	t.Run("Signed out token check fails closed", func(t *testing.T) {
+		authUC, mocks := newAuthUseCase(t)
+		expectAccessToken(t, mocks)
+		mocks.cache.failGet(accessToken, errRedisDown)
+
+		_, err := authUC.Verify(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInternal)
+	})
+}
+
+func TestAccessTokenRequestReuse(t *testing.T) {
+	ctx := context.Background()
+	authUC, mocks := newAuthUseCase(t)
+
+	mocks.jwtAdapter.EXPECT().VerifyRefreshToken("refresh-token").Return(&entity.RefreshToken{
+		UserId:    sessionUserId,
+		SessionId: sessionId,
+		TokenId:   "token-1",
+	}, nil)
+	mocks.userRepo.EXPECT().FindById(ctx, sessionUserId).Return(&entity.User{Id: sessionUserId, Roles: []string{"USER"}}, nil)
+	mocks.jwtAdapter.EXPECT().GenerateRefreshToken(sessionUserId, sessionId, gomock.Any()).Return(&entity.RefreshToken{
+		Token:     "refresh-token-2",
+		ExpiresAt: time.Now().Add(24 * time.Hour),
+	}, nil)
+
+	// The token id was already rotated away while the session is still live, so it is a replay
+	mocks.userSessionRepo.EXPECT().Rotate(ctx, gomock.Any(), gomock.Any(), "token-1").Return(nil, sql.ErrNoRows)
+	mocks.userSessionRepo.EXPECT().FindById(ctx, gomock.Any(), sessionId).Return(liveSession(), nil)
+	mocks.userSessionRepo.EXPECT().Revoke(ctx, gomock.Any(), sessionUserId, sessionId, gomock.Any(), gomock.Any()).Return(liveSession(), nil)
+
+	_, _, err := authUC.AccessTokenRequest(ctx, &model.AccessTokenRequest{RefreshToken: "refresh-token"})
+	assertUseCaseError(t, err, errorcode.ErrUnauthorized)
+	assert.True(t, mocks.cache.has("session:revoked:"+sessionId))
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	twoFactorUserId = "user-1"
+	totpSecret      = "JBSWY3DPEHPK3PXP"
+	validTOTPCode   = "123456"
+)
+
+// expectTOTP decrypts the stored secret as is and only accepts validTOTPCode
+func expectTOTP(mocks *authMocks) {
+	mocks.securityAdapter.EXPECT().Decrypt(gomock.Any()).DoAndReturn(func(ciphertext string) (string, error) {
+		return ciphertext, nil
+	}).AnyTimes()
+	mocks.totpAdapter.EXPECT().Validate(totpSecret, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(secret, code string, at time.Time) bool {
+			return code == validTOTPCode
+		}).AnyTimes()
+}
+
+func twoFactorUser() *entity.User {
+	enabledAt := time.Now().Add(-time.Hour)
+	return &entity.User{
+		Id:                 twoFactorUserId,
+		Username:           "creator",
+		TotpSecret:         sql.NullString{Valid: true, String: totpSecret},
+		TwoFactorEnabledAt: &enabledAt,
+	}
+}
+
+func TestEnableTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Enrollment never started", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(&entity.User{Id: twoFactorUserId}, nil)
+
+		_, err := twoFactorUC.Enable(ctx, &model.TwoFactorCodeRequest{UserId: twoFactorUserId, Code: validTOTPCode})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Wrong codes are capped", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		expectTOTP(mocks)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(&entity.User{Id: twoFactorUserId}, nil).AnyTimes()
+		mocks.cache.set("2fa_enroll:"+twoFactorUserId, totpSecret, 10*time.Minute)
+
+		request := &model.TwoFactorCodeRequest{UserId: twoFactorUserId, Code: "000000"}
+		for attempt := 0; attempt < 5; attempt++ {
+			_, err := twoFactorUC.Enable(ctx, request)
+			assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+		}
+
+		// Even the right code is refused until the attempt window ends
+		request.Code = validTOTPCode
+		_, err := twoFactorUC.Enable(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrTooManyRequests)
+	})
+
+	t.Run("Valid code enables two factor and returns recovery codes", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		expectTOTP(mocks)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(&entity.User{Id: twoFactorUserId}, nil)
+		mocks.cache.set("2fa_enroll:"+twoFactorUserId, totpSecret, 10*time.Minute)
+
+		mocks.expectTransaction()
+		mocks.userRepo.EXPECT().UpdateTwoFactor(ctx, mocks.tx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, tx interface{}, user *entity.User) (*entity.User, error) {
+				assert.Equal(t, totpSecret, user.TotpSecret.String)
+				assert.NotNil(t, user.TwoFactorEnabledAt)
+				return user, nil
+			})
+		mocks.recoveryCodeRepo.EXPECT().DeleteByUserId(ctx, mocks.tx, twoFactorUserId).Return(nil)
+		mocks.recoveryCodeRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any()).Return(nil, nil).Times(10)
+
+		resp, err := twoFactorUC.Enable(ctx, &model.TwoFactorCodeRequest{UserId: twoFactorUserId, Code: validTOTPCode})
+		require.NoError(t, err)
+		assert.Len(t, resp.RecoveryCodes, 10)
+		assert.False(t, mocks.cache.has("2fa_enroll:"+twoFactorUserId))
+		assert.False(t, mocks.cache.has("2fa_attempt:"+twoFactorUserId))
+	})
+}
+
+func TestTwoFactorStepUp(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Step up marks the session and a code is single use", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		expectTOTP(mocks)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(twoFactorUser(), nil).Times(2)
+
+		request := &model.TwoFactorCodeRequest{UserId: twoFactorUserId, SessionId: "session-1", Code: validTOTPCode}
+		resp, err := twoFactorUC.StepUp(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, 300, resp.ExpiresIn)
+		assert.True(t, mocks.cache.has("2fa_step_up:session-1"))
+
+		_, err = twoFactorUC.StepUp(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Recovery code is accepted once", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(twoFactorUser(), nil).Times(2)
+		mocks.recoveryCodeRepo.EXPECT().Consume(ctx, gomock.Any(), twoFactorUserId, "hashed:abcd1234ef", gomock.Any()).Return(true, nil)
+		mocks.recoveryCodeRepo.EXPECT().Consume(ctx, gomock.Any(), twoFactorUserId, "hashed:abcd1234ef", gomock.Any()).Return(false, nil)
+
+		request := &model.TwoFactorCodeRequest{UserId: twoFactorUserId, SessionId: "session-1", Code: "ABCD-1234-EF"}
+		_, err := twoFactorUC.StepUp(ctx, request)
+		require.NoError(t, err)
+
+		_, err = twoFactorUC.StepUp(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("User without two factor can not step up", func(t *testing.T) {
+		_, twoFactorUC, mocks := newAuthUseCases(t)
+		mocks.userRepo.EXPECT().FindById(ctx, twoFactorUserId).Return(&entity.User{Id: twoFactorUserId}, nil)
+
+		_, err := twoFactorUC.StepUp(ctx, &model.TwoFactorCodeRequest{UserId: twoFactorUserId, Code: validTOTPCode})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectSender lets the sender post in the room with the receiver
+func (m *chatMocks) expectSender(ctx context.Context) {
+	m.expectRoom(ctx, senderId)
+	m.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(false, nil)
+}
+
+func TestSendAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Photo the sender can see is shared with its preview", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectSender(ctx)
+		mocks.photoAdapter.EXPECT().GetChatPhotoPreviews(ctx, senderId, []string{"photo-1"}).Return([]*model.ChatPhotoAttachment{
+			{PhotoId: "photo-1", CreatorId: "creator-1", PreviewUrl: "https://cdn.example.com/photo-1-watermark.jpg", IsBuyable: true},
+		}, nil)
+		mocks.expectTransaction()
+		mocks.chatMessageRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, message *entity.ChatMessage) (*entity.ChatMessage, bool, error) {
+				assert.Equal(t, enum.ChatMessageTypePhoto, message.MessageType)
+				require.NotNil(t, message.Attachment)
+				assert.JSONEq(t, `{"photo_id":"photo-1","creator_id":"creator-1","title":"","price":0,"price_str":"",
+					"preview_url":"https://cdn.example.com/photo-1-watermark.jpg","is_buyable":true}`, string(*message.Attachment))
+				return message, true, nil
+			})
+		mocks.chatRoomRepo.EXPECT().UpdateLastMessage(ctx, mocks.tx, gomock.Any()).Return(nil)
+		mocks.chatRoomRepo.EXPECT().FindMemberIds(ctx, gomock.Any(), roomId).Return([]string{senderId, receiverId}, nil)
+		mocks.realtimeChatAdapter.EXPECT().SendMessage(ctx, gomock.Any(), []string{senderId, receiverId}).Return(nil)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Type: enum.ChatMessageTypePhoto,
+			PhotoId: "photo-1"})
+		require.NoError(t, err)
+	})
+
+	t.Run("Photo the sender cannot see is not shared", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectSender(ctx)
+		mocks.photoAdapter.EXPECT().GetChatPhotoPreviews(ctx, senderId, []string{"photo-2"}).Return([]*model.ChatPhotoAttachment{}, nil)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Type: enum.ChatMessageTypePhoto,
+			PhotoId: "photo-2"})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Image outside the chat folder of the sender is rejected", func(t *testing.T) {
+		for _, fileKey := range []string{"chat/user-2/image.jpg", "chat/user-10/image.jpg", "photo/user-1/image.jpg", "image.jpg"} {
+			chatUC, mocks := newChatUseCase(t)
+			mocks.expectSender(ctx)
+
+			_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Type: enum.ChatMessageTypeImage,
+				FileKey: fileKey})
+			assertUseCaseError(t, err, errorcode.ErrForbidden)
+		}
+	})
+
+	t.Run("Image that was never uploaded is not found", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectSender(ctx)
+		mocks.uploadAdapter.EXPECT().StatFile(ctx, "chat/user-1/image.jpg").Return(nil, nil)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Type: enum.ChatMessageTypeImage,
+			FileKey: "chat/user-1/image.jpg"})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+}
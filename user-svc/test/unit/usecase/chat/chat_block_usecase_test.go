@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/adapter"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectRoom makes both users members of the room
+func (m *chatMocks) expectRoom(ctx context.Context, userId string) {
+	m.chatRoomRepo.EXPECT().FindMember(ctx, gomock.Any(), roomId, userId).Return(&entity.ChatRoomMember{RoomId: roomId, UserId: userId}, nil)
+	m.chatRoomRepo.EXPECT().FindMemberIds(ctx, gomock.Any(), roomId).Return([]string{senderId, receiverId}, nil)
+}
+
+func TestBlockUser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Unknown user", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, receiverId).Return(nil, sql.ErrNoRows)
+
+		_, err := chatUC.BlockUser(ctx, &model.RequestBlockUser{UserId: senderId, BlockedUserId: receiverId})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Blocking twice answers like the first block", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, receiverId).Return(&entity.User{Id: receiverId, Username: "receiver"}, nil).Times(2)
+		gomock.InOrder(
+			mocks.userBlockRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ any, block *entity.UserBlock) (bool, error) {
+					assert.Equal(t, senderId, block.BlockerId)
+					assert.Equal(t, receiverId, block.BlockedId)
+					return true, nil
+				}),
+			mocks.userBlockRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(false, nil),
+		)
+
+		for range 2 {
+			resp, err := chatUC.BlockUser(ctx, &model.RequestBlockUser{UserId: senderId, BlockedUserId: receiverId})
+			require.NoError(t, err)
+			assert.Equal(t, receiverId, resp.UserId)
+			require.NotNil(t, resp.Username)
+			assert.Equal(t, "receiver", *resp.Username)
+		}
+	})
+
+	t.Run("Unblocking a user that is not blocked", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.userBlockRepo.EXPECT().Delete(ctx, gomock.Any(), senderId, receiverId).Return(false, nil)
+
+		err := chatUC.UnblockUser(ctx, &model.RequestUnblockUser{UserId: senderId, BlockedUserId: receiverId})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Invalid blocked users cursor", func(t *testing.T) {
+		chatUC, _ := newChatUseCase(t)
+
+		_, _, err := chatUC.GetBlockedUsers(ctx, &model.RequestGetBlockedUsers{UserId: senderId, Cursor: "not-a-cursor", Size: 10})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+}
+
+func TestBlockedChat(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Blocked pair cannot open a room", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.userRepo.EXPECT().FindById(ctx, receiverId).Return(&entity.User{Id: receiverId}, nil)
+		mocks.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(true, nil)
+
+		_, err := chatUC.GetOrCreateRoom(ctx, &model.RequestGetOrCreateRoom{SenderId: senderId, ReceiverId: receiverId})
+		assertUseCaseError(t, err, errorcode.ErrForbidden)
+	})
+
+	t.Run("Message to a blocked peer is not checked or stored", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(true, nil)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Message: "halo"})
+		assertUseCaseError(t, err, errorcode.ErrForbidden)
+	})
+
+	t.Run("Message that could not be moderated is not stored", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(false, nil)
+		mocks.moderationAdapter.EXPECT().IsToxicMessage("halo").Return(false, adapter.ErrModerationUnavailable)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Message: " halo "})
+		assertUseCaseError(t, err, errorcode.ErrExternal)
+	})
+
+	t.Run("Toxic message is rejected", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(false, nil)
+		mocks.moderationAdapter.EXPECT().IsToxicMessage("halo").Return(true, nil)
+
+		_, err := chatUC.SendMessage(ctx, &model.RequestSendMessage{RoomId: roomId, SenderId: senderId, Message: "halo"})
+		assertUseCaseError(t, err, errorcode.ErrValidationFailed)
+	})
+}
+
+func TestReportChat(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Room of other users is not found", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.chatRoomRepo.EXPECT().FindMember(ctx, gomock.Any(), roomId, "user-3").Return(nil, sql.ErrNoRows)
+
+		_, err := chatUC.ReportChat(ctx, &model.RequestReportChat{ReporterId: "user-3", RoomId: roomId, Reason: enum.ChatReportReasonSpam})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Only a message of the reported user can be reported", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.chatMessageRepo.EXPECT().FindById(ctx, gomock.Any(), "message-1").Return(&entity.ChatMessage{
+			Id:       "message-1",
+			RoomId:   roomId,
+			SenderId: sql.NullString{String: senderId, Valid: true},
+		}, nil)
+
+		_, err := chatUC.ReportChat(ctx, &model.RequestReportChat{
+			ReporterId: senderId,
+			RoomId:     roomId,
+			MessageId:  "message-1",
+			Reason:     enum.ChatReportReasonHarassment,
+		})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+
+	t.Run("Report with block blocks the reported user", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.expectTransaction()
+		mocks.chatReportRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any()).Return(true, nil)
+		mocks.userBlockRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ any, block *entity.UserBlock) (bool, error) {
+				assert.Equal(t, senderId, block.BlockerId)
+				assert.Equal(t, receiverId, block.BlockedId)
+				return true, nil
+			})
+
+		resp, err := chatUC.ReportChat(ctx, &model.RequestReportChat{
+			ReporterId:  senderId,
+			RoomId:      roomId,
+			Reason:      enum.ChatReportReasonScam,
+			Description: "  minta transfer  ",
+			Block:       true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, receiverId, resp.ReportedUserId)
+		assert.Equal(t, enum.ChatReportStatusPending, resp.Status)
+		require.NotNil(t, resp.Description)
+		assert.Equal(t, "minta transfer", *resp.Description)
+	})
+
+	t.Run("Pending report of the room is not duplicated or blocked again", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectRoom(ctx, senderId)
+		mocks.expectRollback()
+		mocks.chatReportRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any()).Return(false, nil)
+
+		_, err := chatUC.ReportChat(ctx, &model.RequestReportChat{ReporterId: senderId, RoomId: roomId, Reason: enum.ChatReportReasonSpam, Block: true})
+		assertUseCaseError(t, err, errorcode.ErrAlreadyExists)
+	})
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	roomUserId   = "user-1_user-2"
+	legacyRoomId = "01HQ3Z8V6D5N2K7M4P9R1T0XYZ"
+)
+
+// expectReceiver lets the receiver be found and the pair not be blocked
+func (m *chatMocks) expectReceiver(ctx context.Context) {
+	m.userRepo.EXPECT().FindById(ctx, receiverId).Return(&entity.User{Id: receiverId}, nil)
+	m.userBlockRepo.EXPECT().ExistsBetween(ctx, gomock.Any(), senderId, []string{receiverId}).Return(false, nil)
+}
+
+func TestGetOrCreateRoom(t *testing.T) {
+	ctx := context.Background()
+	request := &model.RequestGetOrCreateRoom{SenderId: senderId, ReceiverId: receiverId}
+
+	t.Run("Existing room is returned", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectReceiver(ctx)
+		mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, gomock.Any(), roomUserId).
+			Return(&entity.ChatRoom{Id: "room-1", RoomUserId: roomUserId}, nil)
+
+		resp, err := chatUC.GetOrCreateRoom(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "room-1", resp.RoomId)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("Pair with a Firestore room keeps its id", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectReceiver(ctx)
+		mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, gomock.Any(), roomUserId).Return(nil, sql.ErrNoRows)
+		mocks.realtimeChatAdapter.EXPECT().FindRoomId(ctx, roomUserId).Return(legacyRoomId, nil)
+		mocks.expectTransaction()
+		mocks.chatRoomRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any(), []string{senderId, receiverId}).DoAndReturn(
+			func(_ context.Context, _ any, room *entity.ChatRoom, _ []string) (bool, error) {
+				assert.Equal(t, legacyRoomId, room.Id)
+				assert.Equal(t, roomUserId, room.RoomUserId)
+				return true, nil
+			})
+		mocks.realtimeChatAdapter.EXPECT().CreateRoom(ctx, gomock.Any(), []string{senderId, receiverId}).Return(nil)
+
+		resp, err := chatUC.GetOrCreateRoom(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, legacyRoomId, resp.RoomId)
+		assert.True(t, resp.Created)
+	})
+
+	t.Run("New pair gets a new room", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectReceiver(ctx)
+		mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, gomock.Any(), roomUserId).Return(nil, sql.ErrNoRows)
+		mocks.realtimeChatAdapter.EXPECT().FindRoomId(ctx, roomUserId).Return("", nil)
+		mocks.expectTransaction()
+		mocks.chatRoomRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any(), gomock.Any()).Return(true, nil)
+		mocks.realtimeChatAdapter.EXPECT().CreateRoom(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := chatUC.GetOrCreateRoom(ctx, request)
+		require.NoError(t, err)
+		assert.Len(t, resp.RoomId, 26)
+		assert.NotEqual(t, legacyRoomId, resp.RoomId)
+		assert.True(t, resp.Created)
+	})
+
+	t.Run("Room created concurrently is returned", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectReceiver(ctx)
+		gomock.InOrder(
+			mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, gomock.Any(), roomUserId).Return(nil, sql.ErrNoRows),
+			mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, mocks.tx, roomUserId).
+				Return(&entity.ChatRoom{Id: "room-1", RoomUserId: roomUserId}, nil),
+		)
+		mocks.realtimeChatAdapter.EXPECT().FindRoomId(ctx, roomUserId).Return("", nil)
+		mocks.expectTransaction()
+		mocks.chatRoomRepo.EXPECT().Create(ctx, mocks.tx, gomock.Any(), gomock.Any()).Return(false, nil)
+
+		resp, err := chatUC.GetOrCreateRoom(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, "room-1", resp.RoomId)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("Failed Firestore lookup does not split the room", func(t *testing.T) {
+		chatUC, mocks := newChatUseCase(t)
+		mocks.expectReceiver(ctx)
+		mocks.chatRoomRepo.EXPECT().FindByRoomUserId(ctx, gomock.Any(), roomUserId).Return(nil, sql.ErrNoRows)
+		mocks.realtimeChatAdapter.EXPECT().FindRoomId(ctx, roomUserId).Return("", errors.New("firestore is down"))
+
+		_, err := chatUC.GetOrCreateRoom(ctx, request)
+		assertUseCaseError(t, err, errorcode.ErrInternal)
+	})
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	senderId   = "user-1"
+	receiverId = "user-2"
+	roomId     = "room-1"
+)
+
+type chatMocks struct {
+	db                  *mockrepository.MockBeginTx
+	tx                  *mockrepository.MockTransactionTx
+	userRepo            *mockrepository.MockUserRepository
+	chatRoomRepo        *mockrepository.MockChatRoomRepository
+	chatMessageRepo     *mockrepository.MockChatMessageRepository
+	userBlockRepo       *mockrepository.MockUserBlockRepository
+	chatReportRepo      *mockrepository.MockChatReportRepository
+	realtimeChatAdapter *mockadapter.MockRealtimeChatAdapter
+	moderationAdapter   *mockadapter.MockModerationAdapter
+	photoAdapter        *mockadapter.MockPhotoAdapter
+	uploadAdapter       *mockadapter.MockUploadAdapter
+}
+
+// newChatUseCase wires the chat use case on mocks, the logger accepts anything so each test only sets up the calls
+// it cares about
+func newChatUseCase(t *testing.T) (usecase.ChatUseCase, *chatMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &chatMocks{
+		db:                  mockrepository.NewMockBeginTx(ctrl),
+		tx:                  mockrepository.NewMockTransactionTx(ctrl),
+		userRepo:            mockrepository.NewMockUserRepository(ctrl),
+		chatRoomRepo:        mockrepository.NewMockChatRoomRepository(ctrl),
+		chatMessageRepo:     mockrepository.NewMockChatMessageRepository(ctrl),
+		userBlockRepo:       mockrepository.NewMockUserBlockRepository(ctrl),
+		chatReportRepo:      mockrepository.NewMockChatReportRepository(ctrl),
+		realtimeChatAdapter: mockadapter.NewMockRealtimeChatAdapter(ctrl),
+		moderationAdapter:   mockadapter.NewMockModerationAdapter(ctrl),
+		photoAdapter:        mockadapter.NewMockPhotoAdapter(ctrl),
+		uploadAdapter:       mockadapter.NewMockUploadAdapter(ctrl),
+	}
+
+	logs := mocklogger.NewMockLog(ctrl)
+	logs.EXPECT().CustomError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().CustomLog(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Log(gomock.Any(), gomock.Any()).AnyTimes()
+
+	chatUC := usecase.NewChatUseCase(mocks.db, mocks.userRepo, mocks.chatRoomRepo, mocks.chatMessageRepo, mocks.userBlockRepo,
+		mocks.chatReportRepo, mocks.realtimeChatAdapter, mockadapter.NewMockAuthClientAdapter(ctrl),
+		mockadapter.NewMockCloudMessagingAdapter(ctrl), mocks.moderationAdapter, mocks.photoAdapter, mocks.uploadAdapter, logs)
+
+	return chatUC, mocks
+}
+
+// expectTransaction lets one transaction begin and commit on the mocked database
+func (m *chatMocks) expectTransaction() {
+	m.db.EXPECT().BeginTxx(gomock.Any(), gomock.Any()).Return(m.tx, nil)
+	m.tx.EXPECT().Commit().Return(nil)
+}
+
+// expectRollback lets one transaction begin and roll back on the mocked database
+func (m *chatMocks) expectRollback() {
+	m.db.EXPECT().BeginTxx(gomock.Any(), gomock.Any()).Return(m.tx, nil)
+	m.tx.EXPECT().Rollback().Return(nil)
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/entity"
+	errorcode "github.com/hervibest/be-yourmoments-backup/user-svc/internal/enum/error"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/helper"
+	mockadapter "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/adapter"
+	mockproducer "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/gateway/producer"
+	mocklogger "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/helper/logger"
+	mockrepository "github.com/hervibest/be-yourmoments-backup/user-svc/internal/mocks/repository"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/model"
+	"github.com/hervibest/be-yourmoments-backup/user-svc/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type socialLinkMocks struct {
+	db                 *mockrepository.MockBeginTx
+	tx                 *mockrepository.MockTransactionTx
+	socialMediaRepo    *mockrepository.MockSocialMediaRepository
+	userSocialLinkRepo *mockrepository.MockUserSocialLinkRepository
+	userProducer       *mockproducer.MockUserProducer
+}
+
+func newUserUseCase(t *testing.T) (usecase.UserUseCase, *socialLinkMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := &socialLinkMocks{
+		db:                 mockrepository.NewMockBeginTx(ctrl),
+		tx:                 mockrepository.NewMockTransactionTx(ctrl),
+		socialMediaRepo:    mockrepository.NewMockSocialMediaRepository(ctrl),
+		userSocialLinkRepo: mockrepository.NewMockUserSocialLinkRepository(ctrl),
+		userProducer:       mockproducer.NewMockUserProducer(ctrl),
+	}
+
+	logs := mocklogger.NewMockLog(ctrl)
+	logs.EXPECT().CustomError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logs.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	userUC := usecase.NewUserUseCase(mocks.db, mockrepository.NewMockUserRepository(ctrl), mockrepository.NewMockUserProfileRepository(ctrl),
+		nil, mocks.socialMediaRepo, mocks.userSocialLinkRepo, nil, mockadapter.NewMockCacheAdapter(ctrl),
+		mocks.userProducer, logs)
+	return userUC, mocks
+}
+
+func assertUseCaseError(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := err.(*helper.AppError)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func socialMedia(baseUrl string) *entity.SocialMedia {
+	return &entity.SocialMedia{
+		Id:       "social-1",
+		Name:     "Instagram",
+		BaseUrl:  sql.NullString{String: baseUrl, Valid: baseUrl != ""},
+		IsActive: true,
+	}
+}
+
+func TestSetSocialLink(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		baseUrl string
+		input   string
+		handle  string
+		url     string
+	}{
+		{name: "Bare handle", baseUrl: "https://www.instagram.com/", input: "johndoe", handle: "johndoe", url: "https://www.instagram.com/johndoe"},
+		{name: "Handle with at sign", baseUrl: "https://www.instagram.com/", input: " @johndoe ", handle: "johndoe", url: "https://www.instagram.com/johndoe"},
+		{name: "Link without www", baseUrl: "https://www.instagram.com/", input: "https://instagram.com/johndoe/", handle: "johndoe", url: "https://www.instagram.com/johndoe"},
+		{name: "Link under a base path", baseUrl: "https://www.tiktok.com/@", input: "https://tiktok.com/@johndoe", handle: "johndoe", url: "https://www.tiktok.com/@johndoe"},
+		{name: "Any link without base url", baseUrl: "", input: "https://johndoe.dev/portfolio", handle: "https://johndoe.dev/portfolio", url: "https://johndoe.dev/portfolio"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userUC, mocks := newUserUseCase(t)
+			mocks.socialMediaRepo.EXPECT().FindById(ctx, "social-1").Return(socialMedia(tt.baseUrl), nil)
+			mocks.db.EXPECT().BeginTxx(ctx, gomock.Any()).Return(mocks.tx, nil)
+			mocks.tx.EXPECT().Commit().Return(nil)
+			mocks.userSocialLinkRepo.EXPECT().Upsert(ctx, mocks.tx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ any, link *entity.UserSocialLink) (*entity.UserSocialLink, error) {
+					assert.Equal(t, "profile-1", link.UserProfileId)
+					assert.Equal(t, tt.handle, link.Handle)
+					return link, nil
+				})
+			mocks.userProducer.EXPECT().ProduceUserProfileUpdated(ctx, gomock.Any()).Return(nil)
+
+			resp, err := userUC.SetSocialLink(ctx, &model.RequestSetSocialLink{
+				UserId:        "user-1",
+				UserProfileId: "profile-1",
+				SocialMediaId: "social-1",
+				Handle:        tt.input,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.handle, resp.Handle)
+			assert.Equal(t, tt.url, resp.Url)
+		})
+	}
+}
+
+func TestSetSocialLinkRejectsInvalidLinks(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		baseUrl string
+		input   string
+	}{
+		{name: "Link of another host", baseUrl: "https://www.instagram.com/", input: "https://www.tiktok.com/johndoe"},
+		{name: "Link outside the base path", baseUrl: "https://www.youtube.com/c/", input: "https://youtube.com/watch?v=1"},
+		{name: "Link with a non http scheme", baseUrl: "https://www.instagram.com/", input: "ftp://instagram.com/johndoe"},
+		{name: "Handle with spaces", baseUrl: "https://www.instagram.com/", input: "john doe"},
+		{name: "Link with a nested path", baseUrl: "https://www.instagram.com/", input: "https://instagram.com/johndoe/reels"},
+		{name: "Link without scheme when there is no base url", baseUrl: "", input: "johndoe.dev"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userUC, mocks := newUserUseCase(t)
+			mocks.socialMediaRepo.EXPECT().FindById(ctx, "social-1").Return(socialMedia(tt.baseUrl), nil)
+
+			_, err := userUC.SetSocialLink(ctx, &model.RequestSetSocialLink{
+				UserId:        "user-1",
+				UserProfileId: "profile-1",
+				SocialMediaId: "social-1",
+				Handle:        tt.input,
+			})
+			assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+		})
+	}
+
+	t.Run("Inactive social media", func(t *testing.T) {
+		userUC, mocks := newUserUseCase(t)
+		inactive := socialMedia("https://www.instagram.com/")
+		inactive.IsActive = false
+		mocks.socialMediaRepo.EXPECT().FindById(ctx, "social-1").Return(inactive, nil)
+
+		_, err := userUC.SetSocialLink(ctx, &model.RequestSetSocialLink{UserId: "user-1", UserProfileId: "profile-1", SocialMediaId: "social-1", Handle: "johndoe"})
+		assertUseCaseError(t, err, errorcode.ErrInvalidArgument)
+	})
+
+	t.Run("Unknown social media", func(t *testing.T) {
+		userUC, mocks := newUserUseCase(t)
+		mocks.socialMediaRepo.EXPECT().FindById(ctx, "social-1").Return(nil, sql.ErrNoRows)
+
+		_, err := userUC.SetSocialLink(ctx, &model.RequestSetSocialLink{UserId: "user-1", UserProfileId: "profile-1", SocialMediaId: "social-1", Handle: "johndoe"})
+		assertUseCaseError(t, err, errorcode.ErrResourceNotFound)
+	})
+}